@@ -89,6 +89,7 @@ import (
 
 	minttypes "github.com/osmosis-labs/osmosis/v24/x/mint/types"
 	protorevtypes "github.com/osmosis-labs/osmosis/v24/x/protorev/types"
+	txfeestypes "github.com/osmosis-labs/osmosis/v24/x/txfees/types"
 
 	"github.com/osmosis-labs/osmosis/v24/app/keepers"
 	"github.com/osmosis-labs/osmosis/v24/app/upgrades"
@@ -334,6 +335,7 @@ func NewOsmosisApp(
 	// NOTE: we may consider parsing `appOpts` inside module constructors. For the moment
 	// we prefer to be more strict in what arguments the modules expect.
 	skipGenesisInvariants := cast.ToBool(appOpts.Get(crisis.FlagSkipGenesisInvariants))
+	mempoolFeeOptions := txfeestypes.NewMempoolFeeOptions(appOpts)
 
 	// NOTE: All module / keeper changes should happen prior to this module.NewManager line being called.
 	// However in the event any changes do need to happen after this call, ensure that that keeper
@@ -344,7 +346,7 @@ func NewOsmosisApp(
 	//
 	// Any time a module requires a keeper de-ref'd that's not its native one,
 	// its code-smell and should probably change. We should get the staking keeper dependencies fixed.
-	app.mm = module.NewManager(appModules(app, encodingConfig, skipGenesisInvariants)...)
+	app.mm = module.NewManager(appModules(app, encodingConfig, skipGenesisInvariants, mempoolFeeOptions)...)
 
 	// During begin block slashing happens after distr.BeginBlocker so that
 	// there is nothing left over in the validator fee pool, so as to keep the
@@ -232,6 +232,7 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 		authenticator.NewAnyOfAuthenticator(appKeepers.AuthenticatorManager),
 		authenticator.NewPartitionedAnyOfAuthenticator(appKeepers.AuthenticatorManager),
 		authenticator.NewPartitionedAllOfAuthenticator(appKeepers.AuthenticatorManager),
+		authenticator.NewTimeWindowAuthenticator(appKeepers.AuthenticatorManager, appKeepers.keys[smartaccounttypes.StoreKey]),
 	})
 	govModuleAddr := appKeepers.AccountKeeper.GetModuleAddress(govtypes.ModuleName)
 
@@ -241,6 +242,9 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 		govModuleAddr,
 		appKeepers.GetSubspace(smartaccounttypes.ModuleName),
 		appKeepers.AuthenticatorManager,
+		appKeepers.AccountKeeper,
+		encodingConfig.TxConfig,
+		bApp.MsgServiceRouter(),
 	)
 	appKeepers.SmartAccountKeeper = &smartAccountKeeper
 
@@ -439,6 +443,18 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 		appKeepers.tkeys[twaptypes.TransientStoreKey],
 		appKeepers.GetSubspace(twaptypes.ModuleName),
 		appKeepers.PoolManagerKeeper)
+	appKeepers.ConcentratedLiquidityKeeper.SetTwapKeeper(appKeepers.TwapKeeper)
+	appKeepers.GAMMKeeper.SetTwapKeeper(appKeepers.TwapKeeper)
+	appKeepers.PoolManagerKeeper.SetTwapKeeper(appKeepers.TwapKeeper)
+
+	// register the native spend limit authenticator, which needs the twap and poolmanager keepers to value tokens
+	appKeepers.AuthenticatorManager.RegisterAuthenticator(
+		authenticator.NewSpendLimitAuthenticator(
+			appKeepers.keys[smartaccounttypes.StoreKey],
+			appKeepers.BankKeeper,
+			appKeepers.TwapKeeper,
+			appKeepers.PoolManagerKeeper,
+		))
 
 	appKeepers.EpochsKeeper = epochskeeper.NewKeeper(appKeepers.keys[epochstypes.StoreKey])
 
@@ -568,6 +584,7 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 	)
 	appKeepers.WasmKeeper = &wasmKeeper
 	appKeepers.CosmwasmPoolKeeper.SetWasmKeeper(appKeepers.WasmKeeper)
+	appKeepers.GAMMKeeper.SetWasmKeeper(appKeepers.WasmKeeper)
 
 	// Pass the contract keeper to all the structs (generally ICS4Wrappers for ibc middlewares) that need it
 	appKeepers.ContractKeeper = wasmkeeper.NewDefaultPermissionKeeper(appKeepers.WasmKeeper)
@@ -825,6 +842,13 @@ func (appKeepers *AppKeepers) SetupHooks() {
 		),
 	)
 
+	appKeepers.CosmwasmPoolKeeper.SetListeners(
+		cosmwasmpooltypes.NewCosmWasmPoolListeners(
+			appKeepers.TwapKeeper.CosmWasmPoolListener(),
+			appKeepers.PoolIncentivesKeeper.Hooks(),
+		),
+	)
+
 	appKeepers.LockupKeeper.SetHooks(
 		lockuptypes.NewMultiLockupHooks(
 			// insert lockup hooks receivers here
@@ -854,6 +878,7 @@ func (appKeepers *AppKeepers) SetupHooks() {
 			appKeepers.IncentivesKeeper.Hooks(),
 			appKeepers.MintKeeper.Hooks(),
 			appKeepers.ProtoRevKeeper.EpochHooks(),
+			appKeepers.GAMMKeeper.EpochHooks(),
 		),
 	)
 
@@ -91,8 +91,10 @@ var AppModuleBasics = []module.AppModuleBasic{
 			gammclient.UpdateMigrationRecordsProposalHandler,
 			gammclient.CreateCLPoolAndLinkToCFMMProposalHandler,
 			gammclient.SetScalingFactorControllerProposalHandler,
+			gammclient.SetScalingFactorRateSourceProposalHandler,
 			clclient.CreateConcentratedLiquidityPoolProposalHandler,
 			clclient.TickSpacingDecreaseProposalHandler,
+			clclient.SetDynamicSpreadFactorProposalHandler,
 			cwpoolclient.UploadCodeIdAndWhitelistProposalHandler,
 			cwpoolclient.MigratePoolContractsProposalHandler,
 			txfeesclient.SubmitUpdateFeeTokenProposalHandler,
@@ -134,7 +134,7 @@ var moduleAccountPermissions = map[string][]string{
 	tokenfactorytypes.ModuleName:             {authtypes.Minter, authtypes.Burner},
 	valsetpreftypes.ModuleName:               {authtypes.Staking},
 	poolmanagertypes.ModuleName:              nil,
-	cosmwasmpooltypes.ModuleName:             nil,
+	cosmwasmpooltypes.ModuleName:             {authtypes.Minter, authtypes.Burner},
 	smartaccounttypes.ModuleName:             nil,
 }
 
@@ -143,6 +143,7 @@ func appModules(
 	app *OsmosisApp,
 	encodingConfig appparams.EncodingConfig,
 	skipGenesisInvariants bool,
+	mempoolFeeOptions txfeestypes.MempoolFeeOptions,
 ) []module.AppModule {
 	appCodec := encodingConfig.Marshaler
 
@@ -178,7 +179,7 @@ func appModules(
 		twapmodule.NewAppModule(*app.TwapKeeper),
 		concentratedliquidity.NewAppModule(appCodec, *app.ConcentratedLiquidityKeeper),
 		protorev.NewAppModule(appCodec, *app.ProtoRevKeeper, app.AccountKeeper, app.BankKeeper, app.EpochsKeeper, app.GAMMKeeper),
-		txfees.NewAppModule(*app.TxFeesKeeper),
+		txfees.NewAppModule(*app.TxFeesKeeper, mempoolFeeOptions),
 		incentives.NewAppModule(*app.IncentivesKeeper, app.AccountKeeper, app.BankKeeper, app.EpochsKeeper),
 		lockup.NewAppModule(*app.LockupKeeper, app.AccountKeeper, app.BankKeeper),
 		poolincentives.NewAppModule(*app.PoolIncentivesKeeper),
@@ -1,15 +1,22 @@
 package v25
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	slashing "github.com/cosmos/cosmos-sdk/x/slashing/keeper"
 	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 
 	"github.com/osmosis-labs/osmosis/v24/app/keepers"
 	"github.com/osmosis-labs/osmosis/v24/app/upgrades"
+	cosmwasmpooltypes "github.com/osmosis-labs/osmosis/v24/x/cosmwasmpool/types"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v24/x/poolmanager/types"
+	txfeestypes "github.com/osmosis-labs/osmosis/v24/x/txfees/types"
 )
 
 func CreateUpgradeHandler(
@@ -38,10 +45,35 @@ func CreateUpgradeHandler(
 		authenticatorParams.IsSmartAccountActive = false
 		keepers.SmartAccountKeeper.SetParams(ctx, authenticatorParams)
 
+		// Set the EIP-1559 params in the store. The base fee keeps being tracked locally by each node
+		// until governance enables tracking it in state.
+		keepers.TxFeesKeeper.SetParam(ctx, txfeestypes.KeyEip1559Params, txfeestypes.DefaultEip1559Params())
+
+		// Set the custom taker fee pool ids in the store. No pool charges the taker fee itself
+		// until governance adds its id.
+		keepers.PoolManagerKeeper.SetParam(ctx, poolmanagertypes.KeyCustomTakerFeePoolIds, []uint64{})
+
+		// Allow the cosmwasmpool module to mint and burn the LP shares of cosmwasm pools.
+		if err := setCosmWasmPoolModuleAccountPermissions(ctx, keepers.AccountKeeper); err != nil {
+			return nil, err
+		}
+
 		return migrations, nil
 	}
 }
 
+// setCosmWasmPoolModuleAccountPermissions grants the minter and burner permissions to the existing
+// cosmwasmpool module account, which was created without permissions.
+func setCosmWasmPoolModuleAccountPermissions(ctx sdk.Context, accountKeeper *authkeeper.AccountKeeper) error {
+	moduleAccount, ok := accountKeeper.GetModuleAccount(ctx, cosmwasmpooltypes.ModuleName).(*authtypes.ModuleAccount)
+	if !ok {
+		return fmt.Errorf("account of module %s is not a module account", cosmwasmpooltypes.ModuleName)
+	}
+	moduleAccount.Permissions = []string{authtypes.Minter, authtypes.Burner}
+	accountKeeper.SetModuleAccount(ctx, moduleAccount)
+	return nil
+}
+
 // resetMissedBlocksCounter resets the missed blocks counter for all validators back to zero.
 // This corrects a mistake that was overlooked in v24, where we cleared all missedBlocks but did not reset the counter.
 func resetMissedBlocksCounter(ctx sdk.Context, slashingKeeper *slashing.Keeper) {
@@ -12,11 +12,14 @@ import (
 	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
 	abci "github.com/cometbft/cometbft/abci/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/osmosis-labs/osmosis/v24/app/apptesting"
+	cosmwasmpooltypes "github.com/osmosis-labs/osmosis/v24/x/cosmwasmpool/types"
 )
 
 const (
@@ -39,6 +42,7 @@ func (s *UpgradeTestSuite) TestUpgrade() {
 	s.Setup()
 
 	preMigrationSigningInfo := s.prepareMissedBlocksCounterTest()
+	s.prepareCosmWasmPoolModuleAccountTest()
 
 	// Run the upgrade
 	dummyUpgrade(s)
@@ -47,6 +51,7 @@ func (s *UpgradeTestSuite) TestUpgrade() {
 	})
 
 	s.executeMissedBlocksCounterTest(preMigrationSigningInfo)
+	s.executeCosmWasmPoolModuleAccountTest()
 }
 
 func dummyUpgrade(s *UpgradeTestSuite) {
@@ -107,3 +112,18 @@ func (s *UpgradeTestSuite) executeMissedBlocksCounterTest(preMigrationSigningInf
 	s.Require().Equal(preMigrationSigningInfo.JailedUntil, postMigrationSigningInfo.JailedUntil)
 	s.Require().Equal(preMigrationSigningInfo.Tombstoned, postMigrationSigningInfo.Tombstoned)
 }
+
+func (s *UpgradeTestSuite) prepareCosmWasmPoolModuleAccountTest() {
+	// Replicate current mainnet state where the cosmwasmpool module account has no permissions
+	moduleAccount := s.App.AccountKeeper.GetModuleAccount(s.Ctx, cosmwasmpooltypes.ModuleName).(*authtypes.ModuleAccount)
+	moduleAccount.Permissions = nil
+	s.App.AccountKeeper.SetModuleAccount(s.Ctx, moduleAccount)
+
+	s.Require().False(s.App.AccountKeeper.GetModuleAccount(s.Ctx, cosmwasmpooltypes.ModuleName).HasPermission(authtypes.Minter))
+}
+
+func (s *UpgradeTestSuite) executeCosmWasmPoolModuleAccountTest() {
+	moduleAccount := s.App.AccountKeeper.GetModuleAccount(s.Ctx, cosmwasmpooltypes.ModuleName)
+	s.Require().True(moduleAccount.HasPermission(authtypes.Minter))
+	s.Require().True(moduleAccount.HasPermission(authtypes.Burner))
+}
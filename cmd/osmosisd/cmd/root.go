@@ -616,6 +616,17 @@ min-gas-price-for-high-gas-tx = ".0025"
 # This parameter enables EIP-1559 like fee market logic in the mempool
 adaptive-fee-enabled = "true"
 
+# Fee lanes let txs whose messages are all of the listed types pay a different min gas price.
+# The node min gas price and the EIP-1559 base fee are multiplied by min-gas-price-multiplier,
+# and txs in a lane with recheck-fee-exempt are not evicted from the mempool when the base fee rises.
+# A tx belongs to the first lane that matches all of its messages. No lanes are configured by default.
+#
+# [[osmosis-mempool.fee-lanes]]
+# name = "ibc-relayer"
+# msg-type-urls = ["/ibc.core.client.v1.MsgUpdateClient", "/ibc.core.channel.v1.MsgRecvPacket", "/ibc.core.channel.v1.MsgAcknowledgement", "/ibc.core.channel.v1.MsgTimeout"]
+# min-gas-price-multiplier = "1"
+# recheck-fee-exempt = true
+
 ###############################################################################
 ###              Osmosis Sidecar Query Server Configuration                 ###
 ###############################################################################
@@ -99,10 +99,14 @@ func init() {
 	setWhitelistedQuery("/osmosis.gamm.v1beta1.Query/TotalLiquidity", &gammtypes.QueryTotalLiquidityResponse{})
 	setWhitelistedQuery("/osmosis.gamm.v1beta1.Query/Pool", &gammtypes.QueryPoolResponse{}) // ==> use x/poolmanager
 	setWhitelistedQuery("/osmosis.gamm.v1beta1.Query/PoolParams", &gammtypes.QueryPoolParamsResponse{})
+	setWhitelistedQuery("/osmosis.gamm.v1beta1.Query/PoolWeights", &gammtypes.QueryPoolWeightsResponse{})
+	setWhitelistedQuery("/osmosis.gamm.v1beta1.Query/ScalingFactorRateSource", &gammtypes.QueryScalingFactorRateSourceResponse{})
 	setWhitelistedQuery("/osmosis.gamm.v1beta1.Query/TotalPoolLiquidity", &gammtypes.QueryTotalPoolLiquidityResponse{}) // ==> use x/poolmanager
 	setWhitelistedQuery("/osmosis.gamm.v1beta1.Query/TotalShares", &gammtypes.QueryTotalSharesResponse{})
 	setWhitelistedQuery("/osmosis.gamm.v1beta1.Query/CalcJoinPoolShares", &gammtypes.QueryCalcJoinPoolSharesResponse{})
 	setWhitelistedQuery("/osmosis.gamm.v1beta1.Query/CalcExitPoolCoinsFromShares", &gammtypes.QueryCalcExitPoolCoinsFromSharesResponse{})
+	setWhitelistedQuery("/osmosis.gamm.v1beta1.Query/CalcJoinSwapShareAmountOut", &gammtypes.QueryCalcJoinSwapShareAmountOutResponse{})
+	setWhitelistedQuery("/osmosis.gamm.v1beta1.Query/CalcExitSwapExactAmountOut", &gammtypes.QueryCalcExitSwapExactAmountOutResponse{})
 	setWhitelistedQuery("/osmosis.gamm.v1beta1.Query/CalcJoinPoolNoSwapShares", &gammtypes.QueryCalcJoinPoolNoSwapSharesResponse{})
 	setWhitelistedQuery("/osmosis.gamm.v1beta1.Query/PoolType", &gammtypes.QueryPoolTypeResponse{})
 	setWhitelistedQuery("/osmosis.gamm.v2.Query/SpotPrice", &gammv2types.QuerySpotPriceResponse{})
@@ -143,6 +147,7 @@ func init() {
 	setWhitelistedQuery("/osmosis.poolmanager.v1beta1.Query/NumPools", &poolmanagerqueryproto.NumPoolsResponse{})
 	setWhitelistedQuery("/osmosis.poolmanager.v1beta1.Query/EstimateSwapExactAmountIn", &poolmanagerqueryproto.EstimateSwapExactAmountInResponse{})
 	setWhitelistedQuery("/osmosis.poolmanager.v1beta1.Query/EstimateSwapExactAmountOut", &poolmanagerqueryproto.EstimateSwapExactAmountOutResponse{})
+	setWhitelistedQuery("/osmosis.poolmanager.v1beta1.Query/EstimateSwapExactAmountInWithTrace", &poolmanagerqueryproto.EstimateSwapExactAmountInWithTraceResponse{})
 	setWhitelistedQuery("/osmosis.poolmanager.v1beta1.Query/EstimateSinglePoolSwapExactAmountIn", &poolmanagerqueryproto.EstimateSwapExactAmountInResponse{})
 	setWhitelistedQuery("/osmosis.poolmanager.v1beta1.Query/EstimateSinglePoolSwapExactAmountOut", &poolmanagerqueryproto.EstimateSwapExactAmountOutResponse{})
 	setWhitelistedQuery("/osmosis.poolmanager.v1beta1.Query/Pool", &poolmanagerqueryproto.PoolResponse{})
@@ -151,6 +156,8 @@ func init() {
 	setWhitelistedQuery("/osmosis.poolmanager.v1beta1.Query/Params", &poolmanagerqueryproto.ParamsResponse{})
 	setWhitelistedQuery("/osmosis.poolmanager.v1beta1.Query/TradingPairTakerFee", &poolmanagerqueryproto.TradingPairTakerFeeResponse{})
 	setWhitelistedQuery("/osmosis.poolmanager.v1beta1.Query/EstimateTradeBasedOnPriceImpact", &poolmanagerqueryproto.EstimateTradeBasedOnPriceImpactResponse{})
+	setWhitelistedQuery("/osmosis.poolmanager.v1beta1.Query/PoolDepth", &poolmanagerqueryproto.PoolDepthResponse{})
+	setWhitelistedQuery("/osmosis.poolmanager.v1beta1.Query/TwapOrder", &poolmanagerqueryproto.TwapOrderResponse{})
 
 	// txfees
 	setWhitelistedQuery("/osmosis.txfees.v1beta1.Query/FeeTokens", &txfeestypes.QueryFeeTokensResponse{})
@@ -168,6 +175,12 @@ func init() {
 	setWhitelistedQuery("/osmosis.twap.v1beta1.Query/ArithmeticTwapToNow", &twapquerytypes.ArithmeticTwapToNowResponse{})
 	setWhitelistedQuery("/osmosis.twap.v1beta1.Query/GeometricTwap", &twapquerytypes.GeometricTwapResponse{})
 	setWhitelistedQuery("/osmosis.twap.v1beta1.Query/GeometricTwapToNow", &twapquerytypes.GeometricTwapToNowResponse{})
+	setWhitelistedQuery("/osmosis.twap.v1beta1.Query/VolumeWeightedTwap", &twapquerytypes.VolumeWeightedTwapResponse{})
+	setWhitelistedQuery("/osmosis.twap.v1beta1.Query/VolumeWeightedTwapToNow", &twapquerytypes.VolumeWeightedTwapToNowResponse{})
+	setWhitelistedQuery("/osmosis.twap.v1beta1.Query/ArithmeticTwapForRoute", &twapquerytypes.ArithmeticTwapForRouteResponse{})
+	setWhitelistedQuery("/osmosis.twap.v1beta1.Query/GeometricTwapForRoute", &twapquerytypes.GeometricTwapForRouteResponse{})
+	setWhitelistedQuery("/osmosis.twap.v1beta1.Query/Volatility", &twapquerytypes.VolatilityResponse{})
+	setWhitelistedQuery("/osmosis.twap.v1beta1.Query/SpotPriceRange", &twapquerytypes.SpotPriceRangeResponse{})
 	setWhitelistedQuery("/osmosis.twap.v1beta1.Query/Params", &twapquerytypes.ParamsResponse{})
 
 	// downtime-detector
@@ -184,6 +197,11 @@ func init() {
 	setWhitelistedQuery("/osmosis.concentratedliquidity.v1beta1.Query/IncentiveRecords", &concentratedliquidityquery.IncentiveRecordsResponse{})
 	setWhitelistedQuery("/osmosis.concentratedliquidity.v1beta1.Query/TickAccumulatorTrackers", &concentratedliquidityquery.TickAccumulatorTrackersResponse{})
 	setWhitelistedQuery("/osmosis.concentratedliquidity.v1beta1.Query/CFMMPoolIdLinkFromConcentratedPoolId", &concentratedliquidityquery.CFMMPoolIdLinkFromConcentratedPoolIdResponse{})
+	setWhitelistedQuery("/osmosis.concentratedliquidity.v1beta1.Query/LimitOrderById", &concentratedliquidityquery.LimitOrderByIdResponse{})
+	setWhitelistedQuery("/osmosis.concentratedliquidity.v1beta1.Query/UserLimitOrders", &concentratedliquidityquery.UserLimitOrdersResponse{})
+	setWhitelistedQuery("/osmosis.concentratedliquidity.v1beta1.Query/UserManagedPositions", &concentratedliquidityquery.UserManagedPositionsResponse{})
+	setWhitelistedQuery("/osmosis.concentratedliquidity.v1beta1.Query/EffectiveSpreadFactor", &concentratedliquidityquery.EffectiveSpreadFactorResponse{})
+	setWhitelistedQuery("/osmosis.concentratedliquidity.v1beta1.Query/PositionHistory", &concentratedliquidityquery.PositionHistoryResponse{})
 }
 
 // IsWhitelistedQuery returns if the query is not whitelisted.
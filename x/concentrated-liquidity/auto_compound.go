@@ -0,0 +1,308 @@
+package concentrated_liquidity
+
+import (
+	"strconv"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v24/x/concentrated-liquidity/math"
+	"github.com/osmosis-labs/osmosis/v24/x/concentrated-liquidity/types"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v24/x/poolmanager/types"
+)
+
+// CompoundPositionData holds the amounts and liquidity added back to a position when compounding it.
+type CompoundPositionData struct {
+	Amount0        osmomath.Int
+	Amount1        osmomath.Int
+	LiquidityAdded osmomath.Dec
+}
+
+// compoundPosition claims the spread rewards and incentives of the position with the given id and
+// adds them back to it, keeping the position id. The claimed pool tokens are first swapped through
+// poolmanager so that they match the ratio of the position's range at the current price, with a
+// minimum amount out derived from the TWAP of the pool (see twapMinAmountOut). Claimed
+// coins that are not pool tokens, as well as any remainder that does not fit the range, are left
+// with the owner.
+//
+// Contrary to addToPosition, the liquidity is added in place, so the position keeps its id and its
+// join time. Since only rewards earned by the position itself are added, this does not allow
+// bypassing the uptime requirements of incentives.
+//
+// Returns error if:
+// - the position does not exist or is not owned by owner
+// - the position is superfluid staked
+// - the TWAP of the pool cannot be computed, or the swap returns less than the TWAP-derived minimum
+// - the claimed pool tokens do not translate to any liquidity in the position's range
+func (k Keeper) compoundPosition(ctx sdk.Context, owner sdk.AccAddress, positionId uint64) (CompoundPositionData, error) {
+	position, err := k.GetPosition(ctx, positionId)
+	if err != nil {
+		return CompoundPositionData{}, err
+	}
+
+	if owner.String() != position.Address {
+		return CompoundPositionData{}, types.NotPositionOwnerError{PositionId: positionId, Address: owner.String()}
+	}
+
+	// Superfluid staked positions track their liquidity in the underlying lock,
+	// so it cannot be changed in place.
+	positionHasUnderlyingLock, _, err := k.positionHasActiveUnderlyingLockAndUpdate(ctx, positionId)
+	if err != nil {
+		return CompoundPositionData{}, err
+	}
+	if positionHasUnderlyingLock {
+		return CompoundPositionData{}, types.PositionSuperfluidStakedError{PositionId: positionId}
+	}
+
+	spreadRewards, err := k.collectSpreadRewards(ctx, owner, positionId)
+	if err != nil {
+		return CompoundPositionData{}, err
+	}
+
+	incentives, _, _, err := k.collectIncentives(ctx, owner, positionId)
+	if err != nil {
+		return CompoundPositionData{}, err
+	}
+
+	pool, err := k.getPoolById(ctx, position.PoolId)
+	if err != nil {
+		return CompoundPositionData{}, err
+	}
+
+	collected := spreadRewards.Add(incentives...)
+	amount0 := collected.AmountOf(pool.GetToken0())
+	amount1 := collected.AmountOf(pool.GetToken1())
+	if amount0.IsZero() && amount1.IsZero() {
+		return CompoundPositionData{}, types.NothingToCompoundError{PositionId: positionId}
+	}
+
+	sqrtPriceLower, sqrtPriceUpper, err := math.TicksToSqrtPrice(position.LowerTick, position.UpperTick)
+	if err != nil {
+		return CompoundPositionData{}, err
+	}
+
+	zeroForOne, amountIn := computeManagedPositionSwap(pool.GetCurrentSqrtPrice(), sqrtPriceLower, sqrtPriceUpper, amount0, amount1)
+	if amountIn.IsPositive() {
+		tokenInDenom, tokenOutDenom := pool.GetToken1(), pool.GetToken0()
+		if zeroForOne {
+			tokenInDenom, tokenOutDenom = pool.GetToken0(), pool.GetToken1()
+		}
+
+		tokenIn := sdk.NewCoin(tokenInDenom, amountIn)
+		tokenOutMinAmount, err := k.twapMinAmountOut(ctx, pool.GetId(), tokenIn, tokenOutDenom, pool.GetSpreadFactor(ctx))
+		if err != nil {
+			return CompoundPositionData{}, err
+		}
+
+		route := []poolmanagertypes.SwapAmountInRoute{{PoolId: pool.GetId(), TokenOutDenom: tokenOutDenom}}
+		tokenOutAmount, err := k.poolmanagerKeeper.RouteExactAmountIn(ctx, owner, route, tokenIn, tokenOutMinAmount)
+		if err != nil {
+			return CompoundPositionData{}, err
+		}
+
+		if zeroForOne {
+			amount0, amount1 = amount0.Sub(amountIn), amount1.Add(tokenOutAmount)
+		} else {
+			amount0, amount1 = amount0.Add(tokenOutAmount), amount1.Sub(amountIn)
+		}
+
+		// The swap moved the current price of the pool.
+		pool, err = k.getPoolById(ctx, position.PoolId)
+		if err != nil {
+			return CompoundPositionData{}, err
+		}
+	}
+
+	liquidityDelta := math.GetLiquidityFromAmounts(pool.GetCurrentSqrtPrice(), sqrtPriceLower, sqrtPriceUpper, amount0, amount1)
+	if liquidityDelta.IsZero() {
+		return CompoundPositionData{}, types.NothingToCompoundError{PositionId: positionId}
+	}
+
+	updateData, err := k.UpdatePosition(ctx, position.PoolId, owner, position.LowerTick, position.UpperTick, liquidityDelta, position.JoinTime, positionId)
+	if err != nil {
+		return CompoundPositionData{}, err
+	}
+
+	err = k.sendCoinsBetweenPoolAndUser(ctx, pool.GetToken0(), pool.GetToken1(), updateData.Amount0, updateData.Amount1, owner, pool.GetAddress())
+	if err != nil {
+		return CompoundPositionData{}, err
+	}
+
+	tokensAdded := sdk.NewCoins(sdk.NewCoin(pool.GetToken0(), updateData.Amount0), sdk.NewCoin(pool.GetToken1(), updateData.Amount1))
+	k.RecordTotalLiquidityIncrease(ctx, tokensAdded)
+
+	if err := k.writePositionCheckpoint(ctx, owner, position.PoolId, positionId, positionCheckpointDelta{deposited: tokensAdded}); err != nil {
+		return CompoundPositionData{}, err
+	}
+
+	event := &liquidityChangeEvent{
+		eventType:      types.TypeEvtCompoundPosition,
+		positionId:     positionId,
+		sender:         owner,
+		poolId:         position.PoolId,
+		lowerTick:      position.LowerTick,
+		upperTick:      position.UpperTick,
+		joinTime:       position.JoinTime,
+		liquidityDelta: liquidityDelta,
+		actualAmount0:  updateData.Amount0,
+		actualAmount1:  updateData.Amount1,
+	}
+	event.emit(ctx)
+
+	return CompoundPositionData{
+		Amount0:        updateData.Amount0,
+		Amount1:        updateData.Amount1,
+		LiquidityAdded: liquidityDelta,
+	}, nil
+}
+
+// setPositionAutoCompound opts the position with the given id into or out of auto-compounding.
+// Opted in positions are first compounded types.AutoCompoundInterval after opting in, and every
+// types.AutoCompoundInterval thereafter. Setting the current value again is a no-op.
+//
+// Returns error if:
+// - the position does not exist or is not owned by owner
+// - the position is superfluid staked and is being opted in
+func (k Keeper) setPositionAutoCompound(ctx sdk.Context, owner sdk.AccAddress, positionId uint64, enabled bool) error {
+	position, err := k.GetPosition(ctx, positionId)
+	if err != nil {
+		return err
+	}
+
+	if owner.String() != position.Address {
+		return types.NotPositionOwnerError{PositionId: positionId, Address: owner.String()}
+	}
+
+	_, isAutoCompounded, err := k.getAutoCompoundPosition(ctx, positionId)
+	if err != nil {
+		return err
+	}
+	if isAutoCompounded == enabled {
+		return nil
+	}
+
+	if enabled {
+		positionHasUnderlyingLock, _, err := k.positionHasActiveUnderlyingLockAndUpdate(ctx, positionId)
+		if err != nil {
+			return err
+		}
+		if positionHasUnderlyingLock {
+			return types.PositionSuperfluidStakedError{PositionId: positionId}
+		}
+
+		k.setAutoCompoundPosition(ctx, types.AutoCompoundPosition{
+			PositionId:       positionId,
+			NextCompoundTime: ctx.BlockTime().Add(types.AutoCompoundInterval),
+		})
+	} else {
+		k.deleteAutoCompoundPosition(ctx, positionId)
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtSetPositionAutoCompound,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, owner.String()),
+		sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(position.PoolId, 10)),
+		sdk.NewAttribute(types.AttributeKeyPositionId, strconv.FormatUint(positionId, 10)),
+		sdk.NewAttribute(types.AttributeKeyAutoCompoundEnabled, strconv.FormatBool(enabled)),
+	))
+
+	return nil
+}
+
+// CompoundDuePositions compounds the auto-compounded positions whose next compound time has been
+// reached, oldest first and up to types.MaxAutoCompoundsPerBlock per block. Each position is
+// compounded in its own cache context, so that a failure only skips that position. Every
+// processed position is rescheduled types.AutoCompoundInterval from now, whether it was compounded
+// or not.
+func (k Keeper) CompoundDuePositions(ctx sdk.Context) {
+	duePositionIds, err := k.getDueAutoCompoundPositionIds(ctx, ctx.BlockTime(), types.MaxAutoCompoundsPerBlock)
+	if err != nil {
+		ctx.Logger().Error("failed to load positions due for auto-compounding", "error", err)
+		return
+	}
+
+	for _, positionId := range duePositionIds {
+		position, err := k.GetPosition(ctx, positionId)
+		if err != nil {
+			ctx.Logger().Error("failed to load auto-compounded position", "position_id", positionId, "error", err)
+			k.deleteAutoCompoundPosition(ctx, positionId)
+			continue
+		}
+
+		// Nothing to compound is the common case for small positions, so failures are only logged to debug.
+		_ = osmoutils.ApplyFuncIfNoErrorLogToDebug(ctx, func(cacheCtx sdk.Context) error {
+			_, err := k.compoundPosition(cacheCtx, sdk.MustAccAddressFromBech32(position.Address), positionId)
+			return err
+		})
+
+		k.setAutoCompoundPosition(ctx, types.AutoCompoundPosition{
+			PositionId:       positionId,
+			NextCompoundTime: ctx.BlockTime().Add(types.AutoCompoundInterval),
+		})
+	}
+}
+
+// getAutoCompoundPosition returns the auto-compound record of the position with the given id and
+// whether the position is opted into auto-compounding.
+func (k Keeper) getAutoCompoundPosition(ctx sdk.Context, positionId uint64) (types.AutoCompoundPosition, bool, error) {
+	autoCompoundPosition := types.AutoCompoundPosition{}
+	found, err := osmoutils.Get(ctx.KVStore(k.storeKey), types.KeyAutoCompoundPosition(positionId), &autoCompoundPosition)
+	if err != nil {
+		return types.AutoCompoundPosition{}, false, err
+	}
+	return autoCompoundPosition, found, nil
+}
+
+// getAllAutoCompoundPositions returns all auto-compound records in state, ordered by position id.
+func (k Keeper) getAllAutoCompoundPositions(ctx sdk.Context) ([]types.AutoCompoundPosition, error) {
+	return osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(k.storeKey), types.AutoCompoundPositionPrefix, ParseAutoCompoundPositionFromBz)
+}
+
+// setAutoCompoundPosition stores the auto-compound record of a position together with its entry in
+// the queue ordered by next compound time, replacing any previous entry.
+func (k Keeper) setAutoCompoundPosition(ctx sdk.Context, autoCompoundPosition types.AutoCompoundPosition) {
+	k.deleteAutoCompoundPosition(ctx, autoCompoundPosition.PositionId)
+
+	store := ctx.KVStore(k.storeKey)
+	osmoutils.MustSet(store, types.KeyAutoCompoundPosition(autoCompoundPosition.PositionId), &autoCompoundPosition)
+	store.Set(types.KeyAutoCompoundQueue(autoCompoundPosition.NextCompoundTime, autoCompoundPosition.PositionId), []byte{1})
+}
+
+// deleteAutoCompoundPosition removes the auto-compound record of the position with the given id
+// and its queue entry from state, if the position is opted into auto-compounding.
+func (k Keeper) deleteAutoCompoundPosition(ctx sdk.Context, positionId uint64) {
+	autoCompoundPosition, found, err := k.getAutoCompoundPosition(ctx, positionId)
+	if err != nil || !found {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyAutoCompoundPosition(positionId))
+	store.Delete(types.KeyAutoCompoundQueue(autoCompoundPosition.NextCompoundTime, positionId))
+}
+
+// getDueAutoCompoundPositionIds returns the ids of up to limit positions whose next compound time
+// is at or before the given time, ordered by next compound time.
+func (k Keeper) getDueAutoCompoundPositionIds(ctx sdk.Context, blockTime time.Time, limit int) ([]uint64, error) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.AutoCompoundQueuePrefix)
+	defer iterator.Close()
+
+	positionIds := []uint64{}
+	for ; iterator.Valid() && len(positionIds) < limit; iterator.Next() {
+		key := iterator.Key()
+		timeBz := key[len(types.AutoCompoundQueuePrefix) : len(key)-types.Uint64ByteSize]
+		nextCompoundTime, err := sdk.ParseTimeBytes(timeBz)
+		if err != nil {
+			return nil, err
+		}
+		if nextCompoundTime.After(blockTime) {
+			break
+		}
+		positionIds = append(positionIds, sdk.BigEndianToUint64(key[len(key)-types.Uint64ByteSize:]))
+	}
+
+	return positionIds, nil
+}
@@ -0,0 +1,249 @@
+package concentrated_liquidity_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v24/x/concentrated-liquidity/types"
+)
+
+// accrueSpreadRewards grows the spread reward accumulator of the pool by the given amounts per unit
+// of liquidity and funds the pool's spread rewards address so that the rewards can be claimed.
+func (s *KeeperTestSuite) accrueSpreadRewards(pool types.ConcentratedPoolExtension, growth sdk.DecCoins) {
+	s.FundAcc(pool.GetSpreadRewardsAddress(), sdk.NewCoins(sdk.NewCoin(ETH, osmomath.NewInt(1_000_000_000_000)), sdk.NewCoin(USDC, osmomath.NewInt(1_000_000_000_000))))
+	for _, growthPerLiquidity := range growth {
+		s.AddToSpreadRewardAccumulator(pool.GetId(), growthPerLiquidity)
+	}
+}
+
+func (s *KeeperTestSuite) TestCompoundPosition() {
+	defaultGrowth := sdk.NewDecCoins(sdk.NewDecCoinFromDec(ETH, osmomath.MustNewDecFromStr("0.0001")), sdk.NewDecCoinFromDec(USDC, osmomath.MustNewDecFromStr("0.5")))
+
+	tests := map[string]struct {
+		growth           sdk.DecCoins
+		senderIsNotOwner bool
+		movePrice        bool
+		expectedErr      error
+	}{
+		"spread rewards in both tokens are compounded": {
+			growth: defaultGrowth,
+		},
+		"spread rewards in one token are swapped and compounded": {
+			growth: sdk.NewDecCoins(sdk.NewDecCoinFromDec(USDC, osmomath.MustNewDecFromStr("0.5"))),
+		},
+		"swap at a price far from the TWAP is rejected": {
+			growth:    sdk.NewDecCoins(sdk.NewDecCoinFromDec(USDC, osmomath.MustNewDecFromStr("0.5"))),
+			movePrice: true,
+		},
+		"no rewards to compound": {
+			expectedErr: types.NothingToCompoundError{PositionId: 1},
+		},
+		"sender is not the owner": {
+			growth:           defaultGrowth,
+			senderIsNotOwner: true,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			clk := s.App.ConcentratedLiquidityKeeper
+			pool := s.PrepareConcentratedPool()
+			s.SetupDefaultPosition(pool.GetId())
+			owner := s.TestAccs[0]
+			positionBefore, err := clk.GetPosition(s.Ctx, 1)
+			s.Require().NoError(err)
+
+			// Record the initial price of the pool in the TWAP, and let a full TWAP window pass.
+			s.AddBlockTime(time.Hour)
+			s.App.TwapKeeper.EndBlock(s.Ctx)
+			s.AddBlockTime(types.TwapMinAmountOutWindow)
+
+			if !tc.growth.Empty() {
+				s.accrueSpreadRewards(pool, tc.growth)
+			}
+			if tc.movePrice {
+				s.swapExactAmountIn(pool.GetId(), sdk.NewCoin(USDC, osmomath.NewInt(3_000_000_000)), ETH)
+			}
+
+			sender := owner
+			if tc.senderIsNotOwner {
+				sender = s.TestAccs[1]
+				tc.expectedErr = types.NotPositionOwnerError{PositionId: positionBefore.PositionId, Address: sender.String()}
+			}
+
+			compoundData, err := clk.CompoundPosition(s.Ctx, sender, positionBefore.PositionId)
+			if tc.movePrice {
+				s.Require().ErrorAs(err, &types.AmountLessThanMinError{})
+				return
+			}
+			if tc.expectedErr != nil {
+				s.Require().ErrorIs(err, tc.expectedErr)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().True(compoundData.LiquidityAdded.IsPositive())
+			s.AssertEventEmitted(s.Ctx, types.TypeEvtCompoundPosition, 1)
+
+			// The liquidity is added to the same position, which keeps its id, range and join time.
+			positionAfter, err := clk.GetPosition(s.Ctx, positionBefore.PositionId)
+			s.Require().NoError(err)
+			s.Require().Equal(positionBefore.Liquidity.Add(compoundData.LiquidityAdded), positionAfter.Liquidity)
+			s.Require().Equal(positionBefore.LowerTick, positionAfter.LowerTick)
+			s.Require().Equal(positionBefore.UpperTick, positionAfter.UpperTick)
+			s.Require().Equal(positionBefore.JoinTime.UTC(), positionAfter.JoinTime.UTC())
+			s.Require().Equal(uint64(2), clk.GetNextPositionId(s.Ctx))
+
+			// Both tokens are added, since the position is in range.
+			s.Require().True(compoundData.Amount0.IsPositive())
+			s.Require().True(compoundData.Amount1.IsPositive())
+
+			// The claimed rewards are consumed by the position and no new rewards are left to claim.
+			claimable, err := clk.GetClaimableSpreadRewards(s.Ctx, positionBefore.PositionId)
+			s.Require().NoError(err)
+			s.Require().True(claimable.IsZero())
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestSetPositionAutoCompound() {
+	s.SetupTest()
+	clk := s.App.ConcentratedLiquidityKeeper
+	pool := s.PrepareConcentratedPool()
+	s.SetupDefaultPosition(pool.GetId())
+	owner := s.TestAccs[0]
+
+	// Only the owner can opt a position in.
+	err := clk.SetPositionAutoCompound(s.Ctx, s.TestAccs[1], 1, true)
+	s.Require().ErrorIs(err, types.NotPositionOwnerError{PositionId: 1, Address: s.TestAccs[1].String()})
+
+	s.Require().NoError(clk.SetPositionAutoCompound(s.Ctx, owner, 1, true))
+	s.AssertEventEmitted(s.Ctx, types.TypeEvtSetPositionAutoCompound, 1)
+	autoCompoundPosition, found, err := clk.GetAutoCompoundPosition(s.Ctx, 1)
+	s.Require().NoError(err)
+	s.Require().True(found)
+	s.Require().Equal(s.Ctx.BlockTime().Add(types.AutoCompoundInterval).UTC(), autoCompoundPosition.NextCompoundTime.UTC())
+
+	// Opting in again does not reschedule the position.
+	s.AddBlockTime(types.AutoCompoundInterval / 2)
+	s.Require().NoError(clk.SetPositionAutoCompound(s.Ctx, owner, 1, true))
+	unchanged, _, err := clk.GetAutoCompoundPosition(s.Ctx, 1)
+	s.Require().NoError(err)
+	s.Require().Equal(autoCompoundPosition.String(), unchanged.String())
+
+	s.Require().NoError(clk.SetPositionAutoCompound(s.Ctx, owner, 1, false))
+	_, found, err = clk.GetAutoCompoundPosition(s.Ctx, 1)
+	s.Require().NoError(err)
+	s.Require().False(found)
+}
+
+func (s *KeeperTestSuite) TestCompoundDuePositions() {
+	s.SetupTest()
+	clk := s.App.ConcentratedLiquidityKeeper
+	pool := s.PrepareConcentratedPool()
+	s.SetupDefaultPosition(pool.GetId())
+	owner := s.TestAccs[0]
+
+	// Record the initial price of the pool in the TWAP, and let a full TWAP window pass.
+	s.AddBlockTime(time.Hour)
+	s.App.TwapKeeper.EndBlock(s.Ctx)
+	s.AddBlockTime(types.TwapMinAmountOutWindow)
+
+	s.Require().NoError(clk.SetPositionAutoCompound(s.Ctx, owner, 1, true))
+	s.accrueSpreadRewards(pool, sdk.NewDecCoins(sdk.NewDecCoinFromDec(ETH, osmomath.MustNewDecFromStr("0.0001")), sdk.NewDecCoinFromDec(USDC, osmomath.MustNewDecFromStr("0.5"))))
+	positionBefore, err := clk.GetPosition(s.Ctx, 1)
+	s.Require().NoError(err)
+
+	// The position is not due yet.
+	clk.CompoundDuePositions(s.Ctx)
+	positionAfter, err := clk.GetPosition(s.Ctx, 1)
+	s.Require().NoError(err)
+	s.Require().Equal(positionBefore.Liquidity, positionAfter.Liquidity)
+
+	s.AddBlockTime(types.AutoCompoundInterval)
+	clk.CompoundDuePositions(s.Ctx)
+	positionAfter, err = clk.GetPosition(s.Ctx, 1)
+	s.Require().NoError(err)
+	s.Require().True(positionAfter.Liquidity.GT(positionBefore.Liquidity))
+
+	// The position is rescheduled.
+	autoCompoundPosition, found, err := clk.GetAutoCompoundPosition(s.Ctx, 1)
+	s.Require().NoError(err)
+	s.Require().True(found)
+	s.Require().Equal(s.Ctx.BlockTime().Add(types.AutoCompoundInterval).UTC(), autoCompoundPosition.NextCompoundTime.UTC())
+
+	// A due position with nothing to compound is rescheduled as well.
+	s.AddBlockTime(types.AutoCompoundInterval)
+	clk.CompoundDuePositions(s.Ctx)
+	autoCompoundPosition, found, err = clk.GetAutoCompoundPosition(s.Ctx, 1)
+	s.Require().NoError(err)
+	s.Require().True(found)
+	s.Require().Equal(s.Ctx.BlockTime().Add(types.AutoCompoundInterval).UTC(), autoCompoundPosition.NextCompoundTime.UTC())
+}
+
+func (s *KeeperTestSuite) TestAutoCompoundFollowsPosition() {
+	s.SetupTest()
+	clk := s.App.ConcentratedLiquidityKeeper
+	pool := s.PrepareConcentratedPool()
+	s.SetupDefaultPosition(pool.GetId())
+	s.SetupDefaultPositionAcc(pool.GetId(), s.TestAccs[1])
+	owner := s.TestAccs[0]
+
+	s.Require().NoError(clk.SetPositionAutoCompound(s.Ctx, owner, 1, true))
+	autoCompoundPosition, _, err := clk.GetAutoCompoundPosition(s.Ctx, 1)
+	s.Require().NoError(err)
+
+	// Adding to the position carries the record over to the new position id.
+	s.FundAcc(owner, sdk.NewCoins(sdk.NewCoin(ETH, osmomath.NewInt(1_000)), sdk.NewCoin(USDC, osmomath.NewInt(5_000_000))))
+	newPositionId, _, _, err := clk.AddToPosition(s.Ctx, owner, 1, osmomath.NewInt(1_000), osmomath.NewInt(5_000_000), osmomath.ZeroInt(), osmomath.ZeroInt())
+	s.Require().NoError(err)
+	_, found, err := clk.GetAutoCompoundPosition(s.Ctx, 1)
+	s.Require().NoError(err)
+	s.Require().False(found)
+	carriedOver, found, err := clk.GetAutoCompoundPosition(s.Ctx, newPositionId)
+	s.Require().NoError(err)
+	s.Require().True(found)
+	s.Require().Equal(autoCompoundPosition.NextCompoundTime.UTC(), carriedOver.NextCompoundTime.UTC())
+
+	// Withdrawing the position drops the record.
+	position, err := clk.GetPosition(s.Ctx, newPositionId)
+	s.Require().NoError(err)
+	_, _, err = clk.WithdrawPosition(s.Ctx, owner, newPositionId, position.Liquidity)
+	s.Require().NoError(err)
+	_, found, err = clk.GetAutoCompoundPosition(s.Ctx, newPositionId)
+	s.Require().NoError(err)
+	s.Require().False(found)
+}
+
+func (s *KeeperTestSuite) TestAutoCompoundGenesisRoundTrip() {
+	s.SetupTest()
+	clk := s.App.ConcentratedLiquidityKeeper
+	pool := s.PrepareConcentratedPool()
+	s.SetupDefaultPosition(pool.GetId())
+	s.Require().NoError(clk.SetPositionAutoCompound(s.Ctx, s.TestAccs[0], 1, true))
+	autoCompoundPosition, _, err := clk.GetAutoCompoundPosition(s.Ctx, 1)
+	s.Require().NoError(err)
+
+	exported := clk.ExportGenesis(s.Ctx)
+	s.Require().Len(exported.AutoCompoundPositions, 1)
+	s.Require().NoError(exported.Validate())
+
+	s.SetupTest()
+	clk = s.App.ConcentratedLiquidityKeeper
+	clk.InitGenesis(s.Ctx, *exported)
+
+	imported, found, err := clk.GetAutoCompoundPosition(s.Ctx, 1)
+	s.Require().NoError(err)
+	s.Require().True(found)
+	s.Require().Equal(autoCompoundPosition.String(), imported.String())
+
+	// The imported position is compounded once due.
+	s.Ctx = s.Ctx.WithBlockTime(autoCompoundPosition.NextCompoundTime)
+	clk.CompoundDuePositions(s.Ctx)
+	rescheduled, found, err := clk.GetAutoCompoundPosition(s.Ctx, 1)
+	s.Require().NoError(err)
+	s.Require().True(found)
+	s.Require().True(rescheduled.NextCompoundTime.After(autoCompoundPosition.NextCompoundTime))
+}
@@ -2,12 +2,19 @@ package cli
 
 import (
 	flag "github.com/spf13/pflag"
+
+	"github.com/osmosis-labs/osmosis/v24/x/concentrated-liquidity/types"
 )
 
 const (
 	FlagPoolId                     = "pool-id"
 	FlagPoolIdToTickSpacingRecords = "pool-tick-spacing-records"
 	FlagPoolRecords                = "pool-records"
+	FlagHalfWidth                  = "half-width"
+	FlagOutOfRangeDelay            = "out-of-range-delay"
+
+	FlagPoolIdToDynamicSpreadFactorRecords = "pool-dynamic-spread-factor-records"
+	FlagDisableDynamicSpreadFactorPoolIds  = "disable-dynamic-spread-factor-pool-ids"
 )
 
 func FlagSetJustPoolId() *flag.FlagSet {
@@ -15,3 +22,10 @@ func FlagSetJustPoolId() *flag.FlagSet {
 	fs.Uint64(FlagPoolId, 0, "The id of pool")
 	return fs
 }
+
+func FlagSetManagedPositionStrategy() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.Int64(FlagHalfWidth, 0, "The number of ticks kept on each side of the current tick, a multiple of the pool's tick spacing")
+	fs.Duration(FlagOutOfRangeDelay, types.MinManagedPositionOutOfRangeDelay, "How long the position must be out of range before it is re-centered")
+	return fs
+}
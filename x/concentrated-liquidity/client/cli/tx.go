@@ -4,10 +4,12 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client/tx"
 
 	"github.com/spf13/cobra"
+	flag "github.com/spf13/pflag"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
@@ -31,6 +33,13 @@ func NewTxCmd() *cobra.Command {
 	osmocli.AddTxCmd(txCmd, NewCollectIncentivesCmd)
 	osmocli.AddTxCmd(txCmd, NewFungifyChargedPositionsCmd)
 	osmocli.AddTxCmd(txCmd, NewTransferPositionsCmd)
+	osmocli.AddTxCmd(txCmd, NewPlaceLimitOrderCmd)
+	osmocli.AddTxCmd(txCmd, NewCancelLimitOrderCmd)
+	osmocli.AddTxCmd(txCmd, NewClaimLimitOrderCmd)
+	osmocli.AddTxCmd(txCmd, NewCreateManagedPositionCmd)
+	osmocli.AddTxCmd(txCmd, NewWithdrawManagedPositionCmd)
+	osmocli.AddTxCmd(txCmd, NewCompoundPositionCmd)
+	osmocli.AddTxCmd(txCmd, NewSetPositionAutoCompoundCmd)
 	return txCmd
 }
 
@@ -103,6 +112,80 @@ func NewTransferPositionsCmd() (*osmocli.TxCliDesc, *types.MsgTransferPositions)
 	}, &types.MsgTransferPositions{}
 }
 
+func NewPlaceLimitOrderCmd() (*osmocli.TxCliDesc, *types.MsgPlaceLimitOrder) {
+	return &osmocli.TxCliDesc{
+		Use:     "place-limit-order",
+		Short:   "place a limit order spanning one tick spacing from the given tick. token0 places an ask above the current tick, token1 a bid below it",
+		Example: "osmosisd tx concentratedliquidity place-limit-order 1 \"[-69000]\" 10000uosmo --from val --chain-id osmosis-1 -b block --keyring-backend test --fees 1000uosmo",
+	}, &types.MsgPlaceLimitOrder{}
+}
+
+func NewCancelLimitOrderCmd() (*osmocli.TxCliDesc, *types.MsgCancelLimitOrder) {
+	return &osmocli.TxCliDesc{
+		Use:     "cancel-limit-order",
+		Short:   "cancel an open limit order, returning its unfilled and partially filled amounts",
+		Example: "osmosisd tx concentratedliquidity cancel-limit-order 1 --from val --chain-id osmosis-1 -b block --keyring-backend test --fees 1000uosmo",
+	}, &types.MsgCancelLimitOrder{}
+}
+
+func NewClaimLimitOrderCmd() (*osmocli.TxCliDesc, *types.MsgClaimLimitOrder) {
+	return &osmocli.TxCliDesc{
+		Use:     "claim-limit-order",
+		Short:   "claim the proceeds of a filled limit order",
+		Example: "osmosisd tx concentratedliquidity claim-limit-order 1 --from val --chain-id osmosis-1 -b block --keyring-backend test --fees 1000uosmo",
+	}, &types.MsgClaimLimitOrder{}
+}
+
+func NewCreateManagedPositionCmd() (*osmocli.TxCliDesc, *types.MsgCreateManagedPosition) {
+	return &osmocli.TxCliDesc{
+		Use:     "create-managed-position",
+		Short:   "create a position around the current tick that is re-centered once out of range for the given delay",
+		Example: "osmosisd tx concentratedliquidity create-managed-position 1 10000uosmo,10000uion --half-width 1000 --out-of-range-delay 1h --from val --chain-id osmosis-1 -b block --keyring-backend test --fees 1000uosmo",
+		CustomFieldParsers: map[string]osmocli.CustomFieldParserFn{
+			"Strategy": osmocli.FlagOnlyParser(parseManagedPositionStrategy),
+		},
+		Flags: osmocli.FlagDesc{OptionalFlags: []*flag.FlagSet{FlagSetManagedPositionStrategy()}},
+	}, &types.MsgCreateManagedPosition{}
+}
+
+func NewWithdrawManagedPositionCmd() (*osmocli.TxCliDesc, *types.MsgWithdrawManagedPosition) {
+	return &osmocli.TxCliDesc{
+		Use:     "withdraw-managed-position",
+		Short:   "withdraw a managed position in full and stop managing it",
+		Example: "osmosisd tx concentratedliquidity withdraw-managed-position 1 --from val --chain-id osmosis-1 -b block --keyring-backend test --fees 1000uosmo",
+	}, &types.MsgWithdrawManagedPosition{}
+}
+
+func NewCompoundPositionCmd() (*osmocli.TxCliDesc, *types.MsgCompoundPosition) {
+	return &osmocli.TxCliDesc{
+		Use:     "compound-position",
+		Short:   "add the spread rewards and incentives of a position back to it, keeping its position id",
+		Example: "osmosisd tx concentratedliquidity compound-position 1 --from val --chain-id osmosis-1 -b block --keyring-backend test --fees 1000uosmo",
+	}, &types.MsgCompoundPosition{}
+}
+
+func NewSetPositionAutoCompoundCmd() (*osmocli.TxCliDesc, *types.MsgSetPositionAutoCompound) {
+	return &osmocli.TxCliDesc{
+		Use:     "set-position-auto-compound",
+		Short:   "opt a position into or out of being compounded periodically by the module",
+		Example: "osmosisd tx concentratedliquidity set-position-auto-compound 1 true --from val --chain-id osmosis-1 -b block --keyring-backend test --fees 1000uosmo",
+	}, &types.MsgSetPositionAutoCompound{}
+}
+
+func parseManagedPositionStrategy(fs *flag.FlagSet) (types.ManagedPositionStrategy, error) {
+	halfWidth, err := fs.GetInt64(FlagHalfWidth)
+	if err != nil {
+		return types.ManagedPositionStrategy{}, err
+	}
+
+	outOfRangeDelay, err := fs.GetDuration(FlagOutOfRangeDelay)
+	if err != nil {
+		return types.ManagedPositionStrategy{}, err
+	}
+
+	return types.ManagedPositionStrategy{HalfWidth: halfWidth, OutOfRangeDelay: outOfRangeDelay}, nil
+}
+
 // NewCmdCreateConcentratedLiquidityPoolsProposal implements a command handler for create concentrated liquidity pool proposal
 func NewCmdCreateConcentratedLiquidityPoolsProposal() *cobra.Command {
 	cmd := &cobra.Command{
@@ -190,6 +273,53 @@ Note: The new tick spacing value must be less than the current tick spacing valu
 	return cmd
 }
 
+func NewSetDynamicSpreadFactorProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-dynamic-spread-factor-proposal [flags]",
+		Args:  cobra.ExactArgs(0),
+		Short: "Submit a set dynamic spread factor proposal",
+		Long: strings.TrimSpace(`Submit a set dynamic spread factor proposal.
+
+Passing in FlagPoolIdToDynamicSpreadFactorRecords separated by commas would be parsed automatically to groups of
+poolId, minSpreadFactor, maxSpreadFactor, volatilityWindow and volatilityMultiplier.
+Ex) --pool-dynamic-spread-factor-records=1,0.001,0.01,1h,0.5 -> [(poolId 1, min 0.001, max 0.01, window 1h, multiplier 0.5)]
+Passing in FlagDisableDynamicSpreadFactorPoolIds disables the dynamic spread factor of the given pools.
+Ex) --disable-dynamic-spread-factor-pool-ids=2,3
+
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, proposalTitle, summary, deposit, isExpedited, authority, err := osmocli.GetProposalInfo(cmd)
+			if err != nil {
+				return err
+			}
+
+			content, err := parseSetDynamicSpreadFactorArgsToContent(cmd)
+			if err != nil {
+				return err
+			}
+
+			contentMsg, err := v1.NewLegacyContent(content, authority.String())
+			if err != nil {
+				return err
+			}
+
+			msg := v1.NewMsgExecLegacyContent(contentMsg.Content, authority.String())
+
+			proposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{msg}, deposit, clientCtx.GetFromAddress().String(), "", proposalTitle, summary, isExpedited)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposalMsg)
+		},
+	}
+	osmocli.AddCommonProposalFlags(cmd)
+	cmd.Flags().String(FlagPoolIdToDynamicSpreadFactorRecords, "", "The pool ID to dynamic spread factor config records array")
+	cmd.Flags().String(FlagDisableDynamicSpreadFactorPoolIds, "", "The IDs of the pools to disable the dynamic spread factor for")
+
+	return cmd
+}
+
 func parseCreateConcentratedLiquidityPoolArgsToContent(cmd *cobra.Command) (govtypesv1beta1.Content, error) {
 	title, err := cmd.Flags().GetString(govcli.FlagTitle)
 	if err != nil {
@@ -275,6 +405,94 @@ func parsePoolIdToTickSpacingRecords(cmd *cobra.Command) ([]types.PoolIdToTickSp
 	return poolIdToTickSpacingRecords, nil
 }
 
+func parseSetDynamicSpreadFactorArgsToContent(cmd *cobra.Command) (govtypesv1beta1.Content, error) {
+	title, err := cmd.Flags().GetString(govcli.FlagTitle)
+	if err != nil {
+		return nil, err
+	}
+
+	description, err := cmd.Flags().GetString(govcli.FlagSummary)
+	if err != nil {
+		return nil, err
+	}
+
+	records, err := parsePoolIdToDynamicSpreadFactorRecords(cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	content := &types.SetDynamicSpreadFactorProposal{
+		Title:                              title,
+		Description:                        description,
+		PoolIdToDynamicSpreadFactorRecords: records,
+	}
+	return content, nil
+}
+
+func parsePoolIdToDynamicSpreadFactorRecords(cmd *cobra.Command) ([]types.PoolIdToDynamicSpreadFactorRecord, error) {
+	recordsStr, err := cmd.Flags().GetString(FlagPoolIdToDynamicSpreadFactorRecords)
+	if err != nil {
+		return nil, err
+	}
+
+	records := []types.PoolIdToDynamicSpreadFactorRecord{}
+	if recordsStr != "" {
+		fields := strings.Split(recordsStr, ",")
+		if len(fields)%5 != 0 {
+			return nil, fmt.Errorf("poolIdToDynamicSpreadFactorRecords must be a list of groups of poolId, minSpreadFactor, maxSpreadFactor, volatilityWindow and volatilityMultiplier")
+		}
+
+		for i := 0; i < len(fields); i += 5 {
+			poolId, err := strconv.ParseUint(fields[i], 10, 64)
+			if err != nil {
+				return nil, err
+			}
+			minSpreadFactor, err := osmomath.NewDecFromStr(fields[i+1])
+			if err != nil {
+				return nil, err
+			}
+			maxSpreadFactor, err := osmomath.NewDecFromStr(fields[i+2])
+			if err != nil {
+				return nil, err
+			}
+			volatilityWindow, err := time.ParseDuration(fields[i+3])
+			if err != nil {
+				return nil, err
+			}
+			volatilityMultiplier, err := osmomath.NewDecFromStr(fields[i+4])
+			if err != nil {
+				return nil, err
+			}
+
+			records = append(records, types.PoolIdToDynamicSpreadFactorRecord{
+				PoolId: poolId,
+				Config: &types.DynamicSpreadFactorConfig{
+					MinSpreadFactor:      minSpreadFactor,
+					MaxSpreadFactor:      maxSpreadFactor,
+					VolatilityWindow:     volatilityWindow,
+					VolatilityMultiplier: volatilityMultiplier,
+				},
+			})
+		}
+	}
+
+	disabledPoolIdsStr, err := cmd.Flags().GetString(FlagDisableDynamicSpreadFactorPoolIds)
+	if err != nil {
+		return nil, err
+	}
+	if disabledPoolIdsStr != "" {
+		for _, poolIdStr := range strings.Split(disabledPoolIdsStr, ",") {
+			poolId, err := strconv.ParseUint(poolIdStr, 10, 64)
+			if err != nil {
+				return nil, err
+			}
+			records = append(records, types.PoolIdToDynamicSpreadFactorRecord{PoolId: poolId})
+		}
+	}
+
+	return records, nil
+}
+
 func parsePoolRecords(cmd *cobra.Command) ([]types.PoolRecord, error) {
 	poolRecordsStr, err := cmd.Flags().GetString(FlagPoolRecords)
 	if err != nil {
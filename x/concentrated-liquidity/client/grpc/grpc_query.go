@@ -41,6 +41,26 @@ func (q Querier) UserPositions(grpcCtx context.Context,
 	return q.Q.UserPositions(ctx, *req)
 }
 
+func (q Querier) UserManagedPositions(grpcCtx context.Context,
+	req *queryproto.UserManagedPositionsRequest,
+) (*queryproto.UserManagedPositionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.UserManagedPositions(ctx, *req)
+}
+
+func (q Querier) UserLimitOrders(grpcCtx context.Context,
+	req *queryproto.UserLimitOrdersRequest,
+) (*queryproto.UserLimitOrdersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.UserLimitOrders(ctx, *req)
+}
+
 func (q Querier) TickAccumulatorTrackers(grpcCtx context.Context,
 	req *queryproto.TickAccumulatorTrackersRequest,
 ) (*queryproto.TickAccumulatorTrackersResponse, error) {
@@ -51,6 +71,16 @@ func (q Querier) TickAccumulatorTrackers(grpcCtx context.Context,
 	return q.Q.TickAccumulatorTrackers(ctx, *req)
 }
 
+func (q Querier) PositionHistory(grpcCtx context.Context,
+	req *queryproto.PositionHistoryRequest,
+) (*queryproto.PositionHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.PositionHistory(ctx, *req)
+}
+
 func (q Querier) PositionById(grpcCtx context.Context,
 	req *queryproto.PositionByIdRequest,
 ) (*queryproto.PositionByIdResponse, error) {
@@ -131,6 +161,16 @@ func (q Querier) LiquidityNetInDirection(grpcCtx context.Context,
 	return q.Q.LiquidityNetInDirection(ctx, *req)
 }
 
+func (q Querier) LimitOrderById(grpcCtx context.Context,
+	req *queryproto.LimitOrderByIdRequest,
+) (*queryproto.LimitOrderByIdResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.LimitOrderById(ctx, *req)
+}
+
 func (q Querier) IncentiveRecords(grpcCtx context.Context,
 	req *queryproto.IncentiveRecordsRequest,
 ) (*queryproto.IncentiveRecordsResponse, error) {
@@ -151,6 +191,16 @@ func (q Querier) GetTotalLiquidity(grpcCtx context.Context,
 	return q.Q.GetTotalLiquidity(ctx, *req)
 }
 
+func (q Querier) EffectiveSpreadFactor(grpcCtx context.Context,
+	req *queryproto.EffectiveSpreadFactorRequest,
+) (*queryproto.EffectiveSpreadFactorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.EffectiveSpreadFactor(ctx, *req)
+}
+
 func (q Querier) ClaimableSpreadRewards(grpcCtx context.Context,
 	req *queryproto.ClaimableSpreadRewardsRequest,
 ) (*queryproto.ClaimableSpreadRewardsResponse, error) {
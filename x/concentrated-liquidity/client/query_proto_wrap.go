@@ -335,3 +335,103 @@ func (q Querier) NumPoolPositions(ctx sdk.Context, req clquery.NumPoolPositionsR
 		PositionCount: uint64(len(positionIDs)),
 	}, nil
 }
+
+// LimitOrderById returns the limit order with the specified id, together with the amounts
+// of asset0 and asset1 its backing position currently holds.
+func (q Querier) LimitOrderById(ctx sdk.Context, req clquery.LimitOrderByIdRequest) (*clquery.LimitOrderByIdResponse, error) {
+	order, err := q.Keeper.GetLimitOrder(ctx, req.OrderId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	breakdown, err := q.Keeper.GetLimitOrderBreakdown(ctx, order)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &clquery.LimitOrderByIdResponse{Order: breakdown}, nil
+}
+
+// UserLimitOrders returns the limit orders placed by the specified address, optionally
+// filtered by pool id.
+func (q Querier) UserLimitOrders(ctx sdk.Context, req clquery.UserLimitOrdersRequest) (*clquery.UserLimitOrdersResponse, error) {
+	sdkAddr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	orders, pageRes, err := q.Keeper.GetUserLimitOrdersSerialized(ctx, sdkAddr, req.PoolId, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &clquery.UserLimitOrdersResponse{
+		Orders:     orders,
+		Pagination: pageRes,
+	}, nil
+}
+
+// UserManagedPositions returns the managed positions of the specified address, optionally
+// filtered by pool id.
+func (q Querier) UserManagedPositions(ctx sdk.Context, req clquery.UserManagedPositionsRequest) (*clquery.UserManagedPositionsResponse, error) {
+	sdkAddr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	managedPositions, pageRes, err := q.Keeper.GetUserManagedPositionsSerialized(ctx, sdkAddr, req.PoolId, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &clquery.UserManagedPositionsResponse{
+		ManagedPositions: managedPositions,
+		Pagination:       pageRes,
+	}, nil
+}
+
+// EffectiveSpreadFactor returns the spread factor charged by swaps in the specified pool at the current
+// block, together with the dynamic spread factor config of the pool, if any.
+func (q Querier) EffectiveSpreadFactor(ctx sdk.Context, req clquery.EffectiveSpreadFactorRequest) (*clquery.EffectiveSpreadFactorResponse, error) {
+	pool, err := q.Keeper.GetConcentratedPoolById(ctx, req.PoolId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	effectiveSpreadFactor, err := q.Keeper.GetEffectiveSpreadFactor(ctx, pool)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	response := &clquery.EffectiveSpreadFactorResponse{EffectiveSpreadFactor: effectiveSpreadFactor}
+	config, found, err := q.Keeper.GetDynamicSpreadFactorConfig(ctx, req.PoolId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if found {
+		response.DynamicSpreadFactorConfig = &config
+	}
+	return response, nil
+}
+
+// PositionHistory returns the checkpoints of the specified position together with its current value,
+// the spread rewards and incentives accrued since its creation, its impermanent loss and its spread
+// reward APR.
+func (q Querier) PositionHistory(ctx sdk.Context, req clquery.PositionHistoryRequest) (*clquery.PositionHistoryResponse, error) {
+	history, err := q.Keeper.GetPositionHistory(ctx, req.PositionId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &clquery.PositionHistoryResponse{
+		Checkpoints:        history.Checkpoints,
+		Asset0:             history.Asset0,
+		Asset1:             history.Asset1,
+		TotalSpreadRewards: history.TotalSpreadRewards,
+		TotalIncentives:    history.TotalIncentives,
+		PositionValue:      history.PositionValue,
+		HoldValue:          history.HoldValue,
+		ImpermanentLoss:    history.ImpermanentLoss,
+		SpreadRewardApr:    history.SpreadRewardApr,
+	}, nil
+}
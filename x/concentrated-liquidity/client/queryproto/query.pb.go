@@ -1717,1530 +1717,1631 @@ func (m *NumNextInitializedTicksResponse) GetCurrentTick() int64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*UserPositionsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.UserPositionsRequest")
-	proto.RegisterType((*UserPositionsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.UserPositionsResponse")
-	proto.RegisterType((*PositionByIdRequest)(nil), "osmosis.concentratedliquidity.v1beta1.PositionByIdRequest")
-	proto.RegisterType((*PositionByIdResponse)(nil), "osmosis.concentratedliquidity.v1beta1.PositionByIdResponse")
-	proto.RegisterType((*NumPoolPositionsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.NumPoolPositionsRequest")
-	proto.RegisterType((*NumPoolPositionsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.NumPoolPositionsResponse")
-	proto.RegisterType((*PoolsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.PoolsRequest")
-	proto.RegisterType((*PoolsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.PoolsResponse")
-	proto.RegisterType((*ParamsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.ParamsRequest")
-	proto.RegisterType((*ParamsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.ParamsResponse")
-	proto.RegisterType((*TickLiquidityNet)(nil), "osmosis.concentratedliquidity.v1beta1.TickLiquidityNet")
-	proto.RegisterType((*LiquidityDepthWithRange)(nil), "osmosis.concentratedliquidity.v1beta1.LiquidityDepthWithRange")
-	proto.RegisterType((*LiquidityNetInDirectionRequest)(nil), "osmosis.concentratedliquidity.v1beta1.LiquidityNetInDirectionRequest")
-	proto.RegisterType((*LiquidityNetInDirectionResponse)(nil), "osmosis.concentratedliquidity.v1beta1.LiquidityNetInDirectionResponse")
-	proto.RegisterType((*LiquidityPerTickRangeRequest)(nil), "osmosis.concentratedliquidity.v1beta1.LiquidityPerTickRangeRequest")
-	proto.RegisterType((*LiquidityPerTickRangeResponse)(nil), "osmosis.concentratedliquidity.v1beta1.LiquidityPerTickRangeResponse")
-	proto.RegisterType((*ClaimableSpreadRewardsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.ClaimableSpreadRewardsRequest")
-	proto.RegisterType((*ClaimableSpreadRewardsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.ClaimableSpreadRewardsResponse")
-	proto.RegisterType((*ClaimableIncentivesRequest)(nil), "osmosis.concentratedliquidity.v1beta1.ClaimableIncentivesRequest")
-	proto.RegisterType((*ClaimableIncentivesResponse)(nil), "osmosis.concentratedliquidity.v1beta1.ClaimableIncentivesResponse")
-	proto.RegisterType((*PoolAccumulatorRewardsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.PoolAccumulatorRewardsRequest")
-	proto.RegisterType((*PoolAccumulatorRewardsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.PoolAccumulatorRewardsResponse")
-	proto.RegisterType((*TickAccumulatorTrackersRequest)(nil), "osmosis.concentratedliquidity.v1beta1.TickAccumulatorTrackersRequest")
-	proto.RegisterType((*TickAccumulatorTrackersResponse)(nil), "osmosis.concentratedliquidity.v1beta1.TickAccumulatorTrackersResponse")
-	proto.RegisterType((*IncentiveRecordsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.IncentiveRecordsRequest")
-	proto.RegisterType((*IncentiveRecordsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.IncentiveRecordsResponse")
-	proto.RegisterType((*CFMMPoolIdLinkFromConcentratedPoolIdRequest)(nil), "osmosis.concentratedliquidity.v1beta1.CFMMPoolIdLinkFromConcentratedPoolIdRequest")
-	proto.RegisterType((*CFMMPoolIdLinkFromConcentratedPoolIdResponse)(nil), "osmosis.concentratedliquidity.v1beta1.CFMMPoolIdLinkFromConcentratedPoolIdResponse")
-	proto.RegisterType((*UserUnbondingPositionsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.UserUnbondingPositionsRequest")
-	proto.RegisterType((*UserUnbondingPositionsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.UserUnbondingPositionsResponse")
-	proto.RegisterType((*GetTotalLiquidityRequest)(nil), "osmosis.concentratedliquidity.v1beta1.GetTotalLiquidityRequest")
-	proto.RegisterType((*GetTotalLiquidityResponse)(nil), "osmosis.concentratedliquidity.v1beta1.GetTotalLiquidityResponse")
-	proto.RegisterType((*NumNextInitializedTicksRequest)(nil), "osmosis.concentratedliquidity.v1beta1.NumNextInitializedTicksRequest")
-	proto.RegisterType((*NumNextInitializedTicksResponse)(nil), "osmosis.concentratedliquidity.v1beta1.NumNextInitializedTicksResponse")
+// =============================== LimitOrderById
+type LimitOrderByIdRequest struct {
+	OrderId uint64 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty" yaml:"order_id"`
 }
 
-func init() {
-	proto.RegisterFile("osmosis/concentratedliquidity/v1beta1/query.proto", fileDescriptor_5da291368ba4d8e3)
+func (m *LimitOrderByIdRequest) Reset()         { *m = LimitOrderByIdRequest{} }
+func (m *LimitOrderByIdRequest) String() string { return proto.CompactTextString(m) }
+func (*LimitOrderByIdRequest) ProtoMessage()    {}
+func (*LimitOrderByIdRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5da291368ba4d8e3, []int{34}
 }
-
-var fileDescriptor_5da291368ba4d8e3 = []byte{
-	// 2355 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5a, 0x4d, 0x6c, 0x1c, 0x49,
-	0x15, 0x4e, 0x39, 0x3f, 0x9b, 0x79, 0x71, 0x9c, 0xa4, 0xec, 0xd8, 0xce, 0x24, 0x99, 0xc9, 0x16,
-	0x84, 0xb5, 0x48, 0x32, 0x43, 0x12, 0x87, 0x90, 0xbf, 0x4d, 0x3c, 0xf6, 0x3a, 0x1a, 0xad, 0xe3,
-	0x75, 0x3a, 0x31, 0x20, 0x84, 0xe8, 0xed, 0xe9, 0x2e, 0x8f, 0x5b, 0xd3, 0xd3, 0x35, 0xee, 0xae,
-	0x4e, 0x62, 0x96, 0x48, 0xab, 0xdd, 0x23, 0x12, 0x2c, 0xe2, 0x8a, 0x90, 0x10, 0x17, 0xb4, 0xe2,
-	0xc8, 0x05, 0x2e, 0x08, 0x0e, 0x28, 0xe2, 0xb0, 0x5a, 0x09, 0x21, 0xa1, 0x3d, 0xcc, 0x42, 0xc2,
-	0x01, 0x69, 0x01, 0x09, 0x73, 0xe1, 0x88, 0xba, 0xba, 0xfa, 0x67, 0x66, 0x7a, 0xec, 0x9e, 0x71,
-	0x38, 0x71, 0xf2, 0x54, 0xbf, 0x7a, 0xef, 0x7d, 0xef, 0xa7, 0x5e, 0xd7, 0x7b, 0x6d, 0xb8, 0xc8,
-	0xdc, 0x26, 0x73, 0x4d, 0xb7, 0xac, 0x33, 0x5b, 0xa7, 0x36, 0x77, 0x34, 0x4e, 0x0d, 0xcb, 0xdc,
-	0xf0, 0x4c, 0xc3, 0xe4, 0x9b, 0xe5, 0x47, 0x17, 0x6b, 0x94, 0x6b, 0x17, 0xcb, 0x1b, 0x1e, 0x75,
-	0x36, 0x4b, 0x2d, 0x87, 0x71, 0x86, 0xcf, 0x4a, 0x96, 0x52, 0x2a, 0x4b, 0x49, 0xb2, 0xe4, 0x27,
-	0xea, 0xac, 0xce, 0x04, 0x47, 0xd9, 0xff, 0x15, 0x30, 0xe7, 0xbf, 0xb8, 0xbd, 0xbe, 0x96, 0xe6,
-	0x68, 0x4d, 0x57, 0xee, 0xbd, 0x92, 0x0d, 0x1b, 0x37, 0xf5, 0x86, 0x6a, 0xda, 0x6b, 0xa1, 0x8a,
-	0x82, 0x2e, 0xf8, 0xca, 0x35, 0xcd, 0xa5, 0xd1, 0x26, 0x9d, 0x99, 0x76, 0x08, 0x21, 0x49, 0x17,
-	0x86, 0x45, 0xbb, 0x5a, 0x5a, 0xdd, 0xb4, 0x35, 0x6e, 0xb2, 0x70, 0xef, 0xa9, 0x3a, 0x63, 0x75,
-	0x8b, 0x96, 0xb5, 0x96, 0x59, 0xd6, 0x6c, 0x9b, 0x71, 0x41, 0x0c, 0x01, 0x9e, 0x90, 0x54, 0xb1,
-	0xaa, 0x79, 0x6b, 0x65, 0xcd, 0xde, 0x0c, 0x49, 0x81, 0x12, 0x35, 0x70, 0x40, 0xb0, 0x90, 0xa4,
-	0xd9, 0x6c, 0x66, 0xb5, 0x98, 0x6b, 0x26, 0x90, 0xdc, 0xcc, 0xc6, 0x65, 0x0a, 0xa2, 0xf9, 0x88,
-	0xaa, 0x0e, 0xd5, 0x99, 0x63, 0x04, 0xdc, 0xe4, 0x97, 0x08, 0x26, 0x56, 0x5d, 0xea, 0xac, 0x48,
-	0xa1, 0xae, 0x42, 0x37, 0x3c, 0xea, 0x72, 0x7c, 0x1e, 0x5e, 0xd1, 0x0c, 0xc3, 0xa1, 0xae, 0x3b,
-	0x8d, 0xce, 0xa0, 0x99, 0x5c, 0x05, 0x6f, 0xb5, 0x8b, 0x63, 0x9b, 0x5a, 0xd3, 0xba, 0x4e, 0x24,
-	0x81, 0x28, 0xe1, 0x16, 0x7c, 0x0e, 0x5e, 0x69, 0x31, 0x66, 0xa9, 0xa6, 0x31, 0x3d, 0x72, 0x06,
-	0xcd, 0xec, 0x4b, 0xee, 0x96, 0x04, 0xa2, 0x1c, 0xf0, 0x7f, 0x55, 0x0d, 0xbc, 0x08, 0x10, 0xfb,
-	0x73, 0x7a, 0xef, 0x19, 0x34, 0x73, 0xe8, 0xd2, 0x17, 0x4a, 0xd2, 0x15, 0xbe, 0xf3, 0x4b, 0x41,
-	0x56, 0x49, 0xe8, 0xa5, 0x15, 0xad, 0x4e, 0x25, 0x2c, 0x25, 0xc1, 0x49, 0x7e, 0x8b, 0xe0, 0x78,
-	0x17, 0x76, 0xb7, 0xc5, 0x6c, 0x97, 0xe2, 0xb7, 0x21, 0x17, 0x7a, 0xc9, 0x87, 0xbf, 0x77, 0xe6,
-	0xd0, 0xa5, 0x9b, 0xa5, 0x4c, 0xd9, 0x59, 0x5a, 0xf4, 0x2c, 0x2b, 0x14, 0x58, 0x71, 0xa8, 0xd6,
-	0x30, 0xd8, 0x63, 0xbb, 0xb2, 0xef, 0x59, 0xbb, 0xb8, 0x47, 0x89, 0x85, 0xe2, 0xbb, 0x1d, 0x36,
-	0x8c, 0x08, 0x1b, 0x5e, 0xdb, 0xd1, 0x86, 0x00, 0x5e, 0x87, 0x11, 0xcb, 0x30, 0x1e, 0xa9, 0xdb,
-	0xac, 0x1a, 0xa1, 0xfb, 0xaf, 0xc2, 0xa1, 0x50, 0x99, 0xef, 0x54, 0x24, 0x9c, 0x3a, 0xb9, 0xd5,
-	0x2e, 0xe2, 0xd0, 0xa9, 0x11, 0x91, 0x28, 0x10, 0xae, 0xaa, 0x06, 0x79, 0x04, 0x13, 0x9d, 0xf2,
-	0xa4, 0x4b, 0xbe, 0x05, 0x07, 0xc3, 0x5d, 0x42, 0xda, 0xcb, 0xf1, 0x48, 0x24, 0x93, 0x2c, 0xc2,
-	0xd4, 0xb2, 0xd7, 0x5c, 0x61, 0xcc, 0xea, 0x49, 0xa5, 0x44, 0x72, 0xa0, 0x9d, 0x92, 0x83, 0x7c,
-	0x13, 0xa6, 0x7b, 0xe5, 0x48, 0x1b, 0xee, 0xc0, 0x58, 0x64, 0xb7, 0xce, 0x3c, 0x9b, 0x4b, 0x79,
-	0x27, 0xb6, 0xda, 0xc5, 0xe3, 0x5d, 0x7e, 0x11, 0x74, 0xa2, 0x1c, 0x0e, 0x1f, 0xcc, 0x8b, 0xf5,
-	0x57, 0x61, 0xd4, 0x17, 0x1d, 0x41, 0x5b, 0x4c, 0x09, 0xe3, 0x30, 0xa9, 0xf8, 0x7d, 0x04, 0x87,
-	0xa5, 0x60, 0x89, 0xf5, 0x0a, 0xec, 0xf7, 0x2d, 0x0a, 0xd3, 0x6f, 0xa2, 0x14, 0x94, 0x84, 0x52,
-	0x58, 0x12, 0x4a, 0x73, 0xf6, 0x66, 0x25, 0xf7, 0xfb, 0x5f, 0x5c, 0xd8, 0xef, 0xf3, 0x55, 0x95,
-	0x60, 0xf7, 0xcb, 0xcb, 0xab, 0x23, 0x70, 0x78, 0x45, 0xd4, 0x4c, 0x09, 0x97, 0xac, 0xc2, 0x58,
-	0xf8, 0x40, 0x42, 0x9c, 0x87, 0x03, 0x41, 0x59, 0x95, 0x09, 0x71, 0x76, 0x87, 0x84, 0x08, 0xd8,
-	0x65, 0xe4, 0x25, 0x2b, 0xf9, 0x10, 0xc1, 0xd1, 0x87, 0xa6, 0xde, 0x58, 0x0a, 0xb7, 0x2d, 0x53,
-	0x8e, 0xdf, 0x86, 0xc3, 0x11, 0x9b, 0x6a, 0x53, 0x2e, 0x4b, 0xc8, 0x0d, 0x9f, 0xf3, 0x93, 0x76,
-	0xf1, 0x64, 0x60, 0x8f, 0x6b, 0x34, 0x4a, 0x26, 0x2b, 0x37, 0x35, 0xbe, 0x5e, 0x5a, 0xa2, 0x75,
-	0x4d, 0xdf, 0x5c, 0xa0, 0xfa, 0x56, 0xbb, 0x38, 0x11, 0x84, 0xb2, 0x43, 0x02, 0x51, 0x46, 0xad,
-	0xa4, 0x86, 0x59, 0x00, 0x59, 0xde, 0x0d, 0xfa, 0x44, 0xf8, 0x69, 0x6f, 0xe5, 0xf8, 0x56, 0xbb,
-	0x78, 0x2c, 0xe0, 0x8d, 0x69, 0x44, 0xc9, 0xf9, 0x8b, 0xaa, 0xf8, 0xfd, 0x0f, 0x04, 0x53, 0x11,
-	0xd0, 0x05, 0xda, 0xe2, 0xeb, 0x5f, 0x33, 0xf9, 0xba, 0xa2, 0xd9, 0x75, 0x8a, 0xd7, 0xe0, 0x68,
-	0xac, 0x51, 0x6b, 0x46, 0xe9, 0xb5, 0x4b, 0xd8, 0x47, 0xa2, 0xf5, 0x9c, 0x90, 0xe9, 0x23, 0xb7,
-	0xd8, 0x63, 0xea, 0xa8, 0x3e, 0xac, 0x5e, 0xe4, 0x31, 0x8d, 0x28, 0x39, 0xb1, 0xf0, 0xbd, 0xeb,
-	0x73, 0x79, 0xad, 0x56, 0xc8, 0xb5, 0xb7, 0x9b, 0x2b, 0xa6, 0x11, 0x25, 0x27, 0x16, 0x3e, 0x17,
-	0xf9, 0x74, 0x04, 0x0a, 0xc9, 0xc0, 0x54, 0xed, 0x05, 0xd3, 0xa1, 0xba, 0x9f, 0x20, 0xc3, 0x1c,
-	0x4e, 0x5c, 0x82, 0x83, 0x9c, 0x35, 0xa8, 0xad, 0x9a, 0x41, 0x6e, 0xe6, 0x2a, 0xe3, 0x5b, 0xed,
-	0xe2, 0x11, 0xe9, 0x73, 0x49, 0x21, 0xca, 0x2b, 0xe2, 0x67, 0xd5, 0xf6, 0x51, 0xbb, 0x5c, 0x73,
-	0x78, 0x1f, 0xd4, 0x31, 0x8d, 0x28, 0x39, 0xb1, 0x10, 0xb6, 0x5e, 0x83, 0x51, 0xcf, 0xa5, 0xaa,
-	0xee, 0x49, 0x6b, 0xf7, 0x9d, 0x41, 0x33, 0x07, 0x2b, 0x53, 0x5b, 0xed, 0xe2, 0xb8, 0xb4, 0x36,
-	0x41, 0x25, 0x0a, 0x78, 0x2e, 0x9d, 0xf7, 0x22, 0x37, 0xd5, 0x98, 0x67, 0x1b, 0x01, 0xe3, 0xfe,
-	0x6e, 0x85, 0x31, 0x8d, 0x28, 0x39, 0xb1, 0x48, 0x2a, 0xb4, 0x99, 0x2a, 0x9e, 0x4d, 0x1f, 0x48,
-	0x53, 0x18, 0x52, 0x03, 0x85, 0xcb, 0xac, 0x22, 0x16, 0x3f, 0xd9, 0x0b, 0xc5, 0xbe, 0x1e, 0x96,
-	0xe7, 0x6c, 0x3d, 0x99, 0x59, 0x86, 0x9f, 0x75, 0x61, 0x55, 0xb8, 0x9a, 0xb1, 0x04, 0x77, 0x1f,
-	0x30, 0x79, 0x06, 0xe3, 0xdc, 0x12, 0xb9, 0xec, 0xe2, 0x57, 0x61, 0x54, 0xf7, 0x1c, 0x87, 0xda,
-	0x3c, 0x91, 0x5d, 0xca, 0x21, 0xf9, 0x4c, 0xd8, 0x6a, 0xc1, 0xb1, 0x70, 0x4b, 0xc4, 0x2d, 0x22,
-	0x93, 0xab, 0xdc, 0xce, 0x96, 0xe7, 0xd3, 0x81, 0x4f, 0x7a, 0xa4, 0x10, 0xe5, 0xa8, 0x7c, 0x16,
-	0x41, 0xc5, 0xef, 0x21, 0xc0, 0xe1, 0x46, 0x77, 0xc3, 0xe1, 0x6a, 0xcb, 0x31, 0x75, 0x2a, 0x22,
-	0x9a, 0xab, 0x3c, 0x94, 0xfa, 0xca, 0x75, 0x93, 0xaf, 0x7b, 0xb5, 0x92, 0xce, 0x9a, 0x65, 0xe9,
-	0x8f, 0x0b, 0x96, 0x56, 0x73, 0xc3, 0x85, 0xf8, 0x2b, 0x60, 0x54, 0xcc, 0x7a, 0x80, 0xe1, 0x44,
-	0x27, 0x86, 0x58, 0x74, 0x0c, 0xe2, 0xc1, 0x86, 0xc3, 0x57, 0xc4, 0xa3, 0x37, 0xe1, 0x54, 0x84,
-	0x68, 0x25, 0x38, 0x19, 0xe2, 0xc8, 0x0f, 0xf5, 0x7e, 0xfa, 0x35, 0x82, 0xd3, 0x7d, 0xa4, 0xc9,
-	0x70, 0xd7, 0x20, 0x17, 0x7b, 0x36, 0x88, 0xf3, 0xeb, 0x19, 0xe3, 0xdc, 0xa7, 0x36, 0x85, 0xd7,
-	0x8f, 0x88, 0x01, 0x5f, 0x87, 0xd1, 0x9a, 0xa7, 0x37, 0x28, 0xef, 0x28, 0x80, 0x89, 0x8c, 0x4d,
-	0x52, 0x89, 0x72, 0x28, 0x58, 0x06, 0x45, 0xf0, 0xeb, 0x70, 0x7a, 0xde, 0xd2, 0xcc, 0xa6, 0x56,
-	0xb3, 0xe8, 0x83, 0x96, 0x43, 0x35, 0x43, 0xa1, 0x8f, 0x35, 0xc7, 0x70, 0x77, 0x7d, 0xf7, 0xf8,
-	0x31, 0x82, 0x42, 0x3f, 0xd1, 0xd2, 0x39, 0xdf, 0x81, 0x69, 0x3d, 0xdc, 0xa1, 0xba, 0x62, 0x8b,
-	0xea, 0x04, 0x7b, 0xa4, 0xaf, 0x4e, 0x74, 0xbc, 0xed, 0x42, 0xcf, 0xcc, 0x33, 0xd3, 0xae, 0xbc,
-	0xe6, 0xbb, 0x61, 0xab, 0x5d, 0x2c, 0xca, 0xe8, 0xf7, 0x11, 0x44, 0x94, 0x49, 0x3d, 0x15, 0x05,
-	0x59, 0x85, 0x7c, 0x84, 0xaf, 0x1a, 0x5e, 0x88, 0x77, 0x6f, 0xf7, 0xfb, 0x23, 0x70, 0x32, 0x55,
-	0xae, 0x34, 0x7a, 0x03, 0x26, 0x62, 0xac, 0xd1, 0x45, 0x3c, 0x83, 0xc1, 0x9f, 0x93, 0x06, 0x9f,
-	0xec, 0x36, 0x38, 0x16, 0x42, 0x94, 0x71, 0xbd, 0x57, 0xb5, 0xaf, 0x72, 0x8d, 0x39, 0x6b, 0xd4,
-	0xe4, 0xd4, 0x48, 0xaa, 0x1c, 0x19, 0x50, 0x65, 0x9a, 0x10, 0xa2, 0x8c, 0x47, 0x8f, 0x63, 0x95,
-	0x64, 0x09, 0x4e, 0xfb, 0x57, 0x99, 0x39, 0x5d, 0xf7, 0x9a, 0x9e, 0xa5, 0x71, 0xe6, 0x74, 0xe5,
-	0xd5, 0x40, 0xe7, 0xec, 0x37, 0x23, 0x50, 0xe8, 0x27, 0x4e, 0xba, 0xf5, 0x03, 0x04, 0x27, 0x3b,
-	0x22, 0xaf, 0xd6, 0x1d, 0xf6, 0x98, 0xaf, 0xab, 0x75, 0x8b, 0xd5, 0x34, 0x4b, 0xba, 0xf7, 0x54,
-	0xaa, 0xad, 0x0b, 0x54, 0x17, 0xe6, 0x5e, 0xf6, 0xcd, 0xfd, 0xf0, 0xd3, 0xe2, 0xb9, 0x44, 0x0d,
-	0x92, 0x6d, 0x60, 0xf0, 0xe7, 0x82, 0x6b, 0x34, 0xca, 0x7c, 0xb3, 0x45, 0xdd, 0x90, 0xc7, 0x55,
-	0xa6, 0xdd, 0x44, 0x56, 0xdd, 0x15, 0x3a, 0xef, 0x0a, 0x95, 0xf8, 0xbb, 0x08, 0x26, 0xbc, 0x16,
-	0x37, 0x9b, 0xb4, 0x0b, 0x4b, 0xe0, 0xf7, 0xd9, 0x8c, 0x75, 0x60, 0x55, 0x88, 0x78, 0xe8, 0x68,
-	0x7a, 0x83, 0x3a, 0xdd, 0x21, 0x49, 0x93, 0x4f, 0x14, 0x1c, 0x3c, 0x4e, 0xa2, 0x21, 0xef, 0x23,
-	0x28, 0xf8, 0xf5, 0x29, 0xe1, 0x43, 0x29, 0x73, 0xa8, 0x98, 0x0c, 0x79, 0xe9, 0xfa, 0x6c, 0x04,
-	0x8a, 0x7d, 0x51, 0xc8, 0x50, 0x3e, 0x43, 0x70, 0x2d, 0x35, 0x94, 0xac, 0x25, 0xce, 0x19, 0x55,
-	0x8d, 0xf0, 0xb5, 0xaa, 0xb2, 0x35, 0xd5, 0xd2, 0x5c, 0xae, 0x72, 0x47, 0x7b, 0x44, 0x1d, 0xf7,
-	0x7f, 0x19, 0xe8, 0x4b, 0xbd, 0x81, 0x7e, 0x4b, 0x02, 0x8a, 0x5e, 0xf3, 0x6f, 0xad, 0x2d, 0x69,
-	0x2e, 0x7f, 0x18, 0x82, 0xc1, 0x4f, 0xe1, 0x88, 0x8c, 0x10, 0x97, 0x56, 0xee, 0x2a, 0xf8, 0x05,
-	0x19, 0xfc, 0xc9, 0x8e, 0xe0, 0x87, 0xa2, 0x89, 0x32, 0xe6, 0x25, 0xb7, 0xbb, 0xe4, 0x7b, 0x08,
-	0xa6, 0xa2, 0x43, 0xa9, 0x88, 0x56, 0x7f, 0xb8, 0x60, 0xbf, 0xac, 0xd6, 0xe8, 0x23, 0x04, 0xd3,
-	0xbd, 0x80, 0x64, 0xdc, 0x4d, 0x38, 0xd6, 0x3d, 0x98, 0x08, 0xcb, 0xe2, 0x97, 0x33, 0xba, 0xab,
-	0x4b, 0xb6, 0x7c, 0x57, 0x1e, 0x35, 0xbb, 0x54, 0xbe, 0xbc, 0xce, 0xea, 0x5d, 0x04, 0xe7, 0xe6,
-	0x17, 0xef, 0xdd, 0x13, 0x7d, 0x9b, 0xb1, 0x64, 0xda, 0x8d, 0x45, 0x87, 0x35, 0xe7, 0x13, 0x20,
-	0x03, 0x4a, 0xe8, 0xf5, 0xfb, 0x30, 0x91, 0xb4, 0x40, 0xed, 0x0c, 0x41, 0x31, 0x51, 0xde, 0x53,
-	0x76, 0x11, 0x05, 0xeb, 0x3d, 0x92, 0x89, 0x09, 0xe7, 0xb3, 0x21, 0x90, 0x6e, 0xbe, 0x06, 0xa3,
-	0xfa, 0x5a, 0xb3, 0xd9, 0xa5, 0x3a, 0x71, 0x5d, 0x48, 0x52, 0x89, 0x02, 0xfe, 0x52, 0xaa, 0xba,
-	0x07, 0xa7, 0x57, 0x5d, 0xea, 0xac, 0xda, 0x35, 0x66, 0x1b, 0xa6, 0x5d, 0xdf, 0xdd, 0xa0, 0x88,
-	0xfc, 0x14, 0x41, 0xa1, 0x9f, 0x3c, 0x09, 0xf6, 0x5d, 0x04, 0xf9, 0x68, 0xd0, 0xa2, 0x3e, 0x36,
-	0xf9, 0xba, 0xda, 0xa2, 0x8e, 0xc9, 0x0c, 0xd5, 0x62, 0x7a, 0x43, 0x66, 0xc7, 0xad, 0x8c, 0xd9,
-	0x11, 0x8a, 0xf7, 0xef, 0x52, 0x2b, 0x42, 0xca, 0x12, 0xd3, 0x1b, 0x32, 0x49, 0xa6, 0x22, 0x35,
-	0x9d, 0x64, 0x92, 0x87, 0xe9, 0xbb, 0x94, 0x3f, 0x64, 0x5c, 0xb3, 0xa2, 0x2b, 0x59, 0xd8, 0x47,
-	0xff, 0x00, 0xc1, 0x89, 0x14, 0xa2, 0x04, 0xcf, 0xe1, 0x08, 0xf7, 0x29, 0x6a, 0xf7, 0x15, 0x70,
-	0x9b, 0x57, 0xee, 0x97, 0x64, 0x69, 0x9a, 0xc9, 0x50, 0x9a, 0x82, 0xba, 0x34, 0xc6, 0x3b, 0xb4,
-	0x93, 0x2d, 0x04, 0x85, 0x65, 0xaf, 0xb9, 0x4c, 0x9f, 0xf0, 0xaa, 0x6d, 0x72, 0x53, 0xb3, 0xcc,
-	0x6f, 0x53, 0xd1, 0xdb, 0x0c, 0x77, 0xf6, 0x6f, 0xc3, 0x58, 0xd8, 0xcd, 0xa9, 0x06, 0xb5, 0x59,
-	0x53, 0x76, 0x7b, 0x89, 0x41, 0x4b, 0x27, 0x9d, 0x28, 0xa3, 0xb2, 0xe7, 0x5b, 0xf0, 0x97, 0xb8,
-	0x06, 0x79, 0xdb, 0x6b, 0xaa, 0x36, 0x7d, 0xe2, 0xdf, 0x41, 0x23, 0x44, 0xa2, 0x2b, 0x71, 0x45,
-	0xbb, 0xb1, 0xaf, 0x72, 0x76, 0xab, 0x5d, 0x7c, 0x35, 0x10, 0xd6, 0x7f, 0x2f, 0x51, 0xa6, 0xec,
-	0x74, 0xc3, 0xc8, 0x8f, 0x46, 0xa0, 0xd8, 0xd7, 0xe8, 0xff, 0xfb, 0xd6, 0xeb, 0xd2, 0xbf, 0xf2,
-	0xb0, 0xff, 0xbe, 0x5f, 0xd1, 0xf0, 0xcf, 0x10, 0x88, 0x21, 0x93, 0x8b, 0x2f, 0x67, 0x3e, 0x35,
-	0xf1, 0x8c, 0x2c, 0x3f, 0x3b, 0x18, 0x53, 0xe0, 0x79, 0x32, 0xfb, 0xde, 0x1f, 0xfe, 0xfa, 0xc3,
-	0x91, 0x12, 0x3e, 0x5f, 0xce, 0x3a, 0xd5, 0xf6, 0x01, 0xfe, 0x1c, 0xc1, 0x81, 0x60, 0xcc, 0x84,
-	0x33, 0xab, 0x4d, 0x4e, 0xb9, 0xf2, 0x57, 0x06, 0xe4, 0x92, 0x68, 0xaf, 0x08, 0xb4, 0x65, 0x7c,
-	0x21, 0x2b, 0xda, 0x00, 0xe3, 0x47, 0x08, 0x0e, 0x77, 0x4c, 0xa0, 0xf1, 0x8d, 0xac, 0x2f, 0xf9,
-	0x94, 0x99, 0x7b, 0xfe, 0xe6, 0x70, 0xcc, 0xd2, 0x86, 0x8a, 0xb0, 0xe1, 0x26, 0xbe, 0x5e, 0x1e,
-	0xec, 0x3b, 0x82, 0x5b, 0x7e, 0x47, 0x56, 0xe7, 0xa7, 0xf8, 0x33, 0x04, 0xc7, 0x53, 0xbb, 0x5b,
-	0x3c, 0x3f, 0x68, 0x0b, 0x9b, 0xd2, 0x69, 0xe7, 0x17, 0x76, 0x27, 0x44, 0x1a, 0x7a, 0x57, 0x18,
-	0x3a, 0x87, 0x6f, 0x67, 0x34, 0x34, 0xae, 0x00, 0xe1, 0x90, 0x4c, 0x75, 0x84, 0x4d, 0xff, 0x4e,
-	0x8e, 0x03, 0x3b, 0x87, 0x37, 0xf8, 0x8d, 0x41, 0xa1, 0xa6, 0x8e, 0xd7, 0xf2, 0x8b, 0xbb, 0x15,
-	0x23, 0x6d, 0xae, 0x0a, 0x9b, 0xe7, 0xf1, 0xdc, 0xc0, 0x36, 0xdb, 0x62, 0x0c, 0x10, 0xdf, 0x9f,
-	0xf1, 0x3f, 0x11, 0x4c, 0xa6, 0x77, 0xe9, 0x38, 0x6b, 0x7c, 0xb6, 0x9d, 0x1f, 0xe4, 0xdf, 0xd8,
-	0xa5, 0x94, 0x21, 0xc3, 0xdc, 0x6f, 0x1c, 0x80, 0xff, 0x82, 0x60, 0x3c, 0xa5, 0x3d, 0xc7, 0x73,
-	0x83, 0xe2, 0xec, 0x19, 0x19, 0xe4, 0x2b, 0xbb, 0x11, 0x21, 0xed, 0x9c, 0x17, 0x76, 0xde, 0xc2,
-	0x37, 0x06, 0xb6, 0x33, 0x6e, 0xc9, 0xf1, 0xef, 0x10, 0x8c, 0x26, 0xbf, 0xfb, 0xe0, 0xeb, 0x03,
-	0x5e, 0x90, 0x12, 0x1f, 0x9f, 0xf2, 0x37, 0x86, 0xe2, 0x95, 0xe6, 0xdc, 0x12, 0xe6, 0x5c, 0xc5,
-	0x57, 0x06, 0x2c, 0x43, 0x6a, 0x6d, 0x53, 0x35, 0x0d, 0xfc, 0x37, 0x04, 0x93, 0xe9, 0x7d, 0x7f,
-	0xe6, 0xec, 0xdc, 0x76, 0x0a, 0x91, 0x39, 0x3b, 0xb7, 0x1f, 0x3e, 0x90, 0x39, 0x61, 0xe6, 0x0d,
-	0x7c, 0x6d, 0x80, 0xf7, 0x9b, 0xaa, 0xf9, 0xf2, 0xa2, 0xbc, 0xfc, 0x23, 0x82, 0xa3, 0xdd, 0x9d,
-	0x11, 0x7e, 0x7d, 0xb8, 0xb6, 0x27, 0x32, 0xef, 0xf6, 0xd0, 0xfc, 0xd2, 0xb0, 0x3b, 0xc2, 0xb0,
-	0xeb, 0xf8, 0x2b, 0xe5, 0xe1, 0x3e, 0x2c, 0xbb, 0xf8, 0xef, 0x08, 0xa6, 0xfa, 0x34, 0xfc, 0x99,
-	0xcb, 0xea, 0xf6, 0x63, 0x8b, 0xcc, 0x65, 0x75, 0x87, 0xb9, 0xc3, 0xc0, 0xef, 0x4c, 0xf1, 0xf2,
-	0x08, 0xa2, 0x18, 0xb6, 0xe0, 0xf8, 0x57, 0x23, 0xf0, 0xf9, 0x2c, 0xdd, 0x18, 0x56, 0xb2, 0x16,
-	0x8b, 0xec, 0xcd, 0x65, 0xfe, 0xc1, 0x4b, 0x95, 0x29, 0xbd, 0x62, 0x0a, 0xaf, 0xe8, 0x58, 0xcb,
-	0x5a, 0x91, 0x12, 0xdd, 0xa3, 0x6a, 0x99, 0x76, 0x43, 0x5d, 0x73, 0x58, 0x53, 0x4d, 0x32, 0x95,
-	0xdf, 0x49, 0xeb, 0x6e, 0x9f, 0xe2, 0xff, 0x20, 0x98, 0x4c, 0xef, 0x07, 0x33, 0x1f, 0xf7, 0x6d,
-	0xdb, 0xd3, 0xcc, 0xc7, 0x7d, 0xfb, 0xa6, 0x94, 0xdc, 0x17, 0x2e, 0x79, 0x13, 0x57, 0x33, 0xba,
-	0xc4, 0x73, 0xa9, 0xa3, 0x7a, 0xa1, 0x3c, 0x35, 0xed, 0xae, 0xf5, 0x09, 0x82, 0x63, 0x3d, 0x8d,
-	0x24, 0xce, 0x7a, 0x7e, 0xfb, 0xf5, 0xa7, 0xf9, 0x3b, 0xc3, 0x0b, 0x18, 0xf2, 0x50, 0xd4, 0x29,
-	0x57, 0xbb, 0x9a, 0x5e, 0x71, 0xb5, 0xea, 0xd3, 0x9c, 0x65, 0xae, 0x01, 0xdb, 0x77, 0xb4, 0x99,
-	0x6b, 0xc0, 0x0e, 0x3d, 0xe2, 0xc0, 0x57, 0xab, 0xfe, 0xcd, 0x6a, 0x65, 0xfd, 0xd9, 0xf3, 0x02,
-	0xfa, 0xf8, 0x79, 0x01, 0xfd, 0xf9, 0x79, 0x01, 0x7d, 0xf0, 0xa2, 0xb0, 0xe7, 0xe3, 0x17, 0x85,
-	0x3d, 0x7f, 0x7a, 0x51, 0xd8, 0xf3, 0x8d, 0xe5, 0x9d, 0xbe, 0x71, 0x3d, 0xba, 0x34, 0x5b, 0x7e,
-	0xd2, 0xa1, 0xf9, 0x42, 0xac, 0x5a, 0xb7, 0x4c, 0x6a, 0xf3, 0xe0, 0x7f, 0x92, 0x82, 0x7f, 0x20,
-	0x38, 0x20, 0xfe, 0x5c, 0xfe, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x62, 0x72, 0xe6, 0xe2, 0xa7,
-	0x25, 0x00, 0x00,
+func (m *LimitOrderByIdRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LimitOrderByIdRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LimitOrderByIdRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LimitOrderByIdRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LimitOrderByIdRequest.Merge(m, src)
+}
+func (m *LimitOrderByIdRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *LimitOrderByIdRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LimitOrderByIdRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_LimitOrderByIdRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Pools returns all concentrated liquidity pools
-	Pools(ctx context.Context, in *PoolsRequest, opts ...grpc.CallOption) (*PoolsResponse, error)
-	// Params returns concentrated liquidity module params.
-	Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error)
-	// UserPositions returns all concentrated positions of some address.
-	UserPositions(ctx context.Context, in *UserPositionsRequest, opts ...grpc.CallOption) (*UserPositionsResponse, error)
-	// LiquidityPerTickRange returns the amount of liquidity per every tick range
-	// existing within the given pool
-	LiquidityPerTickRange(ctx context.Context, in *LiquidityPerTickRangeRequest, opts ...grpc.CallOption) (*LiquidityPerTickRangeResponse, error)
-	// LiquidityNetInDirection returns liquidity net in the direction given.
-	// Uses the bound if specified, if not uses either min tick / max tick
-	// depending on the direction.
-	LiquidityNetInDirection(ctx context.Context, in *LiquidityNetInDirectionRequest, opts ...grpc.CallOption) (*LiquidityNetInDirectionResponse, error)
-	// ClaimableSpreadRewards returns the amount of spread rewards that can be
-	// claimed by a position with the given id.
-	ClaimableSpreadRewards(ctx context.Context, in *ClaimableSpreadRewardsRequest, opts ...grpc.CallOption) (*ClaimableSpreadRewardsResponse, error)
-	// ClaimableIncentives returns the amount of incentives that can be claimed
-	// and how many would be forfeited by a position with the given id.
-	ClaimableIncentives(ctx context.Context, in *ClaimableIncentivesRequest, opts ...grpc.CallOption) (*ClaimableIncentivesResponse, error)
-	// PositionById returns a position with the given id.
-	PositionById(ctx context.Context, in *PositionByIdRequest, opts ...grpc.CallOption) (*PositionByIdResponse, error)
-	// PoolAccumulatorRewards returns the pool-global accumulator rewards.
-	// Contains spread factor rewards and uptime rewards.
-	PoolAccumulatorRewards(ctx context.Context, in *PoolAccumulatorRewardsRequest, opts ...grpc.CallOption) (*PoolAccumulatorRewardsResponse, error)
-	// IncentiveRecords returns the incentive records for a given poolId
-	IncentiveRecords(ctx context.Context, in *IncentiveRecordsRequest, opts ...grpc.CallOption) (*IncentiveRecordsResponse, error)
-	// TickAccumulatorTrackers returns the tick accumulator trackers.
-	// Contains spread factor and uptime accumulator trackers.
-	TickAccumulatorTrackers(ctx context.Context, in *TickAccumulatorTrackersRequest, opts ...grpc.CallOption) (*TickAccumulatorTrackersResponse, error)
-	// CFMMPoolIdLinkFromConcentratedPoolId returns the pool id of the CFMM
-	// pool that is linked with the given concentrated pool.
-	CFMMPoolIdLinkFromConcentratedPoolId(ctx context.Context, in *CFMMPoolIdLinkFromConcentratedPoolIdRequest, opts ...grpc.CallOption) (*CFMMPoolIdLinkFromConcentratedPoolIdResponse, error)
-	// UserUnbondingPositions returns the position and lock info of unbonding
-	// positions of the given address.
-	UserUnbondingPositions(ctx context.Context, in *UserUnbondingPositionsRequest, opts ...grpc.CallOption) (*UserUnbondingPositionsResponse, error)
-	// GetTotalLiquidity returns total liquidity across all cl pools.
-	GetTotalLiquidity(ctx context.Context, in *GetTotalLiquidityRequest, opts ...grpc.CallOption) (*GetTotalLiquidityResponse, error)
-	// NumNextInitializedTicks returns the provided number of next initialized
-	// ticks in the direction of swapping the token in denom.
-	NumNextInitializedTicks(ctx context.Context, in *NumNextInitializedTicksRequest, opts ...grpc.CallOption) (*NumNextInitializedTicksResponse, error)
+func (m *LimitOrderByIdRequest) GetOrderId() uint64 {
+	if m != nil {
+		return m.OrderId
+	}
+	return 0
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+type LimitOrderByIdResponse struct {
+	Order types1.FullLimitOrderBreakdown `protobuf:"bytes,1,opt,name=order,proto3" json:"order"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *LimitOrderByIdResponse) Reset()         { *m = LimitOrderByIdResponse{} }
+func (m *LimitOrderByIdResponse) String() string { return proto.CompactTextString(m) }
+func (*LimitOrderByIdResponse) ProtoMessage()    {}
+func (*LimitOrderByIdResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5da291368ba4d8e3, []int{35}
 }
-
-func (c *queryClient) Pools(ctx context.Context, in *PoolsRequest, opts ...grpc.CallOption) (*PoolsResponse, error) {
-	out := new(PoolsResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/Pools", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *LimitOrderByIdResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LimitOrderByIdResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LimitOrderByIdResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *LimitOrderByIdResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LimitOrderByIdResponse.Merge(m, src)
+}
+func (m *LimitOrderByIdResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *LimitOrderByIdResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LimitOrderByIdResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error) {
-	out := new(ParamsResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_LimitOrderByIdResponse proto.InternalMessageInfo
+
+func (m *LimitOrderByIdResponse) GetOrder() types1.FullLimitOrderBreakdown {
+	if m != nil {
+		return m.Order
 	}
-	return out, nil
+	return types1.FullLimitOrderBreakdown{}
 }
 
-func (c *queryClient) UserPositions(ctx context.Context, in *UserPositionsRequest, opts ...grpc.CallOption) (*UserPositionsResponse, error) {
-	out := new(UserPositionsResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/UserPositions", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// =============================== UserLimitOrders
+type UserLimitOrdersRequest struct {
+	Address    string             `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	PoolId     uint64             `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) LiquidityPerTickRange(ctx context.Context, in *LiquidityPerTickRangeRequest, opts ...grpc.CallOption) (*LiquidityPerTickRangeResponse, error) {
-	out := new(LiquidityPerTickRangeResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/LiquidityPerTickRange", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *UserLimitOrdersRequest) Reset()         { *m = UserLimitOrdersRequest{} }
+func (m *UserLimitOrdersRequest) String() string { return proto.CompactTextString(m) }
+func (*UserLimitOrdersRequest) ProtoMessage()    {}
+func (*UserLimitOrdersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5da291368ba4d8e3, []int{36}
+}
+func (m *UserLimitOrdersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UserLimitOrdersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UserLimitOrdersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *UserLimitOrdersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserLimitOrdersRequest.Merge(m, src)
+}
+func (m *UserLimitOrdersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *UserLimitOrdersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserLimitOrdersRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) LiquidityNetInDirection(ctx context.Context, in *LiquidityNetInDirectionRequest, opts ...grpc.CallOption) (*LiquidityNetInDirectionResponse, error) {
-	out := new(LiquidityNetInDirectionResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/LiquidityNetInDirection", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_UserLimitOrdersRequest proto.InternalMessageInfo
+
+func (m *UserLimitOrdersRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) ClaimableSpreadRewards(ctx context.Context, in *ClaimableSpreadRewardsRequest, opts ...grpc.CallOption) (*ClaimableSpreadRewardsResponse, error) {
-	out := new(ClaimableSpreadRewardsResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/ClaimableSpreadRewards", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *UserLimitOrdersRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) ClaimableIncentives(ctx context.Context, in *ClaimableIncentivesRequest, opts ...grpc.CallOption) (*ClaimableIncentivesResponse, error) {
-	out := new(ClaimableIncentivesResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/ClaimableIncentives", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *UserLimitOrdersRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) PositionById(ctx context.Context, in *PositionByIdRequest, opts ...grpc.CallOption) (*PositionByIdResponse, error) {
-	out := new(PositionByIdResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/PositionById", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type UserLimitOrdersResponse struct {
+	Orders     []types1.FullLimitOrderBreakdown `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders"`
+	Pagination *query.PageResponse              `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) PoolAccumulatorRewards(ctx context.Context, in *PoolAccumulatorRewardsRequest, opts ...grpc.CallOption) (*PoolAccumulatorRewardsResponse, error) {
-	out := new(PoolAccumulatorRewardsResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/PoolAccumulatorRewards", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *UserLimitOrdersResponse) Reset()         { *m = UserLimitOrdersResponse{} }
+func (m *UserLimitOrdersResponse) String() string { return proto.CompactTextString(m) }
+func (*UserLimitOrdersResponse) ProtoMessage()    {}
+func (*UserLimitOrdersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5da291368ba4d8e3, []int{37}
+}
+func (m *UserLimitOrdersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UserLimitOrdersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UserLimitOrdersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *UserLimitOrdersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserLimitOrdersResponse.Merge(m, src)
+}
+func (m *UserLimitOrdersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *UserLimitOrdersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserLimitOrdersResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) IncentiveRecords(ctx context.Context, in *IncentiveRecordsRequest, opts ...grpc.CallOption) (*IncentiveRecordsResponse, error) {
-	out := new(IncentiveRecordsResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/IncentiveRecords", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_UserLimitOrdersResponse proto.InternalMessageInfo
+
+func (m *UserLimitOrdersResponse) GetOrders() []types1.FullLimitOrderBreakdown {
+	if m != nil {
+		return m.Orders
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) TickAccumulatorTrackers(ctx context.Context, in *TickAccumulatorTrackersRequest, opts ...grpc.CallOption) (*TickAccumulatorTrackersResponse, error) {
-	out := new(TickAccumulatorTrackersResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/TickAccumulatorTrackers", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *UserLimitOrdersResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) CFMMPoolIdLinkFromConcentratedPoolId(ctx context.Context, in *CFMMPoolIdLinkFromConcentratedPoolIdRequest, opts ...grpc.CallOption) (*CFMMPoolIdLinkFromConcentratedPoolIdResponse, error) {
-	out := new(CFMMPoolIdLinkFromConcentratedPoolIdResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/CFMMPoolIdLinkFromConcentratedPoolId", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// =============================== UserManagedPositions
+type UserManagedPositionsRequest struct {
+	Address    string             `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	PoolId     uint64             `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) UserUnbondingPositions(ctx context.Context, in *UserUnbondingPositionsRequest, opts ...grpc.CallOption) (*UserUnbondingPositionsResponse, error) {
-	out := new(UserUnbondingPositionsResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/UserUnbondingPositions", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *UserManagedPositionsRequest) Reset()         { *m = UserManagedPositionsRequest{} }
+func (m *UserManagedPositionsRequest) String() string { return proto.CompactTextString(m) }
+func (*UserManagedPositionsRequest) ProtoMessage()    {}
+func (*UserManagedPositionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5da291368ba4d8e3, []int{38}
+}
+func (m *UserManagedPositionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UserManagedPositionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UserManagedPositionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *UserManagedPositionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserManagedPositionsRequest.Merge(m, src)
+}
+func (m *UserManagedPositionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *UserManagedPositionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserManagedPositionsRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) GetTotalLiquidity(ctx context.Context, in *GetTotalLiquidityRequest, opts ...grpc.CallOption) (*GetTotalLiquidityResponse, error) {
-	out := new(GetTotalLiquidityResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/GetTotalLiquidity", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_UserManagedPositionsRequest proto.InternalMessageInfo
+
+func (m *UserManagedPositionsRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) NumNextInitializedTicks(ctx context.Context, in *NumNextInitializedTicksRequest, opts ...grpc.CallOption) (*NumNextInitializedTicksResponse, error) {
-	out := new(NumNextInitializedTicksResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/NumNextInitializedTicks", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *UserManagedPositionsRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	return out, nil
+	return 0
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Pools returns all concentrated liquidity pools
-	Pools(context.Context, *PoolsRequest) (*PoolsResponse, error)
-	// Params returns concentrated liquidity module params.
-	Params(context.Context, *ParamsRequest) (*ParamsResponse, error)
-	// UserPositions returns all concentrated positions of some address.
-	UserPositions(context.Context, *UserPositionsRequest) (*UserPositionsResponse, error)
-	// LiquidityPerTickRange returns the amount of liquidity per every tick range
-	// existing within the given pool
-	LiquidityPerTickRange(context.Context, *LiquidityPerTickRangeRequest) (*LiquidityPerTickRangeResponse, error)
-	// LiquidityNetInDirection returns liquidity net in the direction given.
-	// Uses the bound if specified, if not uses either min tick / max tick
-	// depending on the direction.
-	LiquidityNetInDirection(context.Context, *LiquidityNetInDirectionRequest) (*LiquidityNetInDirectionResponse, error)
-	// ClaimableSpreadRewards returns the amount of spread rewards that can be
-	// claimed by a position with the given id.
-	ClaimableSpreadRewards(context.Context, *ClaimableSpreadRewardsRequest) (*ClaimableSpreadRewardsResponse, error)
-	// ClaimableIncentives returns the amount of incentives that can be claimed
-	// and how many would be forfeited by a position with the given id.
-	ClaimableIncentives(context.Context, *ClaimableIncentivesRequest) (*ClaimableIncentivesResponse, error)
-	// PositionById returns a position with the given id.
-	PositionById(context.Context, *PositionByIdRequest) (*PositionByIdResponse, error)
-	// PoolAccumulatorRewards returns the pool-global accumulator rewards.
-	// Contains spread factor rewards and uptime rewards.
-	PoolAccumulatorRewards(context.Context, *PoolAccumulatorRewardsRequest) (*PoolAccumulatorRewardsResponse, error)
-	// IncentiveRecords returns the incentive records for a given poolId
-	IncentiveRecords(context.Context, *IncentiveRecordsRequest) (*IncentiveRecordsResponse, error)
-	// TickAccumulatorTrackers returns the tick accumulator trackers.
-	// Contains spread factor and uptime accumulator trackers.
-	TickAccumulatorTrackers(context.Context, *TickAccumulatorTrackersRequest) (*TickAccumulatorTrackersResponse, error)
-	// CFMMPoolIdLinkFromConcentratedPoolId returns the pool id of the CFMM
-	// pool that is linked with the given concentrated pool.
-	CFMMPoolIdLinkFromConcentratedPoolId(context.Context, *CFMMPoolIdLinkFromConcentratedPoolIdRequest) (*CFMMPoolIdLinkFromConcentratedPoolIdResponse, error)
-	// UserUnbondingPositions returns the position and lock info of unbonding
-	// positions of the given address.
-	UserUnbondingPositions(context.Context, *UserUnbondingPositionsRequest) (*UserUnbondingPositionsResponse, error)
-	// GetTotalLiquidity returns total liquidity across all cl pools.
-	GetTotalLiquidity(context.Context, *GetTotalLiquidityRequest) (*GetTotalLiquidityResponse, error)
-	// NumNextInitializedTicks returns the provided number of next initialized
-	// ticks in the direction of swapping the token in denom.
-	NumNextInitializedTicks(context.Context, *NumNextInitializedTicksRequest) (*NumNextInitializedTicksResponse, error)
+func (m *UserManagedPositionsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+type UserManagedPositionsResponse struct {
+	ManagedPositions []types1.FullManagedPositionBreakdown `protobuf:"bytes,1,rep,name=managed_positions,json=managedPositions,proto3" json:"managed_positions"`
+	Pagination       *query.PageResponse                   `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (*UnimplementedQueryServer) Pools(ctx context.Context, req *PoolsRequest) (*PoolsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Pools not implemented")
+func (m *UserManagedPositionsResponse) Reset()         { *m = UserManagedPositionsResponse{} }
+func (m *UserManagedPositionsResponse) String() string { return proto.CompactTextString(m) }
+func (*UserManagedPositionsResponse) ProtoMessage()    {}
+func (*UserManagedPositionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5da291368ba4d8e3, []int{39}
 }
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *ParamsRequest) (*ParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *UserManagedPositionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) UserPositions(ctx context.Context, req *UserPositionsRequest) (*UserPositionsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UserPositions not implemented")
+func (m *UserManagedPositionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UserManagedPositionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) LiquidityPerTickRange(ctx context.Context, req *LiquidityPerTickRangeRequest) (*LiquidityPerTickRangeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LiquidityPerTickRange not implemented")
+func (m *UserManagedPositionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserManagedPositionsResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) LiquidityNetInDirection(ctx context.Context, req *LiquidityNetInDirectionRequest) (*LiquidityNetInDirectionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LiquidityNetInDirection not implemented")
+func (m *UserManagedPositionsResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) ClaimableSpreadRewards(ctx context.Context, req *ClaimableSpreadRewardsRequest) (*ClaimableSpreadRewardsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClaimableSpreadRewards not implemented")
+func (m *UserManagedPositionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserManagedPositionsResponse.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) ClaimableIncentives(ctx context.Context, req *ClaimableIncentivesRequest) (*ClaimableIncentivesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClaimableIncentives not implemented")
+
+var xxx_messageInfo_UserManagedPositionsResponse proto.InternalMessageInfo
+
+func (m *UserManagedPositionsResponse) GetManagedPositions() []types1.FullManagedPositionBreakdown {
+	if m != nil {
+		return m.ManagedPositions
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) PositionById(ctx context.Context, req *PositionByIdRequest) (*PositionByIdResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PositionById not implemented")
+
+func (m *UserManagedPositionsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) PoolAccumulatorRewards(ctx context.Context, req *PoolAccumulatorRewardsRequest) (*PoolAccumulatorRewardsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PoolAccumulatorRewards not implemented")
+
+// =============================== EffectiveSpreadFactor
+type EffectiveSpreadFactorRequest struct {
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
 }
-func (*UnimplementedQueryServer) IncentiveRecords(ctx context.Context, req *IncentiveRecordsRequest) (*IncentiveRecordsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method IncentiveRecords not implemented")
+
+func (m *EffectiveSpreadFactorRequest) Reset()         { *m = EffectiveSpreadFactorRequest{} }
+func (m *EffectiveSpreadFactorRequest) String() string { return proto.CompactTextString(m) }
+func (*EffectiveSpreadFactorRequest) ProtoMessage()    {}
+func (*EffectiveSpreadFactorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5da291368ba4d8e3, []int{40}
 }
-func (*UnimplementedQueryServer) TickAccumulatorTrackers(ctx context.Context, req *TickAccumulatorTrackersRequest) (*TickAccumulatorTrackersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TickAccumulatorTrackers not implemented")
+func (m *EffectiveSpreadFactorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) CFMMPoolIdLinkFromConcentratedPoolId(ctx context.Context, req *CFMMPoolIdLinkFromConcentratedPoolIdRequest) (*CFMMPoolIdLinkFromConcentratedPoolIdResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CFMMPoolIdLinkFromConcentratedPoolId not implemented")
+func (m *EffectiveSpreadFactorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EffectiveSpreadFactorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) UserUnbondingPositions(ctx context.Context, req *UserUnbondingPositionsRequest) (*UserUnbondingPositionsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UserUnbondingPositions not implemented")
+func (m *EffectiveSpreadFactorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EffectiveSpreadFactorRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) GetTotalLiquidity(ctx context.Context, req *GetTotalLiquidityRequest) (*GetTotalLiquidityResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTotalLiquidity not implemented")
+func (m *EffectiveSpreadFactorRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) NumNextInitializedTicks(ctx context.Context, req *NumNextInitializedTicksRequest) (*NumNextInitializedTicksResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method NumNextInitializedTicks not implemented")
+func (m *EffectiveSpreadFactorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EffectiveSpreadFactorRequest.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
-}
+var xxx_messageInfo_EffectiveSpreadFactorRequest proto.InternalMessageInfo
 
-func _Query_Pools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PoolsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Pools(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/Pools",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Pools(ctx, req.(*PoolsRequest))
+func (m *EffectiveSpreadFactorRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*ParamsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type EffectiveSpreadFactorResponse struct {
+	EffectiveSpreadFactor cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=effective_spread_factor,json=effectiveSpreadFactor,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"effective_spread_factor" yaml:"effective_spread_factor"`
+	// dynamic_spread_factor_config is nil if the pool charges its fixed spread
+	// factor.
+	DynamicSpreadFactorConfig *types1.DynamicSpreadFactorConfig `protobuf:"bytes,2,opt,name=dynamic_spread_factor_config,json=dynamicSpreadFactorConfig,proto3" json:"dynamic_spread_factor_config,omitempty" yaml:"dynamic_spread_factor_config"`
 }
 
-func _Query_UserPositions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UserPositionsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).UserPositions(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/UserPositions",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).UserPositions(ctx, req.(*UserPositionsRequest))
+func (m *EffectiveSpreadFactorResponse) Reset()         { *m = EffectiveSpreadFactorResponse{} }
+func (m *EffectiveSpreadFactorResponse) String() string { return proto.CompactTextString(m) }
+func (*EffectiveSpreadFactorResponse) ProtoMessage()    {}
+func (*EffectiveSpreadFactorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5da291368ba4d8e3, []int{41}
+}
+func (m *EffectiveSpreadFactorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EffectiveSpreadFactorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EffectiveSpreadFactorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *EffectiveSpreadFactorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EffectiveSpreadFactorResponse.Merge(m, src)
+}
+func (m *EffectiveSpreadFactorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *EffectiveSpreadFactorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EffectiveSpreadFactorResponse.DiscardUnknown(m)
 }
 
-func _Query_LiquidityPerTickRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LiquidityPerTickRangeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).LiquidityPerTickRange(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/LiquidityPerTickRange",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).LiquidityPerTickRange(ctx, req.(*LiquidityPerTickRangeRequest))
+var xxx_messageInfo_EffectiveSpreadFactorResponse proto.InternalMessageInfo
+
+func (m *EffectiveSpreadFactorResponse) GetDynamicSpreadFactorConfig() *types1.DynamicSpreadFactorConfig {
+	if m != nil {
+		return m.DynamicSpreadFactorConfig
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_LiquidityNetInDirection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LiquidityNetInDirectionRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).LiquidityNetInDirection(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/LiquidityNetInDirection",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).LiquidityNetInDirection(ctx, req.(*LiquidityNetInDirectionRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// =============================== PositionHistory
+type PositionHistoryRequest struct {
+	PositionId uint64 `protobuf:"varint,1,opt,name=position_id,json=positionId,proto3" json:"position_id,omitempty" yaml:"position_id"`
 }
 
-func _Query_ClaimableSpreadRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ClaimableSpreadRewardsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ClaimableSpreadRewards(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/ClaimableSpreadRewards",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ClaimableSpreadRewards(ctx, req.(*ClaimableSpreadRewardsRequest))
+func (m *PositionHistoryRequest) Reset()         { *m = PositionHistoryRequest{} }
+func (m *PositionHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*PositionHistoryRequest) ProtoMessage()    {}
+func (*PositionHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5da291368ba4d8e3, []int{42}
+}
+func (m *PositionHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PositionHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PositionHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *PositionHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PositionHistoryRequest.Merge(m, src)
+}
+func (m *PositionHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PositionHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PositionHistoryRequest.DiscardUnknown(m)
 }
 
-func _Query_ClaimableIncentives_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ClaimableIncentivesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ClaimableIncentives(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/ClaimableIncentives",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ClaimableIncentives(ctx, req.(*ClaimableIncentivesRequest))
+var xxx_messageInfo_PositionHistoryRequest proto.InternalMessageInfo
+
+func (m *PositionHistoryRequest) GetPositionId() uint64 {
+	if m != nil {
+		return m.PositionId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_PositionById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PositionByIdRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).PositionById(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/PositionById",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PositionById(ctx, req.(*PositionByIdRequest))
+type PositionHistoryResponse struct {
+	Checkpoints []types1.PositionCheckpoint `protobuf:"bytes,1,rep,name=checkpoints,proto3" json:"checkpoints"`
+	// asset0 and asset1 are the current underlying assets of the position.
+	Asset0 types2.Coin `protobuf:"bytes,2,opt,name=asset0,proto3" json:"asset0"`
+	Asset1 types2.Coin `protobuf:"bytes,3,opt,name=asset1,proto3" json:"asset1"`
+	// total_spread_rewards and total_incentives are the collected and the
+	// currently claimable spread rewards and incentives of the position.
+	TotalSpreadRewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=total_spread_rewards,json=totalSpreadRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_spread_rewards"`
+	TotalIncentives    github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=total_incentives,json=totalIncentives,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_incentives"`
+	// position_value is the value of the current underlying assets plus the
+	// withdrawn assets, and hold_value is the value of the deposited assets,
+	// both in units of token1 at the current spot price.
+	PositionValue cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=position_value,json=positionValue,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"position_value" yaml:"position_value"`
+	HoldValue     cosmossdk_io_math.LegacyDec `protobuf:"bytes,7,opt,name=hold_value,json=holdValue,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"hold_value" yaml:"hold_value"`
+	// impermanent_loss is position_value / hold_value - 1, excluding spread
+	// rewards and incentives.
+	ImpermanentLoss cosmossdk_io_math.LegacyDec `protobuf:"bytes,8,opt,name=impermanent_loss,json=impermanentLoss,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"impermanent_loss" yaml:"impermanent_loss"`
+	// spread_reward_apr is the value of total_spread_rewards relative to
+	// hold_value, annualized over the time since the first checkpoint.
+	SpreadRewardApr cosmossdk_io_math.LegacyDec `protobuf:"bytes,9,opt,name=spread_reward_apr,json=spreadRewardApr,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"spread_reward_apr" yaml:"spread_reward_apr"`
+}
+
+func (m *PositionHistoryResponse) Reset()         { *m = PositionHistoryResponse{} }
+func (m *PositionHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*PositionHistoryResponse) ProtoMessage()    {}
+func (*PositionHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5da291368ba4d8e3, []int{43}
+}
+func (m *PositionHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PositionHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PositionHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *PositionHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PositionHistoryResponse.Merge(m, src)
+}
+func (m *PositionHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PositionHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PositionHistoryResponse.DiscardUnknown(m)
 }
 
-func _Query_PoolAccumulatorRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PoolAccumulatorRewardsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).PoolAccumulatorRewards(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/PoolAccumulatorRewards",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PoolAccumulatorRewards(ctx, req.(*PoolAccumulatorRewardsRequest))
+var xxx_messageInfo_PositionHistoryResponse proto.InternalMessageInfo
+
+func (m *PositionHistoryResponse) GetCheckpoints() []types1.PositionCheckpoint {
+	if m != nil {
+		return m.Checkpoints
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_IncentiveRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(IncentiveRecordsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).IncentiveRecords(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/IncentiveRecords",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).IncentiveRecords(ctx, req.(*IncentiveRecordsRequest))
+func (m *PositionHistoryResponse) GetAsset0() types2.Coin {
+	if m != nil {
+		return m.Asset0
 	}
-	return interceptor(ctx, in, info, handler)
+	return types2.Coin{}
 }
 
-func _Query_TickAccumulatorTrackers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TickAccumulatorTrackersRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).TickAccumulatorTrackers(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/TickAccumulatorTrackers",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TickAccumulatorTrackers(ctx, req.(*TickAccumulatorTrackersRequest))
+func (m *PositionHistoryResponse) GetAsset1() types2.Coin {
+	if m != nil {
+		return m.Asset1
 	}
-	return interceptor(ctx, in, info, handler)
+	return types2.Coin{}
 }
 
-func _Query_CFMMPoolIdLinkFromConcentratedPoolId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CFMMPoolIdLinkFromConcentratedPoolIdRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).CFMMPoolIdLinkFromConcentratedPoolId(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/CFMMPoolIdLinkFromConcentratedPoolId",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).CFMMPoolIdLinkFromConcentratedPoolId(ctx, req.(*CFMMPoolIdLinkFromConcentratedPoolIdRequest))
+func (m *PositionHistoryResponse) GetTotalSpreadRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalSpreadRewards
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_UserUnbondingPositions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UserUnbondingPositionsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).UserUnbondingPositions(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/UserUnbondingPositions",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).UserUnbondingPositions(ctx, req.(*UserUnbondingPositionsRequest))
+func (m *PositionHistoryResponse) GetTotalIncentives() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalIncentives
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_GetTotalLiquidity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTotalLiquidityRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetTotalLiquidity(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/GetTotalLiquidity",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetTotalLiquidity(ctx, req.(*GetTotalLiquidityRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func init() {
+	proto.RegisterType((*UserPositionsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.UserPositionsRequest")
+	proto.RegisterType((*UserPositionsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.UserPositionsResponse")
+	proto.RegisterType((*PositionByIdRequest)(nil), "osmosis.concentratedliquidity.v1beta1.PositionByIdRequest")
+	proto.RegisterType((*PositionByIdResponse)(nil), "osmosis.concentratedliquidity.v1beta1.PositionByIdResponse")
+	proto.RegisterType((*NumPoolPositionsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.NumPoolPositionsRequest")
+	proto.RegisterType((*NumPoolPositionsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.NumPoolPositionsResponse")
+	proto.RegisterType((*PoolsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.PoolsRequest")
+	proto.RegisterType((*PoolsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.PoolsResponse")
+	proto.RegisterType((*ParamsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.ParamsRequest")
+	proto.RegisterType((*ParamsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.ParamsResponse")
+	proto.RegisterType((*TickLiquidityNet)(nil), "osmosis.concentratedliquidity.v1beta1.TickLiquidityNet")
+	proto.RegisterType((*LiquidityDepthWithRange)(nil), "osmosis.concentratedliquidity.v1beta1.LiquidityDepthWithRange")
+	proto.RegisterType((*LiquidityNetInDirectionRequest)(nil), "osmosis.concentratedliquidity.v1beta1.LiquidityNetInDirectionRequest")
+	proto.RegisterType((*LiquidityNetInDirectionResponse)(nil), "osmosis.concentratedliquidity.v1beta1.LiquidityNetInDirectionResponse")
+	proto.RegisterType((*LiquidityPerTickRangeRequest)(nil), "osmosis.concentratedliquidity.v1beta1.LiquidityPerTickRangeRequest")
+	proto.RegisterType((*LiquidityPerTickRangeResponse)(nil), "osmosis.concentratedliquidity.v1beta1.LiquidityPerTickRangeResponse")
+	proto.RegisterType((*ClaimableSpreadRewardsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.ClaimableSpreadRewardsRequest")
+	proto.RegisterType((*ClaimableSpreadRewardsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.ClaimableSpreadRewardsResponse")
+	proto.RegisterType((*ClaimableIncentivesRequest)(nil), "osmosis.concentratedliquidity.v1beta1.ClaimableIncentivesRequest")
+	proto.RegisterType((*ClaimableIncentivesResponse)(nil), "osmosis.concentratedliquidity.v1beta1.ClaimableIncentivesResponse")
+	proto.RegisterType((*PoolAccumulatorRewardsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.PoolAccumulatorRewardsRequest")
+	proto.RegisterType((*PoolAccumulatorRewardsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.PoolAccumulatorRewardsResponse")
+	proto.RegisterType((*TickAccumulatorTrackersRequest)(nil), "osmosis.concentratedliquidity.v1beta1.TickAccumulatorTrackersRequest")
+	proto.RegisterType((*TickAccumulatorTrackersResponse)(nil), "osmosis.concentratedliquidity.v1beta1.TickAccumulatorTrackersResponse")
+	proto.RegisterType((*IncentiveRecordsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.IncentiveRecordsRequest")
+	proto.RegisterType((*IncentiveRecordsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.IncentiveRecordsResponse")
+	proto.RegisterType((*CFMMPoolIdLinkFromConcentratedPoolIdRequest)(nil), "osmosis.concentratedliquidity.v1beta1.CFMMPoolIdLinkFromConcentratedPoolIdRequest")
+	proto.RegisterType((*CFMMPoolIdLinkFromConcentratedPoolIdResponse)(nil), "osmosis.concentratedliquidity.v1beta1.CFMMPoolIdLinkFromConcentratedPoolIdResponse")
+	proto.RegisterType((*UserUnbondingPositionsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.UserUnbondingPositionsRequest")
+	proto.RegisterType((*UserUnbondingPositionsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.UserUnbondingPositionsResponse")
+	proto.RegisterType((*GetTotalLiquidityRequest)(nil), "osmosis.concentratedliquidity.v1beta1.GetTotalLiquidityRequest")
+	proto.RegisterType((*GetTotalLiquidityResponse)(nil), "osmosis.concentratedliquidity.v1beta1.GetTotalLiquidityResponse")
+	proto.RegisterType((*NumNextInitializedTicksRequest)(nil), "osmosis.concentratedliquidity.v1beta1.NumNextInitializedTicksRequest")
+	proto.RegisterType((*NumNextInitializedTicksResponse)(nil), "osmosis.concentratedliquidity.v1beta1.NumNextInitializedTicksResponse")
+	proto.RegisterType((*LimitOrderByIdRequest)(nil), "osmosis.concentratedliquidity.v1beta1.LimitOrderByIdRequest")
+	proto.RegisterType((*LimitOrderByIdResponse)(nil), "osmosis.concentratedliquidity.v1beta1.LimitOrderByIdResponse")
+	proto.RegisterType((*UserLimitOrdersRequest)(nil), "osmosis.concentratedliquidity.v1beta1.UserLimitOrdersRequest")
+	proto.RegisterType((*UserLimitOrdersResponse)(nil), "osmosis.concentratedliquidity.v1beta1.UserLimitOrdersResponse")
+	proto.RegisterType((*UserManagedPositionsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.UserManagedPositionsRequest")
+	proto.RegisterType((*UserManagedPositionsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.UserManagedPositionsResponse")
+	proto.RegisterType((*EffectiveSpreadFactorRequest)(nil), "osmosis.concentratedliquidity.v1beta1.EffectiveSpreadFactorRequest")
+	proto.RegisterType((*EffectiveSpreadFactorResponse)(nil), "osmosis.concentratedliquidity.v1beta1.EffectiveSpreadFactorResponse")
+	proto.RegisterType((*PositionHistoryRequest)(nil), "osmosis.concentratedliquidity.v1beta1.PositionHistoryRequest")
+	proto.RegisterType((*PositionHistoryResponse)(nil), "osmosis.concentratedliquidity.v1beta1.PositionHistoryResponse")
 }
 
-func _Query_NumNextInitializedTicks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(NumNextInitializedTicksRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).NumNextInitializedTicks(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/NumNextInitializedTicks",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).NumNextInitializedTicks(ctx, req.(*NumNextInitializedTicksRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func init() {
+	proto.RegisterFile("osmosis/concentratedliquidity/v1beta1/query.proto", fileDescriptor_5da291368ba4d8e3)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "osmosis.concentratedliquidity.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Pools",
-			Handler:    _Query_Pools_Handler,
-		},
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "UserPositions",
-			Handler:    _Query_UserPositions_Handler,
-		},
-		{
-			MethodName: "LiquidityPerTickRange",
-			Handler:    _Query_LiquidityPerTickRange_Handler,
-		},
-		{
-			MethodName: "LiquidityNetInDirection",
-			Handler:    _Query_LiquidityNetInDirection_Handler,
-		},
-		{
-			MethodName: "ClaimableSpreadRewards",
-			Handler:    _Query_ClaimableSpreadRewards_Handler,
-		},
-		{
-			MethodName: "ClaimableIncentives",
-			Handler:    _Query_ClaimableIncentives_Handler,
-		},
-		{
-			MethodName: "PositionById",
-			Handler:    _Query_PositionById_Handler,
-		},
-		{
-			MethodName: "PoolAccumulatorRewards",
-			Handler:    _Query_PoolAccumulatorRewards_Handler,
-		},
-		{
-			MethodName: "IncentiveRecords",
-			Handler:    _Query_IncentiveRecords_Handler,
-		},
-		{
-			MethodName: "TickAccumulatorTrackers",
-			Handler:    _Query_TickAccumulatorTrackers_Handler,
-		},
-		{
-			MethodName: "CFMMPoolIdLinkFromConcentratedPoolId",
-			Handler:    _Query_CFMMPoolIdLinkFromConcentratedPoolId_Handler,
-		},
-		{
-			MethodName: "UserUnbondingPositions",
-			Handler:    _Query_UserUnbondingPositions_Handler,
-		},
-		{
-			MethodName: "GetTotalLiquidity",
-			Handler:    _Query_GetTotalLiquidity_Handler,
-		},
-		{
-			MethodName: "NumNextInitializedTicks",
-			Handler:    _Query_NumNextInitializedTicks_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "osmosis/concentratedliquidity/v1beta1/query.proto",
+var fileDescriptor_5da291368ba4d8e3 = []byte{
+	// 3024 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5b, 0x5f, 0x6c, 0x1c, 0x47,
+	0x19, 0xcf, 0x3a, 0x89, 0x93, 0xfb, 0xe2, 0xd8, 0xce, 0xc4, 0xb1, 0x9d, 0x4b, 0x72, 0x97, 0x4e,
+	0x29, 0x8d, 0x68, 0x73, 0xd7, 0xa4, 0x09, 0x69, 0xfe, 0xc7, 0x77, 0xfe, 0x53, 0xb7, 0x8e, 0xe3,
+	0x6c, 0x93, 0x16, 0x55, 0x15, 0xdb, 0xbd, 0xdd, 0xb9, 0xf3, 0xe8, 0xf6, 0x76, 0x2e, 0xbb, 0x7b,
+	0x4e, 0x4c, 0x88, 0x54, 0xb5, 0x8f, 0x48, 0x50, 0xc4, 0x2b, 0x42, 0x42, 0xbc, 0xa0, 0x8a, 0x47,
+	0x5e, 0x80, 0x07, 0x0a, 0x0f, 0xa8, 0x02, 0xa9, 0x54, 0x42, 0x48, 0xa8, 0x42, 0x0e, 0x34, 0x3c,
+	0x20, 0x15, 0x2a, 0x64, 0x78, 0x40, 0xe2, 0x05, 0xed, 0xec, 0xec, 0xde, 0xde, 0xde, 0x9e, 0xb3,
+	0x7b, 0x67, 0x1e, 0x2a, 0x9e, 0x7c, 0xb3, 0x33, 0xdf, 0xef, 0xfb, 0x7e, 0xdf, 0x37, 0x33, 0x3b,
+	0xf3, 0x7d, 0x6b, 0x38, 0xc5, 0xec, 0x06, 0xb3, 0xa9, 0x5d, 0xd4, 0x98, 0xa9, 0x11, 0xd3, 0xb1,
+	0x54, 0x87, 0xe8, 0x06, 0xbd, 0xd3, 0xa2, 0x3a, 0x75, 0xd6, 0x8b, 0x6b, 0xa7, 0x2a, 0xc4, 0x51,
+	0x4f, 0x15, 0xef, 0xb4, 0x88, 0xb5, 0x5e, 0x68, 0x5a, 0xcc, 0x61, 0xe8, 0x29, 0x21, 0x52, 0x88,
+	0x15, 0x29, 0x08, 0x91, 0xec, 0x44, 0x8d, 0xd5, 0x18, 0x97, 0x28, 0xba, 0xbf, 0x3c, 0xe1, 0xec,
+	0x97, 0xb6, 0xd6, 0xd7, 0x54, 0x2d, 0xb5, 0x61, 0x8b, 0xb1, 0x67, 0x93, 0xd9, 0xe6, 0x50, 0xad,
+	0xae, 0x50, 0xb3, 0xea, 0xab, 0xc8, 0x69, 0x5c, 0xae, 0x58, 0x51, 0x6d, 0x12, 0x0c, 0xd2, 0x18,
+	0x35, 0x7d, 0x13, 0xc2, 0xfd, 0x9c, 0x58, 0x30, 0xaa, 0xa9, 0xd6, 0xa8, 0xa9, 0x3a, 0x94, 0xf9,
+	0x63, 0x8f, 0xd6, 0x18, 0xab, 0x19, 0xa4, 0xa8, 0x36, 0x69, 0x51, 0x35, 0x4d, 0xe6, 0xf0, 0x4e,
+	0xdf, 0xc0, 0xc3, 0xa2, 0x97, 0xb7, 0x2a, 0xad, 0x6a, 0x51, 0x35, 0xd7, 0xfd, 0x2e, 0x4f, 0x89,
+	0xe2, 0x39, 0xc0, 0x6b, 0x88, 0xae, 0x33, 0xc9, 0x68, 0x35, 0x99, 0x4d, 0x43, 0x96, 0x5c, 0x4a,
+	0x26, 0x45, 0x79, 0x27, 0x5d, 0x23, 0x8a, 0x45, 0x34, 0x66, 0xe9, 0x42, 0xfa, 0x5c, 0x32, 0x69,
+	0x83, 0x36, 0xa8, 0xa3, 0x30, 0x4b, 0x27, 0x56, 0x3a, 0xb5, 0x0d, 0xd5, 0x54, 0x6b, 0x44, 0x57,
+	0x22, 0x46, 0xcf, 0x24, 0x93, 0xd6, 0xd7, 0x4d, 0xb5, 0x41, 0x35, 0xc5, 0x6e, 0x5a, 0x44, 0xd5,
+	0x95, 0xaa, 0xaa, 0x39, 0xcc, 0x37, 0xe0, 0x6a, 0x3a, 0x6f, 0x29, 0xda, 0x2a, 0xd1, 0xea, 0x4d,
+	0x46, 0x4d, 0xc7, 0x03, 0xc0, 0x3f, 0x91, 0x60, 0xe2, 0xb6, 0x4d, 0xac, 0x15, 0x31, 0xc2, 0x96,
+	0xc9, 0x9d, 0x16, 0xb1, 0x1d, 0xf4, 0x2c, 0xec, 0x51, 0x75, 0xdd, 0x22, 0xb6, 0x3d, 0x2d, 0x1d,
+	0x97, 0x4e, 0x64, 0x4a, 0x68, 0x73, 0x23, 0x3f, 0xba, 0xae, 0x36, 0x8c, 0x0b, 0x58, 0x74, 0x60,
+	0xd9, 0x1f, 0x82, 0x9e, 0x81, 0x3d, 0x4d, 0xc6, 0x0c, 0x85, 0xea, 0xd3, 0x43, 0xc7, 0xa5, 0x13,
+	0xbb, 0xc2, 0xa3, 0x45, 0x07, 0x96, 0x87, 0xdd, 0x5f, 0x8b, 0x3a, 0x9a, 0x07, 0x68, 0x4f, 0xa5,
+	0xe9, 0x9d, 0xc7, 0xa5, 0x13, 0xfb, 0x4e, 0x7f, 0xb1, 0x20, 0x66, 0x81, 0x3b, 0xef, 0x0a, 0xde,
+	0x82, 0x12, 0xd6, 0x17, 0x56, 0xd4, 0x1a, 0x11, 0x66, 0xc9, 0x21, 0x49, 0xfc, 0x4b, 0x09, 0x0e,
+	0x45, 0x6c, 0xb7, 0x9b, 0xcc, 0xb4, 0x09, 0x7a, 0x13, 0x32, 0x3e, 0x65, 0xd7, 0xfc, 0x9d, 0x27,
+	0xf6, 0x9d, 0xbe, 0x54, 0x48, 0xb4, 0x30, 0x0b, 0xf3, 0x2d, 0xc3, 0xf0, 0x01, 0x4b, 0x16, 0x51,
+	0xeb, 0x3a, 0xbb, 0x6b, 0x96, 0x76, 0x7d, 0xb0, 0x91, 0xdf, 0x21, 0xb7, 0x41, 0xd1, 0x42, 0x07,
+	0x87, 0x21, 0xce, 0xe1, 0xe9, 0xc7, 0x72, 0xf0, 0xcc, 0xeb, 0x20, 0xb1, 0x0c, 0x07, 0x03, 0x75,
+	0xeb, 0x8b, 0xba, 0xef, 0xfe, 0x73, 0xb0, 0x2f, 0x08, 0x1a, 0xd5, 0x79, 0x08, 0x76, 0x95, 0x26,
+	0x37, 0x37, 0xf2, 0xc8, 0x77, 0x6a, 0xd0, 0x89, 0x65, 0xf0, 0x5b, 0x8b, 0x3a, 0x5e, 0x83, 0x89,
+	0x4e, 0x3c, 0xe1, 0x92, 0xaf, 0xc2, 0x5e, 0x7f, 0x14, 0x47, 0xdb, 0x1e, 0x8f, 0x04, 0x98, 0x78,
+	0x1e, 0xa6, 0x96, 0x5b, 0x8d, 0x15, 0xc6, 0x8c, 0xae, 0xa9, 0x14, 0x9a, 0x1c, 0xd2, 0xe3, 0x26,
+	0x07, 0x7e, 0x03, 0xa6, 0xbb, 0x71, 0x04, 0x87, 0x6b, 0x30, 0xda, 0x9e, 0xc9, 0xac, 0x65, 0x3a,
+	0x02, 0xef, 0xf0, 0xe6, 0x46, 0xfe, 0x50, 0xc4, 0x2f, 0xbc, 0x1f, 0xcb, 0xfb, 0xfd, 0x07, 0x65,
+	0xde, 0x7e, 0x15, 0x46, 0x5c, 0xe8, 0xc0, 0xb4, 0xf9, 0x98, 0x30, 0xf6, 0x33, 0x15, 0xbf, 0x25,
+	0xc1, 0x7e, 0x01, 0x2c, 0x6c, 0x3d, 0x0b, 0xbb, 0x5d, 0x46, 0xfe, 0xf4, 0x9b, 0x28, 0x78, 0xbb,
+	0x61, 0xc1, 0xdf, 0x0d, 0x0b, 0x33, 0xe6, 0x7a, 0x29, 0xf3, 0xeb, 0x1f, 0x9f, 0xdc, 0xed, 0xca,
+	0x2d, 0xca, 0xde, 0xe8, 0xed, 0x9b, 0x57, 0x63, 0xb0, 0x7f, 0x85, 0xbf, 0x2e, 0x84, 0xb9, 0xf8,
+	0x36, 0x8c, 0xfa, 0x0f, 0x84, 0x89, 0x65, 0x18, 0xf6, 0xde, 0x28, 0x62, 0x42, 0x3c, 0xf5, 0x98,
+	0x09, 0xe1, 0x89, 0x8b, 0xc8, 0x0b, 0x51, 0xfc, 0x9e, 0x04, 0xe3, 0xb7, 0xa8, 0x56, 0x5f, 0xf2,
+	0x87, 0x2d, 0x13, 0x07, 0xbd, 0x09, 0xfb, 0x03, 0x31, 0xc5, 0x24, 0x8e, 0xd8, 0x42, 0x2e, 0xba,
+	0x92, 0x1f, 0x6f, 0xe4, 0x8f, 0x78, 0x7c, 0x6c, 0xbd, 0x5e, 0xa0, 0xac, 0xd8, 0x50, 0x9d, 0xd5,
+	0xc2, 0x12, 0xa9, 0xa9, 0xda, 0xfa, 0x2c, 0xd1, 0x36, 0x37, 0xf2, 0x13, 0x5e, 0x28, 0x3b, 0x10,
+	0xb0, 0x3c, 0x62, 0x84, 0x35, 0x9c, 0x01, 0x10, 0x6f, 0x36, 0x9d, 0xdc, 0xe3, 0x7e, 0xda, 0x59,
+	0x3a, 0xb4, 0xb9, 0x91, 0x3f, 0xe0, 0xc9, 0xb6, 0xfb, 0xb0, 0x9c, 0x71, 0x1b, 0x8b, 0xfc, 0xf7,
+	0xdf, 0x25, 0x98, 0x0a, 0x0c, 0x9d, 0x25, 0x4d, 0x67, 0xf5, 0x35, 0xea, 0xac, 0xca, 0xaa, 0x59,
+	0x23, 0xa8, 0x0a, 0xe3, 0x6d, 0x8d, 0x6a, 0x23, 0x98, 0x5e, 0x03, 0x9a, 0x3d, 0x16, 0xb4, 0x67,
+	0x38, 0xa6, 0x6b, 0xb9, 0xc1, 0xee, 0x12, 0x4b, 0x71, 0xcd, 0xea, 0xb6, 0xbc, 0xdd, 0x87, 0xe5,
+	0x0c, 0x6f, 0xb8, 0xde, 0x75, 0xa5, 0x5a, 0xcd, 0xa6, 0x2f, 0xb5, 0x33, 0x2a, 0xd5, 0xee, 0xc3,
+	0x72, 0x86, 0x37, 0x5c, 0x29, 0xfc, 0x70, 0x08, 0x72, 0xe1, 0xc0, 0x2c, 0x9a, 0xb3, 0xd4, 0x22,
+	0x9a, 0x3b, 0x41, 0xfa, 0x59, 0x9c, 0xa8, 0x00, 0x7b, 0x1d, 0x56, 0x27, 0xa6, 0x42, 0xbd, 0xb9,
+	0x99, 0x29, 0x1d, 0xdc, 0xdc, 0xc8, 0x8f, 0x09, 0x9f, 0x8b, 0x1e, 0x2c, 0xef, 0xe1, 0x3f, 0x17,
+	0x4d, 0xd7, 0x6a, 0xdb, 0x51, 0x2d, 0xa7, 0x87, 0xd5, 0xed, 0x3e, 0x2c, 0x67, 0x78, 0x83, 0x73,
+	0x3d, 0x0f, 0x23, 0x2d, 0x9b, 0x28, 0x5a, 0x4b, 0xb0, 0xdd, 0x75, 0x5c, 0x3a, 0xb1, 0xb7, 0x34,
+	0xb5, 0xb9, 0x91, 0x3f, 0x28, 0xd8, 0x86, 0x7a, 0xb1, 0x0c, 0x2d, 0x9b, 0x94, 0x5b, 0x81, 0x9b,
+	0x2a, 0xac, 0x65, 0xea, 0x9e, 0xe0, 0xee, 0xa8, 0xc2, 0x76, 0x1f, 0x96, 0x33, 0xbc, 0x11, 0x56,
+	0x68, 0x32, 0x85, 0x3f, 0x9b, 0x1e, 0x8e, 0x53, 0xe8, 0xf7, 0x7a, 0x0a, 0x97, 0x59, 0x89, 0x37,
+	0xbe, 0xbf, 0x13, 0xf2, 0x3d, 0x3d, 0x2c, 0xd6, 0xd9, 0x6a, 0x78, 0x66, 0xe9, 0xee, 0xac, 0xf3,
+	0x77, 0x85, 0x73, 0x09, 0xb7, 0xe0, 0xe8, 0x02, 0x13, 0x6b, 0x70, 0xcc, 0xe8, 0x98, 0xcb, 0x36,
+	0x7a, 0x02, 0x46, 0xb4, 0x96, 0x65, 0x11, 0xd3, 0x09, 0xcd, 0x2e, 0x79, 0x9f, 0x78, 0xc6, 0xb9,
+	0x1a, 0x70, 0xc0, 0x1f, 0x12, 0x48, 0xf3, 0xc8, 0x64, 0x4a, 0x57, 0x93, 0xcd, 0xf3, 0x69, 0xcf,
+	0x27, 0x5d, 0x28, 0x58, 0x1e, 0x17, 0xcf, 0x02, 0x53, 0xd1, 0xdb, 0x12, 0x20, 0x7f, 0xa0, 0x7d,
+	0xc7, 0x72, 0x94, 0xa6, 0x45, 0x35, 0xc2, 0x23, 0x9a, 0x29, 0xdd, 0x12, 0xfa, 0x8a, 0x35, 0xea,
+	0xac, 0xb6, 0x2a, 0x05, 0x8d, 0x35, 0x8a, 0xc2, 0x1f, 0x27, 0x0d, 0xb5, 0x62, 0xfb, 0x0d, 0xfe,
+	0x97, 0x9b, 0x51, 0xa2, 0x35, 0xcf, 0x86, 0xc3, 0x9d, 0x36, 0xb4, 0xa1, 0xdb, 0x46, 0xbc, 0x72,
+	0xc7, 0x72, 0x56, 0xf8, 0xa3, 0x97, 0xe1, 0x68, 0x60, 0xd1, 0x8a, 0xb7, 0x32, 0xf8, 0x92, 0xef,
+	0xeb, 0xfd, 0xf4, 0x73, 0x09, 0x8e, 0xf5, 0x40, 0x13, 0xe1, 0xae, 0x40, 0xa6, 0xed, 0x59, 0x2f,
+	0xce, 0x57, 0x12, 0xc6, 0xb9, 0xc7, 0xde, 0xe4, 0x1f, 0x3f, 0x02, 0x01, 0x74, 0x01, 0x46, 0x2a,
+	0x2d, 0xad, 0x4e, 0x9c, 0x8e, 0x0d, 0x30, 0x34, 0x63, 0xc3, 0xbd, 0x58, 0xde, 0xe7, 0x35, 0xbd,
+	0x4d, 0xf0, 0x2b, 0x70, 0xac, 0x6c, 0xa8, 0xb4, 0xa1, 0x56, 0x0c, 0xf2, 0x0a, 0x3f, 0x53, 0xca,
+	0xe4, 0xae, 0x6a, 0xe9, 0xf6, 0xc0, 0x67, 0x8f, 0xef, 0x49, 0x90, 0xeb, 0x05, 0x2d, 0x9c, 0xf3,
+	0x75, 0x98, 0xd6, 0xfc, 0x11, 0xfe, 0x89, 0xd6, 0xf2, 0xc6, 0x08, 0x5f, 0x1d, 0xee, 0x78, 0xdb,
+	0xf9, 0x9e, 0x29, 0x33, 0x6a, 0x96, 0x9e, 0x76, 0xdd, 0xb0, 0xb9, 0x91, 0xcf, 0x8b, 0xe8, 0xf7,
+	0x00, 0xc2, 0xf2, 0xa4, 0x16, 0x6b, 0x05, 0xbe, 0x0d, 0xd9, 0xc0, 0xbe, 0x45, 0xff, 0x2e, 0x30,
+	0x38, 0xef, 0x77, 0x86, 0xe0, 0x48, 0x2c, 0xae, 0x20, 0x7d, 0x07, 0x26, 0xda, 0xb6, 0x06, 0x77,
+	0x90, 0x04, 0x84, 0x9f, 0x14, 0x84, 0x8f, 0x44, 0x09, 0xb7, 0x41, 0xb0, 0x7c, 0x50, 0xeb, 0x56,
+	0xed, 0xaa, 0xac, 0x32, 0xab, 0x4a, 0xa8, 0x43, 0xf4, 0xb0, 0xca, 0xa1, 0x94, 0x2a, 0xe3, 0x40,
+	0xb0, 0x7c, 0x30, 0x78, 0xdc, 0x56, 0x89, 0x97, 0xe0, 0x98, 0x7b, 0x94, 0x99, 0xd1, 0xb4, 0x56,
+	0xa3, 0x65, 0xa8, 0x0e, 0xb3, 0x22, 0xf3, 0x2a, 0xd5, 0x3a, 0xfb, 0xc5, 0x10, 0xe4, 0x7a, 0xc1,
+	0x09, 0xb7, 0xbe, 0x2b, 0xc1, 0x91, 0x8e, 0xc8, 0x2b, 0x35, 0x8b, 0xdd, 0x75, 0x56, 0x95, 0x9a,
+	0xc1, 0x2a, 0xaa, 0x21, 0xdc, 0x7b, 0x34, 0x96, 0xeb, 0x2c, 0xd1, 0x38, 0xdd, 0xe7, 0x5d, 0xba,
+	0xef, 0x3d, 0xcc, 0x3f, 0x13, 0xda, 0x83, 0xbc, 0xf1, 0xe2, 0xcf, 0x49, 0x5b, 0xaf, 0x17, 0x9d,
+	0xf5, 0x26, 0xb1, 0x7d, 0x19, 0x5b, 0x9e, 0xb6, 0x43, 0xb3, 0x6a, 0x81, 0xeb, 0x5c, 0xe0, 0x2a,
+	0xd1, 0x37, 0x24, 0x98, 0x68, 0x35, 0x1d, 0xda, 0x20, 0x11, 0x5b, 0x3c, 0xbf, 0x9f, 0x49, 0xb8,
+	0x0f, 0xdc, 0xe6, 0x10, 0xb7, 0x2c, 0x55, 0xab, 0x13, 0x2b, 0x1a, 0x92, 0x38, 0x7c, 0x2c, 0x23,
+	0xef, 0x71, 0xd8, 0x1a, 0xfc, 0x8e, 0x04, 0x39, 0x77, 0x7f, 0x0a, 0xf9, 0x50, 0x60, 0xf6, 0x15,
+	0x93, 0x3e, 0x0f, 0x5d, 0x9f, 0x0e, 0x41, 0xbe, 0xa7, 0x15, 0x22, 0x94, 0x1f, 0x48, 0x70, 0x3e,
+	0x36, 0x94, 0xac, 0xc9, 0xd7, 0x19, 0x51, 0x74, 0xff, 0xb5, 0xaa, 0xb0, 0xaa, 0x62, 0xa8, 0xb6,
+	0xa3, 0x38, 0x96, 0xba, 0x46, 0x2c, 0xfb, 0x7f, 0x19, 0xe8, 0xd3, 0xdd, 0x81, 0xbe, 0x21, 0x0c,
+	0x0a, 0x5e, 0xf3, 0x37, 0xaa, 0x4b, 0xaa, 0xed, 0xdc, 0xf2, 0x8d, 0x41, 0x0f, 0x60, 0x4c, 0x44,
+	0xc8, 0x11, 0x2c, 0x07, 0x0a, 0x7e, 0x4e, 0x04, 0x7f, 0xb2, 0x23, 0xf8, 0x3e, 0x34, 0x96, 0x47,
+	0x5b, 0xe1, 0xe1, 0x36, 0xfe, 0xa6, 0x04, 0x53, 0xc1, 0xa2, 0x94, 0x79, 0x96, 0xa3, 0xbf, 0x60,
+	0x6f, 0xd7, 0xd5, 0xe8, 0x43, 0x09, 0xa6, 0xbb, 0x0d, 0x12, 0x71, 0xa7, 0x70, 0x20, 0x9a, 0x93,
+	0xf1, 0xb7, 0xc5, 0x2f, 0x27, 0x74, 0x57, 0x04, 0x5b, 0xbc, 0x2b, 0xc7, 0x69, 0x44, 0xe5, 0xf6,
+	0xdd, 0xac, 0xde, 0x92, 0xe0, 0x99, 0xf2, 0xfc, 0xf5, 0xeb, 0xfc, 0xde, 0xa6, 0x2f, 0x51, 0xb3,
+	0x3e, 0x6f, 0xb1, 0x46, 0x39, 0x64, 0xa4, 0xd7, 0xe3, 0x7b, 0xfd, 0x26, 0x4c, 0x84, 0x19, 0x28,
+	0x9d, 0x21, 0xc8, 0x87, 0xb6, 0xf7, 0x98, 0x51, 0x58, 0x46, 0x5a, 0x17, 0x32, 0xa6, 0xf0, 0x6c,
+	0x32, 0x0b, 0x84, 0x9b, 0xcf, 0xc3, 0x88, 0x56, 0x6d, 0x34, 0x22, 0xaa, 0x43, 0xc7, 0x85, 0x70,
+	0x2f, 0x96, 0xc1, 0x6d, 0x0a, 0x55, 0xd7, 0xe1, 0x98, 0x9b, 0x63, 0xb9, 0x6d, 0x56, 0x98, 0xa9,
+	0x53, 0xb3, 0x36, 0x58, 0xa2, 0x08, 0xff, 0x40, 0x82, 0x5c, 0x2f, 0x3c, 0x61, 0xec, 0x5b, 0x12,
+	0x64, 0x83, 0x44, 0x8b, 0x72, 0x97, 0x3a, 0xab, 0x4a, 0x93, 0x58, 0x94, 0xe9, 0x8a, 0xc1, 0xb4,
+	0xba, 0x98, 0x1d, 0x97, 0x13, 0xce, 0x0e, 0x1f, 0xde, 0x3d, 0x4b, 0xad, 0x70, 0x94, 0x25, 0xa6,
+	0xd5, 0xc5, 0x24, 0x99, 0x0a, 0xd4, 0x74, 0x76, 0xe3, 0x2c, 0x4c, 0x2f, 0x10, 0xe7, 0x16, 0x73,
+	0x54, 0x23, 0x38, 0x92, 0xf9, 0xf7, 0xe8, 0x6f, 0x4b, 0x70, 0x38, 0xa6, 0x53, 0x18, 0xef, 0xc0,
+	0x98, 0xe3, 0xf6, 0x28, 0xd1, 0x23, 0xe0, 0x16, 0xaf, 0xdc, 0xe7, 0xc4, 0xd6, 0x74, 0x22, 0xc1,
+	0xd6, 0xe4, 0xed, 0x4b, 0xa3, 0x4e, 0x87, 0x76, 0xbc, 0x29, 0x41, 0x6e, 0xb9, 0xd5, 0x58, 0x26,
+	0xf7, 0x9c, 0x45, 0x93, 0x3a, 0x54, 0x35, 0xe8, 0xd7, 0x08, 0xbf, 0xdb, 0xf4, 0xb7, 0xf6, 0xaf,
+	0xc2, 0xa8, 0x7f, 0x9b, 0x53, 0x74, 0x62, 0xb2, 0x86, 0xb8, 0xed, 0x85, 0x12, 0x2d, 0x9d, 0xfd,
+	0x58, 0x1e, 0x11, 0x77, 0xbe, 0x59, 0xb7, 0x89, 0x2a, 0x90, 0x35, 0x5b, 0x0d, 0xc5, 0x24, 0xf7,
+	0xdc, 0x33, 0x68, 0x60, 0x11, 0xbf, 0x95, 0xd8, 0xfc, 0xba, 0xb1, 0xab, 0xf4, 0xd4, 0xe6, 0x46,
+	0xfe, 0x09, 0x0f, 0xac, 0xf7, 0x58, 0x2c, 0x4f, 0x99, 0xf1, 0xc4, 0xf0, 0x77, 0x87, 0x20, 0xdf,
+	0x93, 0xf4, 0xff, 0xfd, 0xd5, 0x0b, 0x2f, 0xc0, 0xa1, 0x25, 0xda, 0xa0, 0xce, 0x0d, 0x37, 0x5f,
+	0x1d, 0x4e, 0x2d, 0x16, 0x60, 0x2f, 0xcf, 0x61, 0xb7, 0xa7, 0x42, 0xe8, 0x12, 0xef, 0xf7, 0x60,
+	0x79, 0x0f, 0xff, 0xb9, 0xa8, 0x63, 0x07, 0x26, 0xa3, 0x40, 0xc2, 0xbb, 0xaf, 0xc3, 0x6e, 0x3e,
+	0x48, 0xe4, 0x8f, 0xae, 0xa4, 0x48, 0x28, 0x86, 0x10, 0x23, 0x29, 0x45, 0x0f, 0x12, 0xff, 0x4c,
+	0x82, 0x49, 0x77, 0xa3, 0x68, 0x0f, 0xfc, 0x3c, 0xa5, 0xa6, 0xdf, 0x97, 0x60, 0xaa, 0xcb, 0x7a,
+	0xe1, 0xb5, 0x37, 0x60, 0x98, 0x53, 0xb4, 0x53, 0x5e, 0x0e, 0xb7, 0x76, 0x9b, 0xc0, 0xdc, 0xbe,
+	0xd7, 0xdc, 0xfb, 0x12, 0x1c, 0x71, 0x29, 0x5c, 0xf7, 0x8a, 0x17, 0x9f, 0xc7, 0x02, 0xc1, 0x43,
+	0x09, 0x8e, 0xc6, 0x53, 0x10, 0xa1, 0x58, 0x83, 0x03, 0xd1, 0xda, 0x8c, 0x1f, 0x95, 0x72, 0x8a,
+	0xa8, 0x44, 0xf0, 0xa3, 0xa1, 0x19, 0x6f, 0x44, 0xf4, 0x6f, 0x5f, 0x90, 0x5e, 0x86, 0xa3, 0x73,
+	0xd5, 0x2a, 0xd1, 0xdc, 0x83, 0x8e, 0x77, 0xd5, 0x9d, 0xe7, 0xe5, 0xa1, 0xbe, 0xae, 0x5c, 0xbf,
+	0x19, 0x82, 0x63, 0x3d, 0xd0, 0x84, 0xbf, 0x1e, 0xc0, 0x14, 0xf1, 0x07, 0x74, 0xd6, 0xa3, 0xc4,
+	0x1c, 0x98, 0x4b, 0xb6, 0x8f, 0xe5, 0x3c, 0x0b, 0x7a, 0x60, 0x61, 0xf9, 0x10, 0x89, 0x33, 0x03,
+	0xbd, 0x27, 0xc1, 0xd1, 0xd8, 0x6a, 0x98, 0xa2, 0x31, 0xb3, 0x4a, 0x6b, 0xc2, 0x93, 0xd7, 0x12,
+	0x86, 0x6e, 0xd6, 0x83, 0x0a, 0xab, 0x28, 0x73, 0x9c, 0xd2, 0xd3, 0x9b, 0x1b, 0xf9, 0x27, 0x3d,
+	0x1b, 0xb7, 0xd2, 0x87, 0xe5, 0xc3, 0x7a, 0x2f, 0x0c, 0x7c, 0x13, 0x26, 0xfd, 0x80, 0xbf, 0x48,
+	0x6d, 0x87, 0x59, 0xeb, 0x03, 0xe7, 0x19, 0xfe, 0x33, 0x0c, 0x53, 0x5d, 0x98, 0x22, 0x34, 0x2a,
+	0xec, 0x6b, 0x17, 0xf7, 0xfc, 0x49, 0x7c, 0x3e, 0xe5, 0x29, 0xa9, 0x1c, 0x20, 0x88, 0xa9, 0x1b,
+	0xc6, 0x44, 0xe7, 0x60, 0x58, 0xb5, 0x6d, 0xe2, 0x3c, 0x27, 0xfc, 0xbc, 0xc5, 0x91, 0x46, 0xec,
+	0x49, 0xde, 0xf0, 0x40, 0xf0, 0xd4, 0xf4, 0xce, 0x34, 0x82, 0xa7, 0xd0, 0x03, 0x98, 0xf0, 0x4e,
+	0x53, 0x91, 0x4c, 0xd1, 0xae, 0xed, 0x3f, 0x52, 0x21, 0xae, 0xa8, 0x23, 0x5d, 0x84, 0xd6, 0x60,
+	0xdc, 0x53, 0x1f, 0x4a, 0xa0, 0xec, 0xde, 0x7e, 0xd5, 0xde, 0x89, 0x31, 0x94, 0xbc, 0xd1, 0x42,
+	0x75, 0xae, 0x35, 0xd5, 0x68, 0x11, 0x9e, 0x91, 0xce, 0x94, 0x2e, 0x25, 0x5b, 0x5d, 0xd1, 0x52,
+	0x18, 0x87, 0x08, 0x95, 0xc2, 0x5e, 0x75, 0xdb, 0xe8, 0x35, 0x80, 0x55, 0x66, 0xe8, 0x42, 0xc1,
+	0x1e, 0xae, 0xe0, 0x85, 0x64, 0x0a, 0xc4, 0x7d, 0xbf, 0x2d, 0x8e, 0xe5, 0x8c, 0xdb, 0xf0, 0x80,
+	0x29, 0x8c, 0xd3, 0x46, 0x93, 0x58, 0x0d, 0xd5, 0xe4, 0x87, 0x14, 0x66, 0xdb, 0xd3, 0x7b, 0x39,
+	0xfc, 0x95, 0x64, 0xf0, 0x53, 0x1e, 0x7c, 0x14, 0x04, 0xcb, 0x63, 0xa1, 0x47, 0x4b, 0xcc, 0xb6,
+	0x51, 0x1d, 0x0e, 0x74, 0x66, 0x0d, 0xd4, 0xa6, 0x35, 0x9d, 0xe9, 0xe3, 0x44, 0xd5, 0x85, 0x82,
+	0xe5, 0xb1, 0xf0, 0xdd, 0x7f, 0xa6, 0x69, 0x9d, 0xfe, 0xec, 0x49, 0xd8, 0x7d, 0xd3, 0xdd, 0x96,
+	0xd1, 0x0f, 0x25, 0xe0, 0x55, 0x3b, 0x1b, 0x3d, 0x9f, 0x78, 0x81, 0xb5, 0x8b, 0x8e, 0xd9, 0x33,
+	0xe9, 0x84, 0xbc, 0x05, 0x8e, 0xcf, 0xbc, 0xfd, 0xbb, 0xbf, 0x7c, 0x67, 0xa8, 0x80, 0x9e, 0x2d,
+	0x26, 0xad, 0xf9, 0xbb, 0x06, 0xfe, 0x48, 0x82, 0x61, 0xaf, 0x6e, 0x87, 0x12, 0xab, 0x0d, 0x97,
+	0x0d, 0xb3, 0x67, 0x53, 0x4a, 0x09, 0x6b, 0xcf, 0x72, 0x6b, 0x8b, 0xe8, 0x64, 0x52, 0x6b, 0x3d,
+	0x1b, 0x3f, 0x94, 0x60, 0x7f, 0x47, 0x49, 0x1f, 0x5d, 0x4c, 0x9a, 0x35, 0x89, 0xf9, 0x88, 0x21,
+	0x7b, 0xa9, 0x3f, 0x61, 0xc1, 0xa1, 0xc4, 0x39, 0x5c, 0x42, 0x17, 0x8a, 0xe9, 0xbe, 0xb2, 0xb0,
+	0x8b, 0xf7, 0xc5, 0xb1, 0xe7, 0x01, 0xfa, 0x54, 0x72, 0x8f, 0xe1, 0x31, 0xe5, 0x02, 0x54, 0x4e,
+	0x5b, 0x13, 0x88, 0x29, 0x5d, 0x64, 0x67, 0x07, 0x03, 0x11, 0x44, 0x17, 0x38, 0xd1, 0x19, 0x74,
+	0xb5, 0x98, 0xf4, 0x43, 0x18, 0xf1, 0x44, 0xf1, 0xab, 0x8e, 0x8a, 0xc5, 0x39, 0xfd, 0x33, 0x5c,
+	0x5f, 0xed, 0xac, 0x86, 0xa1, 0xb9, 0xb4, 0xa6, 0xc6, 0xd6, 0x2b, 0xb3, 0xf3, 0x83, 0xc2, 0x08,
+	0xce, 0x8b, 0x9c, 0x73, 0x19, 0xcd, 0xa4, 0xe6, 0x6c, 0xf2, 0xba, 0x4a, 0x3b, 0x21, 0x89, 0x3e,
+	0x93, 0x60, 0x32, 0xbe, 0xec, 0x81, 0x92, 0xc6, 0x67, 0xcb, 0x82, 0x4c, 0x76, 0x6e, 0x40, 0x94,
+	0x3e, 0xc3, 0xdc, 0xab, 0xbe, 0x82, 0xfe, 0x2c, 0xc1, 0xc1, 0x98, 0x7a, 0x07, 0x9a, 0x49, 0x6b,
+	0x67, 0x57, 0x0d, 0x26, 0x5b, 0x1a, 0x04, 0x42, 0xf0, 0x2c, 0x73, 0x9e, 0x97, 0xd1, 0xc5, 0xd4,
+	0x3c, 0xdb, 0xef, 0x79, 0xf4, 0x2b, 0xc9, 0xfd, 0x54, 0xa4, 0xfd, 0x21, 0x0d, 0xba, 0x90, 0xf2,
+	0x2c, 0x15, 0xba, 0x72, 0x67, 0x2f, 0xf6, 0x25, 0x2b, 0xe8, 0x5c, 0xe6, 0x74, 0xce, 0xa1, 0xb3,
+	0x29, 0xb7, 0x21, 0xa5, 0xb2, 0xae, 0x50, 0x1d, 0xfd, 0x55, 0x82, 0xc9, 0xf8, 0x42, 0x4a, 0xe2,
+	0xd9, 0xb9, 0x65, 0x59, 0x27, 0x3b, 0x37, 0x20, 0x8a, 0xa0, 0x39, 0xc3, 0x69, 0x5e, 0x44, 0xe7,
+	0x53, 0xbc, 0xdf, 0x14, 0xd5, 0xc5, 0x0b, 0xe6, 0xe5, 0xef, 0x25, 0x18, 0x8f, 0xa6, 0x9a, 0xd1,
+	0x95, 0xfe, 0xf2, 0xc8, 0x01, 0xbd, 0xab, 0x7d, 0xcb, 0x0b, 0x62, 0xd7, 0x38, 0xb1, 0x0b, 0xe8,
+	0x85, 0x62, 0x7f, 0x1f, 0x29, 0xda, 0xe8, 0x6f, 0x12, 0x4c, 0xf5, 0xa8, 0xa0, 0x24, 0xde, 0x56,
+	0xb7, 0xae, 0x03, 0x65, 0xe7, 0x07, 0x85, 0xe9, 0xf3, 0x9d, 0xc9, 0x5f, 0x1e, 0x5e, 0x14, 0xfd,
+	0x9a, 0x06, 0xfa, 0xe9, 0x10, 0x7c, 0x21, 0x49, 0x7a, 0x1b, 0xc9, 0x49, 0x37, 0x8b, 0xe4, 0xd9,
+	0xfa, 0xec, 0x2b, 0xdb, 0x8a, 0x29, 0xbc, 0x42, 0xb9, 0x57, 0x34, 0xa4, 0x26, 0xdd, 0x91, 0x42,
+	0xe9, 0x78, 0xc5, 0xa0, 0x66, 0x5d, 0xa9, 0x5a, 0xac, 0xa1, 0x84, 0x85, 0x8a, 0xf7, 0xe3, 0xca,
+	0x05, 0x0f, 0xd0, 0xbf, 0x45, 0xde, 0xac, 0x3b, 0xc1, 0x9e, 0x78, 0xb9, 0x6f, 0x99, 0xef, 0xcf,
+	0xce, 0x0d, 0x88, 0x22, 0x5c, 0x72, 0x93, 0xbb, 0xe4, 0x65, 0xb4, 0x98, 0xd0, 0x25, 0x2d, 0x9b,
+	0x58, 0x4a, 0xcb, 0xc7, 0x53, 0xe2, 0xce, 0x5a, 0x1f, 0x4b, 0x70, 0xa0, 0x2b, 0x33, 0x8f, 0x92,
+	0xae, 0xdf, 0x5e, 0x09, 0xff, 0xec, 0xb5, 0xfe, 0x01, 0xfa, 0x5c, 0x14, 0x35, 0xe2, 0x28, 0x91,
+	0x2a, 0x02, 0x3f, 0x5a, 0xf5, 0xc8, 0x76, 0x27, 0xde, 0x03, 0xb6, 0x2e, 0x11, 0x64, 0xe7, 0x07,
+	0x85, 0xe9, 0xf3, 0x68, 0xd5, 0x3b, 0xfb, 0x8f, 0x7e, 0x2b, 0xc1, 0x68, 0x67, 0xf2, 0x19, 0x5d,
+	0x4a, 0x7c, 0x00, 0x8c, 0x49, 0x7e, 0x67, 0x2f, 0xf7, 0x29, 0xdd, 0xe7, 0x5e, 0x1e, 0xfa, 0x64,
+	0x5c, 0xbc, 0x8e, 0xff, 0x28, 0xc1, 0x58, 0x24, 0x33, 0x8c, 0x2e, 0xa7, 0x58, 0x52, 0xdd, 0xf9,
+	0xf0, 0xec, 0x95, 0x7e, 0xc5, 0x05, 0xa9, 0x97, 0x38, 0xa9, 0x59, 0x54, 0x4a, 0xb3, 0x14, 0x43,
+	0xcc, 0xc2, 0x6b, 0xf0, 0x1f, 0xe2, 0x7b, 0xf2, 0x68, 0xca, 0x15, 0x95, 0x52, 0x18, 0xd9, 0x23,
+	0xe5, 0x9c, 0x2d, 0x0f, 0x84, 0x21, 0xd8, 0xde, 0xe0, 0x6c, 0x17, 0xd1, 0x42, 0x1a, 0xb6, 0x5d,
+	0x59, 0xe2, 0x10, 0xe5, 0x7f, 0x49, 0x70, 0x28, 0x36, 0x6d, 0x9a, 0xf8, 0x8a, 0xb7, 0x55, 0x0a,
+	0x37, 0x3b, 0x3b, 0x18, 0x88, 0x60, 0xbd, 0xc2, 0x59, 0xbf, 0x84, 0x5e, 0x4c, 0xc8, 0xba, 0x47,
+	0x6a, 0xb6, 0x78, 0x3f, 0x78, 0xd1, 0x3c, 0x94, 0x60, 0x2c, 0x92, 0x8c, 0x44, 0x69, 0xab, 0xb2,
+	0x9d, 0x89, 0xd1, 0xec, 0x95, 0x7e, 0xc5, 0x05, 0xc9, 0xeb, 0x9c, 0xe4, 0x02, 0x9a, 0x4b, 0x7b,
+	0x52, 0x5e, 0xf5, 0x80, 0x8a, 0xf7, 0x83, 0x27, 0x54, 0x7f, 0x50, 0x5a, 0xfd, 0xe0, 0x93, 0x9c,
+	0xf4, 0xd1, 0x27, 0x39, 0xe9, 0x4f, 0x9f, 0xe4, 0xa4, 0x77, 0x1f, 0xe5, 0x76, 0x7c, 0xf4, 0x28,
+	0xb7, 0xe3, 0x0f, 0x8f, 0x72, 0x3b, 0x5e, 0x5f, 0x7e, 0xdc, 0x17, 0x8b, 0x6b, 0xa7, 0xcf, 0x14,
+	0xef, 0x75, 0x68, 0x3f, 0xd9, 0x56, 0xaf, 0x19, 0x94, 0x98, 0x8e, 0xf7, 0xcf, 0x35, 0xde, 0xe7,
+	0xe0, 0xc3, 0xfc, 0xcf, 0xf3, 0xff, 0x1d, 0x00, 0x97, 0x78, 0x45, 0x97, 0x70, 0x34, 0x00, 0x00,
 }
 
-func (m *UserPositionsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Pools returns all concentrated liquidity pools
+	Pools(ctx context.Context, in *PoolsRequest, opts ...grpc.CallOption) (*PoolsResponse, error)
+	// Params returns concentrated liquidity module params.
+	Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error)
+	// UserPositions returns all concentrated positions of some address.
+	UserPositions(ctx context.Context, in *UserPositionsRequest, opts ...grpc.CallOption) (*UserPositionsResponse, error)
+	// LiquidityPerTickRange returns the amount of liquidity per every tick range
+	// existing within the given pool
+	LiquidityPerTickRange(ctx context.Context, in *LiquidityPerTickRangeRequest, opts ...grpc.CallOption) (*LiquidityPerTickRangeResponse, error)
+	// LiquidityNetInDirection returns liquidity net in the direction given.
+	// Uses the bound if specified, if not uses either min tick / max tick
+	// depending on the direction.
+	LiquidityNetInDirection(ctx context.Context, in *LiquidityNetInDirectionRequest, opts ...grpc.CallOption) (*LiquidityNetInDirectionResponse, error)
+	// ClaimableSpreadRewards returns the amount of spread rewards that can be
+	// claimed by a position with the given id.
+	ClaimableSpreadRewards(ctx context.Context, in *ClaimableSpreadRewardsRequest, opts ...grpc.CallOption) (*ClaimableSpreadRewardsResponse, error)
+	// ClaimableIncentives returns the amount of incentives that can be claimed
+	// and how many would be forfeited by a position with the given id.
+	ClaimableIncentives(ctx context.Context, in *ClaimableIncentivesRequest, opts ...grpc.CallOption) (*ClaimableIncentivesResponse, error)
+	// PositionById returns a position with the given id.
+	PositionById(ctx context.Context, in *PositionByIdRequest, opts ...grpc.CallOption) (*PositionByIdResponse, error)
+	// PoolAccumulatorRewards returns the pool-global accumulator rewards.
+	// Contains spread factor rewards and uptime rewards.
+	PoolAccumulatorRewards(ctx context.Context, in *PoolAccumulatorRewardsRequest, opts ...grpc.CallOption) (*PoolAccumulatorRewardsResponse, error)
+	// IncentiveRecords returns the incentive records for a given poolId
+	IncentiveRecords(ctx context.Context, in *IncentiveRecordsRequest, opts ...grpc.CallOption) (*IncentiveRecordsResponse, error)
+	// TickAccumulatorTrackers returns the tick accumulator trackers.
+	// Contains spread factor and uptime accumulator trackers.
+	TickAccumulatorTrackers(ctx context.Context, in *TickAccumulatorTrackersRequest, opts ...grpc.CallOption) (*TickAccumulatorTrackersResponse, error)
+	// CFMMPoolIdLinkFromConcentratedPoolId returns the pool id of the CFMM
+	// pool that is linked with the given concentrated pool.
+	CFMMPoolIdLinkFromConcentratedPoolId(ctx context.Context, in *CFMMPoolIdLinkFromConcentratedPoolIdRequest, opts ...grpc.CallOption) (*CFMMPoolIdLinkFromConcentratedPoolIdResponse, error)
+	// UserUnbondingPositions returns the position and lock info of unbonding
+	// positions of the given address.
+	UserUnbondingPositions(ctx context.Context, in *UserUnbondingPositionsRequest, opts ...grpc.CallOption) (*UserUnbondingPositionsResponse, error)
+	// GetTotalLiquidity returns total liquidity across all cl pools.
+	GetTotalLiquidity(ctx context.Context, in *GetTotalLiquidityRequest, opts ...grpc.CallOption) (*GetTotalLiquidityResponse, error)
+	// NumNextInitializedTicks returns the provided number of next initialized
+	// ticks in the direction of swapping the token in denom.
+	NumNextInitializedTicks(ctx context.Context, in *NumNextInitializedTicksRequest, opts ...grpc.CallOption) (*NumNextInitializedTicksResponse, error)
+	// LimitOrderById returns the limit order with the given id together with
+	// the amounts it currently translates to.
+	LimitOrderById(ctx context.Context, in *LimitOrderByIdRequest, opts ...grpc.CallOption) (*LimitOrderByIdResponse, error)
+	// UserLimitOrders returns the limit orders placed by the given address,
+	// optionally filtered by pool id.
+	UserLimitOrders(ctx context.Context, in *UserLimitOrdersRequest, opts ...grpc.CallOption) (*UserLimitOrdersResponse, error)
+	// UserManagedPositions returns the managed positions of the given address,
+	// optionally filtered by pool id.
+	UserManagedPositions(ctx context.Context, in *UserManagedPositionsRequest, opts ...grpc.CallOption) (*UserManagedPositionsResponse, error)
+	// EffectiveSpreadFactor returns the spread factor currently charged on swaps
+	// in the given pool, together with its dynamic spread factor config if any.
+	EffectiveSpreadFactor(ctx context.Context, in *EffectiveSpreadFactorRequest, opts ...grpc.CallOption) (*EffectiveSpreadFactorResponse, error)
+	// PositionHistory returns the checkpoints of the given position together
+	// with its current value, the spread rewards and incentives accrued since
+	// its creation, its impermanent loss compared with holding the deposited
+	// assets, and its spread reward APR.
+	PositionHistory(ctx context.Context, in *PositionHistoryRequest, opts ...grpc.CallOption) (*PositionHistoryResponse, error)
 }
 
-func (m *UserPositionsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type queryClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *UserPositionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
 }
 
-func (m *UserPositionsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Pools(ctx context.Context, in *PoolsRequest, opts ...grpc.CallOption) (*PoolsResponse, error) {
+	out := new(PoolsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/Pools", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *UserPositionsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error) {
+	out := new(ParamsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *UserPositionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func (c *queryClient) UserPositions(ctx context.Context, in *UserPositionsRequest, opts ...grpc.CallOption) (*UserPositionsResponse, error) {
+	out := new(UserPositionsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/UserPositions", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Positions) > 0 {
-		for iNdEx := len(m.Positions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Positions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	return out, nil
+}
+
+func (c *queryClient) LiquidityPerTickRange(ctx context.Context, in *LiquidityPerTickRangeRequest, opts ...grpc.CallOption) (*LiquidityPerTickRangeResponse, error) {
+	out := new(LiquidityPerTickRangeResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/LiquidityPerTickRange", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *PositionByIdRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) LiquidityNetInDirection(ctx context.Context, in *LiquidityNetInDirectionRequest, opts ...grpc.CallOption) (*LiquidityNetInDirectionResponse, error) {
+	out := new(LiquidityNetInDirectionResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/LiquidityNetInDirection", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *PositionByIdRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ClaimableSpreadRewards(ctx context.Context, in *ClaimableSpreadRewardsRequest, opts ...grpc.CallOption) (*ClaimableSpreadRewardsResponse, error) {
+	out := new(ClaimableSpreadRewardsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/ClaimableSpreadRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *PositionByIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PositionId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PositionId))
-		i--
-		dAtA[i] = 0x8
+func (c *queryClient) ClaimableIncentives(ctx context.Context, in *ClaimableIncentivesRequest, opts ...grpc.CallOption) (*ClaimableIncentivesResponse, error) {
+	out := new(ClaimableIncentivesResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/ClaimableIncentives", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *PositionByIdResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) PositionById(ctx context.Context, in *PositionByIdRequest, opts ...grpc.CallOption) (*PositionByIdResponse, error) {
+	out := new(PositionByIdResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/PositionById", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *PositionByIdResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) PoolAccumulatorRewards(ctx context.Context, in *PoolAccumulatorRewardsRequest, opts ...grpc.CallOption) (*PoolAccumulatorRewardsResponse, error) {
+	out := new(PoolAccumulatorRewardsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/PoolAccumulatorRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *PositionByIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Position.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *NumPoolPositionsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) IncentiveRecords(ctx context.Context, in *IncentiveRecordsRequest, opts ...grpc.CallOption) (*IncentiveRecordsResponse, error) {
+	out := new(IncentiveRecordsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/IncentiveRecords", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *NumPoolPositionsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *NumPoolPositionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *NumPoolPositionsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) TickAccumulatorTrackers(ctx context.Context, in *TickAccumulatorTrackersRequest, opts ...grpc.CallOption) (*TickAccumulatorTrackersResponse, error) {
+	out := new(TickAccumulatorTrackersResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/TickAccumulatorTrackers", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *NumPoolPositionsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *NumPoolPositionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PositionCount != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PositionCount))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *PoolsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) CFMMPoolIdLinkFromConcentratedPoolId(ctx context.Context, in *CFMMPoolIdLinkFromConcentratedPoolIdRequest, opts ...grpc.CallOption) (*CFMMPoolIdLinkFromConcentratedPoolIdResponse, error) {
+	out := new(CFMMPoolIdLinkFromConcentratedPoolIdResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/CFMMPoolIdLinkFromConcentratedPoolId", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *PoolsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *PoolsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *PoolsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) UserUnbondingPositions(ctx context.Context, in *UserUnbondingPositionsRequest, opts ...grpc.CallOption) (*UserUnbondingPositionsResponse, error) {
+	out := new(UserUnbondingPositionsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/UserUnbondingPositions", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *PoolsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *PoolsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Pools) > 0 {
-		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) GetTotalLiquidity(ctx context.Context, in *GetTotalLiquidityRequest, opts ...grpc.CallOption) (*GetTotalLiquidityResponse, error) {
+	out := new(GetTotalLiquidityResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/GetTotalLiquidity", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *ParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) NumNextInitializedTicks(ctx context.Context, in *NumNextInitializedTicksRequest, opts ...grpc.CallOption) (*NumNextInitializedTicksResponse, error) {
+	out := new(NumNextInitializedTicksResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/NumNextInitializedTicks", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *ParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) LimitOrderById(ctx context.Context, in *LimitOrderByIdRequest, opts ...grpc.CallOption) (*LimitOrderByIdResponse, error) {
+	out := new(LimitOrderByIdResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/LimitOrderById", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *ParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) UserLimitOrders(ctx context.Context, in *UserLimitOrdersRequest, opts ...grpc.CallOption) (*UserLimitOrdersResponse, error) {
+	out := new(UserLimitOrdersResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/UserLimitOrders", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *TickLiquidityNet) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) UserManagedPositions(ctx context.Context, in *UserManagedPositionsRequest, opts ...grpc.CallOption) (*UserManagedPositionsResponse, error) {
+	out := new(UserManagedPositionsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/UserManagedPositions", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *TickLiquidityNet) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *TickLiquidityNet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.TickIndex != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.TickIndex))
-		i--
-		dAtA[i] = 0x10
-	}
-	{
-		size := m.LiquidityNet.Size()
-		i -= size
-		if _, err := m.LiquidityNet.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) EffectiveSpreadFactor(ctx context.Context, in *EffectiveSpreadFactorRequest, opts ...grpc.CallOption) (*EffectiveSpreadFactorResponse, error) {
+	out := new(EffectiveSpreadFactorResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/EffectiveSpreadFactor", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *LiquidityDepthWithRange) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) PositionHistory(ctx context.Context, in *PositionHistoryRequest, opts ...grpc.CallOption) (*PositionHistoryResponse, error) {
+	out := new(PositionHistoryResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/PositionHistory", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *LiquidityDepthWithRange) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *LiquidityDepthWithRange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.UpperTick != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.UpperTick))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.LowerTick != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.LowerTick))
-		i--
-		dAtA[i] = 0x10
-	}
-	{
-		size := m.LiquidityAmount.Size()
-		i -= size
-		if _, err := m.LiquidityAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Pools returns all concentrated liquidity pools
+	Pools(context.Context, *PoolsRequest) (*PoolsResponse, error)
+	// Params returns concentrated liquidity module params.
+	Params(context.Context, *ParamsRequest) (*ParamsResponse, error)
+	// UserPositions returns all concentrated positions of some address.
+	UserPositions(context.Context, *UserPositionsRequest) (*UserPositionsResponse, error)
+	// LiquidityPerTickRange returns the amount of liquidity per every tick range
+	// existing within the given pool
+	LiquidityPerTickRange(context.Context, *LiquidityPerTickRangeRequest) (*LiquidityPerTickRangeResponse, error)
+	// LiquidityNetInDirection returns liquidity net in the direction given.
+	// Uses the bound if specified, if not uses either min tick / max tick
+	// depending on the direction.
+	LiquidityNetInDirection(context.Context, *LiquidityNetInDirectionRequest) (*LiquidityNetInDirectionResponse, error)
+	// ClaimableSpreadRewards returns the amount of spread rewards that can be
+	// claimed by a position with the given id.
+	ClaimableSpreadRewards(context.Context, *ClaimableSpreadRewardsRequest) (*ClaimableSpreadRewardsResponse, error)
+	// ClaimableIncentives returns the amount of incentives that can be claimed
+	// and how many would be forfeited by a position with the given id.
+	ClaimableIncentives(context.Context, *ClaimableIncentivesRequest) (*ClaimableIncentivesResponse, error)
+	// PositionById returns a position with the given id.
+	PositionById(context.Context, *PositionByIdRequest) (*PositionByIdResponse, error)
+	// PoolAccumulatorRewards returns the pool-global accumulator rewards.
+	// Contains spread factor rewards and uptime rewards.
+	PoolAccumulatorRewards(context.Context, *PoolAccumulatorRewardsRequest) (*PoolAccumulatorRewardsResponse, error)
+	// IncentiveRecords returns the incentive records for a given poolId
+	IncentiveRecords(context.Context, *IncentiveRecordsRequest) (*IncentiveRecordsResponse, error)
+	// TickAccumulatorTrackers returns the tick accumulator trackers.
+	// Contains spread factor and uptime accumulator trackers.
+	TickAccumulatorTrackers(context.Context, *TickAccumulatorTrackersRequest) (*TickAccumulatorTrackersResponse, error)
+	// CFMMPoolIdLinkFromConcentratedPoolId returns the pool id of the CFMM
+	// pool that is linked with the given concentrated pool.
+	CFMMPoolIdLinkFromConcentratedPoolId(context.Context, *CFMMPoolIdLinkFromConcentratedPoolIdRequest) (*CFMMPoolIdLinkFromConcentratedPoolIdResponse, error)
+	// UserUnbondingPositions returns the position and lock info of unbonding
+	// positions of the given address.
+	UserUnbondingPositions(context.Context, *UserUnbondingPositionsRequest) (*UserUnbondingPositionsResponse, error)
+	// GetTotalLiquidity returns total liquidity across all cl pools.
+	GetTotalLiquidity(context.Context, *GetTotalLiquidityRequest) (*GetTotalLiquidityResponse, error)
+	// NumNextInitializedTicks returns the provided number of next initialized
+	// ticks in the direction of swapping the token in denom.
+	NumNextInitializedTicks(context.Context, *NumNextInitializedTicksRequest) (*NumNextInitializedTicksResponse, error)
+	// LimitOrderById returns the limit order with the given id together with
+	// the amounts it currently translates to.
+	LimitOrderById(context.Context, *LimitOrderByIdRequest) (*LimitOrderByIdResponse, error)
+	// UserLimitOrders returns the limit orders placed by the given address,
+	// optionally filtered by pool id.
+	UserLimitOrders(context.Context, *UserLimitOrdersRequest) (*UserLimitOrdersResponse, error)
+	// UserManagedPositions returns the managed positions of the given address,
+	// optionally filtered by pool id.
+	UserManagedPositions(context.Context, *UserManagedPositionsRequest) (*UserManagedPositionsResponse, error)
+	// EffectiveSpreadFactor returns the spread factor currently charged on swaps
+	// in the given pool, together with its dynamic spread factor config if any.
+	EffectiveSpreadFactor(context.Context, *EffectiveSpreadFactorRequest) (*EffectiveSpreadFactorResponse, error)
+	// PositionHistory returns the checkpoints of the given position together
+	// with its current value, the spread rewards and incentives accrued since
+	// its creation, its impermanent loss compared with holding the deposited
+	// assets, and its spread reward APR.
+	PositionHistory(context.Context, *PositionHistoryRequest) (*PositionHistoryResponse, error)
 }
 
-func (m *LiquidityNetInDirectionRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *LiquidityNetInDirectionRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) Pools(ctx context.Context, req *PoolsRequest) (*PoolsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Pools not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *ParamsRequest) (*ParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) UserPositions(ctx context.Context, req *UserPositionsRequest) (*UserPositionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserPositions not implemented")
+}
+func (*UnimplementedQueryServer) LiquidityPerTickRange(ctx context.Context, req *LiquidityPerTickRangeRequest) (*LiquidityPerTickRangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LiquidityPerTickRange not implemented")
+}
+func (*UnimplementedQueryServer) LiquidityNetInDirection(ctx context.Context, req *LiquidityNetInDirectionRequest) (*LiquidityNetInDirectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LiquidityNetInDirection not implemented")
+}
+func (*UnimplementedQueryServer) ClaimableSpreadRewards(ctx context.Context, req *ClaimableSpreadRewardsRequest) (*ClaimableSpreadRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimableSpreadRewards not implemented")
+}
+func (*UnimplementedQueryServer) ClaimableIncentives(ctx context.Context, req *ClaimableIncentivesRequest) (*ClaimableIncentivesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimableIncentives not implemented")
+}
+func (*UnimplementedQueryServer) PositionById(ctx context.Context, req *PositionByIdRequest) (*PositionByIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PositionById not implemented")
+}
+func (*UnimplementedQueryServer) PoolAccumulatorRewards(ctx context.Context, req *PoolAccumulatorRewardsRequest) (*PoolAccumulatorRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolAccumulatorRewards not implemented")
+}
+func (*UnimplementedQueryServer) IncentiveRecords(ctx context.Context, req *IncentiveRecordsRequest) (*IncentiveRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IncentiveRecords not implemented")
+}
+func (*UnimplementedQueryServer) TickAccumulatorTrackers(ctx context.Context, req *TickAccumulatorTrackersRequest) (*TickAccumulatorTrackersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TickAccumulatorTrackers not implemented")
+}
+func (*UnimplementedQueryServer) CFMMPoolIdLinkFromConcentratedPoolId(ctx context.Context, req *CFMMPoolIdLinkFromConcentratedPoolIdRequest) (*CFMMPoolIdLinkFromConcentratedPoolIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CFMMPoolIdLinkFromConcentratedPoolId not implemented")
+}
+func (*UnimplementedQueryServer) UserUnbondingPositions(ctx context.Context, req *UserUnbondingPositionsRequest) (*UserUnbondingPositionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserUnbondingPositions not implemented")
+}
+func (*UnimplementedQueryServer) GetTotalLiquidity(ctx context.Context, req *GetTotalLiquidityRequest) (*GetTotalLiquidityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTotalLiquidity not implemented")
+}
+func (*UnimplementedQueryServer) NumNextInitializedTicks(ctx context.Context, req *NumNextInitializedTicksRequest) (*NumNextInitializedTicksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NumNextInitializedTicks not implemented")
+}
+func (*UnimplementedQueryServer) LimitOrderById(ctx context.Context, req *LimitOrderByIdRequest) (*LimitOrderByIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LimitOrderById not implemented")
+}
+func (*UnimplementedQueryServer) UserLimitOrders(ctx context.Context, req *UserLimitOrdersRequest) (*UserLimitOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserLimitOrders not implemented")
+}
+func (*UnimplementedQueryServer) UserManagedPositions(ctx context.Context, req *UserManagedPositionsRequest) (*UserManagedPositionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserManagedPositions not implemented")
+}
+func (*UnimplementedQueryServer) EffectiveSpreadFactor(ctx context.Context, req *EffectiveSpreadFactorRequest) (*EffectiveSpreadFactorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EffectiveSpreadFactor not implemented")
+}
+func (*UnimplementedQueryServer) PositionHistory(ctx context.Context, req *PositionHistoryRequest) (*PositionHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PositionHistory not implemented")
 }
 
-func (m *LiquidityNetInDirectionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.UseNoBound {
-		i--
-		if m.UseNoBound {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x30
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Pools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PoolsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.BoundTick != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.BoundTick))
-		i--
-		dAtA[i] = 0x28
+	if interceptor == nil {
+		return srv.(QueryServer).Pools(ctx, in)
 	}
-	if m.UseCurTick {
-		i--
-		if m.UseCurTick {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x20
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/Pools",
 	}
-	if m.StartTick != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.StartTick))
-		i--
-		dAtA[i] = 0x18
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Pools(ctx, req.(*PoolsRequest))
 	}
-	if len(m.TokenIn) > 0 {
-		i -= len(m.TokenIn)
-		copy(dAtA[i:], m.TokenIn)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenIn)))
-		i--
-		dAtA[i] = 0x12
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*ParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *LiquidityNetInDirectionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_UserPositions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserPositionsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).UserPositions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/UserPositions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UserPositions(ctx, req.(*UserPositionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *LiquidityNetInDirectionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_LiquidityPerTickRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LiquidityPerTickRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LiquidityPerTickRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/LiquidityPerTickRange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LiquidityPerTickRange(ctx, req.(*LiquidityPerTickRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *LiquidityNetInDirectionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.CurrentSqrtPrice.Size()
-		i -= size
-		if _, err := m.CurrentSqrtPrice.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func _Query_LiquidityNetInDirection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LiquidityNetInDirectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.CurrentLiquidity.Size()
-		i -= size
-		if _, err := m.CurrentLiquidity.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	if interceptor == nil {
+		return srv.(QueryServer).LiquidityNetInDirection(ctx, in)
 	}
-	i--
-	dAtA[i] = 0x1a
-	if m.CurrentTick != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.CurrentTick))
-		i--
-		dAtA[i] = 0x10
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/LiquidityNetInDirection",
 	}
-	if len(m.LiquidityDepths) > 0 {
-		for iNdEx := len(m.LiquidityDepths) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.LiquidityDepths[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LiquidityNetInDirection(ctx, req.(*LiquidityNetInDirectionRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *LiquidityPerTickRangeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_ClaimableSpreadRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClaimableSpreadRewardsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *LiquidityPerTickRangeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *LiquidityPerTickRangeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
+	if interceptor == nil {
+		return srv.(QueryServer).ClaimableSpreadRewards(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/ClaimableSpreadRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClaimableSpreadRewards(ctx, req.(*ClaimableSpreadRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *LiquidityPerTickRangeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_ClaimableIncentives_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClaimableIncentivesRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).ClaimableIncentives(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/ClaimableIncentives",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClaimableIncentives(ctx, req.(*ClaimableIncentivesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *LiquidityPerTickRangeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_PositionById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PositionByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PositionById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/PositionById",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PositionById(ctx, req.(*PositionByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *LiquidityPerTickRangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BucketIndex != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.BucketIndex))
-		i--
-		dAtA[i] = 0x10
+func _Query_PoolAccumulatorRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PoolAccumulatorRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Liquidity) > 0 {
-		for iNdEx := len(m.Liquidity) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Liquidity[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).PoolAccumulatorRewards(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/PoolAccumulatorRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoolAccumulatorRewards(ctx, req.(*PoolAccumulatorRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ClaimableSpreadRewardsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_IncentiveRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IncentiveRecordsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).IncentiveRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/IncentiveRecords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IncentiveRecords(ctx, req.(*IncentiveRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ClaimableSpreadRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_TickAccumulatorTrackers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TickAccumulatorTrackersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TickAccumulatorTrackers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/TickAccumulatorTrackers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TickAccumulatorTrackers(ctx, req.(*TickAccumulatorTrackersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ClaimableSpreadRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PositionId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PositionId))
-		i--
-		dAtA[i] = 0x8
+func _Query_CFMMPoolIdLinkFromConcentratedPoolId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CFMMPoolIdLinkFromConcentratedPoolIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).CFMMPoolIdLinkFromConcentratedPoolId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/CFMMPoolIdLinkFromConcentratedPoolId",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CFMMPoolIdLinkFromConcentratedPoolId(ctx, req.(*CFMMPoolIdLinkFromConcentratedPoolIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ClaimableSpreadRewardsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_UserUnbondingPositions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserUnbondingPositionsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).UserUnbondingPositions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/UserUnbondingPositions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UserUnbondingPositions(ctx, req.(*UserUnbondingPositionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ClaimableSpreadRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_GetTotalLiquidity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTotalLiquidityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetTotalLiquidity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/GetTotalLiquidity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetTotalLiquidity(ctx, req.(*GetTotalLiquidityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ClaimableSpreadRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ClaimableSpreadRewards) > 0 {
-		for iNdEx := len(m.ClaimableSpreadRewards) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ClaimableSpreadRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func _Query_NumNextInitializedTicks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NumNextInitializedTicksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).NumNextInitializedTicks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/NumNextInitializedTicks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NumNextInitializedTicks(ctx, req.(*NumNextInitializedTicksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ClaimableIncentivesRequest) Marshal() (dAtA []byte, err error) {
+func _Query_LimitOrderById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LimitOrderByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LimitOrderById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/LimitOrderById",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LimitOrderById(ctx, req.(*LimitOrderByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UserLimitOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserLimitOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UserLimitOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/UserLimitOrders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UserLimitOrders(ctx, req.(*UserLimitOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UserManagedPositions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserManagedPositionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UserManagedPositions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/UserManagedPositions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UserManagedPositions(ctx, req.(*UserManagedPositionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EffectiveSpreadFactor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EffectiveSpreadFactorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EffectiveSpreadFactor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/EffectiveSpreadFactor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EffectiveSpreadFactor(ctx, req.(*EffectiveSpreadFactorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PositionHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PositionHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PositionHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/PositionHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PositionHistory(ctx, req.(*PositionHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "osmosis.concentratedliquidity.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Pools",
+			Handler:    _Query_Pools_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "UserPositions",
+			Handler:    _Query_UserPositions_Handler,
+		},
+		{
+			MethodName: "LiquidityPerTickRange",
+			Handler:    _Query_LiquidityPerTickRange_Handler,
+		},
+		{
+			MethodName: "LiquidityNetInDirection",
+			Handler:    _Query_LiquidityNetInDirection_Handler,
+		},
+		{
+			MethodName: "ClaimableSpreadRewards",
+			Handler:    _Query_ClaimableSpreadRewards_Handler,
+		},
+		{
+			MethodName: "ClaimableIncentives",
+			Handler:    _Query_ClaimableIncentives_Handler,
+		},
+		{
+			MethodName: "PositionById",
+			Handler:    _Query_PositionById_Handler,
+		},
+		{
+			MethodName: "PoolAccumulatorRewards",
+			Handler:    _Query_PoolAccumulatorRewards_Handler,
+		},
+		{
+			MethodName: "IncentiveRecords",
+			Handler:    _Query_IncentiveRecords_Handler,
+		},
+		{
+			MethodName: "TickAccumulatorTrackers",
+			Handler:    _Query_TickAccumulatorTrackers_Handler,
+		},
+		{
+			MethodName: "CFMMPoolIdLinkFromConcentratedPoolId",
+			Handler:    _Query_CFMMPoolIdLinkFromConcentratedPoolId_Handler,
+		},
+		{
+			MethodName: "UserUnbondingPositions",
+			Handler:    _Query_UserUnbondingPositions_Handler,
+		},
+		{
+			MethodName: "GetTotalLiquidity",
+			Handler:    _Query_GetTotalLiquidity_Handler,
+		},
+		{
+			MethodName: "NumNextInitializedTicks",
+			Handler:    _Query_NumNextInitializedTicks_Handler,
+		},
+		{
+			MethodName: "LimitOrderById",
+			Handler:    _Query_LimitOrderById_Handler,
+		},
+		{
+			MethodName: "UserLimitOrders",
+			Handler:    _Query_UserLimitOrders_Handler,
+		},
+		{
+			MethodName: "UserManagedPositions",
+			Handler:    _Query_UserManagedPositions_Handler,
+		},
+		{
+			MethodName: "EffectiveSpreadFactor",
+			Handler:    _Query_EffectiveSpreadFactor_Handler,
+		},
+		{
+			MethodName: "PositionHistory",
+			Handler:    _Query_PositionHistory_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "osmosis/concentratedliquidity/v1beta1/query.proto",
+}
+
+func (m *UserPositionsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3250,25 +3351,44 @@ func (m *ClaimableIncentivesRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ClaimableIncentivesRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *UserPositionsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ClaimableIncentivesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UserPositionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.PositionId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PositionId))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x1a
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ClaimableIncentivesResponse) Marshal() (dAtA []byte, err error) {
+func (m *UserPositionsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3278,34 +3398,32 @@ func (m *ClaimableIncentivesResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ClaimableIncentivesResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *UserPositionsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ClaimableIncentivesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UserPositionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ForfeitedIncentives) > 0 {
-		for iNdEx := len(m.ForfeitedIncentives) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ForfeitedIncentives[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0x12
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.ClaimableIncentives) > 0 {
-		for iNdEx := len(m.ClaimableIncentives) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Positions) > 0 {
+		for iNdEx := len(m.Positions) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.ClaimableIncentives[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Positions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3319,7 +3437,7 @@ func (m *ClaimableIncentivesResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func (m *PoolAccumulatorRewardsRequest) Marshal() (dAtA []byte, err error) {
+func (m *PositionByIdRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3329,25 +3447,25 @@ func (m *PoolAccumulatorRewardsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PoolAccumulatorRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *PositionByIdRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PoolAccumulatorRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PositionByIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+	if m.PositionId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PositionId))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *PoolAccumulatorRewardsResponse) Marshal() (dAtA []byte, err error) {
+func (m *PositionByIdResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3357,48 +3475,30 @@ func (m *PoolAccumulatorRewardsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PoolAccumulatorRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *PositionByIdResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PoolAccumulatorRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PositionByIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.UptimeGrowthGlobal) > 0 {
-		for iNdEx := len(m.UptimeGrowthGlobal) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.UptimeGrowthGlobal[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.SpreadRewardGrowthGlobal) > 0 {
-		for iNdEx := len(m.SpreadRewardGrowthGlobal) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.SpreadRewardGrowthGlobal[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	{
+		size, err := m.Position.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *TickAccumulatorTrackersRequest) Marshal() (dAtA []byte, err error) {
+func (m *NumPoolPositionsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3408,21 +3508,16 @@ func (m *TickAccumulatorTrackersRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TickAccumulatorTrackersRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *NumPoolPositionsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TickAccumulatorTrackersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *NumPoolPositionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.TickIndex != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.TickIndex))
-		i--
-		dAtA[i] = 0x10
-	}
 	if m.PoolId != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
 		i--
@@ -3431,7 +3526,7 @@ func (m *TickAccumulatorTrackersRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *TickAccumulatorTrackersResponse) Marshal() (dAtA []byte, err error) {
+func (m *NumPoolPositionsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3441,48 +3536,25 @@ func (m *TickAccumulatorTrackersResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TickAccumulatorTrackersResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *NumPoolPositionsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TickAccumulatorTrackersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *NumPoolPositionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.UptimeTrackers) > 0 {
-		for iNdEx := len(m.UptimeTrackers) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.UptimeTrackers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.SpreadRewardGrowthOppositeDirectionOfLastTraversal) > 0 {
-		for iNdEx := len(m.SpreadRewardGrowthOppositeDirectionOfLastTraversal) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.SpreadRewardGrowthOppositeDirectionOfLastTraversal[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if m.PositionCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PositionCount))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *IncentiveRecordsRequest) Marshal() (dAtA []byte, err error) {
+func (m *PoolsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3492,12 +3564,12 @@ func (m *IncentiveRecordsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *IncentiveRecordsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *PoolsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *IncentiveRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PoolsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3514,15 +3586,10 @@ func (m *IncentiveRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *IncentiveRecordsResponse) Marshal() (dAtA []byte, err error) {
+func (m *PoolsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3532,12 +3599,12 @@ func (m *IncentiveRecordsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *IncentiveRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *PoolsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *IncentiveRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PoolsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3554,10 +3621,10 @@ func (m *IncentiveRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.IncentiveRecords) > 0 {
-		for iNdEx := len(m.IncentiveRecords) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Pools) > 0 {
+		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.IncentiveRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3571,7 +3638,7 @@ func (m *IncentiveRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func (m *CFMMPoolIdLinkFromConcentratedPoolIdRequest) Marshal() (dAtA []byte, err error) {
+func (m *ParamsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3581,25 +3648,20 @@ func (m *CFMMPoolIdLinkFromConcentratedPoolIdRequest) Marshal() (dAtA []byte, er
 	return dAtA[:n], nil
 }
 
-func (m *CFMMPoolIdLinkFromConcentratedPoolIdRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ParamsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CFMMPoolIdLinkFromConcentratedPoolIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ConcentratedPoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ConcentratedPoolId))
-		i--
-		dAtA[i] = 0x8
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *CFMMPoolIdLinkFromConcentratedPoolIdResponse) Marshal() (dAtA []byte, err error) {
+func (m *ParamsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3609,25 +3671,30 @@ func (m *CFMMPoolIdLinkFromConcentratedPoolIdResponse) Marshal() (dAtA []byte, e
 	return dAtA[:n], nil
 }
 
-func (m *CFMMPoolIdLinkFromConcentratedPoolIdResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ParamsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CFMMPoolIdLinkFromConcentratedPoolIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.CfmmPoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.CfmmPoolId))
-		i--
-		dAtA[i] = 0x8
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *UserUnbondingPositionsRequest) Marshal() (dAtA []byte, err error) {
+func (m *TickLiquidityNet) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3637,27 +3704,35 @@ func (m *UserUnbondingPositionsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *UserUnbondingPositionsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *TickLiquidityNet) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UserUnbondingPositionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TickLiquidityNet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+	if m.TickIndex != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TickIndex))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.LiquidityNet.Size()
+		i -= size
+		if _, err := m.LiquidityNet.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *UserUnbondingPositionsResponse) Marshal() (dAtA []byte, err error) {
+func (m *LiquidityDepthWithRange) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3667,34 +3742,40 @@ func (m *UserUnbondingPositionsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *UserUnbondingPositionsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *LiquidityDepthWithRange) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UserUnbondingPositionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *LiquidityDepthWithRange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.PositionsWithPeriodLock) > 0 {
-		for iNdEx := len(m.PositionsWithPeriodLock) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PositionsWithPeriodLock[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	if m.UpperTick != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.UpperTick))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.LowerTick != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LowerTick))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.LiquidityAmount.Size()
+		i -= size
+		if _, err := m.LiquidityAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *GetTotalLiquidityRequest) Marshal() (dAtA []byte, err error) {
+func (m *LiquidityNetInDirectionRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3704,20 +3785,62 @@ func (m *GetTotalLiquidityRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetTotalLiquidityRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *LiquidityNetInDirectionRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetTotalLiquidityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *LiquidityNetInDirectionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.UseNoBound {
+		i--
+		if m.UseNoBound {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.BoundTick != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BoundTick))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.UseCurTick {
+		i--
+		if m.UseCurTick {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.StartTick != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartTick))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.TokenIn) > 0 {
+		i -= len(m.TokenIn)
+		copy(dAtA[i:], m.TokenIn)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenIn)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *GetTotalLiquidityResponse) Marshal() (dAtA []byte, err error) {
+func (m *LiquidityNetInDirectionResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3727,26 +3850,51 @@ func (m *GetTotalLiquidityResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetTotalLiquidityResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *LiquidityNetInDirectionResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetTotalLiquidityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *LiquidityNetInDirectionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.TotalLiquidity) > 0 {
-		for iNdEx := len(m.TotalLiquidity) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TotalLiquidity[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
+	{
+		size := m.CurrentSqrtPrice.Size()
+		i -= size
+		if _, err := m.CurrentSqrtPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.CurrentLiquidity.Size()
+		i -= size
+		if _, err := m.CurrentLiquidity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.CurrentTick != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CurrentTick))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.LiquidityDepths) > 0 {
+		for iNdEx := len(m.LiquidityDepths) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LiquidityDepths[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
 			i--
 			dAtA[i] = 0xa
 		}
@@ -3754,7 +3902,7 @@ func (m *GetTotalLiquidityResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *NumNextInitializedTicksRequest) Marshal() (dAtA []byte, err error) {
+func (m *LiquidityPerTickRangeRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3764,28 +3912,16 @@ func (m *NumNextInitializedTicksRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *NumNextInitializedTicksRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *LiquidityPerTickRangeRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *NumNextInitializedTicksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *LiquidityPerTickRangeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.NumNextInitializedTicks != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.NumNextInitializedTicks))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.TokenInDenom) > 0 {
-		i -= len(m.TokenInDenom)
-		copy(dAtA[i:], m.TokenInDenom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenInDenom)))
-		i--
-		dAtA[i] = 0x12
-	}
 	if m.PoolId != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
 		i--
@@ -3794,7 +3930,7 @@ func (m *NumNextInitializedTicksRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *NumNextInitializedTicksResponse) Marshal() (dAtA []byte, err error) {
+func (m *LiquidityPerTickRangeResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3804,35 +3940,25 @@ func (m *NumNextInitializedTicksResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *NumNextInitializedTicksResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *LiquidityPerTickRangeResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *NumNextInitializedTicksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *LiquidityPerTickRangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.CurrentLiquidity.Size()
-		i -= size
-		if _, err := m.CurrentLiquidity.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if m.CurrentTick != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.CurrentTick))
+	if m.BucketIndex != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BucketIndex))
 		i--
 		dAtA[i] = 0x10
 	}
-	if len(m.LiquidityDepths) > 0 {
-		for iNdEx := len(m.LiquidityDepths) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Liquidity) > 0 {
+		for iNdEx := len(m.Liquidity) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.LiquidityDepths[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Liquidity[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3846,552 +3972,2992 @@ func (m *NumNextInitializedTicksResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *UserPositionsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *ClaimableSpreadRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *UserPositionsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Positions) > 0 {
-		for _, e := range m.Positions {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *ClaimableSpreadRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PositionByIdRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ClaimableSpreadRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.PositionId != 0 {
-		n += 1 + sovQuery(uint64(m.PositionId))
+		i = encodeVarintQuery(dAtA, i, uint64(m.PositionId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *PositionByIdResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ClaimableSpreadRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Position.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *NumPoolPositionsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
-	}
-	return n
+func (m *ClaimableSpreadRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *NumPoolPositionsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ClaimableSpreadRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.PositionCount != 0 {
-		n += 1 + sovQuery(uint64(m.PositionCount))
+	if len(m.ClaimableSpreadRewards) > 0 {
+		for iNdEx := len(m.ClaimableSpreadRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClaimableSpreadRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *PoolsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *ClaimableIncentivesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *PoolsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ClaimableIncentivesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClaimableIncentivesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Pools) > 0 {
-		for _, e := range m.Pools {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.PositionId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PositionId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ClaimableIncentivesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *ClaimableIncentivesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TickLiquidityNet) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ClaimableIncentivesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.LiquidityNet.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	if m.TickIndex != 0 {
-		n += 1 + sovQuery(uint64(m.TickIndex))
+	if len(m.ForfeitedIncentives) > 0 {
+		for iNdEx := len(m.ForfeitedIncentives) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ForfeitedIncentives[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	return n
+	if len(m.ClaimableIncentives) > 0 {
+		for iNdEx := len(m.ClaimableIncentives) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClaimableIncentives[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *LiquidityDepthWithRange) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.LiquidityAmount.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	if m.LowerTick != 0 {
-		n += 1 + sovQuery(uint64(m.LowerTick))
-	}
-	if m.UpperTick != 0 {
-		n += 1 + sovQuery(uint64(m.UpperTick))
+func (m *PoolAccumulatorRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *LiquidityNetInDirectionRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *PoolAccumulatorRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolAccumulatorRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
-	}
-	l = len(m.TokenIn)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.StartTick != 0 {
-		n += 1 + sovQuery(uint64(m.StartTick))
-	}
-	if m.UseCurTick {
-		n += 2
-	}
-	if m.BoundTick != 0 {
-		n += 1 + sovQuery(uint64(m.BoundTick))
-	}
-	if m.UseNoBound {
-		n += 2
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *LiquidityNetInDirectionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PoolAccumulatorRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolAccumulatorRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolAccumulatorRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.LiquidityDepths) > 0 {
-		for _, e := range m.LiquidityDepths {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.UptimeGrowthGlobal) > 0 {
+		for iNdEx := len(m.UptimeGrowthGlobal) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UptimeGrowthGlobal[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	if m.CurrentTick != 0 {
-		n += 1 + sovQuery(uint64(m.CurrentTick))
+	if len(m.SpreadRewardGrowthGlobal) > 0 {
+		for iNdEx := len(m.SpreadRewardGrowthGlobal) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpreadRewardGrowthGlobal[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	l = m.CurrentLiquidity.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.CurrentSqrtPrice.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *LiquidityPerTickRangeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
+func (m *TickAccumulatorTrackersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *LiquidityPerTickRangeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *TickAccumulatorTrackersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TickAccumulatorTrackersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Liquidity) > 0 {
-		for _, e := range m.Liquidity {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if m.TickIndex != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TickIndex))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.BucketIndex != 0 {
-		n += 1 + sovQuery(uint64(m.BucketIndex))
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ClaimableSpreadRewardsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PositionId != 0 {
-		n += 1 + sovQuery(uint64(m.PositionId))
+func (m *TickAccumulatorTrackersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ClaimableSpreadRewardsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.ClaimableSpreadRewards) > 0 {
-		for _, e := range m.ClaimableSpreadRewards {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	return n
+func (m *TickAccumulatorTrackersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ClaimableIncentivesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *TickAccumulatorTrackersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.PositionId != 0 {
-		n += 1 + sovQuery(uint64(m.PositionId))
-	}
-	return n
-}
-
-func (m *ClaimableIncentivesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.ClaimableIncentives) > 0 {
-		for _, e := range m.ClaimableIncentives {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.UptimeTrackers) > 0 {
+		for iNdEx := len(m.UptimeTrackers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UptimeTrackers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	if len(m.ForfeitedIncentives) > 0 {
-		for _, e := range m.ForfeitedIncentives {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.SpreadRewardGrowthOppositeDirectionOfLastTraversal) > 0 {
+		for iNdEx := len(m.SpreadRewardGrowthOppositeDirectionOfLastTraversal) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpreadRewardGrowthOppositeDirectionOfLastTraversal[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *PoolAccumulatorRewardsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
+func (m *IncentiveRecordsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *PoolAccumulatorRewardsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *IncentiveRecordsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IncentiveRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.SpreadRewardGrowthGlobal) > 0 {
-		for _, e := range m.SpreadRewardGrowthGlobal {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.UptimeGrowthGlobal) > 0 {
-		for _, e := range m.UptimeGrowthGlobal {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *TickAccumulatorTrackersRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
-	}
-	if m.TickIndex != 0 {
-		n += 1 + sovQuery(uint64(m.TickIndex))
+func (m *IncentiveRecordsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *TickAccumulatorTrackersResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *IncentiveRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IncentiveRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.SpreadRewardGrowthOppositeDirectionOfLastTraversal) > 0 {
-		for _, e := range m.SpreadRewardGrowthOppositeDirectionOfLastTraversal {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.UptimeTrackers) > 0 {
-		for _, e := range m.UptimeTrackers {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.IncentiveRecords) > 0 {
+		for iNdEx := len(m.IncentiveRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IncentiveRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *IncentiveRecordsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *CFMMPoolIdLinkFromConcentratedPoolIdRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *IncentiveRecordsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.IncentiveRecords) > 0 {
-		for _, e := range m.IncentiveRecords {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *CFMMPoolIdLinkFromConcentratedPoolIdRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CFMMPoolIdLinkFromConcentratedPoolIdRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *CFMMPoolIdLinkFromConcentratedPoolIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.ConcentratedPoolId != 0 {
-		n += 1 + sovQuery(uint64(m.ConcentratedPoolId))
+		i = encodeVarintQuery(dAtA, i, uint64(m.ConcentratedPoolId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *CFMMPoolIdLinkFromConcentratedPoolIdResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.CfmmPoolId != 0 {
-		n += 1 + sovQuery(uint64(m.CfmmPoolId))
+func (m *CFMMPoolIdLinkFromConcentratedPoolIdResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *UserUnbondingPositionsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *CFMMPoolIdLinkFromConcentratedPoolIdResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UserUnbondingPositionsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *CFMMPoolIdLinkFromConcentratedPoolIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.PositionsWithPeriodLock) > 0 {
-		for _, e := range m.PositionsWithPeriodLock {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if m.CfmmPoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CfmmPoolId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GetTotalLiquidityRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *UserUnbondingPositionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *UserUnbondingPositionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UserUnbondingPositionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *GetTotalLiquidityResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *UserUnbondingPositionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *UserUnbondingPositionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UserUnbondingPositionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.TotalLiquidity) > 0 {
-		for _, e := range m.TotalLiquidity {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.PositionsWithPeriodLock) > 0 {
+		for iNdEx := len(m.PositionsWithPeriodLock) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PositionsWithPeriodLock[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *NumNextInitializedTicksRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetTotalLiquidityRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetTotalLiquidityRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetTotalLiquidityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
+	return len(dAtA) - i, nil
+}
+
+func (m *GetTotalLiquidityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.TokenInDenom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *GetTotalLiquidityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetTotalLiquidityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TotalLiquidity) > 0 {
+		for iNdEx := len(m.TotalLiquidity) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalLiquidity[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NumNextInitializedTicksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *NumNextInitializedTicksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NumNextInitializedTicksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.NumNextInitializedTicks != 0 {
-		n += 1 + sovQuery(uint64(m.NumNextInitializedTicks))
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumNextInitializedTicks))
+		i--
+		dAtA[i] = 0x18
 	}
-	return n
+	if len(m.TokenInDenom) > 0 {
+		i -= len(m.TokenInDenom)
+		copy(dAtA[i:], m.TokenInDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenInDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *NumNextInitializedTicksResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *NumNextInitializedTicksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *NumNextInitializedTicksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NumNextInitializedTicksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
+	{
+		size := m.CurrentLiquidity.Size()
+		i -= size
+		if _, err := m.CurrentLiquidity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.CurrentTick != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CurrentTick))
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.LiquidityDepths) > 0 {
-		for _, e := range m.LiquidityDepths {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+		for iNdEx := len(m.LiquidityDepths) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LiquidityDepths[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LimitOrderByIdRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LimitOrderByIdRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LimitOrderByIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OrderId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OrderId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LimitOrderByIdResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LimitOrderByIdResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LimitOrderByIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Order.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *UserLimitOrdersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UserLimitOrdersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UserLimitOrdersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UserLimitOrdersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UserLimitOrdersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UserLimitOrdersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Orders) > 0 {
+		for iNdEx := len(m.Orders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Orders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UserManagedPositionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UserManagedPositionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UserManagedPositionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UserManagedPositionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UserManagedPositionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UserManagedPositionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ManagedPositions) > 0 {
+		for iNdEx := len(m.ManagedPositions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ManagedPositions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EffectiveSpreadFactorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EffectiveSpreadFactorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EffectiveSpreadFactorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EffectiveSpreadFactorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EffectiveSpreadFactorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EffectiveSpreadFactorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DynamicSpreadFactorConfig != nil {
+		{
+			size, err := m.DynamicSpreadFactorConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.EffectiveSpreadFactor.Size()
+		i -= size
+		if _, err := m.EffectiveSpreadFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PositionHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PositionHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PositionHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PositionId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PositionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PositionHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PositionHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PositionHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.SpreadRewardApr.Size()
+		i -= size
+		if _, err := m.SpreadRewardApr.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.ImpermanentLoss.Size()
+		i -= size
+		if _, err := m.ImpermanentLoss.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.HoldValue.Size()
+		i -= size
+		if _, err := m.HoldValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.PositionValue.Size()
+		i -= size
+		if _, err := m.PositionValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.TotalIncentives) > 0 {
+		for iNdEx := len(m.TotalIncentives) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalIncentives[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.TotalSpreadRewards) > 0 {
+		for iNdEx := len(m.TotalSpreadRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalSpreadRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.Asset1.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Asset0.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Checkpoints) > 0 {
+		for iNdEx := len(m.Checkpoints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Checkpoints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *UserPositionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *UserPositionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Positions) > 0 {
+		for _, e := range m.Positions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *PositionByIdRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PositionId != 0 {
+		n += 1 + sovQuery(uint64(m.PositionId))
+	}
+	return n
+}
+
+func (m *PositionByIdResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Position.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *NumPoolPositionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *NumPoolPositionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PositionCount != 0 {
+		n += 1 + sovQuery(uint64(m.PositionCount))
+	}
+	return n
+}
+
+func (m *PoolsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *PoolsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pools) > 0 {
+		for _, e := range m.Pools {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *TickLiquidityNet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.LiquidityNet.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.TickIndex != 0 {
+		n += 1 + sovQuery(uint64(m.TickIndex))
+	}
+	return n
+}
+
+func (m *LiquidityDepthWithRange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.LiquidityAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.LowerTick != 0 {
+		n += 1 + sovQuery(uint64(m.LowerTick))
+	}
+	if m.UpperTick != 0 {
+		n += 1 + sovQuery(uint64(m.UpperTick))
+	}
+	return n
+}
+
+func (m *LiquidityNetInDirectionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = len(m.TokenIn)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.StartTick != 0 {
+		n += 1 + sovQuery(uint64(m.StartTick))
+	}
+	if m.UseCurTick {
+		n += 2
+	}
+	if m.BoundTick != 0 {
+		n += 1 + sovQuery(uint64(m.BoundTick))
+	}
+	if m.UseNoBound {
+		n += 2
+	}
+	return n
+}
+
+func (m *LiquidityNetInDirectionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.LiquidityDepths) > 0 {
+		for _, e := range m.LiquidityDepths {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.CurrentTick != 0 {
+		n += 1 + sovQuery(uint64(m.CurrentTick))
+	}
+	l = m.CurrentLiquidity.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.CurrentSqrtPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *LiquidityPerTickRangeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *LiquidityPerTickRangeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Liquidity) > 0 {
+		for _, e := range m.Liquidity {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.BucketIndex != 0 {
+		n += 1 + sovQuery(uint64(m.BucketIndex))
+	}
+	return n
+}
+
+func (m *ClaimableSpreadRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PositionId != 0 {
+		n += 1 + sovQuery(uint64(m.PositionId))
+	}
+	return n
+}
+
+func (m *ClaimableSpreadRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ClaimableSpreadRewards) > 0 {
+		for _, e := range m.ClaimableSpreadRewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ClaimableIncentivesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PositionId != 0 {
+		n += 1 + sovQuery(uint64(m.PositionId))
+	}
+	return n
+}
+
+func (m *ClaimableIncentivesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ClaimableIncentives) > 0 {
+		for _, e := range m.ClaimableIncentives {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.ForfeitedIncentives) > 0 {
+		for _, e := range m.ForfeitedIncentives {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PoolAccumulatorRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *PoolAccumulatorRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SpreadRewardGrowthGlobal) > 0 {
+		for _, e := range m.SpreadRewardGrowthGlobal {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.UptimeGrowthGlobal) > 0 {
+		for _, e := range m.UptimeGrowthGlobal {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TickAccumulatorTrackersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	if m.TickIndex != 0 {
+		n += 1 + sovQuery(uint64(m.TickIndex))
+	}
+	return n
+}
+
+func (m *TickAccumulatorTrackersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SpreadRewardGrowthOppositeDirectionOfLastTraversal) > 0 {
+		for _, e := range m.SpreadRewardGrowthOppositeDirectionOfLastTraversal {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.UptimeTrackers) > 0 {
+		for _, e := range m.UptimeTrackers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *IncentiveRecordsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *IncentiveRecordsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.IncentiveRecords) > 0 {
+		for _, e := range m.IncentiveRecords {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *CFMMPoolIdLinkFromConcentratedPoolIdRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ConcentratedPoolId != 0 {
+		n += 1 + sovQuery(uint64(m.ConcentratedPoolId))
+	}
+	return n
+}
+
+func (m *CFMMPoolIdLinkFromConcentratedPoolIdResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CfmmPoolId != 0 {
+		n += 1 + sovQuery(uint64(m.CfmmPoolId))
+	}
+	return n
+}
+
+func (m *UserUnbondingPositionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *UserUnbondingPositionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PositionsWithPeriodLock) > 0 {
+		for _, e := range m.PositionsWithPeriodLock {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetTotalLiquidityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GetTotalLiquidityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TotalLiquidity) > 0 {
+		for _, e := range m.TotalLiquidity {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *NumNextInitializedTicksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = len(m.TokenInDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.NumNextInitializedTicks != 0 {
+		n += 1 + sovQuery(uint64(m.NumNextInitializedTicks))
+	}
+	return n
+}
+
+func (m *NumNextInitializedTicksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.LiquidityDepths) > 0 {
+		for _, e := range m.LiquidityDepths {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.CurrentTick != 0 {
+		n += 1 + sovQuery(uint64(m.CurrentTick))
+	}
+	l = m.CurrentLiquidity.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *LimitOrderByIdRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderId != 0 {
+		n += 1 + sovQuery(uint64(m.OrderId))
+	}
+	return n
+}
+
+func (m *LimitOrderByIdResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Order.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *UserLimitOrdersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *UserLimitOrdersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Orders) > 0 {
+		for _, e := range m.Orders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *UserManagedPositionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *UserManagedPositionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ManagedPositions) > 0 {
+		for _, e := range m.ManagedPositions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *EffectiveSpreadFactorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *EffectiveSpreadFactorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.EffectiveSpreadFactor.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.DynamicSpreadFactorConfig != nil {
+		l = m.DynamicSpreadFactorConfig.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *PositionHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PositionId != 0 {
+		n += 1 + sovQuery(uint64(m.PositionId))
+	}
+	return n
+}
+
+func (m *PositionHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Checkpoints) > 0 {
+		for _, e := range m.Checkpoints {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.Asset0.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Asset1.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.TotalSpreadRewards) > 0 {
+		for _, e := range m.TotalSpreadRewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.TotalIncentives) > 0 {
+		for _, e := range m.TotalIncentives {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.PositionValue.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.HoldValue.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ImpermanentLoss.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.SpreadRewardApr.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *UserPositionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UserPositionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UserPositionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UserPositionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UserPositionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UserPositionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Positions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Positions = append(m.Positions, model.FullPositionBreakdown{})
+			if err := m.Positions[len(m.Positions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PositionByIdRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PositionByIdRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PositionByIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
+			}
+			m.PositionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PositionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PositionByIdResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PositionByIdResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PositionByIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Position.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NumPoolPositionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NumPoolPositionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NumPoolPositionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NumPoolPositionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NumPoolPositionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NumPoolPositionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionCount", wireType)
+			}
+			m.PositionCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PositionCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pools = append(m.Pools, &types.Any{})
+			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TickLiquidityNet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TickLiquidityNet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TickLiquidityNet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityNet", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidityNet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickIndex", wireType)
+			}
+			m.TickIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TickIndex |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LiquidityDepthWithRange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LiquidityDepthWithRange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LiquidityDepthWithRange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidityAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowerTick", wireType)
+			}
+			m.LowerTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LowerTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpperTick", wireType)
+			}
+			m.UpperTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpperTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.CurrentTick != 0 {
-		n += 1 + sovQuery(uint64(m.CurrentTick))
-	}
-	l = m.CurrentLiquidity.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *UserPositionsRequest) Unmarshal(dAtA []byte) error {
+func (m *LiquidityNetInDirectionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4414,15 +6980,34 @@ func (m *UserPositionsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UserPositionsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: LiquidityNetInDirectionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UserPositionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LiquidityNetInDirectionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4450,13 +7035,13 @@ func (m *UserPositionsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.TokenIn = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTick", wireType)
 			}
-			m.PoolId = 0
+			m.StartTick = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4466,16 +7051,16 @@ func (m *UserPositionsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				m.StartTick |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UseCurTick", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4485,28 +7070,51 @@ func (m *UserPositionsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			m.UseCurTick = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BoundTick", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.BoundTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BoundTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UseNoBound", wireType)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
+			m.UseNoBound = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4528,7 +7136,7 @@ func (m *UserPositionsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UserPositionsResponse) Unmarshal(dAtA []byte) error {
+func (m *LiquidityNetInDirectionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4551,15 +7159,15 @@ func (m *UserPositionsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UserPositionsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: LiquidityNetInDirectionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UserPositionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LiquidityNetInDirectionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Positions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityDepths", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4586,16 +7194,35 @@ func (m *UserPositionsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Positions = append(m.Positions, model.FullPositionBreakdown{})
-			if err := m.Positions[len(m.Positions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.LiquidityDepths = append(m.LiquidityDepths, TickLiquidityNet{})
+			if err := m.LiquidityDepths[len(m.LiquidityDepths)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentTick", wireType)
+			}
+			m.CurrentTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentLiquidity", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4605,25 +7232,57 @@ func (m *UserPositionsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			if err := m.CurrentLiquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentSqrtPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrentSqrtPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4648,7 +7307,7 @@ func (m *UserPositionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PositionByIdRequest) Unmarshal(dAtA []byte) error {
+func (m *LiquidityPerTickRangeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4671,17 +7330,17 @@ func (m *PositionByIdRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PositionByIdRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: LiquidityPerTickRangeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PositionByIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LiquidityPerTickRangeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			m.PositionId = 0
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4691,7 +7350,7 @@ func (m *PositionByIdRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PositionId |= uint64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -4717,7 +7376,7 @@ func (m *PositionByIdRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PositionByIdResponse) Unmarshal(dAtA []byte) error {
+func (m *LiquidityPerTickRangeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4740,15 +7399,15 @@ func (m *PositionByIdResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PositionByIdResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: LiquidityPerTickRangeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PositionByIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LiquidityPerTickRangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Liquidity", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4775,10 +7434,30 @@ func (m *PositionByIdResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Position.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Liquidity = append(m.Liquidity, LiquidityDepthWithRange{})
+			if err := m.Liquidity[len(m.Liquidity)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			iNdEx = postIndex
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketIndex", wireType)
+			}
+			m.BucketIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BucketIndex |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4800,7 +7479,7 @@ func (m *PositionByIdResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NumPoolPositionsRequest) Unmarshal(dAtA []byte) error {
+func (m *ClaimableSpreadRewardsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4823,17 +7502,17 @@ func (m *NumPoolPositionsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NumPoolPositionsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClaimableSpreadRewardsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NumPoolPositionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClaimableSpreadRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
 			}
-			m.PoolId = 0
+			m.PositionId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4843,7 +7522,7 @@ func (m *NumPoolPositionsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				m.PositionId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -4869,7 +7548,7 @@ func (m *NumPoolPositionsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NumPoolPositionsResponse) Unmarshal(dAtA []byte) error {
+func (m *ClaimableSpreadRewardsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4892,17 +7571,17 @@ func (m *NumPoolPositionsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NumPoolPositionsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClaimableSpreadRewardsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NumPoolPositionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClaimableSpreadRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PositionCount", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimableSpreadRewards", wireType)
 			}
-			m.PositionCount = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4912,11 +7591,26 @@ func (m *NumPoolPositionsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PositionCount |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClaimableSpreadRewards = append(m.ClaimableSpreadRewards, types2.Coin{})
+			if err := m.ClaimableSpreadRewards[len(m.ClaimableSpreadRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4938,7 +7632,7 @@ func (m *NumPoolPositionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PoolsRequest) Unmarshal(dAtA []byte) error {
+func (m *ClaimableIncentivesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4961,17 +7655,17 @@ func (m *PoolsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PoolsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClaimableIncentivesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PoolsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClaimableIncentivesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
 			}
-			var msglen int
+			m.PositionId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4981,28 +7675,11 @@ func (m *PoolsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PositionId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5024,7 +7701,7 @@ func (m *PoolsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PoolsResponse) Unmarshal(dAtA []byte) error {
+func (m *ClaimableIncentivesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5047,15 +7724,15 @@ func (m *PoolsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PoolsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClaimableIncentivesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PoolsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClaimableIncentivesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimableIncentives", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5082,14 +7759,14 @@ func (m *PoolsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pools = append(m.Pools, &types.Any{})
-			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ClaimableIncentives = append(m.ClaimableIncentives, types2.Coin{})
+			if err := m.ClaimableIncentives[len(m.ClaimableIncentives)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ForfeitedIncentives", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5116,10 +7793,8 @@ func (m *PoolsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ForfeitedIncentives = append(m.ForfeitedIncentives, types2.Coin{})
+			if err := m.ForfeitedIncentives[len(m.ForfeitedIncentives)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5144,7 +7819,7 @@ func (m *PoolsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *PoolAccumulatorRewardsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5167,12 +7842,31 @@ func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PoolAccumulatorRewardsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PoolAccumulatorRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5194,7 +7888,7 @@ func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *PoolAccumulatorRewardsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5217,15 +7911,15 @@ func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PoolAccumulatorRewardsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PoolAccumulatorRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpreadRewardGrowthGlobal", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5252,7 +7946,42 @@ func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SpreadRewardGrowthGlobal = append(m.SpreadRewardGrowthGlobal, types2.DecCoin{})
+			if err := m.SpreadRewardGrowthGlobal[len(m.SpreadRewardGrowthGlobal)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UptimeGrowthGlobal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UptimeGrowthGlobal = append(m.UptimeGrowthGlobal, model.UptimeTracker{})
+			if err := m.UptimeGrowthGlobal[len(m.UptimeGrowthGlobal)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5277,7 +8006,7 @@ func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TickLiquidityNet) Unmarshal(dAtA []byte) error {
+func (m *TickAccumulatorTrackersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5300,17 +8029,17 @@ func (m *TickLiquidityNet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TickLiquidityNet: wiretype end group for non-group")
+			return fmt.Errorf("proto: TickAccumulatorTrackersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TickLiquidityNet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TickAccumulatorTrackersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityNet", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			var stringLen uint64
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5320,26 +8049,11 @@ func (m *TickLiquidityNet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.LiquidityNet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field TickIndex", wireType)
@@ -5380,7 +8094,7 @@ func (m *TickLiquidityNet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LiquidityDepthWithRange) Unmarshal(dAtA []byte) error {
+func (m *TickAccumulatorTrackersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5403,17 +8117,17 @@ func (m *LiquidityDepthWithRange) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LiquidityDepthWithRange: wiretype end group for non-group")
+			return fmt.Errorf("proto: TickAccumulatorTrackersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LiquidityDepthWithRange: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TickAccumulatorTrackersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpreadRewardGrowthOppositeDirectionOfLastTraversal", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5423,31 +8137,31 @@ func (m *LiquidityDepthWithRange) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LiquidityAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SpreadRewardGrowthOppositeDirectionOfLastTraversal = append(m.SpreadRewardGrowthOppositeDirectionOfLastTraversal, types2.DecCoin{})
+			if err := m.SpreadRewardGrowthOppositeDirectionOfLastTraversal[len(m.SpreadRewardGrowthOppositeDirectionOfLastTraversal)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LowerTick", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UptimeTrackers", wireType)
 			}
-			m.LowerTick = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5457,30 +8171,26 @@ func (m *LiquidityDepthWithRange) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LowerTick |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpperTick", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			m.UpperTick = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UpperTick |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UptimeTrackers = append(m.UptimeTrackers, model.UptimeTracker{})
+			if err := m.UptimeTrackers[len(m.UptimeTrackers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5502,7 +8212,7 @@ func (m *LiquidityDepthWithRange) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LiquidityNetInDirectionRequest) Unmarshal(dAtA []byte) error {
+func (m *IncentiveRecordsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5525,10 +8235,10 @@ func (m *LiquidityNetInDirectionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LiquidityNetInDirectionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: IncentiveRecordsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LiquidityNetInDirectionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IncentiveRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5552,9 +8262,9 @@ func (m *LiquidityNetInDirectionRequest) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5564,102 +8274,28 @@ func (m *LiquidityNetInDirectionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenIn = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTick", wireType)
-			}
-			m.StartTick = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.StartTick |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UseCurTick", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.UseCurTick = bool(v != 0)
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BoundTick", wireType)
-			}
-			m.BoundTick = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BoundTick |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UseNoBound", wireType)
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.UseNoBound = bool(v != 0)
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5681,7 +8317,7 @@ func (m *LiquidityNetInDirectionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LiquidityNetInDirectionResponse) Unmarshal(dAtA []byte) error {
+func (m *IncentiveRecordsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5704,15 +8340,15 @@ func (m *LiquidityNetInDirectionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LiquidityNetInDirectionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: IncentiveRecordsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LiquidityNetInDirectionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IncentiveRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityDepths", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IncentiveRecords", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5739,35 +8375,16 @@ func (m *LiquidityNetInDirectionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LiquidityDepths = append(m.LiquidityDepths, TickLiquidityNet{})
-			if err := m.LiquidityDepths[len(m.LiquidityDepths)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.IncentiveRecords = append(m.IncentiveRecords, types1.IncentiveRecord{})
+			if err := m.IncentiveRecords[len(m.IncentiveRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentTick", wireType)
-			}
-			m.CurrentTick = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CurrentTick |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentLiquidity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5777,57 +8394,25 @@ func (m *LiquidityNetInDirectionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CurrentLiquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentSqrtPrice", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-			if err := m.CurrentSqrtPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5852,7 +8437,7 @@ func (m *LiquidityNetInDirectionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LiquidityPerTickRangeRequest) Unmarshal(dAtA []byte) error {
+func (m *CFMMPoolIdLinkFromConcentratedPoolIdRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5875,17 +8460,17 @@ func (m *LiquidityPerTickRangeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LiquidityPerTickRangeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CFMMPoolIdLinkFromConcentratedPoolIdRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LiquidityPerTickRangeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CFMMPoolIdLinkFromConcentratedPoolIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConcentratedPoolId", wireType)
 			}
-			m.PoolId = 0
+			m.ConcentratedPoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5895,7 +8480,7 @@ func (m *LiquidityPerTickRangeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				m.ConcentratedPoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -5921,7 +8506,7 @@ func (m *LiquidityPerTickRangeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LiquidityPerTickRangeResponse) Unmarshal(dAtA []byte) error {
+func (m *CFMMPoolIdLinkFromConcentratedPoolIdResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5944,51 +8529,17 @@ func (m *LiquidityPerTickRangeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LiquidityPerTickRangeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: CFMMPoolIdLinkFromConcentratedPoolIdResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LiquidityPerTickRangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CFMMPoolIdLinkFromConcentratedPoolIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Liquidity", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Liquidity = append(m.Liquidity, LiquidityDepthWithRange{})
-			if err := m.Liquidity[len(m.Liquidity)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CfmmPoolId", wireType)
 			}
-			m.BucketIndex = 0
+			m.CfmmPoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5998,7 +8549,7 @@ func (m *LiquidityPerTickRangeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BucketIndex |= int64(b&0x7F) << shift
+				m.CfmmPoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -6024,7 +8575,7 @@ func (m *LiquidityPerTickRangeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClaimableSpreadRewardsRequest) Unmarshal(dAtA []byte) error {
+func (m *UserUnbondingPositionsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6047,17 +8598,17 @@ func (m *ClaimableSpreadRewardsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClaimableSpreadRewardsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: UserUnbondingPositionsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClaimableSpreadRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UserUnbondingPositionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			m.PositionId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6067,11 +8618,24 @@ func (m *ClaimableSpreadRewardsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PositionId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6093,7 +8657,7 @@ func (m *ClaimableSpreadRewardsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClaimableSpreadRewardsResponse) Unmarshal(dAtA []byte) error {
+func (m *UserUnbondingPositionsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6116,15 +8680,15 @@ func (m *ClaimableSpreadRewardsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClaimableSpreadRewardsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: UserUnbondingPositionsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClaimableSpreadRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UserUnbondingPositionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClaimableSpreadRewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionsWithPeriodLock", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6151,8 +8715,8 @@ func (m *ClaimableSpreadRewardsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClaimableSpreadRewards = append(m.ClaimableSpreadRewards, types2.Coin{})
-			if err := m.ClaimableSpreadRewards[len(m.ClaimableSpreadRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PositionsWithPeriodLock = append(m.PositionsWithPeriodLock, model.PositionWithPeriodLock{})
+			if err := m.PositionsWithPeriodLock[len(m.PositionsWithPeriodLock)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6177,7 +8741,7 @@ func (m *ClaimableSpreadRewardsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClaimableIncentivesRequest) Unmarshal(dAtA []byte) error {
+func (m *GetTotalLiquidityRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6200,31 +8764,12 @@ func (m *ClaimableIncentivesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClaimableIncentivesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetTotalLiquidityRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClaimableIncentivesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetTotalLiquidityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
-			}
-			m.PositionId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PositionId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6246,7 +8791,7 @@ func (m *ClaimableIncentivesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClaimableIncentivesResponse) Unmarshal(dAtA []byte) error {
+func (m *GetTotalLiquidityResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6269,49 +8814,15 @@ func (m *ClaimableIncentivesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClaimableIncentivesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetTotalLiquidityResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClaimableIncentivesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetTotalLiquidityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClaimableIncentives", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ClaimableIncentives = append(m.ClaimableIncentives, types2.Coin{})
-			if err := m.ClaimableIncentives[len(m.ClaimableIncentives)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ForfeitedIncentives", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalLiquidity", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6338,8 +8849,8 @@ func (m *ClaimableIncentivesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ForfeitedIncentives = append(m.ForfeitedIncentives, types2.Coin{})
-			if err := m.ForfeitedIncentives[len(m.ForfeitedIncentives)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TotalLiquidity = append(m.TotalLiquidity, types2.Coin{})
+			if err := m.TotalLiquidity[len(m.TotalLiquidity)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6364,7 +8875,7 @@ func (m *ClaimableIncentivesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PoolAccumulatorRewardsRequest) Unmarshal(dAtA []byte) error {
+func (m *NumNextInitializedTicksRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6387,10 +8898,10 @@ func (m *PoolAccumulatorRewardsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PoolAccumulatorRewardsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: NumNextInitializedTicksRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PoolAccumulatorRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NumNextInitializedTicksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6412,6 +8923,57 @@ func (m *PoolAccumulatorRewardsRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenInDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumNextInitializedTicks", wireType)
+			}
+			m.NumNextInitializedTicks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumNextInitializedTicks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6433,7 +8995,7 @@ func (m *PoolAccumulatorRewardsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PoolAccumulatorRewardsResponse) Unmarshal(dAtA []byte) error {
+func (m *NumNextInitializedTicksResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6456,15 +9018,15 @@ func (m *PoolAccumulatorRewardsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PoolAccumulatorRewardsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: NumNextInitializedTicksResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PoolAccumulatorRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NumNextInitializedTicksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpreadRewardGrowthGlobal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityDepths", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6491,16 +9053,35 @@ func (m *PoolAccumulatorRewardsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SpreadRewardGrowthGlobal = append(m.SpreadRewardGrowthGlobal, types2.DecCoin{})
-			if err := m.SpreadRewardGrowthGlobal[len(m.SpreadRewardGrowthGlobal)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.LiquidityDepths = append(m.LiquidityDepths, TickLiquidityNet{})
+			if err := m.LiquidityDepths[len(m.LiquidityDepths)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentTick", wireType)
+			}
+			m.CurrentTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UptimeGrowthGlobal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentLiquidity", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6510,23 +9091,23 @@ func (m *PoolAccumulatorRewardsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UptimeGrowthGlobal = append(m.UptimeGrowthGlobal, model.UptimeTracker{})
-			if err := m.UptimeGrowthGlobal[len(m.UptimeGrowthGlobal)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CurrentLiquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6551,7 +9132,7 @@ func (m *PoolAccumulatorRewardsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TickAccumulatorTrackersRequest) Unmarshal(dAtA []byte) error {
+func (m *LimitOrderByIdRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6574,17 +9155,17 @@ func (m *TickAccumulatorTrackersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TickAccumulatorTrackersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: LimitOrderByIdRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TickAccumulatorTrackersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LimitOrderByIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
 			}
-			m.PoolId = 0
+			m.OrderId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6594,16 +9175,66 @@ func (m *TickAccumulatorTrackersRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				m.OrderId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TickIndex", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.TickIndex = 0
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LimitOrderByIdResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LimitOrderByIdResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LimitOrderByIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6613,11 +9244,25 @@ func (m *TickAccumulatorTrackersRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TickIndex |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Order.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6639,7 +9284,7 @@ func (m *TickAccumulatorTrackersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TickAccumulatorTrackersResponse) Unmarshal(dAtA []byte) error {
+func (m *UserLimitOrdersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6662,17 +9307,17 @@ func (m *TickAccumulatorTrackersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TickAccumulatorTrackersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: UserLimitOrdersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TickAccumulatorTrackersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UserLimitOrdersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpreadRewardGrowthOppositeDirectionOfLastTraversal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6682,29 +9327,46 @@ func (m *TickAccumulatorTrackersResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SpreadRewardGrowthOppositeDirectionOfLastTraversal = append(m.SpreadRewardGrowthOppositeDirectionOfLastTraversal, types2.DecCoin{})
-			if err := m.SpreadRewardGrowthOppositeDirectionOfLastTraversal[len(m.SpreadRewardGrowthOppositeDirectionOfLastTraversal)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UptimeTrackers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6731,8 +9393,10 @@ func (m *TickAccumulatorTrackersResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UptimeTrackers = append(m.UptimeTrackers, model.UptimeTracker{})
-			if err := m.UptimeTrackers[len(m.UptimeTrackers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6757,7 +9421,7 @@ func (m *TickAccumulatorTrackersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IncentiveRecordsRequest) Unmarshal(dAtA []byte) error {
+func (m *UserLimitOrdersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6780,17 +9444,17 @@ func (m *IncentiveRecordsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IncentiveRecordsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: UserLimitOrdersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IncentiveRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UserLimitOrdersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Orders", wireType)
 			}
-			m.PoolId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6800,11 +9464,26 @@ func (m *IncentiveRecordsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Orders = append(m.Orders, types1.FullLimitOrderBreakdown{})
+			if err := m.Orders[len(m.Orders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
@@ -6835,7 +9514,7 @@ func (m *IncentiveRecordsRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+				m.Pagination = &query.PageResponse{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -6862,7 +9541,7 @@ func (m *IncentiveRecordsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IncentiveRecordsResponse) Unmarshal(dAtA []byte) error {
+func (m *UserManagedPositionsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6885,17 +9564,17 @@ func (m *IncentiveRecordsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IncentiveRecordsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: UserManagedPositionsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IncentiveRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UserManagedPositionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncentiveRecords", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6905,27 +9584,44 @@ func (m *IncentiveRecordsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IncentiveRecords = append(m.IncentiveRecords, types1.IncentiveRecord{})
-			if err := m.IncentiveRecords[len(m.IncentiveRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -6955,7 +9651,7 @@ func (m *IncentiveRecordsResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+				m.Pagination = &query.PageRequest{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -6982,7 +9678,7 @@ func (m *IncentiveRecordsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CFMMPoolIdLinkFromConcentratedPoolIdRequest) Unmarshal(dAtA []byte) error {
+func (m *UserManagedPositionsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7005,17 +9701,17 @@ func (m *CFMMPoolIdLinkFromConcentratedPoolIdRequest) Unmarshal(dAtA []byte) err
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CFMMPoolIdLinkFromConcentratedPoolIdRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: UserManagedPositionsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CFMMPoolIdLinkFromConcentratedPoolIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UserManagedPositionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConcentratedPoolId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ManagedPositions", wireType)
 			}
-			m.ConcentratedPoolId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7025,11 +9721,62 @@ func (m *CFMMPoolIdLinkFromConcentratedPoolIdRequest) Unmarshal(dAtA []byte) err
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ConcentratedPoolId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ManagedPositions = append(m.ManagedPositions, types1.FullManagedPositionBreakdown{})
+			if err := m.ManagedPositions[len(m.ManagedPositions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7051,7 +9798,7 @@ func (m *CFMMPoolIdLinkFromConcentratedPoolIdRequest) Unmarshal(dAtA []byte) err
 	}
 	return nil
 }
-func (m *CFMMPoolIdLinkFromConcentratedPoolIdResponse) Unmarshal(dAtA []byte) error {
+func (m *EffectiveSpreadFactorRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7074,17 +9821,17 @@ func (m *CFMMPoolIdLinkFromConcentratedPoolIdResponse) Unmarshal(dAtA []byte) er
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CFMMPoolIdLinkFromConcentratedPoolIdResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: EffectiveSpreadFactorRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CFMMPoolIdLinkFromConcentratedPoolIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EffectiveSpreadFactorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CfmmPoolId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			m.CfmmPoolId = 0
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7094,7 +9841,7 @@ func (m *CFMMPoolIdLinkFromConcentratedPoolIdResponse) Unmarshal(dAtA []byte) er
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CfmmPoolId |= uint64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -7120,7 +9867,7 @@ func (m *CFMMPoolIdLinkFromConcentratedPoolIdResponse) Unmarshal(dAtA []byte) er
 	}
 	return nil
 }
-func (m *UserUnbondingPositionsRequest) Unmarshal(dAtA []byte) error {
+func (m *EffectiveSpreadFactorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7143,15 +9890,15 @@ func (m *UserUnbondingPositionsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UserUnbondingPositionsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: EffectiveSpreadFactorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UserUnbondingPositionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EffectiveSpreadFactorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveSpreadFactor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7179,61 +9926,13 @@ func (m *UserUnbondingPositionsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.EffectiveSpreadFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *UserUnbondingPositionsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: UserUnbondingPositionsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UserUnbondingPositionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PositionsWithPeriodLock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DynamicSpreadFactorConfig", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7260,8 +9959,10 @@ func (m *UserUnbondingPositionsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PositionsWithPeriodLock = append(m.PositionsWithPeriodLock, model.PositionWithPeriodLock{})
-			if err := m.PositionsWithPeriodLock[len(m.PositionsWithPeriodLock)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.DynamicSpreadFactorConfig == nil {
+				m.DynamicSpreadFactorConfig = &types1.DynamicSpreadFactorConfig{}
+			}
+			if err := m.DynamicSpreadFactorConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7286,7 +9987,7 @@ func (m *UserUnbondingPositionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetTotalLiquidityRequest) Unmarshal(dAtA []byte) error {
+func (m *PositionHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7309,12 +10010,31 @@ func (m *GetTotalLiquidityRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetTotalLiquidityRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PositionHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetTotalLiquidityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PositionHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
+			}
+			m.PositionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PositionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7336,7 +10056,7 @@ func (m *GetTotalLiquidityRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetTotalLiquidityResponse) Unmarshal(dAtA []byte) error {
+func (m *PositionHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7359,15 +10079,15 @@ func (m *GetTotalLiquidityResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetTotalLiquidityResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PositionHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetTotalLiquidityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PositionHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalLiquidity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Checkpoints", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7394,66 +10114,49 @@ func (m *GetTotalLiquidityResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TotalLiquidity = append(m.TotalLiquidity, types2.Coin{})
-			if err := m.TotalLiquidity[len(m.TotalLiquidity)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Checkpoints = append(m.Checkpoints, types1.PositionCheckpoint{})
+			if err := m.Checkpoints[len(m.Checkpoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset0", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *NumNextInitializedTicksRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Asset0.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: NumNextInitializedTicksRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NumNextInitializedTicksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset1", wireType)
 			}
-			m.PoolId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7463,16 +10166,30 @@ func (m *NumNextInitializedTicksRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Asset1.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenInDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSpreadRewards", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7482,29 +10199,31 @@ func (m *NumNextInitializedTicksRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenInDenom = string(dAtA[iNdEx:postIndex])
+			m.TotalSpreadRewards = append(m.TotalSpreadRewards, types2.Coin{})
+			if err := m.TotalSpreadRewards[len(m.TotalSpreadRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumNextInitializedTicks", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalIncentives", wireType)
 			}
-			m.NumNextInitializedTicks = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7514,66 +10233,65 @@ func (m *NumNextInitializedTicksRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NumNextInitializedTicks |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *NumNextInitializedTicksResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.TotalIncentives = append(m.TotalIncentives, types2.Coin{})
+			if err := m.TotalIncentives[len(m.TotalIncentives)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.PositionValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: NumNextInitializedTicksResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NumNextInitializedTicksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityDepths", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HoldValue", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7583,31 +10301,31 @@ func (m *NumNextInitializedTicksResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LiquidityDepths = append(m.LiquidityDepths, TickLiquidityNet{})
-			if err := m.LiquidityDepths[len(m.LiquidityDepths)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.HoldValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentTick", wireType)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImpermanentLoss", wireType)
 			}
-			m.CurrentTick = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7617,14 +10335,29 @@ func (m *NumNextInitializedTicksResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CurrentTick |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ImpermanentLoss.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentLiquidity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpreadRewardApr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7652,7 +10385,7 @@ func (m *NumNextInitializedTicksResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CurrentLiquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SpreadRewardApr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -124,8 +124,11 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 // BeginBlock performs a no-op.
 func (AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
 
-// EndBlock performs a no-op.
+// EndBlock re-centers the managed positions that are out of range and compounds
+// the auto-compounded positions that are due.
 func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	am.keeper.RebalanceManagedPositions(ctx)
+	am.keeper.CompoundDuePositions(ctx)
 	return []abci.ValidatorUpdate{}
 }
 
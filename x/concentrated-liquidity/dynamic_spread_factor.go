@@ -0,0 +1,102 @@
+package concentrated_liquidity
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v24/x/concentrated-liquidity/types"
+)
+
+// SetDynamicSpreadFactorConfigs sets the dynamic spread factor config of each pool in the given records.
+// A record without a config disables the dynamic spread factor of its pool, which then charges its
+// fixed spread factor again.
+// Returns error if any of the pools does not exist or any of the configs is invalid.
+func (k Keeper) SetDynamicSpreadFactorConfigs(ctx sdk.Context, records []types.PoolIdToDynamicSpreadFactorRecord) error {
+	for _, record := range records {
+		if err := k.setDynamicSpreadFactorConfig(ctx, record.PoolId, record.Config); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// setDynamicSpreadFactorConfig sets the dynamic spread factor config of the given pool, or removes it if
+// the config is nil.
+// Returns error if the pool does not exist or the config is invalid.
+func (k Keeper) setDynamicSpreadFactorConfig(ctx sdk.Context, poolId uint64, config *types.DynamicSpreadFactorConfig) error {
+	if _, err := k.getPoolById(ctx, poolId); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	if config == nil {
+		store.Delete(types.KeyDynamicSpreadFactorConfig(poolId))
+		return nil
+	}
+
+	if err := config.Validate(); err != nil {
+		return err
+	}
+	osmoutils.MustSet(store, types.KeyDynamicSpreadFactorConfig(poolId), config)
+	return nil
+}
+
+// GetDynamicSpreadFactorConfig returns the dynamic spread factor config of the given pool and whether
+// the pool has the dynamic spread factor enabled.
+func (k Keeper) GetDynamicSpreadFactorConfig(ctx sdk.Context, poolId uint64) (types.DynamicSpreadFactorConfig, bool, error) {
+	config := types.DynamicSpreadFactorConfig{}
+	found, err := osmoutils.Get(ctx.KVStore(k.storeKey), types.KeyDynamicSpreadFactorConfig(poolId), &config)
+	if err != nil {
+		return types.DynamicSpreadFactorConfig{}, false, err
+	}
+	return config, found, nil
+}
+
+// GetEffectiveSpreadFactor returns the spread factor charged by swaps in the given pool at the current block.
+//
+// For pools without a dynamic spread factor config, this is the fixed spread factor of the pool.
+// Otherwise, the fixed spread factor is increased by the realized volatility of the pool's price over
+// the configured window, scaled by the volatility multiplier, and clamped to the configured bounds:
+//
+// effective spread factor = min(max(spread factor + volatility multiplier * volatility, min spread factor), max spread factor)
+//
+// If the volatility cannot be computed, e.g. because the pool is younger than the window or no TWAP
+// history is available for it, the fixed spread factor clamped to the bounds is returned instead.
+func (k Keeper) GetEffectiveSpreadFactor(ctx sdk.Context, pool types.ConcentratedPoolExtension) (osmomath.Dec, error) {
+	config, found, err := k.GetDynamicSpreadFactorConfig(ctx, pool.GetId())
+	if err != nil {
+		return osmomath.Dec{}, err
+	}
+	if !found {
+		return pool.GetSpreadFactor(ctx), nil
+	}
+
+	spreadFactor := pool.GetSpreadFactor(ctx)
+	if k.twapKeeper == nil {
+		return config.Bound(spreadFactor), nil
+	}
+
+	endTime := ctx.BlockTime()
+	startTime := endTime.Add(-config.VolatilityWindow)
+	volatility, err := k.twapKeeper.GetRealizedVolatility(ctx, pool.GetId(), pool.GetToken0(), pool.GetToken1(), startTime, endTime)
+	if err != nil {
+		return config.Bound(spreadFactor), nil
+	}
+
+	return config.Bound(spreadFactor.Add(config.VolatilityMultiplier.Mul(volatility))), nil
+}
+
+// spreadFactorForSwap returns the spread factor to charge for a swap in the given pool. If the pool has
+// the dynamic spread factor enabled, the given spread factor is replaced by the effective spread factor
+// of the pool. Otherwise, the given spread factor is returned as is.
+func (k Keeper) spreadFactorForSwap(ctx sdk.Context, pool types.ConcentratedPoolExtension, spreadFactor osmomath.Dec) (osmomath.Dec, error) {
+	_, found, err := k.GetDynamicSpreadFactorConfig(ctx, pool.GetId())
+	if err != nil {
+		return osmomath.Dec{}, err
+	}
+	if !found {
+		return spreadFactor, nil
+	}
+	return k.GetEffectiveSpreadFactor(ctx, pool)
+}
@@ -0,0 +1,178 @@
+package concentrated_liquidity_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v24/x/concentrated-liquidity/types"
+)
+
+var defaultDynamicSpreadFactorConfig = types.DynamicSpreadFactorConfig{
+	MinSpreadFactor:      osmomath.MustNewDecFromStr("0.001"),
+	MaxSpreadFactor:      osmomath.MustNewDecFromStr("0.01"),
+	VolatilityWindow:     time.Hour,
+	VolatilityMultiplier: osmomath.MustNewDecFromStr("0.5"),
+}
+
+// volatileSwaps swaps back and forth in the given pool over more than the default volatility window,
+// updating the TWAP records of the pool at the end of each block.
+func (s *KeeperTestSuite) volatileSwaps(poolId uint64) {
+	for i := 0; i < 4; i++ {
+		s.AddBlockTime(defaultDynamicSpreadFactorConfig.VolatilityWindow / 2)
+		s.Ctx = s.Ctx.WithBlockHeight(s.Ctx.BlockHeight() + 1)
+		if i%2 == 0 {
+			s.swapExactAmountIn(poolId, sdk.NewCoin(USDC, osmomath.NewInt(2_000_000_000)), ETH)
+		} else {
+			s.swapExactAmountIn(poolId, sdk.NewCoin(ETH, osmomath.NewInt(400_000)), USDC)
+		}
+		s.App.TwapKeeper.EndBlock(s.Ctx)
+	}
+	s.AddBlockTime(time.Second)
+}
+
+func (s *KeeperTestSuite) TestSetDynamicSpreadFactorConfigs() {
+	invalidBounds := defaultDynamicSpreadFactorConfig
+	invalidBounds.MinSpreadFactor = osmomath.MustNewDecFromStr("0.02")
+
+	tests := map[string]struct {
+		poolId      uint64
+		config      *types.DynamicSpreadFactorConfig
+		expectedErr error
+	}{
+		"valid config": {
+			poolId: 1,
+			config: &defaultDynamicSpreadFactorConfig,
+		},
+		"nil config disables the dynamic spread factor": {
+			poolId: 1,
+		},
+		"pool does not exist": {
+			poolId:      2,
+			config:      &defaultDynamicSpreadFactorConfig,
+			expectedErr: types.PoolNotFoundError{PoolId: 2},
+		},
+		"min spread factor above max spread factor": {
+			poolId:      1,
+			config:      &invalidBounds,
+			expectedErr: types.InvalidDynamicSpreadFactorBoundsError{MinSpreadFactor: invalidBounds.MinSpreadFactor, MaxSpreadFactor: invalidBounds.MaxSpreadFactor},
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			clk := s.App.ConcentratedLiquidityKeeper
+			s.PrepareConcentratedPool()
+			s.Require().NoError(clk.SetDynamicSpreadFactorConfigs(s.Ctx, []types.PoolIdToDynamicSpreadFactorRecord{{PoolId: 1, Config: &defaultDynamicSpreadFactorConfig}}))
+
+			err := clk.SetDynamicSpreadFactorConfigs(s.Ctx, []types.PoolIdToDynamicSpreadFactorRecord{{PoolId: tc.poolId, Config: tc.config}})
+			if tc.expectedErr != nil {
+				s.Require().ErrorIs(err, tc.expectedErr)
+				return
+			}
+			s.Require().NoError(err)
+
+			config, found, err := clk.GetDynamicSpreadFactorConfig(s.Ctx, tc.poolId)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.config != nil, found)
+			if found {
+				s.Require().Equal(*tc.config, config)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestGetEffectiveSpreadFactor() {
+	s.SetupTest()
+	clk := s.App.ConcentratedLiquidityKeeper
+	pool := s.PrepareConcentratedPool()
+	s.SetupDefaultPosition(pool.GetId())
+
+	// Without a config, the fixed spread factor of the pool is charged.
+	effectiveSpreadFactor, err := clk.GetEffectiveSpreadFactor(s.Ctx, pool)
+	s.Require().NoError(err)
+	s.Require().Equal(pool.GetSpreadFactor(s.Ctx), effectiveSpreadFactor)
+
+	// Without price history over the window, the fixed spread factor is clamped to the bounds.
+	s.Require().NoError(clk.SetDynamicSpreadFactorConfigs(s.Ctx, []types.PoolIdToDynamicSpreadFactorRecord{{PoolId: pool.GetId(), Config: &defaultDynamicSpreadFactorConfig}}))
+	effectiveSpreadFactor, err = clk.GetEffectiveSpreadFactor(s.Ctx, pool)
+	s.Require().NoError(err)
+	s.Require().Equal(defaultDynamicSpreadFactorConfig.MinSpreadFactor, effectiveSpreadFactor)
+
+	// Volatile trading raises the spread factor above the lower bound.
+	s.volatileSwaps(pool.GetId())
+	effectiveSpreadFactor, err = clk.GetEffectiveSpreadFactor(s.Ctx, pool)
+	s.Require().NoError(err)
+	s.Require().True(effectiveSpreadFactor.GT(defaultDynamicSpreadFactorConfig.MinSpreadFactor), effectiveSpreadFactor.String())
+	s.Require().True(effectiveSpreadFactor.LTE(defaultDynamicSpreadFactorConfig.MaxSpreadFactor), effectiveSpreadFactor.String())
+
+	// A lower multiplier yields a lower spread factor for the same volatility.
+	lowMultiplierConfig := defaultDynamicSpreadFactorConfig
+	lowMultiplierConfig.VolatilityMultiplier = osmomath.MustNewDecFromStr("0.0001")
+	s.Require().NoError(clk.SetDynamicSpreadFactorConfigs(s.Ctx, []types.PoolIdToDynamicSpreadFactorRecord{{PoolId: pool.GetId(), Config: &lowMultiplierConfig}}))
+	lowEffectiveSpreadFactor, err := clk.GetEffectiveSpreadFactor(s.Ctx, pool)
+	s.Require().NoError(err)
+	s.Require().True(lowEffectiveSpreadFactor.LT(effectiveSpreadFactor))
+
+	// Disabling the config restores the fixed spread factor.
+	s.Require().NoError(clk.SetDynamicSpreadFactorConfigs(s.Ctx, []types.PoolIdToDynamicSpreadFactorRecord{{PoolId: pool.GetId()}}))
+	effectiveSpreadFactor, err = clk.GetEffectiveSpreadFactor(s.Ctx, pool)
+	s.Require().NoError(err)
+	s.Require().Equal(pool.GetSpreadFactor(s.Ctx), effectiveSpreadFactor)
+}
+
+func (s *KeeperTestSuite) TestSwapChargesEffectiveSpreadFactor() {
+	s.SetupTest()
+	clk := s.App.ConcentratedLiquidityKeeper
+	pool := s.PrepareConcentratedPool()
+	s.SetupDefaultPosition(pool.GetId())
+	tokenIn := sdk.NewCoin(USDC, osmomath.NewInt(1_000_000))
+	fixedSpreadFactor := osmomath.MustNewDecFromStr("0.005")
+
+	// Quote with a fixed spread factor passed by the caller.
+	expectedTokenOut, err := clk.CalcOutAmtGivenIn(s.Ctx, pool, tokenIn, ETH, fixedSpreadFactor)
+	s.Require().NoError(err)
+
+	// With the bounds pinned to the same spread factor, the spread factor passed by the caller is ignored.
+	config := defaultDynamicSpreadFactorConfig
+	config.MinSpreadFactor = fixedSpreadFactor
+	config.MaxSpreadFactor = fixedSpreadFactor
+	s.Require().NoError(clk.SetDynamicSpreadFactorConfigs(s.Ctx, []types.PoolIdToDynamicSpreadFactorRecord{{PoolId: pool.GetId(), Config: &config}}))
+
+	tokenOut, err := clk.CalcOutAmtGivenIn(s.Ctx, pool, tokenIn, ETH, osmomath.ZeroDec())
+	s.Require().NoError(err)
+	s.Require().Equal(expectedTokenOut, tokenOut)
+
+	// The spread rewards of the swap are charged at the effective spread factor.
+	swapper := s.TestAccs[1]
+	s.FundAcc(swapper, sdk.NewCoins(tokenIn))
+	tokenOutAmount, err := clk.SwapExactAmountIn(s.Ctx, swapper, pool, tokenIn, ETH, osmomath.OneInt(), osmomath.ZeroDec())
+	s.Require().NoError(err)
+	s.Require().Equal(expectedTokenOut.Amount, tokenOutAmount)
+
+	spreadRewards := s.App.BankKeeper.GetBalance(s.Ctx, pool.GetSpreadRewardsAddress(), USDC)
+	s.Require().Equal(fixedSpreadFactor.MulInt(tokenIn.Amount).Ceil().TruncateInt(), spreadRewards.Amount)
+}
+
+func (s *KeeperTestSuite) TestDynamicSpreadFactorGenesisRoundTrip() {
+	s.SetupTest()
+	clk := s.App.ConcentratedLiquidityKeeper
+	pool := s.PrepareConcentratedPool()
+	s.Require().NoError(clk.SetDynamicSpreadFactorConfigs(s.Ctx, []types.PoolIdToDynamicSpreadFactorRecord{{PoolId: pool.GetId(), Config: &defaultDynamicSpreadFactorConfig}}))
+
+	exported := clk.ExportGenesis(s.Ctx)
+	s.Require().Len(exported.PoolData, 1)
+	s.Require().NotNil(exported.PoolData[0].DynamicSpreadFactorConfig)
+	s.Require().NoError(exported.Validate())
+
+	s.SetupTest()
+	clk = s.App.ConcentratedLiquidityKeeper
+	clk.InitGenesis(s.Ctx, *exported)
+
+	config, found, err := clk.GetDynamicSpreadFactorConfig(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+	s.Require().True(found)
+	s.Require().Equal(defaultDynamicSpreadFactorConfig, config)
+}
@@ -70,7 +70,7 @@ func (k Keeper) ComputeOutAmtGivenIn(
 	priceLimit osmomath.BigDec,
 	updateAccumulators bool,
 ) (swapResult SwapResult, poolUpdates PoolUpdates, err error) {
-	return k.computeOutAmtGivenIn(ctx, poolId, tokenInMin, tokenOutDenom, spreadFactor, priceLimit, updateAccumulators)
+	return k.computeOutAmtGivenIn(ctx, poolId, tokenInMin, tokenOutDenom, spreadFactor, priceLimit, updateAccumulators, nil)
 }
 
 func (k Keeper) SwapInAmtGivenOut(
@@ -361,3 +361,27 @@ func ScaleDownIncentiveAmount(incentiveAmount osmomath.Int, scalingFactor osmoma
 func (k Keeper) RedepositForfeitedIncentives(ctx sdk.Context, poolId uint64, owner sdk.AccAddress, scaledForfeitedIncentivesByUptime []sdk.Coins, totalForefeitedIncentives sdk.Coins) error {
 	return k.redepositForfeitedIncentives(ctx, poolId, owner, scaledForfeitedIncentivesByUptime, totalForefeitedIncentives)
 }
+
+func (k Keeper) GetLimitOrderIdsAtTick(ctx sdk.Context, poolId uint64, direction types.LimitOrderDirection, tickIndex int64) ([]uint64, error) {
+	return k.getLimitOrderIdsAtTick(ctx, poolId, direction, tickIndex)
+}
+
+func ManagedPositionRange(currentTick int64, tickSpacing uint64, halfWidth int64) (lowerTick, upperTick int64) {
+	return managedPositionRange(currentTick, tickSpacing, halfWidth)
+}
+
+func ComputeManagedPositionSwap(sqrtPrice, sqrtPriceLower, sqrtPriceUpper osmomath.BigDec, amount0, amount1 osmomath.Int) (zeroForOne bool, amountIn osmomath.Int) {
+	return computeManagedPositionSwap(sqrtPrice, sqrtPriceLower, sqrtPriceUpper, amount0, amount1)
+}
+
+func (k Keeper) CompoundPosition(ctx sdk.Context, owner sdk.AccAddress, positionId uint64) (CompoundPositionData, error) {
+	return k.compoundPosition(ctx, owner, positionId)
+}
+
+func (k Keeper) SetPositionAutoCompound(ctx sdk.Context, owner sdk.AccAddress, positionId uint64, enabled bool) error {
+	return k.setPositionAutoCompound(ctx, owner, positionId, enabled)
+}
+
+func (k Keeper) GetAutoCompoundPosition(ctx sdk.Context, positionId uint64) (types.AutoCompoundPosition, bool, error) {
+	return k.getAutoCompoundPosition(ctx, positionId)
+}
@@ -68,6 +68,14 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState genesis.GenesisState) {
 		if err != nil {
 			panic(err)
 		}
+
+		// set dynamic spread factor config, if any
+		if poolData.DynamicSpreadFactorConfig != nil {
+			err = k.setDynamicSpreadFactorConfig(ctx, poolId, poolData.DynamicSpreadFactorConfig)
+			if err != nil {
+				panic(err)
+			}
+		}
 	}
 
 	// set positions for pool
@@ -105,6 +113,62 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState genesis.GenesisState) {
 	k.setTotalLiquidity(ctx, totalLiquidity)
 
 	k.SetIncentivePoolIDMigrationThreshold(ctx, genState.IncentivesAccumulatorPoolIdMigrationThreshold)
+
+	// set limit orders. Their backing positions are owned by the
+	// limit order escrow addresses and are restored above.
+	for _, order := range genState.LimitOrders {
+		if _, ok := seenPoolIds[order.PoolId]; !ok {
+			panic(fmt.Sprintf("found limit order with pool id (%d) but there is no pool with such id that exists", order.PoolId))
+		}
+		k.setLimitOrder(ctx, order)
+	}
+	if genState.NextLimitOrderId != 0 {
+		k.SetNextLimitOrderId(ctx, genState.NextLimitOrderId)
+	}
+
+	// set managed positions. Their backing positions are owned by the
+	// managed position escrow addresses and are restored above.
+	for _, managedPosition := range genState.ManagedPositions {
+		if _, ok := seenPoolIds[managedPosition.PoolId]; !ok {
+			panic(fmt.Sprintf("found managed position with pool id (%d) but there is no pool with such id that exists", managedPosition.PoolId))
+		}
+		k.setManagedPosition(ctx, managedPosition)
+
+		position, err := k.GetPosition(ctx, managedPosition.PositionId)
+		if err != nil {
+			panic(err)
+		}
+		pool, err := k.getPoolById(ctx, managedPosition.PoolId)
+		if err != nil {
+			panic(err)
+		}
+		if managedPosition.IsOutOfRange() {
+			rebalanceTime := managedPosition.OutOfRangeSince.Add(managedPosition.Strategy.OutOfRangeDelay)
+			ctx.KVStore(k.storeKey).Set(types.KeyManagedPositionRebalanceQueue(rebalanceTime, managedPosition.ManagedPositionId), []byte{1})
+		}
+		if _, err := k.indexManagedPositionRange(ctx, managedPosition, position.LowerTick, position.UpperTick, pool.GetCurrentTick()); err != nil {
+			panic(err)
+		}
+	}
+	if genState.NextManagedPositionId != 0 {
+		k.SetNextManagedPositionId(ctx, genState.NextManagedPositionId)
+	}
+
+	// set auto-compounded positions, which are restored above.
+	for _, autoCompoundPosition := range genState.AutoCompoundPositions {
+		if !k.hasPosition(ctx, autoCompoundPosition.PositionId) {
+			panic(fmt.Sprintf("found auto-compound record for position id (%d) but there is no position with such id that exists", autoCompoundPosition.PositionId))
+		}
+		k.setAutoCompoundPosition(ctx, autoCompoundPosition)
+	}
+
+	// set position checkpoints.
+	for _, checkpoint := range genState.PositionCheckpoints {
+		if _, ok := seenPoolIds[checkpoint.PoolId]; !ok {
+			panic(fmt.Sprintf("found checkpoint with pool id (%d) but there is no pool with such id that exists", checkpoint.PoolId))
+		}
+		k.setPositionCheckpoint(ctx, checkpoint)
+	}
 }
 
 // ExportGenesis returns the concentrated-liquidity module's exported genesis state.
@@ -168,12 +232,22 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *genesis.GenesisState {
 			incentivesAccumObject[i] = genesisAccum
 		}
 
+		var dynamicSpreadFactorConfig *types.DynamicSpreadFactorConfig
+		config, found, err := k.GetDynamicSpreadFactorConfig(ctx, poolId)
+		if err != nil {
+			panic(err)
+		}
+		if found {
+			dynamicSpreadFactorConfig = &config
+		}
+
 		poolData = append(poolData, genesis.PoolData{
-			Pool:                    &anyCopy,
-			Ticks:                   ticks,
-			SpreadRewardAccumulator: spreadRewardAccumObject,
-			IncentivesAccumulators:  incentivesAccumObject,
-			IncentiveRecords:        incentiveRecordsForPool,
+			Pool:                      &anyCopy,
+			Ticks:                     ticks,
+			SpreadRewardAccumulator:   spreadRewardAccumObject,
+			IncentivesAccumulators:    incentivesAccumObject,
+			IncentiveRecords:          incentiveRecordsForPool,
+			DynamicSpreadFactorConfig: dynamicSpreadFactorConfig,
 		})
 	}
 
@@ -238,6 +312,26 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *genesis.GenesisState {
 		panic(err)
 	}
 
+	limitOrders, err := k.getAllLimitOrders(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	managedPositions, err := k.getAllManagedPositions(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	autoCompoundPositions, err := k.getAllAutoCompoundPositions(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	positionCheckpoints, err := k.getAllPositionCheckpoints(ctx)
+	if err != nil {
+		panic(err)
+	}
+
 	return &genesis.GenesisState{
 		Params:                k.GetParams(ctx),
 		PoolData:              poolData,
@@ -245,6 +339,12 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *genesis.GenesisState {
 		NextPositionId:        k.GetNextPositionId(ctx),
 		NextIncentiveRecordId: k.GetNextIncentiveRecordId(ctx),
 		IncentivesAccumulatorPoolIdMigrationThreshold: incentivesAccumulatorPoolIDMigrationThreshold,
+		LimitOrders:           limitOrders,
+		NextLimitOrderId:      k.GetNextLimitOrderId(ctx),
+		ManagedPositions:      managedPositions,
+		NextManagedPositionId: k.GetNextManagedPositionId(ctx),
+		AutoCompoundPositions: autoCompoundPositions,
+		PositionCheckpoints:   positionCheckpoints,
 	}
 }
 
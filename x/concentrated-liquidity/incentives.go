@@ -986,6 +986,12 @@ func (k Keeper) collectIncentives(ctx sdk.Context, sender sdk.AccAddress, positi
 		),
 	})
 
+	if !collectedIncentivesForPosition.IsZero() {
+		if err := k.writePositionCheckpoint(ctx, sender, position.PoolId, positionId, positionCheckpointDelta{incentivesCollected: collectedIncentivesForPosition}); err != nil {
+			return sdk.Coins{}, sdk.Coins{}, nil, err
+		}
+	}
+
 	return collectedIncentivesForPosition, totalForfeitedIncentivesForPosition, scaledAmountForfeitedByUptime, nil
 }
 
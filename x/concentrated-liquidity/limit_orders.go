@@ -0,0 +1,451 @@
+package concentrated_liquidity
+
+import (
+	"strconv"
+
+	sdkprefix "github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	gogotypes "github.com/cosmos/gogoproto/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v24/x/concentrated-liquidity/types"
+)
+
+// PlaceLimitOrder places a limit order for the given owner in the given pool.
+// The order is backed by a position spanning [tickIndex, tickIndex + tick spacing) that is
+// owned by the pool's limit order escrow address.
+//
+// The direction is derived from the denom of tokenIn:
+// - token0 places an ask, which must rest entirely above the current tick.
+// - token1 places a bid, which must rest entirely at or below the current tick.
+// Any amount that could not be converted into liquidity due to rounding is refunded to the owner.
+//
+// Returns error if:
+// - the pool does not exist or has no positions to define a current price
+// - tokenIn is not one of the pool tokens
+// - the order range is not fully out of range on the correct side of the current tick
+// - the tick already holds MaxLimitOrdersPerTick open orders in the same direction
+// - the position cannot be created
+func (k Keeper) PlaceLimitOrder(ctx sdk.Context, owner sdk.AccAddress, poolId uint64, tickIndex int64, tokenIn sdk.Coin) (types.LimitOrder, error) {
+	pool, err := k.getPoolById(ctx, poolId)
+	if err != nil {
+		return types.LimitOrder{}, err
+	}
+
+	if !k.PoolHasPosition(ctx, pool) {
+		return types.LimitOrder{}, types.LimitOrderPoolNotInitializedError{PoolId: poolId}
+	}
+
+	var direction types.LimitOrderDirection
+	switch tokenIn.Denom {
+	case pool.GetToken0():
+		direction = types.LimitOrderAsk
+	case pool.GetToken1():
+		direction = types.LimitOrderBid
+	default:
+		return types.LimitOrder{}, types.TokenInDenomNotInPoolError{TokenInDenom: tokenIn.Denom}
+	}
+
+	lowerTick := tickIndex
+	upperTick := tickIndex + int64(pool.GetTickSpacing())
+	if err := validateTickRangeIsValid(pool.GetTickSpacing(), lowerTick, upperTick); err != nil {
+		return types.LimitOrder{}, err
+	}
+
+	currentTick := pool.GetCurrentTick()
+	if (direction == types.LimitOrderAsk && lowerTick <= currentTick) || (direction == types.LimitOrderBid && upperTick > currentTick) {
+		return types.LimitOrder{}, types.LimitOrderTickNotOutOfRangeError{PoolId: poolId, Direction: direction, LowerTick: lowerTick, UpperTick: upperTick, CurrentTick: currentTick}
+	}
+
+	triggerTick := types.LimitOrder{Direction: direction, LowerTick: lowerTick, UpperTick: upperTick}.TriggerTick()
+	numOrdersAtTick, err := k.getNumLimitOrdersAtTick(ctx, poolId, direction, triggerTick)
+	if err != nil {
+		return types.LimitOrder{}, err
+	}
+	if numOrdersAtTick >= types.MaxLimitOrdersPerTick {
+		return types.LimitOrder{}, types.MaxLimitOrdersPerTickReachedError{PoolId: poolId, Direction: direction, TickIndex: triggerTick, Max: types.MaxLimitOrdersPerTick}
+	}
+
+	escrowAddress := types.LimitOrderEscrowAddress(poolId)
+	if err := k.bankKeeper.SendCoins(ctx, owner, escrowAddress, sdk.NewCoins(tokenIn)); err != nil {
+		return types.LimitOrder{}, err
+	}
+
+	positionData, err := k.CreatePosition(ctx, poolId, escrowAddress, sdk.NewCoins(tokenIn), osmomath.ZeroInt(), osmomath.ZeroInt(), lowerTick, upperTick)
+	if err != nil {
+		return types.LimitOrder{}, err
+	}
+
+	quantity := sdk.NewCoin(tokenIn.Denom, positionData.Amount0)
+	if direction == types.LimitOrderBid {
+		quantity = sdk.NewCoin(tokenIn.Denom, positionData.Amount1)
+	}
+
+	// Refund the rounding remainder that did not make it into the position.
+	if refund := tokenIn.Sub(quantity); refund.IsPositive() {
+		if err := k.bankKeeper.SendCoins(ctx, escrowAddress, owner, sdk.NewCoins(refund)); err != nil {
+			return types.LimitOrder{}, err
+		}
+	}
+
+	order := types.LimitOrder{
+		OrderId:    k.getNextLimitOrderIdAndIncrement(ctx),
+		PoolId:     poolId,
+		Owner:      owner.String(),
+		PositionId: positionData.ID,
+		Direction:  direction,
+		LowerTick:  positionData.LowerTick,
+		UpperTick:  positionData.UpperTick,
+		Quantity:   quantity,
+		Liquidity:  positionData.Liquidity,
+		PlacedTime: ctx.BlockTime(),
+	}
+
+	k.setLimitOrder(ctx, order)
+
+	emitLimitOrderEvent(ctx, types.TypeEvtPlaceLimitOrder, order, types.AttributeKeyTokensIn, sdk.NewCoins(quantity))
+
+	return order, nil
+}
+
+// CancelLimitOrder withdraws the position backing an open limit order and sends everything it
+// holds to the owner. If the price is inside the order's range, the order is partially filled and
+// both pool tokens are returned. Any spread rewards and incentives earned by the order are included.
+//
+// Returns error if the order does not exist, is not owned by owner or is already filled.
+func (k Keeper) CancelLimitOrder(ctx sdk.Context, owner sdk.AccAddress, orderId uint64) (sdk.Coins, error) {
+	order, err := k.GetLimitOrder(ctx, orderId)
+	if err != nil {
+		return nil, err
+	}
+
+	if order.Owner != owner.String() {
+		return nil, types.NotLimitOrderOwnerError{OrderId: orderId, Address: owner.String()}
+	}
+
+	if order.Filled {
+		return nil, types.LimitOrderAlreadyFilledError{OrderId: orderId}
+	}
+
+	tokensOut, err := k.withdrawLimitOrderPosition(ctx, order)
+	if err != nil {
+		return nil, err
+	}
+
+	if !tokensOut.Empty() {
+		if err := k.bankKeeper.SendCoins(ctx, types.LimitOrderEscrowAddress(order.PoolId), owner, tokensOut); err != nil {
+			return nil, err
+		}
+	}
+
+	k.deleteLimitOrder(ctx, order)
+
+	emitLimitOrderEvent(ctx, types.TypeEvtCancelLimitOrder, order, types.AttributeKeyTokensOut, tokensOut)
+
+	return tokensOut, nil
+}
+
+// ClaimLimitOrder sends the proceeds of a filled limit order to its owner and removes the order.
+// An open order whose range has been fully crossed by the current price, but that was not filled
+// by the swap that crossed it, is filled first.
+//
+// Returns error if the order does not exist, is not owned by owner or is not filled yet.
+func (k Keeper) ClaimLimitOrder(ctx sdk.Context, owner sdk.AccAddress, orderId uint64) (sdk.Coins, error) {
+	order, err := k.GetLimitOrder(ctx, orderId)
+	if err != nil {
+		return nil, err
+	}
+
+	if order.Owner != owner.String() {
+		return nil, types.NotLimitOrderOwnerError{OrderId: orderId, Address: owner.String()}
+	}
+
+	if !order.Filled {
+		pool, err := k.getPoolById(ctx, order.PoolId)
+		if err != nil {
+			return nil, err
+		}
+		if !isLimitOrderFullyCrossed(order, pool.GetCurrentTick()) {
+			return nil, types.LimitOrderNotFilledError{OrderId: orderId}
+		}
+
+		if order, err = k.fillLimitOrder(ctx, orderId); err != nil {
+			return nil, err
+		}
+	}
+
+	if !order.Claimable.Empty() {
+		if err := k.bankKeeper.SendCoins(ctx, types.LimitOrderEscrowAddress(order.PoolId), owner, order.Claimable); err != nil {
+			return nil, err
+		}
+	}
+
+	k.deleteLimitOrder(ctx, order)
+
+	emitLimitOrderEvent(ctx, types.TypeEvtClaimLimitOrder, order, types.AttributeKeyTokensOut, order.Claimable)
+
+	return order.Claimable, nil
+}
+
+// fillLimitOrdersAtCrossedTicks fills the open orders that are fully converted by a swap
+// in the given direction crossing the given ticks. A swap of token0 for token1 fills the bids
+// whose lower tick was crossed, while a swap of token1 for token0 fills the asks whose upper
+// tick was crossed.
+//
+// At most MaxLimitOrderFillsPerSwap fills are attempted. Each fill is applied in its own cache
+// context, and an order that fails to fill, including one holding the last position in the pool,
+// is left open for its owner to claim, without failing the swap.
+//
+// Must be called after the pool has been updated with the result of the swap.
+func (k Keeper) fillLimitOrdersAtCrossedTicks(ctx sdk.Context, poolId uint64, zeroForOne bool, crossedTicks []int64) error {
+	direction := types.LimitOrderDirectionForSwap(zeroForOne)
+	numFillsAttempted := 0
+	for _, tick := range crossedTicks {
+		if numFillsAttempted >= types.MaxLimitOrderFillsPerSwap {
+			return nil
+		}
+
+		orderIds, err := k.getLimitOrderIdsAtTick(ctx, poolId, direction, tick)
+		if err != nil {
+			return err
+		}
+
+		for _, orderId := range orderIds {
+			if numFillsAttempted >= types.MaxLimitOrderFillsPerSwap {
+				return nil
+			}
+			numFillsAttempted++
+
+			_ = osmoutils.ApplyFuncIfNoError(ctx, func(cacheCtx sdk.Context) error {
+				order, err := k.fillLimitOrder(cacheCtx, orderId)
+				if err != nil {
+					return err
+				}
+
+				// Withdrawing the last position would reset the price of the pool within the swap.
+				if hasPositions, err := k.HasAnyPositionForPool(cacheCtx, poolId); err != nil {
+					return err
+				} else if !hasPositions {
+					return types.FillLastPositionInPoolError{PoolId: poolId, OrderId: order.OrderId}
+				}
+				return nil
+			})
+		}
+	}
+	return nil
+}
+
+// fillLimitOrder withdraws the position backing the order and records everything it held as
+// claimable by the owner. The order stays in state until it is claimed.
+func (k Keeper) fillLimitOrder(ctx sdk.Context, orderId uint64) (types.LimitOrder, error) {
+	order, err := k.GetLimitOrder(ctx, orderId)
+	if err != nil {
+		return types.LimitOrder{}, err
+	}
+
+	claimable, err := k.withdrawLimitOrderPosition(ctx, order)
+	if err != nil {
+		return types.LimitOrder{}, err
+	}
+
+	// Remove the tick index entry before the order is marked as filled
+	// so that the order is not picked up by later tick crossings.
+	k.deleteLimitOrder(ctx, order)
+
+	order.Filled = true
+	order.Claimable = claimable
+	k.setLimitOrder(ctx, order)
+
+	emitLimitOrderEvent(ctx, types.TypeEvtFillLimitOrder, order, types.AttributeKeyTokensOut, claimable)
+
+	return order, nil
+}
+
+// isLimitOrderFullyCrossed returns true if the current tick is past the trigger tick of the order,
+// that is if the position backing the order is fully converted.
+func isLimitOrderFullyCrossed(order types.LimitOrder, currentTick int64) bool {
+	if order.Direction == types.LimitOrderAsk {
+		return currentTick >= order.UpperTick
+	}
+	return currentTick < order.LowerTick
+}
+
+// withdrawLimitOrderPosition withdraws the full liquidity of the position backing the order
+// into the escrow address and returns the coins the escrow address received.
+func (k Keeper) withdrawLimitOrderPosition(ctx sdk.Context, order types.LimitOrder) (sdk.Coins, error) {
+	return k.withdrawEscrowedPosition(ctx, types.LimitOrderEscrowAddress(order.PoolId), order.PositionId)
+}
+
+// GetLimitOrder returns the limit order with the given id.
+func (k Keeper) GetLimitOrder(ctx sdk.Context, orderId uint64) (types.LimitOrder, error) {
+	store := ctx.KVStore(k.storeKey)
+
+	order := types.LimitOrder{}
+	found, err := osmoutils.Get(store, types.KeyLimitOrder(orderId), &order)
+	if err != nil {
+		return types.LimitOrder{}, err
+	}
+	if !found {
+		return types.LimitOrder{}, types.LimitOrderNotFoundError{OrderId: orderId}
+	}
+
+	return order, nil
+}
+
+// getAllLimitOrders returns all limit orders in state, ordered by id.
+func (k Keeper) getAllLimitOrders(ctx sdk.Context) ([]types.LimitOrder, error) {
+	return osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(k.storeKey), types.LimitOrderPrefix, ParseLimitOrderFromBz)
+}
+
+// setLimitOrder stores the order together with its owner index and, for open orders,
+// the index used to find the order when its trigger tick is crossed.
+func (k Keeper) setLimitOrder(ctx sdk.Context, order types.LimitOrder) {
+	store := ctx.KVStore(k.storeKey)
+	owner := sdk.MustAccAddressFromBech32(order.Owner)
+
+	osmoutils.MustSet(store, types.KeyLimitOrder(order.OrderId), &order)
+	store.Set(types.KeyUserLimitOrder(owner, order.PoolId, order.OrderId), []byte{1})
+	if !order.Filled {
+		store.Set(types.KeyLimitOrderAtTick(order.PoolId, order.Direction, order.TriggerTick(), order.OrderId), []byte{1})
+	}
+}
+
+// deleteLimitOrder removes the order and all of its indexes from state.
+func (k Keeper) deleteLimitOrder(ctx sdk.Context, order types.LimitOrder) {
+	store := ctx.KVStore(k.storeKey)
+	owner := sdk.MustAccAddressFromBech32(order.Owner)
+
+	store.Delete(types.KeyLimitOrder(order.OrderId))
+	store.Delete(types.KeyUserLimitOrder(owner, order.PoolId, order.OrderId))
+	store.Delete(types.KeyLimitOrderAtTick(order.PoolId, order.Direction, order.TriggerTick(), order.OrderId))
+}
+
+// getLimitOrderIdsAtTick returns the ids of the open orders in the given direction
+// that are filled by crossing the given tick, in ascending order.
+func (k Keeper) getLimitOrderIdsAtTick(ctx sdk.Context, poolId uint64, direction types.LimitOrderDirection, tickIndex int64) ([]uint64, error) {
+	return osmoutils.GatherValuesFromStorePrefixWithKeyParser(ctx.KVStore(k.storeKey), types.KeyLimitOrdersAtTick(poolId, direction, tickIndex), func(key []byte, _ []byte) (uint64, error) {
+		return types.LimitOrderIdFromIndexKey(key)
+	})
+}
+
+// getNumLimitOrdersAtTick returns the number of open orders in the given direction
+// that are filled by crossing the given tick.
+func (k Keeper) getNumLimitOrdersAtTick(ctx sdk.Context, poolId uint64, direction types.LimitOrderDirection, tickIndex int64) (int, error) {
+	orderIds, err := k.getLimitOrderIdsAtTick(ctx, poolId, direction, tickIndex)
+	return len(orderIds), err
+}
+
+// GetLimitOrderBreakdown returns the order together with the amounts of asset0 and asset1
+// the backing position currently holds. Filled orders report zero amounts as their
+// proceeds are tracked in the order's claimable coins.
+func (k Keeper) GetLimitOrderBreakdown(ctx sdk.Context, order types.LimitOrder) (types.FullLimitOrderBreakdown, error) {
+	pool, err := k.GetConcentratedPoolById(ctx, order.PoolId)
+	if err != nil {
+		return types.FullLimitOrderBreakdown{}, err
+	}
+
+	if order.Filled {
+		return types.FullLimitOrderBreakdown{
+			Order:  order,
+			Asset0: sdk.NewCoin(pool.GetToken0(), osmomath.ZeroInt()),
+			Asset1: sdk.NewCoin(pool.GetToken1(), osmomath.ZeroInt()),
+		}, nil
+	}
+
+	position, err := k.GetPosition(ctx, order.PositionId)
+	if err != nil {
+		return types.FullLimitOrderBreakdown{}, err
+	}
+
+	asset0, asset1, err := CalculateUnderlyingAssetsFromPosition(ctx, position, pool)
+	if err != nil {
+		return types.FullLimitOrderBreakdown{}, err
+	}
+
+	return types.FullLimitOrderBreakdown{
+		Order:  order,
+		Asset0: asset0,
+		Asset1: asset1,
+	}, nil
+}
+
+// GetUserLimitOrdersSerialized returns the limit orders placed by the given address, optionally
+// filtered by pool id, in a way that can be paginated.
+func (k Keeper) GetUserLimitOrdersSerialized(ctx sdk.Context, addr sdk.AccAddress, poolId uint64, pagination *query.PageRequest) ([]types.FullLimitOrderBreakdown, *query.PageResponse, error) {
+	prefix := types.KeyUserLimitOrders(addr)
+	if poolId != 0 {
+		prefix = types.KeyUserPoolLimitOrders(addr, poolId)
+	}
+
+	ordersStore := sdkprefix.NewStore(ctx.KVStore(k.storeKey), prefix)
+
+	orders := []types.FullLimitOrderBreakdown{}
+	pageRes, err := query.Paginate(ordersStore, pagination, func(key, _ []byte) error {
+		orderId, err := types.LimitOrderIdFromIndexKey(key)
+		if err != nil {
+			return err
+		}
+
+		order, err := k.GetLimitOrder(ctx, orderId)
+		if err != nil {
+			return err
+		}
+
+		breakdown, err := k.GetLimitOrderBreakdown(ctx, order)
+		if err != nil {
+			return err
+		}
+
+		orders = append(orders, breakdown)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return orders, pageRes, nil
+}
+
+// GetNextLimitOrderId returns the next limit order id.
+// Defaults to 1 if no limit order has been placed yet.
+func (k Keeper) GetNextLimitOrderId(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	nextLimitOrderId := gogotypes.UInt64Value{}
+	found, err := osmoutils.Get(store, types.KeyNextGlobalLimitOrderId, &nextLimitOrderId)
+	if err != nil {
+		panic(err)
+	}
+	if !found {
+		return 1
+	}
+	return nextLimitOrderId.Value
+}
+
+// SetNextLimitOrderId sets the next limit order id.
+func (k Keeper) SetNextLimitOrderId(ctx sdk.Context, orderId uint64) {
+	store := ctx.KVStore(k.storeKey)
+	osmoutils.MustSet(store, types.KeyNextGlobalLimitOrderId, &gogotypes.UInt64Value{Value: orderId})
+}
+
+func (k Keeper) getNextLimitOrderIdAndIncrement(ctx sdk.Context) uint64 {
+	nextLimitOrderId := k.GetNextLimitOrderId(ctx)
+	k.SetNextLimitOrderId(ctx, nextLimitOrderId+1)
+	return nextLimitOrderId
+}
+
+func emitLimitOrderEvent(ctx sdk.Context, eventType string, order types.LimitOrder, tokensAttributeKey string, tokens sdk.Coins) {
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		eventType,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(types.AttributeKeyLimitOrderId, strconv.FormatUint(order.OrderId, 10)),
+		sdk.NewAttribute(sdk.AttributeKeySender, order.Owner),
+		sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(order.PoolId, 10)),
+		sdk.NewAttribute(types.AttributeKeyPositionId, strconv.FormatUint(order.PositionId, 10)),
+		sdk.NewAttribute(types.AttributeKeyLimitOrderDirection, order.Direction.String()),
+		sdk.NewAttribute(types.AttributeLowerTick, strconv.FormatInt(order.LowerTick, 10)),
+		sdk.NewAttribute(types.AttributeUpperTick, strconv.FormatInt(order.UpperTick, 10)),
+		sdk.NewAttribute(tokensAttributeKey, tokens.String()),
+	))
+}
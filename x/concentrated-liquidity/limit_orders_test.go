@@ -0,0 +1,384 @@
+package concentrated_liquidity_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v24/app/apptesting"
+	"github.com/osmosis-labs/osmosis/v24/x/concentrated-liquidity/types"
+)
+
+var (
+	// one tick spacing above the default current tick, i.e. prices [5000.1, 5000.2).
+	askLowerTick = DefaultCurrTick + int64(DefaultTickSpacing)
+	// one tick spacing below the default current tick, i.e. prices [4999.8, 4999.9).
+	bidLowerTick = DefaultCurrTick - 2*int64(DefaultTickSpacing)
+
+	defaultAskCoin = sdk.NewCoin(ETH, osmomath.NewInt(1_000))
+	defaultBidCoin = sdk.NewCoin(USDC, osmomath.NewInt(5_000_000))
+)
+
+// placeLimitOrder funds the owner with the given coin and places a limit order at the given tick.
+func (s *KeeperTestSuite) placeLimitOrder(poolId uint64, owner sdk.AccAddress, tickIndex int64, tokenIn sdk.Coin) types.LimitOrder {
+	s.FundAcc(owner, sdk.NewCoins(tokenIn))
+	order, err := s.App.ConcentratedLiquidityKeeper.PlaceLimitOrder(s.Ctx, owner, poolId, tickIndex, tokenIn)
+	s.Require().NoError(err)
+	return order
+}
+
+// swapExactAmountIn funds the swapper and swaps the given coin into the pool.
+func (s *KeeperTestSuite) swapExactAmountIn(poolId uint64, tokenIn sdk.Coin, tokenOutDenom string) {
+	swapper := apptesting.CreateRandomAccounts(1)[0]
+	s.FundAcc(swapper, sdk.NewCoins(tokenIn))
+	pool, err := s.App.ConcentratedLiquidityKeeper.GetConcentratedPoolById(s.Ctx, poolId)
+	s.Require().NoError(err)
+	_, err = s.App.ConcentratedLiquidityKeeper.SwapExactAmountIn(s.Ctx, swapper, pool, tokenIn, tokenOutDenom, osmomath.OneInt(), pool.GetSpreadFactor(s.Ctx))
+	s.Require().NoError(err)
+}
+
+func (s *KeeperTestSuite) TestPlaceLimitOrder() {
+	tests := map[string]struct {
+		tickIndex        int64
+		tokenIn          sdk.Coin
+		skipPosition     bool
+		existingOrders   int
+		expectedErr      error
+		expectDirection  types.LimitOrderDirection
+		expectTriggerTck int64
+	}{
+		"ask above current tick": {
+			tickIndex:        askLowerTick,
+			tokenIn:          defaultAskCoin,
+			expectDirection:  types.LimitOrderAsk,
+			expectTriggerTck: askLowerTick + int64(DefaultTickSpacing),
+		},
+		"bid below current tick": {
+			tickIndex:        bidLowerTick,
+			tokenIn:          defaultBidCoin,
+			expectDirection:  types.LimitOrderBid,
+			expectTriggerTck: bidLowerTick,
+		},
+		"bid ending at current tick": {
+			tickIndex:        DefaultCurrTick - int64(DefaultTickSpacing),
+			tokenIn:          defaultBidCoin,
+			expectDirection:  types.LimitOrderBid,
+			expectTriggerTck: DefaultCurrTick - int64(DefaultTickSpacing),
+		},
+		"error: ask starting at current tick": {
+			tickIndex:   DefaultCurrTick,
+			tokenIn:     defaultAskCoin,
+			expectedErr: types.LimitOrderTickNotOutOfRangeError{PoolId: 1, Direction: types.LimitOrderAsk, LowerTick: DefaultCurrTick, UpperTick: DefaultCurrTick + int64(DefaultTickSpacing), CurrentTick: DefaultCurrTick},
+		},
+		"error: bid spanning current tick": {
+			tickIndex:   DefaultCurrTick,
+			tokenIn:     defaultBidCoin,
+			expectedErr: types.LimitOrderTickNotOutOfRangeError{PoolId: 1, Direction: types.LimitOrderBid, LowerTick: DefaultCurrTick, UpperTick: DefaultCurrTick + int64(DefaultTickSpacing), CurrentTick: DefaultCurrTick},
+		},
+		"error: token not in pool": {
+			tickIndex:   askLowerTick,
+			tokenIn:     sdk.NewCoin(FOO, osmomath.NewInt(1_000)),
+			expectedErr: types.TokenInDenomNotInPoolError{TokenInDenom: FOO},
+		},
+		"error: tick not a multiple of tick spacing": {
+			tickIndex:   askLowerTick + 1,
+			tokenIn:     defaultAskCoin,
+			expectedErr: types.TickSpacingError{TickSpacing: DefaultTickSpacing, LowerTick: askLowerTick + 1, UpperTick: askLowerTick + 1 + int64(DefaultTickSpacing)},
+		},
+		"error: pool has no positions": {
+			tickIndex:    askLowerTick,
+			tokenIn:      defaultAskCoin,
+			skipPosition: true,
+			expectedErr:  types.LimitOrderPoolNotInitializedError{PoolId: 1},
+		},
+		"error: tick is full": {
+			tickIndex:      askLowerTick,
+			tokenIn:        defaultAskCoin,
+			existingOrders: types.MaxLimitOrdersPerTick,
+			expectedErr:    types.MaxLimitOrdersPerTickReachedError{PoolId: 1, Direction: types.LimitOrderAsk, TickIndex: askLowerTick + int64(DefaultTickSpacing), Max: types.MaxLimitOrdersPerTick},
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			clk := s.App.ConcentratedLiquidityKeeper
+			pool := s.PrepareConcentratedPool()
+			if !tc.skipPosition {
+				s.SetupDefaultPosition(pool.GetId())
+			}
+			for i := 0; i < tc.existingOrders; i++ {
+				s.placeLimitOrder(pool.GetId(), s.TestAccs[2], tc.tickIndex, tc.tokenIn)
+			}
+
+			owner := s.TestAccs[1]
+			s.FundAcc(owner, sdk.NewCoins(tc.tokenIn))
+
+			order, err := clk.PlaceLimitOrder(s.Ctx, owner, pool.GetId(), tc.tickIndex, tc.tokenIn)
+			if tc.expectedErr != nil {
+				s.Require().ErrorContains(err, tc.expectedErr.Error())
+				return
+			}
+			s.Require().NoError(err)
+
+			s.Require().Equal(tc.expectDirection, order.Direction)
+			s.Require().Equal(tc.expectTriggerTck, order.TriggerTick())
+			s.Require().Equal(tc.tickIndex, order.LowerTick)
+			s.Require().Equal(tc.tickIndex+int64(DefaultTickSpacing), order.UpperTick)
+			s.Require().False(order.Filled)
+			s.Require().True(order.Quantity.IsPositive())
+			s.Require().True(order.Quantity.Amount.LTE(tc.tokenIn.Amount))
+
+			// The owner is only charged what made it into the position.
+			s.Require().Equal(tc.tokenIn.Amount.Sub(order.Quantity.Amount).String(), s.App.BankKeeper.GetBalance(s.Ctx, owner, tc.tokenIn.Denom).Amount.String())
+
+			// The backing position is owned by the escrow address.
+			position, err := clk.GetPosition(s.Ctx, order.PositionId)
+			s.Require().NoError(err)
+			s.Require().Equal(types.LimitOrderEscrowAddress(pool.GetId()).String(), position.Address)
+			s.Require().Equal(order.Liquidity, position.Liquidity)
+
+			storedOrder, err := clk.GetLimitOrder(s.Ctx, order.OrderId)
+			s.Require().NoError(err)
+			s.Require().Equal(order.String(), storedOrder.String())
+			s.Require().Equal(order.OrderId+1, clk.GetNextLimitOrderId(s.Ctx))
+
+			s.AssertEventEmitted(s.Ctx, types.TypeEvtPlaceLimitOrder, 1)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestLimitOrderFillAndClaim() {
+	tests := map[string]struct {
+		tickIndex     int64
+		tokenIn       sdk.Coin
+		swapIn        sdk.Coin
+		swapOutDenom  string
+		proceedsDenom string
+	}{
+		"ask is filled by price moving up": {
+			tickIndex:     askLowerTick,
+			tokenIn:       defaultAskCoin,
+			swapIn:        sdk.NewCoin(USDC, osmomath.NewInt(100_000_000)),
+			swapOutDenom:  ETH,
+			proceedsDenom: USDC,
+		},
+		"bid is filled by price moving down": {
+			tickIndex:     bidLowerTick,
+			tokenIn:       defaultBidCoin,
+			swapIn:        sdk.NewCoin(ETH, osmomath.NewInt(20_000)),
+			swapOutDenom:  USDC,
+			proceedsDenom: ETH,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			clk := s.App.ConcentratedLiquidityKeeper
+			pool := s.PrepareConcentratedPool()
+			s.SetupDefaultPosition(pool.GetId())
+
+			owner := s.TestAccs[1]
+			order := s.placeLimitOrder(pool.GetId(), owner, tc.tickIndex, tc.tokenIn)
+
+			// An open order can not be claimed.
+			_, err := clk.ClaimLimitOrder(s.Ctx, owner, order.OrderId)
+			s.Require().ErrorIs(err, types.LimitOrderNotFilledError{OrderId: order.OrderId})
+
+			s.swapExactAmountIn(pool.GetId(), tc.swapIn, tc.swapOutDenom)
+
+			filledOrder, err := clk.GetLimitOrder(s.Ctx, order.OrderId)
+			s.Require().NoError(err)
+			s.Require().True(filledOrder.Filled)
+			s.Require().True(filledOrder.Claimable.AmountOf(tc.proceedsDenom).IsPositive())
+			s.Require().True(filledOrder.Claimable.AmountOf(tc.tokenIn.Denom).IsZero())
+			s.AssertEventEmitted(s.Ctx, types.TypeEvtFillLimitOrder, 1)
+
+			// The backing position is withdrawn on fill.
+			_, err = clk.GetPosition(s.Ctx, order.PositionId)
+			s.Require().ErrorIs(err, types.PositionIdNotFoundError{PositionId: order.PositionId})
+
+			// The order no longer rests on its trigger tick.
+			orderIds, err := clk.GetLimitOrderIdsAtTick(s.Ctx, pool.GetId(), order.Direction, order.TriggerTick())
+			s.Require().NoError(err)
+			s.Require().Len(orderIds, 0)
+
+			// A filled order can not be cancelled.
+			_, err = clk.CancelLimitOrder(s.Ctx, owner, order.OrderId)
+			s.Require().ErrorIs(err, types.LimitOrderAlreadyFilledError{OrderId: order.OrderId})
+
+			// Only the owner can claim.
+			_, err = clk.ClaimLimitOrder(s.Ctx, s.TestAccs[2], order.OrderId)
+			s.Require().ErrorIs(err, types.NotLimitOrderOwnerError{OrderId: order.OrderId, Address: s.TestAccs[2].String()})
+
+			balanceBefore := s.App.BankKeeper.GetAllBalances(s.Ctx, owner)
+			claimed, err := clk.ClaimLimitOrder(s.Ctx, owner, order.OrderId)
+			s.Require().NoError(err)
+			s.Require().Equal(filledOrder.Claimable, claimed)
+			s.Require().Equal(balanceBefore.Add(claimed...), s.App.BankKeeper.GetAllBalances(s.Ctx, owner))
+			s.Require().True(s.App.BankKeeper.GetAllBalances(s.Ctx, types.LimitOrderEscrowAddress(pool.GetId())).IsZero())
+
+			_, err = clk.GetLimitOrder(s.Ctx, order.OrderId)
+			s.Require().ErrorIs(err, types.LimitOrderNotFoundError{OrderId: order.OrderId})
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestLimitOrderFillsCappedPerSwap() {
+	s.SetupTest()
+	clk := s.App.ConcentratedLiquidityKeeper
+	pool := s.PrepareConcentratedPool()
+	s.SetupDefaultPosition(pool.GetId())
+
+	owner := s.TestAccs[1]
+	orders := make([]types.LimitOrder, types.MaxLimitOrderFillsPerSwap+1)
+	for i := range orders {
+		orders[i] = s.placeLimitOrder(pool.GetId(), owner, askLowerTick, defaultAskCoin)
+	}
+
+	s.swapExactAmountIn(pool.GetId(), sdk.NewCoin(USDC, osmomath.NewInt(100_000_000)), ETH)
+
+	// Only the first orders up to the limit are filled by the swap.
+	for _, order := range orders[:types.MaxLimitOrderFillsPerSwap] {
+		filledOrder, err := clk.GetLimitOrder(s.Ctx, order.OrderId)
+		s.Require().NoError(err)
+		s.Require().True(filledOrder.Filled)
+	}
+	lastOrder := orders[types.MaxLimitOrderFillsPerSwap]
+	openOrder, err := clk.GetLimitOrder(s.Ctx, lastOrder.OrderId)
+	s.Require().NoError(err)
+	s.Require().False(openOrder.Filled)
+
+	// The order over the limit is filled when claimed, as its range was fully crossed.
+	claimed, err := clk.ClaimLimitOrder(s.Ctx, owner, lastOrder.OrderId)
+	s.Require().NoError(err)
+	s.Require().True(claimed.AmountOf(USDC).IsPositive())
+	s.Require().True(claimed.AmountOf(ETH).IsZero())
+
+	_, err = clk.GetLimitOrder(s.Ctx, lastOrder.OrderId)
+	s.Require().ErrorIs(err, types.LimitOrderNotFoundError{OrderId: lastOrder.OrderId})
+	_, err = clk.GetPosition(s.Ctx, lastOrder.PositionId)
+	s.Require().ErrorIs(err, types.PositionIdNotFoundError{PositionId: lastOrder.PositionId})
+}
+
+func (s *KeeperTestSuite) TestLimitOrderPartialFillAndCancel() {
+	s.SetupTest()
+	clk := s.App.ConcentratedLiquidityKeeper
+	pool := s.PrepareConcentratedPool()
+	s.SetupDefaultPosition(pool.GetId())
+
+	owner := s.TestAccs[1]
+	order := s.placeLimitOrder(pool.GetId(), owner, askLowerTick, defaultAskCoin)
+
+	// Move the price into the order's range without crossing its upper tick.
+	s.swapExactAmountIn(pool.GetId(), sdk.NewCoin(USDC, osmomath.NewInt(2_000_000)), ETH)
+
+	pool, err := clk.GetConcentratedPoolById(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+	s.Require().GreaterOrEqual(pool.GetCurrentTick(), order.LowerTick)
+	s.Require().Less(pool.GetCurrentTick(), order.UpperTick)
+
+	breakdown, err := clk.GetLimitOrderBreakdown(s.Ctx, order)
+	s.Require().NoError(err)
+	s.Require().False(breakdown.Order.Filled)
+	s.Require().True(breakdown.Asset0.Amount.IsPositive())
+	s.Require().True(breakdown.Asset0.Amount.LT(order.Quantity.Amount))
+	s.Require().True(breakdown.Asset1.Amount.IsPositive())
+
+	// Only the owner can cancel.
+	_, err = clk.CancelLimitOrder(s.Ctx, s.TestAccs[2], order.OrderId)
+	s.Require().ErrorIs(err, types.NotLimitOrderOwnerError{OrderId: order.OrderId, Address: s.TestAccs[2].String()})
+
+	tokensOut, err := clk.CancelLimitOrder(s.Ctx, owner, order.OrderId)
+	s.Require().NoError(err)
+	s.Require().True(tokensOut.AmountOf(ETH).IsPositive())
+	s.Require().True(tokensOut.AmountOf(USDC).IsPositive())
+	s.Require().Equal(tokensOut, s.App.BankKeeper.GetAllBalances(s.Ctx, owner))
+	s.AssertEventEmitted(s.Ctx, types.TypeEvtCancelLimitOrder, 1)
+
+	_, err = clk.GetLimitOrder(s.Ctx, order.OrderId)
+	s.Require().ErrorIs(err, types.LimitOrderNotFoundError{OrderId: order.OrderId})
+	_, err = clk.GetPosition(s.Ctx, order.PositionId)
+	s.Require().ErrorIs(err, types.PositionIdNotFoundError{PositionId: order.PositionId})
+}
+
+func (s *KeeperTestSuite) TestLimitOrderNotFilledByOppositeSwap() {
+	s.SetupTest()
+	clk := s.App.ConcentratedLiquidityKeeper
+	pool := s.PrepareConcentratedPool()
+	s.SetupDefaultPosition(pool.GetId())
+
+	ask := s.placeLimitOrder(pool.GetId(), s.TestAccs[1], askLowerTick, defaultAskCoin)
+
+	// Swapping token0 in moves the price down, away from the ask.
+	s.swapExactAmountIn(pool.GetId(), sdk.NewCoin(ETH, osmomath.NewInt(20_000)), USDC)
+
+	order, err := clk.GetLimitOrder(s.Ctx, ask.OrderId)
+	s.Require().NoError(err)
+	s.Require().False(order.Filled)
+	orderIds, err := clk.GetLimitOrderIdsAtTick(s.Ctx, pool.GetId(), types.LimitOrderAsk, ask.TriggerTick())
+	s.Require().NoError(err)
+	s.Require().Equal([]uint64{ask.OrderId}, orderIds)
+}
+
+func (s *KeeperTestSuite) TestGetUserLimitOrdersSerialized() {
+	s.SetupTest()
+	clk := s.App.ConcentratedLiquidityKeeper
+	poolOne := s.PrepareConcentratedPool()
+	s.SetupDefaultPosition(poolOne.GetId())
+	poolTwo := s.PrepareConcentratedPool()
+	s.SetupDefaultPosition(poolTwo.GetId())
+
+	owner := s.TestAccs[1]
+	orderOne := s.placeLimitOrder(poolOne.GetId(), owner, askLowerTick, defaultAskCoin)
+	orderTwo := s.placeLimitOrder(poolTwo.GetId(), owner, bidLowerTick, defaultBidCoin)
+	s.placeLimitOrder(poolOne.GetId(), s.TestAccs[2], askLowerTick, defaultAskCoin)
+
+	orders, _, err := clk.GetUserLimitOrdersSerialized(s.Ctx, owner, 0, nil)
+	s.Require().NoError(err)
+	s.Require().Len(orders, 2)
+	s.Require().Equal(orderOne.OrderId, orders[0].Order.OrderId)
+	s.Require().Equal(orderTwo.OrderId, orders[1].Order.OrderId)
+
+	orders, _, err = clk.GetUserLimitOrdersSerialized(s.Ctx, owner, poolTwo.GetId(), nil)
+	s.Require().NoError(err)
+	s.Require().Len(orders, 1)
+	s.Require().Equal(orderTwo.OrderId, orders[0].Order.OrderId)
+	s.Require().True(orders[0].Asset1.Amount.IsPositive())
+	s.Require().True(orders[0].Asset0.Amount.IsZero())
+}
+
+func (s *KeeperTestSuite) TestLimitOrderGenesisRoundTrip() {
+	s.SetupTest()
+	clk := s.App.ConcentratedLiquidityKeeper
+	pool := s.PrepareConcentratedPool()
+	s.SetupDefaultPosition(pool.GetId())
+
+	openOrder := s.placeLimitOrder(pool.GetId(), s.TestAccs[1], bidLowerTick, defaultBidCoin)
+	filledOrder := s.placeLimitOrder(pool.GetId(), s.TestAccs[1], askLowerTick, defaultAskCoin)
+	s.swapExactAmountIn(pool.GetId(), sdk.NewCoin(USDC, osmomath.NewInt(100_000_000)), ETH)
+
+	exported := clk.ExportGenesis(s.Ctx)
+	s.Require().Len(exported.LimitOrders, 2)
+	s.Require().Equal(filledOrder.OrderId+1, exported.NextLimitOrderId)
+	s.Require().NoError(exported.Validate())
+
+	s.SetupTest()
+	clk = s.App.ConcentratedLiquidityKeeper
+	clk.InitGenesis(s.Ctx, *exported)
+
+	s.Require().Equal(exported.NextLimitOrderId, clk.GetNextLimitOrderId(s.Ctx))
+	for _, expected := range exported.LimitOrders {
+		actual, err := clk.GetLimitOrder(s.Ctx, expected.OrderId)
+		s.Require().NoError(err)
+		s.Require().Equal(expected.String(), actual.String())
+	}
+
+	// Only the open order is indexed on its trigger tick.
+	orderIds, err := clk.GetLimitOrderIdsAtTick(s.Ctx, pool.GetId(), types.LimitOrderBid, openOrder.TriggerTick())
+	s.Require().NoError(err)
+	s.Require().Equal([]uint64{openOrder.OrderId}, orderIds)
+	orderIds, err = clk.GetLimitOrderIdsAtTick(s.Ctx, pool.GetId(), types.LimitOrderAsk, filledOrder.TriggerTick())
+	s.Require().NoError(err)
+	s.Require().Len(orderIds, 0)
+}
@@ -186,6 +186,10 @@ func (k Keeper) CreatePosition(ctx sdk.Context, poolId uint64, owner sdk.AccAddr
 	}
 	k.RecordTotalLiquidityIncrease(ctx, tokensAdded)
 
+	if err := k.writePositionCheckpoint(ctx, owner, poolId, positionId, positionCheckpointDelta{deposited: tokensAdded}); err != nil {
+		return CreatePositionData{}, err
+	}
+
 	// Trigger after hook for CreatePosition.
 	// If no contract is set, this will be a no-op.
 	err = k.AfterCreatePosition(ctx, poolId, owner, tokensProvided, amount0Min, amount1Min, lowerTick, upperTick)
@@ -221,7 +225,24 @@ func (k Keeper) CreatePosition(ctx sdk.Context, poolId uint64, owner sdk.AccAddr
 //
 // BeforeWithdrawPosition hook is triggered after validation logic but before any state changes are made.
 // AfterWithdrawPosition hook is triggered after state changes are complete if no errors have occurred.
+//
+// The checkpoints of a position withdrawn in full are deleted.
 func (k Keeper) WithdrawPosition(ctx sdk.Context, owner sdk.AccAddress, positionId uint64, requestedLiquidityAmountToWithdraw osmomath.Dec) (amtDenom0, amtDenom1 osmomath.Int, err error) {
+	amtDenom0, amtDenom1, err = k.withdrawPosition(ctx, owner, positionId, requestedLiquidityAmountToWithdraw)
+	if err != nil {
+		return osmomath.Int{}, osmomath.Int{}, err
+	}
+
+	if !k.hasPosition(ctx, positionId) {
+		k.deletePositionCheckpoints(ctx, positionId)
+	}
+
+	return amtDenom0, amtDenom1, nil
+}
+
+// withdrawPosition withdraws the given liquidity from the position as described in WithdrawPosition,
+// but keeps the checkpoints of a position withdrawn in full so that AddToPosition can carry them over.
+func (k Keeper) withdrawPosition(ctx sdk.Context, owner sdk.AccAddress, positionId uint64, requestedLiquidityAmountToWithdraw osmomath.Dec) (amtDenom0, amtDenom1 osmomath.Int, err error) {
 	position, err := k.GetPosition(ctx, positionId)
 	if err != nil {
 		return osmomath.Int{}, osmomath.Int{}, err
@@ -350,6 +371,11 @@ func (k Keeper) WithdrawPosition(ctx sdk.Context, owner sdk.AccAddress, position
 	}
 	k.RecordTotalLiquidityDecrease(ctx, tokensRemoved)
 
+	tokensWithdrawn := sdk.NewCoins(sdk.NewCoin(pool.GetToken0(), updateData.Amount0.Abs()), sdk.NewCoin(pool.GetToken1(), updateData.Amount1.Abs()))
+	if err := k.writePositionCheckpoint(ctx, owner, position.PoolId, positionId, positionCheckpointDelta{withdrawn: tokensWithdrawn}); err != nil {
+		return osmomath.Int{}, osmomath.Int{}, err
+	}
+
 	event := &liquidityChangeEvent{
 		eventType:      types.TypeEvtWithdrawPosition,
 		positionId:     positionId,
@@ -374,6 +400,32 @@ func (k Keeper) WithdrawPosition(ctx sdk.Context, owner sdk.AccAddress, position
 	return updateData.Amount0.Neg(), updateData.Amount1.Neg(), nil
 }
 
+// withdrawEscrowedPosition withdraws the full liquidity of a position owned by a module escrow
+// address and returns the coins the escrow address received. Measuring the balance change
+// captures the withdrawn amounts as well as the spread rewards and incentives collected as
+// part of the withdrawal, without mixing in the funds of other positions held by the same
+// escrow address.
+func (k Keeper) withdrawEscrowedPosition(ctx sdk.Context, escrowAddress sdk.AccAddress, positionId uint64) (sdk.Coins, error) {
+	position, err := k.GetPosition(ctx, positionId)
+	if err != nil {
+		return nil, err
+	}
+
+	balanceBefore := k.bankKeeper.GetAllBalances(ctx, escrowAddress)
+
+	if _, _, err := k.WithdrawPosition(ctx, escrowAddress, positionId, position.Liquidity); err != nil {
+		return nil, err
+	}
+
+	balanceAfter := k.bankKeeper.GetAllBalances(ctx, escrowAddress)
+	withdrawn, hasNeg := balanceAfter.SafeSub(balanceBefore...)
+	if hasNeg {
+		return nil, fmt.Errorf("escrow (%s) balance decreased while withdrawing position (%d)", escrowAddress, positionId)
+	}
+
+	return withdrawn, nil
+}
+
 // addToPosition attempts to add amount0Added and amount1Added to a position with the given position id.
 // For the sake of backwards-compatibility with future implementations of charging, this function deletes the old position and creates
 // a new one with the resulting amount after addition. Note that due to truncation after `withdrawPosition`, there is some rounding error
@@ -417,8 +469,14 @@ func (k Keeper) addToPosition(ctx sdk.Context, owner sdk.AccAddress, positionId
 		return 0, osmomath.Int{}, osmomath.Int{}, types.PositionSuperfluidStakedError{PositionId: position.PositionId}
 	}
 
-	// Withdraw full position.
-	amount0Withdrawn, amount1Withdrawn, err := k.WithdrawPosition(ctx, owner, positionId, position.Liquidity)
+	// Withdrawing the position drops its auto-compound record, so it is carried over to the new position.
+	autoCompoundPosition, isAutoCompounded, err := k.getAutoCompoundPosition(ctx, positionId)
+	if err != nil {
+		return 0, osmomath.Int{}, osmomath.Int{}, err
+	}
+
+	// Withdraw full position, keeping its checkpoints to carry them over to the new position.
+	amount0Withdrawn, amount1Withdrawn, err := k.withdrawPosition(ctx, owner, positionId, position.Liquidity)
 	if err != nil {
 		return 0, osmomath.Int{}, osmomath.Int{}, err
 	}
@@ -452,6 +510,17 @@ func (k Keeper) addToPosition(ctx sdk.Context, owner sdk.AccAddress, positionId
 		return 0, osmomath.Int{}, osmomath.Int{}, err
 	}
 
+	if isAutoCompounded {
+		autoCompoundPosition.PositionId = newPositionData.ID
+		k.setAutoCompoundPosition(ctx, autoCompoundPosition)
+	}
+
+	// The history of the position is carried over to the new position.
+	reDeposited := sdk.NewCoins(sdk.NewCoin(pool.GetToken0(), amount0Withdrawn), sdk.NewCoin(pool.GetToken1(), amount1Withdrawn))
+	if err := k.movePositionCheckpoints(ctx, positionId, newPositionData.ID, reDeposited); err != nil {
+		return 0, osmomath.Int{}, osmomath.Int{}, err
+	}
+
 	// Emit an event indicating that a position was added to.
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
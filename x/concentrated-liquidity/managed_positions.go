@@ -0,0 +1,759 @@
+package concentrated_liquidity
+
+import (
+	"strconv"
+	"time"
+
+	sdkprefix "github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	gogotypes "github.com/cosmos/gogoproto/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v24/x/concentrated-liquidity/math"
+	"github.com/osmosis-labs/osmosis/v24/x/concentrated-liquidity/types"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v24/x/poolmanager/types"
+)
+
+// CreateManagedPosition creates a position for the given owner that is centered around the current
+// tick of the pool and kept there according to the given strategy. The position is owned by the
+// pool's managed position escrow address. Any amount of tokensProvided that does not fit the ratio
+// of the range is refunded to the owner.
+//
+// Returns error if:
+// - the strategy is invalid or its half width is not a multiple of the pool's tick spacing
+// - the pool does not exist or has no positions to define a current price
+// - the position cannot be created
+func (k Keeper) CreateManagedPosition(ctx sdk.Context, owner sdk.AccAddress, poolId uint64, tokensProvided sdk.Coins, strategy types.ManagedPositionStrategy) (types.ManagedPosition, CreatePositionData, error) {
+	if err := strategy.Validate(); err != nil {
+		return types.ManagedPosition{}, CreatePositionData{}, err
+	}
+
+	pool, err := k.getPoolById(ctx, poolId)
+	if err != nil {
+		return types.ManagedPosition{}, CreatePositionData{}, err
+	}
+
+	if !k.PoolHasPosition(ctx, pool) {
+		return types.ManagedPosition{}, CreatePositionData{}, types.ManagedPositionPoolNotInitializedError{PoolId: poolId}
+	}
+
+	if strategy.HalfWidth%int64(pool.GetTickSpacing()) != 0 {
+		return types.ManagedPosition{}, CreatePositionData{}, types.InvalidManagedPositionHalfWidthError{HalfWidth: strategy.HalfWidth, TickSpacing: pool.GetTickSpacing()}
+	}
+
+	escrowAddress := types.ManagedPositionEscrowAddress(poolId)
+	if err := k.bankKeeper.SendCoins(ctx, owner, escrowAddress, tokensProvided); err != nil {
+		return types.ManagedPosition{}, CreatePositionData{}, err
+	}
+
+	positionData, err := k.createManagedPositionFromEscrow(ctx, pool, owner, tokensProvided, strategy)
+	if err != nil {
+		return types.ManagedPosition{}, CreatePositionData{}, err
+	}
+
+	managedPosition := types.ManagedPosition{
+		ManagedPositionId: k.getNextManagedPositionIdAndIncrement(ctx),
+		PoolId:            poolId,
+		Owner:             owner.String(),
+		PositionId:        positionData.ID,
+		Strategy:          strategy,
+		LastRebalanceTime: ctx.BlockTime(),
+	}
+
+	k.setManagedPosition(ctx, managedPosition)
+	managedPosition, err = k.indexManagedPositionRange(ctx, managedPosition, positionData.LowerTick, positionData.UpperTick, pool.GetCurrentTick())
+	if err != nil {
+		return types.ManagedPosition{}, CreatePositionData{}, err
+	}
+
+	emitManagedPositionEvent(ctx, types.TypeEvtCreateManagedPosition, managedPosition, positionData, 0)
+
+	return managedPosition, positionData, nil
+}
+
+// WithdrawManagedPosition withdraws the position backing the managed position in full, sends
+// everything it held to the owner, including spread rewards and incentives, and stops managing it.
+//
+// Returns error if the managed position does not exist or is not owned by owner.
+func (k Keeper) WithdrawManagedPosition(ctx sdk.Context, owner sdk.AccAddress, managedPositionId uint64) (sdk.Coins, error) {
+	managedPosition, err := k.GetManagedPosition(ctx, managedPositionId)
+	if err != nil {
+		return nil, err
+	}
+
+	if managedPosition.Owner != owner.String() {
+		return nil, types.NotManagedPositionOwnerError{ManagedPositionId: managedPositionId, Address: owner.String()}
+	}
+
+	position, err := k.GetPosition(ctx, managedPosition.PositionId)
+	if err != nil {
+		return nil, err
+	}
+
+	escrowAddress := types.ManagedPositionEscrowAddress(managedPosition.PoolId)
+	tokensOut, err := k.withdrawEscrowedPosition(ctx, escrowAddress, managedPosition.PositionId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !tokensOut.Empty() {
+		if err := k.bankKeeper.SendCoins(ctx, escrowAddress, owner, tokensOut); err != nil {
+			return nil, err
+		}
+	}
+
+	k.unmarkManagedPositionOutOfRange(ctx, managedPosition)
+	k.unindexManagedPositionRange(ctx, managedPosition.PoolId, managedPosition.ManagedPositionId, position.LowerTick, position.UpperTick)
+	k.deleteManagedPosition(ctx, managedPosition)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtWithdrawManagedPosition,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(types.AttributeKeyManagedPositionId, strconv.FormatUint(managedPositionId, 10)),
+		sdk.NewAttribute(sdk.AttributeKeySender, managedPosition.Owner),
+		sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(managedPosition.PoolId, 10)),
+		sdk.NewAttribute(types.AttributeKeyPositionId, strconv.FormatUint(managedPosition.PositionId, 10)),
+		sdk.NewAttribute(types.AttributeKeyTokensOut, tokensOut.String()),
+	))
+
+	return tokensOut, nil
+}
+
+// RebalanceManagedPositions updates which managed positions are out of range and re-centers the
+// ones that have been out of range for at least the delay of their strategy.
+//
+// Managed positions are indexed by the ticks of their range, and every pool with managed positions
+// stores the tick its positions were last checked against. Only the positions with a range tick
+// between that tick and the current tick of the pool can have moved in or out of range, so only
+// those are checked. A position found out of range is marked as such and queued to be re-centered
+// once its delay has elapsed. A position found back in range is unmarked and removed from the queue.
+//
+// At most MaxManagedPositionRebalancesPerBlock due positions are processed per call. A failure to
+// re-center a position is logged and its state changes are discarded. The position is then queued
+// again, to be retried once its delay has elapsed another time, without affecting the other positions.
+func (k Keeper) RebalanceManagedPositions(ctx sdk.Context) {
+	k.updateManagedPositionsOutOfRange(ctx)
+
+	dueManagedPositions, err := k.getDueManagedPositions(ctx, ctx.BlockTime(), types.MaxManagedPositionRebalancesPerBlock)
+	if err != nil {
+		ctx.Logger().Error("failed to load managed positions due for re-centering", "error", err)
+		return
+	}
+
+	for _, due := range dueManagedPositions {
+		managedPosition, err := k.GetManagedPosition(ctx, due.managedPositionId)
+		if err != nil {
+			ctx.Logger().Error("failed to load managed position", "managed_position_id", due.managedPositionId, "error", err)
+			ctx.KVStore(k.storeKey).Delete(types.KeyManagedPositionRebalanceQueue(due.rebalanceTime, due.managedPositionId))
+			continue
+		}
+
+		err = osmoutils.ApplyFuncIfNoError(ctx, func(cacheCtx sdk.Context) error {
+			return k.rebalanceManagedPosition(cacheCtx, managedPosition)
+		})
+		if err != nil {
+			k.unmarkManagedPositionOutOfRange(ctx, managedPosition)
+			k.markManagedPositionOutOfRange(ctx, managedPosition)
+		}
+	}
+}
+
+// updateManagedPositionsOutOfRange marks the managed positions that moved out of range since their
+// pool was last checked, unmarks the ones that moved back in range, and records the current tick of
+// every pool with managed positions as the tick they were checked against.
+func (k Keeper) updateManagedPositionsOutOfRange(ctx sdk.Context) {
+	poolTicks, err := k.getManagedPositionPoolTicks(ctx)
+	if err != nil {
+		ctx.Logger().Error("failed to load the ticks of the pools with managed positions", "error", err)
+		return
+	}
+
+	for _, poolTick := range poolTicks {
+		pool, err := k.getPoolById(ctx, poolTick.poolId)
+		if err != nil {
+			ctx.Logger().Error("failed to load pool with managed positions", "pool_id", poolTick.poolId, "error", err)
+			continue
+		}
+
+		currentTick := pool.GetCurrentTick()
+		if currentTick == poolTick.tick {
+			continue
+		}
+
+		managedPositionIds, err := k.getManagedPositionIdsWithRangeTickBetween(ctx, pool.GetId(), poolTick.tick, currentTick)
+		if err != nil {
+			ctx.Logger().Error("failed to load managed positions of pool", "pool_id", pool.GetId(), "error", err)
+			continue
+		}
+
+		for _, managedPositionId := range managedPositionIds {
+			if err := k.updateManagedPositionOutOfRange(ctx, managedPositionId, currentTick); err != nil {
+				ctx.Logger().Error("failed to update managed position range status", "managed_position_id", managedPositionId, "error", err)
+			}
+		}
+
+		k.setManagedPositionPoolTick(ctx, pool.GetId(), currentTick)
+	}
+}
+
+// updateManagedPositionOutOfRange marks or unmarks the managed position with the given id
+// depending on whether the given tick is outside of its range.
+func (k Keeper) updateManagedPositionOutOfRange(ctx sdk.Context, managedPositionId uint64, currentTick int64) error {
+	managedPosition, err := k.GetManagedPosition(ctx, managedPositionId)
+	if err != nil {
+		return err
+	}
+
+	position, err := k.GetPosition(ctx, managedPosition.PositionId)
+	if err != nil {
+		return err
+	}
+
+	outOfRange := currentTick < position.LowerTick || currentTick >= position.UpperTick
+	if outOfRange && !managedPosition.IsOutOfRange() {
+		k.markManagedPositionOutOfRange(ctx, managedPosition)
+	} else if !outOfRange && managedPosition.IsOutOfRange() {
+		k.unmarkManagedPositionOutOfRange(ctx, managedPosition)
+	}
+	return nil
+}
+
+// markManagedPositionOutOfRange marks the managed position as out of range since the current block
+// and queues it to be re-centered once the delay of its strategy has elapsed.
+func (k Keeper) markManagedPositionOutOfRange(ctx sdk.Context, managedPosition types.ManagedPosition) {
+	managedPosition.OutOfRangeSince = ctx.BlockTime()
+	k.setManagedPosition(ctx, managedPosition)
+
+	rebalanceTime := managedPosition.OutOfRangeSince.Add(managedPosition.Strategy.OutOfRangeDelay)
+	ctx.KVStore(k.storeKey).Set(types.KeyManagedPositionRebalanceQueue(rebalanceTime, managedPosition.ManagedPositionId), []byte{1})
+}
+
+// unmarkManagedPositionOutOfRange clears the out of range mark of the managed position and removes
+// it from the queue of positions to re-center. It is a no-op if the position is not marked.
+func (k Keeper) unmarkManagedPositionOutOfRange(ctx sdk.Context, managedPosition types.ManagedPosition) {
+	if !managedPosition.IsOutOfRange() {
+		return
+	}
+
+	rebalanceTime := managedPosition.OutOfRangeSince.Add(managedPosition.Strategy.OutOfRangeDelay)
+	ctx.KVStore(k.storeKey).Delete(types.KeyManagedPositionRebalanceQueue(rebalanceTime, managedPosition.ManagedPositionId))
+
+	managedPosition.OutOfRangeSince = time.Time{}
+	k.setManagedPosition(ctx, managedPosition)
+}
+
+// rebalanceManagedPosition re-centers the managed position around the current tick. It withdraws
+// the underlying position, which also collects its spread rewards and incentives, swaps the pool
+// tokens through the pool to match the ratio of the new range and re-creates the position with
+// them, compounding the collected spread rewards. Collected coins that are not pool tokens, as well
+// as any remainder that does not fit the new position, are sent to the owner.
+//
+// Returns error if the managed position holds the last position in the pool, since withdrawing
+// it would reset the current price of the pool.
+func (k Keeper) rebalanceManagedPosition(ctx sdk.Context, managedPosition types.ManagedPosition) error {
+	escrowAddress := types.ManagedPositionEscrowAddress(managedPosition.PoolId)
+	owner := sdk.MustAccAddressFromBech32(managedPosition.Owner)
+
+	previousPosition, err := k.GetPosition(ctx, managedPosition.PositionId)
+	if err != nil {
+		return err
+	}
+
+	k.unmarkManagedPositionOutOfRange(ctx, managedPosition)
+	k.unindexManagedPositionRange(ctx, managedPosition.PoolId, managedPosition.ManagedPositionId, previousPosition.LowerTick, previousPosition.UpperTick)
+
+	withdrawn, err := k.withdrawEscrowedPosition(ctx, escrowAddress, managedPosition.PositionId)
+	if err != nil {
+		return err
+	}
+
+	pool, err := k.getPoolById(ctx, managedPosition.PoolId)
+	if err != nil {
+		return err
+	}
+
+	if !k.PoolHasPosition(ctx, pool) {
+		return types.RebalanceLastPositionInPoolError{PoolId: pool.GetId(), ManagedPositionId: managedPosition.ManagedPositionId}
+	}
+
+	poolTokens := sdk.NewCoins(
+		sdk.NewCoin(pool.GetToken0(), withdrawn.AmountOf(pool.GetToken0())),
+		sdk.NewCoin(pool.GetToken1(), withdrawn.AmountOf(pool.GetToken1())),
+	)
+
+	if otherTokens := withdrawn.Sub(poolTokens...); !otherTokens.Empty() {
+		if err := k.bankKeeper.SendCoins(ctx, escrowAddress, owner, otherTokens); err != nil {
+			return err
+		}
+	}
+
+	poolTokens, err = k.swapToManagedPositionRatio(ctx, escrowAddress, pool, poolTokens, managedPosition.Strategy.HalfWidth)
+	if err != nil {
+		return err
+	}
+
+	pool, err = k.getPoolById(ctx, managedPosition.PoolId)
+	if err != nil {
+		return err
+	}
+
+	positionData, err := k.createManagedPositionFromEscrow(ctx, pool, owner, poolTokens, managedPosition.Strategy)
+	if err != nil {
+		return err
+	}
+
+	previousPositionId := managedPosition.PositionId
+	managedPosition.PositionId = positionData.ID
+	managedPosition.OutOfRangeSince = time.Time{}
+	managedPosition.LastRebalanceTime = ctx.BlockTime()
+	managedPosition.NumRebalances++
+	k.setManagedPosition(ctx, managedPosition)
+	managedPosition, err = k.indexManagedPositionRange(ctx, managedPosition, positionData.LowerTick, positionData.UpperTick, pool.GetCurrentTick())
+	if err != nil {
+		return err
+	}
+
+	emitManagedPositionEvent(ctx, types.TypeEvtRebalanceManagedPosition, managedPosition, positionData, previousPositionId)
+
+	return nil
+}
+
+// indexManagedPositionRange indexes the managed position by the ticks of its range and marks it as out
+// of range or not depending on the tick the managed positions of its pool were last checked against,
+// so that the next update of the pool picks up any change of its status. If the pool had no managed
+// positions, the given current tick is recorded as that tick. Returns the updated managed position.
+func (k Keeper) indexManagedPositionRange(ctx sdk.Context, managedPosition types.ManagedPosition, lowerTick, upperTick, currentTick int64) (types.ManagedPosition, error) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyManagedPositionLowerTick(managedPosition.PoolId, lowerTick, managedPosition.ManagedPositionId), []byte{1})
+	store.Set(types.KeyManagedPositionUpperTick(managedPosition.PoolId, upperTick, managedPosition.ManagedPositionId), []byte{1})
+
+	observedTick, found, err := k.getManagedPositionPoolTick(ctx, managedPosition.PoolId)
+	if err != nil {
+		return types.ManagedPosition{}, err
+	}
+	if !found {
+		observedTick = currentTick
+		k.setManagedPositionPoolTick(ctx, managedPosition.PoolId, observedTick)
+	}
+
+	outOfRange := observedTick < lowerTick || observedTick >= upperTick
+	if outOfRange && !managedPosition.IsOutOfRange() {
+		k.markManagedPositionOutOfRange(ctx, managedPosition)
+	} else if !outOfRange && managedPosition.IsOutOfRange() {
+		k.unmarkManagedPositionOutOfRange(ctx, managedPosition)
+	}
+
+	return k.GetManagedPosition(ctx, managedPosition.ManagedPositionId)
+}
+
+// unindexManagedPositionRange removes the range index entries of the managed position. Once the
+// pool has no managed positions left, the tick they were last checked against is removed as well.
+func (k Keeper) unindexManagedPositionRange(ctx sdk.Context, poolId, managedPositionId uint64, lowerTick, upperTick int64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyManagedPositionLowerTick(poolId, lowerTick, managedPositionId))
+	store.Delete(types.KeyManagedPositionUpperTick(poolId, upperTick, managedPositionId))
+
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyManagedPositionsByLowerTick(poolId))
+	hasManagedPositions := iterator.Valid()
+	iterator.Close()
+	if !hasManagedPositions {
+		store.Delete(types.KeyManagedPositionPoolTick(poolId))
+	}
+}
+
+// getManagedPositionIdsWithRangeTickBetween returns the ids of the managed positions of the pool
+// with a lower or upper tick in (min(tickA, tickB), max(tickA, tickB)]. These are the positions
+// whose range status can differ between the two ticks.
+func (k Keeper) getManagedPositionIdsWithRangeTickBetween(ctx sdk.Context, poolId uint64, tickA, tickB int64) ([]uint64, error) {
+	lo, hi := tickA, tickB
+	if lo > hi {
+		lo, hi = hi, lo
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	seen := map[uint64]bool{}
+	managedPositionIds := []uint64{}
+	for _, prefix := range [][]byte{types.KeyManagedPositionsByLowerTick(poolId), types.KeyManagedPositionsByUpperTick(poolId)} {
+		start := append(append([]byte{}, prefix...), types.TickIndexToBytes(lo+1)...)
+		end := append(append([]byte{}, prefix...), types.TickIndexToBytes(hi+1)...)
+		iterator := store.Iterator(start, end)
+		for ; iterator.Valid(); iterator.Next() {
+			key := iterator.Key()
+			if len(key) < types.Uint64ByteSize {
+				iterator.Close()
+				return nil, types.ErrValueParse
+			}
+			managedPositionId := sdk.BigEndianToUint64(key[len(key)-types.Uint64ByteSize:])
+			if !seen[managedPositionId] {
+				seen[managedPositionId] = true
+				managedPositionIds = append(managedPositionIds, managedPositionId)
+			}
+		}
+		iterator.Close()
+	}
+
+	return managedPositionIds, nil
+}
+
+// managedPositionPoolTick is the tick the managed positions of a pool were last checked against.
+type managedPositionPoolTick struct {
+	poolId uint64
+	tick   int64
+}
+
+// getManagedPositionPoolTicks returns the tick the managed positions of every pool with managed
+// positions were last checked against, ordered by pool id.
+func (k Keeper) getManagedPositionPoolTicks(ctx sdk.Context) ([]managedPositionPoolTick, error) {
+	return osmoutils.GatherValuesFromStorePrefixWithKeyParser(ctx.KVStore(k.storeKey), types.ManagedPositionPoolTickPrefix, func(key []byte, value []byte) (managedPositionPoolTick, error) {
+		if len(key) != len(types.ManagedPositionPoolTickPrefix)+types.Uint64ByteSize {
+			return managedPositionPoolTick{}, types.ErrValueParse
+		}
+		tick := gogotypes.Int64Value{}
+		if err := tick.Unmarshal(value); err != nil {
+			return managedPositionPoolTick{}, err
+		}
+		return managedPositionPoolTick{poolId: sdk.BigEndianToUint64(key[len(types.ManagedPositionPoolTickPrefix):]), tick: tick.Value}, nil
+	})
+}
+
+// getManagedPositionPoolTick returns the tick the managed positions of the pool were last checked
+// against and whether the pool has managed positions.
+func (k Keeper) getManagedPositionPoolTick(ctx sdk.Context, poolId uint64) (int64, bool, error) {
+	tick := gogotypes.Int64Value{}
+	found, err := osmoutils.Get(ctx.KVStore(k.storeKey), types.KeyManagedPositionPoolTick(poolId), &tick)
+	return tick.Value, found, err
+}
+
+// setManagedPositionPoolTick sets the tick the managed positions of the pool were last checked against.
+func (k Keeper) setManagedPositionPoolTick(ctx sdk.Context, poolId uint64, tick int64) {
+	osmoutils.MustSet(ctx.KVStore(k.storeKey), types.KeyManagedPositionPoolTick(poolId), &gogotypes.Int64Value{Value: tick})
+}
+
+// dueManagedPosition is an entry of the queue of managed positions to re-center.
+type dueManagedPosition struct {
+	rebalanceTime     time.Time
+	managedPositionId uint64
+}
+
+// getDueManagedPositions returns up to limit entries of the queue of managed positions to re-center
+// that are due at or before the given time, ordered by the time they are due.
+func (k Keeper) getDueManagedPositions(ctx sdk.Context, blockTime time.Time, limit int) ([]dueManagedPosition, error) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.ManagedPositionRebalanceQueuePrefix)
+	defer iterator.Close()
+
+	dueManagedPositions := []dueManagedPosition{}
+	for ; iterator.Valid() && len(dueManagedPositions) < limit; iterator.Next() {
+		key := iterator.Key()
+		timeBz := key[len(types.ManagedPositionRebalanceQueuePrefix) : len(key)-types.Uint64ByteSize]
+		rebalanceTime, err := sdk.ParseTimeBytes(timeBz)
+		if err != nil {
+			return nil, err
+		}
+		if rebalanceTime.After(blockTime) {
+			break
+		}
+		dueManagedPositions = append(dueManagedPositions, dueManagedPosition{
+			rebalanceTime:     rebalanceTime,
+			managedPositionId: sdk.BigEndianToUint64(key[len(key)-types.Uint64ByteSize:]),
+		})
+	}
+
+	return dueManagedPositions, nil
+}
+
+// createManagedPositionFromEscrow creates a position owned by the pool's managed position escrow
+// address with the given tokens, centered around the current tick of the pool. The tokens must
+// already be held by the escrow address. Any remainder that does not fit the ratio of the range
+// is sent to the owner.
+func (k Keeper) createManagedPositionFromEscrow(ctx sdk.Context, pool types.ConcentratedPoolExtension, owner sdk.AccAddress, tokens sdk.Coins, strategy types.ManagedPositionStrategy) (CreatePositionData, error) {
+	escrowAddress := types.ManagedPositionEscrowAddress(pool.GetId())
+	lowerTick, upperTick := managedPositionRange(pool.GetCurrentTick(), pool.GetTickSpacing(), strategy.HalfWidth)
+
+	positionData, err := k.CreatePosition(ctx, pool.GetId(), escrowAddress, tokens, osmomath.ZeroInt(), osmomath.ZeroInt(), lowerTick, upperTick)
+	if err != nil {
+		return CreatePositionData{}, err
+	}
+
+	used := sdk.NewCoins(sdk.NewCoin(pool.GetToken0(), positionData.Amount0), sdk.NewCoin(pool.GetToken1(), positionData.Amount1))
+	if refund := tokens.Sub(used...); !refund.Empty() {
+		if err := k.bankKeeper.SendCoins(ctx, escrowAddress, owner, refund); err != nil {
+			return CreatePositionData{}, err
+		}
+	}
+
+	return positionData, nil
+}
+
+// swapToManagedPositionRatio swaps part of the given pool tokens held by the escrow address
+// through the pool so that they match the ratio of the range a managed position with the given
+// half width would be re-created in. The ratio is computed at the current price, ignoring the
+// spread factor and the price impact of the swap itself, so a small remainder is to be expected
+// when creating the position. Returns the pool tokens held after the swap.
+func (k Keeper) swapToManagedPositionRatio(ctx sdk.Context, escrowAddress sdk.AccAddress, pool types.ConcentratedPoolExtension, poolTokens sdk.Coins, halfWidth int64) (sdk.Coins, error) {
+	lowerTick, upperTick := managedPositionRange(pool.GetCurrentTick(), pool.GetTickSpacing(), halfWidth)
+	sqrtPriceLower, err := math.TickToSqrtPrice(lowerTick)
+	if err != nil {
+		return nil, err
+	}
+	sqrtPriceUpper, err := math.TickToSqrtPrice(upperTick)
+	if err != nil {
+		return nil, err
+	}
+
+	amount0 := poolTokens.AmountOf(pool.GetToken0())
+	amount1 := poolTokens.AmountOf(pool.GetToken1())
+	zeroForOne, amountIn := computeManagedPositionSwap(pool.GetCurrentSqrtPrice(), sqrtPriceLower, sqrtPriceUpper, amount0, amount1)
+	if !amountIn.IsPositive() {
+		return poolTokens, nil
+	}
+
+	tokenInDenom, tokenOutDenom := pool.GetToken1(), pool.GetToken0()
+	if zeroForOne {
+		tokenInDenom, tokenOutDenom = pool.GetToken0(), pool.GetToken1()
+	}
+
+	tokenIn := sdk.NewCoin(tokenInDenom, amountIn)
+	spreadFactor, err := k.GetEffectiveSpreadFactor(ctx, pool)
+	if err != nil {
+		return nil, err
+	}
+	tokenOutMinAmount, err := k.twapMinAmountOut(ctx, pool.GetId(), tokenIn, tokenOutDenom, spreadFactor)
+	if err != nil {
+		return nil, err
+	}
+
+	// The swap is routed through poolmanager so that it is charged the taker fee like any user swap.
+	route := []poolmanagertypes.SwapAmountInRoute{{PoolId: pool.GetId(), TokenOutDenom: tokenOutDenom}}
+	tokenOutAmount, err := k.poolmanagerKeeper.RouteExactAmountIn(ctx, escrowAddress, route, tokenIn, tokenOutMinAmount)
+	if err != nil {
+		return nil, err
+	}
+
+	return poolTokens.Sub(tokenIn).Add(sdk.NewCoin(tokenOutDenom, tokenOutAmount)), nil
+}
+
+// twapMinAmountOut returns the minimum amount out to accept when the module swaps tokenIn for
+// tokenOutDenom through the pool on behalf of a user, at the end of a block where no minimum is
+// provided. It is the amount out at the arithmetic TWAP over types.TwapMinAmountOutWindow, less
+// the spread factor and types.TwapMinAmountOutMaxSlippage, so that the swap fails instead of
+// executing at a price manipulated within the block.
+func (k Keeper) twapMinAmountOut(ctx sdk.Context, poolId uint64, tokenIn sdk.Coin, tokenOutDenom string, spreadFactor osmomath.Dec) (osmomath.Int, error) {
+	startTime := ctx.BlockTime().Add(-types.TwapMinAmountOutWindow)
+	twap, err := k.twapKeeper.GetArithmeticTwapToNow(ctx, poolId, tokenIn.Denom, tokenOutDenom, startTime)
+	if err != nil {
+		return osmomath.Int{}, err
+	}
+
+	return twap.MulInt(tokenIn.Amount).
+		Mul(osmomath.OneDec().Sub(spreadFactor)).
+		Mul(osmomath.OneDec().Sub(types.TwapMinAmountOutMaxSlippage)).
+		TruncateInt(), nil
+}
+
+// computeManagedPositionSwap returns the direction and amount of the swap that converts amount0
+// and amount1 into the ratio required by a position between sqrtPriceLower and sqrtPriceUpper at
+// sqrtPrice.
+//
+// Per unit of liquidity, such a position holds 1/sqrtPrice - 1/sqrtPriceUpper of token0 and
+// sqrtPrice - sqrtPriceLower of token1. The share of its value held in token1 is thus
+// a1 / (a0 * price + a1), which is applied to the total value of the tokens denominated in token1.
+func computeManagedPositionSwap(sqrtPrice, sqrtPriceLower, sqrtPriceUpper osmomath.BigDec, amount0, amount1 osmomath.Int) (zeroForOne bool, amountIn osmomath.Int) {
+	price := sqrtPrice.Mul(sqrtPrice)
+
+	perLiquidity0 := osmomath.OneBigDec().Quo(sqrtPrice).Sub(osmomath.OneBigDec().Quo(sqrtPriceUpper))
+	perLiquidity1 := sqrtPrice.Sub(sqrtPriceLower)
+	if perLiquidity0.IsNegative() {
+		perLiquidity0 = osmomath.ZeroBigDec()
+	}
+	if perLiquidity1.IsNegative() {
+		perLiquidity1 = osmomath.ZeroBigDec()
+	}
+
+	totalValue1 := osmomath.BigDecFromSDKInt(amount0).Mul(price).Add(osmomath.BigDecFromSDKInt(amount1))
+	targetAmount1 := totalValue1.Mul(perLiquidity1).Quo(perLiquidity0.Mul(price).Add(perLiquidity1))
+
+	currentAmount1 := osmomath.BigDecFromSDKInt(amount1)
+	if currentAmount1.GT(targetAmount1) {
+		return false, currentAmount1.Sub(targetAmount1).Dec().TruncateInt()
+	}
+	return true, targetAmount1.Sub(currentAmount1).Quo(price).Dec().TruncateInt()
+}
+
+// managedPositionRange returns the range of a managed position with the given half width around
+// the current tick. The range is centered on the start of the tick spacing the current tick is in,
+// so that the current tick is always inside of it, and clamped to the valid tick range.
+func managedPositionRange(currentTick int64, tickSpacing uint64, halfWidth int64) (lowerTick, upperTick int64) {
+	spacing := int64(tickSpacing)
+	center := currentTick - currentTick%spacing
+	if currentTick%spacing < 0 {
+		center -= spacing
+	}
+
+	lowerTick = center - halfWidth
+	upperTick = center + halfWidth
+
+	minTick := types.MinInitializedTick - types.MinInitializedTick%spacing
+	if minTick < types.MinInitializedTick {
+		minTick += spacing
+	}
+	if lowerTick < minTick {
+		lowerTick = minTick
+	}
+
+	maxTick := types.MaxTick - types.MaxTick%spacing
+	if upperTick > maxTick {
+		upperTick = maxTick
+	}
+
+	return lowerTick, upperTick
+}
+
+// GetManagedPosition returns the managed position with the given id.
+func (k Keeper) GetManagedPosition(ctx sdk.Context, managedPositionId uint64) (types.ManagedPosition, error) {
+	store := ctx.KVStore(k.storeKey)
+
+	managedPosition := types.ManagedPosition{}
+	found, err := osmoutils.Get(store, types.KeyManagedPosition(managedPositionId), &managedPosition)
+	if err != nil {
+		return types.ManagedPosition{}, err
+	}
+	if !found {
+		return types.ManagedPosition{}, types.ManagedPositionNotFoundError{ManagedPositionId: managedPositionId}
+	}
+
+	return managedPosition, nil
+}
+
+// getAllManagedPositions returns all managed positions in state, ordered by id.
+func (k Keeper) getAllManagedPositions(ctx sdk.Context) ([]types.ManagedPosition, error) {
+	return osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(k.storeKey), types.ManagedPositionPrefix, ParseManagedPositionFromBz)
+}
+
+// setManagedPosition stores the managed position together with its owner index.
+func (k Keeper) setManagedPosition(ctx sdk.Context, managedPosition types.ManagedPosition) {
+	store := ctx.KVStore(k.storeKey)
+	owner := sdk.MustAccAddressFromBech32(managedPosition.Owner)
+
+	osmoutils.MustSet(store, types.KeyManagedPosition(managedPosition.ManagedPositionId), &managedPosition)
+	store.Set(types.KeyUserManagedPosition(owner, managedPosition.PoolId, managedPosition.ManagedPositionId), []byte{1})
+}
+
+// deleteManagedPosition removes the managed position and its owner index from state.
+func (k Keeper) deleteManagedPosition(ctx sdk.Context, managedPosition types.ManagedPosition) {
+	store := ctx.KVStore(k.storeKey)
+	owner := sdk.MustAccAddressFromBech32(managedPosition.Owner)
+
+	store.Delete(types.KeyManagedPosition(managedPosition.ManagedPositionId))
+	store.Delete(types.KeyUserManagedPosition(owner, managedPosition.PoolId, managedPosition.ManagedPositionId))
+}
+
+// GetManagedPositionBreakdown returns the managed position together with the range and the
+// amounts of asset0 and asset1 of the underlying position.
+func (k Keeper) GetManagedPositionBreakdown(ctx sdk.Context, managedPosition types.ManagedPosition) (types.FullManagedPositionBreakdown, error) {
+	pool, err := k.GetConcentratedPoolById(ctx, managedPosition.PoolId)
+	if err != nil {
+		return types.FullManagedPositionBreakdown{}, err
+	}
+
+	position, err := k.GetPosition(ctx, managedPosition.PositionId)
+	if err != nil {
+		return types.FullManagedPositionBreakdown{}, err
+	}
+
+	asset0, asset1, err := CalculateUnderlyingAssetsFromPosition(ctx, position, pool)
+	if err != nil {
+		return types.FullManagedPositionBreakdown{}, err
+	}
+
+	return types.FullManagedPositionBreakdown{
+		ManagedPosition: managedPosition,
+		Asset0:          asset0,
+		Asset1:          asset1,
+		LowerTick:       position.LowerTick,
+		UpperTick:       position.UpperTick,
+	}, nil
+}
+
+// GetUserManagedPositionsSerialized returns the managed positions of the given address, optionally
+// filtered by pool id, in a way that can be paginated.
+func (k Keeper) GetUserManagedPositionsSerialized(ctx sdk.Context, addr sdk.AccAddress, poolId uint64, pagination *query.PageRequest) ([]types.FullManagedPositionBreakdown, *query.PageResponse, error) {
+	prefix := types.KeyUserManagedPositions(addr)
+	if poolId != 0 {
+		prefix = types.KeyUserPoolManagedPositions(addr, poolId)
+	}
+
+	managedPositionsStore := sdkprefix.NewStore(ctx.KVStore(k.storeKey), prefix)
+
+	managedPositions := []types.FullManagedPositionBreakdown{}
+	pageRes, err := query.Paginate(managedPositionsStore, pagination, func(key, _ []byte) error {
+		if len(key) < types.Uint64ByteSize {
+			return types.ErrValueParse
+		}
+
+		managedPosition, err := k.GetManagedPosition(ctx, sdk.BigEndianToUint64(key[len(key)-types.Uint64ByteSize:]))
+		if err != nil {
+			return err
+		}
+
+		breakdown, err := k.GetManagedPositionBreakdown(ctx, managedPosition)
+		if err != nil {
+			return err
+		}
+
+		managedPositions = append(managedPositions, breakdown)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return managedPositions, pageRes, nil
+}
+
+// GetNextManagedPositionId returns the next managed position id.
+// Defaults to 1 if no managed position has been created yet.
+func (k Keeper) GetNextManagedPositionId(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	nextManagedPositionId := gogotypes.UInt64Value{}
+	found, err := osmoutils.Get(store, types.KeyNextGlobalManagedPositionId, &nextManagedPositionId)
+	if err != nil {
+		panic(err)
+	}
+	if !found {
+		return 1
+	}
+	return nextManagedPositionId.Value
+}
+
+// SetNextManagedPositionId sets the next managed position id.
+func (k Keeper) SetNextManagedPositionId(ctx sdk.Context, managedPositionId uint64) {
+	store := ctx.KVStore(k.storeKey)
+	osmoutils.MustSet(store, types.KeyNextGlobalManagedPositionId, &gogotypes.UInt64Value{Value: managedPositionId})
+}
+
+func (k Keeper) getNextManagedPositionIdAndIncrement(ctx sdk.Context) uint64 {
+	nextManagedPositionId := k.GetNextManagedPositionId(ctx)
+	k.SetNextManagedPositionId(ctx, nextManagedPositionId+1)
+	return nextManagedPositionId
+}
+
+func emitManagedPositionEvent(ctx sdk.Context, eventType string, managedPosition types.ManagedPosition, positionData CreatePositionData, previousPositionId uint64) {
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(types.AttributeKeyManagedPositionId, strconv.FormatUint(managedPosition.ManagedPositionId, 10)),
+		sdk.NewAttribute(sdk.AttributeKeySender, managedPosition.Owner),
+		sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(managedPosition.PoolId, 10)),
+		sdk.NewAttribute(types.AttributeKeyPositionId, strconv.FormatUint(positionData.ID, 10)),
+		sdk.NewAttribute(types.AttributeLowerTick, strconv.FormatInt(positionData.LowerTick, 10)),
+		sdk.NewAttribute(types.AttributeUpperTick, strconv.FormatInt(positionData.UpperTick, 10)),
+		sdk.NewAttribute(types.AttributeAmount0, positionData.Amount0.String()),
+		sdk.NewAttribute(types.AttributeAmount1, positionData.Amount1.String()),
+		sdk.NewAttribute(types.AttributeLiquidity, positionData.Liquidity.String()),
+	}
+	if previousPositionId != 0 {
+		attributes = append(attributes, sdk.NewAttribute(types.AttributeKeyPreviousPositionId, strconv.FormatUint(previousPositionId, 10)))
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(eventType, attributes...))
+}
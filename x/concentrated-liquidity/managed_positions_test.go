@@ -0,0 +1,428 @@
+package concentrated_liquidity_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils/osmoassert"
+	cl "github.com/osmosis-labs/osmosis/v24/x/concentrated-liquidity"
+	"github.com/osmosis-labs/osmosis/v24/x/concentrated-liquidity/math"
+	"github.com/osmosis-labs/osmosis/v24/x/concentrated-liquidity/types"
+	txfeestypes "github.com/osmosis-labs/osmosis/v24/x/txfees/types"
+)
+
+var (
+	defaultManagedPositionCoins    = sdk.NewCoins(sdk.NewCoin(ETH, osmomath.NewInt(1_000)), sdk.NewCoin(USDC, osmomath.NewInt(5_000_000)))
+	defaultManagedPositionStrategy = types.ManagedPositionStrategy{HalfWidth: int64(DefaultTickSpacing), OutOfRangeDelay: types.MinManagedPositionOutOfRangeDelay}
+)
+
+// createManagedPosition funds the owner with the given coins and creates a managed position with them.
+func (s *KeeperTestSuite) createManagedPosition(poolId uint64, owner sdk.AccAddress, tokensProvided sdk.Coins, strategy types.ManagedPositionStrategy) types.ManagedPosition {
+	s.FundAcc(owner, tokensProvided)
+	managedPosition, _, err := s.App.ConcentratedLiquidityKeeper.CreateManagedPosition(s.Ctx, owner, poolId, tokensProvided, strategy)
+	s.Require().NoError(err)
+	return managedPosition
+}
+
+func (s *KeeperTestSuite) TestManagedPositionRange() {
+	tests := map[string]struct {
+		currentTick   int64
+		tickSpacing   uint64
+		halfWidth     int64
+		expectedLower int64
+		expectedUpper int64
+	}{
+		"current tick on tick spacing": {
+			currentTick:   31000000,
+			tickSpacing:   100,
+			halfWidth:     1000,
+			expectedLower: 30999000,
+			expectedUpper: 31001000,
+		},
+		"current tick between tick spacings": {
+			currentTick:   31000050,
+			tickSpacing:   100,
+			halfWidth:     100,
+			expectedLower: 30999900,
+			expectedUpper: 31000100,
+		},
+		"negative current tick between tick spacings": {
+			currentTick:   -150,
+			tickSpacing:   100,
+			halfWidth:     100,
+			expectedLower: -300,
+			expectedUpper: -100,
+		},
+		"clamped to min tick": {
+			currentTick:   types.MinInitializedTick + 50,
+			tickSpacing:   100,
+			halfWidth:     1000,
+			expectedLower: types.MinInitializedTick,
+			expectedUpper: types.MinInitializedTick + 1000,
+		},
+		"clamped to max tick": {
+			currentTick:   types.MaxTick - 50,
+			tickSpacing:   100,
+			halfWidth:     1000,
+			expectedLower: types.MaxTick - 1100,
+			expectedUpper: types.MaxTick,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			lowerTick, upperTick := cl.ManagedPositionRange(tc.currentTick, tc.tickSpacing, tc.halfWidth)
+			s.Require().Equal(tc.expectedLower, lowerTick)
+			s.Require().Equal(tc.expectedUpper, upperTick)
+			s.Require().LessOrEqual(lowerTick, tc.currentTick)
+			s.Require().Greater(upperTick, tc.currentTick)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestComputeManagedPositionSwap() {
+	sqrtPrice, err := math.TickToSqrtPrice(DefaultCurrTick)
+	s.Require().NoError(err)
+	sqrtPriceLower, err := math.TickToSqrtPrice(DefaultCurrTick - 1000)
+	s.Require().NoError(err)
+	sqrtPriceUpper, err := math.TickToSqrtPrice(DefaultCurrTick + 1000)
+	s.Require().NoError(err)
+
+	tests := map[string]struct {
+		amount0            osmomath.Int
+		amount1            osmomath.Int
+		expectedZeroForOne bool
+	}{
+		"only token0 swaps token0 in": {
+			amount0:            osmomath.NewInt(1_000_000),
+			amount1:            osmomath.ZeroInt(),
+			expectedZeroForOne: true,
+		},
+		"only token1 swaps token1 in": {
+			amount0:            osmomath.ZeroInt(),
+			amount1:            osmomath.NewInt(5_000_000_000),
+			expectedZeroForOne: false,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			zeroForOne, amountIn := cl.ComputeManagedPositionSwap(sqrtPrice, sqrtPriceLower, sqrtPriceUpper, tc.amount0, tc.amount1)
+			s.Require().Equal(tc.expectedZeroForOne, zeroForOne)
+			s.Require().True(amountIn.IsPositive())
+
+			// The range is symmetric in ticks around the current tick, so roughly half of the value is swapped.
+			held := tc.amount0
+			if !zeroForOne {
+				held = tc.amount1
+			}
+			s.Require().True(amountIn.GT(held.QuoRaw(2).MulRaw(99).QuoRaw(100)))
+			s.Require().True(amountIn.LT(held.QuoRaw(2).MulRaw(101).QuoRaw(100)))
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestCreateManagedPosition() {
+	tests := map[string]struct {
+		strategy     types.ManagedPositionStrategy
+		skipPosition bool
+		expectedErr  error
+	}{
+		"valid strategy": {
+			strategy: types.ManagedPositionStrategy{HalfWidth: 10 * int64(DefaultTickSpacing), OutOfRangeDelay: time.Hour},
+		},
+		"error: half width not a multiple of tick spacing": {
+			strategy:    types.ManagedPositionStrategy{HalfWidth: int64(DefaultTickSpacing) + 1, OutOfRangeDelay: types.MinManagedPositionOutOfRangeDelay},
+			expectedErr: types.InvalidManagedPositionHalfWidthError{HalfWidth: int64(DefaultTickSpacing) + 1, TickSpacing: DefaultTickSpacing},
+		},
+		"error: non positive half width": {
+			strategy:    types.ManagedPositionStrategy{},
+			expectedErr: types.NonPositiveHalfWidthError{},
+		},
+		"error: out of range delay too short": {
+			strategy:    types.ManagedPositionStrategy{HalfWidth: int64(DefaultTickSpacing), OutOfRangeDelay: types.MinManagedPositionOutOfRangeDelay - time.Second},
+			expectedErr: types.OutOfRangeDelayTooShortError{Delay: types.MinManagedPositionOutOfRangeDelay - time.Second, MinDelay: types.MinManagedPositionOutOfRangeDelay},
+		},
+		"error: pool has no positions": {
+			strategy:     defaultManagedPositionStrategy,
+			skipPosition: true,
+			expectedErr:  types.ManagedPositionPoolNotInitializedError{PoolId: 1},
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			clk := s.App.ConcentratedLiquidityKeeper
+			pool := s.PrepareConcentratedPool()
+			if !tc.skipPosition {
+				s.SetupDefaultPosition(pool.GetId())
+			}
+
+			owner := s.TestAccs[1]
+			s.FundAcc(owner, defaultManagedPositionCoins)
+
+			managedPosition, positionData, err := clk.CreateManagedPosition(s.Ctx, owner, pool.GetId(), defaultManagedPositionCoins, tc.strategy)
+			if tc.expectedErr != nil {
+				s.Require().ErrorIs(err, tc.expectedErr)
+				return
+			}
+			s.Require().NoError(err)
+
+			s.Require().Equal(uint64(1), managedPosition.ManagedPositionId)
+			s.Require().Equal(positionData.ID, managedPosition.PositionId)
+			s.Require().Equal(tc.strategy, managedPosition.Strategy)
+			s.Require().False(managedPosition.IsOutOfRange())
+			s.Require().Equal(DefaultCurrTick-tc.strategy.HalfWidth, positionData.LowerTick)
+			s.Require().Equal(DefaultCurrTick+tc.strategy.HalfWidth, positionData.UpperTick)
+
+			// The backing position is owned by the escrow address.
+			position, err := clk.GetPosition(s.Ctx, positionData.ID)
+			s.Require().NoError(err)
+			s.Require().Equal(types.ManagedPositionEscrowAddress(pool.GetId()).String(), position.Address)
+
+			// The owner is refunded everything that did not make it into the position.
+			expectedBalance := defaultManagedPositionCoins.Sub(sdk.NewCoin(ETH, positionData.Amount0), sdk.NewCoin(USDC, positionData.Amount1))
+			s.Require().Equal(expectedBalance.String(), s.App.BankKeeper.GetAllBalances(s.Ctx, owner).String())
+			s.Require().True(s.App.BankKeeper.GetAllBalances(s.Ctx, types.ManagedPositionEscrowAddress(pool.GetId())).IsZero())
+
+			storedManagedPosition, err := clk.GetManagedPosition(s.Ctx, managedPosition.ManagedPositionId)
+			s.Require().NoError(err)
+			s.Require().Equal(managedPosition.String(), storedManagedPosition.String())
+			s.Require().Equal(managedPosition.ManagedPositionId+1, clk.GetNextManagedPositionId(s.Ctx))
+
+			s.AssertEventEmitted(s.Ctx, types.TypeEvtCreateManagedPosition, 1)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestRebalanceManagedPositions() {
+	tests := map[string]struct {
+		movePrice       bool
+		swapAmount      int64
+		moveTwap        bool
+		movePriceBack   bool
+		expectRebalance bool
+		// takerFee is set for the pool's denom pair if not nil.
+		takerFee osmomath.Dec
+		// spreadFactor pins the effective spread factor of the pool if not nil.
+		spreadFactor osmomath.Dec
+	}{
+		"in range position is left untouched": {
+			movePrice: false,
+		},
+		"out of range position is re-centered after the delay": {
+			movePrice:       true,
+			moveTwap:        true,
+			expectRebalance: true,
+		},
+		"position back in range before the delay is left untouched": {
+			movePrice:     true,
+			moveTwap:      true,
+			movePriceBack: true,
+		},
+		"out of range position is re-centered and charged the taker fee": {
+			movePrice:       true,
+			moveTwap:        true,
+			expectRebalance: true,
+			takerFee:        osmomath.MustNewDecFromStr("0.01"),
+		},
+		"out of range position is re-centered with an effective spread factor far above the fixed one": {
+			movePrice:       true,
+			moveTwap:        true,
+			expectRebalance: true,
+			spreadFactor:    osmomath.MustNewDecFromStr("0.1"),
+		},
+		"out of range position is not re-centered at a price far from the TWAP": {
+			movePrice:  true,
+			swapAmount: 3_000_000_000,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			clk := s.App.ConcentratedLiquidityKeeper
+			pool := s.PrepareConcentratedPool()
+			s.SetupDefaultPosition(pool.GetId())
+			if !tc.takerFee.IsNil() {
+				s.App.PoolManagerKeeper.SetDenomPairTakerFee(s.Ctx, ETH, USDC, tc.takerFee)
+				s.App.PoolManagerKeeper.SetDenomPairTakerFee(s.Ctx, USDC, ETH, tc.takerFee)
+			}
+			if !tc.spreadFactor.IsNil() {
+				config := defaultDynamicSpreadFactorConfig
+				config.MinSpreadFactor, config.MaxSpreadFactor = tc.spreadFactor, tc.spreadFactor
+				s.Require().NoError(clk.SetDynamicSpreadFactorConfigs(s.Ctx, []types.PoolIdToDynamicSpreadFactorRecord{{PoolId: pool.GetId(), Config: &config}}))
+			}
+
+			owner := s.TestAccs[1]
+			strategy := defaultManagedPositionStrategy
+			managedPosition := s.createManagedPosition(pool.GetId(), owner, defaultManagedPositionCoins, strategy)
+			ownerBalanceBefore := s.App.BankKeeper.GetAllBalances(s.Ctx, owner)
+
+			// Record the initial price of the pool in the TWAP.
+			s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(time.Hour))
+			s.App.TwapKeeper.EndBlock(s.Ctx)
+
+			if tc.movePrice {
+				swapAmount := tc.swapAmount
+				if swapAmount == 0 {
+					swapAmount = 100_000_000
+				}
+				s.swapExactAmountIn(pool.GetId(), sdk.NewCoin(USDC, osmomath.NewInt(swapAmount)), ETH)
+			}
+			if tc.moveTwap {
+				s.App.TwapKeeper.EndBlock(s.Ctx)
+			}
+
+			clk.RebalanceManagedPositions(s.Ctx)
+
+			if !tc.movePrice {
+				stored, err := clk.GetManagedPosition(s.Ctx, managedPosition.ManagedPositionId)
+				s.Require().NoError(err)
+				s.Require().Equal(managedPosition.String(), stored.String())
+				return
+			}
+
+			// The position is only marked as out of range until the delay elapses.
+			stored, err := clk.GetManagedPosition(s.Ctx, managedPosition.ManagedPositionId)
+			s.Require().NoError(err)
+			s.Require().True(stored.IsOutOfRange())
+			s.Require().Equal(s.Ctx.BlockTime().UTC(), stored.OutOfRangeSince.UTC())
+			s.Require().Equal(managedPosition.PositionId, stored.PositionId)
+			s.Require().Zero(stored.NumRebalances)
+
+			if tc.movePriceBack {
+				s.swapExactAmountIn(pool.GetId(), sdk.NewCoin(ETH, osmomath.NewInt(20_000)), USDC)
+				s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(time.Second))
+				clk.RebalanceManagedPositions(s.Ctx)
+
+				stored, err := clk.GetManagedPosition(s.Ctx, managedPosition.ManagedPositionId)
+				s.Require().NoError(err)
+				s.Require().False(stored.IsOutOfRange())
+			}
+
+			takerFeeCollector := s.App.AccountKeeper.GetModuleAddress(txfeestypes.TakerFeeCollectorName)
+			takerFeesBefore := s.App.BankKeeper.GetAllBalances(s.Ctx, takerFeeCollector)
+
+			s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(strategy.OutOfRangeDelay))
+			clk.RebalanceManagedPositions(s.Ctx)
+
+			if !tc.expectRebalance {
+				stored, err := clk.GetManagedPosition(s.Ctx, managedPosition.ManagedPositionId)
+				s.Require().NoError(err)
+				s.Require().Equal(managedPosition.PositionId, stored.PositionId)
+				s.Require().Zero(stored.NumRebalances)
+				if !tc.movePriceBack {
+					// The failed attempt is retried once the delay elapses again.
+					s.Require().Equal(s.Ctx.BlockTime().UTC(), stored.OutOfRangeSince.UTC())
+				}
+				return
+			}
+
+			rebalanced, err := clk.GetManagedPosition(s.Ctx, managedPosition.ManagedPositionId)
+			s.Require().NoError(err)
+			s.Require().Equal(uint64(1), rebalanced.NumRebalances)
+			s.Require().False(rebalanced.IsOutOfRange())
+			s.Require().Equal(s.Ctx.BlockTime().UTC(), rebalanced.LastRebalanceTime.UTC())
+			s.Require().NotEqual(managedPosition.PositionId, rebalanced.PositionId)
+			s.AssertEventEmitted(s.Ctx, types.TypeEvtRebalanceManagedPosition, 1)
+
+			// The re-centering swap is charged the taker fee like any user swap.
+			takerFeesCharged := s.App.BankKeeper.GetAllBalances(s.Ctx, takerFeeCollector).Sub(takerFeesBefore...)
+			s.Require().Equal(!tc.takerFee.IsNil(), !takerFeesCharged.IsZero(), takerFeesCharged.String())
+
+			// The previous position is withdrawn.
+			_, err = clk.GetPosition(s.Ctx, managedPosition.PositionId)
+			s.Require().ErrorIs(err, types.PositionIdNotFoundError{PositionId: managedPosition.PositionId})
+
+			// The new position holds both tokens around the current tick.
+			updatedPool, err := clk.GetConcentratedPoolById(s.Ctx, pool.GetId())
+			s.Require().NoError(err)
+			breakdown, err := clk.GetManagedPositionBreakdown(s.Ctx, rebalanced)
+			s.Require().NoError(err)
+			s.Require().LessOrEqual(breakdown.LowerTick, updatedPool.GetCurrentTick())
+			s.Require().Greater(breakdown.UpperTick, updatedPool.GetCurrentTick())
+			s.Require().Equal(breakdown.UpperTick-breakdown.LowerTick, 2*strategy.HalfWidth)
+			s.Require().True(breakdown.Asset0.Amount.IsPositive())
+			s.Require().True(breakdown.Asset1.Amount.IsPositive())
+
+			// Only the remainder that did not fit the new position is sent to the owner.
+			ownerBalanceAfter := s.App.BankKeeper.GetAllBalances(s.Ctx, owner)
+			s.Require().True(ownerBalanceAfter.IsAllGTE(ownerBalanceBefore))
+			s.Require().True(s.App.BankKeeper.GetAllBalances(s.Ctx, types.ManagedPositionEscrowAddress(pool.GetId())).IsZero())
+
+			// A re-centered position is in range and left untouched.
+			s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(strategy.OutOfRangeDelay))
+			clk.RebalanceManagedPositions(s.Ctx)
+			stored, err = clk.GetManagedPosition(s.Ctx, managedPosition.ManagedPositionId)
+			s.Require().NoError(err)
+			s.Require().Equal(rebalanced.String(), stored.String())
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestWithdrawManagedPosition() {
+	s.SetupTest()
+	clk := s.App.ConcentratedLiquidityKeeper
+	pool := s.PrepareConcentratedPool()
+	s.SetupDefaultPosition(pool.GetId())
+
+	owner := s.TestAccs[1]
+	managedPosition := s.createManagedPosition(pool.GetId(), owner, defaultManagedPositionCoins, defaultManagedPositionStrategy)
+
+	breakdown, err := clk.GetManagedPositionBreakdown(s.Ctx, managedPosition)
+	s.Require().NoError(err)
+
+	// Only the owner can withdraw.
+	_, err = clk.WithdrawManagedPosition(s.Ctx, s.TestAccs[2], managedPosition.ManagedPositionId)
+	s.Require().ErrorIs(err, types.NotManagedPositionOwnerError{ManagedPositionId: managedPosition.ManagedPositionId, Address: s.TestAccs[2].String()})
+
+	_, err = clk.WithdrawManagedPosition(s.Ctx, owner, managedPosition.ManagedPositionId+1)
+	s.Require().ErrorIs(err, types.ManagedPositionNotFoundError{ManagedPositionId: managedPosition.ManagedPositionId + 1})
+
+	balanceBefore := s.App.BankKeeper.GetAllBalances(s.Ctx, owner)
+	tokensOut, err := clk.WithdrawManagedPosition(s.Ctx, owner, managedPosition.ManagedPositionId)
+	s.Require().NoError(err)
+	s.Require().Equal(balanceBefore.Add(tokensOut...), s.App.BankKeeper.GetAllBalances(s.Ctx, owner))
+	// The breakdown rounds up in favor of the position while withdrawals round down in favor of the pool.
+	osmoassert.Equal(s.T(), errToleranceOneRoundDown, breakdown.Asset0.Amount, tokensOut.AmountOf(ETH))
+	osmoassert.Equal(s.T(), errToleranceOneRoundDown, breakdown.Asset1.Amount, tokensOut.AmountOf(USDC))
+	s.AssertEventEmitted(s.Ctx, types.TypeEvtWithdrawManagedPosition, 1)
+
+	_, err = clk.GetManagedPosition(s.Ctx, managedPosition.ManagedPositionId)
+	s.Require().ErrorIs(err, types.ManagedPositionNotFoundError{ManagedPositionId: managedPosition.ManagedPositionId})
+	_, err = clk.GetPosition(s.Ctx, managedPosition.PositionId)
+	s.Require().ErrorIs(err, types.PositionIdNotFoundError{PositionId: managedPosition.PositionId})
+
+	managedPositions, _, err := clk.GetUserManagedPositionsSerialized(s.Ctx, owner, 0, nil)
+	s.Require().NoError(err)
+	s.Require().Len(managedPositions, 0)
+}
+
+func (s *KeeperTestSuite) TestManagedPositionGenesisRoundTrip() {
+	s.SetupTest()
+	clk := s.App.ConcentratedLiquidityKeeper
+	pool := s.PrepareConcentratedPool()
+	s.SetupDefaultPosition(pool.GetId())
+
+	owner := s.TestAccs[1]
+	managedPosition := s.createManagedPosition(pool.GetId(), owner, defaultManagedPositionCoins, defaultManagedPositionStrategy)
+
+	exported := clk.ExportGenesis(s.Ctx)
+	s.Require().Len(exported.ManagedPositions, 1)
+	s.Require().Equal(managedPosition.ManagedPositionId+1, exported.NextManagedPositionId)
+	s.Require().NoError(exported.Validate())
+
+	s.SetupTest()
+	clk = s.App.ConcentratedLiquidityKeeper
+	clk.InitGenesis(s.Ctx, *exported)
+
+	s.Require().Equal(exported.NextManagedPositionId, clk.GetNextManagedPositionId(s.Ctx))
+	managedPositions, _, err := clk.GetUserManagedPositionsSerialized(s.Ctx, owner, pool.GetId(), nil)
+	s.Require().NoError(err)
+	s.Require().Len(managedPositions, 1)
+	s.Require().Equal(managedPosition.String(), managedPositions[0].ManagedPosition.String())
+}
@@ -198,3 +198,141 @@ func (server msgServer) TransferPositions(goCtx context.Context, msg *types.MsgT
 
 	return &types.MsgTransferPositionsResponse{}, nil
 }
+
+func (server msgServer) PlaceLimitOrder(goCtx context.Context, msg *types.MsgPlaceLimitOrder) (*types.MsgPlaceLimitOrderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	order, err := server.keeper.PlaceLimitOrder(ctx, sender, msg.PoolId, msg.TickIndex, msg.TokenIn)
+	if err != nil {
+		return nil, err
+	}
+
+	// Note: place limit order event is emitted in keeper.PlaceLimitOrder(...)
+
+	return &types.MsgPlaceLimitOrderResponse{OrderId: order.OrderId, PositionId: order.PositionId, Quantity: order.Quantity}, nil
+}
+
+func (server msgServer) CancelLimitOrder(goCtx context.Context, msg *types.MsgCancelLimitOrder) (*types.MsgCancelLimitOrderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	tokensOut, err := server.keeper.CancelLimitOrder(ctx, sender, msg.OrderId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Note: cancel limit order event is emitted in keeper.CancelLimitOrder(...)
+
+	return &types.MsgCancelLimitOrderResponse{TokensOut: tokensOut}, nil
+}
+
+func (server msgServer) ClaimLimitOrder(goCtx context.Context, msg *types.MsgClaimLimitOrder) (*types.MsgClaimLimitOrderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	tokensOut, err := server.keeper.ClaimLimitOrder(ctx, sender, msg.OrderId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Note: claim limit order event is emitted in keeper.ClaimLimitOrder(...)
+
+	return &types.MsgClaimLimitOrderResponse{TokensOut: tokensOut}, nil
+}
+
+func (server msgServer) CreateManagedPosition(goCtx context.Context, msg *types.MsgCreateManagedPosition) (*types.MsgCreateManagedPositionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	managedPosition, positionData, err := server.keeper.CreateManagedPosition(ctx, sender, msg.PoolId, msg.TokensProvided, msg.Strategy)
+	if err != nil {
+		return nil, err
+	}
+
+	// Note: create managed position event is emitted in keeper.CreateManagedPosition(...)
+
+	return &types.MsgCreateManagedPositionResponse{
+		ManagedPositionId: managedPosition.ManagedPositionId,
+		PositionId:        positionData.ID,
+		Amount0:           positionData.Amount0,
+		Amount1:           positionData.Amount1,
+		LiquidityCreated:  positionData.Liquidity,
+		LowerTick:         positionData.LowerTick,
+		UpperTick:         positionData.UpperTick,
+	}, nil
+}
+
+func (server msgServer) WithdrawManagedPosition(goCtx context.Context, msg *types.MsgWithdrawManagedPosition) (*types.MsgWithdrawManagedPositionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	tokensOut, err := server.keeper.WithdrawManagedPosition(ctx, sender, msg.ManagedPositionId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Note: withdraw managed position event is emitted in keeper.WithdrawManagedPosition(...)
+
+	return &types.MsgWithdrawManagedPositionResponse{TokensOut: tokensOut}, nil
+}
+
+func (server msgServer) CompoundPosition(goCtx context.Context, msg *types.MsgCompoundPosition) (*types.MsgCompoundPositionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	compoundData, err := server.keeper.compoundPosition(ctx, sender, msg.PositionId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Note: compound position event is emitted in keeper.compoundPosition(...)
+
+	return &types.MsgCompoundPositionResponse{
+		PositionId:     msg.PositionId,
+		Amount0:        compoundData.Amount0,
+		Amount1:        compoundData.Amount1,
+		LiquidityAdded: compoundData.LiquidityAdded,
+	}, nil
+}
+
+func (server msgServer) SetPositionAutoCompound(goCtx context.Context, msg *types.MsgSetPositionAutoCompound) (*types.MsgSetPositionAutoCompoundResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := server.keeper.setPositionAutoCompound(ctx, sender, msg.PositionId, msg.Enabled); err != nil {
+		return nil, err
+	}
+
+	// Note: set position auto compound event is emitted in keeper.setPositionAutoCompound(...)
+
+	return &types.MsgSetPositionAutoCompoundResponse{}, nil
+}
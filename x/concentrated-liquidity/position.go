@@ -346,6 +346,7 @@ func (k Keeper) SetPosition(ctx sdk.Context,
 // - owner-pool-id-position-id to position id
 // - pool-id-position-id to position id
 // - position-id to underlying lock id if such mapping exists
+// - position-id to auto-compound record if such mapping exists
 // Returns error if:
 // - the position with the given id does not exist.
 // - the owner-pool-id-position-id to position id mapping does not exist.
@@ -390,6 +391,9 @@ func (k Keeper) deletePosition(ctx sdk.Context,
 		store.Delete(lockIdPositionKey)
 	}
 
+	// Remove the position from auto-compounding (if it is opted in)
+	k.deleteAutoCompoundPosition(ctx, positionId)
+
 	return nil
 }
 
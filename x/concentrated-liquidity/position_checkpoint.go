@@ -0,0 +1,291 @@
+package concentrated_liquidity
+
+import (
+	"errors"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v24/x/concentrated-liquidity/types"
+)
+
+// secondsPerYear is used to annualize the spread reward APR of a position.
+const secondsPerYear = int64(365 * 24 * time.Hour / time.Second)
+
+// positionCheckpointDelta holds the amounts by which an action on a position increases the
+// cumulative amounts recorded in its checkpoints.
+type positionCheckpointDelta struct {
+	deposited              sdk.Coins
+	withdrawn              sdk.Coins
+	spreadRewardsCollected sdk.Coins
+	incentivesCollected    sdk.Coins
+}
+
+// PositionHistory holds the checkpoints of a position together with its current value and the
+// performance derived from them.
+type PositionHistory struct {
+	Checkpoints        []types.PositionCheckpoint
+	Asset0             sdk.Coin
+	Asset1             sdk.Coin
+	TotalSpreadRewards sdk.Coins
+	TotalIncentives    sdk.Coins
+	PositionValue      osmomath.Dec
+	HoldValue          osmomath.Dec
+	ImpermanentLoss    osmomath.Dec
+	SpreadRewardApr    osmomath.Dec
+}
+
+// writePositionCheckpoint records the current underlying assets and liquidity of the given position at the
+// current height, adding the given delta to the cumulative amounts of its latest checkpoint. A checkpoint
+// written earlier at the same height is replaced, and the oldest checkpoint but the first is pruned once
+// the position has more than types.MaxPositionCheckpoints. If the position was withdrawn in full, its
+// underlying assets and liquidity are recorded as zero until WithdrawPosition deletes its checkpoints.
+// No-op for positions owned by a module escrow address on behalf of their users, such as limit orders
+// and managed positions.
+func (k Keeper) writePositionCheckpoint(ctx sdk.Context, owner sdk.AccAddress, poolId, positionId uint64, delta positionCheckpointDelta) error {
+	if isEscrowAddress(owner, poolId) {
+		return nil
+	}
+
+	pool, err := k.getPoolById(ctx, poolId)
+	if err != nil {
+		return err
+	}
+
+	checkpoint := types.PositionCheckpoint{
+		PositionId: positionId,
+		PoolId:     poolId,
+		Height:     ctx.BlockHeight(),
+		Time:       ctx.BlockTime(),
+		Liquidity:  osmomath.ZeroDec(),
+		Asset0:     sdk.NewCoin(pool.GetToken0(), osmomath.ZeroInt()),
+		Asset1:     sdk.NewCoin(pool.GetToken1(), osmomath.ZeroInt()),
+	}
+
+	latest, found, err := k.getLatestPositionCheckpoint(ctx, positionId)
+	if err != nil {
+		return err
+	}
+	if found {
+		checkpoint.Deposited = latest.Deposited
+		checkpoint.Withdrawn = latest.Withdrawn
+		checkpoint.SpreadRewardsCollected = latest.SpreadRewardsCollected
+		checkpoint.IncentivesCollected = latest.IncentivesCollected
+	}
+	checkpoint.Deposited = checkpoint.Deposited.Add(delta.deposited...)
+	checkpoint.Withdrawn = checkpoint.Withdrawn.Add(delta.withdrawn...)
+	checkpoint.SpreadRewardsCollected = checkpoint.SpreadRewardsCollected.Add(delta.spreadRewardsCollected...)
+	checkpoint.IncentivesCollected = checkpoint.IncentivesCollected.Add(delta.incentivesCollected...)
+
+	position, err := k.GetPosition(ctx, positionId)
+	if err != nil && !errors.Is(err, types.PositionIdNotFoundError{PositionId: positionId}) {
+		return err
+	}
+	if err == nil {
+		checkpoint.Liquidity = position.Liquidity
+	}
+	// The underlying assets cannot be computed without a spot price, i.e. once the pool has been drained.
+	if err == nil && !pool.GetCurrentSqrtPrice().IsZero() {
+		checkpoint.Asset0, checkpoint.Asset1, err = CalculateUnderlyingAssetsFromPosition(ctx, position, pool)
+		if err != nil {
+			return err
+		}
+	}
+
+	k.setPositionCheckpoint(ctx, checkpoint)
+	return k.prunePositionCheckpoints(ctx, positionId)
+}
+
+// isEscrowAddress returns true if the given address is one of the module escrow addresses of the pool.
+func isEscrowAddress(address sdk.AccAddress, poolId uint64) bool {
+	return address.Equals(types.LimitOrderEscrowAddress(poolId)) || address.Equals(types.ManagedPositionEscrowAddress(poolId))
+}
+
+// prunePositionCheckpoints deletes the oldest checkpoints of the given position after the first one
+// until it has at most types.MaxPositionCheckpoints.
+func (k Keeper) prunePositionCheckpoints(ctx sdk.Context, positionId uint64) error {
+	checkpoints, err := k.getPositionCheckpoints(ctx, positionId)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	for i := 1; i <= len(checkpoints)-types.MaxPositionCheckpoints; i++ {
+		store.Delete(types.KeyPositionCheckpoint(positionId, checkpoints[i].Height))
+	}
+	return nil
+}
+
+// deletePositionCheckpoints deletes all checkpoints of the given position.
+func (k Keeper) deletePositionCheckpoints(ctx sdk.Context, positionId uint64) {
+	osmoutils.DeleteAllKeysFromPrefix(ctx.KVStore(k.storeKey), types.KeyPositionCheckpoints(positionId))
+}
+
+// movePositionCheckpoints moves the checkpoints of the position with id fromId over to the position with id
+// toId, which was created by re-depositing the fully withdrawn liquidity of the former together with
+// additional tokens. The re-deposited amounts are neither counted as withdrawn nor as deposited, so that
+// the cumulative amounts of the new position only grow by the additional tokens.
+// No-op if the former position has no checkpoints.
+func (k Keeper) movePositionCheckpoints(ctx sdk.Context, fromId, toId uint64, reDeposited sdk.Coins) error {
+	checkpoints, err := k.getPositionCheckpoints(ctx, fromId)
+	if err != nil {
+		return err
+	}
+	if len(checkpoints) == 0 {
+		return nil
+	}
+
+	newCheckpoint, found, err := k.getLatestPositionCheckpoint(ctx, toId)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return types.PositionIdNotFoundError{PositionId: toId}
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	for _, checkpoint := range checkpoints {
+		store.Delete(types.KeyPositionCheckpoint(fromId, checkpoint.Height))
+		checkpoint.PositionId = toId
+		k.setPositionCheckpoint(ctx, checkpoint)
+	}
+
+	latest := checkpoints[len(checkpoints)-1]
+	newCheckpoint.Deposited = latest.Deposited.Add(newCheckpoint.Deposited...).Sub(reDeposited...)
+	newCheckpoint.Withdrawn = latest.Withdrawn.Sub(reDeposited...)
+	newCheckpoint.SpreadRewardsCollected = latest.SpreadRewardsCollected.Add(newCheckpoint.SpreadRewardsCollected...)
+	newCheckpoint.IncentivesCollected = latest.IncentivesCollected.Add(newCheckpoint.IncentivesCollected...)
+	k.setPositionCheckpoint(ctx, newCheckpoint)
+	return k.prunePositionCheckpoints(ctx, toId)
+}
+
+// GetPositionHistory returns the checkpoints of the given position together with its current underlying
+// assets, the spread rewards and incentives collected and claimable since its creation, and its
+// performance:
+// - the position value, i.e. the value of its current underlying assets plus the assets withdrawn from it,
+// and the hold value, i.e. the value of the assets deposited into it, both in units of token1 at the
+// current spot price
+// - the impermanent loss, i.e. position value / hold value - 1, excluding rewards
+// - the spread reward APR, i.e. the value of the spread rewards relative to the hold value,
+// annualized over the time since the first checkpoint
+//
+// The checkpoints of a position withdrawn in full are deleted, and positions owned by a module escrow
+// address have none. Positions without checkpoints have no deposits to compare with, so their
+// impermanent loss and APR are zero.
+// Returns error if the position has neither checkpoints nor exists.
+func (k Keeper) GetPositionHistory(ctx sdk.Context, positionId uint64) (PositionHistory, error) {
+	checkpoints, err := k.getPositionCheckpoints(ctx, positionId)
+	if err != nil {
+		return PositionHistory{}, err
+	}
+
+	position, err := k.GetPosition(ctx, positionId)
+	positionExists := err == nil
+	if err != nil && !errors.Is(err, types.PositionIdNotFoundError{PositionId: positionId}) {
+		return PositionHistory{}, err
+	}
+	if !positionExists && len(checkpoints) == 0 {
+		return PositionHistory{}, types.PositionIdNotFoundError{PositionId: positionId}
+	}
+
+	poolId := position.PoolId
+	if !positionExists {
+		poolId = checkpoints[0].PoolId
+	}
+	pool, err := k.getPoolById(ctx, poolId)
+	if err != nil {
+		return PositionHistory{}, err
+	}
+
+	latest := types.PositionCheckpoint{}
+	if len(checkpoints) > 0 {
+		latest = checkpoints[len(checkpoints)-1]
+	}
+
+	history := PositionHistory{
+		Checkpoints:        checkpoints,
+		Asset0:             sdk.NewCoin(pool.GetToken0(), osmomath.ZeroInt()),
+		Asset1:             sdk.NewCoin(pool.GetToken1(), osmomath.ZeroInt()),
+		TotalSpreadRewards: latest.SpreadRewardsCollected,
+		TotalIncentives:    latest.IncentivesCollected,
+		ImpermanentLoss:    osmomath.ZeroDec(),
+		SpreadRewardApr:    osmomath.ZeroDec(),
+	}
+
+	if positionExists {
+		if !pool.GetCurrentSqrtPrice().IsZero() {
+			history.Asset0, history.Asset1, err = CalculateUnderlyingAssetsFromPosition(ctx, position, pool)
+			if err != nil {
+				return PositionHistory{}, err
+			}
+		}
+
+		claimableSpreadRewards, err := k.GetClaimableSpreadRewards(ctx, positionId)
+		if err != nil {
+			return PositionHistory{}, err
+		}
+		history.TotalSpreadRewards = history.TotalSpreadRewards.Add(claimableSpreadRewards...)
+
+		claimableIncentives, _, err := k.GetClaimableIncentives(ctx, positionId)
+		if err != nil {
+			return PositionHistory{}, err
+		}
+		history.TotalIncentives = history.TotalIncentives.Add(claimableIncentives...)
+	}
+
+	// The value of token0 and token1 amounts in units of token1 at the current spot price.
+	currentSqrtPrice := pool.GetCurrentSqrtPrice()
+	spotPrice := currentSqrtPrice.Mul(currentSqrtPrice)
+	valueInToken1 := func(coins sdk.Coins) osmomath.Dec {
+		value := osmomath.BigDecFromSDKInt(coins.AmountOf(pool.GetToken0())).MulMut(spotPrice)
+		return value.AddMut(osmomath.BigDecFromSDKInt(coins.AmountOf(pool.GetToken1()))).Dec()
+	}
+
+	history.PositionValue = valueInToken1(sdk.NewCoins(history.Asset0, history.Asset1).Add(latest.Withdrawn...))
+	history.HoldValue = valueInToken1(latest.Deposited)
+	if !history.HoldValue.IsPositive() {
+		return history, nil
+	}
+
+	history.ImpermanentLoss = history.PositionValue.Quo(history.HoldValue).Sub(osmomath.OneDec())
+
+	elapsedSeconds := int64(ctx.BlockTime().Sub(checkpoints[0].Time) / time.Second)
+	if elapsedSeconds > 0 {
+		spreadRewardsValue := valueInToken1(history.TotalSpreadRewards)
+		history.SpreadRewardApr = spreadRewardsValue.MulInt64(secondsPerYear).Quo(history.HoldValue).QuoInt64(elapsedSeconds)
+	}
+
+	return history, nil
+}
+
+// getLatestPositionCheckpoint returns the latest checkpoint of the given position and whether it has any.
+func (k Keeper) getLatestPositionCheckpoint(ctx sdk.Context, positionId uint64) (types.PositionCheckpoint, bool, error) {
+	iterator := sdk.KVStoreReversePrefixIterator(ctx.KVStore(k.storeKey), types.KeyPositionCheckpoints(positionId))
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return types.PositionCheckpoint{}, false, nil
+	}
+	checkpoint, err := ParsePositionCheckpointFromBz(iterator.Value())
+	if err != nil {
+		return types.PositionCheckpoint{}, false, err
+	}
+	return checkpoint, true, nil
+}
+
+// getPositionCheckpoints returns the checkpoints of the given position, ordered by height.
+func (k Keeper) getPositionCheckpoints(ctx sdk.Context, positionId uint64) ([]types.PositionCheckpoint, error) {
+	return osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(k.storeKey), types.KeyPositionCheckpoints(positionId), ParsePositionCheckpointFromBz)
+}
+
+// getAllPositionCheckpoints returns the checkpoints of all positions, ordered by position id and height.
+func (k Keeper) getAllPositionCheckpoints(ctx sdk.Context) ([]types.PositionCheckpoint, error) {
+	return osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(k.storeKey), types.PositionCheckpointPrefix, ParsePositionCheckpointFromBz)
+}
+
+// setPositionCheckpoint stores the given checkpoint, replacing any checkpoint of the same position at the same height.
+func (k Keeper) setPositionCheckpoint(ctx sdk.Context, checkpoint types.PositionCheckpoint) {
+	osmoutils.MustSet(ctx.KVStore(k.storeKey), types.KeyPositionCheckpoint(checkpoint.PositionId, checkpoint.Height), &checkpoint)
+}
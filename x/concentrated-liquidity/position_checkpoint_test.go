@@ -0,0 +1,224 @@
+package concentrated_liquidity_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v24/x/concentrated-liquidity/types"
+)
+
+// nextBlock moves the context to the next block, the given duration later.
+func (s *KeeperTestSuite) nextBlock(duration time.Duration) {
+	s.AddBlockTime(duration)
+	s.Ctx = s.Ctx.WithBlockHeight(s.Ctx.BlockHeight() + 1)
+}
+
+func (s *KeeperTestSuite) TestPositionCheckpoints() {
+	s.SetupTest()
+	clk := s.App.ConcentratedLiquidityKeeper
+	pool := s.PrepareConcentratedPool()
+	s.SetupDefaultPosition(pool.GetId())
+	owner := s.TestAccs[0]
+
+	// Creating the position records its deposit.
+	history, err := clk.GetPositionHistory(s.Ctx, 1)
+	s.Require().NoError(err)
+	s.Require().Len(history.Checkpoints, 1)
+	created := history.Checkpoints[0]
+	s.Require().Equal(s.Ctx.BlockHeight(), created.Height)
+	s.Require().Equal(sdk.NewCoins(created.Asset0, created.Asset1), created.Deposited)
+	s.Require().True(created.Withdrawn.Empty())
+
+	// Collecting spread rewards records the collected amounts.
+	s.nextBlock(time.Hour)
+	s.accrueSpreadRewards(pool, sdk.NewDecCoins(sdk.NewDecCoinFromDec(USDC, osmomath.MustNewDecFromStr("0.5"))))
+	collected, err := clk.CollectSpreadRewards(s.Ctx, owner, 1)
+	s.Require().NoError(err)
+	history, err = clk.GetPositionHistory(s.Ctx, 1)
+	s.Require().NoError(err)
+	s.Require().Len(history.Checkpoints, 2)
+	s.Require().Equal(collected, history.Checkpoints[1].SpreadRewardsCollected)
+	s.Require().Equal(created.Deposited, history.Checkpoints[1].Deposited)
+
+	// A partial withdrawal records the withdrawn amounts and the remaining liquidity.
+	s.nextBlock(time.Hour)
+	amount0, amount1, err := clk.WithdrawPosition(s.Ctx, owner, 1, created.Liquidity.QuoInt64(2))
+	s.Require().NoError(err)
+	history, err = clk.GetPositionHistory(s.Ctx, 1)
+	s.Require().NoError(err)
+	s.Require().Len(history.Checkpoints, 3)
+	withdrawn := history.Checkpoints[2]
+	s.Require().Equal(sdk.NewCoins(sdk.NewCoin(ETH, amount0), sdk.NewCoin(USDC, amount1)), withdrawn.Withdrawn)
+	s.Require().Equal(created.Liquidity.Sub(created.Liquidity.QuoInt64(2)), withdrawn.Liquidity)
+	s.Require().Equal(collected, withdrawn.SpreadRewardsCollected)
+
+	// Actions within the same block replace the checkpoint of the block.
+	_, _, err = clk.WithdrawPosition(s.Ctx, owner, 1, withdrawn.Liquidity.QuoInt64(2))
+	s.Require().NoError(err)
+	history, err = clk.GetPositionHistory(s.Ctx, 1)
+	s.Require().NoError(err)
+	s.Require().Len(history.Checkpoints, 3)
+
+	// The history of a position withdrawn in full is deleted.
+	s.nextBlock(time.Hour)
+	position, err := clk.GetPosition(s.Ctx, 1)
+	s.Require().NoError(err)
+	_, _, err = clk.WithdrawPosition(s.Ctx, owner, 1, position.Liquidity)
+	s.Require().NoError(err)
+	_, err = clk.GetPositionHistory(s.Ctx, 1)
+	s.Require().ErrorIs(err, types.PositionIdNotFoundError{PositionId: 1})
+	exported := clk.ExportGenesis(s.Ctx)
+	s.Require().Empty(exported.PositionCheckpoints)
+
+	// Unknown positions have no history.
+	_, err = clk.GetPositionHistory(s.Ctx, 2)
+	s.Require().ErrorIs(err, types.PositionIdNotFoundError{PositionId: 2})
+}
+
+func (s *KeeperTestSuite) TestPositionCheckpointsPruned() {
+	s.SetupTest()
+	clk := s.App.ConcentratedLiquidityKeeper
+	pool := s.PrepareConcentratedPool()
+	s.SetupDefaultPosition(pool.GetId())
+	owner := s.TestAccs[0]
+
+	history, err := clk.GetPositionHistory(s.Ctx, 1)
+	s.Require().NoError(err)
+	created := history.Checkpoints[0]
+
+	withdrawn := sdk.Coins{}
+	for i := 0; i < types.MaxPositionCheckpoints+5; i++ {
+		s.nextBlock(time.Hour)
+		amount0, amount1, err := clk.WithdrawPosition(s.Ctx, owner, 1, osmomath.OneDec())
+		s.Require().NoError(err)
+		withdrawn = withdrawn.Add(sdk.NewCoin(ETH, amount0), sdk.NewCoin(USDC, amount1))
+	}
+
+	// The first checkpoint is kept, followed by the latest ones, and the cumulative amounts are preserved.
+	history, err = clk.GetPositionHistory(s.Ctx, 1)
+	s.Require().NoError(err)
+	s.Require().Len(history.Checkpoints, types.MaxPositionCheckpoints)
+	s.Require().Equal(created.String(), history.Checkpoints[0].String())
+	s.Require().Equal(s.Ctx.BlockHeight()-types.MaxPositionCheckpoints+2, history.Checkpoints[1].Height)
+	latest := history.Checkpoints[len(history.Checkpoints)-1]
+	s.Require().Equal(s.Ctx.BlockHeight(), latest.Height)
+	s.Require().Equal(withdrawn.String(), latest.Withdrawn.String())
+}
+
+func (s *KeeperTestSuite) TestEscrowPositionsHaveNoCheckpoints() {
+	s.SetupTest()
+	clk := s.App.ConcentratedLiquidityKeeper
+	pool := s.PrepareConcentratedPool()
+	s.SetupDefaultPosition(pool.GetId())
+
+	managedPosition := s.createManagedPosition(pool.GetId(), s.TestAccs[1], defaultManagedPositionCoins, defaultManagedPositionStrategy)
+	history, err := clk.GetPositionHistory(s.Ctx, managedPosition.PositionId)
+	s.Require().NoError(err)
+	s.Require().Empty(history.Checkpoints)
+
+	exported := clk.ExportGenesis(s.Ctx)
+	for _, checkpoint := range exported.PositionCheckpoints {
+		s.Require().Equal(uint64(1), checkpoint.PositionId)
+	}
+}
+
+func (s *KeeperTestSuite) TestAddToPositionMovesCheckpoints() {
+	s.SetupTest()
+	clk := s.App.ConcentratedLiquidityKeeper
+	pool := s.PrepareConcentratedPool()
+	s.SetupDefaultPosition(pool.GetId())
+	s.SetupDefaultPositionAcc(pool.GetId(), s.TestAccs[1])
+	owner := s.TestAccs[0]
+
+	history, err := clk.GetPositionHistory(s.Ctx, 1)
+	s.Require().NoError(err)
+	deposited := history.Checkpoints[0].Deposited
+
+	s.nextBlock(time.Hour)
+	s.FundAcc(owner, sdk.NewCoins(sdk.NewCoin(ETH, osmomath.NewInt(1_000)), sdk.NewCoin(USDC, osmomath.NewInt(5_000_000))))
+	newPositionId, _, _, err := clk.AddToPosition(s.Ctx, owner, 1, osmomath.NewInt(1_000), osmomath.NewInt(5_000_000), osmomath.ZeroInt(), osmomath.ZeroInt())
+	s.Require().NoError(err)
+
+	_, err = clk.GetPositionHistory(s.Ctx, 1)
+	s.Require().ErrorIs(err, types.PositionIdNotFoundError{PositionId: 1})
+
+	history, err = clk.GetPositionHistory(s.Ctx, newPositionId)
+	s.Require().NoError(err)
+	s.Require().Len(history.Checkpoints, 2)
+	for _, checkpoint := range history.Checkpoints {
+		s.Require().Equal(newPositionId, checkpoint.PositionId)
+	}
+
+	// Only the added amounts count as deposited, and the re-deposited liquidity does not count as withdrawn.
+	latest := history.Checkpoints[1]
+	s.Require().True(latest.Withdrawn.Empty())
+	added, hasNeg := latest.Deposited.SafeSub(deposited...)
+	s.Require().False(hasNeg)
+	s.Require().True(added.AmountOf(ETH).LTE(osmomath.NewInt(1_000)))
+	s.Require().True(added.AmountOf(USDC).LTE(osmomath.NewInt(5_000_000)))
+	s.Require().True(added.AmountOf(USDC).IsPositive())
+}
+
+func (s *KeeperTestSuite) TestGetPositionHistory() {
+	s.SetupTest()
+	clk := s.App.ConcentratedLiquidityKeeper
+	pool := s.PrepareConcentratedPool()
+	s.SetupDefaultPosition(pool.GetId())
+
+	// Before the price moves, the position is worth as much as the deposit.
+	history, err := clk.GetPositionHistory(s.Ctx, 1)
+	s.Require().NoError(err)
+	s.Require().True(history.HoldValue.IsPositive())
+	s.Require().Equal(osmomath.ZeroDec(), history.SpreadRewardApr)
+	s.Require().True(history.ImpermanentLoss.Abs().LT(osmomath.MustNewDecFromStr("0.000001")), history.ImpermanentLoss.String())
+
+	// Moving the price makes the position worth less than holding the deposit.
+	s.nextBlock(time.Hour)
+	s.swapExactAmountIn(pool.GetId(), sdk.NewCoin(USDC, osmomath.NewInt(2_000_000_000)), ETH)
+	history, err = clk.GetPositionHistory(s.Ctx, 1)
+	s.Require().NoError(err)
+	s.Require().True(history.ImpermanentLoss.IsNegative(), history.ImpermanentLoss.String())
+	s.Require().True(history.PositionValue.LT(history.HoldValue))
+
+	// Claimable spread rewards count towards the total and are annualized into the APR.
+	s.accrueSpreadRewards(pool, sdk.NewDecCoins(sdk.NewDecCoinFromDec(USDC, osmomath.MustNewDecFromStr("0.5"))))
+	claimable, err := clk.GetClaimableSpreadRewards(s.Ctx, 1)
+	s.Require().NoError(err)
+	history, err = clk.GetPositionHistory(s.Ctx, 1)
+	s.Require().NoError(err)
+	s.Require().Equal(claimable, history.TotalSpreadRewards)
+
+	expectedApr := claimable.AmountOf(USDC).ToLegacyDec().MulInt64(365 * 24).Quo(history.HoldValue)
+	s.Require().Equal(0, osmomath.ErrTolerance{AdditiveTolerance: osmomath.SmallestDec().MulInt64(10)}.CompareBigDec(osmomath.BigDecFromDec(expectedApr), osmomath.BigDecFromDec(history.SpreadRewardApr)))
+}
+
+func (s *KeeperTestSuite) TestPositionCheckpointsGenesisRoundTrip() {
+	s.SetupTest()
+	clk := s.App.ConcentratedLiquidityKeeper
+	pool := s.PrepareConcentratedPool()
+	s.SetupDefaultPosition(pool.GetId())
+	s.nextBlock(time.Hour)
+	_, _, err := clk.WithdrawPosition(s.Ctx, s.TestAccs[0], 1, osmomath.OneDec())
+	s.Require().NoError(err)
+	history, err := clk.GetPositionHistory(s.Ctx, 1)
+	s.Require().NoError(err)
+
+	exported := clk.ExportGenesis(s.Ctx)
+	s.Require().Len(exported.PositionCheckpoints, 2)
+	s.Require().NoError(exported.Validate())
+
+	blockTime, height := s.Ctx.BlockTime(), s.Ctx.BlockHeight()
+	s.SetupTest()
+	s.Ctx = s.Ctx.WithBlockTime(blockTime).WithBlockHeight(height)
+	clk = s.App.ConcentratedLiquidityKeeper
+	clk.InitGenesis(s.Ctx, *exported)
+
+	imported, err := clk.GetPositionHistory(s.Ctx, 1)
+	s.Require().NoError(err)
+	s.Require().Equal(len(history.Checkpoints), len(imported.Checkpoints))
+	for i := range history.Checkpoints {
+		s.Require().Equal(history.Checkpoints[i].String(), imported.Checkpoints[i].String())
+	}
+}
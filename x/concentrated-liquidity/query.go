@@ -15,6 +15,7 @@ import (
 	"github.com/osmosis-labs/osmosis/v24/x/concentrated-liquidity/model"
 	"github.com/osmosis-labs/osmosis/v24/x/concentrated-liquidity/swapstrategy"
 	types "github.com/osmosis-labs/osmosis/v24/x/concentrated-liquidity/types"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v24/x/poolmanager/types"
 )
 
 const invalidTickIndex = int64(-1)
@@ -364,3 +365,119 @@ func (k Keeper) GetNumNextInitializedTicks(ctx sdk.Context, poolId, numberOfNext
 
 	return liquidityDepths, nil
 }
+
+// CalcAmountsToSpotPrices returns the amounts of tokenInDenom swapped into and of tokenOutDenom swapped out of
+// the given pool to move the spot price of tokenOutDenom, quoted in tokenInDenom, from its current value up to
+// each of the given target spot prices, which must be in increasing order. The amounts are cumulative and
+// exclude the spread factor. Target spot prices that are not above the current spot price, or beyond the
+// price range of the pool, are clamped.
+//
+// The amounts are computed by walking the initialized ticks from the current sqrt price in the direction of
+// the swap, and summing the token deltas of the liquidity active in each range between the current sqrt price,
+// the initialized ticks and the target sqrt prices.
+//
+// Returns error if the pool is not a concentrated liquidity pool, or either denom is not an asset in the pool.
+func (k Keeper) CalcAmountsToSpotPrices(ctx sdk.Context, poolI poolmanagertypes.PoolI, tokenInDenom, tokenOutDenom string, targetSpotPrices []osmomath.BigDec) ([]osmomath.Int, []osmomath.Int, error) {
+	pool, err := asConcentrated(poolI)
+	if err != nil {
+		return nil, nil, err
+	}
+	if tokenInDenom == tokenOutDenom {
+		return nil, nil, types.DenomDuplicatedError{TokenInDenom: tokenInDenom, TokenOutDenom: tokenOutDenom}
+	}
+	if tokenInDenom != pool.GetToken0() && tokenInDenom != pool.GetToken1() {
+		return nil, nil, types.TokenInDenomNotInPoolError{TokenInDenom: tokenInDenom}
+	}
+	if tokenOutDenom != pool.GetToken0() && tokenOutDenom != pool.GetToken1() {
+		return nil, nil, types.TokenOutDenomNotInPoolError{TokenOutDenom: tokenOutDenom}
+	}
+
+	amountsIn := make([]osmomath.Int, len(targetSpotPrices))
+	amountsOut := make([]osmomath.Int, len(targetSpotPrices))
+	sqrtPrice := pool.GetCurrentSqrtPrice()
+	if sqrtPrice.IsZero() {
+		for i := range targetSpotPrices {
+			amountsIn[i], amountsOut[i] = osmomath.ZeroInt(), osmomath.ZeroInt()
+		}
+		return amountsIn, amountsOut, nil
+	}
+
+	// Swapping token0 in lowers the spot price of token0 in terms of token1, i.e. raises the spot price of token1
+	// in terms of token0. Swapping token1 in raises the spot price of token0 in terms of token1.
+	zeroForOne := tokenInDenom == pool.GetToken0()
+	swapStrategy := swapstrategy.New(zeroForOne, osmomath.ZeroBigDec(), k.storeKey, osmomath.ZeroDec())
+	nextInitTickIter := swapStrategy.InitializeNextTickIterator(ctx, pool.GetId(), pool.GetCurrentTick())
+	defer nextInitTickIter.Close()
+
+	// isBeyond returns whether the given sqrt price is strictly beyond the other one in the direction of the swap.
+	isBeyond := func(sqrtPrice, other osmomath.BigDec) bool {
+		if zeroForOne {
+			return sqrtPrice.LT(other)
+		}
+		return sqrtPrice.GT(other)
+	}
+
+	liquidity := pool.GetLiquidity()
+	amountIn, amountOut := osmomath.ZeroBigDec(), osmomath.ZeroBigDec()
+	for i, targetSpotPrice := range targetSpotPrices {
+		// The spot price of the pool is that of token0 in terms of token1, so targets for token1 are inverted.
+		targetSqrtPrice := types.MaxSqrtPriceBigDec
+		if zeroForOne {
+			targetSqrtPrice = types.MinSqrtPriceBigDec
+			if targetSpotPrice.IsPositive() && osmomath.OneBigDec().Quo(targetSpotPrice).GT(types.MinSpotPriceBigDec) {
+				targetSqrtPrice, err = osmomath.MonotonicSqrtBigDec(osmomath.OneBigDec().Quo(targetSpotPrice))
+			}
+		} else if targetSpotPrice.LT(types.MaxSpotPriceBigDec) {
+			targetSqrtPrice, err = osmomath.MonotonicSqrtBigDec(targetSpotPrice)
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+
+		for isBeyond(targetSqrtPrice, sqrtPrice) {
+			// The current range ends at the next initialized tick, unless the target comes first.
+			rangeEndSqrtPrice := targetSqrtPrice
+			crossesTick := false
+			if nextInitTickIter.Valid() {
+				nextInitializedTick, err := types.TickIndexFromBytes(nextInitTickIter.Key())
+				if err != nil {
+					return nil, nil, err
+				}
+				nextInitializedTickSqrtPrice, err := math.TickToSqrtPrice(nextInitializedTick)
+				if err != nil {
+					return nil, nil, err
+				}
+				if !isBeyond(nextInitializedTickSqrtPrice, targetSqrtPrice) {
+					rangeEndSqrtPrice = nextInitializedTickSqrtPrice
+					crossesTick = true
+				}
+			}
+
+			if !liquidity.IsZero() {
+				if zeroForOne {
+					amountIn.AddMut(math.CalcAmount0Delta(liquidity, sqrtPrice, rangeEndSqrtPrice, true))
+					amountOut.AddMut(math.CalcAmount1Delta(liquidity, sqrtPrice, rangeEndSqrtPrice, false))
+				} else {
+					amountIn.AddMut(math.CalcAmount1Delta(liquidity, sqrtPrice, rangeEndSqrtPrice, true))
+					amountOut.AddMut(math.CalcAmount0Delta(liquidity, sqrtPrice, rangeEndSqrtPrice, false))
+				}
+			}
+			sqrtPrice = rangeEndSqrtPrice
+
+			if !crossesTick {
+				break
+			}
+			nextInitializedTickInfo, err := ParseTickFromBz(nextInitTickIter.Value())
+			if err != nil {
+				return nil, nil, err
+			}
+			liquidity = liquidity.Add(swapStrategy.SetLiquidityDeltaSign(nextInitializedTickInfo.LiquidityNet))
+			nextInitTickIter.Next()
+		}
+
+		amountsIn[i] = amountIn.Ceil().Dec().TruncateInt()
+		amountsOut[i] = amountOut.Dec().TruncateInt()
+	}
+
+	return amountsIn, amountsOut, nil
+}
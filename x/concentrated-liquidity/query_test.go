@@ -4,8 +4,10 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v24/app/apptesting"
 	"github.com/osmosis-labs/osmosis/v24/x/concentrated-liquidity/client/queryproto"
 	"github.com/osmosis-labs/osmosis/v24/x/concentrated-liquidity/math"
+	"github.com/osmosis-labs/osmosis/v24/x/concentrated-liquidity/types"
 	"github.com/osmosis-labs/osmosis/v24/x/concentrated-liquidity/types/genesis"
 )
 
@@ -1028,3 +1030,87 @@ func (s *KeeperTestSuite) TestGetNumNextInitializedTicks() {
 		})
 	}
 }
+
+// This test validates that CalcAmountsToSpotPrices returns the same amounts as swaps that are
+// limited at each of the target spot prices, in both directions and across initialized ticks.
+func (s *KeeperTestSuite) TestCalcAmountsToSpotPrices() {
+	tests := map[string]struct {
+		tokenInDenom  string
+		tokenOutDenom string
+		// targetSpotPrices are the spot prices of the token out in terms of the token in.
+		targetSpotPrices []osmomath.BigDec
+		// priceLimits are the target spot prices as spot prices of the pool.
+		priceLimits []osmomath.BigDec
+
+		expectedError error
+	}{
+		"token1 in: spot price rises within and beyond the default position": {
+			tokenInDenom:     USDC,
+			tokenOutDenom:    ETH,
+			targetSpotPrices: []osmomath.BigDec{osmomath.NewBigDec(5100), osmomath.NewBigDec(5600)},
+			priceLimits:      []osmomath.BigDec{osmomath.NewBigDec(5100), osmomath.NewBigDec(5600)},
+		},
+		"token0 in: spot price falls within and beyond the default position": {
+			tokenInDenom:     ETH,
+			tokenOutDenom:    USDC,
+			targetSpotPrices: []osmomath.BigDec{osmomath.OneBigDec().QuoInt64(4800), osmomath.OneBigDec().QuoInt64(4400)},
+			priceLimits:      []osmomath.BigDec{osmomath.NewBigDec(4800), osmomath.NewBigDec(4400)},
+		},
+		"target below current spot price is clamped": {
+			tokenInDenom:     USDC,
+			tokenOutDenom:    ETH,
+			targetSpotPrices: []osmomath.BigDec{osmomath.NewBigDec(4000)},
+		},
+		"error: token in denom not in pool": {
+			tokenInDenom:     "foo",
+			tokenOutDenom:    ETH,
+			targetSpotPrices: []osmomath.BigDec{osmomath.NewBigDec(5100)},
+			expectedError:    types.TokenInDenomNotInPoolError{TokenInDenom: "foo"},
+		},
+		"error: duplicate denoms": {
+			tokenInDenom:     ETH,
+			tokenOutDenom:    ETH,
+			targetSpotPrices: []osmomath.BigDec{osmomath.NewBigDec(5100)},
+			expectedError:    types.DenomDuplicatedError{TokenInDenom: ETH, TokenOutDenom: ETH},
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			pool := s.PrepareConcentratedPool()
+			s.SetupDefaultPosition(pool.GetId())
+			s.SetupFullRangePositionAcc(pool.GetId(), s.TestAccs[1])
+			s.SetupOverlappingRangePositionAcc(pool.GetId(), s.TestAccs[2])
+			pool, err := s.App.ConcentratedLiquidityKeeper.GetConcentratedPoolById(s.Ctx, pool.GetId())
+			s.Require().NoError(err)
+
+			amountsIn, amountsOut, err := s.App.ConcentratedLiquidityKeeper.CalcAmountsToSpotPrices(s.Ctx, pool, tc.tokenInDenom, tc.tokenOutDenom, tc.targetSpotPrices)
+			if tc.expectedError != nil {
+				s.Require().ErrorIs(err, tc.expectedError)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Len(amountsIn, len(tc.targetSpotPrices))
+			s.Require().Len(amountsOut, len(tc.targetSpotPrices))
+
+			if tc.priceLimits == nil {
+				for i := range tc.targetSpotPrices {
+					s.Require().Equal(osmomath.ZeroInt(), amountsIn[i])
+					s.Require().Equal(osmomath.ZeroInt(), amountsOut[i])
+				}
+				return
+			}
+
+			errTolerance := osmomath.ErrTolerance{AdditiveTolerance: osmomath.NewDec(2)}
+			for i, priceLimit := range tc.priceLimits {
+				// The token in is far larger than the liquidity up to the price limit, so the swap stops at it.
+				swapResult, _, err := s.App.ConcentratedLiquidityKeeper.ComputeOutAmtGivenIn(s.Ctx, pool.GetId(), sdk.NewCoin(tc.tokenInDenom, apptesting.DefaultCoinAmount), tc.tokenOutDenom, osmomath.ZeroDec(), priceLimit, false)
+				s.Require().NoError(err)
+
+				s.Require().Equal(0, errTolerance.Compare(swapResult.AmountIn, amountsIn[i]), "expected %s, got %s", swapResult.AmountIn, amountsIn[i])
+				s.Require().Equal(0, errTolerance.Compare(swapResult.AmountOut, amountsOut[i]), "expected %s, got %s", swapResult.AmountOut, amountsOut[i])
+			}
+		})
+	}
+}
@@ -199,6 +199,10 @@ func (k Keeper) collectSpreadRewards(ctx sdk.Context, sender sdk.AccAddress, pos
 		),
 	})
 
+	if err := k.writePositionCheckpoint(ctx, sender, position.PoolId, positionId, positionCheckpointDelta{spreadRewardsCollected: spreadRewardsClaimed}); err != nil {
+		return sdk.Coins{}, err
+	}
+
 	return spreadRewardsClaimed, nil
 }
 
@@ -49,6 +49,47 @@ func ParsePositionFromBz(value []byte) (model.Position, error) {
 	return position, nil
 }
 
+// ParseLimitOrderFromBz parses and returns a limit order from a byte array.
+// Returns an error if fails to unmarshal.
+func ParseLimitOrderFromBz(value []byte) (types.LimitOrder, error) {
+	order := types.LimitOrder{}
+	err := proto.Unmarshal(value, &order)
+	if err != nil {
+		return types.LimitOrder{}, err
+	}
+	return order, nil
+}
+
+// ParseManagedPositionFromBz parses a managed position from the bytes it is stored as.
+func ParseManagedPositionFromBz(value []byte) (types.ManagedPosition, error) {
+	managedPosition := types.ManagedPosition{}
+	err := proto.Unmarshal(value, &managedPosition)
+	if err != nil {
+		return types.ManagedPosition{}, err
+	}
+	return managedPosition, nil
+}
+
+// ParseAutoCompoundPositionFromBz parses an auto-compound record from the bytes it is stored as.
+func ParseAutoCompoundPositionFromBz(value []byte) (types.AutoCompoundPosition, error) {
+	autoCompoundPosition := types.AutoCompoundPosition{}
+	err := proto.Unmarshal(value, &autoCompoundPosition)
+	if err != nil {
+		return types.AutoCompoundPosition{}, err
+	}
+	return autoCompoundPosition, nil
+}
+
+// ParsePositionCheckpointFromBz parses a position checkpoint from the bytes it is stored as.
+func ParsePositionCheckpointFromBz(value []byte) (types.PositionCheckpoint, error) {
+	checkpoint := types.PositionCheckpoint{}
+	err := proto.Unmarshal(value, &checkpoint)
+	if err != nil {
+		return types.PositionCheckpoint{}, err
+	}
+	return checkpoint, nil
+}
+
 // ParseTickFromBz takes a byte slice representing the serialized tick data and
 // attempts to parse it into a TickInfo struct using the protobuf Unmarshal function.
 // If the byte slice is empty or the unmarshalling fails, an appropriate error is returned.
@@ -57,6 +57,12 @@ type SwapState struct {
 	// global spread reward growth
 	globalSpreadRewardGrowth osmomath.Dec
 
+	// Initialized ticks crossed while calculating swap.
+	// Initialized to empty.
+	// Only updated when the swap is applied to state, so that
+	// limit orders resting on these ticks can be filled.
+	crossedTicks []int64
+
 	swapStrategy swapstrategy.SwapStrategy
 }
 
@@ -99,6 +105,8 @@ type PoolUpdates struct {
 	NewCurrentTick int64
 	NewLiquidity   osmomath.Dec
 	NewSqrtPrice   osmomath.BigDec
+	// CrossedTicks are the initialized ticks crossed by the swap, in crossing order.
+	CrossedTicks []int64
 }
 
 var (
@@ -147,6 +155,11 @@ func (k Keeper) SwapExactAmountIn(
 		return osmomath.Int{}, err
 	}
 
+	spreadFactor, err = k.spreadFactorForSwap(ctx, pool, spreadFactor)
+	if err != nil {
+		return osmomath.Int{}, err
+	}
+
 	// Trigger before hook for SwapExactAmountIn prior to mutating state.
 	// If no contract is set, this will be a no-op.
 	err = k.BeforeSwapExactAmountIn(ctx, pool.GetId(), sender, tokenIn, tokenOutDenom, tokenOutMinAmount, spreadFactor)
@@ -203,6 +216,11 @@ func (k Keeper) SwapExactAmountOut(
 		return osmomath.Int{}, err
 	}
 
+	spreadFactor, err = k.spreadFactorForSwap(ctx, pool, spreadFactor)
+	if err != nil {
+		return osmomath.Int{}, err
+	}
+
 	// Trigger before hook for SwapExactAmountOut prior to mutating state.
 	// If no contract is set, this will be a no-op.
 	err = k.BeforeSwapExactAmountOut(ctx, pool.GetId(), sender, tokenInDenom, tokenInMaxAmount, tokenOut, spreadFactor)
@@ -248,7 +266,7 @@ func (k Keeper) swapOutAmtGivenIn(
 	spreadFactor osmomath.Dec,
 	priceLimit osmomath.BigDec,
 ) (calcTokenIn, calcTokenOut sdk.Coin, poolUpdates PoolUpdates, err error) {
-	swapResult, poolUpdates, err := k.computeOutAmtGivenIn(ctx, pool.GetId(), tokenIn, tokenOutDenom, spreadFactor, priceLimit, true)
+	swapResult, poolUpdates, err := k.computeOutAmtGivenIn(ctx, pool.GetId(), tokenIn, tokenOutDenom, spreadFactor, priceLimit, true, nil)
 	if err != nil {
 		return sdk.Coin{}, sdk.Coin{}, PoolUpdates{}, err
 	}
@@ -265,6 +283,12 @@ func (k Keeper) swapOutAmtGivenIn(
 		return sdk.Coin{}, sdk.Coin{}, PoolUpdates{}, err
 	}
 
+	// Fill the limit orders resting on the crossed ticks. This must happen after the pool
+	// is updated so that the withdrawn order positions are valued at the post-swap price.
+	if err := k.fillLimitOrdersAtCrossedTicks(ctx, pool.GetId(), getZeroForOne(tokenIn.Denom, pool.GetToken0()), poolUpdates.CrossedTicks); err != nil {
+		return sdk.Coin{}, sdk.Coin{}, PoolUpdates{}, err
+	}
+
 	return tokenIn, tokenOut, poolUpdates, nil
 }
 
@@ -297,6 +321,12 @@ func (k *Keeper) swapInAmtGivenOut(
 		return sdk.Coin{}, sdk.Coin{}, PoolUpdates{}, err
 	}
 
+	// Fill the limit orders resting on the crossed ticks. This must happen after the pool
+	// is updated so that the withdrawn order positions are valued at the post-swap price.
+	if err := k.fillLimitOrdersAtCrossedTicks(ctx, pool.GetId(), getZeroForOne(tokenIn.Denom, pool.GetToken0()), poolUpdates.CrossedTicks); err != nil {
+		return sdk.Coin{}, sdk.Coin{}, PoolUpdates{}, err
+	}
+
 	return tokenIn, tokenOut, poolUpdates, nil
 }
 
@@ -309,14 +339,76 @@ func (k Keeper) CalcOutAmtGivenIn(
 	tokenOutDenom string,
 	spreadFactor osmomath.Dec,
 ) (tokenOut sdk.Coin, err error) {
+	pool, err := asConcentrated(poolI)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	spreadFactor, err = k.spreadFactorForSwap(ctx, pool, spreadFactor)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
 	cacheCtx, _ := ctx.CacheContext()
-	swapResult, _, err := k.computeOutAmtGivenIn(cacheCtx, poolI.GetId(), tokenIn, tokenOutDenom, spreadFactor, unboundedPriceLimit, false)
+	swapResult, _, err := k.computeOutAmtGivenIn(cacheCtx, poolI.GetId(), tokenIn, tokenOutDenom, spreadFactor, unboundedPriceLimit, false, nil)
 	if err != nil {
 		return sdk.Coin{}, err
 	}
 	return sdk.NewCoin(tokenOutDenom, swapResult.AmountOut), nil
 }
 
+// CalcOutAmtGivenInWithTrace returns the same token out as CalcOutAmtGivenIn, together with the trace of the swap:
+// the spread factor charged, the spread fee, the spot price of tokenOutDenom in terms of the token in after the swap,
+// and each step of the swap within the liquidity between the initialized ticks crossed.
+func (k Keeper) CalcOutAmtGivenInWithTrace(
+	ctx sdk.Context,
+	poolI poolmanagertypes.PoolI,
+	tokenIn sdk.Coin,
+	tokenOutDenom string,
+	spreadFactor osmomath.Dec,
+) (poolmanagertypes.HopTrace, error) {
+	pool, err := asConcentrated(poolI)
+	if err != nil {
+		return poolmanagertypes.HopTrace{}, err
+	}
+
+	spreadFactor, err = k.spreadFactorForSwap(ctx, pool, spreadFactor)
+	if err != nil {
+		return poolmanagertypes.HopTrace{}, err
+	}
+
+	steps := []poolmanagertypes.SwapStepTrace{}
+	cacheCtx, _ := ctx.CacheContext()
+	swapResult, poolUpdates, err := k.computeOutAmtGivenIn(cacheCtx, poolI.GetId(), tokenIn, tokenOutDenom, spreadFactor, unboundedPriceLimit, false, &steps)
+	if err != nil {
+		return poolmanagertypes.HopTrace{}, err
+	}
+
+	spreadRewardCharge := osmomath.ZeroDec()
+	for _, step := range steps {
+		spreadRewardCharge.AddMut(step.SpreadRewardCharge)
+	}
+
+	// The spot price after the swap is read from a copy of the pool, so that the given pool is left unmodified.
+	poolAfter, err := k.getPoolById(cacheCtx, pool.GetId())
+	if err != nil {
+		return poolmanagertypes.HopTrace{}, err
+	}
+	poolAfter.SetCurrentSqrtPrice(poolUpdates.NewSqrtPrice)
+	spotPriceAfter, err := poolAfter.SpotPrice(cacheCtx, tokenIn.Denom, tokenOutDenom)
+	if err != nil {
+		return poolmanagertypes.HopTrace{}, err
+	}
+
+	return poolmanagertypes.HopTrace{
+		TokenOut:       sdk.NewCoin(tokenOutDenom, swapResult.AmountOut),
+		SpreadFactor:   spreadFactor,
+		SpreadFee:      sdk.NewCoin(tokenIn.Denom, spreadRewardCharge.Ceil().TruncateInt()),
+		SpotPriceAfter: spotPriceAfter,
+		Steps:          steps,
+	}, nil
+}
+
 func (k Keeper) CalcInAmtGivenOut(
 	ctx sdk.Context,
 	poolI poolmanagertypes.PoolI,
@@ -324,6 +416,16 @@ func (k Keeper) CalcInAmtGivenOut(
 	tokenInDenom string,
 	spreadFactor osmomath.Dec,
 ) (sdk.Coin, error) {
+	pool, err := asConcentrated(poolI)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	spreadFactor, err = k.spreadFactorForSwap(ctx, pool, spreadFactor)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
 	cacheCtx, _ := ctx.CacheContext()
 	swapResult, _, err := k.computeInAmtGivenOut(cacheCtx, tokenOut, tokenInDenom, spreadFactor, unboundedPriceLimit, poolI.GetId(), false)
 	if err != nil {
@@ -383,6 +485,7 @@ func iteratorToNextInitializedTickSqrtPriceTarget(nextInitTickIter db.Iterator,
 // Note this method is mutative, some of the tick and accumulator updates get written to store.
 // However, there are no token transfers or pool updates done in this method. These mutations are performed in swapInAmtGivenOut.
 // Note that passing in 0 for `priceLimit` will result in the price limit being set to the max/min value based on swap direction
+// If stepTrace is not nil, each step of the swap within the liquidity between initialized ticks is appended to it.
 func (k Keeper) computeOutAmtGivenIn(
 	ctx sdk.Context,
 	poolId uint64,
@@ -391,6 +494,7 @@ func (k Keeper) computeOutAmtGivenIn(
 	spreadFactor osmomath.Dec,
 	priceLimit osmomath.BigDec,
 	updateAccumulators bool,
+	stepTrace *[]poolmanagertypes.SwapStepTrace,
 ) (swapResult SwapResult, poolUpdates PoolUpdates, err error) {
 	p, spreadRewardAccumulator, err := k.swapSetup(ctx, poolId, tokenInMin.Denom, tokenOutDenom, updateAccumulators)
 	if err != nil {
@@ -448,6 +552,18 @@ func (k Keeper) computeOutAmtGivenIn(
 
 		ctx.Logger().Debug("cl calc out given in")
 		emitSwapDebugLogs(ctx, swapState, computedSqrtPrice, amountIn, amountOut, spreadRewardCharge)
+		if stepTrace != nil {
+			*stepTrace = append(*stepTrace, poolmanagertypes.SwapStepTrace{
+				SqrtPriceStart:      swapState.sqrtPrice.Clone(),
+				SqrtPriceEnd:        computedSqrtPrice.Clone(),
+				Liquidity:           swapState.liquidity.Clone(),
+				AmountIn:            amountIn,
+				AmountOut:           amountOut,
+				SpreadRewardCharge:  spreadRewardCharge,
+				NextInitializedTick: nextInitializedTick,
+				TickCrossed:         nextInitializedTickSqrtPrice.Equal(computedSqrtPrice),
+			})
+		}
 
 		// Update the swapState with the new sqrtPrice from the above swap
 		swapState.sqrtPrice = computedSqrtPrice
@@ -513,7 +629,7 @@ func (k Keeper) computeOutAmtGivenIn(
 		AmountIn:      amountIn,
 		AmountOut:     amountOut,
 		SpreadRewards: swapState.globalSpreadRewardGrowth,
-	}, PoolUpdates{swapState.tick, swapState.liquidity, swapState.sqrtPrice}, nil
+	}, PoolUpdates{swapState.tick, swapState.liquidity, swapState.sqrtPrice, swapState.crossedTicks}, nil
 }
 
 // computeInAmtGivenOut calculates tokens to be swapped in given the desired token out and spread factor deducted. It also returns
@@ -644,7 +760,7 @@ func (k Keeper) computeInAmtGivenOut(
 		AmountIn:      amountIn,
 		AmountOut:     amountOut,
 		SpreadRewards: swapState.globalSpreadRewardGrowth,
-	}, PoolUpdates{swapState.tick, swapState.liquidity, swapState.sqrtPrice}, nil
+	}, PoolUpdates{swapState.tick, swapState.liquidity, swapState.sqrtPrice, swapState.crossedTicks}, nil
 }
 
 func emitSwapDebugLogs(ctx sdk.Context, swapState SwapState, reachedPrice osmomath.BigDec, amountIn, amountOut, spreadCharge osmomath.Dec) {
@@ -689,6 +805,8 @@ func (k Keeper) swapCrossTickLogic(ctx sdk.Context,
 		if err != nil {
 			return swapState, err
 		}
+
+		swapState.crossedTicks = append(swapState.crossedTicks, nextInitializedTick)
 	}
 	liquidityNet := nextInitializedTickInfo.LiquidityNet
 
@@ -2706,6 +2706,60 @@ func (s *KeeperTestSuite) TestCalcOutAmtGivenIn_NonMutative() {
 	}
 }
 
+// TestCalcOutAmtGivenInWithTrace tests that CalcOutAmtGivenInWithTrace returns the same token out as CalcOutAmtGivenIn,
+// with steps that chain from one initialized tick to the next, and that it is non-mutative.
+func (s *KeeperTestSuite) TestCalcOutAmtGivenInWithTrace() {
+	tests := makeTests(swapOutGivenInCases, swapOutGivenInSpreadRewardCases)
+	for name, test := range tests {
+		test := test
+		s.Run(name, func() {
+			s.SetupAndFundSwapTest()
+			poolBeforeCalc := s.preparePoolAndDefaultPositions(test)
+
+			expectedTokenOut, err := s.App.ConcentratedLiquidityKeeper.CalcOutAmtGivenIn(
+				s.Ctx,
+				poolBeforeCalc,
+				test.TokenIn, test.TokenOutDenom,
+				test.SpreadFactor)
+			s.Require().NoError(err)
+
+			hop, err := s.App.ConcentratedLiquidityKeeper.CalcOutAmtGivenInWithTrace(
+				s.Ctx,
+				poolBeforeCalc,
+				test.TokenIn, test.TokenOutDenom,
+				test.SpreadFactor)
+			s.Require().NoError(err)
+			s.Require().Equal(expectedTokenOut, hop.TokenOut)
+			s.Require().Equal(test.SpreadFactor, hop.SpreadFactor)
+			s.Require().NotEmpty(hop.Steps)
+
+			amountOut, spreadRewardCharge := osmomath.ZeroDec(), osmomath.ZeroDec()
+			for i, step := range hop.Steps {
+				// Every step but the last one swaps up to the next initialized tick and crosses it.
+				if i < len(hop.Steps)-1 {
+					s.Require().True(step.TickCrossed)
+					s.Require().Equal(step.SqrtPriceEnd, hop.Steps[i+1].SqrtPriceStart)
+				}
+				amountOut = amountOut.Add(step.AmountOut)
+				spreadRewardCharge = spreadRewardCharge.Add(step.SpreadRewardCharge)
+			}
+			s.Require().Equal(hop.TokenOut.Amount, amountOut.TruncateInt())
+			s.Require().Equal(sdk.NewCoin(test.TokenIn.Denom, spreadRewardCharge.Ceil().TruncateInt()), hop.SpreadFee)
+
+			// The spot price after the swap is the one of the pool after actually swapping.
+			cacheCtx, _ := s.Ctx.CacheContext()
+			_, _, _, err = s.App.ConcentratedLiquidityKeeper.SwapOutAmtGivenIn(cacheCtx, s.TestAccs[0], poolBeforeCalc, test.TokenIn, test.TokenOutDenom, test.SpreadFactor, osmomath.ZeroBigDec())
+			s.Require().NoError(err)
+			expectedSpotPriceAfter, err := s.App.ConcentratedLiquidityKeeper.CalculateSpotPrice(cacheCtx, poolBeforeCalc.GetId(), test.TokenIn.Denom, test.TokenOutDenom)
+			s.Require().NoError(err)
+			s.Require().Equal(expectedSpotPriceAfter, hop.SpotPriceAfter)
+
+			s.assertPoolNotModified(poolBeforeCalc)
+			s.assertZeroSpreadRewards(poolBeforeCalc.GetId())
+		})
+	}
+}
+
 func (s *KeeperTestSuite) SetupSecondPosition(test apptesting.ConcentratedSwapTest, pool types.ConcentratedPoolExtension) {
 	if !test.SecondPositionLowerPrice.IsNil() {
 		newLowerTick, newUpperTick := s.LowerUpperPricesToTick(test.SecondPositionLowerPrice, test.SecondPositionUpperPrice, pool.GetTickSpacing())
@@ -2960,7 +3014,7 @@ func (s *KeeperTestSuite) TestUpdatePoolForSwap() {
 			expectedSpreadFactors := tc.tokenIn.Amount.ToLegacyDec().Mul(pool.GetSpreadFactor(s.Ctx)).Ceil()
 			expectedSpreadFactorsCoins := sdk.NewCoins(sdk.NewCoin(tc.tokenIn.Denom, expectedSpreadFactors.TruncateInt()))
 			swapDetails := cl.SwapDetails{sender, tc.tokenIn, tc.tokenOut}
-			poolUpdates := cl.PoolUpdates{tc.newCurrentTick, tc.newLiquidity, tc.newSqrtPrice}
+			poolUpdates := cl.PoolUpdates{tc.newCurrentTick, tc.newLiquidity, tc.newSqrtPrice, nil}
 			err = s.Clk.UpdatePoolForSwap(s.Ctx, pool, swapDetails, poolUpdates, expectedSpreadFactors)
 
 			// Test that pool is updated
@@ -19,10 +19,18 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgCollectSpreadRewards{}, "osmosis/cl-col-sp-rewards", nil)
 	cdc.RegisterConcrete(&MsgCollectIncentives{}, "osmosis/cl-collect-incentives", nil)
 	cdc.RegisterConcrete(&MsgFungifyChargedPositions{}, "osmosis/cl-fungify-charged-positions", nil)
+	cdc.RegisterConcrete(&MsgPlaceLimitOrder{}, "osmosis/cl-place-limit-order", nil)
+	cdc.RegisterConcrete(&MsgCancelLimitOrder{}, "osmosis/cl-cancel-limit-order", nil)
+	cdc.RegisterConcrete(&MsgClaimLimitOrder{}, "osmosis/cl-claim-limit-order", nil)
+	cdc.RegisterConcrete(&MsgCreateManagedPosition{}, "osmosis/cl-create-managed-position", nil)
+	cdc.RegisterConcrete(&MsgWithdrawManagedPosition{}, "osmosis/cl-withdraw-managed-position", nil)
+	cdc.RegisterConcrete(&MsgCompoundPosition{}, "osmosis/cl-compound-position", nil)
+	cdc.RegisterConcrete(&MsgSetPositionAutoCompound{}, "osmosis/cl-set-position-auto-compound", nil)
 
 	// gov proposals
 	cdc.RegisterConcrete(&CreateConcentratedLiquidityPoolsProposal{}, "osmosis/create-cl-pools-proposal", nil)
 	cdc.RegisterConcrete(&TickSpacingDecreaseProposal{}, "osmosis/cl-tick-spacing-dec-prop", nil)
+	cdc.RegisterConcrete(&SetDynamicSpreadFactorProposal{}, "osmosis/cl-set-dyn-spread-prop", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -39,12 +47,20 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgCollectSpreadRewards{},
 		&MsgCollectIncentives{},
 		&MsgFungifyChargedPositions{},
+		&MsgPlaceLimitOrder{},
+		&MsgCancelLimitOrder{},
+		&MsgClaimLimitOrder{},
+		&MsgCreateManagedPosition{},
+		&MsgWithdrawManagedPosition{},
+		&MsgCompoundPosition{},
+		&MsgSetPositionAutoCompound{},
 	)
 
 	registry.RegisterImplementations(
 		(*govtypesv1.Content)(nil),
 		&CreateConcentratedLiquidityPoolsProposal{},
 		&TickSpacingDecreaseProposal{},
+		&SetDynamicSpreadFactorProposal{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -28,6 +28,34 @@ const (
 	BaseGasFeeForNewIncentive           = 10_000
 	BaseGasFeeForInitializingTick       = 10_000
 	BaseGasFeeForTransferPosition       = 10_000
+	// MaxLimitOrdersPerTick bounds the number of open limit orders in one direction
+	// resting on a single tick.
+	MaxLimitOrdersPerTick = 100
+	// MaxLimitOrderFillsPerSwap bounds the number of limit orders a single swap attempts
+	// to fill, since every fill withdraws a position at the expense of the swapper.
+	// Orders over the limit stay open and are filled when claimed by their owner.
+	MaxLimitOrderFillsPerSwap = 10
+	// MaxManagedPositionRebalancesPerBlock bounds the number of managed positions
+	// re-centered at the end of a block. Positions over the limit stay out of range
+	// and are picked up in the following blocks.
+	MaxManagedPositionRebalancesPerBlock = 20
+	// MinManagedPositionOutOfRangeDelay is the minimum time a managed position must stay out of
+	// range before it is re-centered, so that the price cannot be pushed out of range for a
+	// single block to have the position re-centered at a manipulated price.
+	MinManagedPositionOutOfRangeDelay = 10 * time.Minute
+	// TwapMinAmountOutWindow is the window of the TWAP used to bound the swaps the module makes
+	// on behalf of users at the end of a block, where no minimum amount out is provided.
+	TwapMinAmountOutWindow = 10 * time.Minute
+	// MaxAutoCompoundsPerBlock bounds the number of positions compounded at the end
+	// of a block. Due positions over the limit are picked up in the following blocks.
+	MaxAutoCompoundsPerBlock = 20
+	// AutoCompoundInterval is the time between two compoundings of a position that
+	// opted into auto-compounding.
+	AutoCompoundInterval = 24 * time.Hour
+	// MaxPositionCheckpoints bounds the number of checkpoints stored per position. The first
+	// checkpoint is always kept, and the oldest one after it is pruned once the limit is reached.
+	// Since every checkpoint holds the cumulative amounts, pruning loses no totals.
+	MaxPositionCheckpoints = 20
 )
 
 var (
@@ -43,6 +71,10 @@ var (
 	MaxSqrtPriceBigDec = osmomath.BigDecFromDec(MaxSqrtPrice)
 	MinSqrtPriceBigDec = osmomath.BigDecFromDec(MinSqrtPrice)
 
+	// TwapMinAmountOutMaxSlippage is the maximum slippage, relative to the TWAP over
+	// TwapMinAmountOutWindow, accepted by the swaps the module makes on behalf of users.
+	TwapMinAmountOutMaxSlippage = osmomath.MustNewDecFromStr("0.05")
+
 	// Supported uptimes preset to 1 ns, 1 min, 1 hr, 1D, 1W, 2W
 	SupportedUptimes        = []time.Duration{time.Nanosecond, time.Minute, time.Hour, time.Hour * 24, time.Hour * 24 * 7, time.Hour * 24 * 7 * 2}
 	AuthorizedTickSpacing   = []uint64{1, 10, 100, 1000}
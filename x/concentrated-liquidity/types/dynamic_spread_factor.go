@@ -0,0 +1,34 @@
+package types
+
+import (
+	"github.com/osmosis-labs/osmosis/osmomath"
+)
+
+// Validate performs the stateless checks on the config.
+func (c DynamicSpreadFactorConfig) Validate() error {
+	if c.MinSpreadFactor.IsNil() || c.MaxSpreadFactor.IsNil() ||
+		c.MinSpreadFactor.IsNegative() || c.MaxSpreadFactor.GTE(osmomath.OneDec()) || c.MinSpreadFactor.GT(c.MaxSpreadFactor) {
+		return InvalidDynamicSpreadFactorBoundsError{MinSpreadFactor: c.MinSpreadFactor, MaxSpreadFactor: c.MaxSpreadFactor}
+	}
+
+	if c.VolatilityWindow <= 0 {
+		return NonPositiveVolatilityWindowError{VolatilityWindow: c.VolatilityWindow}
+	}
+
+	if c.VolatilityMultiplier.IsNil() || c.VolatilityMultiplier.IsNegative() {
+		return NegativeVolatilityMultiplierError{VolatilityMultiplier: c.VolatilityMultiplier}
+	}
+
+	return nil
+}
+
+// Bound returns the given spread factor clamped to the bounds of the config.
+func (c DynamicSpreadFactorConfig) Bound(spreadFactor osmomath.Dec) osmomath.Dec {
+	if spreadFactor.LT(c.MinSpreadFactor) {
+		return c.MinSpreadFactor
+	}
+	if spreadFactor.GT(c.MaxSpreadFactor) {
+		return c.MaxSpreadFactor
+	}
+	return spreadFactor
+}
@@ -956,3 +956,208 @@ type InvalidForfeitedIncentivesLengthError struct {
 func (e InvalidForfeitedIncentivesLengthError) Error() string {
 	return fmt.Sprintf("attempted to redeposit incorrectly constructed forfeited incentives slice. forfeited incentives must have an entry for each supported uptime. forfeit entries: %d, expected: %d", e.ForfeitedIncentivesLength, e.ExpectedLength)
 }
+
+type LimitOrderNotFoundError struct {
+	OrderId uint64
+}
+
+func (e LimitOrderNotFoundError) Error() string {
+	return fmt.Sprintf("limit order not found. order id (%d)", e.OrderId)
+}
+
+type NotLimitOrderOwnerError struct {
+	OrderId uint64
+	Address string
+}
+
+func (e NotLimitOrderOwnerError) Error() string {
+	return fmt.Sprintf("address (%s) is not the owner of limit order (%d)", e.Address, e.OrderId)
+}
+
+type LimitOrderPoolNotInitializedError struct {
+	PoolId uint64
+}
+
+func (e LimitOrderPoolNotInitializedError) Error() string {
+	return fmt.Sprintf("cannot place a limit order in pool (%d) before it has liquidity and a current price", e.PoolId)
+}
+
+type LimitOrderTickNotOutOfRangeError struct {
+	PoolId      uint64
+	Direction   LimitOrderDirection
+	LowerTick   int64
+	UpperTick   int64
+	CurrentTick int64
+}
+
+func (e LimitOrderTickNotOutOfRangeError) Error() string {
+	if e.Direction == LimitOrderAsk {
+		return fmt.Sprintf("ask in pool (%d) must be placed above the current tick (%d), got lower tick (%d)", e.PoolId, e.CurrentTick, e.LowerTick)
+	}
+	return fmt.Sprintf("bid in pool (%d) must be placed at or below the current tick (%d), got upper tick (%d)", e.PoolId, e.CurrentTick, e.UpperTick)
+}
+
+type MaxLimitOrdersPerTickReachedError struct {
+	PoolId    uint64
+	Direction LimitOrderDirection
+	TickIndex int64
+	Max       int
+}
+
+func (e MaxLimitOrdersPerTickReachedError) Error() string {
+	return fmt.Sprintf("tick (%d) in pool (%d) already has the maximum of (%d) open %s orders", e.TickIndex, e.PoolId, e.Max, e.Direction)
+}
+
+type LimitOrderAlreadyFilledError struct {
+	OrderId uint64
+}
+
+func (e LimitOrderAlreadyFilledError) Error() string {
+	return fmt.Sprintf("limit order (%d) is already filled and can only be claimed", e.OrderId)
+}
+
+type LimitOrderNotFilledError struct {
+	OrderId uint64
+}
+
+func (e LimitOrderNotFilledError) Error() string {
+	return fmt.Sprintf("limit order (%d) is not filled yet, it can only be cancelled", e.OrderId)
+}
+
+type FillLastPositionInPoolError struct {
+	PoolId  uint64
+	OrderId uint64
+}
+
+func (e FillLastPositionInPoolError) Error() string {
+	return fmt.Sprintf("cannot fill limit order (%d) within a swap as it holds the last position in pool (%d)", e.OrderId, e.PoolId)
+}
+
+type InvalidNextLimitOrderIdError struct {
+	NextLimitOrderId uint64
+	OrderId          uint64
+}
+
+func (e InvalidNextLimitOrderIdError) Error() string {
+	return fmt.Sprintf("next limit order id (%d) must be greater than every existing order id, found order (%d)", e.NextLimitOrderId, e.OrderId)
+}
+
+type ManagedPositionNotFoundError struct {
+	ManagedPositionId uint64
+}
+
+func (e ManagedPositionNotFoundError) Error() string {
+	return fmt.Sprintf("managed position not found. managed position id (%d)", e.ManagedPositionId)
+}
+
+type NotManagedPositionOwnerError struct {
+	ManagedPositionId uint64
+	Address           string
+}
+
+func (e NotManagedPositionOwnerError) Error() string {
+	return fmt.Sprintf("address (%s) is not the owner of managed position (%d)", e.Address, e.ManagedPositionId)
+}
+
+type ManagedPositionPoolNotInitializedError struct {
+	PoolId uint64
+}
+
+func (e ManagedPositionPoolNotInitializedError) Error() string {
+	return fmt.Sprintf("cannot create a managed position in pool (%d) before it has liquidity and a current price", e.PoolId)
+}
+
+type InvalidManagedPositionHalfWidthError struct {
+	HalfWidth   int64
+	TickSpacing uint64
+}
+
+func (e InvalidManagedPositionHalfWidthError) Error() string {
+	return fmt.Sprintf("managed position half width (%d) must be a positive multiple of the tick spacing (%d)", e.HalfWidth, e.TickSpacing)
+}
+
+type NonPositiveHalfWidthError struct {
+	HalfWidth int64
+}
+
+func (e NonPositiveHalfWidthError) Error() string {
+	return fmt.Sprintf("managed position half width (%d) must be positive", e.HalfWidth)
+}
+
+type OutOfRangeDelayTooShortError struct {
+	Delay    time.Duration
+	MinDelay time.Duration
+}
+
+func (e OutOfRangeDelayTooShortError) Error() string {
+	return fmt.Sprintf("managed position out of range delay (%s) must be at least (%s)", e.Delay, e.MinDelay)
+}
+
+type RebalanceLastPositionInPoolError struct {
+	PoolId            uint64
+	ManagedPositionId uint64
+}
+
+func (e RebalanceLastPositionInPoolError) Error() string {
+	return fmt.Sprintf("cannot re-center managed position (%d) as it holds the last position in pool (%d)", e.ManagedPositionId, e.PoolId)
+}
+
+type InvalidNextManagedPositionIdError struct {
+	NextManagedPositionId uint64
+	ManagedPositionId     uint64
+}
+
+func (e InvalidNextManagedPositionIdError) Error() string {
+	return fmt.Sprintf("next managed position id (%d) must be greater than every existing managed position id, found managed position (%d)", e.NextManagedPositionId, e.ManagedPositionId)
+}
+
+type NothingToCompoundError struct {
+	PositionId uint64
+}
+
+func (e NothingToCompoundError) Error() string {
+	return fmt.Sprintf("position (%d) has no spread rewards or incentives in pool tokens to compound into liquidity", e.PositionId)
+}
+
+type InvalidAutoCompoundPositionIdError struct {
+	NextPositionId uint64
+	PositionId     uint64
+}
+
+func (e InvalidAutoCompoundPositionIdError) Error() string {
+	return fmt.Sprintf("auto-compound record refers to position (%d), which is not below the next position id (%d)", e.PositionId, e.NextPositionId)
+}
+
+type InvalidDynamicSpreadFactorBoundsError struct {
+	MinSpreadFactor osmomath.Dec
+	MaxSpreadFactor osmomath.Dec
+}
+
+func (e InvalidDynamicSpreadFactorBoundsError) Error() string {
+	return fmt.Sprintf("dynamic spread factor bounds must satisfy 0 <= min (%s) <= max (%s) < 1", e.MinSpreadFactor, e.MaxSpreadFactor)
+}
+
+type NonPositiveVolatilityWindowError struct {
+	VolatilityWindow time.Duration
+}
+
+func (e NonPositiveVolatilityWindowError) Error() string {
+	return fmt.Sprintf("dynamic spread factor volatility window (%s) must be positive", e.VolatilityWindow)
+}
+
+type NegativeVolatilityMultiplierError struct {
+	VolatilityMultiplier osmomath.Dec
+}
+
+func (e NegativeVolatilityMultiplierError) Error() string {
+	return fmt.Sprintf("dynamic spread factor volatility multiplier (%s) must not be negative", e.VolatilityMultiplier)
+}
+
+type InvalidPositionCheckpointIdError struct {
+	NextPositionId uint64
+	PositionId     uint64
+}
+
+func (e InvalidPositionCheckpointIdError) Error() string {
+	return fmt.Sprintf("position checkpoint refers to position (%d), which is not below the next position id (%d)", e.PositionId, e.NextPositionId)
+}
@@ -15,6 +15,15 @@ const (
 	TypeEvtTransferPositions         = "transfer_positions"
 	TypeEvtInitTick                  = "init_tick"
 	TypeEvtRemoveTick                = "remove_tick"
+	TypeEvtPlaceLimitOrder           = "place_limit_order"
+	TypeEvtFillLimitOrder            = "fill_limit_order"
+	TypeEvtCancelLimitOrder          = "cancel_limit_order"
+	TypeEvtClaimLimitOrder           = "claim_limit_order"
+	TypeEvtCreateManagedPosition     = "create_managed_position"
+	TypeEvtRebalanceManagedPosition  = "rebalance_managed_position"
+	TypeEvtWithdrawManagedPosition   = "withdraw_managed_position"
+	TypeEvtCompoundPosition          = "compound_position"
+	TypeEvtSetPositionAutoCompound   = "set_position_auto_compound"
 
 	AttributeValueCategory                                         = ModuleName
 	AttributeKeyPositionId                                         = "position_id"
@@ -50,4 +59,9 @@ const (
 	AttributeKeySpreadRewardGrowthOppositeDirectionOfLastTraversal = "spread_reward_growth"
 	AttributeKeyUptimeGrowthOppositeDirectionOfLastTraversal       = "uptime_growth"
 	AttributeNewOwner                                              = "new_owner"
+	AttributeKeyLimitOrderId                                       = "limit_order_id"
+	AttributeKeyLimitOrderDirection                                = "direction"
+	AttributeKeyManagedPositionId                                  = "managed_position_id"
+	AttributeKeyPreviousPositionId                                 = "previous_position_id"
+	AttributeKeyAutoCompoundEnabled                                = "auto_compound_enabled"
 )
@@ -13,6 +13,11 @@ func DefaultGenesis() *GenesisState {
 		NextIncentiveRecordId: 1,
 		// By default, the migration threshold is set to 0, which means all pools are migrated.
 		IncentivesAccumulatorPoolIdMigrationThreshold: 0,
+		LimitOrders:           []types.LimitOrder{},
+		NextLimitOrderId:      1,
+		ManagedPositions:      []types.ManagedPosition{},
+		NextManagedPositionId: 1,
+		AutoCompoundPositions: []types.AutoCompoundPosition{},
 	}
 }
 
@@ -27,5 +32,41 @@ func (gs GenesisState) Validate() error {
 	if gs.NextIncentiveRecordId == 0 {
 		return types.InvalidNextIncentiveRecordIdError{NextIncentiveRecordId: gs.NextIncentiveRecordId}
 	}
+	for _, poolData := range gs.PoolData {
+		if poolData.DynamicSpreadFactorConfig == nil {
+			continue
+		}
+		if err := poolData.DynamicSpreadFactorConfig.Validate(); err != nil {
+			return err
+		}
+	}
+	for _, order := range gs.LimitOrders {
+		if order.OrderId >= gs.NextLimitOrderId {
+			return types.InvalidNextLimitOrderIdError{NextLimitOrderId: gs.NextLimitOrderId, OrderId: order.OrderId}
+		}
+	}
+	for _, managedPosition := range gs.ManagedPositions {
+		if managedPosition.ManagedPositionId >= gs.NextManagedPositionId {
+			return types.InvalidNextManagedPositionIdError{NextManagedPositionId: gs.NextManagedPositionId, ManagedPositionId: managedPosition.ManagedPositionId}
+		}
+		if err := managedPosition.Strategy.Validate(); err != nil {
+			return err
+		}
+	}
+	seenAutoCompoundPositionIds := map[uint64]struct{}{}
+	for _, autoCompoundPosition := range gs.AutoCompoundPositions {
+		if autoCompoundPosition.PositionId >= gs.NextPositionId {
+			return types.InvalidAutoCompoundPositionIdError{NextPositionId: gs.NextPositionId, PositionId: autoCompoundPosition.PositionId}
+		}
+		if _, ok := seenAutoCompoundPositionIds[autoCompoundPosition.PositionId]; ok {
+			return types.DuplicatePositionIdsError{PositionIds: []uint64{autoCompoundPosition.PositionId}}
+		}
+		seenAutoCompoundPositionIds[autoCompoundPosition.PositionId] = struct{}{}
+	}
+	for _, checkpoint := range gs.PositionCheckpoints {
+		if checkpoint.PositionId >= gs.NextPositionId {
+			return types.InvalidPositionCheckpointIdError{NextPositionId: gs.NextPositionId, PositionId: checkpoint.PositionId}
+		}
+	}
 	return nil
 }
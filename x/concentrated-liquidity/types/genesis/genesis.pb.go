@@ -104,6 +104,9 @@ type PoolData struct {
 	IncentivesAccumulators  []AccumObject `protobuf:"bytes,4,rep,name=incentives_accumulators,json=incentivesAccumulators,proto3" json:"incentives_accumulators" yaml:"incentives_accumulator"`
 	// incentive records to be set
 	IncentiveRecords []types1.IncentiveRecord `protobuf:"bytes,5,rep,name=incentive_records,json=incentiveRecords,proto3" json:"incentive_records"`
+	// dynamic spread factor config of the pool, nil if the pool charges its
+	// fixed spread factor
+	DynamicSpreadFactorConfig *types1.DynamicSpreadFactorConfig `protobuf:"bytes,6,opt,name=dynamic_spread_factor_config,json=dynamicSpreadFactorConfig,proto3" json:"dynamic_spread_factor_config,omitempty" yaml:"dynamic_spread_factor_config"`
 }
 
 func (m *PoolData) Reset()         { *m = PoolData{} }
@@ -174,6 +177,13 @@ func (m *PoolData) GetIncentiveRecords() []types1.IncentiveRecord {
 	return nil
 }
 
+func (m *PoolData) GetDynamicSpreadFactorConfig() *types1.DynamicSpreadFactorConfig {
+	if m != nil {
+		return m.DynamicSpreadFactorConfig
+	}
+	return nil
+}
+
 type PositionData struct {
 	Position                *model.Position `protobuf:"bytes,1,opt,name=position,proto3" json:"position,omitempty"`
 	LockId                  uint64          `protobuf:"varint,2,opt,name=lock_id,json=lockId,proto3" json:"lock_id,omitempty" yaml:"lock_id"`
@@ -247,11 +257,17 @@ type GenesisState struct {
 	// params are all the parameters of the module
 	Params types1.Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// pool data containing serialized pool struct and ticks.
-	PoolData                                      []PoolData     `protobuf:"bytes,2,rep,name=pool_data,json=poolData,proto3" json:"pool_data"`
-	PositionData                                  []PositionData `protobuf:"bytes,3,rep,name=position_data,json=positionData,proto3" json:"position_data"`
-	NextPositionId                                uint64         `protobuf:"varint,4,opt,name=next_position_id,json=nextPositionId,proto3" json:"next_position_id,omitempty" yaml:"next_position_id"`
-	NextIncentiveRecordId                         uint64         `protobuf:"varint,5,opt,name=next_incentive_record_id,json=nextIncentiveRecordId,proto3" json:"next_incentive_record_id,omitempty" yaml:"next_incentive_record_id"`
-	IncentivesAccumulatorPoolIdMigrationThreshold uint64         `protobuf:"varint,6,opt,name=incentives_accumulator_pool_id_migration_threshold,json=incentivesAccumulatorPoolIdMigrationThreshold,proto3" json:"incentives_accumulator_pool_id_migration_threshold,omitempty" yaml:"incentives_accumulator_pool_id_migration_threshold"`
+	PoolData                                      []PoolData                    `protobuf:"bytes,2,rep,name=pool_data,json=poolData,proto3" json:"pool_data"`
+	PositionData                                  []PositionData                `protobuf:"bytes,3,rep,name=position_data,json=positionData,proto3" json:"position_data"`
+	NextPositionId                                uint64                        `protobuf:"varint,4,opt,name=next_position_id,json=nextPositionId,proto3" json:"next_position_id,omitempty" yaml:"next_position_id"`
+	NextIncentiveRecordId                         uint64                        `protobuf:"varint,5,opt,name=next_incentive_record_id,json=nextIncentiveRecordId,proto3" json:"next_incentive_record_id,omitempty" yaml:"next_incentive_record_id"`
+	IncentivesAccumulatorPoolIdMigrationThreshold uint64                        `protobuf:"varint,6,opt,name=incentives_accumulator_pool_id_migration_threshold,json=incentivesAccumulatorPoolIdMigrationThreshold,proto3" json:"incentives_accumulator_pool_id_migration_threshold,omitempty" yaml:"incentives_accumulator_pool_id_migration_threshold"`
+	LimitOrders                                   []types1.LimitOrder           `protobuf:"bytes,7,rep,name=limit_orders,json=limitOrders,proto3" json:"limit_orders" yaml:"limit_orders"`
+	NextLimitOrderId                              uint64                        `protobuf:"varint,8,opt,name=next_limit_order_id,json=nextLimitOrderId,proto3" json:"next_limit_order_id,omitempty" yaml:"next_limit_order_id"`
+	ManagedPositions                              []types1.ManagedPosition      `protobuf:"bytes,9,rep,name=managed_positions,json=managedPositions,proto3" json:"managed_positions" yaml:"managed_positions"`
+	NextManagedPositionId                         uint64                        `protobuf:"varint,10,opt,name=next_managed_position_id,json=nextManagedPositionId,proto3" json:"next_managed_position_id,omitempty" yaml:"next_managed_position_id"`
+	AutoCompoundPositions                         []types1.AutoCompoundPosition `protobuf:"bytes,11,rep,name=auto_compound_positions,json=autoCompoundPositions,proto3" json:"auto_compound_positions" yaml:"auto_compound_positions"`
+	PositionCheckpoints                           []types1.PositionCheckpoint   `protobuf:"bytes,12,rep,name=position_checkpoints,json=positionCheckpoints,proto3" json:"position_checkpoints" yaml:"position_checkpoints"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -329,6 +345,48 @@ func (m *GenesisState) GetIncentivesAccumulatorPoolIdMigrationThreshold() uint64
 	return 0
 }
 
+func (m *GenesisState) GetLimitOrders() []types1.LimitOrder {
+	if m != nil {
+		return m.LimitOrders
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextLimitOrderId() uint64 {
+	if m != nil {
+		return m.NextLimitOrderId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetManagedPositions() []types1.ManagedPosition {
+	if m != nil {
+		return m.ManagedPositions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextManagedPositionId() uint64 {
+	if m != nil {
+		return m.NextManagedPositionId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetAutoCompoundPositions() []types1.AutoCompoundPosition {
+	if m != nil {
+		return m.AutoCompoundPositions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPositionCheckpoints() []types1.PositionCheckpoint {
+	if m != nil {
+		return m.PositionCheckpoints
+	}
+	return nil
+}
+
 type AccumObject struct {
 	// Accumulator's name (pulled from AccumulatorContent)
 	Name         string                    `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty" yaml:"name"`
@@ -395,65 +453,84 @@ func init() {
 }
 
 var fileDescriptor_4cdf50d18c43a7c5 = []byte{
-	// 914 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcb, 0x6e, 0x23, 0x45,
-	0x17, 0x4e, 0x27, 0x1d, 0xff, 0x49, 0xd9, 0x33, 0x7f, 0xa6, 0x94, 0x21, 0x3d, 0x41, 0xe3, 0x36,
-	0x3d, 0x8a, 0x14, 0x40, 0xe9, 0xd6, 0x38, 0x01, 0x09, 0x04, 0x8b, 0xf4, 0x70, 0x91, 0x41, 0x40,
-	0x54, 0x84, 0x0d, 0xb7, 0xa6, 0xdc, 0x5d, 0x71, 0x8a, 0xe9, 0xee, 0x6a, 0xba, 0xca, 0x21, 0xde,
-	0xf2, 0x04, 0x88, 0x15, 0x8f, 0xc0, 0x03, 0x20, 0xb1, 0x66, 0x37, 0x42, 0x2c, 0x66, 0x07, 0x2b,
-	0x0b, 0x25, 0x6f, 0xe0, 0x27, 0x40, 0x5d, 0x17, 0x5f, 0x82, 0x27, 0x38, 0xec, 0xaa, 0x7c, 0xce,
-	0xf7, 0x9d, 0xaf, 0xce, 0xad, 0x0d, 0xf6, 0x19, 0xcf, 0x18, 0xa7, 0x3c, 0x88, 0x59, 0x1e, 0x93,
-	0x5c, 0x94, 0x58, 0x90, 0x24, 0xa5, 0xdf, 0xf4, 0x69, 0x42, 0xc5, 0x20, 0x38, 0x7b, 0xd8, 0x25,
-	0x02, 0x3f, 0x0c, 0x7a, 0x24, 0x27, 0x9c, 0x72, 0xbf, 0x28, 0x99, 0x60, 0x70, 0x47, 0x83, 0xfc,
-	0xb9, 0x20, 0x5f, 0x83, 0xb6, 0x37, 0x7b, 0xac, 0xc7, 0x24, 0x22, 0xa8, 0x4e, 0x0a, 0xbc, 0x7d,
-	0x2f, 0x96, 0xe8, 0x48, 0x19, 0xd4, 0xc5, 0x98, 0x7a, 0x8c, 0xf5, 0x52, 0x12, 0xc8, 0x5b, 0xb7,
-	0x7f, 0x12, 0xe0, 0x7c, 0xa0, 0x4d, 0x2f, 0x18, 0x9d, 0x38, 0x8e, 0xfb, 0xd9, 0x58, 0x97, 0xbc,
-	0x69, 0x97, 0x97, 0xae, 0x7f, 0x4a, 0x81, 0x4b, 0x9c, 0x99, 0x48, 0x07, 0x8b, 0x3d, 0xbb, 0x60,
-	0x9c, 0x0a, 0xca, 0x72, 0x8d, 0x7a, 0x65, 0x31, 0x94, 0xa0, 0xf1, 0xe3, 0x88, 0xe6, 0x27, 0xe6,
-	0xc5, 0x6f, 0x2c, 0x06, 0xa3, 0xd2, 0x48, 0xcf, 0x48, 0x54, 0x92, 0x98, 0x95, 0x89, 0x42, 0x7b,
-	0xbf, 0x5b, 0x60, 0xed, 0x9d, 0x7e, 0x9a, 0x1e, 0xd3, 0xf8, 0x31, 0x7c, 0x19, 0xfc, 0xaf, 0x60,
-	0x2c, 0x8d, 0x68, 0xe2, 0x58, 0x2d, 0x6b, 0xd7, 0x0e, 0xe1, 0x68, 0xe8, 0xde, 0x1e, 0xe0, 0x2c,
-	0x7d, 0xdd, 0xd3, 0x06, 0x0f, 0xd5, 0xaa, 0x53, 0x27, 0x81, 0x07, 0x00, 0x68, 0x29, 0x09, 0x39,
-	0x77, 0x96, 0x5b, 0xd6, 0xee, 0x4a, 0x78, 0x77, 0x34, 0x74, 0xef, 0x28, 0xff, 0x89, 0xcd, 0x43,
-	0xeb, 0xd5, 0xa5, 0x53, 0x9d, 0xe1, 0x17, 0xc0, 0xae, 0xb4, 0x3b, 0x2b, 0x2d, 0x6b, 0xb7, 0xde,
-	0x0e, 0xfc, 0x85, 0x6a, 0xed, 0x1f, 0x4b, 0xfc, 0x09, 0x0b, 0x9d, 0x27, 0x43, 0x77, 0x69, 0x34,
-	0x74, 0x37, 0x66, 0x82, 0x9c, 0x30, 0x0f, 0x49, 0x5a, 0xef, 0x17, 0x1b, 0xac, 0x1d, 0x31, 0x96,
-	0xbe, 0x85, 0x05, 0x86, 0xfb, 0xc0, 0xae, 0xb4, 0xca, 0xb7, 0xd4, 0xdb, 0x9b, 0xbe, 0xaa, 0xbf,
-	0x6f, 0xea, 0xef, 0x1f, 0xe6, 0x83, 0x70, 0xfd, 0xb7, 0x9f, 0xf7, 0x56, 0x2b, 0x44, 0x07, 0x49,
-	0x67, 0xf8, 0x19, 0x58, 0xad, 0x58, 0xb9, 0xb3, 0xdc, 0x5a, 0xb9, 0x81, 0x42, 0x93, 0xc3, 0x70,
-	0x53, 0x2b, 0x6c, 0x4c, 0x14, 0x72, 0x0f, 0x29, 0x4e, 0xf8, 0xa3, 0x05, 0xee, 0xf1, 0xa2, 0x24,
-	0x38, 0x89, 0x4a, 0xf2, 0x2d, 0x2e, 0x93, 0x48, 0xb6, 0x58, 0x3f, 0xc5, 0x82, 0x95, 0x3a, 0x27,
-	0xed, 0x05, 0x23, 0x1e, 0x56, 0xc8, 0x8f, 0xba, 0x5f, 0x93, 0x58, 0x84, 0xbb, 0x3a, 0x68, 0x4b,
-	0x05, 0x7d, 0x66, 0x08, 0x0f, 0x6d, 0x29, 0x1b, 0x92, 0xa6, 0xc3, 0x89, 0x05, 0xfe, 0x60, 0x81,
-	0xad, 0x71, 0x8f, 0xf0, 0x69, 0x10, 0x77, 0x6c, 0x99, 0x8a, 0xff, 0x22, 0x6c, 0x47, 0x0b, 0xbb,
-	0xaf, 0x84, 0xcd, 0x0f, 0xe0, 0xa1, 0xe7, 0x26, 0x86, 0x29, 0x4d, 0x1c, 0x52, 0x70, 0xe7, 0x6a,
-	0xdf, 0x72, 0x67, 0x55, 0xaa, 0x79, 0x75, 0x41, 0x35, 0x1d, 0x83, 0x47, 0x12, 0x1e, 0xda, 0x95,
-	0x22, 0xb4, 0x41, 0x67, 0x7f, 0xe6, 0xde, 0xaf, 0xcb, 0xa0, 0x71, 0xa4, 0x07, 0x52, 0x76, 0xcf,
-	0xfb, 0x60, 0xcd, 0x0c, 0xa8, 0xee, 0xa0, 0x45, 0x7b, 0xc1, 0xd0, 0xa0, 0x31, 0x41, 0x35, 0x59,
-	0x29, 0xab, 0x7a, 0x35, 0x91, 0x93, 0x32, 0x33, 0x59, 0xda, 0xe0, 0xa1, 0x5a, 0x75, 0xea, 0x24,
-	0xf0, 0x2b, 0xb0, 0x3d, 0xa7, 0x82, 0xfa, 0xfd, 0xba, 0x4b, 0xee, 0x8f, 0xb5, 0xa8, 0x25, 0x65,
-	0x62, 0xcf, 0xbc, 0xf2, 0x9f, 0xc5, 0x56, 0x66, 0xf8, 0x09, 0xd8, 0xec, 0x17, 0x82, 0x66, 0x64,
-	0x86, 0xda, 0x14, 0x7a, 0x21, 0x6e, 0xa8, 0x08, 0xa6, 0x58, 0xb9, 0xf7, 0x87, 0x0d, 0x1a, 0xef,
-	0xaa, 0x5d, 0xfe, 0xb1, 0xc0, 0x82, 0xc0, 0x47, 0xa0, 0xa6, 0x16, 0xa3, 0xce, 0xe0, 0xce, 0xbf,
-	0x64, 0xf0, 0x48, 0x3a, 0xeb, 0x08, 0x1a, 0x0a, 0x11, 0x58, 0x97, 0xcb, 0x27, 0xc1, 0x02, 0xdf,
-	0x70, 0x2a, 0xcd, 0x2a, 0xd0, 0x8c, 0x6b, 0x85, 0x59, 0x0d, 0x5f, 0x82, 0x5b, 0xa6, 0x36, 0x8a,
-	0x77, 0x45, 0xf2, 0xee, 0xdf, 0xb0, 0xc2, 0x53, 0xdc, 0x8d, 0x62, 0xba, 0x79, 0xde, 0x06, 0x1b,
-	0x39, 0x39, 0x17, 0xd1, 0x38, 0x08, 0x4d, 0x1c, 0x5b, 0x16, 0xfe, 0xf9, 0xd1, 0xd0, 0xdd, 0x52,
-	0x85, 0xbf, 0xea, 0xe1, 0xa1, 0xdb, 0xd5, 0x4f, 0x86, 0xbc, 0x93, 0xc0, 0xcf, 0x81, 0x23, 0x9d,
-	0xae, 0x0e, 0x41, 0x45, 0xb7, 0x2a, 0xe9, 0x1e, 0x8c, 0x86, 0xae, 0x3b, 0x45, 0x37, 0xc7, 0xd3,
-	0x43, 0x77, 0x2b, 0xd3, 0x95, 0x41, 0xe8, 0x24, 0xf0, 0x27, 0x0b, 0xb4, 0xe7, 0x4f, 0x64, 0xa4,
-	0xb7, 0x7d, 0x94, 0xd1, 0x5e, 0x89, 0xa5, 0x3c, 0x71, 0x5a, 0x12, 0x7e, 0xca, 0xd2, 0xc4, 0xa9,
-	0xc9, 0xc0, 0x6f, 0x8e, 0x86, 0xee, 0x6b, 0xd7, 0x4d, 0xf5, 0x75, 0x1c, 0x1e, 0xda, 0x9b, 0x3b,
-	0xf1, 0x72, 0x11, 0x27, 0x1f, 0x18, 0xc0, 0xf1, 0xd8, 0xff, 0x3b, 0x0b, 0xd4, 0xa7, 0xf6, 0x0a,
-	0x7c, 0x00, 0xec, 0x1c, 0x67, 0x44, 0xb6, 0xd5, 0x7a, 0xf8, 0xff, 0xd1, 0xd0, 0xad, 0xeb, 0x24,
-	0xe0, 0x8c, 0x78, 0x48, 0x1a, 0xe1, 0x87, 0xe0, 0x96, 0x6a, 0xef, 0x98, 0xe5, 0x82, 0xe4, 0x42,
-	0x8e, 0x5e, 0xbd, 0xfd, 0xe2, 0x33, 0xda, 0x7b, 0x4a, 0xc7, 0x23, 0x05, 0x40, 0x0d, 0xe9, 0xa1,
-	0x6f, 0x61, 0xf2, 0xe4, 0xa2, 0x69, 0x3d, 0xbd, 0x68, 0x5a, 0x7f, 0x5d, 0x34, 0xad, 0xef, 0x2f,
-	0x9b, 0x4b, 0x4f, 0x2f, 0x9b, 0x4b, 0x7f, 0x5e, 0x36, 0x97, 0x3e, 0x7d, 0xaf, 0x47, 0xc5, 0x69,
-	0xbf, 0xeb, 0xc7, 0x2c, 0x0b, 0x34, 0xf9, 0x5e, 0x8a, 0xbb, 0xdc, 0x5c, 0x82, 0xb3, 0xf6, 0x41,
-	0x70, 0x3e, 0xf3, 0x85, 0xde, 0x9b, 0x7c, 0xa2, 0xc5, 0xa0, 0x20, 0xdc, 0xfc, 0x09, 0xea, 0xd6,
-	0xe4, 0xf7, 0x69, 0xff, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0x51, 0xac, 0xd9, 0x02, 0x3c, 0x09,
-	0x00, 0x00,
+	// 1217 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0x4d, 0x6f, 0xe3, 0xc4,
+	0x1b, 0xaf, 0xdb, 0xb4, 0xdb, 0x4e, 0xb2, 0xfb, 0xef, 0xce, 0x76, 0xff, 0xf5, 0x76, 0xd9, 0x24,
+	0xb8, 0x2a, 0x14, 0x50, 0x13, 0x35, 0x2d, 0xa0, 0xf2, 0x22, 0xa8, 0xbb, 0x2c, 0x0a, 0x50, 0xb6,
+	0x9a, 0x2d, 0x17, 0xde, 0xcc, 0xc4, 0x9e, 0xa4, 0x43, 0x6d, 0x8f, 0xd7, 0x33, 0x29, 0xcd, 0x15,
+	0x89, 0x3b, 0x70, 0x42, 0xe2, 0x0b, 0x20, 0x6e, 0x48, 0x7c, 0x01, 0x6e, 0x2b, 0xc4, 0x61, 0x8f,
+	0x9c, 0x22, 0xd4, 0xf2, 0x09, 0xf2, 0x09, 0x90, 0xc7, 0xe3, 0xc4, 0x49, 0xd3, 0xe2, 0x70, 0xf3,
+	0xe4, 0x79, 0x7e, 0xbf, 0xe7, 0x37, 0xf3, 0xbc, 0xcc, 0x04, 0x6c, 0x31, 0xee, 0x31, 0x4e, 0x79,
+	0xd5, 0x66, 0xbe, 0x4d, 0x7c, 0x11, 0x62, 0x41, 0x1c, 0x97, 0x3e, 0x6e, 0x53, 0x87, 0x8a, 0x4e,
+	0xf5, 0x64, 0xb3, 0x41, 0x04, 0xde, 0xac, 0xb6, 0x88, 0x4f, 0x38, 0xe5, 0x95, 0x20, 0x64, 0x82,
+	0xc1, 0x35, 0x05, 0xaa, 0x8c, 0x05, 0x55, 0x14, 0x68, 0x65, 0xa9, 0xc5, 0x5a, 0x4c, 0x22, 0xaa,
+	0xd1, 0x57, 0x0c, 0x5e, 0xb9, 0x63, 0x4b, 0xb4, 0x15, 0x1b, 0xe2, 0x45, 0x62, 0x6a, 0x31, 0xd6,
+	0x72, 0x49, 0x55, 0xae, 0x1a, 0xed, 0x66, 0x15, 0xfb, 0x1d, 0x65, 0x7a, 0x36, 0xd1, 0x89, 0x6d,
+	0xbb, 0xed, 0xf5, 0x75, 0xc9, 0x95, 0x72, 0x79, 0xf1, 0xea, 0xad, 0x04, 0x38, 0xc4, 0x5e, 0x12,
+	0x69, 0x3b, 0xdb, 0xb6, 0x03, 0xc6, 0xa9, 0xa0, 0xcc, 0x57, 0xa8, 0x97, 0xb3, 0xa1, 0x04, 0xb5,
+	0x8f, 0x2d, 0xea, 0x37, 0x93, 0x1d, 0xbf, 0x91, 0x0d, 0x46, 0xa5, 0x91, 0x9e, 0x10, 0x2b, 0x24,
+	0x36, 0x0b, 0x1d, 0x85, 0x7e, 0x35, 0x1b, 0xda, 0xa5, 0x1e, 0x15, 0x16, 0x0b, 0x1d, 0x12, 0x4e,
+	0x16, 0xd6, 0xc3, 0x3e, 0x6e, 0x11, 0xc7, 0x1a, 0xd9, 0xeb, 0x4e, 0x36, 0x34, 0x6e, 0x0b, 0x66,
+	0xd9, 0xcc, 0x0b, 0x58, 0xdb, 0x4f, 0x14, 0xef, 0x66, 0x83, 0x3a, 0x1d, 0x1f, 0x7b, 0xd4, 0xb6,
+	0x78, 0x10, 0x12, 0xec, 0x58, 0x4d, 0x6c, 0x0b, 0x96, 0x68, 0x7f, 0x6b, 0xb2, 0xfc, 0x58, 0xf6,
+	0x11, 0xb1, 0x8f, 0x03, 0x46, 0x7d, 0x11, 0x13, 0x18, 0x7f, 0x68, 0x60, 0xfe, 0x41, 0xdb, 0x75,
+	0x0f, 0xa9, 0x7d, 0x0c, 0x5f, 0x02, 0xd7, 0x02, 0xc6, 0x5c, 0x8b, 0x3a, 0xba, 0x56, 0xd6, 0xd6,
+	0x73, 0x26, 0xec, 0x75, 0x4b, 0x37, 0x3a, 0xd8, 0x73, 0x5f, 0x33, 0x94, 0xc1, 0x40, 0x73, 0xd1,
+	0x57, 0xdd, 0x81, 0xdb, 0x00, 0xa8, 0x04, 0x3a, 0xe4, 0x54, 0x9f, 0x2e, 0x6b, 0xeb, 0x33, 0xe6,
+	0xed, 0x5e, 0xb7, 0x74, 0x33, 0xf6, 0x1f, 0xd8, 0x0c, 0xb4, 0x10, 0x2d, 0xea, 0xd1, 0x37, 0xfc,
+	0x0c, 0xe4, 0xa2, 0x8c, 0xeb, 0x33, 0x65, 0x6d, 0x3d, 0x5f, 0xab, 0x56, 0x32, 0x75, 0x48, 0xe5,
+	0x50, 0xe2, 0x9b, 0xcc, 0xd4, 0x9f, 0x74, 0x4b, 0x53, 0xbd, 0x6e, 0x69, 0x71, 0x28, 0x48, 0x93,
+	0x19, 0x48, 0xd2, 0x1a, 0x7f, 0xcf, 0x82, 0xf9, 0x03, 0xc6, 0xdc, 0xfb, 0x58, 0x60, 0xb8, 0x05,
+	0x72, 0x91, 0x56, 0xb9, 0x97, 0x7c, 0x6d, 0xa9, 0x12, 0x77, 0x4d, 0x25, 0xe9, 0x9a, 0xca, 0xae,
+	0xdf, 0x31, 0x17, 0x7e, 0xff, 0x75, 0x63, 0x36, 0x42, 0xd4, 0x91, 0x74, 0x86, 0x9f, 0x80, 0xd9,
+	0x88, 0x95, 0xeb, 0xd3, 0xe5, 0x99, 0x09, 0x14, 0x26, 0x67, 0x68, 0x2e, 0x29, 0x85, 0x85, 0x81,
+	0x42, 0x6e, 0xa0, 0x98, 0x13, 0xfe, 0xa0, 0x81, 0x3b, 0x2a, 0x8d, 0x21, 0xf9, 0x0a, 0x87, 0x8e,
+	0x25, 0x1b, 0xb3, 0xed, 0x62, 0xc1, 0x42, 0x75, 0x26, 0xb5, 0x8c, 0x11, 0x77, 0x23, 0xe4, 0xc3,
+	0xc6, 0x97, 0xc4, 0x16, 0xe6, 0xba, 0x0a, 0x5a, 0x8e, 0x83, 0x5e, 0x1a, 0xc2, 0x40, 0xcb, 0xb1,
+	0x0d, 0x49, 0xd3, 0xee, 0xc0, 0x02, 0xbf, 0xd7, 0xc0, 0x72, 0xbf, 0xb3, 0x78, 0x1a, 0xc4, 0xf5,
+	0x5c, 0x79, 0xe6, 0x3f, 0x0a, 0x5b, 0x53, 0xc2, 0xee, 0xc5, 0xc2, 0xc6, 0x07, 0x30, 0xd0, 0xff,
+	0x07, 0x86, 0x94, 0x26, 0x0e, 0x29, 0xb8, 0x39, 0xda, 0xed, 0x5c, 0x9f, 0x95, 0x6a, 0x5e, 0xc9,
+	0xa8, 0xa6, 0x9e, 0xe0, 0x91, 0x84, 0x9b, 0xb9, 0x48, 0x11, 0x5a, 0xa4, 0xc3, 0x3f, 0x73, 0xf8,
+	0xb3, 0x06, 0x9e, 0x19, 0xdb, 0x69, 0x96, 0xcd, 0xfc, 0x26, 0x6d, 0xe9, 0x73, 0x32, 0x3b, 0x6f,
+	0x67, 0x0c, 0x7b, 0x3f, 0xa6, 0x7a, 0x24, 0x99, 0x1e, 0x48, 0xa2, 0x3d, 0xc9, 0x63, 0x3e, 0xdf,
+	0xeb, 0x96, 0x56, 0xe3, 0xe3, 0xb8, 0x2a, 0x9e, 0x81, 0xee, 0x38, 0x97, 0x71, 0x18, 0xbf, 0x4d,
+	0x83, 0xc2, 0x81, 0xea, 0x69, 0x59, 0xea, 0xef, 0x83, 0xf9, 0xa4, 0xc7, 0x55, 0xb9, 0x67, 0x2d,
+	0xdc, 0x84, 0x06, 0xf5, 0x09, 0xa2, 0x31, 0xe0, 0xb2, 0xa8, 0xb1, 0x1c, 0x7d, 0x7a, 0x74, 0x0c,
+	0x28, 0x83, 0x81, 0xe6, 0xa2, 0xaf, 0xba, 0x03, 0xbf, 0x00, 0x2b, 0x63, 0xca, 0x4d, 0x25, 0x4b,
+	0x95, 0xf4, 0xbd, 0xbe, 0x16, 0x69, 0xec, 0xc7, 0x1e, 0x4a, 0xc9, 0xc5, 0xca, 0x8c, 0xcd, 0xf0,
+	0x23, 0xb0, 0xd4, 0x0e, 0x04, 0xf5, 0xc8, 0x10, 0x75, 0x52, 0x95, 0x99, 0xb8, 0x61, 0x4c, 0x90,
+	0x62, 0xe5, 0xc6, 0x2f, 0x00, 0x14, 0xde, 0x8d, 0xaf, 0xeb, 0x47, 0x02, 0x0b, 0x02, 0xf7, 0xc0,
+	0x5c, 0x7c, 0xf7, 0xa9, 0x13, 0x5c, 0xfb, 0x97, 0x13, 0x3c, 0x90, 0xce, 0x2a, 0x82, 0x82, 0x42,
+	0x04, 0x16, 0xe4, 0xa4, 0x74, 0xb0, 0xc0, 0x13, 0x8e, 0x90, 0x64, 0x6e, 0x29, 0xc6, 0xf9, 0x20,
+	0x99, 0x63, 0x9f, 0x83, 0xeb, 0xfd, 0x01, 0x2e, 0x79, 0x67, 0x24, 0xef, 0xd6, 0x84, 0x19, 0x4e,
+	0x71, 0x17, 0x82, 0x74, 0xf1, 0xbc, 0x03, 0x16, 0x7d, 0x72, 0x2a, 0xfa, 0x37, 0x5b, 0x94, 0xf8,
+	0x9c, 0x4c, 0xfc, 0xdd, 0x5e, 0xb7, 0xb4, 0x1c, 0x27, 0x7e, 0xd4, 0xc3, 0x40, 0x37, 0xa2, 0x9f,
+	0x12, 0xf2, 0xba, 0x03, 0x3f, 0x05, 0xba, 0x74, 0x1a, 0xed, 0xd8, 0x88, 0x6e, 0x56, 0xd2, 0xad,
+	0xf6, 0xba, 0xa5, 0x52, 0x8a, 0x6e, 0x8c, 0xa7, 0x81, 0x6e, 0x47, 0xa6, 0x91, 0xae, 0xad, 0x3b,
+	0xf0, 0x27, 0x0d, 0xd4, 0xc6, 0x8f, 0x0f, 0x4b, 0x5d, 0x4d, 0x96, 0x47, 0x5b, 0x21, 0x96, 0xf2,
+	0xc4, 0x51, 0x48, 0xf8, 0x11, 0x73, 0x1d, 0xd9, 0xb5, 0x39, 0xf3, 0xcd, 0x5e, 0xb7, 0xb4, 0x73,
+	0xd5, 0x08, 0xba, 0x8a, 0xc3, 0x40, 0x1b, 0x63, 0xc7, 0x93, 0xbc, 0x35, 0x9c, 0xfd, 0x04, 0x70,
+	0x98, 0xf8, 0xc3, 0xc7, 0xa0, 0x90, 0x7a, 0x65, 0x70, 0xfd, 0x9a, 0x4c, 0xd7, 0x66, 0xc6, 0x74,
+	0x7d, 0x10, 0x41, 0x1f, 0x46, 0x48, 0xf3, 0xae, 0x9a, 0x9e, 0xb7, 0x54, 0xef, 0xa5, 0x48, 0x0d,
+	0x94, 0x77, 0xfb, 0x8e, 0x1c, 0xee, 0x83, 0x5b, 0xf2, 0x44, 0x53, 0x2e, 0xd1, 0xb1, 0xcf, 0xcb,
+	0xdd, 0x17, 0x7b, 0xdd, 0xd2, 0x4a, 0xea, 0xd8, 0x87, 0x9d, 0x0c, 0x24, 0xb3, 0x3f, 0x08, 0x5b,
+	0x77, 0xe0, 0x37, 0x1a, 0xb8, 0x39, 0xfa, 0xde, 0xe1, 0xfa, 0xc2, 0x44, 0x83, 0x77, 0x3f, 0xc6,
+	0x27, 0x05, 0x62, 0x96, 0xd5, 0x66, 0xf4, 0x58, 0xc9, 0x05, 0x7a, 0x03, 0x2d, 0x7a, 0xc3, 0x10,
+	0xde, 0x2f, 0xa9, 0x51, 0xe7, 0x68, 0x6f, 0x60, 0x6c, 0x49, 0x8d, 0xf1, 0x54, 0x25, 0x35, 0xa2,
+	0xa7, 0xee, 0xc0, 0x1f, 0x35, 0xb0, 0x3c, 0xf4, 0x2e, 0x4b, 0xed, 0x35, 0x2f, 0xf7, 0xfa, 0x7a,
+	0xd6, 0x2b, 0xaf, 0x2d, 0xd8, 0x9e, 0x22, 0xe9, 0x6f, 0xf8, 0x39, 0xb5, 0xe1, 0x62, 0x2c, 0xef,
+	0x92, 0x48, 0x06, 0xba, 0x8d, 0xc7, 0xa0, 0x39, 0xfc, 0x4e, 0x03, 0x4b, 0x63, 0xde, 0x6d, 0x5c,
+	0x2f, 0x48, 0x69, 0x3b, 0x13, 0x76, 0xff, 0x5e, 0x9f, 0xc1, 0x5c, 0x55, 0xc2, 0xee, 0x26, 0x2f,
+	0xbb, 0x8b, 0x41, 0x0c, 0x74, 0x2b, 0xb8, 0x00, 0xe4, 0xc6, 0xd7, 0x1a, 0xc8, 0xa7, 0xae, 0x77,
+	0xb8, 0x0a, 0x72, 0x3e, 0xf6, 0x88, 0x1c, 0x98, 0x0b, 0xe6, 0xff, 0x7a, 0xdd, 0x52, 0x5e, 0xe5,
+	0x02, 0x7b, 0xc4, 0x40, 0xd2, 0x08, 0x3f, 0x04, 0xd7, 0xe3, 0xc1, 0x6d, 0x33, 0x5f, 0x10, 0x5f,
+	0xc8, 0x4b, 0x25, 0x5f, 0x7b, 0xe1, 0x92, 0xc1, 0x9d, 0xea, 0xb0, 0xbd, 0x18, 0x80, 0x0a, 0xd2,
+	0x43, 0xad, 0x4c, 0xe7, 0xc9, 0x59, 0x51, 0x7b, 0x7a, 0x56, 0xd4, 0xfe, 0x3a, 0x2b, 0x6a, 0xdf,
+	0x9e, 0x17, 0xa7, 0x9e, 0x9e, 0x17, 0xa7, 0xfe, 0x3c, 0x2f, 0x4e, 0x7d, 0xfc, 0x5e, 0x8b, 0x8a,
+	0xa3, 0x76, 0xa3, 0x62, 0x33, 0xaf, 0xaa, 0xc8, 0x37, 0x5c, 0xdc, 0xe0, 0xc9, 0xa2, 0x7a, 0x52,
+	0xdb, 0xae, 0x9e, 0x0e, 0xbd, 0x95, 0x37, 0x06, 0x8f, 0x65, 0xd1, 0x09, 0x08, 0x4f, 0xfe, 0xc1,
+	0x35, 0xe6, 0xe4, 0x33, 0x71, 0xeb, 0x9f, 0x01, 0x00, 0x17, 0xdc, 0x89, 0x9c, 0xf9, 0x0d, 0x00,
+	0x00,
 }
 
 func (m *FullTick) Marshal() (dAtA []byte, err error) {
@@ -519,6 +596,18 @@ func (m *PoolData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DynamicSpreadFactorConfig != nil {
+		{
+			size, err := m.DynamicSpreadFactorConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
 	if len(m.IncentiveRecords) > 0 {
 		for iNdEx := len(m.IncentiveRecords) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -670,6 +759,72 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PositionCheckpoints) > 0 {
+		for iNdEx := len(m.PositionCheckpoints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PositionCheckpoints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.AutoCompoundPositions) > 0 {
+		for iNdEx := len(m.AutoCompoundPositions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AutoCompoundPositions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.NextManagedPositionId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextManagedPositionId))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.ManagedPositions) > 0 {
+		for iNdEx := len(m.ManagedPositions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ManagedPositions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.NextLimitOrderId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextLimitOrderId))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.LimitOrders) > 0 {
+		for iNdEx := len(m.LimitOrders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LimitOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if m.IncentivesAccumulatorPoolIdMigrationThreshold != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.IncentivesAccumulatorPoolIdMigrationThreshold))
 		i--
@@ -826,6 +981,10 @@ func (m *PoolData) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if m.DynamicSpreadFactorConfig != nil {
+		l = m.DynamicSpreadFactorConfig.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -882,6 +1041,36 @@ func (m *GenesisState) Size() (n int) {
 	if m.IncentivesAccumulatorPoolIdMigrationThreshold != 0 {
 		n += 1 + sovGenesis(uint64(m.IncentivesAccumulatorPoolIdMigrationThreshold))
 	}
+	if len(m.LimitOrders) > 0 {
+		for _, e := range m.LimitOrders {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextLimitOrderId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextLimitOrderId))
+	}
+	if len(m.ManagedPositions) > 0 {
+		for _, e := range m.ManagedPositions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextManagedPositionId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextManagedPositionId))
+	}
+	if len(m.AutoCompoundPositions) > 0 {
+		for _, e := range m.AutoCompoundPositions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PositionCheckpoints) > 0 {
+		for _, e := range m.PositionCheckpoints {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1229,6 +1418,42 @@ func (m *PoolData) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DynamicSpreadFactorConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DynamicSpreadFactorConfig == nil {
+				m.DynamicSpreadFactorConfig = &types1.DynamicSpreadFactorConfig{}
+			}
+			if err := m.DynamicSpreadFactorConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1609,6 +1834,180 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitOrders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LimitOrders = append(m.LimitOrders, types1.LimitOrder{})
+			if err := m.LimitOrders[len(m.LimitOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextLimitOrderId", wireType)
+			}
+			m.NextLimitOrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextLimitOrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ManagedPositions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ManagedPositions = append(m.ManagedPositions, types1.ManagedPosition{})
+			if err := m.ManagedPositions[len(m.ManagedPositions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextManagedPositionId", wireType)
+			}
+			m.NextManagedPositionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextManagedPositionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundPositions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoCompoundPositions = append(m.AutoCompoundPositions, types1.AutoCompoundPosition{})
+			if err := m.AutoCompoundPositions[len(m.AutoCompoundPositions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionCheckpoints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PositionCheckpoints = append(m.PositionCheckpoints, types1.PositionCheckpoint{})
+			if err := m.PositionCheckpoints[len(m.PositionCheckpoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -14,16 +14,19 @@ import (
 const (
 	ProposalTypeCreateConcentratedLiquidityPool = "CreateConcentratedLiquidityPool"
 	ProposalTypeTickSpacingDecrease             = "TickSpacingDecrease"
+	ProposalTypeSetDynamicSpreadFactor          = "SetDynamicSpreadFactor"
 )
 
 func init() {
 	govtypesv1.RegisterProposalType(ProposalTypeCreateConcentratedLiquidityPool)
 	govtypesv1.RegisterProposalType(ProposalTypeTickSpacingDecrease)
+	govtypesv1.RegisterProposalType(ProposalTypeSetDynamicSpreadFactor)
 }
 
 var (
 	_ govtypesv1.Content = &CreateConcentratedLiquidityPoolsProposal{}
 	_ govtypesv1.Content = &TickSpacingDecreaseProposal{}
+	_ govtypesv1.Content = &SetDynamicSpreadFactorProposal{}
 )
 
 // NewCreateConcentratedLiquidityPoolsProposal returns a new instance of a create concentrated liquidity pool proposal struct.
@@ -155,3 +158,70 @@ Records:     %s
 `, p.Title, p.Description, recordsStr))
 	return b.String()
 }
+
+func NewSetDynamicSpreadFactorProposal(title, description string, records []PoolIdToDynamicSpreadFactorRecord) govtypesv1.Content {
+	return &SetDynamicSpreadFactorProposal{
+		Title:                              title,
+		Description:                        description,
+		PoolIdToDynamicSpreadFactorRecords: records,
+	}
+}
+
+// GetTitle gets the title of the proposal
+func (p *SetDynamicSpreadFactorProposal) GetTitle() string { return p.Title }
+
+// GetDescription gets the description of the proposal
+func (p *SetDynamicSpreadFactorProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the router key for the proposal
+func (p *SetDynamicSpreadFactorProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal
+func (p *SetDynamicSpreadFactorProposal) ProposalType() string {
+	return ProposalTypeSetDynamicSpreadFactor
+}
+
+// ValidateBasic validates a governance proposal's abstract and basic contents.
+func (p *SetDynamicSpreadFactorProposal) ValidateBasic() error {
+	err := govtypesv1.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+	if len(p.PoolIdToDynamicSpreadFactorRecords) == 0 {
+		return fmt.Errorf("empty proposal records")
+	}
+
+	for _, record := range p.PoolIdToDynamicSpreadFactorRecords {
+		if record.PoolId == 0 {
+			return fmt.Errorf("pool id must be positive")
+		}
+
+		if record.Config != nil {
+			if err := record.Config.Validate(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// String returns a string containing the set dynamic spread factor proposal.
+func (p SetDynamicSpreadFactorProposal) String() string {
+	recordsStr := ""
+	for _, record := range p.PoolIdToDynamicSpreadFactorRecords {
+		if record.Config == nil {
+			recordsStr = recordsStr + fmt.Sprintf("(PoolID: %d, Disabled) ", record.PoolId)
+			continue
+		}
+		recordsStr = recordsStr + fmt.Sprintf("(PoolID: %d, MinSpreadFactor: %s, MaxSpreadFactor: %s, VolatilityWindow: %s, VolatilityMultiplier: %s) ",
+			record.PoolId, record.Config.MinSpreadFactor, record.Config.MaxSpreadFactor, record.Config.VolatilityWindow, record.Config.VolatilityMultiplier)
+	}
+
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Set Dynamic Spread Factor Proposal:
+Title:       %s
+Description: %s
+Records:     %s
+`, p.Title, p.Description, recordsStr))
+	return b.String()
+}
@@ -163,6 +163,102 @@ func (m *PoolIdToTickSpacingRecord) GetNewTickSpacing() uint64 {
 	return 0
 }
 
+// SetDynamicSpreadFactorProposal is a gov Content type for enabling, updating
+// or disabling the dynamic spread factor of pools. The proposal will fail if
+// one of the pools does not exist.
+type SetDynamicSpreadFactorProposal struct {
+	Title                              string                              `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description                        string                              `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	PoolIdToDynamicSpreadFactorRecords []PoolIdToDynamicSpreadFactorRecord `protobuf:"bytes,3,rep,name=pool_id_to_dynamic_spread_factor_records,json=poolIdToDynamicSpreadFactorRecords,proto3" json:"pool_id_to_dynamic_spread_factor_records"`
+}
+
+func (m *SetDynamicSpreadFactorProposal) Reset()      { *m = SetDynamicSpreadFactorProposal{} }
+func (*SetDynamicSpreadFactorProposal) ProtoMessage() {}
+func (*SetDynamicSpreadFactorProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a96adc35f4989ef7, []int{3}
+}
+func (m *SetDynamicSpreadFactorProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetDynamicSpreadFactorProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetDynamicSpreadFactorProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetDynamicSpreadFactorProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetDynamicSpreadFactorProposal.Merge(m, src)
+}
+func (m *SetDynamicSpreadFactorProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetDynamicSpreadFactorProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetDynamicSpreadFactorProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetDynamicSpreadFactorProposal proto.InternalMessageInfo
+
+// PoolIdToDynamicSpreadFactorRecord is a struct that contains a pool id to
+// dynamic spread factor config pair. A nil config disables the dynamic spread
+// factor of the pool.
+type PoolIdToDynamicSpreadFactorRecord struct {
+	PoolId uint64                     `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	Config *DynamicSpreadFactorConfig `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+}
+
+func (m *PoolIdToDynamicSpreadFactorRecord) Reset()         { *m = PoolIdToDynamicSpreadFactorRecord{} }
+func (m *PoolIdToDynamicSpreadFactorRecord) String() string { return proto.CompactTextString(m) }
+func (*PoolIdToDynamicSpreadFactorRecord) ProtoMessage()    {}
+func (*PoolIdToDynamicSpreadFactorRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a96adc35f4989ef7, []int{4}
+}
+func (m *PoolIdToDynamicSpreadFactorRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolIdToDynamicSpreadFactorRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolIdToDynamicSpreadFactorRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolIdToDynamicSpreadFactorRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolIdToDynamicSpreadFactorRecord.Merge(m, src)
+}
+func (m *PoolIdToDynamicSpreadFactorRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolIdToDynamicSpreadFactorRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolIdToDynamicSpreadFactorRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolIdToDynamicSpreadFactorRecord proto.InternalMessageInfo
+
+func (m *PoolIdToDynamicSpreadFactorRecord) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *PoolIdToDynamicSpreadFactorRecord) GetConfig() *DynamicSpreadFactorConfig {
+	if m != nil {
+		return m.Config
+	}
+	return nil
+}
+
 type PoolRecord struct {
 	Denom0       string                      `protobuf:"bytes,1,opt,name=denom0,proto3" json:"denom0,omitempty" yaml:"denom0"`
 	Denom1       string                      `protobuf:"bytes,2,opt,name=denom1,proto3" json:"denom1,omitempty" yaml:"denom1"`
@@ -174,7 +270,7 @@ func (m *PoolRecord) Reset()         { *m = PoolRecord{} }
 func (m *PoolRecord) String() string { return proto.CompactTextString(m) }
 func (*PoolRecord) ProtoMessage()    {}
 func (*PoolRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a96adc35f4989ef7, []int{3}
+	return fileDescriptor_a96adc35f4989ef7, []int{5}
 }
 func (m *PoolRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -228,6 +324,8 @@ func init() {
 	proto.RegisterType((*CreateConcentratedLiquidityPoolsProposal)(nil), "osmosis.concentratedliquidity.v1beta1.CreateConcentratedLiquidityPoolsProposal")
 	proto.RegisterType((*TickSpacingDecreaseProposal)(nil), "osmosis.concentratedliquidity.v1beta1.TickSpacingDecreaseProposal")
 	proto.RegisterType((*PoolIdToTickSpacingRecord)(nil), "osmosis.concentratedliquidity.v1beta1.PoolIdToTickSpacingRecord")
+	proto.RegisterType((*SetDynamicSpreadFactorProposal)(nil), "osmosis.concentratedliquidity.v1beta1.SetDynamicSpreadFactorProposal")
+	proto.RegisterType((*PoolIdToDynamicSpreadFactorRecord)(nil), "osmosis.concentratedliquidity.v1beta1.PoolIdToDynamicSpreadFactorRecord")
 	proto.RegisterType((*PoolRecord)(nil), "osmosis.concentratedliquidity.v1beta1.PoolRecord")
 }
 
@@ -236,42 +334,47 @@ func init() {
 }
 
 var fileDescriptor_a96adc35f4989ef7 = []byte{
-	// 548 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0xcd, 0x6e, 0xd3, 0x4c,
-	0x14, 0xb5, 0x5b, 0x27, 0xdf, 0xc7, 0x24, 0x45, 0xc5, 0x44, 0x6a, 0x68, 0x24, 0x3b, 0xb2, 0x84,
-	0x14, 0x16, 0xb5, 0x71, 0x61, 0x15, 0x36, 0x28, 0xad, 0x90, 0x40, 0x5d, 0x54, 0xa6, 0x2b, 0x84,
-	0x14, 0x26, 0xe3, 0xc1, 0x1d, 0xc5, 0xf1, 0x75, 0x3d, 0xd3, 0x94, 0xbc, 0x01, 0x12, 0x2c, 0x58,
-	0xb2, 0xcc, 0xe3, 0x74, 0xd9, 0x25, 0x62, 0x11, 0xa1, 0x64, 0xc3, 0x96, 0x3c, 0x01, 0xca, 0x4c,
-	0x7e, 0x9c, 0x42, 0x25, 0x10, 0x3b, 0xcf, 0xcc, 0x39, 0xe7, 0x9e, 0x73, 0xaf, 0x2f, 0xf2, 0x80,
-	0xf7, 0x80, 0x33, 0xee, 0x11, 0x48, 0x08, 0x4d, 0x44, 0x86, 0x05, 0x0d, 0x63, 0x76, 0x76, 0xce,
-	0x42, 0x26, 0x06, 0x5e, 0xdf, 0xef, 0x50, 0x81, 0x7d, 0x2f, 0x82, 0xbe, 0x9b, 0x66, 0x20, 0xc0,
-	0xbc, 0x3f, 0x27, 0xb8, 0xbf, 0x25, 0xb8, 0x73, 0xc2, 0x6e, 0x25, 0x82, 0x08, 0x24, 0xc3, 0x9b,
-	0x7d, 0x29, 0xb2, 0x33, 0xd1, 0x51, 0xe3, 0x20, 0xa3, 0x58, 0xd0, 0x83, 0x1c, 0xfb, 0x68, 0xc1,
-	0x3e, 0x06, 0x88, 0xf9, 0x71, 0x06, 0x29, 0x70, 0x1c, 0x9b, 0x15, 0x54, 0x10, 0x4c, 0xc4, 0xb4,
-	0xaa, 0xd7, 0xf5, 0xc6, 0xad, 0x40, 0x1d, 0xcc, 0x3a, 0x2a, 0x85, 0x94, 0x93, 0x8c, 0xa5, 0x82,
-	0x41, 0x52, 0xdd, 0x90, 0x6f, 0xf9, 0x2b, 0xf3, 0x0c, 0x95, 0x53, 0x80, 0xb8, 0x9d, 0x51, 0x02,
-	0x59, 0xc8, 0xab, 0x9b, 0xf5, 0xcd, 0x46, 0x69, 0xdf, 0x77, 0xff, 0xc8, 0xb8, 0x3b, 0xf3, 0x10,
-	0x48, 0x66, 0xab, 0x76, 0x39, 0xb2, 0xb5, 0xe9, 0xc8, 0xbe, 0x3b, 0xc0, 0xbd, 0xb8, 0xe9, 0xe4,
-	0x45, 0x9d, 0xa0, 0x94, 0x2e, 0x81, 0xbc, 0x59, 0x7e, 0x3f, 0xb4, 0xb5, 0xcf, 0x43, 0x5b, 0xfb,
-	0x3e, 0xb4, 0x75, 0xe7, 0x87, 0x8e, 0x6a, 0x27, 0x8c, 0x74, 0x5f, 0xa6, 0x98, 0xb0, 0x24, 0x3a,
-	0xa4, 0x24, 0xa3, 0x98, 0xd3, 0x7f, 0x0e, 0xf6, 0x41, 0x47, 0xb6, 0x34, 0xc1, 0xc2, 0xb6, 0x80,
-	0xb6, 0x60, 0xa4, 0xdb, 0xe6, 0xaa, 0xc6, 0xb5, 0xb0, 0x4f, 0xff, 0x22, 0xec, 0xf3, 0xf0, 0x04,
-	0x72, 0x6e, 0xe7, 0xd9, 0x8d, 0x59, 0xf6, 0x60, 0x37, 0xbd, 0x09, 0x70, 0x3d, 0x73, 0x88, 0xee,
-	0xdd, 0x28, 0x66, 0xee, 0xa0, 0xff, 0xe6, 0xbe, 0x65, 0x64, 0x23, 0x28, 0x2a, 0x5d, 0xb3, 0x81,
-	0xb6, 0x13, 0x7a, 0xb1, 0x96, 0x44, 0x06, 0x37, 0x82, 0xdb, 0x09, 0xbd, 0xc8, 0x09, 0x35, 0x0d,
-	0x59, 0xe5, 0xe3, 0x06, 0x42, 0xab, 0x01, 0x99, 0x0f, 0x50, 0x31, 0xa4, 0x09, 0xf4, 0x1e, 0xaa,
-	0x4e, 0xb6, 0xee, 0x4c, 0x47, 0xf6, 0x96, 0x1a, 0x96, 0xba, 0x77, 0x82, 0x39, 0x60, 0x09, 0xf5,
-	0x55, 0x63, 0x7f, 0x81, 0xfa, 0x0b, 0xa8, 0x6f, 0x36, 0x51, 0x79, 0xcd, 0xd0, 0xe6, 0xcc, 0x50,
-	0x6b, 0x67, 0xf5, 0x23, 0xe4, 0x5f, 0x9d, 0xa0, 0x24, 0x56, 0x36, 0xcd, 0x37, 0x68, 0x8b, 0xa7,
-	0x19, 0xc5, 0x61, 0xfb, 0x2d, 0x26, 0x02, 0xb2, 0x6a, 0x41, 0x56, 0x7b, 0x32, 0xeb, 0xe6, 0xd7,
-	0x91, 0x5d, 0x23, 0x72, 0x2e, 0x3c, 0xec, 0xba, 0x0c, 0xbc, 0x1e, 0x16, 0xa7, 0xee, 0x11, 0x8d,
-	0x30, 0x19, 0x1c, 0x52, 0x32, 0x1d, 0xd9, 0x15, 0xa5, 0xbf, 0xa6, 0xe0, 0x04, 0x65, 0x75, 0x7e,
-	0x26, 0x8f, 0xaa, 0x11, 0x2f, 0x8c, 0xff, 0x8d, 0xed, 0x42, 0xeb, 0xf5, 0xe5, 0xd8, 0xd2, 0xaf,
-	0xc6, 0x96, 0xfe, 0x6d, 0x6c, 0xe9, 0x9f, 0x26, 0x96, 0x76, 0x35, 0xb1, 0xb4, 0x2f, 0x13, 0x4b,
-	0x7b, 0xd5, 0x8a, 0x98, 0x38, 0x3d, 0xef, 0xb8, 0x04, 0x7a, 0x8b, 0x0d, 0xdf, 0x8b, 0x71, 0x87,
-	0x2f, 0xd7, 0xbd, 0xbf, 0xff, 0xd8, 0x7b, 0xb7, 0xb6, 0xf4, 0x7b, 0xab, 0xad, 0x17, 0x83, 0x94,
-	0xf2, 0x4e, 0x51, 0xee, 0xec, 0xa3, 0x9f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xb1, 0x8f, 0x91, 0x17,
-	0x23, 0x04, 0x00, 0x00,
+	// 638 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xc1, 0x4f, 0xd4, 0x4e,
+	0x14, 0x6e, 0x97, 0xb2, 0xbf, 0x9f, 0xb3, 0x8b, 0xc1, 0x4a, 0xc2, 0x0a, 0x49, 0x8b, 0x4d, 0x4c,
+	0xd6, 0x03, 0xad, 0x45, 0x4f, 0xeb, 0x45, 0x17, 0x62, 0xd4, 0x70, 0x20, 0x85, 0x83, 0x31, 0x26,
+	0x75, 0x76, 0x3a, 0x94, 0x09, 0x6d, 0xa7, 0x74, 0x06, 0x70, 0xff, 0x03, 0x13, 0x35, 0xf1, 0xe8,
+	0xc1, 0x03, 0x07, 0xff, 0x18, 0x8e, 0x1c, 0x8d, 0x87, 0x8d, 0x61, 0x2f, 0x5e, 0xe5, 0x2f, 0x30,
+	0x3b, 0xd3, 0x65, 0xdb, 0xcd, 0x12, 0x20, 0xdc, 0xda, 0x99, 0xf7, 0xbe, 0xf7, 0xbe, 0xef, 0x7d,
+	0xf3, 0x80, 0x43, 0x59, 0x4c, 0x19, 0x61, 0x0e, 0xa2, 0x09, 0xc2, 0x09, 0xcf, 0x20, 0xc7, 0x41,
+	0x44, 0xf6, 0xf6, 0x49, 0x40, 0x78, 0xd7, 0x39, 0x70, 0x3b, 0x98, 0x43, 0xd7, 0x09, 0xe9, 0x81,
+	0x9d, 0x66, 0x94, 0x53, 0xfd, 0x41, 0x9e, 0x60, 0x4f, 0x4c, 0xb0, 0xf3, 0x84, 0x85, 0xb9, 0x90,
+	0x86, 0x54, 0x64, 0x38, 0x83, 0x2f, 0x99, 0xbc, 0xf0, 0xfc, 0x6a, 0xd5, 0x82, 0x6e, 0x02, 0x63,
+	0x82, 0x7c, 0x96, 0x66, 0x18, 0x06, 0xfe, 0x36, 0x44, 0x9c, 0x66, 0x12, 0xc2, 0xea, 0xab, 0xa0,
+	0xb9, 0x9a, 0x61, 0xc8, 0xf1, 0x6a, 0x01, 0x63, 0x7d, 0x88, 0xb1, 0x41, 0x69, 0xc4, 0x36, 0x32,
+	0x9a, 0x52, 0x06, 0x23, 0x7d, 0x0e, 0x4c, 0x73, 0xc2, 0x23, 0xdc, 0x50, 0x97, 0xd4, 0xe6, 0x2d,
+	0x4f, 0xfe, 0xe8, 0x4b, 0xa0, 0x16, 0x60, 0x86, 0x32, 0x92, 0x72, 0x42, 0x93, 0x46, 0x45, 0xdc,
+	0x15, 0x8f, 0xf4, 0x3d, 0x50, 0x4f, 0x29, 0x8d, 0xfc, 0x0c, 0x23, 0x9a, 0x05, 0xac, 0x31, 0xb5,
+	0x34, 0xd5, 0xac, 0xad, 0xb8, 0xf6, 0x95, 0xb8, 0xdb, 0x83, 0x1e, 0x3c, 0x91, 0xd9, 0x5e, 0x3c,
+	0xee, 0x99, 0xca, 0x59, 0xcf, 0xbc, 0xdb, 0x85, 0x71, 0xd4, 0xb2, 0x8a, 0xa0, 0x96, 0x57, 0x4b,
+	0xcf, 0x03, 0x59, 0xab, 0xfe, 0xf1, 0xc8, 0x54, 0xbe, 0x1d, 0x99, 0xca, 0x9f, 0x23, 0x53, 0xb5,
+	0xfe, 0xaa, 0x60, 0x71, 0x8b, 0xa0, 0xdd, 0xcd, 0x14, 0x22, 0x92, 0x84, 0x6b, 0x18, 0x65, 0x18,
+	0x32, 0x7c, 0x63, 0x62, 0x9f, 0x54, 0x60, 0x8a, 0x26, 0x48, 0xe0, 0x73, 0xea, 0x73, 0x82, 0x76,
+	0x7d, 0x26, 0x6b, 0x8c, 0x91, 0x7d, 0x76, 0x0d, 0xb2, 0xaf, 0x82, 0x2d, 0x5a, 0xe8, 0x36, 0xe7,
+	0xae, 0x0d, 0xb8, 0x7b, 0x0b, 0xe9, 0x45, 0x01, 0xe3, 0x9c, 0x03, 0x70, 0xef, 0x42, 0x30, 0x7d,
+	0x1e, 0xfc, 0x97, 0xf7, 0x2d, 0x28, 0x6b, 0x5e, 0x55, 0xe2, 0xea, 0x4d, 0x30, 0x9b, 0xe0, 0xc3,
+	0x12, 0x13, 0x41, 0x5c, 0xf3, 0x6e, 0x27, 0xf8, 0xb0, 0x00, 0xd4, 0xd2, 0x44, 0x95, 0x2f, 0x15,
+	0x60, 0x6c, 0x62, 0xbe, 0x26, 0x2d, 0xb6, 0x29, 0x1c, 0xf6, 0x42, 0x18, 0xec, 0xc6, 0xe2, 0xfe,
+	0x50, 0x41, 0xb3, 0x20, 0xee, 0x44, 0x17, 0x8f, 0xa9, 0xfc, 0xf2, 0x9a, 0x2a, 0x4f, 0x68, 0xbb,
+	0xa4, 0xb6, 0x95, 0x5e, 0x16, 0x38, 0xae, 0xfa, 0x77, 0x15, 0xdc, 0xbf, 0x14, 0xfd, 0x62, 0xf9,
+	0xdf, 0x80, 0x2a, 0xa2, 0xc9, 0x36, 0x91, 0xa2, 0x5f, 0xdd, 0x36, 0x13, 0x4a, 0xad, 0x0a, 0x1c,
+	0x2f, 0xc7, 0xcb, 0xc7, 0xf5, 0xb9, 0x02, 0xc0, 0xe8, 0x3d, 0xe9, 0x0f, 0x41, 0x35, 0xc0, 0x09,
+	0x8d, 0x1f, 0xc9, 0xd9, 0xb4, 0xef, 0x9c, 0xf5, 0xcc, 0x19, 0xf9, 0xb6, 0xe4, 0xb9, 0xe5, 0xe5,
+	0x01, 0xe7, 0xa1, 0x6e, 0xa3, 0x32, 0x31, 0xd4, 0x1d, 0x86, 0xba, 0x7a, 0x0b, 0xd4, 0x4b, 0xfe,
+	0x99, 0x1a, 0x50, 0x6c, 0xcf, 0x8f, 0xde, 0x6d, 0xf1, 0xd6, 0xf2, 0x6a, 0x7c, 0xe4, 0x2a, 0xfd,
+	0x3d, 0x98, 0x29, 0x0d, 0xb8, 0x31, 0x2d, 0xaa, 0x3d, 0x1d, 0x8c, 0xe3, 0x57, 0xcf, 0x5c, 0x44,
+	0x42, 0x0f, 0x16, 0xec, 0xda, 0x84, 0x3a, 0x31, 0xe4, 0x3b, 0xf6, 0x3a, 0x0e, 0x21, 0xea, 0xae,
+	0x61, 0x74, 0xd6, 0x33, 0xe7, 0x24, 0x7e, 0x09, 0xc1, 0xf2, 0xea, 0xac, 0x20, 0x8b, 0x14, 0xe2,
+	0xb5, 0xf6, 0xbf, 0x36, 0x3b, 0xdd, 0x7e, 0x77, 0x7c, 0x6a, 0xa8, 0x27, 0xa7, 0x86, 0xfa, 0xfb,
+	0xd4, 0x50, 0xbf, 0xf6, 0x0d, 0xe5, 0xa4, 0x6f, 0x28, 0x3f, 0xfb, 0x86, 0xf2, 0xb6, 0x1d, 0x12,
+	0xbe, 0xb3, 0xdf, 0xb1, 0x11, 0x8d, 0x87, 0x3b, 0x7d, 0x39, 0x82, 0x1d, 0x36, 0xfc, 0x71, 0x0e,
+	0x56, 0x9e, 0x38, 0x1f, 0x4a, 0x8b, 0x77, 0x79, 0xb4, 0x79, 0x79, 0x37, 0xc5, 0xac, 0x53, 0x15,
+	0x2b, 0xf6, 0xf1, 0xbf, 0x01, 0x00, 0xd2, 0x3d, 0x0d, 0x95, 0x15, 0x06, 0x00, 0x00,
 }
 
 func (this *CreateConcentratedLiquidityPoolsProposal) Equal(that interface{}) bool {
@@ -371,6 +474,68 @@ func (this *PoolIdToTickSpacingRecord) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *SetDynamicSpreadFactorProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SetDynamicSpreadFactorProposal)
+	if !ok {
+		that2, ok := that.(SetDynamicSpreadFactorProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if len(this.PoolIdToDynamicSpreadFactorRecords) != len(that1.PoolIdToDynamicSpreadFactorRecords) {
+		return false
+	}
+	for i := range this.PoolIdToDynamicSpreadFactorRecords {
+		if !this.PoolIdToDynamicSpreadFactorRecords[i].Equal(&that1.PoolIdToDynamicSpreadFactorRecords[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *PoolIdToDynamicSpreadFactorRecord) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PoolIdToDynamicSpreadFactorRecord)
+	if !ok {
+		that2, ok := that.(PoolIdToDynamicSpreadFactorRecord)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.PoolId != that1.PoolId {
+		return false
+	}
+	if !this.Config.Equal(that1.Config) {
+		return false
+	}
+	return true
+}
 func (this *PoolRecord) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -539,6 +704,97 @@ func (m *PoolIdToTickSpacingRecord) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *SetDynamicSpreadFactorProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetDynamicSpreadFactorProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetDynamicSpreadFactorProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PoolIdToDynamicSpreadFactorRecords) > 0 {
+		for iNdEx := len(m.PoolIdToDynamicSpreadFactorRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolIdToDynamicSpreadFactorRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolIdToDynamicSpreadFactorRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolIdToDynamicSpreadFactorRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolIdToDynamicSpreadFactorRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Config != nil {
+		{
+			size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGov(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *PoolRecord) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -663,6 +919,45 @@ func (m *PoolIdToTickSpacingRecord) Size() (n int) {
 	return n
 }
 
+func (m *SetDynamicSpreadFactorProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.PoolIdToDynamicSpreadFactorRecords) > 0 {
+		for _, e := range m.PoolIdToDynamicSpreadFactorRecords {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PoolIdToDynamicSpreadFactorRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovGov(uint64(m.PoolId))
+	}
+	if m.Config != nil {
+		l = m.Config.Size()
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
 func (m *PoolRecord) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1075,6 +1370,259 @@ func (m *PoolIdToTickSpacingRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SetDynamicSpreadFactorProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetDynamicSpreadFactorProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetDynamicSpreadFactorProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolIdToDynamicSpreadFactorRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolIdToDynamicSpreadFactorRecords = append(m.PoolIdToDynamicSpreadFactorRecords, PoolIdToDynamicSpreadFactorRecord{})
+			if err := m.PoolIdToDynamicSpreadFactorRecords[len(m.PoolIdToDynamicSpreadFactorRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolIdToDynamicSpreadFactorRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolIdToDynamicSpreadFactorRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolIdToDynamicSpreadFactorRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Config == nil {
+				m.Config = &DynamicSpreadFactorConfig{}
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *PoolRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	proto "github.com/cosmos/gogoproto/proto"
 	"github.com/stretchr/testify/require"
@@ -189,3 +190,78 @@ func TestCreateConcentratedLiquidityPoolsProposal_ValidateBasic(t *testing.T) {
 		}
 	}
 }
+
+func TestSetDynamicSpreadFactorProposal_ValidateBasic(t *testing.T) {
+	baseConfig := types.DynamicSpreadFactorConfig{
+		MinSpreadFactor:      osmomath.MustNewDecFromStr("0.001"),
+		MaxSpreadFactor:      osmomath.MustNewDecFromStr("0.01"),
+		VolatilityWindow:     time.Hour,
+		VolatilityMultiplier: osmomath.MustNewDecFromStr("0.5"),
+	}
+
+	withConfig := func(modify func(config *types.DynamicSpreadFactorConfig)) []types.PoolIdToDynamicSpreadFactorRecord {
+		config := baseConfig
+		modify(&config)
+		return []types.PoolIdToDynamicSpreadFactorRecord{{PoolId: 1, Config: &config}}
+	}
+
+	tests := []struct {
+		name       string
+		records    []types.PoolIdToDynamicSpreadFactorRecord
+		expectPass bool
+	}{
+		{
+			name:       "proper msg",
+			records:    withConfig(func(config *types.DynamicSpreadFactorConfig) {}),
+			expectPass: true,
+		},
+		{
+			name:       "disabling the dynamic spread factor",
+			records:    []types.PoolIdToDynamicSpreadFactorRecord{{PoolId: 1}},
+			expectPass: true,
+		},
+		{
+			name:       "no records",
+			expectPass: false,
+		},
+		{
+			name:       "zero pool id",
+			records:    []types.PoolIdToDynamicSpreadFactorRecord{{PoolId: 0}},
+			expectPass: false,
+		},
+		{
+			name: "min spread factor above max spread factor",
+			records: withConfig(func(config *types.DynamicSpreadFactorConfig) {
+				config.MinSpreadFactor = osmomath.MustNewDecFromStr("0.02")
+			}),
+			expectPass: false,
+		},
+		{
+			name:       "max spread factor of one",
+			records:    withConfig(func(config *types.DynamicSpreadFactorConfig) { config.MaxSpreadFactor = osmomath.OneDec() }),
+			expectPass: false,
+		},
+		{
+			name:       "zero volatility window",
+			records:    withConfig(func(config *types.DynamicSpreadFactorConfig) { config.VolatilityWindow = 0 }),
+			expectPass: false,
+		},
+		{
+			name: "negative volatility multiplier",
+			records: withConfig(func(config *types.DynamicSpreadFactorConfig) {
+				config.VolatilityMultiplier = osmomath.MustNewDecFromStr("-0.1")
+			}),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		proposal := types.NewSetDynamicSpreadFactorProposal("title", "description", test.records)
+
+		if test.expectPass {
+			require.NoError(t, proposal.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, proposal.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
@@ -4,8 +4,10 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 )
 
 const (
@@ -52,6 +54,27 @@ var (
 
 	KeyIncentiveAccumulatorMigrationThreshold = []byte{0x15}
 
+	LimitOrderPrefix          = []byte{0x16}
+	LimitOrderTickPrefix      = []byte{0x17}
+	LimitOrderOwnerPrefix     = []byte{0x18}
+	KeyNextGlobalLimitOrderId = []byte{0x19}
+
+	ManagedPositionPrefix          = []byte{0x1A}
+	ManagedPositionOwnerPrefix     = []byte{0x1B}
+	KeyNextGlobalManagedPositionId = []byte{0x1C}
+
+	AutoCompoundPositionPrefix = []byte{0x1D}
+	AutoCompoundQueuePrefix    = []byte{0x1E}
+
+	DynamicSpreadFactorConfigPrefix = []byte{0x1F}
+
+	PositionCheckpointPrefix = []byte{0x20}
+
+	ManagedPositionLowerTickPrefix      = []byte{0x21}
+	ManagedPositionUpperTickPrefix      = []byte{0x22}
+	ManagedPositionPoolTickPrefix       = []byte{0x23}
+	ManagedPositionRebalanceQueuePrefix = []byte{0x24}
+
 	// TickPrefix + pool id
 	KeyTickPrefixByPoolIdLengthBytes = len(TickPrefix) + Uint64ByteSize
 	// TickPrefix + pool id + sign byte(negative / positive prefix) + tick index: 18bytes in total
@@ -278,6 +301,170 @@ func KeyBalancerFullRange(clPoolId, balancerPoolId, uptimeIndex uint64) []byte {
 	return []byte(fmt.Sprintf("%s%s%d%s%d%s%d", BalancerFullRangePrefix, KeySeparator, clPoolId, KeySeparator, balancerPoolId, KeySeparator, uptimeIndex))
 }
 
+// Limit Order Prefix Keys
+
+// KeyLimitOrder returns the key (LimitOrderPrefix | order id) used to store a limit order.
+func KeyLimitOrder(orderId uint64) []byte {
+	key := make([]byte, 0, len(LimitOrderPrefix)+Uint64ByteSize)
+	key = append(key, LimitOrderPrefix...)
+	key = append(key, sdk.Uint64ToBigEndian(orderId)...)
+	return key
+}
+
+// KeyLimitOrdersAtTick returns the prefix (LimitOrderTickPrefix | pool id | direction | tick index)
+// that can be used to iterate over the open orders in the given direction that are filled by crossing the given tick.
+func KeyLimitOrdersAtTick(poolId uint64, direction LimitOrderDirection, tickIndex int64) []byte {
+	key := make([]byte, 0, len(LimitOrderTickPrefix)+Uint64ByteSize+1+Uint64ByteSize+1+Uint64ByteSize)
+	key = append(key, LimitOrderTickPrefix...)
+	key = append(key, sdk.Uint64ToBigEndian(poolId)...)
+	key = append(key, byte(direction))
+	key = append(key, TickIndexToBytes(tickIndex)...)
+	return key
+}
+
+// KeyLimitOrderAtTick returns the full key linking an open order to the tick whose crossing fills it.
+func KeyLimitOrderAtTick(poolId uint64, direction LimitOrderDirection, tickIndex int64, orderId uint64) []byte {
+	return append(KeyLimitOrdersAtTick(poolId, direction, tickIndex), sdk.Uint64ToBigEndian(orderId)...)
+}
+
+// KeyUserLimitOrders returns the prefix (LimitOrderOwnerPrefix | length prefixed address)
+// that can be used to iterate over all orders placed by an address.
+func KeyUserLimitOrders(addr sdk.AccAddress) []byte {
+	return append(append([]byte{}, LimitOrderOwnerPrefix...), address.MustLengthPrefix(addr)...)
+}
+
+// KeyUserPoolLimitOrders returns the prefix that can be used to iterate over all orders
+// placed by an address in a given pool.
+func KeyUserPoolLimitOrders(addr sdk.AccAddress, poolId uint64) []byte {
+	return append(KeyUserLimitOrders(addr), sdk.Uint64ToBigEndian(poolId)...)
+}
+
+// KeyUserLimitOrder returns the full key linking an address to one of its orders.
+func KeyUserLimitOrder(addr sdk.AccAddress, poolId uint64, orderId uint64) []byte {
+	return append(KeyUserPoolLimitOrders(addr, poolId), sdk.Uint64ToBigEndian(orderId)...)
+}
+
+// LimitOrderIdFromIndexKey returns the order id encoded in the last bytes of
+// a tick or owner limit order index key.
+func LimitOrderIdFromIndexKey(key []byte) (uint64, error) {
+	if len(key) < Uint64ByteSize {
+		return 0, fmt.Errorf("invalid limit order index key length (%d)", len(key))
+	}
+	return sdk.BigEndianToUint64(key[len(key)-Uint64ByteSize:]), nil
+}
+
+// Managed Position Prefix Keys
+
+// KeyManagedPosition returns the key (ManagedPositionPrefix | managed position id) used to store a managed position.
+func KeyManagedPosition(managedPositionId uint64) []byte {
+	key := make([]byte, 0, len(ManagedPositionPrefix)+Uint64ByteSize)
+	key = append(key, ManagedPositionPrefix...)
+	key = append(key, sdk.Uint64ToBigEndian(managedPositionId)...)
+	return key
+}
+
+// KeyUserManagedPositions returns the prefix (ManagedPositionOwnerPrefix | length prefixed address)
+// that can be used to iterate over all managed positions of an address.
+func KeyUserManagedPositions(addr sdk.AccAddress) []byte {
+	return append(append([]byte{}, ManagedPositionOwnerPrefix...), address.MustLengthPrefix(addr)...)
+}
+
+// KeyUserPoolManagedPositions returns the prefix that can be used to iterate over all managed
+// positions of an address in a given pool.
+func KeyUserPoolManagedPositions(addr sdk.AccAddress, poolId uint64) []byte {
+	return append(KeyUserManagedPositions(addr), sdk.Uint64ToBigEndian(poolId)...)
+}
+
+// KeyUserManagedPosition returns the full key linking an address to one of its managed positions.
+func KeyUserManagedPosition(addr sdk.AccAddress, poolId uint64, managedPositionId uint64) []byte {
+	return append(KeyUserPoolManagedPositions(addr, poolId), sdk.Uint64ToBigEndian(managedPositionId)...)
+}
+
+// KeyManagedPositionsByLowerTick returns the prefix (ManagedPositionLowerTickPrefix | pool id) that
+// can be used to iterate over the managed positions of a pool in ascending lower tick order.
+func KeyManagedPositionsByLowerTick(poolId uint64) []byte {
+	return append(append([]byte{}, ManagedPositionLowerTickPrefix...), sdk.Uint64ToBigEndian(poolId)...)
+}
+
+// KeyManagedPositionLowerTick returns the key (ManagedPositionLowerTickPrefix | pool id | lower tick | managed position id)
+// that indexes a managed position by the lower tick of its range.
+func KeyManagedPositionLowerTick(poolId uint64, lowerTick int64, managedPositionId uint64) []byte {
+	return append(append(KeyManagedPositionsByLowerTick(poolId), TickIndexToBytes(lowerTick)...), sdk.Uint64ToBigEndian(managedPositionId)...)
+}
+
+// KeyManagedPositionsByUpperTick returns the prefix (ManagedPositionUpperTickPrefix | pool id) that
+// can be used to iterate over the managed positions of a pool in ascending upper tick order.
+func KeyManagedPositionsByUpperTick(poolId uint64) []byte {
+	return append(append([]byte{}, ManagedPositionUpperTickPrefix...), sdk.Uint64ToBigEndian(poolId)...)
+}
+
+// KeyManagedPositionUpperTick returns the key (ManagedPositionUpperTickPrefix | pool id | upper tick | managed position id)
+// that indexes a managed position by the upper tick of its range.
+func KeyManagedPositionUpperTick(poolId uint64, upperTick int64, managedPositionId uint64) []byte {
+	return append(append(KeyManagedPositionsByUpperTick(poolId), TickIndexToBytes(upperTick)...), sdk.Uint64ToBigEndian(managedPositionId)...)
+}
+
+// KeyManagedPositionPoolTick returns the key (ManagedPositionPoolTickPrefix | pool id) used to store
+// the tick of a pool that its managed positions were last checked against.
+func KeyManagedPositionPoolTick(poolId uint64) []byte {
+	return append(append([]byte{}, ManagedPositionPoolTickPrefix...), sdk.Uint64ToBigEndian(poolId)...)
+}
+
+// KeyManagedPositionRebalanceQueue returns the key (ManagedPositionRebalanceQueuePrefix | rebalance time | managed position id)
+// that orders out of range managed positions by the time they are due to be re-centered.
+func KeyManagedPositionRebalanceQueue(rebalanceTime time.Time, managedPositionId uint64) []byte {
+	timeBz := sdk.FormatTimeBytes(rebalanceTime)
+	key := make([]byte, 0, len(ManagedPositionRebalanceQueuePrefix)+len(timeBz)+Uint64ByteSize)
+	key = append(key, ManagedPositionRebalanceQueuePrefix...)
+	key = append(key, timeBz...)
+	key = append(key, sdk.Uint64ToBigEndian(managedPositionId)...)
+	return key
+}
+
+// KeyAutoCompoundPosition returns the key (AutoCompoundPositionPrefix | position id) used to store
+// the auto-compound record of a position.
+func KeyAutoCompoundPosition(positionId uint64) []byte {
+	key := make([]byte, 0, len(AutoCompoundPositionPrefix)+Uint64ByteSize)
+	key = append(key, AutoCompoundPositionPrefix...)
+	key = append(key, sdk.Uint64ToBigEndian(positionId)...)
+	return key
+}
+
+// KeyAutoCompoundQueue returns the key (AutoCompoundQueuePrefix | next compound time | position id)
+// that orders auto-compounded positions by the time they are compounded next.
+func KeyAutoCompoundQueue(nextCompoundTime time.Time, positionId uint64) []byte {
+	timeBz := sdk.FormatTimeBytes(nextCompoundTime)
+	key := make([]byte, 0, len(AutoCompoundQueuePrefix)+len(timeBz)+Uint64ByteSize)
+	key = append(key, AutoCompoundQueuePrefix...)
+	key = append(key, timeBz...)
+	key = append(key, sdk.Uint64ToBigEndian(positionId)...)
+	return key
+}
+
+// KeyDynamicSpreadFactorConfig returns the key (DynamicSpreadFactorConfigPrefix | pool id) used to store
+// the dynamic spread factor config of a pool.
+func KeyDynamicSpreadFactorConfig(poolId uint64) []byte {
+	key := make([]byte, 0, len(DynamicSpreadFactorConfigPrefix)+Uint64ByteSize)
+	key = append(key, DynamicSpreadFactorConfigPrefix...)
+	key = append(key, sdk.Uint64ToBigEndian(poolId)...)
+	return key
+}
+
+// KeyPositionCheckpoints returns the prefix (PositionCheckpointPrefix | position id) that can be used
+// to iterate over all checkpoints of a position, ordered by height.
+func KeyPositionCheckpoints(positionId uint64) []byte {
+	key := make([]byte, 0, len(PositionCheckpointPrefix)+Uint64ByteSize)
+	key = append(key, PositionCheckpointPrefix...)
+	key = append(key, sdk.Uint64ToBigEndian(positionId)...)
+	return key
+}
+
+// KeyPositionCheckpoint returns the key (PositionCheckpointPrefix | position id | height) used to store
+// the checkpoint of a position at the given height.
+func KeyPositionCheckpoint(positionId uint64, height int64) []byte {
+	return append(KeyPositionCheckpoints(positionId), sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
 // Helper Functions
 func GetPoolIdFromShareDenom(denom string) (uint64, error) {
 	if !strings.HasPrefix(denom, ConcentratedLiquidityTokenPrefix) {
@@ -0,0 +1,35 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
+)
+
+const limitOrderEscrowAddressPrefix = "limitOrders"
+
+// LimitOrderEscrowAddress returns the address that owns the positions backing the
+// limit orders of the given pool and holds the proceeds of filled orders until claimed.
+func LimitOrderEscrowAddress(poolId uint64) sdk.AccAddress {
+	return osmoutils.NewModuleAddressWithPrefix(ModuleName, limitOrderEscrowAddressPrefix, sdk.Uint64ToBigEndian(poolId))
+}
+
+// LimitOrderDirectionForSwap returns the direction of the orders that get filled
+// by a swap in the given direction. Swapping token0 in moves the price down,
+// filling bids. Swapping token1 in moves the price up, filling asks.
+func LimitOrderDirectionForSwap(zeroForOne bool) LimitOrderDirection {
+	if zeroForOne {
+		return LimitOrderBid
+	}
+	return LimitOrderAsk
+}
+
+// TriggerTick returns the tick whose crossing fills the order. An ask is fully
+// converted to token1 once the price rises past its upper tick, while a bid is
+// fully converted to token0 once the price falls past its lower tick.
+func (o LimitOrder) TriggerTick() int64 {
+	if o.Direction == LimitOrderAsk {
+		return o.UpperTick
+	}
+	return o.LowerTick
+}
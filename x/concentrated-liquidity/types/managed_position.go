@@ -0,0 +1,35 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
+)
+
+const managedPositionEscrowAddressPrefix = "managedPositions"
+
+// ManagedPositionEscrowAddress returns the address that owns the positions backing the
+// managed positions of the given pool.
+func ManagedPositionEscrowAddress(poolId uint64) sdk.AccAddress {
+	return osmoutils.NewModuleAddressWithPrefix(ModuleName, managedPositionEscrowAddressPrefix, sdk.Uint64ToBigEndian(poolId))
+}
+
+// Validate performs the stateless checks on the strategy. Whether the half width
+// is a multiple of the tick spacing can only be checked against the pool.
+func (s ManagedPositionStrategy) Validate() error {
+	if s.HalfWidth <= 0 {
+		return NonPositiveHalfWidthError{HalfWidth: s.HalfWidth}
+	}
+
+	if s.OutOfRangeDelay < MinManagedPositionOutOfRangeDelay {
+		return OutOfRangeDelayTooShortError{Delay: s.OutOfRangeDelay, MinDelay: MinManagedPositionOutOfRangeDelay}
+	}
+
+	return nil
+}
+
+// IsOutOfRange returns true if the current tick of the pool was observed outside
+// of the position's range and the position has not been re-centered since.
+func (p ManagedPosition) IsOutOfRange() bool {
+	return !p.OutOfRangeSince.IsZero()
+}
@@ -19,6 +19,13 @@ const (
 	TypeMsgCollectIncentives       = "collect-incentives"
 	TypeMsgFungifyChargedPositions = "fungify-charged-positions"
 	TypeMsgTransferPositions       = "transfer-positions"
+	TypeMsgPlaceLimitOrder         = "place-limit-order"
+	TypeMsgCancelLimitOrder        = "cancel-limit-order"
+	TypeMsgClaimLimitOrder         = "claim-limit-order"
+	TypeMsgCreateManagedPosition   = "create-managed-position"
+	TypeMsgWithdrawManagedPosition = "withdraw-managed-position"
+	TypeMsgCompoundPosition        = "compound-position"
+	TypeMsgSetPositionAutoCompound = "set-position-auto-compound"
 )
 
 var _ sdk.Msg = &MsgCreatePosition{}
@@ -267,3 +274,224 @@ func (msg MsgTransferPositions) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{sender}
 }
+
+var _ sdk.Msg = &MsgPlaceLimitOrder{}
+
+func (msg MsgPlaceLimitOrder) Route() string { return RouterKey }
+func (msg MsgPlaceLimitOrder) Type() string  { return TypeMsgPlaceLimitOrder }
+func (msg MsgPlaceLimitOrder) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return fmt.Errorf("Invalid sender address (%s)", err)
+	}
+
+	if !msg.TokenIn.IsValid() {
+		return fmt.Errorf("Invalid coin (%s)", msg.TokenIn.String())
+	}
+
+	if !msg.TokenIn.IsPositive() {
+		return NotPositiveRequireAmountError{Amount: msg.TokenIn.Amount.String()}
+	}
+
+	return nil
+}
+
+func (msg MsgPlaceLimitOrder) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgPlaceLimitOrder) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgCancelLimitOrder{}
+
+func (msg MsgCancelLimitOrder) Route() string { return RouterKey }
+func (msg MsgCancelLimitOrder) Type() string  { return TypeMsgCancelLimitOrder }
+func (msg MsgCancelLimitOrder) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return fmt.Errorf("Invalid sender address (%s)", err)
+	}
+
+	if msg.OrderId == 0 {
+		return fmt.Errorf("Invalid limit order id, cannot be 0")
+	}
+
+	return nil
+}
+
+func (msg MsgCancelLimitOrder) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgCancelLimitOrder) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgClaimLimitOrder{}
+
+func (msg MsgClaimLimitOrder) Route() string { return RouterKey }
+func (msg MsgClaimLimitOrder) Type() string  { return TypeMsgClaimLimitOrder }
+func (msg MsgClaimLimitOrder) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return fmt.Errorf("Invalid sender address (%s)", err)
+	}
+
+	if msg.OrderId == 0 {
+		return fmt.Errorf("Invalid limit order id, cannot be 0")
+	}
+
+	return nil
+}
+
+func (msg MsgClaimLimitOrder) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgClaimLimitOrder) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgCreateManagedPosition{}
+
+func (msg MsgCreateManagedPosition) Route() string { return RouterKey }
+func (msg MsgCreateManagedPosition) Type() string  { return TypeMsgCreateManagedPosition }
+func (msg MsgCreateManagedPosition) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return fmt.Errorf("Invalid sender address (%s)", err)
+	}
+
+	if msg.TokensProvided.Empty() {
+		return fmt.Errorf("Empty coins provided (%s)", msg.TokensProvided.String())
+	}
+
+	if !msg.TokensProvided.IsValid() {
+		return fmt.Errorf("Invalid coins (%s)", msg.TokensProvided.String())
+	}
+
+	if len(msg.TokensProvided) > 2 {
+		return CoinLengthError{Length: len(msg.TokensProvided), MaxLength: 2}
+	}
+
+	for _, coin := range msg.TokensProvided {
+		if coin.Amount.LTE(osmomath.ZeroInt()) {
+			return NotPositiveRequireAmountError{Amount: coin.Amount.String()}
+		}
+	}
+
+	return msg.Strategy.Validate()
+}
+
+func (msg MsgCreateManagedPosition) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgCreateManagedPosition) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgWithdrawManagedPosition{}
+
+func (msg MsgWithdrawManagedPosition) Route() string { return RouterKey }
+func (msg MsgWithdrawManagedPosition) Type() string  { return TypeMsgWithdrawManagedPosition }
+func (msg MsgWithdrawManagedPosition) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return fmt.Errorf("Invalid sender address (%s)", err)
+	}
+
+	if msg.ManagedPositionId == 0 {
+		return fmt.Errorf("Invalid managed position id, cannot be 0")
+	}
+
+	return nil
+}
+
+func (msg MsgWithdrawManagedPosition) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgWithdrawManagedPosition) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgCompoundPosition{}
+
+func (msg MsgCompoundPosition) Route() string { return RouterKey }
+func (msg MsgCompoundPosition) Type() string  { return TypeMsgCompoundPosition }
+func (msg MsgCompoundPosition) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return fmt.Errorf("Invalid sender address (%s)", err)
+	}
+
+	if msg.PositionId == 0 {
+		return fmt.Errorf("Invalid position id, cannot be 0")
+	}
+
+	return nil
+}
+
+func (msg MsgCompoundPosition) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgCompoundPosition) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgSetPositionAutoCompound{}
+
+func (msg MsgSetPositionAutoCompound) Route() string { return RouterKey }
+func (msg MsgSetPositionAutoCompound) Type() string  { return TypeMsgSetPositionAutoCompound }
+func (msg MsgSetPositionAutoCompound) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return fmt.Errorf("Invalid sender address (%s)", err)
+	}
+
+	if msg.PositionId == 0 {
+		return fmt.Errorf("Invalid position id, cannot be 0")
+	}
+
+	return nil
+}
+
+func (msg MsgSetPositionAutoCompound) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgSetPositionAutoCompound) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -362,6 +363,60 @@ func TestConcentratedLiquiditySerialization(t *testing.T) {
 				PositionIds: []uint64{1, 2},
 			},
 		},
+		{
+			name: "MsgPlaceLimitOrder",
+			clMsg: &types.MsgPlaceLimitOrder{
+				PoolId:    defaultPoolId,
+				Sender:    addr1,
+				TickIndex: int64(10000),
+				TokenIn:   sdk.NewCoin("foo", osmomath.NewInt(1000)),
+			},
+		},
+		{
+			name: "MsgCancelLimitOrder",
+			clMsg: &types.MsgCancelLimitOrder{
+				OrderId: 1,
+				Sender:  addr1,
+			},
+		},
+		{
+			name: "MsgClaimLimitOrder",
+			clMsg: &types.MsgClaimLimitOrder{
+				OrderId: 1,
+				Sender:  addr1,
+			},
+		},
+		{
+			name: "MsgCreateManagedPosition",
+			clMsg: &types.MsgCreateManagedPosition{
+				PoolId:         defaultPoolId,
+				Sender:         addr1,
+				TokensProvided: sdk.NewCoins(sdk.NewCoin("foo", osmomath.NewInt(1000)), sdk.NewCoin("bar", osmomath.NewInt(1000))),
+				Strategy:       types.ManagedPositionStrategy{HalfWidth: 1000, OutOfRangeDelay: time.Hour},
+			},
+		},
+		{
+			name: "MsgWithdrawManagedPosition",
+			clMsg: &types.MsgWithdrawManagedPosition{
+				ManagedPositionId: 1,
+				Sender:            addr1,
+			},
+		},
+		{
+			name: "MsgCompoundPosition",
+			clMsg: &types.MsgCompoundPosition{
+				PositionId: 1,
+				Sender:     addr1,
+			},
+		},
+		{
+			name: "MsgSetPositionAutoCompound",
+			clMsg: &types.MsgSetPositionAutoCompound{
+				PositionId: 1,
+				Sender:     addr1,
+				Enabled:    true,
+			},
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -434,3 +489,315 @@ func TestMsgTransferPositions(t *testing.T) {
 		runValidateBasicTest(t, test.name, &test.msg, test.expectPass, types.TypeMsgTransferPositions)
 	}
 }
+
+func TestMsgPlaceLimitOrder(t *testing.T) {
+	tests := []struct {
+		name       string
+		msg        types.MsgPlaceLimitOrder
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: types.MsgPlaceLimitOrder{
+				PoolId:    1,
+				Sender:    addr1,
+				TickIndex: -100,
+				TokenIn:   sdk.NewCoin("foo", osmomath.NewInt(1000)),
+			},
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: types.MsgPlaceLimitOrder{
+				PoolId:    1,
+				Sender:    invalidAddr.String(),
+				TickIndex: -100,
+				TokenIn:   sdk.NewCoin("foo", osmomath.NewInt(1000)),
+			},
+			expectPass: false,
+		},
+		{
+			name: "zero token in",
+			msg: types.MsgPlaceLimitOrder{
+				PoolId:    1,
+				Sender:    addr1,
+				TickIndex: -100,
+				TokenIn:   sdk.NewCoin("foo", osmomath.ZeroInt()),
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid token in denom",
+			msg: types.MsgPlaceLimitOrder{
+				PoolId:    1,
+				Sender:    addr1,
+				TickIndex: -100,
+				TokenIn:   sdk.Coin{Denom: "1", Amount: osmomath.NewInt(1000)},
+			},
+			expectPass: false,
+		},
+	}
+	for _, test := range tests {
+		runValidateBasicTest(t, test.name, &test.msg, test.expectPass, types.TypeMsgPlaceLimitOrder)
+	}
+}
+
+func TestMsgCancelLimitOrder(t *testing.T) {
+	tests := []struct {
+		name       string
+		msg        types.MsgCancelLimitOrder
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: types.MsgCancelLimitOrder{
+				OrderId: 1,
+				Sender:  addr1,
+			},
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: types.MsgCancelLimitOrder{
+				OrderId: 1,
+				Sender:  invalidAddr.String(),
+			},
+			expectPass: false,
+		},
+		{
+			name: "zero order id",
+			msg: types.MsgCancelLimitOrder{
+				Sender: addr1,
+			},
+			expectPass: false,
+		},
+	}
+	for _, test := range tests {
+		runValidateBasicTest(t, test.name, &test.msg, test.expectPass, types.TypeMsgCancelLimitOrder)
+	}
+}
+
+func TestMsgClaimLimitOrder(t *testing.T) {
+	tests := []struct {
+		name       string
+		msg        types.MsgClaimLimitOrder
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: types.MsgClaimLimitOrder{
+				OrderId: 1,
+				Sender:  addr1,
+			},
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: types.MsgClaimLimitOrder{
+				OrderId: 1,
+				Sender:  invalidAddr.String(),
+			},
+			expectPass: false,
+		},
+		{
+			name: "zero order id",
+			msg: types.MsgClaimLimitOrder{
+				Sender: addr1,
+			},
+			expectPass: false,
+		},
+	}
+	for _, test := range tests {
+		runValidateBasicTest(t, test.name, &test.msg, test.expectPass, types.TypeMsgClaimLimitOrder)
+	}
+}
+
+func TestMsgCreateManagedPosition(t *testing.T) {
+	validTokens := sdk.NewCoins(sdk.NewCoin("foo", osmomath.NewInt(1000)), sdk.NewCoin("bar", osmomath.NewInt(1000)))
+	validStrategy := types.ManagedPositionStrategy{HalfWidth: 1000, OutOfRangeDelay: time.Hour}
+
+	tests := []struct {
+		name       string
+		msg        types.MsgCreateManagedPosition
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: types.MsgCreateManagedPosition{
+				PoolId:         1,
+				Sender:         addr1,
+				TokensProvided: validTokens,
+				Strategy:       validStrategy,
+			},
+			expectPass: true,
+		},
+		{
+			name: "zero delay",
+			msg: types.MsgCreateManagedPosition{
+				PoolId:         1,
+				Sender:         addr1,
+				TokensProvided: validTokens,
+				Strategy:       types.ManagedPositionStrategy{HalfWidth: 1000},
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid sender",
+			msg: types.MsgCreateManagedPosition{
+				PoolId:         1,
+				Sender:         invalidAddr.String(),
+				TokensProvided: validTokens,
+				Strategy:       validStrategy,
+			},
+			expectPass: false,
+		},
+		{
+			name: "no tokens provided",
+			msg: types.MsgCreateManagedPosition{
+				PoolId:   1,
+				Sender:   addr1,
+				Strategy: validStrategy,
+			},
+			expectPass: false,
+		},
+		{
+			name: "too many tokens provided",
+			msg: types.MsgCreateManagedPosition{
+				PoolId:         1,
+				Sender:         addr1,
+				TokensProvided: validTokens.Add(sdk.NewCoin("baz", osmomath.NewInt(1000))),
+				Strategy:       validStrategy,
+			},
+			expectPass: false,
+		},
+		{
+			name: "zero half width",
+			msg: types.MsgCreateManagedPosition{
+				PoolId:         1,
+				Sender:         addr1,
+				TokensProvided: validTokens,
+				Strategy:       types.ManagedPositionStrategy{OutOfRangeDelay: time.Hour},
+			},
+			expectPass: false,
+		},
+		{
+			name: "negative delay",
+			msg: types.MsgCreateManagedPosition{
+				PoolId:         1,
+				Sender:         addr1,
+				TokensProvided: validTokens,
+				Strategy:       types.ManagedPositionStrategy{HalfWidth: 1000, OutOfRangeDelay: -time.Hour},
+			},
+			expectPass: false,
+		},
+	}
+	for _, test := range tests {
+		runValidateBasicTest(t, test.name, &test.msg, test.expectPass, types.TypeMsgCreateManagedPosition)
+	}
+}
+
+func TestMsgWithdrawManagedPosition(t *testing.T) {
+	tests := []struct {
+		name       string
+		msg        types.MsgWithdrawManagedPosition
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: types.MsgWithdrawManagedPosition{
+				ManagedPositionId: 1,
+				Sender:            addr1,
+			},
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: types.MsgWithdrawManagedPosition{
+				ManagedPositionId: 1,
+				Sender:            invalidAddr.String(),
+			},
+			expectPass: false,
+		},
+		{
+			name: "zero managed position id",
+			msg: types.MsgWithdrawManagedPosition{
+				Sender: addr1,
+			},
+			expectPass: false,
+		},
+	}
+	for _, test := range tests {
+		runValidateBasicTest(t, test.name, &test.msg, test.expectPass, types.TypeMsgWithdrawManagedPosition)
+	}
+}
+
+func TestMsgCompoundPosition(t *testing.T) {
+	tests := []struct {
+		name       string
+		msg        types.MsgCompoundPosition
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: types.MsgCompoundPosition{
+				PositionId: 1,
+				Sender:     addr1,
+			},
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: types.MsgCompoundPosition{
+				PositionId: 1,
+				Sender:     invalidAddr.String(),
+			},
+			expectPass: false,
+		},
+		{
+			name: "zero position id",
+			msg: types.MsgCompoundPosition{
+				Sender: addr1,
+			},
+			expectPass: false,
+		},
+	}
+	for _, test := range tests {
+		runValidateBasicTest(t, test.name, &test.msg, test.expectPass, types.TypeMsgCompoundPosition)
+	}
+}
+
+func TestMsgSetPositionAutoCompound(t *testing.T) {
+	tests := []struct {
+		name       string
+		msg        types.MsgSetPositionAutoCompound
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: types.MsgSetPositionAutoCompound{
+				PositionId: 1,
+				Sender:     addr1,
+				Enabled:    true,
+			},
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: types.MsgSetPositionAutoCompound{
+				PositionId: 1,
+				Sender:     invalidAddr.String(),
+			},
+			expectPass: false,
+		},
+		{
+			name: "zero position id",
+			msg: types.MsgSetPositionAutoCompound{
+				Sender: addr1,
+			},
+			expectPass: false,
+		},
+	}
+	for _, test := range tests {
+		runValidateBasicTest(t, test.name, &test.msg, test.expectPass, types.TypeMsgSetPositionAutoCompound)
+	}
+}
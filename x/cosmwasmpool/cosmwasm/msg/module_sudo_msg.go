@@ -33,3 +33,25 @@ func NewSwapExactAmountOutSudoMsg(sender string, tokenInDenom string, tokenOut s
 		},
 	}
 }
+
+// JoinPool
+func NewJoinPoolSudoMsg(sender string, tokensIn sdk.Coins, totalShares osmomath.Int) JoinPoolSudoMsg {
+	return JoinPoolSudoMsg{
+		JoinPool: JoinPool{
+			Sender:      sender,
+			TokensIn:    tokensIn,
+			TotalShares: totalShares,
+		},
+	}
+}
+
+// ExitPool
+func NewExitPoolSudoMsg(sender string, shareInAmount osmomath.Int, totalShares osmomath.Int) ExitPoolSudoMsg {
+	return ExitPoolSudoMsg{
+		ExitPool: ExitPool{
+			Sender:        sender,
+			ShareInAmount: shareInAmount,
+			TotalShares:   totalShares,
+		},
+	}
+}
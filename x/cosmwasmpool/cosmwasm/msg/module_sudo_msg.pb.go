@@ -329,6 +329,287 @@ func (m *SwapExactAmountOutSudoMsgResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SwapExactAmountOutSudoMsgResponse proto.InternalMessageInfo
 
+// ===================== JoinPool
+type JoinPool struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// tokens_in are the tokens sent to the pool.
+	TokensIn []types.Coin `protobuf:"bytes,2,rep,name=tokens_in,json=tokensIn,proto3" json:"tokens_in"`
+	// total_shares is the total amount of LP shares of the pool before the join.
+	TotalShares cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=total_shares,json=totalShares,proto3,customtype=cosmossdk.io/math.Int" json:"total_shares"`
+}
+
+func (m *JoinPool) Reset()         { *m = JoinPool{} }
+func (m *JoinPool) String() string { return proto.CompactTextString(m) }
+func (*JoinPool) ProtoMessage()    {}
+func (*JoinPool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e3b9879c5388a3a5, []int{6}
+}
+func (m *JoinPool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JoinPool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JoinPool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *JoinPool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JoinPool.Merge(m, src)
+}
+func (m *JoinPool) XXX_Size() int {
+	return m.Size()
+}
+func (m *JoinPool) XXX_DiscardUnknown() {
+	xxx_messageInfo_JoinPool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JoinPool proto.InternalMessageInfo
+
+func (m *JoinPool) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *JoinPool) GetTokensIn() []types.Coin {
+	if m != nil {
+		return m.TokensIn
+	}
+	return nil
+}
+
+type JoinPoolSudoMsg struct {
+	// join_pool is the structure containing all the request
+	// information for this message.
+	JoinPool JoinPool `protobuf:"bytes,1,opt,name=join_pool,json=joinPool,proto3" json:"join_pool"`
+}
+
+func (m *JoinPoolSudoMsg) Reset()         { *m = JoinPoolSudoMsg{} }
+func (m *JoinPoolSudoMsg) String() string { return proto.CompactTextString(m) }
+func (*JoinPoolSudoMsg) ProtoMessage()    {}
+func (*JoinPoolSudoMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e3b9879c5388a3a5, []int{7}
+}
+func (m *JoinPoolSudoMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JoinPoolSudoMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JoinPoolSudoMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *JoinPoolSudoMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JoinPoolSudoMsg.Merge(m, src)
+}
+func (m *JoinPoolSudoMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *JoinPoolSudoMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_JoinPoolSudoMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JoinPoolSudoMsg proto.InternalMessageInfo
+
+func (m *JoinPoolSudoMsg) GetJoinPool() JoinPool {
+	if m != nil {
+		return m.JoinPool
+	}
+	return JoinPool{}
+}
+
+type JoinPoolSudoMsgResponse struct {
+	// shares_out_amount is the amount of LP shares to mint for the tokens in.
+	SharesOutAmount cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=shares_out_amount,json=sharesOutAmount,proto3,customtype=cosmossdk.io/math.Int" json:"shares_out_amount"`
+}
+
+func (m *JoinPoolSudoMsgResponse) Reset()         { *m = JoinPoolSudoMsgResponse{} }
+func (m *JoinPoolSudoMsgResponse) String() string { return proto.CompactTextString(m) }
+func (*JoinPoolSudoMsgResponse) ProtoMessage()    {}
+func (*JoinPoolSudoMsgResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e3b9879c5388a3a5, []int{8}
+}
+func (m *JoinPoolSudoMsgResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JoinPoolSudoMsgResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JoinPoolSudoMsgResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *JoinPoolSudoMsgResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JoinPoolSudoMsgResponse.Merge(m, src)
+}
+func (m *JoinPoolSudoMsgResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *JoinPoolSudoMsgResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_JoinPoolSudoMsgResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JoinPoolSudoMsgResponse proto.InternalMessageInfo
+
+// ===================== ExitPool
+type ExitPool struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// share_in_amount is the amount of LP shares to burn.
+	ShareInAmount cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=share_in_amount,json=shareInAmount,proto3,customtype=cosmossdk.io/math.Int" json:"share_in_amount"`
+	// total_shares is the total amount of LP shares of the pool before the exit.
+	TotalShares cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=total_shares,json=totalShares,proto3,customtype=cosmossdk.io/math.Int" json:"total_shares"`
+}
+
+func (m *ExitPool) Reset()         { *m = ExitPool{} }
+func (m *ExitPool) String() string { return proto.CompactTextString(m) }
+func (*ExitPool) ProtoMessage()    {}
+func (*ExitPool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e3b9879c5388a3a5, []int{9}
+}
+func (m *ExitPool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExitPool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExitPool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExitPool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExitPool.Merge(m, src)
+}
+func (m *ExitPool) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExitPool) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExitPool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExitPool proto.InternalMessageInfo
+
+func (m *ExitPool) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+type ExitPoolSudoMsg struct {
+	// exit_pool is the structure containing all the request
+	// information for this message.
+	ExitPool ExitPool `protobuf:"bytes,1,opt,name=exit_pool,json=exitPool,proto3" json:"exit_pool"`
+}
+
+func (m *ExitPoolSudoMsg) Reset()         { *m = ExitPoolSudoMsg{} }
+func (m *ExitPoolSudoMsg) String() string { return proto.CompactTextString(m) }
+func (*ExitPoolSudoMsg) ProtoMessage()    {}
+func (*ExitPoolSudoMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e3b9879c5388a3a5, []int{10}
+}
+func (m *ExitPoolSudoMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExitPoolSudoMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExitPoolSudoMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExitPoolSudoMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExitPoolSudoMsg.Merge(m, src)
+}
+func (m *ExitPoolSudoMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExitPoolSudoMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExitPoolSudoMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExitPoolSudoMsg proto.InternalMessageInfo
+
+func (m *ExitPoolSudoMsg) GetExitPool() ExitPool {
+	if m != nil {
+		return m.ExitPool
+	}
+	return ExitPool{}
+}
+
+type ExitPoolSudoMsgResponse struct {
+	// tokens_out are the tokens to send from the pool to the sender
+	// for the shares in.
+	TokensOut []types.Coin `protobuf:"bytes,1,rep,name=tokens_out,json=tokensOut,proto3" json:"tokens_out"`
+}
+
+func (m *ExitPoolSudoMsgResponse) Reset()         { *m = ExitPoolSudoMsgResponse{} }
+func (m *ExitPoolSudoMsgResponse) String() string { return proto.CompactTextString(m) }
+func (*ExitPoolSudoMsgResponse) ProtoMessage()    {}
+func (*ExitPoolSudoMsgResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e3b9879c5388a3a5, []int{11}
+}
+func (m *ExitPoolSudoMsgResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExitPoolSudoMsgResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExitPoolSudoMsgResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExitPoolSudoMsgResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExitPoolSudoMsgResponse.Merge(m, src)
+}
+func (m *ExitPoolSudoMsgResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExitPoolSudoMsgResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExitPoolSudoMsgResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExitPoolSudoMsgResponse proto.InternalMessageInfo
+
+func (m *ExitPoolSudoMsgResponse) GetTokensOut() []types.Coin {
+	if m != nil {
+		return m.TokensOut
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*SwapExactAmountIn)(nil), "osmosis.cosmwasmpool.v1beta1.SwapExactAmountIn")
 	proto.RegisterType((*SwapExactAmountInSudoMsg)(nil), "osmosis.cosmwasmpool.v1beta1.SwapExactAmountInSudoMsg")
@@ -336,6 +617,12 @@ func init() {
 	proto.RegisterType((*SwapExactAmountOut)(nil), "osmosis.cosmwasmpool.v1beta1.SwapExactAmountOut")
 	proto.RegisterType((*SwapExactAmountOutSudoMsg)(nil), "osmosis.cosmwasmpool.v1beta1.SwapExactAmountOutSudoMsg")
 	proto.RegisterType((*SwapExactAmountOutSudoMsgResponse)(nil), "osmosis.cosmwasmpool.v1beta1.SwapExactAmountOutSudoMsgResponse")
+	proto.RegisterType((*JoinPool)(nil), "osmosis.cosmwasmpool.v1beta1.JoinPool")
+	proto.RegisterType((*JoinPoolSudoMsg)(nil), "osmosis.cosmwasmpool.v1beta1.JoinPoolSudoMsg")
+	proto.RegisterType((*JoinPoolSudoMsgResponse)(nil), "osmosis.cosmwasmpool.v1beta1.JoinPoolSudoMsgResponse")
+	proto.RegisterType((*ExitPool)(nil), "osmosis.cosmwasmpool.v1beta1.ExitPool")
+	proto.RegisterType((*ExitPoolSudoMsg)(nil), "osmosis.cosmwasmpool.v1beta1.ExitPoolSudoMsg")
+	proto.RegisterType((*ExitPoolSudoMsgResponse)(nil), "osmosis.cosmwasmpool.v1beta1.ExitPoolSudoMsgResponse")
 }
 
 func init() {
@@ -343,43 +630,52 @@ func init() {
 }
 
 var fileDescriptor_e3b9879c5388a3a5 = []byte{
-	// 564 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcf, 0x6f, 0xd3, 0x30,
-	0x18, 0x6d, 0xc6, 0xd8, 0x0f, 0xc3, 0xc6, 0x6a, 0x3a, 0x94, 0x0d, 0xc8, 0x4a, 0x41, 0x68, 0x17,
-	0x6c, 0x36, 0x38, 0x20, 0x84, 0x40, 0x94, 0x0d, 0x54, 0x69, 0xa5, 0x52, 0x77, 0x82, 0x4b, 0xe4,
-	0x26, 0x5e, 0x16, 0x5a, 0xfb, 0xab, 0x66, 0x67, 0x2d, 0x57, 0x0e, 0x9c, 0xf9, 0x0b, 0xe0, 0xdf,
-	0xd9, 0x71, 0x47, 0xc4, 0x61, 0x42, 0xed, 0x3f, 0x82, 0x92, 0x38, 0x2d, 0x5b, 0x54, 0xd4, 0x49,
-	0x5c, 0xaa, 0xda, 0xdf, 0xf7, 0xde, 0xfb, 0xfc, 0xf2, 0x6c, 0xf4, 0x0c, 0x94, 0x00, 0x15, 0x2a,
-	0xea, 0x81, 0x12, 0x3d, 0xa6, 0x44, 0x17, 0xa0, 0x43, 0x8f, 0xb7, 0x5a, 0x5c, 0xb3, 0x2d, 0x2a,
-	0xc0, 0xe7, 0x9d, 0xf8, 0x37, 0xea, 0x70, 0x57, 0x45, 0x3e, 0xb8, 0x42, 0x05, 0xa4, 0x7b, 0x04,
-	0x1a, 0xf0, 0x1d, 0x83, 0x24, 0x7f, 0x23, 0x89, 0x41, 0xae, 0x97, 0x02, 0x08, 0x20, 0x69, 0xa4,
-	0xf1, 0xbf, 0x14, 0xb3, 0xee, 0x78, 0x09, 0x88, 0xb6, 0x98, 0xe2, 0x23, 0x11, 0x0f, 0x42, 0x99,
-	0xd6, 0x2b, 0x3f, 0x66, 0x50, 0x71, 0xbf, 0xc7, 0xba, 0xbb, 0x7d, 0xe6, 0xe9, 0xd7, 0x02, 0x22,
-	0xa9, 0x6b, 0x12, 0xdf, 0x42, 0x73, 0x8a, 0x4b, 0x9f, 0x1f, 0xd9, 0x56, 0xd9, 0xda, 0x5c, 0x6c,
-	0x9a, 0x15, 0x7e, 0x8e, 0x16, 0x34, 0xb4, 0xb9, 0x74, 0x43, 0x69, 0xcf, 0x94, 0xad, 0xcd, 0x6b,
-	0xdb, 0x6b, 0x24, 0x15, 0x20, 0xb1, 0x40, 0x36, 0x0b, 0x79, 0x03, 0xa1, 0xac, 0xce, 0x9e, 0x9c,
-	0x6d, 0x14, 0x9a, 0xf3, 0x09, 0xa0, 0x26, 0xf1, 0x43, 0x74, 0x23, 0xc5, 0x42, 0xa4, 0x5d, 0x9f,
-	0x4b, 0x10, 0xf6, 0x95, 0x84, 0x7c, 0x29, 0xd9, 0x6e, 0x44, 0x7a, 0x27, 0xde, 0xc4, 0xef, 0x51,
-	0x69, 0xdc, 0x27, 0x42, 0xe9, 0xb2, 0x64, 0x2a, 0x7b, 0x36, 0x6e, 0xae, 0xde, 0x8d, 0x49, 0x7f,
-	0x9d, 0x6d, 0xac, 0xa6, 0xb2, 0xca, 0x6f, 0x93, 0x10, 0xa8, 0x60, 0xfa, 0x90, 0xd4, 0xa4, 0x6e,
-	0x16, 0x33, 0xae, 0x7a, 0x28, 0xd3, 0xd3, 0xe0, 0x97, 0x68, 0x41, 0xf5, 0x58, 0xd7, 0x3d, 0xe0,
-	0xdc, 0xbe, 0x9a, 0x70, 0xdc, 0x37, 0x1c, 0xb7, 0xf3, 0x1c, 0x7b, 0x3c, 0x60, 0xde, 0xe7, 0x1d,
-	0xee, 0x35, 0xe7, 0x63, 0xd0, 0x5b, 0xce, 0x2b, 0x5f, 0x2c, 0x64, 0xe7, 0x1c, 0xda, 0x8f, 0x7c,
-	0xa8, 0xab, 0x00, 0x1f, 0xa0, 0x52, 0x42, 0xce, 0xe3, 0xa2, 0x99, 0x34, 0x36, 0xc7, 0x4a, 0xcc,
-	0xa1, 0xe4, 0x5f, 0x5f, 0x8c, 0xe4, 0x58, 0x8d, 0x65, 0x45, 0x75, 0xb1, 0x50, 0x69, 0xa3, 0xf2,
-	0xa4, 0x19, 0x9a, 0x5c, 0x75, 0x41, 0x2a, 0x8e, 0xdf, 0xa1, 0x95, 0xb1, 0x71, 0xc6, 0x34, 0x6b,
-	0x1a, 0xd3, 0x96, 0x33, 0xd3, 0x52, 0xe6, 0xca, 0xf7, 0x19, 0x84, 0x2f, 0xa8, 0x35, 0x22, 0x3d,
-	0x31, 0x14, 0x2f, 0xd0, 0xe2, 0x48, 0x77, 0xda, 0x54, 0x2c, 0x64, 0x92, 0xf8, 0x01, 0x5a, 0xce,
-	0x22, 0x75, 0x2e, 0x15, 0xd7, 0x4d, 0x6e, 0xd2, 0x50, 0xec, 0xa1, 0x9b, 0xa3, 0x2e, 0xc1, 0xfa,
-	0x97, 0xca, 0xc4, 0x8a, 0x61, 0xaa, 0xb3, 0xfe, 0x7f, 0x8a, 0xc4, 0x57, 0x0b, 0xad, 0xe5, 0x0d,
-	0xca, 0x32, 0x11, 0xa2, 0xd5, 0x7c, 0x26, 0x62, 0x6f, 0xd2, 0x50, 0x3c, 0xbe, 0x54, 0x28, 0x1a,
-	0x91, 0x36, 0x96, 0x61, 0x95, 0xab, 0x54, 0x3e, 0xa1, 0x7b, 0x13, 0xe7, 0x18, 0xe5, 0x62, 0x37,
-	0xbb, 0x78, 0xe3, 0xbb, 0x34, 0x55, 0x2c, 0x96, 0x8c, 0x6f, 0x29, 0x71, 0xf5, 0xc3, 0xc9, 0xc0,
-	0xb1, 0x4e, 0x07, 0x8e, 0xf5, 0x7b, 0xe0, 0x58, 0xdf, 0x86, 0x4e, 0xe1, 0x74, 0xe8, 0x14, 0x7e,
-	0x0e, 0x9d, 0xc2, 0xc7, 0x57, 0x41, 0xa8, 0x0f, 0xa3, 0x16, 0xf1, 0x40, 0x50, 0x73, 0xb6, 0x47,
-	0x1d, 0xd6, 0x52, 0xd9, 0x82, 0x1e, 0x6f, 0x3f, 0xa5, 0xfd, 0xf3, 0xef, 0x5d, 0xb6, 0xa0, 0x42,
-	0x05, 0xad, 0xb9, 0xe4, 0x2d, 0x7a, 0xf2, 0x27, 0x00, 0x00, 0xff, 0xff, 0xaa, 0x6a, 0xc5, 0x65,
-	0x1b, 0x05, 0x00, 0x00,
+	// 715 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xcf, 0x4f, 0x13, 0x41,
+	0x14, 0xee, 0x16, 0x84, 0xf2, 0xf8, 0xd9, 0x15, 0x64, 0x41, 0x5d, 0x70, 0x35, 0x84, 0x8b, 0xbb,
+	0x82, 0x1e, 0x8c, 0x31, 0xa8, 0x48, 0x35, 0x35, 0x20, 0xa6, 0x9c, 0x30, 0x26, 0x9b, 0xe9, 0xee,
+	0xb0, 0x2c, 0x74, 0x67, 0x1a, 0x66, 0x16, 0xea, 0xd5, 0x83, 0x67, 0xff, 0x02, 0x4d, 0xbc, 0xfa,
+	0x8f, 0x70, 0xe4, 0x68, 0x3c, 0x10, 0x03, 0xff, 0x88, 0xd9, 0x9d, 0x99, 0x16, 0xda, 0x50, 0xb7,
+	0x09, 0x97, 0xa6, 0x6f, 0x67, 0xbe, 0xef, 0x7d, 0xfd, 0xde, 0xf7, 0xba, 0xf0, 0x94, 0xb2, 0x88,
+	0xb2, 0x90, 0x39, 0x1e, 0x65, 0xd1, 0x11, 0x62, 0x51, 0x9d, 0xd2, 0x9a, 0x73, 0xb8, 0x54, 0xc5,
+	0x1c, 0x2d, 0x39, 0x11, 0xf5, 0x71, 0x2d, 0xf9, 0x8c, 0x6b, 0xd8, 0x65, 0xb1, 0x4f, 0xdd, 0x88,
+	0x05, 0x76, 0xfd, 0x80, 0x72, 0xaa, 0xdf, 0x91, 0x48, 0xfb, 0x22, 0xd2, 0x96, 0xc8, 0xd9, 0xc9,
+	0x80, 0x06, 0x34, 0xbd, 0xe8, 0x24, 0xdf, 0x04, 0x66, 0xd6, 0xf4, 0x52, 0x90, 0x53, 0x45, 0x0c,
+	0x37, 0x9b, 0x78, 0x34, 0x24, 0xe2, 0xdc, 0xfa, 0x91, 0x87, 0xe2, 0xd6, 0x11, 0xaa, 0x97, 0x1a,
+	0xc8, 0xe3, 0xaf, 0x22, 0x1a, 0x13, 0x5e, 0x26, 0xfa, 0x2d, 0x18, 0x60, 0x98, 0xf8, 0xf8, 0xc0,
+	0xd0, 0xe6, 0xb5, 0xc5, 0xa1, 0x8a, 0xac, 0xf4, 0x67, 0x50, 0xe0, 0x74, 0x1f, 0x13, 0x37, 0x24,
+	0x46, 0x7e, 0x5e, 0x5b, 0x1c, 0x5e, 0x9e, 0xb1, 0x45, 0x03, 0x3b, 0x69, 0xa0, 0xb4, 0xd8, 0xaf,
+	0x69, 0x48, 0x56, 0xfb, 0x8f, 0x4f, 0xe7, 0x72, 0x95, 0xc1, 0x14, 0x50, 0x26, 0xfa, 0x02, 0x8c,
+	0x0b, 0x2c, 0x8d, 0xb9, 0xeb, 0x63, 0x42, 0x23, 0xa3, 0x2f, 0x25, 0x1f, 0x4d, 0x1f, 0x6f, 0xc6,
+	0x7c, 0x2d, 0x79, 0xa8, 0xbf, 0x87, 0xc9, 0xd6, 0xbd, 0x28, 0x24, 0x2e, 0x4a, 0x55, 0x19, 0xfd,
+	0xc9, 0xe5, 0xd5, 0xbb, 0x09, 0xe9, 0x9f, 0xd3, 0xb9, 0x29, 0xd1, 0x96, 0xf9, 0xfb, 0x76, 0x48,
+	0x9d, 0x08, 0xf1, 0x5d, 0xbb, 0x4c, 0x78, 0xa5, 0xa8, 0xb8, 0x36, 0x42, 0x22, 0x7e, 0x8d, 0xbe,
+	0x02, 0x05, 0x76, 0x84, 0xea, 0xee, 0x0e, 0xc6, 0xc6, 0x8d, 0x94, 0xe3, 0xbe, 0xe4, 0xb8, 0xdd,
+	0xc9, 0xb1, 0x8e, 0x03, 0xe4, 0x7d, 0x5e, 0xc3, 0x5e, 0x65, 0x30, 0x01, 0xbd, 0xc1, 0xd8, 0xfa,
+	0xa2, 0x81, 0xd1, 0xe1, 0xd0, 0x56, 0xec, 0xd3, 0x0d, 0x16, 0xe8, 0x3b, 0x30, 0x99, 0x92, 0xe3,
+	0xe4, 0x50, 0x2a, 0x4d, 0xcc, 0xd1, 0x52, 0x73, 0x1c, 0xbb, 0xdb, 0xc4, 0xec, 0x0e, 0x56, 0x69,
+	0x59, 0x91, 0xb5, 0x1f, 0x58, 0xfb, 0x30, 0x7f, 0x95, 0x86, 0x0a, 0x66, 0x75, 0x4a, 0x18, 0xd6,
+	0xdf, 0xc2, 0x44, 0xcb, 0x38, 0x69, 0x9a, 0x96, 0xc5, 0xb4, 0x31, 0x65, 0x9a, 0x60, 0xb6, 0xbe,
+	0xe7, 0x41, 0x6f, 0xeb, 0xb6, 0x19, 0xf3, 0x2b, 0x43, 0xf1, 0x1c, 0x86, 0x9a, 0x7d, 0xb3, 0xa6,
+	0xa2, 0xa0, 0x5a, 0xea, 0x0f, 0x60, 0x4c, 0x45, 0xea, 0x52, 0x2a, 0x46, 0x64, 0x6e, 0x44, 0x28,
+	0xd6, 0xe1, 0x66, 0xf3, 0x56, 0x84, 0x1a, 0x3d, 0x65, 0x62, 0x42, 0x32, 0x6d, 0xa0, 0xc6, 0x35,
+	0x45, 0xe2, 0xab, 0x06, 0x33, 0x9d, 0x06, 0xa9, 0x4c, 0x84, 0x30, 0xd5, 0x99, 0x89, 0xc4, 0x1b,
+	0x11, 0x8a, 0x47, 0x3d, 0x85, 0x62, 0x33, 0xe6, 0xd2, 0x32, 0x9d, 0x75, 0x9c, 0x58, 0x7b, 0x70,
+	0xef, 0x4a, 0x1d, 0xcd, 0x5c, 0x94, 0xd4, 0xe2, 0xb5, 0x76, 0x29, 0x53, 0x2c, 0x46, 0xa5, 0x6f,
+	0x32, 0x15, 0x3f, 0x35, 0x28, 0xbc, 0xa3, 0x21, 0xf9, 0x40, 0x69, 0xed, 0xbf, 0x59, 0x60, 0xe2,
+	0x1f, 0xa2, 0x2f, 0x7b, 0x16, 0x58, 0x99, 0xe8, 0x2f, 0x61, 0x84, 0x53, 0x8e, 0x6a, 0x2e, 0xdb,
+	0x45, 0x07, 0x98, 0x19, 0x7d, 0x59, 0x64, 0x0e, 0xa7, 0x90, 0xad, 0x14, 0x61, 0x7d, 0x82, 0x71,
+	0xa5, 0x51, 0x8d, 0xa3, 0x0c, 0x43, 0x7b, 0x34, 0x24, 0x6e, 0xe2, 0xb2, 0x1c, 0xc1, 0x42, 0xf7,
+	0x11, 0x28, 0x06, 0xa5, 0x6f, 0x4f, 0xd6, 0x96, 0x0f, 0xd3, 0x6d, 0xec, 0x4d, 0x93, 0xcb, 0x50,
+	0x14, 0xa2, 0x7b, 0xde, 0xbe, 0x71, 0x81, 0x6b, 0xad, 0xdf, 0x2f, 0x0d, 0x0a, 0xa5, 0x46, 0xc8,
+	0xbb, 0x1a, 0x5d, 0x02, 0x81, 0xbb, 0x30, 0xd4, 0x7c, 0xa6, 0xa1, 0xa6, 0x28, 0x35, 0xd4, 0xeb,
+	0x71, 0x5c, 0x89, 0xbd, 0xe0, 0x38, 0x6e, 0x84, 0xbc, 0x07, 0xc7, 0x15, 0x83, 0x72, 0x1c, 0xcb,
+	0xda, 0xda, 0x86, 0xe9, 0x36, 0xf6, 0xa6, 0xe3, 0x2b, 0x00, 0x32, 0x6a, 0x62, 0xb7, 0x32, 0x65,
+	0x4d, 0xa6, 0x33, 0xd9, 0xaa, 0xed, 0xe3, 0x33, 0x53, 0x3b, 0x39, 0x33, 0xb5, 0xbf, 0x67, 0xa6,
+	0xf6, 0xed, 0xdc, 0xcc, 0x9d, 0x9c, 0x9b, 0xb9, 0xdf, 0xe7, 0x66, 0xee, 0xe3, 0x8b, 0x20, 0xe4,
+	0xbb, 0x71, 0xd5, 0xf6, 0x68, 0xe4, 0x48, 0xd9, 0x0f, 0x6b, 0xa8, 0xca, 0x54, 0xe1, 0x1c, 0x2e,
+	0x3f, 0x71, 0x1a, 0x97, 0xdf, 0xdf, 0xaa, 0x70, 0x22, 0x16, 0x54, 0x07, 0xd2, 0x77, 0xeb, 0xe3,
+	0x7f, 0x03, 0x00, 0xc6, 0xb7, 0x01, 0xd2, 0xeb, 0x07, 0x00, 0x00,
 }
 
 func (m *SwapExactAmountIn) Marshal() (dAtA []byte, err error) {
@@ -648,71 +944,311 @@ func (m *SwapExactAmountOutSudoMsgResponse) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintModuleSudoMsg(dAtA []byte, offset int, v uint64) int {
-	offset -= sovModuleSudoMsg(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *JoinPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *SwapExactAmountIn) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *JoinPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JoinPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovModuleSudoMsg(uint64(l))
+	{
+		size := m.TotalShares.Size()
+		i -= size
+		if _, err := m.TotalShares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintModuleSudoMsg(dAtA, i, uint64(size))
 	}
-	l = m.TokenIn.Size()
-	n += 1 + l + sovModuleSudoMsg(uint64(l))
-	l = len(m.TokenOutDenom)
-	if l > 0 {
-		n += 1 + l + sovModuleSudoMsg(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.TokensIn) > 0 {
+		for iNdEx := len(m.TokensIn) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokensIn[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModuleSudoMsg(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	l = m.TokenOutMinAmount.Size()
-	n += 1 + l + sovModuleSudoMsg(uint64(l))
-	l = m.SwapFee.Size()
-	n += 1 + l + sovModuleSudoMsg(uint64(l))
-	return n
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintModuleSudoMsg(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *SwapExactAmountInSudoMsg) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *JoinPoolSudoMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.SwapExactAmountIn.Size()
-	n += 1 + l + sovModuleSudoMsg(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *SwapExactAmountInSudoMsgResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.TokenOutAmount.Size()
-	n += 1 + l + sovModuleSudoMsg(uint64(l))
-	return n
+func (m *JoinPoolSudoMsg) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SwapExactAmountOut) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *JoinPoolSudoMsg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovModuleSudoMsg(uint64(l))
+	{
+		size, err := m.JoinPool.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintModuleSudoMsg(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *JoinPoolSudoMsgResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JoinPoolSudoMsgResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JoinPoolSudoMsgResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.SharesOutAmount.Size()
+		i -= size
+		if _, err := m.SharesOutAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintModuleSudoMsg(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ExitPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExitPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExitPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TotalShares.Size()
+		i -= size
+		if _, err := m.TotalShares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintModuleSudoMsg(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.ShareInAmount.Size()
+		i -= size
+		if _, err := m.ShareInAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintModuleSudoMsg(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintModuleSudoMsg(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExitPoolSudoMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExitPoolSudoMsg) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExitPoolSudoMsg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ExitPool.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintModuleSudoMsg(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ExitPoolSudoMsgResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExitPoolSudoMsgResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExitPoolSudoMsgResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokensOut) > 0 {
+		for iNdEx := len(m.TokensOut) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokensOut[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModuleSudoMsg(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintModuleSudoMsg(dAtA []byte, offset int, v uint64) int {
+	offset -= sovModuleSudoMsg(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SwapExactAmountIn) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovModuleSudoMsg(uint64(l))
+	}
+	l = m.TokenIn.Size()
+	n += 1 + l + sovModuleSudoMsg(uint64(l))
+	l = len(m.TokenOutDenom)
+	if l > 0 {
+		n += 1 + l + sovModuleSudoMsg(uint64(l))
+	}
+	l = m.TokenOutMinAmount.Size()
+	n += 1 + l + sovModuleSudoMsg(uint64(l))
+	l = m.SwapFee.Size()
+	n += 1 + l + sovModuleSudoMsg(uint64(l))
+	return n
+}
+
+func (m *SwapExactAmountInSudoMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SwapExactAmountIn.Size()
+	n += 1 + l + sovModuleSudoMsg(uint64(l))
+	return n
+}
+
+func (m *SwapExactAmountInSudoMsgResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TokenOutAmount.Size()
+	n += 1 + l + sovModuleSudoMsg(uint64(l))
+	return n
+}
+
+func (m *SwapExactAmountOut) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovModuleSudoMsg(uint64(l))
 	}
 	l = m.TokenOut.Size()
 	n += 1 + l + sovModuleSudoMsg(uint64(l))
@@ -731,31 +1267,797 @@ func (m *SwapExactAmountOutSudoMsg) Size() (n int) {
 	if m == nil {
 		return 0
 	}
-	var l int
-	_ = l
-	l = m.SwapExactAmountOut.Size()
-	n += 1 + l + sovModuleSudoMsg(uint64(l))
-	return n
-}
+	var l int
+	_ = l
+	l = m.SwapExactAmountOut.Size()
+	n += 1 + l + sovModuleSudoMsg(uint64(l))
+	return n
+}
+
+func (m *SwapExactAmountOutSudoMsgResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TokenInAmount.Size()
+	n += 1 + l + sovModuleSudoMsg(uint64(l))
+	return n
+}
+
+func (m *JoinPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovModuleSudoMsg(uint64(l))
+	}
+	if len(m.TokensIn) > 0 {
+		for _, e := range m.TokensIn {
+			l = e.Size()
+			n += 1 + l + sovModuleSudoMsg(uint64(l))
+		}
+	}
+	l = m.TotalShares.Size()
+	n += 1 + l + sovModuleSudoMsg(uint64(l))
+	return n
+}
+
+func (m *JoinPoolSudoMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.JoinPool.Size()
+	n += 1 + l + sovModuleSudoMsg(uint64(l))
+	return n
+}
+
+func (m *JoinPoolSudoMsgResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SharesOutAmount.Size()
+	n += 1 + l + sovModuleSudoMsg(uint64(l))
+	return n
+}
+
+func (m *ExitPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovModuleSudoMsg(uint64(l))
+	}
+	l = m.ShareInAmount.Size()
+	n += 1 + l + sovModuleSudoMsg(uint64(l))
+	l = m.TotalShares.Size()
+	n += 1 + l + sovModuleSudoMsg(uint64(l))
+	return n
+}
+
+func (m *ExitPoolSudoMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ExitPool.Size()
+	n += 1 + l + sovModuleSudoMsg(uint64(l))
+	return n
+}
+
+func (m *ExitPoolSudoMsgResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TokensOut) > 0 {
+		for _, e := range m.TokensOut {
+			l = e.Size()
+			n += 1 + l + sovModuleSudoMsg(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovModuleSudoMsg(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozModuleSudoMsg(x uint64) (n int) {
+	return sovModuleSudoMsg(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SwapExactAmountIn) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModuleSudoMsg
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SwapExactAmountIn: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SwapExactAmountIn: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModuleSudoMsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModuleSudoMsg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModuleSudoMsg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModuleSudoMsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModuleSudoMsg
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModuleSudoMsg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModuleSudoMsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModuleSudoMsg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModuleSudoMsg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenOutDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutMinAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModuleSudoMsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModuleSudoMsg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModuleSudoMsg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenOutMinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModuleSudoMsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModuleSudoMsg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModuleSudoMsg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SwapFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModuleSudoMsg(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModuleSudoMsg
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SwapExactAmountInSudoMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModuleSudoMsg
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SwapExactAmountInSudoMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SwapExactAmountInSudoMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapExactAmountIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModuleSudoMsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModuleSudoMsg
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModuleSudoMsg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SwapExactAmountIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModuleSudoMsg(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModuleSudoMsg
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SwapExactAmountInSudoMsgResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModuleSudoMsg
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SwapExactAmountInSudoMsgResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SwapExactAmountInSudoMsgResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModuleSudoMsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModuleSudoMsg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModuleSudoMsg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModuleSudoMsg(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModuleSudoMsg
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SwapExactAmountOut) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModuleSudoMsg
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SwapExactAmountOut: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SwapExactAmountOut: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModuleSudoMsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModuleSudoMsg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModuleSudoMsg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModuleSudoMsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModuleSudoMsg
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModuleSudoMsg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModuleSudoMsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModuleSudoMsg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModuleSudoMsg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenInDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInMaxAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModuleSudoMsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModuleSudoMsg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModuleSudoMsg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenInMaxAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModuleSudoMsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModuleSudoMsg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModuleSudoMsg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SwapFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModuleSudoMsg(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModuleSudoMsg
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SwapExactAmountOutSudoMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModuleSudoMsg
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SwapExactAmountOutSudoMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SwapExactAmountOutSudoMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapExactAmountOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModuleSudoMsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModuleSudoMsg
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModuleSudoMsg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SwapExactAmountOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModuleSudoMsg(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModuleSudoMsg
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *SwapExactAmountOutSudoMsgResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.TokenInAmount.Size()
-	n += 1 + l + sovModuleSudoMsg(uint64(l))
-	return n
-}
-
-func sovModuleSudoMsg(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozModuleSudoMsg(x uint64) (n int) {
-	return sovModuleSudoMsg(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *SwapExactAmountIn) Unmarshal(dAtA []byte) error {
+func (m *SwapExactAmountOutSudoMsgResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -778,15 +2080,15 @@ func (m *SwapExactAmountIn) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SwapExactAmountIn: wiretype end group for non-group")
+			return fmt.Errorf("proto: SwapExactAmountOutSudoMsgResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SwapExactAmountIn: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SwapExactAmountOutSudoMsgResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -814,44 +2116,63 @@ func (m *SwapExactAmountIn) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+			if err := m.TokenInAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModuleSudoMsg
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModuleSudoMsg(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthModuleSudoMsg
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthModuleSudoMsg
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JoinPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModuleSudoMsg
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 3:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JoinPool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JoinPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -879,13 +2200,13 @@ func (m *SwapExactAmountIn) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenOutDenom = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutMinAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokensIn", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModuleSudoMsg
@@ -895,29 +2216,29 @@ func (m *SwapExactAmountIn) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthModuleSudoMsg
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthModuleSudoMsg
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenOutMinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TokensIn = append(m.TokensIn, types.Coin{})
+			if err := m.TokensIn[len(m.TokensIn)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SwapFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalShares", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -945,7 +2266,7 @@ func (m *SwapExactAmountIn) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SwapFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TotalShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -970,7 +2291,7 @@ func (m *SwapExactAmountIn) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SwapExactAmountInSudoMsg) Unmarshal(dAtA []byte) error {
+func (m *JoinPoolSudoMsg) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -993,15 +2314,15 @@ func (m *SwapExactAmountInSudoMsg) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SwapExactAmountInSudoMsg: wiretype end group for non-group")
+			return fmt.Errorf("proto: JoinPoolSudoMsg: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SwapExactAmountInSudoMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JoinPoolSudoMsg: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SwapExactAmountIn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JoinPool", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1028,7 +2349,7 @@ func (m *SwapExactAmountInSudoMsg) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SwapExactAmountIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.JoinPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1053,7 +2374,7 @@ func (m *SwapExactAmountInSudoMsg) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SwapExactAmountInSudoMsgResponse) Unmarshal(dAtA []byte) error {
+func (m *JoinPoolSudoMsgResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1076,15 +2397,15 @@ func (m *SwapExactAmountInSudoMsgResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SwapExactAmountInSudoMsgResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: JoinPoolSudoMsgResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SwapExactAmountInSudoMsgResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JoinPoolSudoMsgResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SharesOutAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1112,7 +2433,7 @@ func (m *SwapExactAmountInSudoMsgResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SharesOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1137,7 +2458,7 @@ func (m *SwapExactAmountInSudoMsgResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SwapExactAmountOut) Unmarshal(dAtA []byte) error {
+func (m *ExitPool) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1160,10 +2481,10 @@ func (m *SwapExactAmountOut) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SwapExactAmountOut: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExitPool: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SwapExactAmountOut: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExitPool: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1200,72 +2521,7 @@ func (m *SwapExactAmountOut) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModuleSudoMsg
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthModuleSudoMsg
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthModuleSudoMsg
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.TokenOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenInDenom", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModuleSudoMsg
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthModuleSudoMsg
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthModuleSudoMsg
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TokenInDenom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenInMaxAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareInAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1293,13 +2549,13 @@ func (m *SwapExactAmountOut) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenInMaxAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ShareInAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SwapFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalShares", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1327,7 +2583,7 @@ func (m *SwapExactAmountOut) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SwapFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TotalShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1352,7 +2608,7 @@ func (m *SwapExactAmountOut) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SwapExactAmountOutSudoMsg) Unmarshal(dAtA []byte) error {
+func (m *ExitPoolSudoMsg) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1375,15 +2631,15 @@ func (m *SwapExactAmountOutSudoMsg) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SwapExactAmountOutSudoMsg: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExitPoolSudoMsg: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SwapExactAmountOutSudoMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExitPoolSudoMsg: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SwapExactAmountOut", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitPool", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1410,7 +2666,7 @@ func (m *SwapExactAmountOutSudoMsg) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SwapExactAmountOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ExitPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1435,7 +2691,7 @@ func (m *SwapExactAmountOutSudoMsg) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SwapExactAmountOutSudoMsgResponse) Unmarshal(dAtA []byte) error {
+func (m *ExitPoolSudoMsgResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1458,17 +2714,17 @@ func (m *SwapExactAmountOutSudoMsgResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SwapExactAmountOutSudoMsgResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExitPoolSudoMsgResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SwapExactAmountOutSudoMsgResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExitPoolSudoMsgResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenInAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokensOut", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModuleSudoMsg
@@ -1478,23 +2734,23 @@ func (m *SwapExactAmountOutSudoMsgResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthModuleSudoMsg
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthModuleSudoMsg
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenInAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TokensOut = append(m.TokensOut, types.Coin{})
+			if err := m.TokensOut[len(m.TokensOut)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
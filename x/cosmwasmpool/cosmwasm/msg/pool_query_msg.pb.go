@@ -339,8 +339,8 @@ func (m *GetTotalPoolLiquidityQueryMsg) GetGetTotalPoolLiquidity() EmptyStruct {
 }
 
 type GetTotalPoolLiquidityQueryMsgResponse struct {
-	// total_pool_liquidity is the total liquidity in the pool denominated in
-	// coins.
+	//  total_pool_liquidity is the total liquidity in the pool denominated in
+	//  coins.
 	TotalPoolLiquidity []types.Coin `protobuf:"bytes,1,rep,name=total_pool_liquidity,json=totalPoolLiquidity,proto3" json:"total_pool_liquidity"`
 }
 
@@ -476,6 +476,108 @@ func (m *GetTotalSharesQueryMsgResponse) GetTotalShares() string {
 	return ""
 }
 
+// ===================== GetCapabilitiesQueryMsg
+type GetCapabilitiesQueryMsg struct {
+	// get_capabilities is the structure containing request field of the
+	// capabilities query message.
+	GetCapabilities EmptyStruct `protobuf:"bytes,1,opt,name=get_capabilities,json=getCapabilities,proto3" json:"get_capabilities"`
+}
+
+func (m *GetCapabilitiesQueryMsg) Reset()         { *m = GetCapabilitiesQueryMsg{} }
+func (m *GetCapabilitiesQueryMsg) String() string { return proto.CompactTextString(m) }
+func (*GetCapabilitiesQueryMsg) ProtoMessage()    {}
+func (*GetCapabilitiesQueryMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3251d17e76ef0dc1, []int{10}
+}
+func (m *GetCapabilitiesQueryMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetCapabilitiesQueryMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetCapabilitiesQueryMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetCapabilitiesQueryMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCapabilitiesQueryMsg.Merge(m, src)
+}
+func (m *GetCapabilitiesQueryMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetCapabilitiesQueryMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCapabilitiesQueryMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCapabilitiesQueryMsg proto.InternalMessageInfo
+
+func (m *GetCapabilitiesQueryMsg) GetGetCapabilities() EmptyStruct {
+	if m != nil {
+		return m.GetCapabilities
+	}
+	return EmptyStruct{}
+}
+
+type GetCapabilitiesQueryMsgResponse struct {
+	// interface_version is the version of the pool contract interface
+	// implemented by the contract.
+	InterfaceVersion uint64 `protobuf:"varint,1,opt,name=interface_version,json=interfaceVersion,proto3" json:"interface_version,omitempty"`
+	// capabilities is the list of optional features supported by the contract.
+	Capabilities []string `protobuf:"bytes,2,rep,name=capabilities,proto3" json:"capabilities,omitempty"`
+}
+
+func (m *GetCapabilitiesQueryMsgResponse) Reset()         { *m = GetCapabilitiesQueryMsgResponse{} }
+func (m *GetCapabilitiesQueryMsgResponse) String() string { return proto.CompactTextString(m) }
+func (*GetCapabilitiesQueryMsgResponse) ProtoMessage()    {}
+func (*GetCapabilitiesQueryMsgResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3251d17e76ef0dc1, []int{11}
+}
+func (m *GetCapabilitiesQueryMsgResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetCapabilitiesQueryMsgResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetCapabilitiesQueryMsgResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetCapabilitiesQueryMsgResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCapabilitiesQueryMsgResponse.Merge(m, src)
+}
+func (m *GetCapabilitiesQueryMsgResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetCapabilitiesQueryMsgResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCapabilitiesQueryMsgResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCapabilitiesQueryMsgResponse proto.InternalMessageInfo
+
+func (m *GetCapabilitiesQueryMsgResponse) GetInterfaceVersion() uint64 {
+	if m != nil {
+		return m.InterfaceVersion
+	}
+	return 0
+}
+
+func (m *GetCapabilitiesQueryMsgResponse) GetCapabilities() []string {
+	if m != nil {
+		return m.Capabilities
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GetSwapFeeQueryMsg)(nil), "osmosis.cosmwasmpool.v1beta1.GetSwapFeeQueryMsg")
 	proto.RegisterType((*GetSwapFeeQueryMsgResponse)(nil), "osmosis.cosmwasmpool.v1beta1.GetSwapFeeQueryMsgResponse")
@@ -487,6 +589,8 @@ func init() {
 	proto.RegisterType((*GetTotalPoolLiquidityQueryMsgResponse)(nil), "osmosis.cosmwasmpool.v1beta1.GetTotalPoolLiquidityQueryMsgResponse")
 	proto.RegisterType((*GetTotalSharesQueryMsg)(nil), "osmosis.cosmwasmpool.v1beta1.GetTotalSharesQueryMsg")
 	proto.RegisterType((*GetTotalSharesQueryMsgResponse)(nil), "osmosis.cosmwasmpool.v1beta1.GetTotalSharesQueryMsgResponse")
+	proto.RegisterType((*GetCapabilitiesQueryMsg)(nil), "osmosis.cosmwasmpool.v1beta1.GetCapabilitiesQueryMsg")
+	proto.RegisterType((*GetCapabilitiesQueryMsgResponse)(nil), "osmosis.cosmwasmpool.v1beta1.GetCapabilitiesQueryMsgResponse")
 }
 
 func init() {
@@ -494,41 +598,46 @@ func init() {
 }
 
 var fileDescriptor_3251d17e76ef0dc1 = []byte{
-	// 544 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0x86, 0x63, 0x8a, 0x00, 0x4f, 0x4a, 0x69, 0xad, 0x82, 0xda, 0x40, 0xdd, 0x62, 0x84, 0x08,
-	0x48, 0xd8, 0x6a, 0x40, 0x42, 0xe2, 0x00, 0x22, 0x2d, 0xf4, 0x12, 0xa4, 0x26, 0xe1, 0x52, 0x84,
-	0x64, 0x6d, 0x9c, 0x65, 0x63, 0x61, 0x67, 0x9c, 0xcc, 0xa6, 0x21, 0xbc, 0x01, 0x37, 0x1e, 0xab,
-	0xc7, 0x1e, 0x11, 0x87, 0x0a, 0x25, 0x2f, 0x82, 0xd6, 0x71, 0x36, 0x89, 0x52, 0x72, 0xe8, 0x2d,
-	0x3b, 0x3b, 0xff, 0x7c, 0xf3, 0xcf, 0x6c, 0x0c, 0xaf, 0x90, 0x62, 0xa4, 0x90, 0xbc, 0x00, 0x29,
-	0xee, 0x33, 0x8a, 0x13, 0xc4, 0xc8, 0x3b, 0xdd, 0x6f, 0x70, 0xc9, 0xf6, 0xbd, 0x18, 0x9b, 0x3c,
-	0xf2, 0x54, 0xc8, 0xef, 0xf4, 0x78, 0x77, 0xe0, 0xc7, 0x24, 0xdc, 0xa4, 0x8b, 0x12, 0xad, 0x07,
-	0x99, 0xd0, 0x9d, 0x15, 0xba, 0x99, 0xb0, 0xb0, 0x29, 0x50, 0x60, 0x9a, 0xe8, 0xa9, 0x5f, 0x63,
-	0x4d, 0xc1, 0x0e, 0x52, 0x91, 0xd7, 0x60, 0xc4, 0x35, 0x23, 0xc0, 0xb0, 0x3d, 0xbe, 0x77, 0x04,
-	0x58, 0x47, 0x5c, 0xd6, 0xfb, 0x2c, 0xf9, 0xc0, 0x79, 0x55, 0x01, 0x3f, 0x92, 0xb0, 0xaa, 0xb0,
-	0x2a, 0xb8, 0xf4, 0xa9, 0xcf, 0x12, 0xff, 0x2b, 0xe7, 0x5b, 0xc6, 0x9e, 0x51, 0xcc, 0x97, 0x9e,
-	0xba, 0xcb, 0x1a, 0x70, 0xdf, 0xc7, 0x89, 0x1c, 0xd4, 0x65, 0xb7, 0x17, 0xc8, 0xf2, 0xf5, 0xb3,
-	0x8b, 0xdd, 0x5c, 0x0d, 0x84, 0x2e, 0xed, 0x7c, 0x81, 0xc2, 0x22, 0xa8, 0xc6, 0x29, 0xc1, 0x36,
-	0x71, 0xeb, 0x0d, 0xdc, 0xd2, 0xb0, 0x95, 0x3d, 0xa3, 0x68, 0x96, 0x1f, 0xa9, 0x0a, 0x7f, 0x2e,
-	0x76, 0xef, 0x8f, 0x0d, 0x50, 0xf3, 0x9b, 0x1b, 0xa2, 0x17, 0x33, 0xd9, 0x72, 0x2b, 0x5c, 0xb0,
-	0x60, 0x70, 0xc8, 0x83, 0xda, 0x4d, 0xca, 0xaa, 0x33, 0x30, 0xeb, 0x09, 0xca, 0xe3, 0x6e, 0x18,
-	0x70, 0xeb, 0x19, 0x6c, 0x74, 0x7a, 0x28, 0xb9, 0xcf, 0x88, 0xb8, 0xf4, 0x9b, 0xbc, 0x8d, 0x71,
-	0x6a, 0xc1, 0xac, 0xdd, 0x49, 0x2f, 0xde, 0xa9, 0xf8, 0xa1, 0x0a, 0x5b, 0x45, 0x58, 0x57, 0xa3,
-	0x99, 0x4b, 0xbd, 0x96, 0xa6, 0xae, 0xa9, 0xf8, 0x34, 0xd3, 0x61, 0xb0, 0xa1, 0x11, 0x7a, 0x50,
-	0x15, 0x00, 0x4a, 0x50, 0xfa, 0x89, 0x8a, 0x66, 0x63, 0x7a, 0xb2, 0x7c, 0x4c, 0xba, 0x48, 0x36,
-	0x24, 0x93, 0x26, 0x01, 0xe7, 0x35, 0x6c, 0x2f, 0x20, 0xf4, 0x88, 0x76, 0x16, 0x50, 0xe6, 0xac,
-	0xf6, 0x36, 0xe4, 0x67, 0x16, 0xe0, 0xfc, 0x34, 0x60, 0xe7, 0x88, 0xcb, 0x4f, 0x28, 0x59, 0x74,
-	0x8c, 0x18, 0x55, 0xc2, 0x4e, 0x2f, 0x6c, 0x86, 0x72, 0xa0, 0x5b, 0x6f, 0xc1, 0x96, 0xda, 0xb1,
-	0x54, 0x19, 0x7e, 0xfa, 0xde, 0xa2, 0x49, 0xce, 0x55, 0xf7, 0x7d, 0x57, 0x5c, 0x46, 0x74, 0x7e,
-	0xc0, 0xe3, 0xa5, 0xad, 0x68, 0x8b, 0x55, 0xd8, 0xfc, 0x4f, 0x3b, 0x2b, 0xc5, 0x7c, 0x69, 0xdb,
-	0x1d, 0x3f, 0x05, 0x57, 0x2d, 0x46, 0x77, 0x71, 0x80, 0x61, 0x3b, 0xc3, 0x5b, 0x72, 0x91, 0x4d,
-	0x70, 0x6f, 0xc2, 0xae, 0xb7, 0x58, 0x97, 0x93, 0xf6, 0x7f, 0x02, 0xeb, 0x53, 0xff, 0x94, 0xde,
-	0x5d, 0xd5, 0xf7, 0x9a, 0x98, 0x43, 0x38, 0x07, 0x60, 0x5f, 0x0e, 0xd5, 0x4e, 0x1f, 0xc2, 0xea,
-	0x02, 0xd8, 0xac, 0xe5, 0xe5, 0x54, 0x52, 0x3e, 0x39, 0x1b, 0xda, 0xc6, 0xf9, 0xd0, 0x36, 0xfe,
-	0x0e, 0x6d, 0xe3, 0xd7, 0xc8, 0xce, 0x9d, 0x8f, 0xec, 0xdc, 0xef, 0x91, 0x9d, 0xfb, 0xfc, 0x56,
-	0x84, 0xb2, 0xd5, 0x6b, 0xb8, 0x01, 0xc6, 0x5e, 0xd6, 0xe9, 0xf3, 0x88, 0x35, 0x68, 0x72, 0xf0,
-	0x4e, 0x4b, 0x2f, 0xbd, 0xef, 0xf3, 0x9f, 0x97, 0xc9, 0xc1, 0x8b, 0x49, 0x34, 0x6e, 0xa4, 0xff,
-	0xfd, 0x17, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0x9d, 0x9f, 0x65, 0xf8, 0x8a, 0x04, 0x00, 0x00,
+	// 611 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0x4d, 0x4f, 0xd4, 0x5c,
+	0x14, 0xc7, 0xa7, 0x40, 0x9e, 0xc7, 0x9e, 0x41, 0x5e, 0x1a, 0x54, 0x40, 0x29, 0x58, 0x63, 0x1c,
+	0x35, 0xb6, 0x01, 0x4d, 0x4c, 0x5c, 0x68, 0x04, 0x94, 0x0d, 0x26, 0xd0, 0x31, 0x26, 0x10, 0x93,
+	0xe6, 0x4e, 0xe7, 0x70, 0xe7, 0xc6, 0x76, 0x6e, 0xe9, 0xbd, 0x03, 0x8e, 0xdf, 0xc0, 0x9d, 0x1f,
+	0x8b, 0x25, 0x4b, 0xe3, 0x82, 0x18, 0xf8, 0x22, 0xe6, 0xb6, 0x9d, 0x4b, 0x9b, 0x01, 0x16, 0xb3,
+	0x6b, 0xcf, 0xdb, 0xef, 0xff, 0x3f, 0xa7, 0x29, 0xbc, 0xe6, 0x22, 0xe6, 0x82, 0x09, 0x2f, 0xe4,
+	0x22, 0x3e, 0x26, 0x22, 0x4e, 0x38, 0x8f, 0xbc, 0xa3, 0xd5, 0x16, 0x4a, 0xb2, 0xea, 0xc5, 0xbc,
+	0x8d, 0x91, 0xa7, 0x42, 0xc1, 0x61, 0x0f, 0xd3, 0x7e, 0x10, 0x0b, 0xea, 0x26, 0x29, 0x97, 0xdc,
+	0x7a, 0x50, 0x34, 0xba, 0xe5, 0x46, 0xb7, 0x68, 0x5c, 0x9c, 0xa3, 0x9c, 0xf2, 0xac, 0xd0, 0x53,
+	0x4f, 0x79, 0xcf, 0xa2, 0x1d, 0x66, 0x4d, 0x5e, 0x8b, 0x08, 0xd4, 0x8c, 0x90, 0xb3, 0x6e, 0x9e,
+	0x77, 0x28, 0x58, 0x5b, 0x28, 0x9b, 0xc7, 0x24, 0xf9, 0x88, 0xb8, 0xab, 0x80, 0x9f, 0x04, 0xb5,
+	0x76, 0x61, 0x92, 0xa2, 0x0c, 0xc4, 0x31, 0x49, 0x82, 0x03, 0xc4, 0x79, 0x63, 0xc5, 0x68, 0xd4,
+	0xd7, 0x9e, 0xba, 0x37, 0x09, 0x70, 0x3f, 0xc4, 0x89, 0xec, 0x37, 0x65, 0xda, 0x0b, 0xe5, 0xfa,
+	0xc4, 0xc9, 0xd9, 0x72, 0xcd, 0x07, 0xaa, 0x47, 0x3b, 0x5f, 0x61, 0x71, 0x18, 0xe4, 0xa3, 0x48,
+	0x78, 0x57, 0xa0, 0xf5, 0x16, 0x6e, 0x69, 0xd8, 0xf8, 0x8a, 0xd1, 0x30, 0xd7, 0x1f, 0xa9, 0x09,
+	0x7f, 0xce, 0x96, 0xef, 0xe7, 0x06, 0x44, 0xfb, 0x9b, 0xcb, 0xb8, 0x17, 0x13, 0xd9, 0x71, 0xb7,
+	0x91, 0x92, 0xb0, 0xbf, 0x89, 0xa1, 0xff, 0xbf, 0x28, 0xa6, 0x13, 0x30, 0x9b, 0x09, 0x97, 0x3b,
+	0x29, 0x0b, 0xd1, 0x7a, 0x06, 0xb3, 0x87, 0x3d, 0x2e, 0x31, 0x20, 0x42, 0xa0, 0x0c, 0xda, 0xd8,
+	0xe5, 0x71, 0x66, 0xc1, 0xf4, 0xa7, 0xb3, 0xc4, 0x7b, 0x15, 0xdf, 0x54, 0x61, 0xab, 0x01, 0x33,
+	0x6a, 0x35, 0x95, 0xd2, 0xb1, 0xac, 0x74, 0x4a, 0xc5, 0x2f, 0x2b, 0x1d, 0x02, 0xb3, 0x1a, 0xa1,
+	0x17, 0xb5, 0x0d, 0x20, 0x12, 0x2e, 0x83, 0x44, 0x45, 0x8b, 0x35, 0x3d, 0xb9, 0x79, 0x4d, 0x7a,
+	0x48, 0xb1, 0x24, 0x53, 0x0c, 0x02, 0xce, 0x1b, 0x58, 0x18, 0x42, 0xe8, 0x15, 0x2d, 0x0d, 0xa1,
+	0xcc, 0x72, 0xef, 0x6d, 0xa8, 0x97, 0x0e, 0xe0, 0xfc, 0x34, 0x60, 0x69, 0x0b, 0xe5, 0x67, 0x2e,
+	0x49, 0xb4, 0xc3, 0x79, 0xb4, 0xcd, 0x0e, 0x7b, 0xac, 0xcd, 0x64, 0x5f, 0x4b, 0xef, 0xc0, 0xbc,
+	0xba, 0xb1, 0x54, 0x15, 0x41, 0xf6, 0xbd, 0x45, 0x83, 0x9a, 0x51, 0xef, 0x7d, 0x87, 0x5e, 0x45,
+	0x74, 0x7e, 0xc0, 0xe3, 0x1b, 0xa5, 0x68, 0x8b, 0xbb, 0x30, 0x77, 0x8d, 0x9c, 0xf1, 0x46, 0x7d,
+	0x6d, 0xc1, 0xcd, 0x3f, 0x05, 0x57, 0x1d, 0x46, 0xab, 0xd8, 0xe0, 0xac, 0x5b, 0xe0, 0x2d, 0x39,
+	0xcc, 0x16, 0x70, 0x77, 0xc0, 0x6e, 0x76, 0x48, 0x8a, 0x42, 0xfb, 0xdf, 0x83, 0x99, 0x4b, 0xff,
+	0x22, 0xcb, 0x8d, 0xea, 0x7b, 0x8a, 0x56, 0x10, 0xce, 0x06, 0xd8, 0x57, 0x43, 0xb5, 0xd3, 0x87,
+	0x30, 0x39, 0x04, 0x36, 0xfd, 0xba, 0x2c, 0x0d, 0xe9, 0xc1, 0xbd, 0x2d, 0x94, 0x1b, 0x24, 0x21,
+	0x2d, 0x16, 0x31, 0xc9, 0x4a, 0xd2, 0xf7, 0x73, 0xe9, 0x61, 0x29, 0x37, 0xaa, 0xf4, 0x69, 0x5a,
+	0x65, 0x38, 0x29, 0x2c, 0x5f, 0x83, 0xd5, 0xe2, 0x9f, 0xc3, 0x2c, 0xeb, 0x4a, 0x4c, 0x0f, 0x48,
+	0x88, 0xc1, 0x11, 0xa6, 0x82, 0xf1, 0x6e, 0xc6, 0x9f, 0xf0, 0x67, 0x74, 0xe2, 0x4b, 0x1e, 0xb7,
+	0x1c, 0x98, 0xac, 0xe8, 0x1c, 0x5b, 0x19, 0x6f, 0x98, 0x7e, 0x25, 0xb6, 0xbe, 0x77, 0x72, 0x6e,
+	0x1b, 0xa7, 0xe7, 0xb6, 0xf1, 0xf7, 0xdc, 0x36, 0x7e, 0x5d, 0xd8, 0xb5, 0xd3, 0x0b, 0xbb, 0xf6,
+	0xfb, 0xc2, 0xae, 0xed, 0xbf, 0xa3, 0x4c, 0x76, 0x7a, 0x2d, 0x37, 0xe4, 0xb1, 0x57, 0x38, 0x7b,
+	0x11, 0x91, 0x96, 0x18, 0xbc, 0x78, 0x47, 0x6b, 0xaf, 0xbc, 0xef, 0xd5, 0x3f, 0xe9, 0xe0, 0xc5,
+	0x8b, 0x05, 0x6d, 0xfd, 0x97, 0xfd, 0xe6, 0x5e, 0xfe, 0x1b, 0x00, 0xfd, 0xa6, 0xbc, 0x04, 0x75,
+	0x05, 0x00, 0x00,
 }
 
 func (m *GetSwapFeeQueryMsg) Marshal() (dAtA []byte, err error) {
@@ -853,6 +962,76 @@ func (m *GetTotalSharesQueryMsgResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *GetCapabilitiesQueryMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetCapabilitiesQueryMsg) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetCapabilitiesQueryMsg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.GetCapabilities.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintPoolQueryMsg(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GetCapabilitiesQueryMsgResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetCapabilitiesQueryMsgResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetCapabilitiesQueryMsgResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Capabilities) > 0 {
+		for iNdEx := len(m.Capabilities) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Capabilities[iNdEx])
+			copy(dAtA[i:], m.Capabilities[iNdEx])
+			i = encodeVarintPoolQueryMsg(dAtA, i, uint64(len(m.Capabilities[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.InterfaceVersion != 0 {
+		i = encodeVarintPoolQueryMsg(dAtA, i, uint64(m.InterfaceVersion))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintPoolQueryMsg(dAtA []byte, offset int, v uint64) int {
 	offset -= sovPoolQueryMsg(v)
 	base := offset
@@ -986,6 +1165,35 @@ func (m *GetTotalSharesQueryMsgResponse) Size() (n int) {
 	return n
 }
 
+func (m *GetCapabilitiesQueryMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.GetCapabilities.Size()
+	n += 1 + l + sovPoolQueryMsg(uint64(l))
+	return n
+}
+
+func (m *GetCapabilitiesQueryMsgResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.InterfaceVersion != 0 {
+		n += 1 + sovPoolQueryMsg(uint64(m.InterfaceVersion))
+	}
+	if len(m.Capabilities) > 0 {
+		for _, s := range m.Capabilities {
+			l = len(s)
+			n += 1 + l + sovPoolQueryMsg(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovPoolQueryMsg(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1820,6 +2028,190 @@ func (m *GetTotalSharesQueryMsgResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *GetCapabilitiesQueryMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPoolQueryMsg
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetCapabilitiesQueryMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetCapabilitiesQueryMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GetCapabilities", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPoolQueryMsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPoolQueryMsg
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPoolQueryMsg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GetCapabilities.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPoolQueryMsg(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPoolQueryMsg
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetCapabilitiesQueryMsgResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPoolQueryMsg
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetCapabilitiesQueryMsgResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetCapabilitiesQueryMsgResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InterfaceVersion", wireType)
+			}
+			m.InterfaceVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPoolQueryMsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InterfaceVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Capabilities", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPoolQueryMsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPoolQueryMsg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPoolQueryMsg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Capabilities = append(m.Capabilities, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPoolQueryMsg(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPoolQueryMsg
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipPoolQueryMsg(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
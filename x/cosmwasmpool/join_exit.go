@@ -0,0 +1,167 @@
+// This file implements the poolmanagertypes.JoinExitPoolModuleI interface
+package cosmwasmpool
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils/cosmwasm"
+	"github.com/osmosis-labs/osmosis/v24/x/cosmwasmpool/cosmwasm/msg"
+	"github.com/osmosis-labs/osmosis/v24/x/cosmwasmpool/types"
+	"github.com/osmosis-labs/osmosis/v24/x/poolmanager/events"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v24/x/poolmanager/types"
+)
+
+// JoinPool adds the tokens in to the liquidity of a cosmwasm pool in exchange for LP shares of the pool.
+// The tokens in are sent to the contract, which returns the amount of shares to mint given the total
+// amount of shares before the join. The shares are then minted by the module and sent to the sender.
+//
+// Parameters:
+// - ctx: The context of the operation.
+// - sender: The address of the account joining the pool.
+// - pool: The liquidity pool to join.
+// - tokensIn: The tokens (assets) to add to the pool.
+// - shareOutMinAmount: The minimum amount of shares to receive.
+//
+// Returns:
+// - sdk.Coin: The shares minted to the sender.
+// - error: An error if the pool conversion fails, if the pool does not support joins and exits,
+// if the join operation fails or if the shares out amount is not positive or below the minimum.
+func (k Keeper) JoinPool(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	pool poolmanagertypes.PoolI,
+	tokensIn sdk.Coins,
+	shareOutMinAmount osmomath.Int,
+) (sdk.Coin, error) {
+	cosmwasmPool, err := k.asCosmwasmPool(pool)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := validateCapability(cosmwasmPool, types.CapabilityJoinExit); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	shareDenom := types.GetPoolShareDenom(pool.GetId())
+	totalShares := k.bankKeeper.GetSupply(ctx, shareDenom).Amount
+
+	// Send tokens in from sender to the pool
+	// We do this because sudo message does not support sending coins from the sender
+	if err := k.bankKeeper.SendCoins(ctx, sender, sdk.MustAccAddressFromBech32(cosmwasmPool.GetContractAddress()), tokensIn); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	request := msg.NewJoinPoolSudoMsg(sender.String(), tokensIn, totalShares)
+	response, err := cosmwasm.Sudo[msg.JoinPoolSudoMsg, msg.JoinPoolSudoMsgResponse](ctx, k.contractKeeper, cosmwasmPool.GetContractAddress(), request)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if response.SharesOutAmount.IsNil() || !response.SharesOutAmount.IsPositive() || response.SharesOutAmount.LT(shareOutMinAmount) {
+		return sdk.Coin{}, types.InsufficientSharesOutError{PoolId: pool.GetId(), SharesOutAmount: response.SharesOutAmount, ShareOutMinAmount: shareOutMinAmount}
+	}
+
+	// Mint the shares to the sender
+	sharesOut := sdk.NewCoin(shareDenom, response.SharesOutAmount)
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(sharesOut)); err != nil {
+		return sdk.Coin{}, err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, sdk.NewCoins(sharesOut)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	events.EmitAddLiquidityEvent(ctx, sender, pool.GetId(), tokensIn)
+
+	k.listeners.AfterCosmWasmPoolJoin(ctx, sender, pool.GetId(), tokensIn, sharesOut.Amount)
+
+	return sharesOut, nil
+}
+
+// ExitPool burns LP shares of a cosmwasm pool in exchange for a part of the pool liquidity.
+// The shares are burned by the module, and the contract returns the tokens out given the total
+// amount of shares before the exit. The tokens out are then sent from the contract to the sender.
+//
+// Parameters:
+// - ctx: The context of the operation.
+// - sender: The address of the account exiting the pool.
+// - pool: The liquidity pool to exit.
+// - shareInAmount: The amount of shares to burn.
+// - tokenOutMins: The minimum amounts of the tokens (assets) to receive.
+//
+// Returns:
+// - sdk.Coins: The tokens sent to the sender.
+// - error: An error if the pool conversion fails, if the pool does not support joins and exits,
+// if the exit operation fails or if any of the tokens out is below its minimum.
+func (k Keeper) ExitPool(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	pool poolmanagertypes.PoolI,
+	shareInAmount osmomath.Int,
+	tokenOutMins sdk.Coins,
+) (sdk.Coins, error) {
+	cosmwasmPool, err := k.asCosmwasmPool(pool)
+	if err != nil {
+		return sdk.Coins{}, err
+	}
+
+	if err := validateCapability(cosmwasmPool, types.CapabilityJoinExit); err != nil {
+		return sdk.Coins{}, err
+	}
+
+	shareDenom := types.GetPoolShareDenom(pool.GetId())
+	totalShares := k.bankKeeper.GetSupply(ctx, shareDenom).Amount
+
+	// Burn the shares of the sender
+	sharesIn := sdk.NewCoins(sdk.NewCoin(shareDenom, shareInAmount))
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, sharesIn); err != nil {
+		return sdk.Coins{}, err
+	}
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, sharesIn); err != nil {
+		return sdk.Coins{}, err
+	}
+
+	request := msg.NewExitPoolSudoMsg(sender.String(), shareInAmount, totalShares)
+	response, err := cosmwasm.Sudo[msg.ExitPoolSudoMsg, msg.ExitPoolSudoMsgResponse](ctx, k.contractKeeper, cosmwasmPool.GetContractAddress(), request)
+	if err != nil {
+		return sdk.Coins{}, err
+	}
+
+	tokensOut := sdk.Coins(response.TokensOut).Sort()
+	if err := tokensOut.Validate(); err != nil {
+		return sdk.Coins{}, err
+	}
+	if !tokensOut.IsAllGTE(tokenOutMins) {
+		return sdk.Coins{}, types.InsufficientTokensOutError{PoolId: pool.GetId(), TokensOut: tokensOut, TokenOutMins: tokenOutMins}
+	}
+
+	// Send tokens out from the pool to the sender
+	if err := k.bankKeeper.SendCoins(ctx, sdk.MustAccAddressFromBech32(cosmwasmPool.GetContractAddress()), sender, tokensOut); err != nil {
+		return sdk.Coins{}, err
+	}
+
+	events.EmitRemoveLiquidityEvent(ctx, sender, pool.GetId(), tokensOut)
+
+	k.listeners.AfterCosmWasmPoolExit(ctx, sender, pool.GetId(), shareInAmount, tokensOut)
+
+	return tokensOut, nil
+}
+
+// setPoolShareDenomMetadata sets the bank metadata of the LP shares of the given pool.
+func (k Keeper) setPoolShareDenomMetadata(ctx sdk.Context, poolId uint64) {
+	poolShareBaseDenom := types.GetPoolShareDenom(poolId)
+	k.bankKeeper.SetDenomMetaData(ctx, banktypes.Metadata{
+		Description: fmt.Sprintf("The share token of the cosmwasm pool %d", poolId),
+		DenomUnits: []*banktypes.DenomUnit{
+			{
+				Denom:    poolShareBaseDenom,
+				Exponent: 0,
+			},
+		},
+		Base:    poolShareBaseDenom,
+		Display: poolShareBaseDenom,
+	})
+}
@@ -0,0 +1,209 @@
+package cosmwasmpool_test
+
+import (
+	"encoding/json"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v24/x/cosmwasmpool/cosmwasm/msg"
+	"github.com/osmosis-labs/osmosis/v24/x/cosmwasmpool/types"
+)
+
+// joinExitContractKeeper answers the join_pool and exit_pool sudo messages with fixed responses,
+// since the transmuter contract only supports joins and exits through execute messages.
+// Every other message is forwarded to the wrapped contract keeper.
+type joinExitContractKeeper struct {
+	types.ContractKeeper
+
+	sharesOutAmount osmomath.Int
+	tokensOut       sdk.Coins
+
+	joinRequests []msg.JoinPool
+	exitRequests []msg.ExitPool
+}
+
+func (k *joinExitContractKeeper) Sudo(ctx sdk.Context, contractAddress sdk.AccAddress, sudoMsg []byte) ([]byte, error) {
+	switch {
+	case strings.HasPrefix(string(sudoMsg), `{"join_pool"`):
+		var request msg.JoinPoolSudoMsg
+		if err := json.Unmarshal(sudoMsg, &request); err != nil {
+			return nil, err
+		}
+		k.joinRequests = append(k.joinRequests, request.JoinPool)
+		return json.Marshal(msg.JoinPoolSudoMsgResponse{SharesOutAmount: k.sharesOutAmount})
+	case strings.HasPrefix(string(sudoMsg), `{"exit_pool"`):
+		var request msg.ExitPoolSudoMsg
+		if err := json.Unmarshal(sudoMsg, &request); err != nil {
+			return nil, err
+		}
+		k.exitRequests = append(k.exitRequests, request.ExitPool)
+		return json.Marshal(msg.ExitPoolSudoMsgResponse{TokensOut: k.tokensOut})
+	default:
+		return k.ContractKeeper.Sudo(ctx, contractAddress, sudoMsg)
+	}
+}
+
+// setupJoinExitPool creates a transmuter pool holding the initial liquidity and returns the contract keeper
+// answering its join and exit sudo messages. The pool declares the join exit capability unless withoutJoinExit is set.
+func (s *PoolModuleSuite) setupJoinExitPool(withoutJoinExit bool) (types.CosmWasmExtension, *joinExitContractKeeper) {
+	pool := s.PrepareCustomTransmuterPool(s.TestAccs[0], defaultDenoms)
+	s.FundAcc(s.TestAccs[0], initalDefaultSupply)
+	s.JoinTransmuterPool(s.TestAccs[0], pool.GetId(), initalDefaultSupply)
+
+	if !withoutJoinExit {
+		pool.SetCapabilities(types.MaxSupportedInterfaceVersion, []string{types.CapabilityJoinExit})
+		s.App.CosmwasmPoolKeeper.SetPool(s.Ctx, pool)
+	}
+
+	contractKeeper := &joinExitContractKeeper{ContractKeeper: s.App.ContractKeeper}
+	s.App.CosmwasmPoolKeeper.SetContractKeeper(contractKeeper)
+	return pool, contractKeeper
+}
+
+func (s *PoolModuleSuite) TestJoinPool() {
+	var (
+		tokensIn  = sdk.NewCoins(sdk.NewCoin(denomA, defaultAmount), sdk.NewCoin(denomB, defaultAmount))
+		sharesOut = osmomath.NewInt(200)
+	)
+
+	tests := map[string]struct {
+		sharesOutAmount   osmomath.Int
+		shareOutMinAmount osmomath.Int
+		withoutJoinExit   bool
+
+		expectedError error
+	}{
+		"valid join": {
+			sharesOutAmount:   sharesOut,
+			shareOutMinAmount: sharesOut,
+		},
+		"error: shares out below min": {
+			sharesOutAmount:   sharesOut,
+			shareOutMinAmount: sharesOut.Add(osmomath.OneInt()),
+			expectedError:     types.InsufficientSharesOutError{PoolId: defaultPoolId, SharesOutAmount: sharesOut, ShareOutMinAmount: sharesOut.Add(osmomath.OneInt())},
+		},
+		"error: zero shares out": {
+			sharesOutAmount:   osmomath.ZeroInt(),
+			shareOutMinAmount: osmomath.ZeroInt(),
+			expectedError:     types.InsufficientSharesOutError{PoolId: defaultPoolId, SharesOutAmount: osmomath.ZeroInt(), ShareOutMinAmount: osmomath.ZeroInt()},
+		},
+		"error: pool does not support join exit": {
+			sharesOutAmount:   sharesOut,
+			shareOutMinAmount: sharesOut,
+			withoutJoinExit:   true,
+			expectedError:     types.UnsupportedCapabilityError{PoolId: defaultPoolId, Capability: types.CapabilityJoinExit},
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		s.Run(name, func() {
+			s.Setup()
+			pool, contractKeeper := s.setupJoinExitPool(tc.withoutJoinExit)
+			contractKeeper.sharesOutAmount = tc.sharesOutAmount
+
+			sender := s.TestAccs[1]
+			s.FundAcc(sender, tokensIn)
+			contractAddr := sdk.MustAccAddressFromBech32(pool.GetContractAddress())
+			senderBalanceBefore := s.App.BankKeeper.GetAllBalances(s.Ctx, sender)
+			contractBalanceBefore := s.App.BankKeeper.GetAllBalances(s.Ctx, contractAddr)
+
+			shareOut, err := s.App.CosmwasmPoolKeeper.JoinPool(s.Ctx, sender, pool, tokensIn, tc.shareOutMinAmount)
+
+			if tc.expectedError != nil {
+				s.Require().ErrorContains(err, tc.expectedError.Error())
+				return
+			}
+			s.Require().NoError(err)
+
+			shareDenom := types.GetPoolShareDenom(pool.GetId())
+			s.Require().Equal(sdk.NewCoin(shareDenom, tc.sharesOutAmount), shareOut)
+			s.Require().Equal(shareOut, s.App.BankKeeper.GetBalance(s.Ctx, sender, shareDenom))
+			s.Require().Equal(shareOut, s.App.BankKeeper.GetSupply(s.Ctx, shareDenom))
+			s.Require().Equal(senderBalanceBefore.Sub(tokensIn...).Add(shareOut), s.App.BankKeeper.GetAllBalances(s.Ctx, sender))
+			s.Require().Equal(contractBalanceBefore.Add(tokensIn...), s.App.BankKeeper.GetAllBalances(s.Ctx, contractAddr))
+
+			// The contract is given the total shares before the join.
+			s.Require().Len(contractKeeper.joinRequests, 1)
+			s.Require().Equal(sender.String(), contractKeeper.joinRequests[0].Sender)
+			s.Require().Equal(osmomath.ZeroInt(), contractKeeper.joinRequests[0].TotalShares)
+		})
+	}
+}
+
+func (s *PoolModuleSuite) TestExitPool() {
+	var (
+		sharesIn  = osmomath.NewInt(100)
+		tokensOut = sdk.NewCoins(sdk.NewCoin(denomA, osmomath.NewInt(50)), sdk.NewCoin(denomB, osmomath.NewInt(50)))
+	)
+
+	tests := map[string]struct {
+		shareInAmount osmomath.Int
+		tokenOutMins  sdk.Coins
+
+		expectedError    error
+		expectedErrorMsg string
+	}{
+		"valid exit": {
+			shareInAmount: sharesIn,
+			tokenOutMins:  tokensOut,
+		},
+		"valid exit, no token out mins": {
+			shareInAmount: sharesIn,
+		},
+		"error: tokens out below min": {
+			shareInAmount: sharesIn,
+			tokenOutMins:  sdk.NewCoins(sdk.NewCoin(denomA, osmomath.NewInt(51))),
+			expectedError: types.InsufficientTokensOutError{PoolId: defaultPoolId, TokensOut: tokensOut, TokenOutMins: sdk.NewCoins(sdk.NewCoin(denomA, osmomath.NewInt(51)))},
+		},
+		"error: insufficient shares": {
+			shareInAmount:    sharesIn.Add(osmomath.OneInt()),
+			expectedErrorMsg: "insufficient funds",
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		s.Run(name, func() {
+			s.Setup()
+			pool, contractKeeper := s.setupJoinExitPool(false)
+
+			// Join the pool for the shares to exit with.
+			sender := s.TestAccs[1]
+			s.FundAcc(sender, initalDefaultSupply)
+			contractKeeper.sharesOutAmount = sharesIn
+			_, err := s.App.CosmwasmPoolKeeper.JoinPool(s.Ctx, sender, pool, initalDefaultSupply, osmomath.ZeroInt())
+			s.Require().NoError(err)
+
+			contractKeeper.tokensOut = tokensOut
+			contractAddr := sdk.MustAccAddressFromBech32(pool.GetContractAddress())
+			senderBalanceBefore := s.App.BankKeeper.GetAllBalances(s.Ctx, sender)
+			contractBalanceBefore := s.App.BankKeeper.GetAllBalances(s.Ctx, contractAddr)
+
+			actualTokensOut, err := s.App.CosmwasmPoolKeeper.ExitPool(s.Ctx, sender, pool, tc.shareInAmount, tc.tokenOutMins)
+
+			if tc.expectedError != nil {
+				s.Require().ErrorContains(err, tc.expectedError.Error())
+				return
+			}
+			if tc.expectedErrorMsg != "" {
+				s.Require().ErrorContains(err, tc.expectedErrorMsg)
+				return
+			}
+			s.Require().NoError(err)
+
+			shareDenom := types.GetPoolShareDenom(pool.GetId())
+			s.Require().Equal(tokensOut, actualTokensOut)
+			s.Require().Equal(senderBalanceBefore.Sub(sdk.NewCoin(shareDenom, tc.shareInAmount)).Add(tokensOut...), s.App.BankKeeper.GetAllBalances(s.Ctx, sender))
+			s.Require().Equal(contractBalanceBefore.Sub(tokensOut...), s.App.BankKeeper.GetAllBalances(s.Ctx, contractAddr))
+			s.Require().True(s.App.BankKeeper.GetSupply(s.Ctx, shareDenom).IsZero())
+
+			// The contract is given the total shares before the exit.
+			s.Require().Len(contractKeeper.exitRequests, 1)
+			s.Require().Equal(tc.shareInAmount, contractKeeper.exitRequests[0].ShareInAmount)
+			s.Require().Equal(sharesIn, contractKeeper.exitRequests[0].TotalShares)
+		})
+	}
+}
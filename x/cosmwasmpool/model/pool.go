@@ -31,8 +31,9 @@ type Pool struct {
 }
 
 var (
-	_ poolmanagertypes.PoolI  = &Pool{}
-	_ types.CosmWasmExtension = &Pool{}
+	_ poolmanagertypes.PoolI                = &Pool{}
+	_ poolmanagertypes.TakerFeeHandlingPool = &Pool{}
+	_ types.CosmWasmExtension               = &Pool{}
 )
 
 // NewCosmWasmPool creates a new CosmWasm pool with the specified parameters.
@@ -145,10 +146,12 @@ func (p *Pool) GetPoolDenoms(ctx sdk.Context) []string {
 
 func (p Pool) AsSerializablePool() poolmanagertypes.PoolI {
 	return &CosmWasmPool{
-		ContractAddress: p.ContractAddress,
-		PoolId:          p.PoolId,
-		CodeId:          p.CodeId,
-		InstantiateMsg:  p.InstantiateMsg,
+		ContractAddress:  p.ContractAddress,
+		PoolId:           p.PoolId,
+		CodeId:           p.CodeId,
+		InstantiateMsg:   p.InstantiateMsg,
+		InterfaceVersion: p.InterfaceVersion,
+		Capabilities:     p.Capabilities,
 	}
 }
 
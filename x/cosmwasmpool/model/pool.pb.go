@@ -45,6 +45,13 @@ type CosmWasmPool struct {
 	PoolId          uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
 	CodeId          uint64 `protobuf:"varint,3,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty"`
 	InstantiateMsg  []byte `protobuf:"bytes,4,opt,name=instantiate_msg,json=instantiateMsg,proto3" json:"instantiate_msg,omitempty" yaml:"instantiate_msg"`
+	// interface_version is the version of the pool contract interface reported
+	// by the contract at pool creation. Zero for contracts that predate
+	// capability discovery.
+	InterfaceVersion uint64 `protobuf:"varint,5,opt,name=interface_version,json=interfaceVersion,proto3" json:"interface_version,omitempty" yaml:"interface_version"`
+	// capabilities is the list of optional features the contract declared
+	// support for at pool creation.
+	Capabilities []string `protobuf:"bytes,6,rep,name=capabilities,proto3" json:"capabilities,omitempty" yaml:"capabilities"`
 }
 
 func (m *CosmWasmPool) Reset()      { *m = CosmWasmPool{} }
@@ -88,28 +95,32 @@ func init() {
 }
 
 var fileDescriptor_a0cb64564a744af1 = []byte{
-	// 331 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0xcd, 0x2f, 0xce, 0xcd,
-	0x2f, 0xce, 0x2c, 0xd6, 0x4f, 0xce, 0x2f, 0xce, 0x2d, 0x4f, 0x2c, 0xce, 0x2d, 0xc8, 0xcf, 0xcf,
-	0xd1, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0xcf, 0xcd, 0x4f, 0x49, 0xcd, 0xd1, 0x07,
-	0x09, 0xe9, 0x15, 0x14, 0xe5, 0x97, 0xe4, 0x0b, 0xc9, 0x40, 0x95, 0xeb, 0x21, 0x2b, 0xd7, 0x83,
-	0x2a, 0x97, 0x92, 0x4c, 0x06, 0x4b, 0xc7, 0x83, 0xd5, 0xea, 0x43, 0x38, 0x10, 0x8d, 0x52, 0x22,
-	0xe9, 0xf9, 0xe9, 0xf9, 0x10, 0x71, 0x10, 0x0b, 0x22, 0xaa, 0xf4, 0x80, 0x91, 0x8b, 0xc7, 0x39,
-	0xbf, 0x38, 0x37, 0x3c, 0xb1, 0x38, 0x37, 0x20, 0x3f, 0x3f, 0x47, 0xc8, 0x8d, 0x4b, 0x20, 0x39,
-	0x3f, 0xaf, 0xa4, 0x28, 0x31, 0xb9, 0x24, 0x3e, 0x31, 0x25, 0xa5, 0x28, 0xb5, 0xb8, 0x58, 0x82,
-	0x51, 0x81, 0x51, 0x83, 0xd3, 0x49, 0xfa, 0xd3, 0x3d, 0x79, 0xf1, 0xca, 0xc4, 0xdc, 0x1c, 0x2b,
-	0x25, 0x74, 0x15, 0x4a, 0x41, 0xfc, 0x30, 0x21, 0x47, 0x88, 0x88, 0x90, 0x38, 0x17, 0x3b, 0xc8,
-	0x65, 0xf1, 0x99, 0x29, 0x12, 0x4c, 0x0a, 0x8c, 0x1a, 0x2c, 0x41, 0x6c, 0x20, 0xae, 0x67, 0x0a,
-	0x48, 0x22, 0x39, 0x3f, 0x25, 0x15, 0x24, 0xc1, 0x0c, 0x91, 0x00, 0x71, 0x3d, 0x53, 0x84, 0x9c,
-	0xb9, 0xf8, 0x33, 0xf3, 0x8a, 0x4b, 0x12, 0xf3, 0x4a, 0x32, 0x13, 0x4b, 0x52, 0xe3, 0x73, 0x8b,
-	0xd3, 0x25, 0x58, 0x14, 0x18, 0x35, 0x78, 0x9c, 0xa4, 0x3e, 0xdd, 0x93, 0x17, 0x83, 0x58, 0x8c,
-	0xa6, 0x40, 0x29, 0x88, 0x0f, 0x49, 0xc4, 0xb7, 0x38, 0xdd, 0x4a, 0xb0, 0x63, 0x81, 0x3c, 0xc3,
-	0x8c, 0x05, 0xf2, 0x0c, 0xa7, 0xb6, 0xe8, 0xb2, 0x82, 0x3c, 0xe4, 0xe9, 0x14, 0x74, 0xe2, 0x91,
-	0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1,
-	0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x16, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a,
-	0xc9, 0xf9, 0xb9, 0xfa, 0xd0, 0x60, 0xd5, 0xcd, 0x49, 0x4c, 0x2a, 0x86, 0x71, 0xf4, 0xcb, 0x8c,
-	0x4c, 0xf4, 0x2b, 0x50, 0x23, 0x06, 0x1c, 0x21, 0x49, 0x6c, 0xe0, 0xd0, 0x33, 0x06, 0x04, 0x00,
-	0x00, 0xff, 0xff, 0x8b, 0x90, 0xe7, 0x76, 0xbd, 0x01, 0x00, 0x00,
+	// 400 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x92, 0x3f, 0x8f, 0x94, 0x40,
+	0x18, 0xc6, 0xe1, 0xfe, 0xac, 0x39, 0xb2, 0xf1, 0xee, 0xd0, 0xb8, 0xb8, 0x5e, 0x80, 0x50, 0xd1,
+	0x2c, 0x93, 0x53, 0x0b, 0x73, 0x56, 0x72, 0x89, 0x09, 0x85, 0x89, 0xa1, 0xd0, 0xc4, 0x86, 0x0c,
+	0xc3, 0x88, 0x93, 0x30, 0xbc, 0x1b, 0x66, 0x5c, 0xf5, 0x1b, 0x58, 0x5a, 0x5a, 0xee, 0x87, 0xf0,
+	0x43, 0x18, 0xab, 0x2d, 0xad, 0x88, 0xd9, 0x6d, 0xac, 0xf9, 0x04, 0x66, 0x18, 0xd6, 0xec, 0x6e,
+	0xc7, 0xf3, 0x7b, 0x7e, 0x30, 0x64, 0xde, 0xd7, 0x9a, 0x81, 0xe0, 0x20, 0x98, 0x40, 0x04, 0x04,
+	0xff, 0x84, 0x05, 0x9f, 0x03, 0x54, 0x68, 0x71, 0x9d, 0x53, 0x89, 0xaf, 0x11, 0x87, 0x82, 0x56,
+	0x48, 0xa1, 0x68, 0xde, 0x80, 0x04, 0xfb, 0x6a, 0xd0, 0xa3, 0x5d, 0x3d, 0x1a, 0xf4, 0xe9, 0x43,
+	0xd2, 0xd7, 0x59, 0xef, 0x22, 0x1d, 0xf4, 0x8b, 0xd3, 0xfb, 0x25, 0x94, 0xa0, 0xb9, 0x7a, 0xd2,
+	0x34, 0xf8, 0x7b, 0x64, 0x8d, 0x6f, 0x41, 0xf0, 0xb7, 0x58, 0xf0, 0xd7, 0x00, 0x95, 0xfd, 0xd2,
+	0xba, 0x20, 0x50, 0xcb, 0x06, 0x13, 0x99, 0xe1, 0xa2, 0x68, 0xa8, 0x10, 0x8e, 0xe9, 0x9b, 0xe1,
+	0x59, 0xfc, 0xa8, 0x6b, 0xbd, 0xc9, 0x17, 0xcc, 0xab, 0x9b, 0xe0, 0xd0, 0x08, 0xd2, 0xf3, 0x2d,
+	0x7a, 0xa1, 0x89, 0x3d, 0xb1, 0xee, 0xa8, 0x3f, 0xcb, 0x58, 0xe1, 0x1c, 0xf9, 0x66, 0x78, 0x92,
+	0x8e, 0x54, 0x4c, 0x0a, 0x55, 0x10, 0x28, 0xa8, 0x2a, 0x8e, 0x75, 0xa1, 0x62, 0x52, 0xd8, 0xb7,
+	0xd6, 0x39, 0xab, 0x85, 0xc4, 0xb5, 0x64, 0x58, 0xd2, 0x8c, 0x8b, 0xd2, 0x39, 0xf1, 0xcd, 0x70,
+	0x1c, 0x4f, 0xbb, 0xd6, 0x7b, 0xa0, 0x0f, 0x3e, 0x10, 0x82, 0xf4, 0xee, 0x0e, 0x79, 0x25, 0x4a,
+	0x3b, 0xb1, 0x2e, 0x59, 0x2d, 0x69, 0xf3, 0x1e, 0x13, 0x9a, 0x2d, 0x68, 0x23, 0x18, 0xd4, 0xce,
+	0xa9, 0x3a, 0x27, 0xbe, 0xea, 0x5a, 0xcf, 0xd9, 0x7e, 0xe6, 0x40, 0x09, 0xd2, 0x8b, 0xff, 0xec,
+	0x8d, 0x46, 0xf6, 0x73, 0x6b, 0x4c, 0xf0, 0x1c, 0xe7, 0xac, 0x62, 0x92, 0x51, 0xe1, 0x8c, 0xfc,
+	0xe3, 0xf0, 0x2c, 0x9e, 0x74, 0xad, 0x77, 0x6f, 0xb8, 0x85, 0x9d, 0x36, 0x48, 0xf7, 0xe4, 0x9b,
+	0xcb, 0xaf, 0x4b, 0xcf, 0xf8, 0xbe, 0xf4, 0x8c, 0x5f, 0x3f, 0x66, 0xa7, 0xea, 0x62, 0x93, 0x38,
+	0xfd, 0xb9, 0x76, 0xcd, 0xd5, 0xda, 0x35, 0xff, 0xac, 0x5d, 0xf3, 0xdb, 0xc6, 0x35, 0x56, 0x1b,
+	0xd7, 0xf8, 0xbd, 0x71, 0x8d, 0x77, 0xcf, 0x4a, 0x26, 0x3f, 0x7c, 0xcc, 0x23, 0x02, 0x1c, 0x0d,
+	0xe3, 0x9d, 0x55, 0x38, 0x17, 0xdb, 0x80, 0x16, 0x8f, 0x9f, 0xa2, 0xcf, 0xfb, 0x0b, 0xd2, 0x2f,
+	0x46, 0x3e, 0xea, 0xa7, 0xf8, 0xe4, 0xdf, 0x00, 0x4b, 0xf0, 0x5e, 0xcc, 0x45, 0x02, 0x00, 0x00,
 }
 
 func (m *CosmWasmPool) Marshal() (dAtA []byte, err error) {
@@ -132,6 +143,20 @@ func (m *CosmWasmPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Capabilities) > 0 {
+		for iNdEx := len(m.Capabilities) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Capabilities[iNdEx])
+			copy(dAtA[i:], m.Capabilities[iNdEx])
+			i = encodeVarintPool(dAtA, i, uint64(len(m.Capabilities[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.InterfaceVersion != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.InterfaceVersion))
+		i--
+		dAtA[i] = 0x28
+	}
 	if len(m.InstantiateMsg) > 0 {
 		i -= len(m.InstantiateMsg)
 		copy(dAtA[i:], m.InstantiateMsg)
@@ -190,6 +215,15 @@ func (m *CosmWasmPool) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovPool(uint64(l))
 	}
+	if m.InterfaceVersion != 0 {
+		n += 1 + sovPool(uint64(m.InterfaceVersion))
+	}
+	if len(m.Capabilities) > 0 {
+		for _, s := range m.Capabilities {
+			l = len(s)
+			n += 1 + l + sovPool(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -332,6 +366,57 @@ func (m *CosmWasmPool) Unmarshal(dAtA []byte) error {
 				m.InstantiateMsg = []byte{}
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InterfaceVersion", wireType)
+			}
+			m.InterfaceVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InterfaceVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Capabilities", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Capabilities = append(m.Capabilities, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPool(dAtA[iNdEx:])
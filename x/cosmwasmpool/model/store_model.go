@@ -106,3 +106,31 @@ func (p *CosmWasmPool) GetPoolDenoms(ctx sdk.Context) []string {
 func (p *CosmWasmPool) SetCodeId(codeId uint64) {
 	p.CodeId = codeId
 }
+
+// GetInterfaceVersion implements types.CosmWasmExtension.
+func (p CosmWasmPool) GetInterfaceVersion() uint64 {
+	return p.InterfaceVersion
+}
+
+// GetCapabilities implements types.CosmWasmExtension.
+func (p CosmWasmPool) GetCapabilities() []string {
+	return p.Capabilities
+}
+
+// SetCapabilities implements types.CosmWasmExtension.
+func (p *CosmWasmPool) SetCapabilities(interfaceVersion uint64, capabilities []string) {
+	p.InterfaceVersion = interfaceVersion
+	p.Capabilities = capabilities
+}
+
+// SupportsCapability implements types.CosmWasmExtension.
+// Pools created from contracts that predate capability discovery
+// support the legacy capability set.
+func (p CosmWasmPool) SupportsCapability(capability string) bool {
+	return types.SupportsCapability(p.InterfaceVersion, p.Capabilities, capability)
+}
+
+// HandlesTakerFee implements poolmanagertypes.TakerFeeHandlingPool.
+func (p CosmWasmPool) HandlesTakerFee() bool {
+	return p.SupportsCapability(types.CapabilityCustomTakerFee)
+}
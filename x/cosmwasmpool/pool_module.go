@@ -32,6 +32,7 @@ var (
 // - error:
 // * if the pool conversion, contract instantiation, or storage process fails.
 // * if the code id is not whitelisted by governance.
+// * if the contract reports an unsupported interface version or unknown capabilities.
 // - otherwise, nil.
 func (k Keeper) InitializePool(ctx sdk.Context, pool poolmanagertypes.PoolI, creatorAddress sdk.AccAddress) error {
 	// Convert the pool to CosmWasmPool
@@ -59,9 +60,48 @@ func (k Keeper) InitializePool(ctx sdk.Context, pool poolmanagertypes.PoolI, cre
 	// Store the address in pool model
 	cosmwasmPool.SetContractAddress(contractAddress.String())
 
+	// Discover which optional features the contract supports
+	interfaceVersion, capabilities, err := k.queryCapabilities(ctx, contractAddress.String())
+	if err != nil {
+		return err
+	}
+	cosmwasmPool.SetCapabilities(interfaceVersion, capabilities)
+
+	// Register the LP share denom of pools that can be joined and exited
+	if cosmwasmPool.SupportsCapability(types.CapabilityJoinExit) {
+		k.setPoolShareDenomMetadata(ctx, cosmwasmPool.GetId())
+	}
+
 	// Store the pool model
 	k.SetPool(ctx, cosmwasmPool)
 
+	k.listeners.AfterCosmWasmPoolCreated(ctx, creatorAddress, cosmwasmPool.GetId())
+
+	return nil
+}
+
+// queryCapabilities queries the interface version and optional capabilities of the pool contract.
+// Contracts that fail the query predate capability discovery and are assigned the legacy interface version.
+// Returns an error if the contract reports an unsupported interface version or unknown capabilities.
+func (k Keeper) queryCapabilities(ctx sdk.Context, contractAddress string) (uint64, []string, error) {
+	request := msg.GetCapabilitiesQueryMsg{}
+	response, err := cosmwasm.Query[msg.GetCapabilitiesQueryMsg, msg.GetCapabilitiesQueryMsgResponse](ctx, k.wasmKeeper, contractAddress, request)
+	if err != nil {
+		return types.LegacyInterfaceVersion, nil, nil
+	}
+
+	if err := types.ValidateCapabilities(response.InterfaceVersion, response.Capabilities); err != nil {
+		return 0, nil, err
+	}
+
+	return response.InterfaceVersion, response.Capabilities, nil
+}
+
+// validateCapability returns an error if the given pool does not support the given capability.
+func validateCapability(cosmwasmPool types.CosmWasmExtension, capability string) error {
+	if !cosmwasmPool.SupportsCapability(capability) {
+		return types.UnsupportedCapabilityError{PoolId: cosmwasmPool.GetId(), Capability: capability}
+	}
 	return nil
 }
 
@@ -249,6 +289,8 @@ func (k Keeper) SwapExactAmountIn(
 	// Each new pool module will have to emit this event separately
 	events.EmitSwapEvent(ctx, sender, pool.GetId(), sdk.Coins{tokenIn}, sdk.Coins{sdk.Coin{Denom: tokenOutDenom, Amount: response.TokenOutAmount}})
 
+	k.listeners.AfterCosmWasmPoolSwap(ctx, sender, pool.GetId(), sdk.Coins{tokenIn}, sdk.Coins{sdk.Coin{Denom: tokenOutDenom, Amount: response.TokenOutAmount}})
+
 	return response.TokenOutAmount, nil
 }
 
@@ -298,7 +340,7 @@ func (k Keeper) CalcOutAmtGivenIn(
 //
 // Returns:
 // - osmomath.Int: The actual amount of the input token used in the swap.
-// - error: An error if the swap operation fails or if the pool conversion fails.
+// - error: An error if the swap operation fails, if the pool conversion fails or if the pool does not support exact amount out swaps.
 func (k Keeper) SwapExactAmountOut(
 	ctx sdk.Context,
 	sender sdk.AccAddress,
@@ -313,6 +355,10 @@ func (k Keeper) SwapExactAmountOut(
 		return osmomath.Int{}, err
 	}
 
+	if err := validateCapability(cosmwasmPool, types.CapabilitySwapExactAmountOut); err != nil {
+		return osmomath.Int{}, err
+	}
+
 	contractAddr := sdk.MustAccAddressFromBech32(cosmwasmPool.GetContractAddress())
 
 	// Send token in max amount from sender to the pool
@@ -356,6 +402,8 @@ func (k Keeper) SwapExactAmountOut(
 	// Each new pool module will have to emit this event separately
 	events.EmitSwapEvent(ctx, sender, pool.GetId(), sdk.Coins{sdk.Coin{Denom: tokenInDenom, Amount: response.TokenInAmount}}, sdk.Coins{tokenOut})
 
+	k.listeners.AfterCosmWasmPoolSwap(ctx, sender, pool.GetId(), sdk.Coins{sdk.Coin{Denom: tokenInDenom, Amount: response.TokenInAmount}}, sdk.Coins{tokenOut})
+
 	return response.TokenInAmount, nil
 }
 
@@ -370,7 +418,7 @@ func (k Keeper) SwapExactAmountOut(
 //
 // Returns:
 // - sdk.Coin: The calculated input token amount.
-// - error: An error if the calculation fails or if the pool conversion fails.
+// - error: An error if the calculation fails, if the pool conversion fails or if the pool does not support exact amount out swaps.
 func (k Keeper) CalcInAmtGivenOut(
 	ctx sdk.Context,
 	poolI poolmanagertypes.PoolI,
@@ -383,6 +431,10 @@ func (k Keeper) CalcInAmtGivenOut(
 		return sdk.Coin{}, err
 	}
 
+	if err := validateCapability(cosmwasmPool, types.CapabilitySwapExactAmountOut); err != nil {
+		return sdk.Coin{}, err
+	}
+
 	request := msg.NewCalcInAmtGivenOutRequest(tokenInDenom, tokenOut, swapFee)
 	response, err := cosmwasm.Query[msg.CalcInAmtGivenOutRequest, msg.CalcInAmtGivenOutResponse](ctx, k.wasmKeeper, cosmwasmPool.GetContractAddress(), request)
 	if err != nil {
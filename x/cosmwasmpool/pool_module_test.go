@@ -84,6 +84,8 @@ func (s *PoolModuleSuite) TestInitializePool() {
 			var testPool poolmanagertypes.PoolI
 			if !tc.isInvalidPoolType {
 				testPool = model.NewCosmWasmPool(defaultPoolId, tc.codeid, tc.instantiateMsg)
+				// Poolmanager routes the pool before initializing it, so that listeners can get it.
+				s.App.PoolManagerKeeper.SetPoolRoute(s.Ctx, defaultPoolId, poolmanagertypes.CosmWasm)
 			} else {
 				testPool = s.PrepareConcentratedPool()
 			}
@@ -122,6 +124,13 @@ func (s *PoolModuleSuite) TestInitializePool() {
 
 			// Validate that the pool's instantiate msg is set
 			s.Require().Equal(tc.instantiateMsg, cosmWasmPool.GetInstantiateMsg())
+
+			// The transmuter does not implement the capabilities query,
+			// so the pool is assigned the legacy interface version and capabilities.
+			s.Require().Equal(types.LegacyInterfaceVersion, cosmWasmPool.GetInterfaceVersion())
+			s.Require().Empty(cosmWasmPool.GetCapabilities())
+			s.Require().True(cosmWasmPool.SupportsCapability(types.CapabilitySwapExactAmountOut))
+			s.Require().False(cosmWasmPool.SupportsCapability(types.CapabilityTwapHooks))
 		})
 	}
 }
@@ -293,6 +302,7 @@ func (s *PoolModuleSuite) TestCalcInAmtGivenOut_SwapInAmtGivenOut() {
 		tokenInMaxAmount osmomath.Int
 		swapFee          osmomath.Dec
 		isInvalidPool    bool
+		withoutExactOut  bool
 
 		expectedTokenIn      sdk.Coin
 		expectedErrorMessage string
@@ -339,6 +349,19 @@ func (s *PoolModuleSuite) TestCalcInAmtGivenOut_SwapInAmtGivenOut() {
 				ActualPool: &clmodel.Pool{},
 			}.Error(),
 		},
+		"pool does not support exact amount out": {
+			initialCoins:     initalDefaultSupply,
+			tokenOut:         sdk.NewCoin(denomA, defaultAmount.Sub(osmomath.OneInt())),
+			tokenInDenom:     denomB,
+			tokenInMaxAmount: defaultAmount,
+			swapFee:          osmomath.ZeroDec(),
+			withoutExactOut:  true,
+
+			expectedErrorMessage: types.UnsupportedCapabilityError{
+				PoolId:     defaultPoolId,
+				Capability: types.CapabilitySwapExactAmountOut,
+			}.Error(),
+		},
 	}
 
 	for name, tc := range tests {
@@ -365,6 +388,10 @@ func (s *PoolModuleSuite) TestCalcInAmtGivenOut_SwapInAmtGivenOut() {
 
 			originalPoolBalances := s.App.BankKeeper.GetAllBalances(s.Ctx, sdk.MustAccAddressFromBech32(pool.GetContractAddress()))
 
+			if tc.withoutExactOut {
+				pool.SetCapabilities(types.MaxSupportedInterfaceVersion, nil)
+			}
+
 			var poolIn poolmanagertypes.PoolI = pool
 			if tc.isInvalidPool {
 				poolIn = s.PrepareConcentratedPool()
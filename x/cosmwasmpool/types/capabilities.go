@@ -0,0 +1,85 @@
+package types
+
+const (
+	// LegacyInterfaceVersion is the interface version assigned to contracts
+	// that do not respond to the capabilities query. Such contracts predate
+	// capability discovery and are assumed to support LegacyCapabilities only.
+	LegacyInterfaceVersion = uint64(0)
+	// MaxSupportedInterfaceVersion is the latest pool contract interface version
+	// that this chain knows how to interact with.
+	MaxSupportedInterfaceVersion = uint64(1)
+)
+
+const (
+	// CapabilitySwapExactAmountOut signals that the contract implements
+	// the calc_in_amt_given_out query and the swap_exact_amount_out sudo message.
+	CapabilitySwapExactAmountOut = "swap_exact_amount_out"
+	// CapabilityJoinExit signals that the contract supports joining and exiting
+	// the pool in exchange for LP shares.
+	CapabilityJoinExit = "join_exit"
+	// CapabilityTwapHooks signals that the pool should be tracked by x/twap.
+	CapabilityTwapHooks = "twap_hooks"
+	// CapabilityCustomTakerFee signals that the contract charges the taker fee itself,
+	// in which case poolmanager does not charge it on swaps routed through the pool.
+	CapabilityCustomTakerFee = "custom_taker_fee"
+)
+
+var (
+	// SupportedCapabilities is the set of optional capabilities a contract may declare.
+	SupportedCapabilities = []string{
+		CapabilitySwapExactAmountOut,
+		CapabilityJoinExit,
+		CapabilityTwapHooks,
+		CapabilityCustomTakerFee,
+	}
+
+	// LegacyCapabilities is the set of capabilities assumed for contracts with
+	// LegacyInterfaceVersion. It matches the fixed message set that existed
+	// before capability discovery.
+	LegacyCapabilities = []string{
+		CapabilitySwapExactAmountOut,
+	}
+)
+
+// ValidateCapabilities returns an error if the interface version is not supported
+// or if the capabilities contain unknown or duplicate entries.
+func ValidateCapabilities(interfaceVersion uint64, capabilities []string) error {
+	if interfaceVersion == LegacyInterfaceVersion || interfaceVersion > MaxSupportedInterfaceVersion {
+		return UnsupportedInterfaceVersionError{InterfaceVersion: interfaceVersion, MaxSupportedVersion: MaxSupportedInterfaceVersion}
+	}
+
+	seen := make(map[string]struct{}, len(capabilities))
+	for _, capability := range capabilities {
+		if !isSupportedCapability(capability) {
+			return UnknownCapabilityError{Capability: capability}
+		}
+		if _, ok := seen[capability]; ok {
+			return DuplicateCapabilityError{Capability: capability}
+		}
+		seen[capability] = struct{}{}
+	}
+	return nil
+}
+
+// SupportsCapability returns true if a contract with the given interface version
+// and declared capabilities supports the given capability.
+func SupportsCapability(interfaceVersion uint64, capabilities []string, capability string) bool {
+	if interfaceVersion == LegacyInterfaceVersion {
+		capabilities = LegacyCapabilities
+	}
+	for _, c := range capabilities {
+		if c == capability {
+			return true
+		}
+	}
+	return false
+}
+
+func isSupportedCapability(capability string) bool {
+	for _, c := range SupportedCapabilities {
+		if c == capability {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,98 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/osmosis/v24/x/cosmwasmpool/types"
+)
+
+func TestValidateCapabilities(t *testing.T) {
+	tests := map[string]struct {
+		interfaceVersion uint64
+		capabilities     []string
+		expectedErr      error
+	}{
+		"valid: no capabilities": {
+			interfaceVersion: types.MaxSupportedInterfaceVersion,
+		},
+		"valid: all capabilities": {
+			interfaceVersion: types.MaxSupportedInterfaceVersion,
+			capabilities:     types.SupportedCapabilities,
+		},
+		"error: legacy interface version reported by contract": {
+			interfaceVersion: types.LegacyInterfaceVersion,
+			expectedErr:      types.UnsupportedInterfaceVersionError{InterfaceVersion: types.LegacyInterfaceVersion, MaxSupportedVersion: types.MaxSupportedInterfaceVersion},
+		},
+		"error: interface version too new": {
+			interfaceVersion: types.MaxSupportedInterfaceVersion + 1,
+			expectedErr:      types.UnsupportedInterfaceVersionError{InterfaceVersion: types.MaxSupportedInterfaceVersion + 1, MaxSupportedVersion: types.MaxSupportedInterfaceVersion},
+		},
+		"error: unknown capability": {
+			interfaceVersion: types.MaxSupportedInterfaceVersion,
+			capabilities:     []string{types.CapabilityJoinExit, "flash_loans"},
+			expectedErr:      types.UnknownCapabilityError{Capability: "flash_loans"},
+		},
+		"error: duplicate capability": {
+			interfaceVersion: types.MaxSupportedInterfaceVersion,
+			capabilities:     []string{types.CapabilityTwapHooks, types.CapabilityTwapHooks},
+			expectedErr:      types.DuplicateCapabilityError{Capability: types.CapabilityTwapHooks},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := types.ValidateCapabilities(tc.interfaceVersion, tc.capabilities)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSupportsCapability(t *testing.T) {
+	tests := map[string]struct {
+		interfaceVersion uint64
+		capabilities     []string
+		capability       string
+		expected         bool
+	}{
+		"legacy: exact amount out is supported": {
+			interfaceVersion: types.LegacyInterfaceVersion,
+			capability:       types.CapabilitySwapExactAmountOut,
+			expected:         true,
+		},
+		"legacy: join exit is not supported": {
+			interfaceVersion: types.LegacyInterfaceVersion,
+			capability:       types.CapabilityJoinExit,
+			expected:         false,
+		},
+		"legacy: stored capabilities are ignored": {
+			interfaceVersion: types.LegacyInterfaceVersion,
+			capabilities:     []string{types.CapabilityCustomTakerFee},
+			capability:       types.CapabilityCustomTakerFee,
+			expected:         false,
+		},
+		"discovered: declared capability is supported": {
+			interfaceVersion: types.MaxSupportedInterfaceVersion,
+			capabilities:     []string{types.CapabilityJoinExit, types.CapabilityTwapHooks},
+			capability:       types.CapabilityTwapHooks,
+			expected:         true,
+		},
+		"discovered: exact amount out must be declared": {
+			interfaceVersion: types.MaxSupportedInterfaceVersion,
+			capabilities:     []string{types.CapabilityJoinExit},
+			capability:       types.CapabilitySwapExactAmountOut,
+			expected:         false,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.expected, types.SupportsCapability(tc.interfaceVersion, tc.capabilities, tc.capability))
+		})
+	}
+}
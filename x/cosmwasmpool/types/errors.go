@@ -4,6 +4,8 @@ import (
 	"errors"
 	"fmt"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/osmosis-labs/osmosis/osmomath"
 )
 
@@ -46,3 +48,57 @@ type NegativeExcessiveTokenInAmountError struct {
 func (e NegativeExcessiveTokenInAmountError) Error() string {
 	return fmt.Sprintf("excessive token in amount cannot be negative. token in max amount = %d, token in required amount = %d, token in excessive amount = %d", e.TokenInMaxAmount, e.TokenInRequiredAmount, e.TokenInExcessiveAmount)
 }
+
+type UnsupportedInterfaceVersionError struct {
+	InterfaceVersion    uint64
+	MaxSupportedVersion uint64
+}
+
+func (e UnsupportedInterfaceVersionError) Error() string {
+	return fmt.Sprintf("cosmwasm pool contract reports unsupported interface version (%d). Supported versions are 1 to %d", e.InterfaceVersion, e.MaxSupportedVersion)
+}
+
+type UnknownCapabilityError struct {
+	Capability string
+}
+
+func (e UnknownCapabilityError) Error() string {
+	return fmt.Sprintf("cosmwasm pool contract declares unknown capability (%s)", e.Capability)
+}
+
+type DuplicateCapabilityError struct {
+	Capability string
+}
+
+func (e DuplicateCapabilityError) Error() string {
+	return fmt.Sprintf("cosmwasm pool contract declares capability (%s) more than once", e.Capability)
+}
+
+type UnsupportedCapabilityError struct {
+	PoolId     uint64
+	Capability string
+}
+
+func (e UnsupportedCapabilityError) Error() string {
+	return fmt.Sprintf("cosmwasm pool (%d) does not support capability (%s)", e.PoolId, e.Capability)
+}
+
+type InsufficientSharesOutError struct {
+	PoolId            uint64
+	SharesOutAmount   osmomath.Int
+	ShareOutMinAmount osmomath.Int
+}
+
+func (e InsufficientSharesOutError) Error() string {
+	return fmt.Sprintf("cosmwasm pool (%d) returned shares out amount (%s), which must be positive and at least the share out min amount (%s)", e.PoolId, e.SharesOutAmount, e.ShareOutMinAmount)
+}
+
+type InsufficientTokensOutError struct {
+	PoolId       uint64
+	TokensOut    sdk.Coins
+	TokenOutMins sdk.Coins
+}
+
+func (e InsufficientTokensOutError) Error() string {
+	return fmt.Sprintf("cosmwasm pool (%d) returned tokens out (%s), which must be at least the token out mins (%s)", e.PoolId, e.TokensOut, e.TokenOutMins)
+}
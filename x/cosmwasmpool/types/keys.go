@@ -1,6 +1,10 @@
 package types
 
 import (
+	"fmt"
+	"strconv"
+	"strings"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -10,6 +14,10 @@ const (
 	StoreKey = ModuleName
 
 	RouterKey = ModuleName
+
+	// PoolShareDenomPrefix is the prefix of the denom of the LP shares of cosmwasm pools
+	// that support joining and exiting through poolmanager.
+	PoolShareDenomPrefix = "cosmwasmpool/pool/"
 )
 
 var (
@@ -27,3 +35,20 @@ func FormatPoolsPrefix(poolId uint64) []byte {
 func FormatCodeIdWhitelistPrefix(codeId uint64) []byte {
 	return append(CodeIdWhiteListKey, sdk.Uint64ToBigEndian(codeId)...)
 }
+
+// GetPoolShareDenom returns the denom of the LP shares of the given pool.
+func GetPoolShareDenom(poolId uint64) string {
+	return fmt.Sprintf("%s%d", PoolShareDenomPrefix, poolId)
+}
+
+// GetPoolIdFromShareDenom returns the id of the pool whose LP shares have the given denom.
+func GetPoolIdFromShareDenom(denom string) (uint64, error) {
+	if !strings.HasPrefix(denom, PoolShareDenomPrefix) {
+		return 0, fmt.Errorf("denom (%s) does not start with the cosmwasm pool share prefix (%s)", denom, PoolShareDenomPrefix)
+	}
+	poolId, err := strconv.ParseUint(strings.TrimPrefix(denom, PoolShareDenomPrefix), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse pool id from denom (%s): %w", denom, err)
+	}
+	return poolId, nil
+}
@@ -0,0 +1,46 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/osmosis/v24/x/cosmwasmpool/types"
+)
+
+func TestGetPoolIdFromShareDenom(t *testing.T) {
+	tests := map[string]struct {
+		denom          string
+		expectedPoolId uint64
+		expectErr      bool
+	}{
+		"valid share denom": {
+			denom:          types.GetPoolShareDenom(12),
+			expectedPoolId: 12,
+		},
+		"error: gamm share denom": {
+			denom:     "gamm/pool/12",
+			expectErr: true,
+		},
+		"error: no pool id": {
+			denom:     types.PoolShareDenomPrefix,
+			expectErr: true,
+		},
+		"error: invalid pool id": {
+			denom:     types.PoolShareDenomPrefix + "-1",
+			expectErr: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			poolId, err := types.GetPoolIdFromShareDenom(tc.denom)
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedPoolId, poolId)
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+)
+
+// CosmWasmPoolListener is notified of events on cosmwasm pools.
+type CosmWasmPoolListener interface {
+	// AfterCosmWasmPoolCreated runs after a cosmwasm pool is initialized.
+	AfterCosmWasmPoolCreated(ctx sdk.Context, sender sdk.AccAddress, poolId uint64)
+	// AfterCosmWasmPoolSwap is called after a swap in a cosmwasm pool.
+	AfterCosmWasmPoolSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins)
+	// AfterCosmWasmPoolJoin is called after LP shares of a cosmwasm pool are minted for the tokens in.
+	AfterCosmWasmPoolJoin(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, tokensIn sdk.Coins, shareOutAmount osmomath.Int)
+	// AfterCosmWasmPoolExit is called after LP shares of a cosmwasm pool are burned for the tokens out.
+	AfterCosmWasmPoolExit(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, shareInAmount osmomath.Int, tokensOut sdk.Coins)
+}
+
+type CosmWasmPoolListeners []CosmWasmPoolListener
+
+var _ CosmWasmPoolListener = &CosmWasmPoolListeners{}
+
+func (l CosmWasmPoolListeners) AfterCosmWasmPoolCreated(ctx sdk.Context, sender sdk.AccAddress, poolId uint64) {
+	for i := range l {
+		l[i].AfterCosmWasmPoolCreated(ctx, sender, poolId)
+	}
+}
+
+func (l CosmWasmPoolListeners) AfterCosmWasmPoolSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins) {
+	for i := range l {
+		l[i].AfterCosmWasmPoolSwap(ctx, sender, poolId, input, output)
+	}
+}
+
+func (l CosmWasmPoolListeners) AfterCosmWasmPoolJoin(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, tokensIn sdk.Coins, shareOutAmount osmomath.Int) {
+	for i := range l {
+		l[i].AfterCosmWasmPoolJoin(ctx, sender, poolId, tokensIn, shareOutAmount)
+	}
+}
+
+func (l CosmWasmPoolListeners) AfterCosmWasmPoolExit(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, shareInAmount osmomath.Int, tokensOut sdk.Coins) {
+	for i := range l {
+		l[i].AfterCosmWasmPoolExit(ctx, sender, poolId, shareInAmount, tokensOut)
+	}
+}
+
+// Creates listeners for the x/cosmwasmpool module.
+func NewCosmWasmPoolListeners(listeners ...CosmWasmPoolListener) CosmWasmPoolListeners {
+	return listeners
+}
@@ -25,4 +25,12 @@ type CosmWasmExtension interface {
 	GetTotalPoolLiquidity(ctx sdk.Context) sdk.Coins
 
 	SetCodeId(codeId uint64)
+
+	GetInterfaceVersion() uint64
+
+	GetCapabilities() []string
+
+	SetCapabilities(interfaceVersion uint64, capabilities []string)
+
+	SupportsCapability(capability string) bool
 }
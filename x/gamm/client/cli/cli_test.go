@@ -3,6 +3,7 @@ package cli_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 
@@ -304,6 +305,50 @@ func TestNewExitSwapShareAmountInCmd(t *testing.T) {
 	osmocli.RunTxTestCases(t, desc, tcs)
 }
 
+func TestNewSetSmoothWeightChangeCmd(t *testing.T) {
+	desc, _ := cli.NewSetSmoothWeightChangeCmd()
+	targetPoolWeights := []balancer.PoolAsset{
+		{Weight: osmomath.NewInt(9), Token: sdk.NewCoin("uion", osmomath.ZeroInt())},
+		{Weight: osmomath.NewInt(1), Token: sdk.NewCoin("uosmo", osmomath.ZeroInt())},
+	}
+	tcs := map[string]osmocli.TxCliTestCase[*balancer.MsgSetSmoothWeightChange]{
+		"start at the block time": {
+			Cmd: "1 72h 1uosmo,9uion --from=" + testAddresses[0].String(),
+			ExpectedMsg: &balancer.MsgSetSmoothWeightChange{
+				Sender:            testAddresses[0].String(),
+				PoolID:            1,
+				Duration:          72 * time.Hour,
+				TargetPoolWeights: targetPoolWeights,
+			},
+		},
+		"start at a unix time": {
+			Cmd: "1 72h 1uosmo,9uion --start-time=1700000000 --from=" + testAddresses[0].String(),
+			ExpectedMsg: &balancer.MsgSetSmoothWeightChange{
+				Sender:            testAddresses[0].String(),
+				PoolID:            1,
+				StartTime:         time.Unix(1700000000, 0).UTC(),
+				Duration:          72 * time.Hour,
+				TargetPoolWeights: targetPoolWeights,
+			},
+		},
+		"start at an RFC3339 time": {
+			Cmd: "1 72h 1uosmo,9uion --start-time=2024-01-01T00:00:00Z --from=" + testAddresses[0].String(),
+			ExpectedMsg: &balancer.MsgSetSmoothWeightChange{
+				Sender:            testAddresses[0].String(),
+				PoolID:            1,
+				StartTime:         time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+				Duration:          72 * time.Hour,
+				TargetPoolWeights: targetPoolWeights,
+			},
+		},
+		"invalid start time": {
+			Cmd:         "1 72h 1uosmo,9uion --start-time=tomorrow --from=" + testAddresses[0].String(),
+			ExpectedErr: true,
+		},
+	}
+	osmocli.RunTxTestCases(t, desc, tcs)
+}
+
 func TestGetCmdPools(t *testing.T) {
 	desc, _ := cli.GetCmdPools()
 	tcs := map[string]osmocli.QueryCliTestCase[*types.QueryPoolsRequest]{
@@ -42,6 +42,19 @@ const (
 	FlagScalingFactors                 = "scaling-factors"
 	FlagScalingFactorControllerAddress = "scaling-factor-controller-address"
 
+	// Will be parsed to time.Time.
+	FlagStartTime = "start-time"
+
+	FlagBaseDenom         = "base-denom"
+	FlagQuoteDenom        = "quote-denom"
+	FlagMaxChangePerEpoch = "max-change-per-epoch"
+	FlagTwapPoolId        = "twap-pool-id"
+	FlagTwapWindow        = "twap-window"
+	FlagContractAddress   = "contract-address"
+	FlagContractQueryMsg  = "contract-query-msg"
+	FlagRedemptionRate    = "redemption-rate"
+	FlagRemoveRateSource  = "remove-rate-source"
+
 	FlagMigrationRecords = "migration-records"
 
 	FlagPoolRecords = "pool-records"
@@ -120,6 +133,12 @@ func FlagSetAdjustScalingFactors() *flag.FlagSet {
 	return fs
 }
 
+func FlagSetSmoothWeightChange() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.String(FlagStartTime, "", "The time the weights start changing at, as a unix timestamp or in RFC3339 format. Defaults to the block time")
+	return fs
+}
+
 func FlagSetMigratePosition() *flag.FlagSet {
 	fs := flag.NewFlagSet("", flag.ContinueOnError)
 	fs.StringArray(FlagMinAmountsOut, []string{""}, "Minimum tokens out")
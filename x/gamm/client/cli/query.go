@@ -33,6 +33,10 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(
 		GetCmdNumPools(),
 		GetCmdPoolParams(),
+		GetCmdPoolWeights(),
+		GetCmdScalingFactorRateSource(),
+		GetCmdCalcJoinSwapShareAmountOut(),
+		GetCmdCalcExitSwapExactAmountOut(),
 		GetCmdTotalShares(),
 		GetCmdQueryTotalLiquidity(),
 		GetCmdTotalPoolLiquidity(),
@@ -161,6 +165,60 @@ $ %s query gamm pool-params 1
 	return cmd
 }
 
+// GetCmdPoolWeights returns the current and target weights of a balancer pool.
+func GetCmdPoolWeights() *cobra.Command {
+	return osmocli.SimpleQueryCmd[*types.QueryPoolWeightsRequest](
+		"pool-weights",
+		"Query the current and target weights of a balancer pool",
+		`Query the current and target weights of a balancer pool.
+Example:
+{{.CommandPrefix}} pool-weights 1
+`,
+		types.ModuleName, types.NewQueryClient,
+	)
+}
+
+// GetCmdScalingFactorRateSource returns the rate source driving the scaling factors of a stableswap pool.
+func GetCmdScalingFactorRateSource() *cobra.Command {
+	return osmocli.SimpleQueryCmd[*types.QueryScalingFactorRateSourceRequest](
+		"scaling-factor-rate-source",
+		"Query the rate source driving the scaling factors of a stableswap pool",
+		`Query the rate source driving the scaling factors of a stableswap pool, and its current scaling factors.
+Example:
+{{.CommandPrefix}} scaling-factor-rate-source 1
+`,
+		types.ModuleName, types.NewQueryClient,
+	)
+}
+
+// GetCmdCalcJoinSwapShareAmountOut returns the tokens needed to join a pool with a single asset for an exact amount of shares.
+func GetCmdCalcJoinSwapShareAmountOut() *cobra.Command {
+	return osmocli.SimpleQueryCmd[*types.QueryCalcJoinSwapShareAmountOutRequest](
+		"join-swap-share-amount-out [pool-id] [token-in-denom] [share-out-amount]",
+		"Query the tokens needed to join a pool with a single asset for an exact amount of shares",
+		`Query the amount of tokens needed to join a pool with a single asset for an exact amount of shares,
+and the implied price impact of the join.
+Example:
+{{.CommandPrefix}} join-swap-share-amount-out 1 uosmo 1000000000000000000
+`,
+		types.ModuleName, types.NewQueryClient,
+	)
+}
+
+// GetCmdCalcExitSwapExactAmountOut returns the shares needed to exit a pool with a single asset for an exact amount of tokens.
+func GetCmdCalcExitSwapExactAmountOut() *cobra.Command {
+	return osmocli.SimpleQueryCmd[*types.QueryCalcExitSwapExactAmountOutRequest](
+		"exit-swap-exact-amount-out [pool-id] [token-out]",
+		"Query the shares needed to exit a pool with a single asset for an exact amount of tokens",
+		`Query the amount of shares needed to exit a pool with a single asset for an exact amount of tokens,
+and the implied price impact of the exit.
+Example:
+{{.CommandPrefix}} exit-swap-exact-amount-out 1 1000000uusdc
+`,
+		types.ModuleName, types.NewQueryClient,
+	)
+}
+
 func GetCmdTotalShares() *cobra.Command {
 	return osmocli.SimpleQueryCmd[*types.QueryTotalSharesRequest](
 		"total-share",
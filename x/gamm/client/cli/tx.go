@@ -38,6 +38,7 @@ func NewTxCmd() *cobra.Command {
 	osmocli.AddTxCmd(txCmd, NewJoinSwapShareAmountOut)
 	osmocli.AddTxCmd(txCmd, NewExitSwapExternAmountOut)
 	osmocli.AddTxCmd(txCmd, NewExitSwapShareAmountIn)
+	osmocli.AddTxCmd(txCmd, NewSetSmoothWeightChangeCmd)
 	txCmd.AddCommand(
 		NewCreatePoolCmd().BuildCommandCustomFn(),
 		NewStableSwapAdjustScalingFactorsCmd(),
@@ -168,6 +169,21 @@ func NewExitSwapShareAmountIn() (*osmocli.TxCliDesc, *types.MsgExitSwapShareAmou
 	}, &types.MsgExitSwapShareAmountIn{}
 }
 
+func NewSetSmoothWeightChangeCmd() (*osmocli.TxCliDesc, *balancer.MsgSetSmoothWeightChange) {
+	return &osmocli.TxCliDesc{
+		Use:   "set-smooth-weight-change",
+		Short: "schedule the weights of a balancer pool to change linearly to the target weights",
+		Long: `Schedule the weights of a balancer pool to change linearly from their current value to the target weights over the duration,
+replacing any weight change in progress. The sender must be the future governor of the pool.`,
+		Example: "osmosisd tx gamm set-smooth-weight-change 1 72h 1uosmo,1uion --start-time=2024-01-01T00:00:00Z --from val --chain-id osmosis-1",
+		CustomFieldParsers: map[string]osmocli.CustomFieldParserFn{
+			"StartTime":         startTimeParser,
+			"TargetPoolWeights": targetPoolWeightsParser,
+		},
+		Flags: osmocli.FlagDesc{OptionalFlags: []*flag.FlagSet{FlagSetSmoothWeightChange()}},
+	}, &balancer.MsgSetSmoothWeightChange{}
+}
+
 // TODO: Change these flags to args. Required flags don't make that much sense.
 func NewStableSwapAdjustScalingFactorsCmd() *cobra.Command {
 	cmd := osmocli.TxCliDesc{
@@ -372,6 +388,171 @@ Sample proposal with flags
 	return cmd
 }
 
+// NewCmdSubmitSetScalingFactorRateSourceProposal implements a command handler for the set scaling factor rate source proposal
+func NewCmdSubmitSetScalingFactorRateSourceProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-scaling-factor-rate-source-proposal [flags]",
+		Args:  cobra.ExactArgs(0),
+		Short: "Submit a set scaling factor rate source proposal",
+		Long: strings.TrimSpace(`Submit a set scaling factor rate source proposal.
+
+At the end of each epoch, the scaling factor of the base denom of the pool moves towards the scaling factor of the
+quote denom divided by the rate, by at most max-change-per-epoch of its value. The rate is the amount of quote denom
+one unit of base denom is worth, and is read from exactly one of:
+- the arithmetic TWAP of a reference pool over a window (--twap-pool-id, --twap-window)
+- a CosmWasm contract answering the query msg with {"rate": "<decimal>"} (--contract-address, --contract-query-msg)
+- a fixed redemption rate (--redemption-rate)
+
+Sample proposal with flags
+>>> osmosisd tx gov submit-proposal set-scaling-factor-rate-source-proposal \
+        --title "Set Scaling Factor Rate Source Proposal" \
+		--summary "Follow the stOSMO/OSMO redemption rate"
+		--deposit 1600000000uosmo
+		--pool-id 1
+		--base-denom stuosmo
+		--quote-denom uosmo
+		--max-change-per-epoch 0.005
+		--contract-address osmoXXX
+		--contract-query-msg '{"redemption_rate":{"denom":"stuosmo"}}'
+
+Sample proposal removing the rate source of a pool
+>>> osmosisd tx gov submit-proposal set-scaling-factor-rate-source-proposal \
+        --title "Remove Scaling Factor Rate Source Proposal" \
+		--summary "Hand the scaling factors back to the controller"
+		--deposit 1600000000uosmo
+		--pool-id 1
+		--remove-rate-source
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, proposalTitle, summary, deposit, isExpedited, authority, err := osmocli.GetProposalInfo(cmd)
+			if err != nil {
+				return err
+			}
+
+			content, err := parseSetScalingFactorRateSourceArgsToContent(cmd)
+			if err != nil {
+				return err
+			}
+
+			contentMsg, err := v1.NewLegacyContent(content, authority.String())
+			if err != nil {
+				return err
+			}
+
+			msg := v1.NewMsgExecLegacyContent(contentMsg.Content, authority.String())
+
+			proposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{msg}, deposit, clientCtx.GetFromAddress().String(), "", proposalTitle, summary, isExpedited)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposalMsg)
+		},
+	}
+	osmocli.AddCommonProposalFlags(cmd)
+	cmd.Flags().Uint64(FlagPoolId, 0, "stableswap pool-id")
+	cmd.Flags().String(FlagBaseDenom, "", "denom whose scaling factor follows the rate")
+	cmd.Flags().String(FlagQuoteDenom, "", "denom the rate is quoted in")
+	cmd.Flags().String(FlagMaxChangePerEpoch, "", "maximum relative change of the base denom scaling factor per epoch, e.g. 0.01")
+	cmd.Flags().Uint64(FlagTwapPoolId, 0, "id of the reference pool of a TWAP rate source")
+	cmd.Flags().Duration(FlagTwapWindow, 0, "window of a TWAP rate source")
+	cmd.Flags().String(FlagContractAddress, "", "address of the contract of a contract rate source")
+	cmd.Flags().String(FlagContractQueryMsg, "", "JSON query msg of a contract rate source")
+	cmd.Flags().String(FlagRedemptionRate, "", "rate of a fixed redemption rate source")
+	cmd.Flags().Bool(FlagRemoveRateSource, false, "remove the rate source of the pool")
+
+	return cmd
+}
+
+func parseSetScalingFactorRateSourceArgsToContent(cmd *cobra.Command) (govtypesv1beta1.Content, error) {
+	title, err := cmd.Flags().GetString(govcli.FlagTitle)
+	if err != nil {
+		return nil, err
+	}
+
+	description, err := cmd.Flags().GetString(govcli.FlagSummary)
+	if err != nil {
+		return nil, err
+	}
+
+	poolId, err := cmd.Flags().GetUint64(FlagPoolId)
+	if err != nil {
+		return nil, err
+	}
+
+	remove, err := cmd.Flags().GetBool(FlagRemoveRateSource)
+	if err != nil {
+		return nil, err
+	}
+	if remove {
+		return types.NewSetScalingFactorRateSourceProposal(title, description, poolId, nil), nil
+	}
+
+	rateSource, err := parseScalingFactorRateSource(cmd.Flags())
+	if err != nil {
+		return nil, err
+	}
+
+	return types.NewSetScalingFactorRateSourceProposal(title, description, poolId, &rateSource), nil
+}
+
+func parseScalingFactorRateSource(fs *flag.FlagSet) (types.ScalingFactorRateSource, error) {
+	rateSource := types.ScalingFactorRateSource{}
+	var err error
+	if rateSource.BaseDenom, err = fs.GetString(FlagBaseDenom); err != nil {
+		return types.ScalingFactorRateSource{}, err
+	}
+	if rateSource.QuoteDenom, err = fs.GetString(FlagQuoteDenom); err != nil {
+		return types.ScalingFactorRateSource{}, err
+	}
+
+	maxChangePerEpochStr, err := fs.GetString(FlagMaxChangePerEpoch)
+	if err != nil {
+		return types.ScalingFactorRateSource{}, err
+	}
+	if rateSource.MaxChangePerEpoch, err = osmomath.NewDecFromStr(maxChangePerEpochStr); err != nil {
+		return types.ScalingFactorRateSource{}, fmt.Errorf("invalid max change per epoch: %w", err)
+	}
+
+	twapPoolId, err := fs.GetUint64(FlagTwapPoolId)
+	if err != nil {
+		return types.ScalingFactorRateSource{}, err
+	}
+	if twapPoolId != 0 {
+		twapWindow, err := fs.GetDuration(FlagTwapWindow)
+		if err != nil {
+			return types.ScalingFactorRateSource{}, err
+		}
+		rateSource.Twap = &types.TwapRateSource{PoolId: twapPoolId, Window: twapWindow}
+	}
+
+	contractAddress, err := fs.GetString(FlagContractAddress)
+	if err != nil {
+		return types.ScalingFactorRateSource{}, err
+	}
+	if contractAddress != "" {
+		queryMsg, err := fs.GetString(FlagContractQueryMsg)
+		if err != nil {
+			return types.ScalingFactorRateSource{}, err
+		}
+		rateSource.Contract = &types.ContractRateSource{ContractAddress: contractAddress, QueryMsg: []byte(queryMsg)}
+	}
+
+	redemptionRateStr, err := fs.GetString(FlagRedemptionRate)
+	if err != nil {
+		return types.ScalingFactorRateSource{}, err
+	}
+	if redemptionRateStr != "" {
+		redemptionRate, err := osmomath.NewDecFromStr(redemptionRateStr)
+		if err != nil {
+			return types.ScalingFactorRateSource{}, fmt.Errorf("invalid redemption rate: %w", err)
+		}
+		rateSource.RedemptionRate = &types.RedemptionRateSource{Rate: redemptionRate}
+	}
+
+	return rateSource, nil
+}
+
 func BuildCreatePoolCmd(clientCtx client.Context, args []string, fs *flag.FlagSet) (sdk.Msg, error) {
 	poolType, err := fs.GetString(FlagPoolType)
 	if err != nil {
@@ -540,6 +721,37 @@ func NewBuildCreateStableswapPoolMsg(clientCtx client.Context, fs *flag.FlagSet)
 	}, nil
 }
 
+func startTimeParser(_arg string, fs *flag.FlagSet) (any, osmocli.FieldReadLocation, error) {
+	startTimeStr, err := fs.GetString(FlagStartTime)
+	if err != nil || startTimeStr == "" {
+		return time.Time{}, false, err
+	}
+	if startTimeUnix, err := strconv.ParseInt(startTimeStr, 10, 64); err == nil {
+		return time.Unix(startTimeUnix, 0).UTC(), false, nil
+	}
+	startTime, err := time.Parse(time.RFC3339, startTimeStr)
+	if err != nil {
+		return time.Time{}, false, fmt.Errorf("could not parse time: %w", err)
+	}
+	return startTime, false, nil
+}
+
+func targetPoolWeightsParser(arg string, _ *flag.FlagSet) (any, osmocli.FieldReadLocation, error) {
+	weights, err := sdk.ParseDecCoins(arg)
+	if err != nil {
+		return nil, true, err
+	}
+
+	targetPoolWeights := make([]balancer.PoolAsset, len(weights))
+	for i, weight := range weights {
+		targetPoolWeights[i] = balancer.PoolAsset{
+			Weight: weight.Amount.RoundInt(),
+			Token:  sdk.NewCoin(weight.Denom, osmomath.ZeroInt()),
+		}
+	}
+	return targetPoolWeights, true, nil
+}
+
 func maxAmountsInParser(fs *flag.FlagSet) (sdk.Coins, error) {
 	return stringArrayCoinsParser(FlagMaxAmountsIn, fs)
 }
@@ -11,4 +11,5 @@ var (
 	UpdateMigrationRecordsProposalHandler     = govclient.NewProposalHandler(cli.NewCmdSubmitUpdateMigrationRecordsProposal)
 	CreateCLPoolAndLinkToCFMMProposalHandler  = govclient.NewProposalHandler(cli.NewCmdSubmitCreateCLPoolAndLinkToCFMMProposal)
 	SetScalingFactorControllerProposalHandler = govclient.NewProposalHandler(cli.NewCmdSubmitSetScalingFactorControllerProposal)
+	SetScalingFactorRateSourceProposalHandler = govclient.NewProposalHandler(cli.NewCmdSubmitSetScalingFactorRateSourceProposal)
 )
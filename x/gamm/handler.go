@@ -23,6 +23,8 @@ func NewGammProposalHandler(k keeper.Keeper) govtypesv1.Handler {
 			return handleCreatingCLPoolAndLinkToCFMMProposal(ctx, k, c)
 		case *types.SetScalingFactorControllerProposal:
 			return handleSetScalingFactorControllerProposal(ctx, k, c)
+		case *types.SetScalingFactorRateSourceProposal:
+			return handleSetScalingFactorRateSourceProposal(ctx, k, c)
 
 		default:
 			return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized migration record proposal content type: %T", c)
@@ -55,3 +57,9 @@ func handleCreatingCLPoolAndLinkToCFMMProposal(ctx sdk.Context, k keeper.Keeper,
 func handleSetScalingFactorControllerProposal(ctx sdk.Context, k keeper.Keeper, p *types.SetScalingFactorControllerProposal) error {
 	return k.HandleSetScalingFactorControllerProposal(ctx, p)
 }
+
+// handleSetScalingFactorRateSourceProposal is a handler for gov proposals to set the rate source driving
+// a stableswap pool's scaling factors
+func handleSetScalingFactorRateSourceProposal(ctx sdk.Context, k keeper.Keeper, p *types.SetScalingFactorRateSourceProposal) error {
+	return k.HandleSetScalingFactorRateSourceProposal(ctx, p)
+}
@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v24/x/gamm/types"
+	epochstypes "github.com/osmosis-labs/osmosis/x/epochs/types"
+)
+
+type EpochHooks struct {
+	k Keeper
+}
+
+var _ epochstypes.EpochHooks = EpochHooks{}
+
+func (k Keeper) EpochHooks() epochstypes.EpochHooks {
+	return EpochHooks{k}
+}
+
+// GetModuleName implements types.EpochHooks.
+func (EpochHooks) GetModuleName() string {
+	return types.ModuleName
+}
+
+// BeforeEpochStart is the epoch start hook.
+func (h EpochHooks) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNumber int64) error {
+	return nil
+}
+
+// AfterEpochEnd is the epoch end hook. At the end of each distribution epoch, it updates the scaling
+// factors of the stableswap pools driven by a rate source.
+func (h EpochHooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) error {
+	if h.k.incentivesKeeper == nil || epochIdentifier != h.k.incentivesKeeper.GetEpochInfo(ctx).Identifier {
+		return nil
+	}
+	return h.k.UpdateScalingFactorsFromRateSources(ctx)
+}
@@ -38,3 +38,11 @@ func (k Keeper) UnmarshalPoolLegacy(bz []byte) (poolmanagertypes.PoolI, error) {
 func GetMaximalNoSwapLPAmount(ctx sdk.Context, pool types.CFMMPoolI, shareOutAmount osmomath.Int) (neededLpLiquidity sdk.Coins, err error) {
 	return getMaximalNoSwapLPAmount(ctx, pool, shareOutAmount)
 }
+
+func CalcJoinPriceImpact(ctx sdk.Context, pool types.CFMMPoolI, tokensIn sdk.Coins, sharesOut osmomath.Int) (osmomath.Dec, error) {
+	return calcJoinPriceImpact(ctx, pool, tokensIn, sharesOut)
+}
+
+func CalcExitPriceImpact(ctx sdk.Context, pool types.CFMMPoolI, tokensOut sdk.Coins, sharesIn osmomath.Int) (osmomath.Dec, error) {
+	return calcExitPriceImpact(ctx, pool, tokensOut, sharesIn)
+}
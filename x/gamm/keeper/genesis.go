@@ -41,6 +41,17 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState, unpack
 	} else {
 		k.SetMigrationRecords(ctx, *genState.MigrationRecords)
 	}
+
+	for _, record := range genState.ScalingFactorRateSources {
+		rateSource := record.RateSource
+		if err := k.SetScalingFactorRateSource(ctx, record.PoolId, &rateSource); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, poolId := range genState.SmoothWeightChangePoolIds {
+		k.setSmoothWeightChangePool(ctx, poolId)
+	}
 }
 
 // ExportGenesis returns the capability module's exported genesis.
@@ -53,6 +64,10 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	if err != nil {
 		panic(err)
 	}
+	scalingFactorRateSources, err := k.GetAllScalingFactorRateSources(ctx)
+	if err != nil {
+		panic(err)
+	}
 	poolAnys := []*codectypes.Any{}
 	for _, poolI := range pools {
 		any, err := codectypes.NewAnyWithValue(poolI)
@@ -66,5 +81,8 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		Pools:            poolAnys,
 		Params:           k.GetParams(ctx),
 		MigrationRecords: &migrationInfo,
+
+		ScalingFactorRateSources:  scalingFactorRateSources,
+		SmoothWeightChangePoolIds: k.getAllSmoothWeightChangePoolIds(ctx),
 	}
 }
@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"time"
+
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
@@ -56,7 +58,8 @@ func (s *KeeperTestSuite) TestGammInitGenesis() {
 		Params: types.Params{
 			PoolCreationFee: sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000_000_000)},
 		},
-		MigrationRecords: &DefaultMigrationRecords,
+		MigrationRecords:          &DefaultMigrationRecords,
+		SmoothWeightChangePoolIds: []uint64{1},
 	}, s.App.AppCodec())
 
 	poolStored, err := s.App.GAMMKeeper.GetPoolAndPoke(s.Ctx, 1)
@@ -79,6 +82,8 @@ func (s *KeeperTestSuite) TestGammInitGenesis() {
 	postInitGenMigrationRecords, err := s.App.GAMMKeeper.GetAllMigrationInfo(s.Ctx)
 	s.Require().NoError(err)
 	s.Require().Equal(DefaultMigrationRecords, postInitGenMigrationRecords)
+
+	s.Require().Equal([]uint64{1}, s.App.GAMMKeeper.ExportGenesis(s.Ctx).SmoothWeightChangePoolIds)
 }
 
 func (s *KeeperTestSuite) TestGammExportGenesis() {
@@ -106,9 +111,17 @@ func (s *KeeperTestSuite) TestGammExportGenesis() {
 	_, err = s.App.PoolManagerKeeper.CreatePool(ctx, msg)
 	s.Require().NoError(err)
 
+	// The second pool opts into smooth weight changes.
 	msg = balancer.NewMsgCreateBalancerPool(acc1, balancer.PoolParams{
 		SwapFee: osmomath.NewDecWithPrec(1, 2),
 		ExitFee: osmomath.ZeroDec(),
+		SmoothWeightChangeParams: &balancer.SmoothWeightChangeParams{
+			Duration: time.Hour,
+			TargetPoolWeights: []balancer.PoolAsset{
+				{Weight: osmomath.NewInt(100), Token: sdk.NewCoin("foo", osmomath.ZeroInt())},
+				{Weight: osmomath.NewInt(100), Token: sdk.NewCoin("bar", osmomath.ZeroInt())},
+			},
+		},
 	}, []balancer.PoolAsset{{
 		Weight: osmomath.NewInt(70),
 		Token:  sdk.NewCoin("foo", osmomath.NewInt(10000)),
@@ -124,6 +137,13 @@ func (s *KeeperTestSuite) TestGammExportGenesis() {
 	genesis := s.App.GAMMKeeper.ExportGenesis(ctx)
 	s.Require().Len(genesis.Pools, 2)
 	s.Require().Equal(&DefaultMigrationRecords, genesis.MigrationRecords)
+	s.Require().Equal([]uint64{2}, genesis.SmoothWeightChangePoolIds)
+
+	bz, err := genesis.Marshal()
+	s.Require().NoError(err)
+	decoded := types.GenesisState{}
+	s.Require().NoError(decoded.Unmarshal(bz))
+	s.Require().Equal(genesis.SmoothWeightChangePoolIds, decoded.SmoothWeightChangePoolIds)
 }
 
 func (s *KeeperTestSuite) TestMarshalUnmarshalGenesis() {
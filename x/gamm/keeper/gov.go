@@ -17,3 +17,7 @@ func (k Keeper) HandleUpdateMigrationRecordsProposal(ctx sdk.Context, p *types.U
 func (k Keeper) HandleSetScalingFactorControllerProposal(ctx sdk.Context, p *types.SetScalingFactorControllerProposal) error {
 	return k.setStableSwapScalingFactorController(ctx, p.PoolId, p.ControllerAddress)
 }
+
+func (k Keeper) HandleSetScalingFactorRateSourceProposal(ctx sdk.Context, p *types.SetScalingFactorRateSourceProposal) error {
+	return k.SetScalingFactorRateSource(ctx, p.PoolId, p.RateSource)
+}
@@ -166,9 +166,96 @@ func (q Querier) CalcJoinPoolShares(ctx context.Context, req *types.QueryCalcJoi
 		return nil, err
 	}
 
+	priceImpact, err := calcJoinPriceImpact(sdkCtx, pool, newLiquidity, numShares)
+	if err != nil {
+		return nil, err
+	}
+
 	return &types.QueryCalcJoinPoolSharesResponse{
 		ShareOutAmount: numShares,
 		TokensOut:      newLiquidity,
+		PriceImpact:    priceImpact,
+	}, nil
+}
+
+// CalcJoinSwapShareAmountOut returns the amount of tokens needed to join a pool with a single asset for an exact
+// amount of shares, and the implied price impact of the join.
+func (q Querier) CalcJoinSwapShareAmountOut(ctx context.Context, req *types.QueryCalcJoinSwapShareAmountOutRequest) (*types.QueryCalcJoinSwapShareAmountOutResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.ShareOutAmount.IsNil() || !req.ShareOutAmount.IsPositive() {
+		return nil, status.Error(codes.InvalidArgument, "share out amount must be positive")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	pool, err := q.Keeper.GetCFMMPool(sdkCtx, req.PoolId)
+	if err != nil {
+		return nil, err
+	}
+
+	extendedPool, ok := pool.(types.PoolAmountOutExtension)
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "pool with id %d does not support this kind of join", req.PoolId)
+	}
+
+	tokenInAmount, err := extendedPool.CalcTokenInShareAmountOut(sdkCtx, req.TokenInDenom, req.ShareOutAmount, pool.GetSpreadFactor(sdkCtx))
+	if err != nil {
+		return nil, err
+	}
+
+	priceImpact, err := calcJoinPriceImpact(sdkCtx, pool, sdk.NewCoins(sdk.NewCoin(req.TokenInDenom, tokenInAmount)), req.ShareOutAmount)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryCalcJoinSwapShareAmountOutResponse{
+		TokenInAmount: tokenInAmount,
+		PriceImpact:   priceImpact,
+	}, nil
+}
+
+// CalcExitSwapExactAmountOut returns the amount of shares needed to exit a pool with a single asset for an exact
+// amount of tokens, and the implied price impact of the exit.
+func (q Querier) CalcExitSwapExactAmountOut(ctx context.Context, req *types.QueryCalcExitSwapExactAmountOutRequest) (*types.QueryCalcExitSwapExactAmountOutResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	tokenOut, err := sdk.ParseCoinNormalized(req.TokenOut)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid token: %s", err.Error())
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	pool, err := q.Keeper.GetCFMMPool(sdkCtx, req.PoolId)
+	if err != nil {
+		return nil, err
+	}
+
+	extendedPool, ok := pool.(types.PoolAmountOutExtension)
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "pool with id %d does not support this kind of exit", req.PoolId)
+	}
+
+	// price impact is computed against the pool before the exit, so we exit from a copy of it.
+	poolCopy, err := q.Keeper.GetCFMMPool(sdkCtx, req.PoolId)
+	if err != nil {
+		return nil, err
+	}
+	shareInAmount, err := poolCopy.(types.PoolAmountOutExtension).ExitSwapExactAmountOut(sdkCtx, tokenOut, pool.GetTotalShares())
+	if err != nil {
+		return nil, err
+	}
+
+	priceImpact, err := calcExitPriceImpact(sdkCtx, extendedPool, sdk.NewCoins(tokenOut), shareInAmount)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryCalcExitSwapExactAmountOutResponse{
+		ShareInAmount: shareInAmount,
+		PriceImpact:   priceImpact,
 	}, nil
 }
 
@@ -318,6 +405,77 @@ func (q Querier) PoolParams(ctx context.Context, req *types.QueryPoolParamsReque
 	}
 }
 
+// PoolWeights returns the current weights of a balancer pool, and the weights it is changing to.
+func (q Querier) PoolWeights(ctx context.Context, req *types.QueryPoolWeightsRequest) (*types.QueryPoolWeightsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	pool, err := q.Keeper.GetPoolAndPoke(sdkCtx, req.PoolId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	balancerPool, ok := pool.(*balancer.Pool)
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "pool id %d is not of type balancer pool", req.PoolId)
+	}
+
+	currentWeights := poolAssetsToPoolWeights(balancerPool.PoolAssets)
+	params := balancerPool.PoolParams.SmoothWeightChangeParams
+	if params == nil {
+		return &types.QueryPoolWeightsResponse{
+			CurrentWeights: currentWeights,
+			TargetWeights:  currentWeights,
+		}, nil
+	}
+
+	startTime := params.StartTime
+	endTime := params.StartTime.Add(params.Duration)
+	return &types.QueryPoolWeightsResponse{
+		CurrentWeights: currentWeights,
+		TargetWeights:  poolAssetsToPoolWeights(params.TargetPoolWeights),
+		StartTime:      &startTime,
+		EndTime:        &endTime,
+	}, nil
+}
+
+// ScalingFactorRateSource returns the rate source driving the scaling factors of a stableswap pool, if any,
+// and the current scaling factors of the pool.
+func (q Querier) ScalingFactorRateSource(ctx context.Context, req *types.QueryScalingFactorRateSourceRequest) (*types.QueryScalingFactorRateSourceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	stableswapPool, err := q.Keeper.getStableswapPool(sdkCtx, req.PoolId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	rateSource, found, err := q.Keeper.GetScalingFactorRateSource(sdkCtx, req.PoolId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	response := &types.QueryScalingFactorRateSourceResponse{ScalingFactors: stableswapPool.GetScalingFactors()}
+	if found {
+		response.RateSource = &rateSource
+	}
+	return response, nil
+}
+
+func poolAssetsToPoolWeights(poolAssets []balancer.PoolAsset) []types.PoolWeight {
+	weights := make([]types.PoolWeight, len(poolAssets))
+	for i, asset := range poolAssets {
+		weights[i] = types.PoolWeight{Denom: asset.Token.Denom, Weight: asset.Weight}
+	}
+	return weights
+}
+
 // TotalPoolLiquidity returns total liquidity in pool.
 // Deprecated: please use the alternative in x/poolmanager
 // nolint: staticcheck
@@ -9,6 +9,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v24/x/gamm/keeper"
 	"github.com/osmosis-labs/osmosis/v24/x/gamm/pool-models/balancer"
 	"github.com/osmosis-labs/osmosis/v24/x/gamm/pool-models/stableswap"
 	"github.com/osmosis-labs/osmosis/v24/x/gamm/types"
@@ -426,6 +427,10 @@ func (s *KeeperTestSuite) TestCalcJoinPoolShares() {
 				s.Require().NoError(err)
 				s.Require().Equal(numShares, out.ShareOutAmount)
 				s.Require().Equal(numLiquidity, out.TokensOut)
+
+				priceImpact, err := keeper.CalcJoinPriceImpact(ctx, pool, numLiquidity, numShares)
+				s.Require().NoError(err)
+				s.Require().Equal(priceImpact, out.PriceImpact)
 			} else {
 				s.Require().EqualError(err, tc.expectedErr.Error())
 			}
@@ -433,6 +438,135 @@ func (s *KeeperTestSuite) TestCalcJoinPoolShares() {
 	}
 }
 
+func (s *KeeperTestSuite) TestCalcJoinSwapShareAmountOut() {
+	s.FundAcc(s.TestAccs[0], defaultAcctFunds)
+	poolId := s.PrepareImbalancedStableswapPool()
+
+	testCases := []struct {
+		name           string
+		poolId         uint64
+		tokenInDenom   string
+		shareOutAmount osmomath.Int
+		expectedErr    bool
+	}{
+		{
+			name:           "valid join",
+			poolId:         poolId,
+			tokenInDenom:   "foo",
+			shareOutAmount: types.OneShare,
+		},
+		{
+			name:           "pool id does not exist",
+			poolId:         poolId + 1,
+			tokenInDenom:   "foo",
+			shareOutAmount: types.OneShare,
+			expectedErr:    true,
+		},
+		{
+			name:           "token in denom does not exist",
+			poolId:         poolId,
+			tokenInDenom:   "random",
+			shareOutAmount: types.OneShare,
+			expectedErr:    true,
+		},
+		{
+			name:           "zero shares out",
+			poolId:         poolId,
+			tokenInDenom:   "foo",
+			shareOutAmount: osmomath.ZeroInt(),
+			expectedErr:    true,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			out, err := s.queryClient.CalcJoinSwapShareAmountOut(gocontext.Background(), &types.QueryCalcJoinSwapShareAmountOutRequest{
+				PoolId:         tc.poolId,
+				TokenInDenom:   tc.tokenInDenom,
+				ShareOutAmount: tc.shareOutAmount,
+			})
+			if tc.expectedErr {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			// the estimate matches the amount charged by the join
+			s.FundAcc(s.TestAccs[1], sdk.NewCoins(sdk.NewCoin(tc.tokenInDenom, out.TokenInAmount)))
+			cacheCtx, _ := s.Ctx.CacheContext()
+			tokenInAmount, err := s.App.GAMMKeeper.JoinSwapShareAmountOut(cacheCtx, s.TestAccs[1], tc.poolId, tc.tokenInDenom, tc.shareOutAmount, out.TokenInAmount)
+			s.Require().NoError(err)
+			s.Require().Equal(tokenInAmount, out.TokenInAmount)
+
+			// joining with a single asset in an imbalanced pool has a small positive price impact
+			s.Require().True(out.PriceImpact.IsPositive(), "price impact %s", out.PriceImpact)
+			s.Require().True(out.PriceImpact.LT(osmomath.NewDecWithPrec(5, 2)), "price impact %s", out.PriceImpact)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestCalcExitSwapExactAmountOut() {
+	s.FundAcc(s.TestAccs[0], defaultAcctFunds)
+	poolId := s.PrepareImbalancedStableswapPool()
+
+	testCases := []struct {
+		name        string
+		poolId      uint64
+		tokenOut    string
+		expectedErr bool
+	}{
+		{
+			name:     "valid exit",
+			poolId:   poolId,
+			tokenOut: "1000000bar",
+		},
+		{
+			name:        "pool id does not exist",
+			poolId:      poolId + 1,
+			tokenOut:    "1000000bar",
+			expectedErr: true,
+		},
+		{
+			name:        "token out denom does not exist",
+			poolId:      poolId,
+			tokenOut:    "1000000random",
+			expectedErr: true,
+		},
+		{
+			name:        "invalid token out",
+			poolId:      poolId,
+			tokenOut:    "bar",
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			out, err := s.queryClient.CalcExitSwapExactAmountOut(gocontext.Background(), &types.QueryCalcExitSwapExactAmountOutRequest{
+				PoolId:   tc.poolId,
+				TokenOut: tc.tokenOut,
+			})
+			if tc.expectedErr {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			// the estimate matches the shares charged by the exit, and the query does not change the pool
+			tokenOut, err := sdk.ParseCoinNormalized(tc.tokenOut)
+			s.Require().NoError(err)
+			cacheCtx, _ := s.Ctx.CacheContext()
+			shareInAmount, err := s.App.GAMMKeeper.ExitSwapExactAmountOut(cacheCtx, s.TestAccs[0], tc.poolId, tokenOut, out.ShareInAmount)
+			s.Require().NoError(err)
+			s.Require().Equal(shareInAmount, out.ShareInAmount)
+
+			// exiting with a single asset in an imbalanced pool has a small positive price impact
+			s.Require().True(out.PriceImpact.IsPositive(), "price impact %s", out.PriceImpact)
+			s.Require().True(out.PriceImpact.LT(osmomath.NewDecWithPrec(1, 2)), "price impact %s", out.PriceImpact)
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestQueryPool() {
 	queryClient := s.queryClient
 
@@ -45,6 +45,22 @@ func (server msgServer) CreateBalancerPool(goCtx context.Context, msg *balancer.
 	return &balancer.MsgCreateBalancerPoolResponse{PoolID: poolId}, err
 }
 
+// SetSmoothWeightChange schedules a smooth weight change of a balancer pool.
+func (server msgServer) SetSmoothWeightChange(goCtx context.Context, msg *balancer.MsgSetSmoothWeightChange) (*balancer.MsgSetSmoothWeightChangeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	params := balancer.SmoothWeightChangeParams{
+		StartTime:         msg.StartTime,
+		Duration:          msg.Duration,
+		TargetPoolWeights: msg.TargetPoolWeights,
+	}
+	if err := server.keeper.setBalancerSmoothWeightChange(ctx, msg.PoolID, params, msg.Sender); err != nil {
+		return nil, err
+	}
+
+	return &balancer.MsgSetSmoothWeightChangeResponse{}, nil
+}
+
 func (server msgServer) CreateStableswapPool(goCtx context.Context, msg *stableswap.MsgCreateStableswapPool) (*stableswap.MsgCreateStableswapPoolResponse, error) {
 	poolId, err := server.CreatePool(goCtx, msg)
 	if err != nil {
@@ -1,10 +1,13 @@
 package keeper_test
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/v24/x/gamm/keeper"
+	"github.com/osmosis-labs/osmosis/v24/x/gamm/pool-models/balancer"
 	"github.com/osmosis-labs/osmosis/v24/x/gamm/types"
 	poolmanagertypes "github.com/osmosis-labs/osmosis/v24/x/poolmanager/types"
 )
@@ -365,3 +368,127 @@ func (s *KeeperTestSuite) TestExitPool_Events() {
 		})
 	}
 }
+
+// TestSetSmoothWeightChange tests that a weight change scheduled by the future governor of a balancer pool
+// that opted into smooth weight changes at creation applies to the swaps and joins during the change,
+// and ends at the target weights.
+func (s *KeeperTestSuite) TestSetSmoothWeightChange() {
+	s.SetupTest()
+	governor := s.TestAccs[0]
+	poolLiquidity := osmomath.NewInt(1_000_000_000)
+	poolAssets := func(fooWeight, barWeight int64) []balancer.PoolAsset {
+		return []balancer.PoolAsset{
+			{Weight: osmomath.NewInt(fooWeight), Token: sdk.NewCoin("foo", poolLiquidity)},
+			{Weight: osmomath.NewInt(barWeight), Token: sdk.NewCoin("bar", poolLiquidity)},
+		}
+	}
+	poolParams := balancer.PoolParams{SwapFee: osmomath.ZeroDec(), ExitFee: osmomath.ZeroDec()}
+	scaledWeight := func(weight int64) osmomath.Int {
+		return osmomath.NewInt(weight).MulRaw(balancer.GuaranteedWeightPrecision)
+	}
+
+	// The pool opts into smooth weight changes by being created with one.
+	lbpParams := poolParams
+	lbpParams.SmoothWeightChangeParams = &balancer.SmoothWeightChangeParams{
+		Duration:          time.Hour,
+		TargetPoolWeights: poolAssets(1, 1),
+	}
+
+	s.FundAcc(governor, sdk.NewCoins(sdk.NewCoin("uosmo", osmomath.NewInt(20_000_000_000)), sdk.NewCoin("foo", poolLiquidity.MulRaw(2)), sdk.NewCoin("bar", poolLiquidity.MulRaw(2))))
+	poolId, err := s.App.PoolManagerKeeper.CreatePool(s.Ctx, balancer.NewMsgCreateBalancerPool(governor, lbpParams, poolAssets(1, 1), governor.String()))
+	s.Require().NoError(err)
+	nonLbpPoolId, err := s.App.PoolManagerKeeper.CreatePool(s.Ctx, balancer.NewMsgCreateBalancerPool(governor, poolParams, poolAssets(1, 1), governor.String()))
+	s.Require().NoError(err)
+
+	msgServer := keeper.NewBalancerMsgServerImpl(s.App.GAMMKeeper)
+	querier := keeper.NewQuerier(*s.App.GAMMKeeper)
+	msg := balancer.NewMsgSetSmoothWeightChange(governor.String(), poolId, time.Time{}, 24*time.Hour, []balancer.PoolAsset{
+		{Weight: osmomath.NewInt(1), Token: sdk.NewCoin("foo", osmomath.ZeroInt())},
+		{Weight: osmomath.NewInt(9), Token: sdk.NewCoin("bar", osmomath.ZeroInt())},
+	})
+
+	// Only the future governor can schedule a weight change.
+	nonGovernorMsg := msg
+	nonGovernorMsg.Sender = s.TestAccs[1].String()
+	_, err = msgServer.SetSmoothWeightChange(sdk.WrapSDKContext(s.Ctx), &nonGovernorMsg)
+	s.Require().ErrorIs(err, types.ErrNotFuturePoolGovernor)
+
+	// Pools created without a smooth weight change can not have one scheduled, even by their future governor.
+	nonLbpMsg := msg
+	nonLbpMsg.PoolID = nonLbpPoolId
+	_, err = msgServer.SetSmoothWeightChange(sdk.WrapSDKContext(s.Ctx), &nonLbpMsg)
+	s.Require().ErrorIs(err, types.ErrSmoothWeightChangeDisabled)
+
+	_, err = msgServer.SetSmoothWeightChange(sdk.WrapSDKContext(s.Ctx), &msg)
+	s.Require().NoError(err)
+
+	startTime := s.Ctx.BlockTime()
+	endTime := startTime.Add(24 * time.Hour)
+	weights, err := querier.PoolWeights(sdk.WrapSDKContext(s.Ctx), &types.QueryPoolWeightsRequest{PoolId: poolId})
+	s.Require().NoError(err)
+	s.Require().Equal(&types.QueryPoolWeightsResponse{
+		CurrentWeights: []types.PoolWeight{{Denom: "bar", Weight: scaledWeight(1)}, {Denom: "foo", Weight: scaledWeight(1)}},
+		TargetWeights:  []types.PoolWeight{{Denom: "bar", Weight: scaledWeight(9)}, {Denom: "foo", Weight: scaledWeight(1)}},
+		StartTime:      &startTime,
+		EndTime:        &endTime,
+	}, weights)
+
+	// Halfway through the weight change, the weights are 1:5.
+	s.Ctx = s.Ctx.WithBlockTime(startTime.Add(12 * time.Hour))
+	weights, err = querier.PoolWeights(sdk.WrapSDKContext(s.Ctx), &types.QueryPoolWeightsRequest{PoolId: poolId})
+	s.Require().NoError(err)
+	s.Require().Equal([]types.PoolWeight{{Denom: "bar", Weight: scaledWeight(5)}, {Denom: "foo", Weight: scaledWeight(1)}}, weights.CurrentWeights)
+
+	// A swap uses the weights of the block time.
+	tokenIn := sdk.NewCoin("foo", osmomath.NewInt(1_000_000))
+	expectedPool, err := balancer.NewBalancerPool(poolId, poolParams, poolAssets(1, 5), "", s.Ctx.BlockTime())
+	s.Require().NoError(err)
+	expectedTokenOut, err := expectedPool.CalcOutAmtGivenIn(s.Ctx, sdk.NewCoins(tokenIn), "bar", osmomath.ZeroDec())
+	s.Require().NoError(err)
+
+	s.FundAcc(s.TestAccs[1], sdk.NewCoins(tokenIn))
+	pool, err := s.App.GAMMKeeper.GetPoolAndPoke(s.Ctx, poolId)
+	s.Require().NoError(err)
+	tokenOutAmount, err := s.App.GAMMKeeper.SwapExactAmountIn(s.Ctx, s.TestAccs[1], pool, tokenIn, "bar", osmomath.OneInt(), osmomath.ZeroDec())
+	s.Require().NoError(err)
+	s.Require().Equal(expectedTokenOut.Amount.String(), tokenOutAmount.String())
+
+	// A single asset join also uses the weights of the block time.
+	s.Ctx = s.Ctx.WithBlockTime(startTime.Add(18 * time.Hour))
+	pool, err = s.App.GAMMKeeper.GetPoolAndPoke(s.Ctx, poolId)
+	s.Require().NoError(err)
+	liquidity := pool.GetTotalPoolLiquidity(s.Ctx)
+	expectedPool, err = balancer.NewBalancerPool(poolId, poolParams, []balancer.PoolAsset{
+		{Weight: osmomath.NewInt(1), Token: sdk.NewCoin("foo", liquidity.AmountOf("foo"))},
+		{Weight: osmomath.NewInt(7), Token: sdk.NewCoin("bar", liquidity.AmountOf("bar"))},
+	}, "", s.Ctx.BlockTime())
+	s.Require().NoError(err)
+	expectedShares, _, err := expectedPool.CalcJoinPoolShares(s.Ctx, sdk.NewCoins(tokenIn), osmomath.ZeroDec())
+	s.Require().NoError(err)
+
+	s.FundAcc(s.TestAccs[1], sdk.NewCoins(tokenIn))
+	shares, err := s.App.GAMMKeeper.JoinSwapExactAmountIn(s.Ctx, s.TestAccs[1], poolId, sdk.NewCoins(tokenIn), osmomath.OneInt())
+	s.Require().NoError(err)
+	s.Require().Equal(expectedShares.String(), shares.String())
+
+	// The weight change is still in progress from the initial weights.
+	storedPool, err := s.App.GAMMKeeper.GetPoolAndPoke(s.Ctx, poolId)
+	s.Require().NoError(err)
+	params := storedPool.(*balancer.Pool).PoolParams.SmoothWeightChangeParams
+	s.Require().NotNil(params)
+	s.Require().Equal(scaledWeight(1), params.InitialPoolWeights[0].Weight)
+
+	// Past the end time, the weights are the target weights and the weight change is over.
+	s.Ctx = s.Ctx.WithBlockTime(endTime.Add(time.Second))
+	weights, err = querier.PoolWeights(sdk.WrapSDKContext(s.Ctx), &types.QueryPoolWeightsRequest{PoolId: poolId})
+	s.Require().NoError(err)
+	s.Require().Equal(&types.QueryPoolWeightsResponse{
+		CurrentWeights: []types.PoolWeight{{Denom: "bar", Weight: scaledWeight(9)}, {Denom: "foo", Weight: scaledWeight(1)}},
+		TargetWeights:  []types.PoolWeight{{Denom: "bar", Weight: scaledWeight(9)}, {Denom: "foo", Weight: scaledWeight(1)}},
+	}, weights)
+
+	// Non balancer pools have no weights.
+	stableswapPoolId := s.PrepareBasicStableswapPool()
+	_, err = querier.PoolWeights(sdk.WrapSDKContext(s.Ctx), &types.QueryPoolWeightsRequest{PoolId: stableswapPoolId})
+	s.Require().Error(err)
+}
@@ -309,16 +309,19 @@ func (k Keeper) GetTotalPoolShares(ctx sdk.Context, poolId uint64) (osmomath.Int
 }
 
 // setStableSwapScalingFactors sets the stable swap scaling factors.
-// errors if the pool does not exist, the sender is not the scaling factor controller, or due to other
-// internal errors.
+// errors if the pool does not exist, the sender is not the scaling factor controller, the scaling factors
+// are driven by a rate source, or due to other internal errors.
 func (k Keeper) setStableSwapScalingFactors(ctx sdk.Context, poolId uint64, scalingFactors []uint64, sender string) error {
-	pool, err := k.GetPoolAndPoke(ctx, poolId)
+	stableswapPool, err := k.getStableswapPool(ctx, poolId)
 	if err != nil {
 		return err
 	}
-	stableswapPool, ok := pool.(*stableswap.Pool)
-	if !ok {
-		return fmt.Errorf("pool id %d is not of type stableswap pool", poolId)
+	_, hasRateSource, err := k.GetScalingFactorRateSource(ctx, poolId)
+	if err != nil {
+		return err
+	}
+	if hasRateSource {
+		return types.ErrScalingFactorsDrivenByRateSource
 	}
 	if err := stableswapPool.SetScalingFactors(ctx, scalingFactors, sender); err != nil {
 		return err
@@ -344,6 +347,53 @@ func (k Keeper) setStableSwapScalingFactorController(ctx sdk.Context, poolId uin
 	return k.setPool(ctx, stableswapPool)
 }
 
+// setBalancerSmoothWeightChange schedules a smooth weight change of a balancer pool.
+// errors if the pool does not exist, is not a balancer pool or did not opt into smooth weight changes
+// at creation, the sender is not the pool's future governor, or the weight change is invalid.
+func (k Keeper) setBalancerSmoothWeightChange(ctx sdk.Context, poolId uint64, params balancer.SmoothWeightChangeParams, sender string) error {
+	if !k.isSmoothWeightChangePool(ctx, poolId) {
+		return errorsmod.Wrapf(types.ErrSmoothWeightChangeDisabled, "pool id %d", poolId)
+	}
+
+	pool, err := k.GetPoolAndPoke(ctx, poolId)
+	if err != nil {
+		return err
+	}
+	balancerPool, ok := pool.(*balancer.Pool)
+	if !ok {
+		return fmt.Errorf("pool id %d is not of type balancer pool", poolId)
+	}
+	if err := balancerPool.SetSmoothWeightChange(params, sender, ctx.BlockTime()); err != nil {
+		return err
+	}
+
+	return k.setPool(ctx, balancerPool)
+}
+
+// setSmoothWeightChangePool records that the given balancer pool opted into smooth weight changes,
+// allowing its future governor to schedule new ones with MsgSetSmoothWeightChange.
+func (k Keeper) setSmoothWeightChangePool(ctx sdk.Context, poolId uint64) {
+	ctx.KVStore(k.storeKey).Set(types.GetKeySmoothWeightChangePool(poolId), []byte{})
+}
+
+// isSmoothWeightChangePool returns true if the given pool opted into smooth weight changes.
+func (k Keeper) isSmoothWeightChangePool(ctx sdk.Context, poolId uint64) bool {
+	return ctx.KVStore(k.storeKey).Has(types.GetKeySmoothWeightChangePool(poolId))
+}
+
+// getAllSmoothWeightChangePoolIds returns the ids of all the pools that opted into smooth weight changes,
+// in ascending order.
+func (k Keeper) getAllSmoothWeightChangePoolIds(ctx sdk.Context) []uint64 {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.KeyPrefixSmoothWeightChangePools)
+	defer iter.Close()
+
+	poolIds := []uint64{}
+	for ; iter.Valid(); iter.Next() {
+		poolIds = append(poolIds, sdk.BigEndianToUint64(iter.Key()[len(types.KeyPrefixSmoothWeightChangePools):]))
+	}
+	return poolIds
+}
+
 // asCFMMPool converts PoolI to CFMMPoolI by casting the input.
 // Returns the pool of the CFMMPoolI or error if the given pool does not implement
 // CFMMPoolI.
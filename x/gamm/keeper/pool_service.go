@@ -11,6 +11,7 @@ import (
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v24/x/gamm/pool-models/balancer"
 	"github.com/osmosis-labs/osmosis/v24/x/gamm/types"
 	poolmanagertypes "github.com/osmosis-labs/osmosis/v24/x/poolmanager/types"
 )
@@ -116,6 +117,12 @@ func (k Keeper) InitializePool(ctx sdk.Context, pool poolmanagertypes.PoolI, sen
 		return err
 	}
 
+	// Only balancer pools created with a smooth weight change (e.g. LBPs) can later have their
+	// weight change rescheduled by their future governor.
+	if balancerPool, ok := pool.(*balancer.Pool); ok && balancerPool.PoolParams.SmoothWeightChangeParams != nil {
+		k.setSmoothWeightChangePool(ctx, pool.GetId())
+	}
+
 	// N.B.: these hooks propagate to x/twap to create
 	// twap records at pool creation time.
 	// Additionally, these hooks are used in x/pool-incentives to
@@ -412,6 +419,18 @@ func (k Keeper) ExitSwapExactAmountOut(
 	tokenOut sdk.Coin,
 	shareInMaxAmount osmomath.Int,
 ) (shareInAmount osmomath.Int, err error) {
+	// defer to catch panics, in case something internal overflows.
+	defer func() {
+		if r := recover(); r != nil {
+			shareInAmount = osmomath.Int{}
+			if isErr, d := osmoutils.IsOutOfGasError(r); isErr {
+				err = fmt.Errorf("function ExitSwapExactAmountOut failed due to lack of gas: %v", d)
+			} else {
+				err = fmt.Errorf("function ExitSwapExactAmountOut failed due to internal reason: %v", r)
+			}
+		}
+	}()
+
 	pool, err := k.GetCFMMPool(ctx, poolId)
 	if err != nil {
 		return osmomath.Int{}, err
@@ -835,6 +835,46 @@ func (s *KeeperTestSuite) TestActiveBalancerPool() {
 	}
 }
 
+func (s *KeeperTestSuite) TestStableswapAmountOutJoinExit() {
+	s.SetupTest()
+	s.FundAcc(s.TestAccs[0], defaultAcctFunds)
+	poolId := s.PrepareImbalancedStableswapPool()
+	shareDenom := types.GetPoolShareDenom(poolId)
+	testAccount := s.TestAccs[1]
+	s.FundAcc(testAccount, sdk.NewCoins(sdk.NewCoin("foo", osmomath.NewInt(1_000_000_000)), sdk.NewCoin("bar", osmomath.NewInt(1_000_000_000))))
+
+	// join with a single asset for an exact amount of shares
+	shareOutAmount := types.OneShare
+	fooBalanceBefore := s.App.BankKeeper.GetBalance(s.Ctx, testAccount, "foo")
+	tokenInAmount, err := s.App.GAMMKeeper.JoinSwapShareAmountOut(s.Ctx, testAccount, poolId, "foo", shareOutAmount, osmomath.NewInt(1_000_000_000))
+	s.Require().NoError(err)
+	s.Require().Equal(fooBalanceBefore.Amount.Sub(tokenInAmount), s.App.BankKeeper.GetBalance(s.Ctx, testAccount, "foo").Amount)
+	s.Require().Equal(shareOutAmount, s.App.BankKeeper.GetBalance(s.Ctx, testAccount, shareDenom).Amount)
+
+	// the join can not exceed the max amount of tokens in
+	_, err = s.App.GAMMKeeper.JoinSwapShareAmountOut(s.Ctx, testAccount, poolId, "foo", shareOutAmount, tokenInAmount.QuoRaw(2))
+	s.Require().ErrorIs(err, types.ErrLimitMaxAmount)
+
+	// join with a subset of the pool's assets
+	sharesOut, err := s.App.GAMMKeeper.JoinSwapExactAmountIn(s.Ctx, testAccount, poolId,
+		sdk.NewCoins(sdk.NewCoin("foo", osmomath.NewInt(1_000_000)), sdk.NewCoin("bar", osmomath.NewInt(2_000_000))), osmomath.OneInt())
+	s.Require().NoError(err)
+	s.Require().True(sharesOut.IsPositive())
+
+	// exit with a single asset for an exact amount of tokens
+	tokenOut := sdk.NewCoin("bar", osmomath.NewInt(1_000_000))
+	sharesBefore := s.App.BankKeeper.GetBalance(s.Ctx, testAccount, shareDenom)
+	barBalanceBefore := s.App.BankKeeper.GetBalance(s.Ctx, testAccount, "bar")
+	shareInAmount, err := s.App.GAMMKeeper.ExitSwapExactAmountOut(s.Ctx, testAccount, poolId, tokenOut, sharesBefore.Amount)
+	s.Require().NoError(err)
+	s.Require().Equal(barBalanceBefore.Add(tokenOut), s.App.BankKeeper.GetBalance(s.Ctx, testAccount, "bar"))
+	s.Require().Equal(sharesBefore.Amount.Sub(shareInAmount), s.App.BankKeeper.GetBalance(s.Ctx, testAccount, shareDenom).Amount)
+
+	// the exit can not exceed the max amount of shares in
+	_, err = s.App.GAMMKeeper.ExitSwapExactAmountOut(s.Ctx, testAccount, poolId, tokenOut, shareInAmount.QuoRaw(2))
+	s.Require().ErrorIs(err, types.ErrLimitMaxAmount)
+}
+
 func (s *KeeperTestSuite) TestJoinSwapExactAmountInConsistency() {
 	testCases := []struct {
 		name              string
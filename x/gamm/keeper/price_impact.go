@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v24/x/gamm/types"
+)
+
+// calcJoinPriceImpact returns the relative value lost by joining the pool with tokensIn for sharesOut,
+// compared to the shares tokensIn are worth at the spot prices of the pool.
+func calcJoinPriceImpact(ctx sdk.Context, pool types.CFMMPoolI, tokensIn sdk.Coins, sharesOut osmomath.Int) (osmomath.Dec, error) {
+	spotPriceShares, err := sharesAtSpotPrice(ctx, pool, tokensIn)
+	if err != nil {
+		return osmomath.Dec{}, err
+	}
+	if !spotPriceShares.IsPositive() {
+		return osmomath.Dec{}, errors.New("tokens in are worth no shares at the spot prices of the pool")
+	}
+
+	// price impact = 1 - shares out / shares at spot price
+	return osmomath.OneBigDec().Sub(osmomath.BigDecFromSDKInt(sharesOut).Quo(spotPriceShares)).Dec(), nil
+}
+
+// calcExitPriceImpact returns the relative value lost by exiting sharesIn from the pool for tokensOut,
+// compared to the shares tokensOut are worth at the spot prices of the pool.
+func calcExitPriceImpact(ctx sdk.Context, pool types.CFMMPoolI, tokensOut sdk.Coins, sharesIn osmomath.Int) (osmomath.Dec, error) {
+	if !sharesIn.IsPositive() {
+		return osmomath.Dec{}, errors.New("shares in must be positive")
+	}
+	spotPriceShares, err := sharesAtSpotPrice(ctx, pool, tokensOut)
+	if err != nil {
+		return osmomath.Dec{}, err
+	}
+
+	// price impact = 1 - shares at spot price / shares in
+	return osmomath.OneBigDec().Sub(spotPriceShares.Quo(osmomath.BigDecFromSDKInt(sharesIn))).Dec(), nil
+}
+
+// sharesAtSpotPrice returns the number of LP shares the given coins are worth, when valuing both the coins
+// and the pool liquidity at the spot prices of the pool in its first denom.
+func sharesAtSpotPrice(ctx sdk.Context, pool types.CFMMPoolI, coins sdk.Coins) (osmomath.BigDec, error) {
+	poolLiquidity := pool.GetTotalPoolLiquidity(ctx)
+	if poolLiquidity.Empty() {
+		return osmomath.BigDec{}, types.ErrPoolNotFound
+	}
+	numeraireDenom := poolLiquidity[0].Denom
+
+	valueInNumeraire := func(coins sdk.Coins) (osmomath.BigDec, error) {
+		value := osmomath.ZeroBigDec()
+		for _, coin := range coins {
+			price := osmomath.OneBigDec()
+			if coin.Denom != numeraireDenom {
+				var err error
+				price, err = pool.SpotPrice(ctx, numeraireDenom, coin.Denom)
+				if err != nil {
+					return osmomath.BigDec{}, err
+				}
+			}
+			value = value.Add(price.Mul(osmomath.BigDecFromSDKInt(coin.Amount)))
+		}
+		return value, nil
+	}
+
+	coinsValue, err := valueInNumeraire(coins)
+	if err != nil {
+		return osmomath.BigDec{}, err
+	}
+	poolValue, err := valueInNumeraire(poolLiquidity)
+	if err != nil {
+		return osmomath.BigDec{}, err
+	}
+	if !poolValue.IsPositive() {
+		return osmomath.BigDec{}, errors.New("pool liquidity is worth nothing at its spot prices")
+	}
+
+	return coinsValue.Mul(osmomath.BigDecFromSDKInt(pool.GetTotalShares())).Quo(poolValue), nil
+}
@@ -0,0 +1,209 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v24/x/gamm/pool-models/stableswap"
+	"github.com/osmosis-labs/osmosis/v24/x/gamm/types"
+)
+
+// SetScalingFactorRateSource sets the rate source driving the scaling factors of the given stableswap pool,
+// or removes it if the rate source is nil. While a pool has a rate source, its scaling factors are updated
+// at the end of each epoch and can not be adjusted by its scaling factor controller.
+// Returns error if the pool is not a stableswap pool, the rate source is invalid or its denoms are not in the pool.
+func (k Keeper) SetScalingFactorRateSource(ctx sdk.Context, poolId uint64, rateSource *types.ScalingFactorRateSource) error {
+	stableswapPool, err := k.getStableswapPool(ctx, poolId)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	if rateSource == nil {
+		store.Delete(types.GetKeyScalingFactorRateSource(poolId))
+		return nil
+	}
+
+	if err := rateSource.Validate(); err != nil {
+		return err
+	}
+	for _, denom := range []string{rateSource.BaseDenom, rateSource.QuoteDenom} {
+		if stableswapPool.PoolLiquidity.AmountOf(denom).IsZero() {
+			return types.NoDesiredDenomInPoolError{DesiredDenom: denom}
+		}
+	}
+	if rateSource.Twap != nil && rateSource.Twap.PoolId == poolId {
+		return fmt.Errorf("pool %d can not follow its own TWAP", poolId)
+	}
+
+	osmoutils.MustSet(store, types.GetKeyScalingFactorRateSource(poolId), &types.PoolIdToScalingFactorRateSource{
+		PoolId:     poolId,
+		RateSource: *rateSource,
+	})
+	return nil
+}
+
+// GetScalingFactorRateSource returns the rate source driving the scaling factors of the given pool and
+// whether the pool has one.
+func (k Keeper) GetScalingFactorRateSource(ctx sdk.Context, poolId uint64) (types.ScalingFactorRateSource, bool, error) {
+	record := types.PoolIdToScalingFactorRateSource{}
+	found, err := osmoutils.Get(ctx.KVStore(k.storeKey), types.GetKeyScalingFactorRateSource(poolId), &record)
+	if err != nil {
+		return types.ScalingFactorRateSource{}, false, err
+	}
+	return record.RateSource, found, nil
+}
+
+// GetAllScalingFactorRateSources returns the rate sources of all the pools that have one, by pool id.
+func (k Keeper) GetAllScalingFactorRateSources(ctx sdk.Context) ([]types.PoolIdToScalingFactorRateSource, error) {
+	return osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(k.storeKey), types.KeyPrefixScalingFactorRateSource, parseScalingFactorRateSource)
+}
+
+func parseScalingFactorRateSource(value []byte) (types.PoolIdToScalingFactorRateSource, error) {
+	record := types.PoolIdToScalingFactorRateSource{}
+	err := record.Unmarshal(value)
+	return record, err
+}
+
+// UpdateScalingFactorsFromRateSources updates the scaling factors of all the pools that have a rate source.
+// A pool whose rate can not be read or whose scaling factors can not be updated keeps its scaling factors,
+// without affecting the other pools.
+func (k Keeper) UpdateScalingFactorsFromRateSources(ctx sdk.Context) error {
+	records, err := k.GetAllScalingFactorRateSources(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, record := range records {
+		record := record
+		err := osmoutils.ApplyFuncIfNoError(ctx, func(cacheCtx sdk.Context) error {
+			return k.updateScalingFactorsFromRateSource(cacheCtx, record.PoolId, record.RateSource)
+		})
+		if err != nil {
+			ctx.Logger().Error(fmt.Sprintf("failed to update scaling factors of pool %d from its rate source: %s", record.PoolId, err))
+		}
+	}
+	return nil
+}
+
+// updateScalingFactorsFromRateSource moves the scaling factor of the base denom of the given pool towards
+// the scaling factor of the quote denom divided by the rate, by at most the max change per epoch of the
+// rate source.
+func (k Keeper) updateScalingFactorsFromRateSource(ctx sdk.Context, poolId uint64, rateSource types.ScalingFactorRateSource) error {
+	stableswapPool, err := k.getStableswapPool(ctx, poolId)
+	if err != nil {
+		return err
+	}
+
+	rate, err := k.getRate(ctx, rateSource)
+	if err != nil {
+		return err
+	}
+	if rate.IsNil() || !rate.IsPositive() {
+		return types.NonPositiveRateError{Rate: rate}
+	}
+
+	quoteScalingFactor := stableswapPool.GetScalingFactorByDenom(rateSource.QuoteDenom)
+	targetScalingFactor := osmomath.NewDecFromInt(osmomath.NewIntFromUint64(quoteScalingFactor)).Quo(rate)
+
+	scalingFactors := make([]uint64, len(stableswapPool.ScalingFactors))
+	copy(scalingFactors, stableswapPool.ScalingFactors)
+	for i, coin := range stableswapPool.PoolLiquidity {
+		if coin.Denom == rateSource.BaseDenom {
+			scalingFactors[i] = rateSource.BoundScalingFactor(scalingFactors[i], targetScalingFactor)
+		}
+	}
+
+	if err := stableswapPool.UpdateScalingFactors(scalingFactors); err != nil {
+		return err
+	}
+	if err := k.setPool(ctx, stableswapPool); err != nil {
+		return err
+	}
+
+	scalingFactorStrs := make([]string, len(scalingFactors))
+	for i, scalingFactor := range scalingFactors {
+		scalingFactorStrs[i] = strconv.FormatUint(scalingFactor, 10)
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtScalingFactorsUpdated,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(poolId, 10)),
+		sdk.NewAttribute(types.AttributeKeyRate, rate.String()),
+		sdk.NewAttribute(types.AttributeKeyScalingFactors, strings.Join(scalingFactorStrs, ",")),
+	))
+	return nil
+}
+
+// getRate returns the amount of quote denom one unit of base denom is worth, according to the rate source.
+func (k Keeper) getRate(ctx sdk.Context, rateSource types.ScalingFactorRateSource) (osmomath.Dec, error) {
+	switch {
+	case rateSource.Twap != nil:
+		if k.twapKeeper == nil {
+			return osmomath.Dec{}, fmt.Errorf("twap keeper is not set")
+		}
+		startTime := ctx.BlockTime().Add(-rateSource.Twap.Window)
+		return k.twapKeeper.GetArithmeticTwapToNow(ctx, rateSource.Twap.PoolId, rateSource.BaseDenom, rateSource.QuoteDenom, startTime)
+
+	case rateSource.Contract != nil:
+		if k.wasmKeeper == nil {
+			return osmomath.Dec{}, fmt.Errorf("wasm keeper is not set")
+		}
+		contractAddress, err := sdk.AccAddressFromBech32(rateSource.Contract.ContractAddress)
+		if err != nil {
+			return osmomath.Dec{}, err
+		}
+		bz, err := k.queryRateContract(ctx, contractAddress, rateSource.Contract.QueryMsg)
+		if err != nil {
+			return osmomath.Dec{}, err
+		}
+		response := types.ContractRateResponse{}
+		if err := json.Unmarshal(bz, &response); err != nil {
+			return osmomath.Dec{}, err
+		}
+		return response.Rate, nil
+
+	case rateSource.RedemptionRate != nil:
+		return rateSource.RedemptionRate.Rate, nil
+
+	default:
+		return osmomath.Dec{}, types.ErrInvalidScalingFactorRateSource
+	}
+}
+
+// queryRateContract runs the query of a contract rate source with a gas limit of RateContractQueryGasLimit,
+// and charges the gas it used to ctx. Returns RateContractOutOfGasError if the query runs out of gas.
+func (k Keeper) queryRateContract(ctx sdk.Context, contractAddress sdk.AccAddress, queryMsg []byte) (bz []byte, err error) {
+	childCtx := ctx.WithGasMeter(sdk.NewGasMeter(types.RateContractQueryGasLimit))
+	defer func() {
+		if r := recover(); r != nil {
+			if isOutOfGas, _ := osmoutils.IsOutOfGasError(r); !isOutOfGas {
+				panic(r)
+			}
+			bz, err = nil, types.RateContractOutOfGasError{ContractAddress: contractAddress.String(), GasLimit: types.RateContractQueryGasLimit}
+		}
+		ctx.GasMeter().ConsumeGas(childCtx.GasMeter().GasConsumedToLimit(), "rate source contract query")
+	}()
+
+	return k.wasmKeeper.QuerySmart(childCtx, contractAddress, queryMsg)
+}
+
+// getStableswapPool returns the stableswap pool with the given id.
+// Returns error if the pool does not exist or is not a stableswap pool.
+func (k Keeper) getStableswapPool(ctx sdk.Context, poolId uint64) (*stableswap.Pool, error) {
+	pool, err := k.GetPoolAndPoke(ctx, poolId)
+	if err != nil {
+		return nil, err
+	}
+	stableswapPool, ok := pool.(*stableswap.Pool)
+	if !ok {
+		return nil, fmt.Errorf("pool id %d is not of type stableswap pool", poolId)
+	}
+	return stableswapPool, nil
+}
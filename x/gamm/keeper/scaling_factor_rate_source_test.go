@@ -0,0 +1,292 @@
+package keeper_test
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v24/x/gamm/pool-models/stableswap"
+	"github.com/osmosis-labs/osmosis/v24/x/gamm/types"
+)
+
+var defaultRateSourceScalingFactors = []uint64{1_000_000, 1_000_000}
+
+// prepareRateSourceStableswapPool creates a bar/foo stableswap pool whose scaling factors can be adjusted by TestAccs[0].
+func (s *KeeperTestSuite) prepareRateSourceStableswapPool() uint64 {
+	liquidity := sdk.NewCoins(sdk.NewCoin("bar", osmomath.NewInt(1_000_000_000_000)), sdk.NewCoin("foo", osmomath.NewInt(1_000_000_000_000)))
+	s.FundAcc(s.TestAccs[0], liquidity.Add(sdk.NewCoin("uosmo", osmomath.NewInt(10_000_000_000))))
+
+	msg := stableswap.NewMsgCreateStableswapPool(s.TestAccs[0], stableswap.PoolParams{SwapFee: osmomath.ZeroDec(), ExitFee: osmomath.ZeroDec()}, liquidity, defaultRateSourceScalingFactors, "")
+	msg.ScalingFactorController = s.TestAccs[0].String()
+	poolId, err := s.App.PoolManagerKeeper.CreatePool(s.Ctx, msg)
+	s.Require().NoError(err)
+	return poolId
+}
+
+func (s *KeeperTestSuite) getScalingFactors(poolId uint64) []uint64 {
+	pool, err := s.App.GAMMKeeper.GetPoolAndPoke(s.Ctx, poolId)
+	s.Require().NoError(err)
+	return pool.(*stableswap.Pool).GetScalingFactors()
+}
+
+// mockRateContractWasmKeeper answers every query with the given rate, after consuming the given amount of gas.
+type mockRateContractWasmKeeper struct {
+	gas  uint64
+	rate string
+}
+
+func (w mockRateContractWasmKeeper) QuerySmart(ctx sdk.Context, _ sdk.AccAddress, _ []byte) ([]byte, error) {
+	ctx.GasMeter().ConsumeGas(w.gas, "mock rate contract query")
+	return []byte(fmt.Sprintf(`{"rate":"%s"}`, w.rate)), nil
+}
+
+func (s *KeeperTestSuite) endDistrEpoch() {
+	epochIdentifier := s.App.IncentivesKeeper.GetEpochInfo(s.Ctx).Identifier
+	s.Require().NoError(s.App.GAMMKeeper.EpochHooks().AfterEpochEnd(s.Ctx, epochIdentifier, 1))
+}
+
+func (s *KeeperTestSuite) TestSetScalingFactorRateSource() {
+	redemptionRateSource := types.ScalingFactorRateSource{
+		BaseDenom:         "foo",
+		QuoteDenom:        "bar",
+		MaxChangePerEpoch: osmomath.MustNewDecFromStr("0.01"),
+		RedemptionRate:    &types.RedemptionRateSource{Rate: osmomath.MustNewDecFromStr("1.1")},
+	}
+
+	tests := map[string]struct {
+		isBalancerPool bool
+		rateSource     func(poolId uint64) *types.ScalingFactorRateSource
+		expectedErr    bool
+	}{
+		"redemption rate source": {
+			rateSource: func(uint64) *types.ScalingFactorRateSource { return &redemptionRateSource },
+		},
+		"twap rate source": {
+			rateSource: func(poolId uint64) *types.ScalingFactorRateSource {
+				rateSource := redemptionRateSource
+				rateSource.RedemptionRate = nil
+				rateSource.Twap = &types.TwapRateSource{PoolId: poolId + 1, Window: time.Hour}
+				return &rateSource
+			},
+		},
+		"error: twap of the pool itself": {
+			rateSource: func(poolId uint64) *types.ScalingFactorRateSource {
+				rateSource := redemptionRateSource
+				rateSource.RedemptionRate = nil
+				rateSource.Twap = &types.TwapRateSource{PoolId: poolId, Window: time.Hour}
+				return &rateSource
+			},
+			expectedErr: true,
+		},
+		"error: base denom not in pool": {
+			rateSource: func(uint64) *types.ScalingFactorRateSource {
+				rateSource := redemptionRateSource
+				rateSource.BaseDenom = "baz"
+				return &rateSource
+			},
+			expectedErr: true,
+		},
+		"error: invalid rate source": {
+			rateSource: func(uint64) *types.ScalingFactorRateSource {
+				rateSource := redemptionRateSource
+				rateSource.MaxChangePerEpoch = osmomath.OneDec()
+				return &rateSource
+			},
+			expectedErr: true,
+		},
+		"error: not a stableswap pool": {
+			isBalancerPool: true,
+			rateSource:     func(uint64) *types.ScalingFactorRateSource { return &redemptionRateSource },
+			expectedErr:    true,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			poolId := s.prepareRateSourceStableswapPool()
+			if tc.isBalancerPool {
+				poolId = s.PrepareBalancerPoolWithCoins(sdk.NewCoin("bar", osmomath.NewInt(1_000_000)), sdk.NewCoin("foo", osmomath.NewInt(1_000_000)))
+			}
+
+			rateSource := tc.rateSource(poolId)
+			err := s.App.GAMMKeeper.SetScalingFactorRateSource(s.Ctx, poolId, rateSource)
+			if tc.expectedErr {
+				s.Require().Error(err)
+				_, found, err := s.App.GAMMKeeper.GetScalingFactorRateSource(s.Ctx, poolId)
+				s.Require().NoError(err)
+				s.Require().False(found)
+				return
+			}
+			s.Require().NoError(err)
+
+			storedRateSource, found, err := s.App.GAMMKeeper.GetScalingFactorRateSource(s.Ctx, poolId)
+			s.Require().NoError(err)
+			s.Require().True(found)
+			s.Require().Equal(*rateSource, storedRateSource)
+
+			// The scaling factor controller can no longer adjust the scaling factors.
+			err = s.App.GAMMKeeper.SetStableSwapScalingFactors(s.Ctx, poolId, []uint64{1, 1}, s.TestAccs[0].String())
+			s.Require().ErrorIs(err, types.ErrScalingFactorsDrivenByRateSource)
+
+			// Removing the rate source hands the scaling factors back to the controller.
+			s.Require().NoError(s.App.GAMMKeeper.SetScalingFactorRateSource(s.Ctx, poolId, nil))
+			_, found, err = s.App.GAMMKeeper.GetScalingFactorRateSource(s.Ctx, poolId)
+			s.Require().NoError(err)
+			s.Require().False(found)
+			s.Require().NoError(s.App.GAMMKeeper.SetStableSwapScalingFactors(s.Ctx, poolId, []uint64{1, 1}, s.TestAccs[0].String()))
+		})
+	}
+}
+
+// TestUpdateScalingFactorsFromRateSources tests that the scaling factors of pools with a rate source move towards
+// the rate at the end of each distribution epoch, by at most the max change per epoch.
+func (s *KeeperTestSuite) TestUpdateScalingFactorsFromRateSources() {
+	s.Run("redemption rate source", func() {
+		s.SetupTest()
+		poolId := s.prepareRateSourceStableswapPool()
+		err := s.App.GAMMKeeper.SetScalingFactorRateSource(s.Ctx, poolId, &types.ScalingFactorRateSource{
+			BaseDenom:         "foo",
+			QuoteDenom:        "bar",
+			MaxChangePerEpoch: osmomath.MustNewDecFromStr("0.04"),
+			RedemptionRate:    &types.RedemptionRateSource{Rate: osmomath.MustNewDecFromStr("1.1")},
+		})
+		s.Require().NoError(err)
+
+		// Other epochs do not update the scaling factors.
+		s.Require().NoError(s.App.GAMMKeeper.EpochHooks().AfterEpochEnd(s.Ctx, "hour", 1))
+		s.Require().Equal(defaultRateSourceScalingFactors, s.getScalingFactors(poolId))
+
+		// The target scaling factor of foo is 1_000_000 / 1.1 = 909_091, which takes 3 epochs to reach.
+		s.endDistrEpoch()
+		s.Require().Equal([]uint64{1_000_000, 960_000}, s.getScalingFactors(poolId))
+		s.endDistrEpoch()
+		s.Require().Equal([]uint64{1_000_000, 921_600}, s.getScalingFactors(poolId))
+		s.endDistrEpoch()
+		s.Require().Equal([]uint64{1_000_000, 909_091}, s.getScalingFactors(poolId))
+		s.endDistrEpoch()
+		s.Require().Equal([]uint64{1_000_000, 909_091}, s.getScalingFactors(poolId))
+
+		// One unit of foo is now worth 1.1 units of bar.
+		spotPrice, err := s.App.GAMMKeeper.CalculateSpotPrice(s.Ctx, poolId, "bar", "foo")
+		s.Require().NoError(err)
+		s.Require().InDelta(1.1, spotPrice.Dec().MustFloat64(), 0.001)
+	})
+
+	s.Run("twap rate source", func() {
+		s.SetupTest()
+		poolId := s.prepareRateSourceStableswapPool()
+		// One unit of foo is worth 2 units of bar in the reference pool.
+		referencePoolId := s.PrepareBalancerPoolWithCoins(sdk.NewCoin("bar", osmomath.NewInt(2_000_000_000)), sdk.NewCoin("foo", osmomath.NewInt(1_000_000_000)))
+		s.App.TwapKeeper.EndBlock(s.Ctx)
+		s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(time.Hour))
+
+		err := s.App.GAMMKeeper.SetScalingFactorRateSource(s.Ctx, poolId, &types.ScalingFactorRateSource{
+			BaseDenom:         "foo",
+			QuoteDenom:        "bar",
+			MaxChangePerEpoch: osmomath.MustNewDecFromStr("0.6"),
+			Twap:              &types.TwapRateSource{PoolId: referencePoolId, Window: 30 * time.Minute},
+		})
+		s.Require().NoError(err)
+
+		s.endDistrEpoch()
+		s.Require().Equal([]uint64{1_000_000, 500_000}, s.getScalingFactors(poolId))
+	})
+
+	s.Run("contract rate source", func() {
+		s.SetupTest()
+		poolId := s.prepareRateSourceStableswapPool()
+		s.App.GAMMKeeper.SetWasmKeeper(mockRateContractWasmKeeper{gas: types.RateContractQueryGasLimit, rate: "2"})
+
+		err := s.App.GAMMKeeper.SetScalingFactorRateSource(s.Ctx, poolId, &types.ScalingFactorRateSource{
+			BaseDenom:         "foo",
+			QuoteDenom:        "bar",
+			MaxChangePerEpoch: osmomath.MustNewDecFromStr("0.5"),
+			Contract:          &types.ContractRateSource{ContractAddress: s.TestAccs[1].String(), QueryMsg: []byte(`{"rate":{}}`)},
+		})
+		s.Require().NoError(err)
+
+		s.endDistrEpoch()
+		s.Require().Equal([]uint64{1_000_000, 500_000}, s.getScalingFactors(poolId))
+	})
+
+	s.Run("a contract rate query running out of gas is skipped", func() {
+		s.SetupTest()
+		failingPoolId := s.prepareRateSourceStableswapPool()
+		poolId := s.prepareRateSourceStableswapPool()
+		s.App.GAMMKeeper.SetWasmKeeper(mockRateContractWasmKeeper{gas: types.RateContractQueryGasLimit + 1, rate: "2"})
+
+		err := s.App.GAMMKeeper.SetScalingFactorRateSource(s.Ctx, failingPoolId, &types.ScalingFactorRateSource{
+			BaseDenom:         "foo",
+			QuoteDenom:        "bar",
+			MaxChangePerEpoch: osmomath.MustNewDecFromStr("0.5"),
+			Contract:          &types.ContractRateSource{ContractAddress: s.TestAccs[1].String(), QueryMsg: []byte(`{"rate":{}}`)},
+		})
+		s.Require().NoError(err)
+		err = s.App.GAMMKeeper.SetScalingFactorRateSource(s.Ctx, poolId, &types.ScalingFactorRateSource{
+			BaseDenom:         "foo",
+			QuoteDenom:        "bar",
+			MaxChangePerEpoch: osmomath.MustNewDecFromStr("0.5"),
+			RedemptionRate:    &types.RedemptionRateSource{Rate: osmomath.NewDec(2)},
+		})
+		s.Require().NoError(err)
+
+		// The epoch hook runs under an infinite gas meter.
+		s.Ctx = s.Ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+		s.Require().NotPanics(s.endDistrEpoch)
+		s.Require().Equal(defaultRateSourceScalingFactors, s.getScalingFactors(failingPoolId))
+		s.Require().Equal([]uint64{1_000_000, 500_000}, s.getScalingFactors(poolId))
+	})
+
+	s.Run("a failing rate source does not affect other pools", func() {
+		s.SetupTest()
+		failingPoolId := s.prepareRateSourceStableswapPool()
+		poolId := s.prepareRateSourceStableswapPool()
+
+		// The reference pool has no TWAP records, so its rate can not be read.
+		referencePoolId := s.PrepareBalancerPoolWithCoins(sdk.NewCoin("bar", osmomath.NewInt(2_000_000_000)), sdk.NewCoin("foo", osmomath.NewInt(1_000_000_000)))
+		err := s.App.GAMMKeeper.SetScalingFactorRateSource(s.Ctx, failingPoolId, &types.ScalingFactorRateSource{
+			BaseDenom:         "foo",
+			QuoteDenom:        "bar",
+			MaxChangePerEpoch: osmomath.MustNewDecFromStr("0.5"),
+			Twap:              &types.TwapRateSource{PoolId: referencePoolId, Window: time.Hour},
+		})
+		s.Require().NoError(err)
+		err = s.App.GAMMKeeper.SetScalingFactorRateSource(s.Ctx, poolId, &types.ScalingFactorRateSource{
+			BaseDenom:         "foo",
+			QuoteDenom:        "bar",
+			MaxChangePerEpoch: osmomath.MustNewDecFromStr("0.5"),
+			RedemptionRate:    &types.RedemptionRateSource{Rate: osmomath.NewDec(2)},
+		})
+		s.Require().NoError(err)
+
+		s.endDistrEpoch()
+		s.Require().Equal(defaultRateSourceScalingFactors, s.getScalingFactors(failingPoolId))
+		s.Require().Equal([]uint64{1_000_000, 500_000}, s.getScalingFactors(poolId))
+	})
+}
+
+func (s *KeeperTestSuite) TestScalingFactorRateSourceGenesis() {
+	s.SetupTest()
+	poolId := s.prepareRateSourceStableswapPool()
+	rateSource := types.ScalingFactorRateSource{
+		BaseDenom:         "foo",
+		QuoteDenom:        "bar",
+		MaxChangePerEpoch: osmomath.MustNewDecFromStr("0.01"),
+		RedemptionRate:    &types.RedemptionRateSource{Rate: osmomath.MustNewDecFromStr("1.1")},
+	}
+	s.Require().NoError(s.App.GAMMKeeper.SetScalingFactorRateSource(s.Ctx, poolId, &rateSource))
+
+	genesis := s.App.GAMMKeeper.ExportGenesis(s.Ctx)
+	s.Require().Equal([]types.PoolIdToScalingFactorRateSource{{PoolId: poolId, RateSource: rateSource}}, genesis.ScalingFactorRateSources)
+
+	s.Require().NoError(s.App.GAMMKeeper.SetScalingFactorRateSource(s.Ctx, poolId, nil))
+	s.App.GAMMKeeper.InitGenesis(s.Ctx, *genesis, s.App.AppCodec())
+
+	storedRateSource, found, err := s.App.GAMMKeeper.GetScalingFactorRateSource(s.Ctx, poolId)
+	s.Require().NoError(err)
+	s.Require().True(found)
+	s.Require().Equal(rateSource, storedRateSource)
+}
@@ -1,6 +1,8 @@
 package balancer
 
 import (
+	"time"
+
 	"github.com/osmosis-labs/osmosis/osmomath"
 )
 
@@ -18,6 +20,14 @@ var (
 	// This is done so that smooth weight changes have enough precision to actually be smooth.
 	GuaranteedWeightPrecision int64 = 1 << 30
 
+	// MinSmoothWeightChangeDuration is the minimum duration of a smooth weight change scheduled
+	// with MsgSetSmoothWeightChange.
+	MinSmoothWeightChangeDuration = time.Hour
+	// MaxSmoothWeightChangePerHour bounds how fast a smooth weight change scheduled with
+	// MsgSetSmoothWeightChange moves the normalized weight of any asset, i.e. its share of
+	// the total weight, per hour of the duration.
+	MaxSmoothWeightChangePerHour = osmomath.MustNewDecFromStr("0.02")
+
 	PoolTypeName string = "Balancer"
 	oneDec              = osmomath.OneDec()
 )
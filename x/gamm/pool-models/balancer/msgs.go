@@ -1,6 +1,8 @@
 package balancer
 
 import (
+	"time"
+
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -10,7 +12,8 @@ import (
 )
 
 const (
-	TypeMsgCreateBalancerPool = "create_balancer_pool"
+	TypeMsgCreateBalancerPool    = "create_balancer_pool"
+	TypeMsgSetSmoothWeightChange = "set_smooth_weight_change"
 )
 
 var (
@@ -104,3 +107,63 @@ func (msg MsgCreateBalancerPool) CreatePool(ctx sdk.Context, poolID uint64) (poo
 func (msg MsgCreateBalancerPool) GetPoolType() poolmanagertypes.PoolType {
 	return poolmanagertypes.Balancer
 }
+
+var _ sdk.Msg = &MsgSetSmoothWeightChange{}
+
+func NewMsgSetSmoothWeightChange(
+	sender string,
+	poolID uint64,
+	startTime time.Time,
+	duration time.Duration,
+	targetPoolWeights []PoolAsset,
+) MsgSetSmoothWeightChange {
+	return MsgSetSmoothWeightChange{
+		Sender:            sender,
+		PoolID:            poolID,
+		StartTime:         startTime,
+		Duration:          duration,
+		TargetPoolWeights: targetPoolWeights,
+	}
+}
+
+func (msg MsgSetSmoothWeightChange) Route() string { return types.RouterKey }
+func (msg MsgSetSmoothWeightChange) Type() string  { return TypeMsgSetSmoothWeightChange }
+func (msg MsgSetSmoothWeightChange) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if len(msg.TargetPoolWeights) == 0 {
+		return types.ErrPoolParamsInvalidNumDenoms
+	}
+
+	exists := make(map[string]bool)
+	for _, asset := range msg.TargetPoolWeights {
+		if err := ValidateUserSpecifiedWeight(asset.Weight); err != nil {
+			return err
+		}
+		if exists[asset.Token.Denom] {
+			return types.ErrPoolParamsInvalidDenom
+		}
+		exists[asset.Token.Denom] = true
+	}
+
+	if msg.Duration < MinSmoothWeightChangeDuration {
+		return errorsmod.Wrapf(types.ErrSmoothWeightChangeTooShort, "duration (%s) must be at least %s", msg.Duration, MinSmoothWeightChangeDuration)
+	}
+
+	return nil
+}
+
+func (msg MsgSetSmoothWeightChange) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgSetSmoothWeightChange) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
@@ -2,6 +2,7 @@ package balancer_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -243,6 +244,125 @@ func TestMsgCreateBalancerPool_ValidateBasic(t *testing.T) {
 	}
 }
 
+func TestMsgSetSmoothWeightChange_ValidateBasic(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address()).String()
+	invalidAddr := sdk.AccAddress("invalid")
+
+	createMsg := func(after func(msg balancer.MsgSetSmoothWeightChange) balancer.MsgSetSmoothWeightChange) balancer.MsgSetSmoothWeightChange {
+		msg := balancer.NewMsgSetSmoothWeightChange(addr1, 1, time.Unix(1618700000, 0), time.Hour, []balancer.PoolAsset{
+			{
+				Weight: osmomath.NewInt(1),
+				Token:  sdk.NewCoin("test", osmomath.ZeroInt()),
+			},
+			{
+				Weight: osmomath.NewInt(9),
+				Token:  sdk.NewCoin("test2", osmomath.ZeroInt()),
+			},
+		})
+		return after(msg)
+	}
+
+	default_msg := createMsg(func(msg balancer.MsgSetSmoothWeightChange) balancer.MsgSetSmoothWeightChange {
+		// Do nothing
+		return msg
+	})
+
+	require.Equal(t, default_msg.Route(), types.RouterKey)
+	require.Equal(t, default_msg.Type(), "set_smooth_weight_change")
+	signers := default_msg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1)
+
+	tests := []struct {
+		name       string
+		msg        balancer.MsgSetSmoothWeightChange
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: createMsg(func(msg balancer.MsgSetSmoothWeightChange) balancer.MsgSetSmoothWeightChange {
+				// Do nothing
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "empty start time",
+			msg: createMsg(func(msg balancer.MsgSetSmoothWeightChange) balancer.MsgSetSmoothWeightChange {
+				msg.StartTime = time.Time{}
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: createMsg(func(msg balancer.MsgSetSmoothWeightChange) balancer.MsgSetSmoothWeightChange {
+				msg.Sender = invalidAddr.String()
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "empty target weights",
+			msg: createMsg(func(msg balancer.MsgSetSmoothWeightChange) balancer.MsgSetSmoothWeightChange {
+				msg.TargetPoolWeights = nil
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero target weight",
+			msg: createMsg(func(msg balancer.MsgSetSmoothWeightChange) balancer.MsgSetSmoothWeightChange {
+				msg.TargetPoolWeights[0].Weight = osmomath.ZeroInt()
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "target weight too large",
+			msg: createMsg(func(msg balancer.MsgSetSmoothWeightChange) balancer.MsgSetSmoothWeightChange {
+				msg.TargetPoolWeights[0].Weight = balancer.MaxUserSpecifiedWeight
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "repeated target weight denom",
+			msg: createMsg(func(msg balancer.MsgSetSmoothWeightChange) balancer.MsgSetSmoothWeightChange {
+				msg.TargetPoolWeights[1].Token.Denom = msg.TargetPoolWeights[0].Token.Denom
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero duration",
+			msg: createMsg(func(msg balancer.MsgSetSmoothWeightChange) balancer.MsgSetSmoothWeightChange {
+				msg.Duration = 0
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "duration below the minimum",
+			msg: createMsg(func(msg balancer.MsgSetSmoothWeightChange) balancer.MsgSetSmoothWeightChange {
+				msg.Duration = balancer.MinSmoothWeightChangeDuration - time.Second
+				return msg
+			}),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
 func (s *KeeperTestSuite) TestMsgCreateBalancerPool() {
 	tests := map[string]struct {
 		msg         balancer.MsgCreateBalancerPool
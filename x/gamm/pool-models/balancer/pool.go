@@ -423,7 +423,7 @@ func (p *Pool) PokePool(blockTime time.Time) {
 		return
 
 	case blockTime.After(params.StartTime.Add(params.Duration)):
-		// case 2: start_time < t <= start_time + duration:
+		// case 3: t > start_time + duration: w(t) = target_pool_weights
 
 		// Update weights to be the target weights.
 		//
@@ -436,7 +436,7 @@ func (p *Pool) PokePool(blockTime time.Time) {
 		return
 
 	default:
-		// case 3: t > start_time + duration: w(t) = target_pool_weights
+		// case 2: start_time < t <= start_time + duration:
 
 		shiftedBlockTime := blockTime.Sub(params.StartTime).Milliseconds()
 		percentDurationElapsed := osmomath.NewDec(shiftedBlockTime).QuoInt64(params.Duration.Milliseconds())
@@ -457,6 +457,68 @@ func (p *Pool) PokePool(blockTime time.Time) {
 	}
 }
 
+// SetSmoothWeightChange schedules the weights of the pool to change linearly from their current value to the
+// target weights of params, replacing any weight change in progress. The pool is assumed to be poked at blockTime.
+// It should only be able to be successfully called by the pool's FuturePoolGovernor, when it is an address.
+// The change must last at least MinSmoothWeightChangeDuration, and move the normalized weight of every asset
+// by at most MaxSmoothWeightChangePerHour per hour, so that the price cannot be moved abruptly against LPs.
+func (p *Pool) SetSmoothWeightChange(params SmoothWeightChangeParams, sender string, blockTime time.Time) error {
+	if sender != p.FuturePoolGovernor {
+		return types.ErrNotFuturePoolGovernor
+	}
+
+	if params.Duration < MinSmoothWeightChangeDuration {
+		return errorsmod.Wrapf(types.ErrSmoothWeightChangeTooShort, "duration (%s) must be at least %s", params.Duration, MinSmoothWeightChangeDuration)
+	}
+
+	// A start time in the past would make the weights jump on the next poke.
+	if params.StartTime.Unix() <= 0 {
+		params.StartTime = blockTime
+	} else if params.StartTime.Before(blockTime) {
+		return types.ErrInvalidWeightChangeStart
+	}
+
+	// The target weights are sorted and scaled in place, so we copy them not to mutate the caller's.
+	params.TargetPoolWeights = append([]PoolAsset(nil), params.TargetPoolWeights...)
+	params.InitialPoolWeights = nil
+
+	poolParams := p.PoolParams
+	poolParams.SmoothWeightChangeParams = &params
+	if err := poolParams.Validate(p.PoolAssets); err != nil {
+		return err
+	}
+	if err := p.validateSmoothWeightChangeRate(params); err != nil {
+		return err
+	}
+
+	return p.setInitialPoolParams(poolParams, p.GetAllPoolAssets(), blockTime)
+}
+
+// validateSmoothWeightChangeRate returns an error if the weight change of params moves the normalized weight
+// of any asset of the pool by more than MaxSmoothWeightChangePerHour per hour of its duration.
+// The target weights are assumed to be validated against the pool assets.
+func (p Pool) validateSmoothWeightChangeRate(params SmoothWeightChangeParams) error {
+	totalTargetWeight := osmomath.ZeroInt()
+	for _, asset := range params.TargetPoolWeights {
+		totalTargetWeight = totalTargetWeight.Add(asset.Weight)
+	}
+
+	maxChange := MaxSmoothWeightChangePerHour.MulInt64(params.Duration.Milliseconds()).QuoInt64(time.Hour.Milliseconds())
+	for _, asset := range params.TargetPoolWeights {
+		poolAsset, err := p.GetPoolAsset(asset.Token.Denom)
+		if err != nil {
+			return err
+		}
+		currentWeight := poolAsset.Weight.ToLegacyDec().QuoInt(p.TotalWeight)
+		targetWeight := asset.Weight.ToLegacyDec().QuoInt(totalTargetWeight)
+		if change := targetWeight.Sub(currentWeight).Abs(); change.GT(maxChange) {
+			return errorsmod.Wrapf(types.ErrSmoothWeightChangeTooFast, "normalized weight of %s changes by %s over %s, at most %s per hour",
+				asset.Token.Denom, change, params.Duration, MaxSmoothWeightChangePerHour)
+		}
+	}
+	return nil
+}
+
 func (p Pool) GetTokenWeight(denom string) (osmomath.Int, error) {
 	PoolAsset, err := p.GetPoolAsset(denom)
 	if err != nil {
@@ -979,6 +979,181 @@ func TestLBPParamsEmptyStartTime(t *testing.T) {
 	require.Equal(t, pacc.PoolParams.SmoothWeightChangeParams.StartTime, defaultCurBlockTime)
 }
 
+func TestSetSmoothWeightChange(t *testing.T) {
+	governor := sdk.AccAddress("governor").String()
+	blockTime := defaultCurBlockTime.Add(time.Hour)
+	scaledWeight := func(weight int64) osmomath.Int {
+		return osmomath.NewInt(weight).MulRaw(balancer.GuaranteedWeightPrecision)
+	}
+	initialPoolAssets := []balancer.PoolAsset{
+		{
+			Weight: osmomath.NewInt(1),
+			Token:  sdk.NewCoin("asset1", osmomath.NewInt(1000)),
+		},
+		{
+			Weight: osmomath.NewInt(1),
+			Token:  sdk.NewCoin("asset2", osmomath.NewInt(1000)),
+		},
+	}
+	// The target weights are given out of denom order to check they are sorted.
+	targetPoolWeights := func(weight1, weight2 int64) []balancer.PoolAsset {
+		return []balancer.PoolAsset{
+			{
+				Weight: osmomath.NewInt(weight2),
+				Token:  sdk.NewCoin("asset2", osmomath.ZeroInt()),
+			},
+			{
+				Weight: osmomath.NewInt(weight1),
+				Token:  sdk.NewCoin("asset1", osmomath.ZeroInt()),
+			},
+		}
+	}
+
+	tests := map[string]struct {
+		// weightChangeInProgress schedules a change of the weights to 1:5 over 2 hours at pool creation,
+		// so that the weights are 1:3 at the block time.
+		weightChangeInProgress bool
+		sender                 string
+		params                 balancer.SmoothWeightChangeParams
+
+		expectedInitialWeights []osmomath.Int
+		expectedStartTime      time.Time
+		expectedErr            error
+	}{
+		"weight change from the pool weights, starting at the block time": {
+			sender: governor,
+			params: balancer.SmoothWeightChangeParams{
+				Duration:          24 * time.Hour,
+				TargetPoolWeights: targetPoolWeights(1, 9),
+			},
+			expectedInitialWeights: []osmomath.Int{scaledWeight(1), scaledWeight(1)},
+			expectedStartTime:      blockTime,
+		},
+		"weight change from the weights of the change in progress, starting in the future": {
+			weightChangeInProgress: true,
+			sender:                 governor,
+			params: balancer.SmoothWeightChangeParams{
+				StartTime:         blockTime.Add(time.Minute),
+				Duration:          24 * time.Hour,
+				TargetPoolWeights: targetPoolWeights(1, 9),
+			},
+			expectedInitialWeights: []osmomath.Int{scaledWeight(1), scaledWeight(3)},
+			expectedStartTime:      blockTime.Add(time.Minute),
+		},
+		"weight change at the maximum rate": {
+			sender: governor,
+			params: balancer.SmoothWeightChangeParams{
+				// The normalized weight of asset2 moves from 0.5 to 0.1 over 20 hours.
+				Duration:          20 * time.Hour,
+				TargetPoolWeights: targetPoolWeights(1, 9),
+			},
+			expectedInitialWeights: []osmomath.Int{scaledWeight(1), scaledWeight(1)},
+			expectedStartTime:      blockTime,
+		},
+		"error: sender is not the future governor": {
+			sender: sdk.AccAddress("notgovernor").String(),
+			params: balancer.SmoothWeightChangeParams{
+				Duration:          24 * time.Hour,
+				TargetPoolWeights: targetPoolWeights(1, 9),
+			},
+			expectedErr: types.ErrNotFuturePoolGovernor,
+		},
+		"error: start time before the block time": {
+			sender: governor,
+			params: balancer.SmoothWeightChangeParams{
+				StartTime:         blockTime.Add(-time.Second),
+				Duration:          24 * time.Hour,
+				TargetPoolWeights: targetPoolWeights(1, 9),
+			},
+			expectedErr: types.ErrInvalidWeightChangeStart,
+		},
+		"error: duration too short": {
+			sender: governor,
+			params: balancer.SmoothWeightChangeParams{
+				Duration:          balancer.MinSmoothWeightChangeDuration - time.Second,
+				TargetPoolWeights: targetPoolWeights(1, 1),
+			},
+			expectedErr: types.ErrSmoothWeightChangeTooShort,
+		},
+		"error: weight change too fast": {
+			sender: governor,
+			params: balancer.SmoothWeightChangeParams{
+				Duration:          20*time.Hour - time.Second,
+				TargetPoolWeights: targetPoolWeights(1, 9),
+			},
+			expectedErr: types.ErrSmoothWeightChangeTooFast,
+		},
+		"error: target weight denom not in pool": {
+			sender: governor,
+			params: balancer.SmoothWeightChangeParams{
+				Duration: time.Hour,
+				TargetPoolWeights: []balancer.PoolAsset{
+					{
+						Weight: osmomath.NewInt(1),
+						Token:  sdk.NewCoin("asset1", osmomath.ZeroInt()),
+					},
+					{
+						Weight: osmomath.NewInt(1),
+						Token:  sdk.NewCoin("asset3", osmomath.ZeroInt()),
+					},
+				},
+			},
+			expectedErr: types.ErrPoolParamsInvalidDenom,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			poolParams := defaultBalancerPoolParams
+			if tc.weightChangeInProgress {
+				poolParams.SmoothWeightChangeParams = &balancer.SmoothWeightChangeParams{
+					Duration:          2 * time.Hour,
+					TargetPoolWeights: targetPoolWeights(1, 5),
+				}
+			}
+			pool, err := balancer.NewBalancerPool(defaultPoolId, poolParams, initialPoolAssets, governor, defaultCurBlockTime)
+			require.NoError(t, err)
+			pool.PokePool(blockTime)
+
+			msgTargetPoolWeights := tc.params.TargetPoolWeights
+			err = pool.SetSmoothWeightChange(tc.params, tc.sender, blockTime)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+
+			// The target weights of the caller are not mutated.
+			require.Equal(t, targetPoolWeights(1, 9), msgTargetPoolWeights)
+
+			params := pool.PoolParams.SmoothWeightChangeParams
+			require.NotNil(t, params)
+			require.Equal(t, tc.expectedStartTime, params.StartTime)
+			require.Equal(t, tc.params.Duration, params.Duration)
+			for i, asset := range pool.PoolAssets {
+				require.Equal(t, asset.Token.Denom, params.InitialPoolWeights[i].Token.Denom)
+				require.Equal(t, tc.expectedInitialWeights[i], params.InitialPoolWeights[i].Weight)
+				require.Equal(t, tc.expectedInitialWeights[i], asset.Weight)
+			}
+			require.Equal(t, "asset1", params.TargetPoolWeights[0].Token.Denom)
+			require.Equal(t, scaledWeight(1), params.TargetPoolWeights[0].Weight)
+			require.Equal(t, "asset2", params.TargetPoolWeights[1].Token.Denom)
+			require.Equal(t, scaledWeight(9), params.TargetPoolWeights[1].Weight)
+
+			// Halfway through, the weights are halfway from the initial to the target weights.
+			pool.PokePool(params.StartTime.Add(params.Duration / 2))
+			require.Equal(t, scaledWeight(1), pool.PoolAssets[0].Weight)
+			require.Equal(t, tc.expectedInitialWeights[1].Add(scaledWeight(9)).QuoRaw(2), pool.PoolAssets[1].Weight)
+
+			// Past the end, the weights are the target weights and the weight change is over.
+			pool.PokePool(params.StartTime.Add(params.Duration + time.Second))
+			require.Equal(t, scaledWeight(1), pool.PoolAssets[0].Weight)
+			require.Equal(t, scaledWeight(9), pool.PoolAssets[1].Weight)
+			require.Nil(t, pool.PoolParams.SmoothWeightChangeParams)
+		})
+	}
+}
+
 func TestBalancerPoolPokeTokenWeights(t *testing.T) {
 	// Set default date
 	defaultStartTime := time.Unix(1618703511, 0)
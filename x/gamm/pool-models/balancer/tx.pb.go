@@ -11,19 +11,23 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -145,9 +149,132 @@ func (m *MsgCreateBalancerPoolResponse) GetPoolID() uint64 {
 	return 0
 }
 
+// ===================== MsgSetSmoothWeightChange
+// Sender must be the pool's future_pool_governor in order for the tx to
+// succeed. Schedules the weights of the pool to change linearly from their
+// current value to target_pool_weights, between start_time and
+// start_time + duration. Replaces any weight change in progress.
+type MsgSetSmoothWeightChange struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	PoolID uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// start_time is the time the weights start changing at. It must not be
+	// before the block time. If left blank, it is set to the block time.
+	StartTime time.Time     `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
+	Duration  time.Duration `protobuf:"bytes,4,opt,name=duration,proto3,stdduration" json:"duration" yaml:"duration"`
+	// target_pool_weights are the weights of the pool once the change is over,
+	// one per pool asset. The PoolAsset.token.amount field is ignored.
+	TargetPoolWeights []PoolAsset `protobuf:"bytes,5,rep,name=target_pool_weights,json=targetPoolWeights,proto3" json:"target_pool_weights" yaml:"target_pool_weights"`
+}
+
+func (m *MsgSetSmoothWeightChange) Reset()         { *m = MsgSetSmoothWeightChange{} }
+func (m *MsgSetSmoothWeightChange) String() string { return proto.CompactTextString(m) }
+func (*MsgSetSmoothWeightChange) ProtoMessage()    {}
+func (*MsgSetSmoothWeightChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4d22c5192b37962a, []int{2}
+}
+func (m *MsgSetSmoothWeightChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetSmoothWeightChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetSmoothWeightChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetSmoothWeightChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetSmoothWeightChange.Merge(m, src)
+}
+func (m *MsgSetSmoothWeightChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetSmoothWeightChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetSmoothWeightChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetSmoothWeightChange proto.InternalMessageInfo
+
+func (m *MsgSetSmoothWeightChange) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgSetSmoothWeightChange) GetPoolID() uint64 {
+	if m != nil {
+		return m.PoolID
+	}
+	return 0
+}
+
+func (m *MsgSetSmoothWeightChange) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *MsgSetSmoothWeightChange) GetDuration() time.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+func (m *MsgSetSmoothWeightChange) GetTargetPoolWeights() []PoolAsset {
+	if m != nil {
+		return m.TargetPoolWeights
+	}
+	return nil
+}
+
+type MsgSetSmoothWeightChangeResponse struct {
+}
+
+func (m *MsgSetSmoothWeightChangeResponse) Reset()         { *m = MsgSetSmoothWeightChangeResponse{} }
+func (m *MsgSetSmoothWeightChangeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetSmoothWeightChangeResponse) ProtoMessage()    {}
+func (*MsgSetSmoothWeightChangeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4d22c5192b37962a, []int{3}
+}
+func (m *MsgSetSmoothWeightChangeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetSmoothWeightChangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetSmoothWeightChangeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetSmoothWeightChangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetSmoothWeightChangeResponse.Merge(m, src)
+}
+func (m *MsgSetSmoothWeightChangeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetSmoothWeightChangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetSmoothWeightChangeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetSmoothWeightChangeResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgCreateBalancerPool)(nil), "osmosis.gamm.poolmodels.balancer.v1beta1.MsgCreateBalancerPool")
 	proto.RegisterType((*MsgCreateBalancerPoolResponse)(nil), "osmosis.gamm.poolmodels.balancer.v1beta1.MsgCreateBalancerPoolResponse")
+	proto.RegisterType((*MsgSetSmoothWeightChange)(nil), "osmosis.gamm.poolmodels.balancer.v1beta1.MsgSetSmoothWeightChange")
+	proto.RegisterType((*MsgSetSmoothWeightChangeResponse)(nil), "osmosis.gamm.poolmodels.balancer.v1beta1.MsgSetSmoothWeightChangeResponse")
 }
 
 func init() {
@@ -155,38 +282,50 @@ func init() {
 }
 
 var fileDescriptor_4d22c5192b37962a = []byte{
-	// 494 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0x41, 0x6f, 0xd3, 0x30,
-	0x14, 0xc7, 0x9b, 0xad, 0x2a, 0xc2, 0x15, 0x87, 0x59, 0x03, 0x55, 0x45, 0x24, 0x25, 0x48, 0x50,
-	0x90, 0x6a, 0xab, 0x85, 0xd3, 0x2e, 0x13, 0x61, 0x62, 0xda, 0x61, 0xd2, 0xc8, 0x6d, 0x5c, 0x26,
-	0x27, 0xf5, 0x42, 0xa4, 0x38, 0x2f, 0x8a, 0xdd, 0x6a, 0x7c, 0x05, 0x4e, 0x7c, 0x02, 0x8e, 0x9c,
-	0xf9, 0x18, 0x3b, 0xee, 0xc8, 0x29, 0x9a, 0xda, 0x03, 0xf7, 0x7e, 0x02, 0x64, 0xc7, 0xd9, 0x56,
-	0x54, 0x24, 0x24, 0x2e, 0x51, 0xfc, 0xfc, 0xfb, 0xff, 0xdf, 0xf3, 0x7b, 0x36, 0x1a, 0x83, 0x14,
-	0x20, 0x53, 0x49, 0x13, 0x26, 0x04, 0x2d, 0x00, 0x32, 0x01, 0x53, 0x9e, 0x49, 0x1a, 0xb1, 0x8c,
-	0xe5, 0x31, 0x2f, 0xe9, 0x7c, 0x1c, 0x71, 0xc5, 0xc6, 0x54, 0x5d, 0x90, 0xa2, 0x04, 0x05, 0x78,
-	0x68, 0x25, 0x44, 0x4b, 0xc8, 0xad, 0x84, 0x34, 0x12, 0x62, 0x25, 0xfd, 0xdd, 0x04, 0x12, 0x30,
-	0x22, 0xaa, 0xff, 0x6a, 0x7d, 0x7f, 0x87, 0x89, 0x34, 0x07, 0x6a, 0xbe, 0x36, 0xf4, 0x62, 0xad,
-	0x8a, 0x26, 0x63, 0xe3, 0x77, 0x02, 0x90, 0x59, 0xd0, 0x8d, 0x0d, 0x49, 0x23, 0x26, 0xf9, 0x0d,
-	0x17, 0x43, 0x9a, 0xdb, 0x7d, 0x2f, 0x01, 0x48, 0x32, 0x4e, 0xcd, 0x2a, 0x9a, 0x9d, 0x53, 0x95,
-	0x0a, 0x2e, 0x15, 0x13, 0x45, 0x0d, 0xf8, 0xd7, 0x5b, 0xe8, 0xe1, 0xb1, 0x4c, 0xde, 0x95, 0x9c,
-	0x29, 0x1e, 0xdc, 0x49, 0x80, 0x5f, 0xa2, 0x8e, 0xe4, 0xf9, 0x94, 0x97, 0x3d, 0x67, 0xe0, 0x0c,
-	0xef, 0x07, 0x3b, 0xab, 0xca, 0x7b, 0xf0, 0x99, 0x89, 0x6c, 0xcf, 0xaf, 0xe3, 0x7e, 0x68, 0x01,
-	0x7c, 0x8a, 0xba, 0xfa, 0xd8, 0x67, 0x05, 0x2b, 0x99, 0x90, 0xbd, 0xad, 0x81, 0x33, 0xec, 0x4e,
-	0x06, 0x64, 0xad, 0x2f, 0xb6, 0x38, 0xa2, 0xbd, 0x4f, 0x0c, 0x17, 0x3c, 0x5a, 0x55, 0x1e, 0xae,
-	0x1d, 0xef, 0xc8, 0xfd, 0x10, 0x15, 0x37, 0x0c, 0x7e, 0x6f, 0xad, 0x99, 0x94, 0x5c, 0xc9, 0xde,
-	0xf6, 0x60, 0x7b, 0xd8, 0x9d, 0x78, 0x7f, 0xb7, 0x7e, 0xab, 0xb9, 0xa0, 0x7d, 0x59, 0x79, 0xad,
-	0xda, 0xc7, 0x04, 0x24, 0xfe, 0x80, 0x76, 0xcf, 0x67, 0x6a, 0x56, 0xf2, 0x33, 0x63, 0x97, 0xc0,
-	0x9c, 0x97, 0x39, 0x94, 0xbd, 0xb6, 0x39, 0x9b, 0xb7, 0xaa, 0xbc, 0xc7, 0x75, 0x25, 0x9b, 0x28,
-	0x3f, 0xc4, 0x75, 0x58, 0x67, 0x38, 0xb4, 0xc1, 0xbd, 0xe7, 0x5f, 0x7e, 0xfd, 0x78, 0xf5, 0x74,
-	0x6d, 0x52, 0xb1, 0x69, 0xe3, 0xa8, 0x19, 0xd4, 0x48, 0xbb, 0xf8, 0x07, 0xe8, 0xc9, 0xc6, 0x0e,
-	0x87, 0x5c, 0x16, 0x90, 0x4b, 0x8e, 0x9f, 0xa1, 0x7b, 0x26, 0x5d, 0x3a, 0x35, 0xad, 0x6e, 0x07,
-	0x68, 0x51, 0x79, 0x1d, 0x8d, 0x1c, 0x1d, 0x84, 0x1d, 0xbd, 0x75, 0x34, 0x9d, 0x7c, 0x77, 0xd0,
-	0xf6, 0xb1, 0x4c, 0xf0, 0x37, 0x07, 0xe1, 0x0d, 0xd3, 0xda, 0x27, 0xff, 0x7a, 0x0b, 0xc9, 0xc6,
-	0x62, 0xfa, 0x87, 0xff, 0x69, 0xd0, 0x9c, 0x26, 0x38, 0xbd, 0x5c, 0xb8, 0xce, 0xd5, 0xc2, 0x75,
-	0xae, 0x17, 0xae, 0xf3, 0x75, 0xe9, 0xb6, 0xae, 0x96, 0x6e, 0xeb, 0xe7, 0xd2, 0x6d, 0x7d, 0xdc,
-	0x4f, 0x52, 0xf5, 0x69, 0x16, 0x91, 0x18, 0x04, 0xb5, 0xc9, 0x46, 0x19, 0x8b, 0x64, 0xb3, 0xa0,
-	0xf3, 0xc9, 0x1b, 0x7a, 0x71, 0xfb, 0xf2, 0x46, 0x7f, 0x3c, 0xbd, 0xa8, 0x63, 0xee, 0xec, 0xeb,
-	0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xf5, 0x48, 0x49, 0x6e, 0xa5, 0x03, 0x00, 0x00,
+	// 687 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0x3d, 0x4f, 0xdb, 0x4e,
+	0x1c, 0x8e, 0x13, 0xfe, 0xf9, 0x97, 0x8b, 0xaa, 0x2a, 0x2e, 0x54, 0x6e, 0x10, 0x76, 0xea, 0xaa,
+	0x6d, 0x8a, 0x64, 0x5b, 0xa4, 0x9d, 0x58, 0x50, 0x0d, 0x2a, 0xa2, 0x12, 0x12, 0x35, 0x95, 0x5a,
+	0xba, 0xa0, 0x73, 0x72, 0x38, 0x96, 0x6c, 0x5f, 0xe4, 0xbb, 0x50, 0xfa, 0x15, 0x3a, 0x31, 0x76,
+	0xea, 0xda, 0xb5, 0x6b, 0xbf, 0x01, 0x23, 0x63, 0xa7, 0x14, 0x85, 0xa1, 0x7b, 0x3e, 0x41, 0x75,
+	0x6f, 0x81, 0x50, 0x23, 0x81, 0x58, 0x10, 0xfe, 0xdd, 0xf3, 0x72, 0xf9, 0x3d, 0x4f, 0x62, 0xb0,
+	0x8c, 0x49, 0x8a, 0x49, 0x4c, 0xbc, 0x08, 0xa6, 0xa9, 0xd7, 0xc7, 0x38, 0x49, 0x71, 0x17, 0x25,
+	0xc4, 0x0b, 0x61, 0x02, 0xb3, 0x0e, 0xca, 0xbd, 0x83, 0xe5, 0x10, 0x51, 0xb8, 0xec, 0xd1, 0x43,
+	0xb7, 0x9f, 0x63, 0x8a, 0xf5, 0x96, 0xa4, 0xb8, 0x8c, 0xe2, 0x9e, 0x53, 0x5c, 0x45, 0x71, 0x25,
+	0xa5, 0x31, 0x17, 0xe1, 0x08, 0x73, 0x92, 0xc7, 0xfe, 0x13, 0xfc, 0x46, 0x1d, 0xa6, 0x71, 0x86,
+	0x3d, 0xfe, 0x57, 0x8e, 0x9e, 0x4d, 0xdd, 0x42, 0x39, 0x2a, 0xbd, 0x6d, 0x8c, 0x13, 0x09, 0x34,
+	0x3b, 0x1c, 0xe9, 0x85, 0x90, 0xa0, 0x09, 0xae, 0x83, 0xe3, 0x4c, 0x9d, 0x47, 0x18, 0x47, 0x09,
+	0xf2, 0xf8, 0x53, 0x38, 0xd8, 0xf7, 0xba, 0x83, 0x1c, 0xd2, 0x18, 0xab, 0x73, 0xeb, 0xf2, 0x39,
+	0x8d, 0x53, 0x44, 0x28, 0x4c, 0xfb, 0x02, 0x60, 0x9f, 0x96, 0xc1, 0xfc, 0x16, 0x89, 0xd6, 0x72,
+	0x04, 0x29, 0xf2, 0x2f, 0x5c, 0x40, 0x7f, 0x0e, 0xaa, 0x04, 0x65, 0x5d, 0x94, 0x1b, 0x5a, 0x53,
+	0x6b, 0xcd, 0xfa, 0xf5, 0xf1, 0xd0, 0xba, 0xfb, 0x19, 0xa6, 0xc9, 0x8a, 0x2d, 0xe6, 0x76, 0x20,
+	0x01, 0xfa, 0x2e, 0xa8, 0xb1, 0xb5, 0xec, 0xf5, 0x61, 0x0e, 0x53, 0x62, 0x94, 0x9b, 0x5a, 0xab,
+	0xd6, 0x6e, 0xba, 0x53, 0x7b, 0x93, 0x97, 0x77, 0x99, 0xf6, 0x36, 0xc7, 0xf9, 0x0f, 0xc6, 0x43,
+	0x4b, 0x17, 0x8a, 0x17, 0xe8, 0x76, 0x00, 0xfa, 0x13, 0x8c, 0xfe, 0x5a, 0x4a, 0x43, 0x42, 0x10,
+	0x25, 0x46, 0xa5, 0x59, 0x69, 0xd5, 0xda, 0xd6, 0xd5, 0xd2, 0xaf, 0x18, 0xce, 0x9f, 0x39, 0x1e,
+	0x5a, 0x25, 0xa1, 0xc3, 0x07, 0x44, 0x7f, 0x0b, 0xe6, 0xf6, 0x07, 0x74, 0x90, 0xa3, 0x3d, 0x2e,
+	0x17, 0xe1, 0x03, 0x94, 0x67, 0x38, 0x37, 0x66, 0xf8, 0x67, 0xb3, 0xc6, 0x43, 0x6b, 0x41, 0xdc,
+	0xa4, 0x08, 0x65, 0x07, 0xba, 0x18, 0x33, 0x87, 0x0d, 0x39, 0x5c, 0x79, 0xfa, 0xe5, 0xcf, 0x8f,
+	0xa5, 0x47, 0x53, 0x49, 0x76, 0xf8, 0x1a, 0x1d, 0x15, 0xa4, 0xc3, 0x54, 0xec, 0x75, 0xb0, 0x58,
+	0xb8, 0xe1, 0x00, 0x91, 0x3e, 0xce, 0x08, 0xd2, 0x1f, 0x83, 0xff, 0xb9, 0x5d, 0xdc, 0xe5, 0xab,
+	0x9e, 0xf1, 0xc1, 0x68, 0x68, 0x55, 0x19, 0x64, 0x73, 0x3d, 0xa8, 0xb2, 0xa3, 0xcd, 0xae, 0xfd,
+	0xb3, 0x02, 0x8c, 0x2d, 0x12, 0xed, 0x20, 0xba, 0x93, 0x62, 0x4c, 0x7b, 0xef, 0x51, 0x1c, 0xf5,
+	0xe8, 0x5a, 0x0f, 0x66, 0x11, 0xba, 0x49, 0x56, 0x17, 0xcc, 0xca, 0x57, 0x99, 0xe9, 0x1f, 0x00,
+	0x20, 0x14, 0xe6, 0x74, 0x8f, 0xd5, 0xc5, 0xa8, 0xf0, 0x3c, 0x1b, 0xae, 0xe8, 0x92, 0xab, 0xba,
+	0xe4, 0xbe, 0x53, 0x5d, 0xf2, 0x17, 0xd9, 0xbe, 0xc7, 0x43, 0xab, 0x2e, 0x3d, 0x27, 0x5c, 0xfb,
+	0xe8, 0xb7, 0xa5, 0x05, 0xb3, 0x7c, 0xc0, 0xe0, 0x7a, 0x00, 0xee, 0xa8, 0x8a, 0xf2, 0xdd, 0xd7,
+	0xda, 0x0f, 0xff, 0xd1, 0x5d, 0x97, 0x00, 0x7f, 0x41, 0xca, 0xde, 0x13, 0xb2, 0x8a, 0x68, 0x7f,
+	0x65, 0xa2, 0x13, 0x1d, 0x9d, 0x80, 0xfb, 0x14, 0xe6, 0x11, 0xa2, 0x22, 0xb5, 0x4f, 0x7c, 0x33,
+	0xc4, 0xf8, 0xef, 0x7a, 0x5d, 0xb1, 0xa5, 0x49, 0x43, 0x98, 0x14, 0x28, 0xd9, 0x41, 0x5d, 0x4c,
+	0x19, 0x49, 0xec, 0x9d, 0xac, 0x2c, 0xb1, 0xf4, 0x9f, 0x4c, 0xa5, 0x4f, 0x10, 0x75, 0x08, 0x0f,
+	0xc7, 0x11, 0x4c, 0xa7, 0xc3, 0xe3, 0xb1, 0x6d, 0xd0, 0xbc, 0x2a, 0x3a, 0x55, 0x82, 0xf6, 0x49,
+	0x19, 0x54, 0xb6, 0x48, 0xa4, 0x7f, 0xd3, 0x80, 0x5e, 0xf0, 0x6d, 0x5c, 0x75, 0xaf, 0xfb, 0x2b,
+	0xe4, 0x16, 0x96, 0xad, 0xb1, 0x71, 0x4b, 0x81, 0x49, 0x5b, 0xbf, 0x6b, 0x60, 0xbe, 0xb8, 0x85,
+	0xfe, 0x8d, 0x2c, 0x0a, 0x35, 0x1a, 0x6f, 0x6e, 0xaf, 0xa1, 0x6e, 0xea, 0xef, 0x1e, 0x8f, 0x4c,
+	0xed, 0x64, 0x64, 0x6a, 0xa7, 0x23, 0x53, 0x3b, 0x3a, 0x33, 0x4b, 0x27, 0x67, 0x66, 0xe9, 0xd7,
+	0x99, 0x59, 0xfa, 0xb8, 0x1a, 0xc5, 0xb4, 0x37, 0x08, 0xdd, 0x0e, 0x4e, 0x3d, 0xe9, 0xe7, 0x24,
+	0x30, 0x24, 0xea, 0xc1, 0x3b, 0x68, 0xbf, 0xf4, 0x0e, 0xcf, 0xdf, 0x11, 0xce, 0xa5, 0x97, 0x44,
+	0x58, 0xe5, 0x65, 0x7d, 0xf1, 0x77, 0x00, 0xc1, 0xb1, 0xb5, 0xae, 0x4f, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -202,6 +341,7 @@ const _ = grpc.SupportPackageIsVersion4
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type MsgClient interface {
 	CreateBalancerPool(ctx context.Context, in *MsgCreateBalancerPool, opts ...grpc.CallOption) (*MsgCreateBalancerPoolResponse, error)
+	SetSmoothWeightChange(ctx context.Context, in *MsgSetSmoothWeightChange, opts ...grpc.CallOption) (*MsgSetSmoothWeightChangeResponse, error)
 }
 
 type msgClient struct {
@@ -221,9 +361,19 @@ func (c *msgClient) CreateBalancerPool(ctx context.Context, in *MsgCreateBalance
 	return out, nil
 }
 
+func (c *msgClient) SetSmoothWeightChange(ctx context.Context, in *MsgSetSmoothWeightChange, opts ...grpc.CallOption) (*MsgSetSmoothWeightChangeResponse, error) {
+	out := new(MsgSetSmoothWeightChangeResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.poolmodels.balancer.v1beta1.Msg/SetSmoothWeightChange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	CreateBalancerPool(context.Context, *MsgCreateBalancerPool) (*MsgCreateBalancerPoolResponse, error)
+	SetSmoothWeightChange(context.Context, *MsgSetSmoothWeightChange) (*MsgSetSmoothWeightChangeResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -233,6 +383,9 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) CreateBalancerPool(ctx context.Context, req *MsgCreateBalancerPool) (*MsgCreateBalancerPoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateBalancerPool not implemented")
 }
+func (*UnimplementedMsgServer) SetSmoothWeightChange(ctx context.Context, req *MsgSetSmoothWeightChange) (*MsgSetSmoothWeightChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSmoothWeightChange not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -256,6 +409,24 @@ func _Msg_CreateBalancerPool_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetSmoothWeightChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetSmoothWeightChange)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetSmoothWeightChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.poolmodels.balancer.v1beta1.Msg/SetSmoothWeightChange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetSmoothWeightChange(ctx, req.(*MsgSetSmoothWeightChange))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.gamm.poolmodels.balancer.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -264,6 +435,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CreateBalancerPool",
 			Handler:    _Msg_CreateBalancerPool_Handler,
 		},
+		{
+			MethodName: "SetSmoothWeightChange",
+			Handler:    _Msg_SetSmoothWeightChange_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/gamm/poolmodels/balancer/v1beta1/tx.proto",
@@ -360,6 +535,94 @@ func (m *MsgCreateBalancerPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgSetSmoothWeightChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetSmoothWeightChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetSmoothWeightChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TargetPoolWeights) > 0 {
+		for iNdEx := len(m.TargetPoolWeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TargetPoolWeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintTx(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x22
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintTx(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x1a
+	if m.PoolID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetSmoothWeightChangeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetSmoothWeightChangeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetSmoothWeightChangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -410,6 +673,41 @@ func (m *MsgCreateBalancerPoolResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSetSmoothWeightChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolID != 0 {
+		n += 1 + sovTx(uint64(m.PoolID))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.TargetPoolWeights) > 0 {
+		for _, e := range m.TargetPoolWeights {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetSmoothWeightChangeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -669,6 +967,257 @@ func (m *MsgCreateBalancerPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSetSmoothWeightChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetSmoothWeightChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetSmoothWeightChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
+			}
+			m.PoolID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetPoolWeights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetPoolWeights = append(m.TargetPoolWeights, PoolAsset{})
+			if err := m.TargetPoolWeights[len(m.TargetPoolWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetSmoothWeightChangeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetSmoothWeightChangeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetSmoothWeightChangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
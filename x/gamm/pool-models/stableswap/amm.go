@@ -2,9 +2,12 @@ package stableswap
 
 import (
 	"errors"
+	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	errorsmod "cosmossdk.io/errors"
+
 	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/v24/x/gamm/pool-models/internal/cfmm_common"
 	types "github.com/osmosis-labs/osmosis/v24/x/gamm/types"
@@ -281,7 +284,8 @@ func (p *Pool) singleAssetJoinSpreadFactorRatio(tokenInDenom string) (osmomath.D
 	return nonInternalAssetRatio, nil
 }
 
-// Route a pool join attempt to either a single-asset join or all-asset join (mutates pool state)
+// Route a pool join attempt to either a single-asset join, a join with a subset of the pool's assets,
+// or all-asset join (mutates pool state)
 // Eventually, we intend to switch this to a COW wrapped pa for better performance
 func (p *Pool) joinPoolSharesInternal(ctx sdk.Context, tokensIn sdk.Coins, spreadFactor osmomath.Dec) (numShares osmomath.Int, tokensJoined sdk.Coins, err error) {
 	if !tokensIn.DenomsSubsetOf(p.GetTotalPoolLiquidity(ctx)) {
@@ -294,10 +298,17 @@ func (p *Pool) joinPoolSharesInternal(ctx sdk.Context, tokensIn sdk.Coins, sprea
 			return osmomath.ZeroInt(), sdk.NewCoins(), err
 		}
 
+		tokensJoined = tokensIn
+	} else if len(tokensIn) > 1 && len(tokensIn) != p.NumAssets() {
+		numShares, err = p.calcSubsetJoinShares(tokensIn, spreadFactor)
+		if err != nil {
+			return osmomath.ZeroInt(), sdk.NewCoins(), err
+		}
+
 		tokensJoined = tokensIn
 	} else if len(tokensIn) != p.NumAssets() {
 		return osmomath.ZeroInt(), sdk.NewCoins(), errors.New(
-			"stableswap pool only supports LP'ing with one asset, or all assets in pool")
+			"stableswap pool only supports LP'ing with one asset of more than one unit, a subset of its assets, or all assets in pool")
 	} else {
 		// Add all exact coins we can (no swap). ctx arg doesn't matter for Stableswap
 		var remCoins sdk.Coins
@@ -317,3 +328,155 @@ func (p *Pool) joinPoolSharesInternal(ctx sdk.Context, tokensIn sdk.Coins, sprea
 
 	return numShares, tokensJoined, nil
 }
+
+// calcSubsetJoinShares calculates the number of LP shares that should be granted given the passed in
+// tokens, which are a subset of the pool's assets with more than one asset (non-mutative).
+// Each token is joined as a single asset join against the pool updated by the previous ones,
+// which by CFMM path-independence is the same as joining all of them at once.
+// As for single asset joins, each token must be more than one unit.
+func (p *Pool) calcSubsetJoinShares(tokensIn sdk.Coins, spreadFactor osmomath.Dec) (osmomath.Int, error) {
+	pCopy := p.Copy()
+	numShares := osmomath.ZeroInt()
+	for _, tokenIn := range tokensIn {
+		if tokenIn.Amount.LTE(osmomath.OneInt()) {
+			return osmomath.Int{}, fmt.Errorf("subset join amount of %s must be more than one unit, was %s", tokenIn.Denom, tokenIn.Amount)
+		}
+		newShares, err := pCopy.calcSingleAssetJoinShares(tokenIn, spreadFactor)
+		if err != nil {
+			return osmomath.Int{}, err
+		}
+
+		pCopy.updatePoolForJoin(sdk.NewCoins(tokenIn), newShares)
+		numShares = numShares.Add(newShares)
+	}
+	return numShares, nil
+}
+
+// calcTokenInShareAmountOut calculates the amount of tokenInDenom that a single asset join must provide
+// to be granted exactly shareOutAmount LP shares (non-mutative).
+// By CFMM path-independence, a single asset join is the same as joining all assets in the pool's ratio,
+// and swapping tokenInDenom against the pool for every other asset joined. So we compute the all-asset join
+// for shareOutAmount, and the amount of tokenInDenom swapped in for the other assets under 0 spread factor.
+// The spread factor is then applied the same way as in calcSingleAssetJoinShares.
+func (p *Pool) calcTokenInShareAmountOut(tokenInDenom string, shareOutAmount osmomath.Int, spreadFactor osmomath.Dec) (osmomath.Int, error) {
+	if p.PoolLiquidity.AmountOf(tokenInDenom).IsZero() {
+		return osmomath.Int{}, errorsmod.Wrapf(types.ErrDenomNotFoundInPool, "denom %s", tokenInDenom)
+	}
+	if !shareOutAmount.IsPositive() {
+		return osmomath.Int{}, errorsmod.Wrapf(types.ErrNotPositiveRequireAmount, "shares amount must be positive, was %s", shareOutAmount)
+	}
+
+	// we round up the all-asset join, so that the pool is never under-charged.
+	totalShares := p.GetTotalShares()
+	allAssetJoin := sdk.NewCoins()
+	for _, asset := range p.PoolLiquidity {
+		amount := shareOutAmount.Mul(asset.Amount).ToLegacyDec().QuoInt(totalShares).Ceil().TruncateInt()
+		allAssetJoin = allAssetJoin.Add(sdk.NewCoin(asset.Denom, amount))
+	}
+
+	pCopy := p.Copy()
+	pCopy.updatePoolForJoin(allAssetJoin, shareOutAmount)
+
+	tokenInAmtBeforeFee := allAssetJoin.AmountOf(tokenInDenom)
+	for _, coin := range allAssetJoin {
+		if coin.Denom == tokenInDenom {
+			continue
+		}
+		tokenIn, err := pCopy.SwapInAmtGivenOut(sdk.Context{}, sdk.NewCoins(coin), tokenInDenom, osmomath.ZeroDec())
+		if err != nil {
+			return osmomath.Int{}, err
+		}
+		tokenInAmtBeforeFee = tokenInAmtBeforeFee.Add(tokenIn.Amount)
+	}
+
+	spreadFactorApplicableRatio, err := p.singleAssetJoinSpreadFactorRatio(tokenInDenom)
+	if err != nil {
+		return osmomath.Int{}, err
+	}
+	oneMinusSpreadFactor := osmomath.OneDec().Sub(spreadFactor.Mul(spreadFactorApplicableRatio))
+	// We round up tokenInAmount, as this is what's charged for the precise amount of shares out.
+	tokenInAmount := tokenInAmtBeforeFee.ToLegacyDec().Quo(oneMinusSpreadFactor).Ceil().TruncateInt()
+
+	if err := validatePoolLiquidity(p.PoolLiquidity.Add(sdk.NewCoin(tokenInDenom, tokenInAmount)), p.ScalingFactors); err != nil {
+		return osmomath.Int{}, err
+	}
+
+	return tokenInAmount, nil
+}
+
+// calcSharesInGivenExactAmountOut calculates the number of LP shares that must be exited to get exactly
+// tokenOut out of the pool with a single asset exit (non-mutative).
+// We binary search the smallest number of LP shares s.t. if we exited them and swapped all the tokens
+// other than tokenOut's denom back to it, we'd get at least tokenOut.
+func (p *Pool) calcSharesInGivenExactAmountOut(tokenOut sdk.Coin, spreadFactor, exitFee osmomath.Dec) (osmomath.Int, error) {
+	tokenOutLiquidity := p.PoolLiquidity.AmountOf(tokenOut.Denom)
+	if tokenOutLiquidity.IsZero() {
+		return osmomath.Int{}, errorsmod.Wrapf(types.ErrDenomNotFoundInPool, "denom %s", tokenOut.Denom)
+	}
+	if !tokenOut.Amount.IsPositive() {
+		return osmomath.Int{}, errorsmod.Wrapf(types.ErrNotPositiveRequireAmount, "token amount must be positive, was %s", tokenOut.Amount)
+	}
+
+	// Returns how many tokens of tokenOut's denom we'd get, if we exited sharesIn and swapped all
+	// the other exited tokens to it.
+	estimateCoinOutGivenShares := func(sharesIn osmomath.Int) (osmomath.Int, error) {
+		pCopy := p.Copy()
+		exitedCoins, err := pCopy.ExitPool(sdk.Context{}, sharesIn, exitFee)
+		if err != nil {
+			return osmomath.Int{}, err
+		}
+
+		tokenOutAmount := exitedCoins.AmountOf(tokenOut.Denom)
+		for _, coin := range exitedCoins {
+			if coin.Denom == tokenOut.Denom {
+				continue
+			}
+			swapOut, err := pCopy.SwapOutAmtGivenIn(sdk.Context{}, sdk.NewCoins(coin), tokenOut.Denom, spreadFactor)
+			// exited coins too small to swap for any tokens out do not count towards the output
+			if errors.Is(err, types.ErrInvalidMathApprox) {
+				continue
+			} else if err != nil {
+				return osmomath.Int{}, err
+			}
+			tokenOutAmount = tokenOutAmount.Add(swapOut.Amount)
+		}
+		return tokenOutAmount, nil
+	}
+
+	// The exited tokenOut alone is at least tokenOut once
+	// sharesIn * (1 - exit fee) / totalShares * tokenOutLiquidity >= tokenOut.Amount,
+	// which gives an upperbound on the number of LP shares.
+	totalShares := p.GetTotalShares()
+	sharesUpperbound := tokenOut.Amount.Mul(totalShares).ToLegacyDec().
+		Quo(tokenOutLiquidity.ToLegacyDec().Mul(osmomath.OneDec().Sub(exitFee))).Ceil().TruncateInt().AddRaw(1)
+	if sharesUpperbound.GTE(totalShares) {
+		sharesUpperbound = totalShares.SubRaw(1)
+	}
+
+	// Find the smallest number of LP shares whose estimated output is at least tokenOut,
+	// so that the pool is never under-charged.
+	sharesLowerbound := osmomath.ZeroInt()
+	for sharesLowerbound.LT(sharesUpperbound) {
+		sharesEstimate := sharesLowerbound.Add(sharesUpperbound).QuoRaw(2)
+		tokenOutEstimate, err := estimateCoinOutGivenShares(sharesEstimate)
+		if err != nil {
+			return osmomath.Int{}, err
+		}
+
+		if tokenOutEstimate.GTE(tokenOut.Amount) {
+			sharesUpperbound = sharesEstimate
+		} else {
+			sharesLowerbound = sharesEstimate.AddRaw(1)
+		}
+	}
+
+	tokenOutEstimate, err := estimateCoinOutGivenShares(sharesUpperbound)
+	if err != nil {
+		return osmomath.Int{}, err
+	}
+	if tokenOutEstimate.LT(tokenOut.Amount) {
+		return osmomath.Int{}, errorsmod.Wrapf(types.ErrLimitMaxAmount, "can not exit %s from the pool", tokenOut)
+	}
+
+	return sharesUpperbound, nil
+}
@@ -1150,6 +1150,27 @@ func TestJoinPoolSharesInternal(t *testing.T) {
 			),
 			expectPass: false,
 		},
+		"single-asset pool join of one unit": {
+			tokensIn:       sdk.NewCoins(sdk.NewInt64Coin("foo", 1)),
+			poolAssets:     twoEvenStablePoolAssets,
+			scalingFactors: defaultTwoAssetScalingFactors,
+			spreadFactor:   osmomath.ZeroDec(),
+			expectPass:     false,
+		},
+		"single-asset join of one unit to a 3-asset pool": {
+			tokensIn:       sdk.NewCoins(sdk.NewInt64Coin("asset/a", 1)),
+			poolAssets:     threeEvenStablePoolAssets,
+			scalingFactors: defaultThreeAssetScalingFactors,
+			spreadFactor:   osmomath.ZeroDec(),
+			expectPass:     false,
+		},
+		"subset join with one unit of an asset": {
+			tokensIn:       sdk.NewCoins(sdk.NewInt64Coin("asset/a", 1), sdk.NewInt64Coin("asset/b", 1000)),
+			poolAssets:     threeEvenStablePoolAssets,
+			scalingFactors: defaultThreeAssetScalingFactors,
+			spreadFactor:   osmomath.ZeroDec(),
+			expectPass:     false,
+		},
 		"all-asset pool join attempt exactly hits max scaled asset amount": {
 			tokensIn: sdk.NewCoins(
 				sdk.NewInt64Coin("foo", 1),
@@ -1191,6 +1212,229 @@ func TestJoinPoolSharesInternal(t *testing.T) {
 	}
 }
 
+func TestCalcSubsetJoinShares(t *testing.T) {
+	type testcase struct {
+		tokensIn       sdk.Coins
+		poolAssets     sdk.Coins
+		scalingFactors []uint64
+		spreadFactor   osmomath.Dec
+	}
+
+	tests := map[string]testcase{
+		"even 3-asset pool, two assets in": {
+			tokensIn:       sdk.NewCoins(sdk.NewInt64Coin("asset/a", 1000), sdk.NewInt64Coin("asset/b", 1000)),
+			poolAssets:     threeEvenStablePoolAssets,
+			scalingFactors: defaultThreeAssetScalingFactors,
+			spreadFactor:   osmomath.ZeroDec(),
+		},
+		"uneven 3-asset pool, two assets in": {
+			tokensIn:       sdk.NewCoins(sdk.NewInt64Coin("asset/a", 1000), sdk.NewInt64Coin("asset/c", 3000)),
+			poolAssets:     threeUnevenStablePoolAssets,
+			scalingFactors: defaultThreeAssetScalingFactors,
+			spreadFactor:   osmomath.ZeroDec(),
+		},
+		"even 3-asset pool, two assets in, default spread factor": {
+			tokensIn:       sdk.NewCoins(sdk.NewInt64Coin("asset/a", 1000), sdk.NewInt64Coin("asset/b", 1000)),
+			poolAssets:     threeEvenStablePoolAssets,
+			scalingFactors: defaultThreeAssetScalingFactors,
+			spreadFactor:   defaultSpreadFactor,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := sdk.Context{}
+			p := poolStructFromAssets(tc.poolAssets, tc.scalingFactors)
+
+			shares, tokensJoined, err := p.joinPoolSharesInternal(ctx, tc.tokensIn, tc.spreadFactor)
+			require.NoError(t, err)
+			require.Equal(t, tc.tokensIn, tokensJoined)
+			require.Equal(t, tc.poolAssets.Add(tc.tokensIn...), p.PoolLiquidity)
+
+			// joining is the same as joining each asset in after the other
+			expectedPool := poolStructFromAssets(tc.poolAssets, tc.scalingFactors)
+			expectedShares := osmomath.ZeroInt()
+			for _, tokenIn := range tc.tokensIn {
+				newShares, err := expectedPool.calcSingleAssetJoinShares(tokenIn, tc.spreadFactor)
+				require.NoError(t, err)
+				expectedPool.updatePoolForJoin(sdk.NewCoins(tokenIn), newShares)
+				expectedShares = expectedShares.Add(newShares)
+			}
+			require.Equal(t, expectedShares, shares)
+
+			// exiting the shares and swapping everything to the first asset in must not be profitable,
+			// compared to swapping the tokens in to it
+			exitTokens, err := p.ExitPool(ctx, shares, osmomath.ZeroDec())
+			require.NoError(t, err)
+			tokenOutAmount, err := cfmm_common.SwapAllCoinsToSingleAsset(&p, ctx, exitTokens, tc.tokensIn[0].Denom, osmomath.ZeroDec())
+			require.NoError(t, err)
+			initialPool := poolStructFromAssets(tc.poolAssets, tc.scalingFactors)
+			valueIn, err := cfmm_common.SwapAllCoinsToSingleAsset(&initialPool, ctx, tc.tokensIn, tc.tokensIn[0].Denom, osmomath.ZeroDec())
+			require.NoError(t, err)
+			// since each asset swap can have up to osmomath.OneInt() error, our expected error bound is 1*numAssets
+			correctnessThreshold := osmomath.NewInt(int64(len(p.PoolLiquidity)))
+			require.True(t, tokenOutAmount.LTE(valueIn.Add(correctnessThreshold)), "token out %s, value in %s", tokenOutAmount, valueIn)
+		})
+	}
+}
+
+func TestCalcTokenInShareAmountOut(t *testing.T) {
+	type testcase struct {
+		tokenIn        sdk.Coin
+		poolAssets     sdk.Coins
+		scalingFactors []uint64
+		spreadFactor   osmomath.Dec
+	}
+
+	tests := map[string]testcase{
+		"even two asset pool, no spread factor": {
+			tokenIn:        sdk.NewCoin("foo", osmomath.NewInt(1000000)),
+			poolAssets:     twoEvenStablePoolAssets,
+			scalingFactors: defaultTwoAssetScalingFactors,
+			spreadFactor:   osmomath.ZeroDec(),
+		},
+		"uneven two asset pool, default spread factor": {
+			tokenIn:        sdk.NewCoin("foo", osmomath.NewInt(1000000)),
+			poolAssets:     twoUnevenStablePoolAssets,
+			scalingFactors: defaultTwoAssetScalingFactors,
+			spreadFactor:   defaultSpreadFactor,
+		},
+		"even 3-asset pool, no spread factor": {
+			tokenIn:        sdk.NewCoin("asset/a", osmomath.NewInt(10000)),
+			poolAssets:     threeEvenStablePoolAssets,
+			scalingFactors: defaultThreeAssetScalingFactors,
+			spreadFactor:   osmomath.ZeroDec(),
+		},
+		"uneven 3-asset pool, 0.03 spread factor": {
+			tokenIn:        sdk.NewCoin("asset/b", osmomath.NewInt(10000)),
+			poolAssets:     threeUnevenStablePoolAssets,
+			scalingFactors: defaultThreeAssetScalingFactors,
+			spreadFactor:   osmomath.MustNewDecFromStr("0.03"),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			p := poolStructFromAssets(tc.poolAssets, tc.scalingFactors)
+
+			// the shares we get from a single asset join of tokenIn must cost about tokenIn to get exactly.
+			shares, err := p.calcSingleAssetJoinShares(tc.tokenIn, tc.spreadFactor)
+			require.NoError(t, err)
+
+			tokenInAmount, err := p.calcTokenInShareAmountOut(tc.tokenIn.Denom, shares, tc.spreadFactor)
+			require.NoError(t, err)
+			require.Equal(t, tc.poolAssets, p.PoolLiquidity)
+
+			// single asset joins only match the exited tokens to the token in up to the rounding of each exited asset,
+			// so we accept a small relative error.
+			errTolerance := osmomath.ErrTolerance{MultiplicativeTolerance: osmomath.NewDecWithPrec(1, 3)}
+			require.Equal(t, 0, errTolerance.Compare(tc.tokenIn.Amount, tokenInAmount),
+				"token in %s, expected %s", tokenInAmount, tc.tokenIn.Amount)
+
+			// the exact amount in must never get less shares than asked for.
+			sharesForTokenInAmount, err := p.calcSingleAssetJoinShares(sdk.NewCoin(tc.tokenIn.Denom, tokenInAmount), tc.spreadFactor)
+			require.NoError(t, err)
+			require.True(t, sharesForTokenInAmount.Add(osmomath.OneInt()).GTE(shares))
+		})
+	}
+
+	t.Run("denom not in pool", func(t *testing.T) {
+		p := poolStructFromAssets(twoEvenStablePoolAssets, defaultTwoAssetScalingFactors)
+		_, err := p.calcTokenInShareAmountOut("baz", types.OneShare, osmomath.ZeroDec())
+		require.ErrorIs(t, err, types.ErrDenomNotFoundInPool)
+	})
+}
+
+func TestExitSwapExactAmountOut(t *testing.T) {
+	type testcase struct {
+		tokenOut         sdk.Coin
+		poolAssets       sdk.Coins
+		scalingFactors   []uint64
+		spreadFactor     osmomath.Dec
+		exitFee          osmomath.Dec
+		shareInMaxAmount osmomath.Int
+		expectedErr      error
+	}
+
+	tests := map[string]testcase{
+		"even two asset pool, no fees": {
+			tokenOut:         sdk.NewCoin("foo", osmomath.NewInt(1000000)),
+			poolAssets:       twoEvenStablePoolAssets,
+			scalingFactors:   defaultTwoAssetScalingFactors,
+			spreadFactor:     osmomath.ZeroDec(),
+			exitFee:          osmomath.ZeroDec(),
+			shareInMaxAmount: types.InitPoolSharesSupply,
+		},
+		"uneven 3-asset pool, default spread factor": {
+			tokenOut:         sdk.NewCoin("asset/c", osmomath.NewInt(50000)),
+			poolAssets:       threeUnevenStablePoolAssets,
+			scalingFactors:   defaultThreeAssetScalingFactors,
+			spreadFactor:     defaultSpreadFactor,
+			exitFee:          osmomath.ZeroDec(),
+			shareInMaxAmount: types.InitPoolSharesSupply,
+		},
+		"even 3-asset pool, spread factor and exit fee": {
+			tokenOut:         sdk.NewCoin("asset/a", osmomath.NewInt(50000)),
+			poolAssets:       threeEvenStablePoolAssets,
+			scalingFactors:   defaultThreeAssetScalingFactors,
+			spreadFactor:     defaultSpreadFactor,
+			exitFee:          osmomath.MustNewDecFromStr("0.01"),
+			shareInMaxAmount: types.InitPoolSharesSupply,
+		},
+		"shares in over max": {
+			tokenOut:         sdk.NewCoin("foo", osmomath.NewInt(1000000)),
+			poolAssets:       twoEvenStablePoolAssets,
+			scalingFactors:   defaultTwoAssetScalingFactors,
+			spreadFactor:     osmomath.ZeroDec(),
+			exitFee:          osmomath.ZeroDec(),
+			shareInMaxAmount: osmomath.OneInt(),
+			expectedErr:      types.ErrLimitMaxAmount,
+		},
+		"denom not in pool": {
+			tokenOut:         sdk.NewCoin("baz", osmomath.NewInt(1000000)),
+			poolAssets:       twoEvenStablePoolAssets,
+			scalingFactors:   defaultTwoAssetScalingFactors,
+			spreadFactor:     osmomath.ZeroDec(),
+			exitFee:          osmomath.ZeroDec(),
+			shareInMaxAmount: types.InitPoolSharesSupply,
+			expectedErr:      types.ErrDenomNotFoundInPool,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := sdk.Context{}
+			p := poolStructFromAssets(tc.poolAssets, tc.scalingFactors)
+			p.PoolParams.SwapFee = tc.spreadFactor
+			p.PoolParams.ExitFee = tc.exitFee
+			initialShares := p.GetTotalShares()
+
+			sharesIn, err := p.ExitSwapExactAmountOut(ctx, tc.tokenOut, tc.shareInMaxAmount)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				require.Equal(t, tc.poolAssets, p.PoolLiquidity)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.poolAssets.Sub(tc.tokenOut), p.PoolLiquidity)
+			require.Equal(t, initialShares.Sub(sharesIn), p.GetTotalShares())
+
+			// exiting sharesIn and swapping everything to the token out denom gives at least token out,
+			// while exiting one share less does not.
+			estimateTokenOut := func(shares osmomath.Int) osmomath.Int {
+				pCopy := poolStructFromAssets(tc.poolAssets, tc.scalingFactors)
+				exitTokens, err := pCopy.ExitPool(ctx, shares, tc.exitFee)
+				require.NoError(t, err)
+				tokenOutAmount, err := cfmm_common.SwapAllCoinsToSingleAsset(&pCopy, ctx, exitTokens, tc.tokenOut.Denom, tc.spreadFactor)
+				require.NoError(t, err)
+				return tokenOutAmount
+			}
+			require.True(t, estimateTokenOut(sharesIn).GTE(tc.tokenOut.Amount))
+			require.True(t, estimateTokenOut(sharesIn.SubRaw(1)).LT(tc.tokenOut.Amount))
+		})
+	}
+}
+
 func TestSingleAssetJoinSpreadFactorRatio(t *testing.T) {
 	largeInt, ok := osmomath.NewIntFromString("123456789012345678")
 	require.True(t, ok)
@@ -16,8 +16,9 @@ import (
 )
 
 var (
-	_ poolmanagertypes.PoolI = &Pool{}
-	_ types.CFMMPoolI        = &Pool{}
+	_ poolmanagertypes.PoolI       = &Pool{}
+	_ types.CFMMPoolI              = &Pool{}
+	_ types.PoolAmountOutExtension = &Pool{}
 )
 
 // NewStableswapPool returns a stableswap pool
@@ -395,6 +396,50 @@ func (p Pool) CalcExitPoolCoinsFromShares(ctx sdk.Context, exitingShares osmomat
 	return cfmm_common.CalcExitPool(ctx, &p, exitingShares, exitFee)
 }
 
+// CalcTokenInShareAmountOut returns the amount of tokenInDenom that a single asset join must provide
+// to be granted exactly shareOutAmount LP shares (non-mutative).
+func (p *Pool) CalcTokenInShareAmountOut(ctx sdk.Context, tokenInDenom string, shareOutAmount osmomath.Int, spreadFactor osmomath.Dec) (tokenInAmount osmomath.Int, err error) {
+	return p.calcTokenInShareAmountOut(tokenInDenom, shareOutAmount, spreadFactor)
+}
+
+// JoinPoolTokenInMaxShareAmountOut joins the pool with a single asset for exactly shareOutAmount LP shares,
+// and returns the amount of tokenInDenom joined (mutates pool state).
+func (p *Pool) JoinPoolTokenInMaxShareAmountOut(ctx sdk.Context, tokenInDenom string, shareOutAmount osmomath.Int) (tokenInAmount osmomath.Int, err error) {
+	tokenInAmount, err = p.calcTokenInShareAmountOut(tokenInDenom, shareOutAmount, p.GetSpreadFactor(ctx))
+	if err != nil {
+		return osmomath.Int{}, err
+	}
+
+	p.IncreaseLiquidity(shareOutAmount, sdk.NewCoins(sdk.NewCoin(tokenInDenom, tokenInAmount)))
+	return tokenInAmount, nil
+}
+
+// ExitSwapExactAmountOut exits the pool with a single asset for exactly tokenOut, and returns the number
+// of LP shares exited, which must not exceed shareInMaxAmount (mutates pool state).
+func (p *Pool) ExitSwapExactAmountOut(ctx sdk.Context, tokenOut sdk.Coin, shareInMaxAmount osmomath.Int) (shareInAmount osmomath.Int, err error) {
+	shareInAmount, err = p.calcSharesInGivenExactAmountOut(tokenOut, p.GetSpreadFactor(ctx), p.GetExitFee(ctx))
+	if err != nil {
+		return osmomath.Int{}, err
+	}
+
+	if shareInAmount.GT(shareInMaxAmount) {
+		return osmomath.Int{}, errorsmod.Wrapf(types.ErrLimitMaxAmount, "%s resulted shares is larger than the max amount of %s", shareInAmount, shareInMaxAmount)
+	}
+
+	tokensOut := sdk.NewCoins(tokenOut)
+	if err := validatePoolLiquidity(p.PoolLiquidity.Sub(tokensOut...), p.ScalingFactors); err != nil {
+		return osmomath.Int{}, err
+	}
+
+	p.updatePoolLiquidityForExit(tokensOut, shareInAmount)
+	return shareInAmount, nil
+}
+
+// IncreaseLiquidity increases the pool's liquidity by coinsIn and its total shares by sharesOut.
+func (p *Pool) IncreaseLiquidity(sharesOut osmomath.Int, coinsIn sdk.Coins) {
+	p.updatePoolForJoin(coinsIn, sharesOut)
+}
+
 // SetScalingFactors sets scaling factors for pool to the given amount
 // It should only be able to be successfully called by the pool's ScalingFactorGovernor
 // TODO: move commented test for this function from x/gamm/keeper/pool_service_test.go once a pool_test.go file has been created for stableswap
@@ -408,11 +453,18 @@ func (p *Pool) SetScalingFactors(ctx sdk.Context, scalingFactors []uint64, sende
 		return err
 	}
 
-	if err = validateScalingFactors(scalingFactors, p.PoolLiquidity.Len()); err != nil {
+	return p.UpdateScalingFactors(scalingFactors)
+}
+
+// UpdateScalingFactors sets the scaling factors of the pool to the given ones, which must already include
+// the scaling factor multiplier. Unlike SetScalingFactors, it does not check the sender, so it is meant for
+// the updates done by the module itself, e.g. from the rate source of the pool.
+func (p *Pool) UpdateScalingFactors(scalingFactors []uint64) error {
+	if err := validateScalingFactors(scalingFactors, p.PoolLiquidity.Len()); err != nil {
 		return err
 	}
 
-	if err = validatePoolLiquidity(p.PoolLiquidity, scalingFactors); err != nil {
+	if err := validatePoolLiquidity(p.PoolLiquidity, scalingFactors); err != nil {
 		return err
 	}
 
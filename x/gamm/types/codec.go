@@ -25,6 +25,7 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&ReplaceMigrationRecordsProposal{}, "osmosis/gamm/replace-migration-records-proposal", nil)
 	cdc.RegisterConcrete(&CreateConcentratedLiquidityPoolsAndLinktoCFMMProposal{}, "osmosis/gamm/create-cl-pool-and-cfmm-link", nil)
 	cdc.RegisterConcrete(&SetScalingFactorControllerProposal{}, "osmosis/gamm/scaling-factor-controller", nil)
+	cdc.RegisterConcrete(&SetScalingFactorRateSourceProposal{}, "osmosis/gamm/scaling-factor-rate-source", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
@@ -51,6 +52,7 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&ReplaceMigrationRecordsProposal{},
 		&CreateConcentratedLiquidityPoolsAndLinktoCFMMProposal{},
 		&SetScalingFactorControllerProposal{},
+		&SetScalingFactorRateSourceProposal{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
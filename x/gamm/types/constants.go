@@ -18,6 +18,10 @@ const (
 	// pools can be created with min and max number of assets defined with this constants
 	MinNumOfAssetsInPool = 2
 	MaxNumOfAssetsInPool = 8
+
+	// RateContractQueryGasLimit is the gas limit of the query reading the rate of a contract rate source.
+	// Rate sources are read in the epoch hook, which does not otherwise meter gas.
+	RateContractQueryGasLimit = uint64(1_000_000)
 )
 
 var (
@@ -6,6 +6,8 @@ import (
 	errorsmod "cosmossdk.io/errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
 )
 
 type PoolDoesNotExistError struct {
@@ -58,6 +60,23 @@ func (e NoDesiredDenomInPoolError) Error() string {
 	return fmt.Sprintf("desired denom (%s) was not found in the pool", e.DesiredDenom)
 }
 
+type NonPositiveRateError struct {
+	Rate osmomath.Dec
+}
+
+func (e NonPositiveRateError) Error() string {
+	return fmt.Sprintf("rate (%s) must be positive", e.Rate)
+}
+
+type RateContractOutOfGasError struct {
+	ContractAddress string
+	GasLimit        uint64
+}
+
+func (e RateContractOutOfGasError) Error() string {
+	return fmt.Sprintf("rate query of contract %s ran out of gas (limit %d)", e.ContractAddress, e.GasLimit)
+}
+
 type MustHaveTwoDenomsError struct {
 	NumDenoms int
 }
@@ -97,6 +116,11 @@ var (
 
 	ErrPoolParamsInvalidDenom     = errorsmod.Register(ModuleName, 50, "pool params' LBP params has an invalid denomination")
 	ErrPoolParamsInvalidNumDenoms = errorsmod.Register(ModuleName, 51, "pool params' LBP doesn't have same number of params as underlying pool")
+	ErrNotFuturePoolGovernor      = errorsmod.Register(ModuleName, 52, "not future pool governor")
+	ErrInvalidWeightChangeStart   = errorsmod.Register(ModuleName, 53, "smooth weight change can not start before the block time")
+	ErrSmoothWeightChangeTooShort = errorsmod.Register(ModuleName, 54, "smooth weight change duration is too short")
+	ErrSmoothWeightChangeTooFast  = errorsmod.Register(ModuleName, 55, "smooth weight change moves the weights too fast")
+	ErrSmoothWeightChangeDisabled = errorsmod.Register(ModuleName, 56, "pool did not opt into smooth weight changes at creation")
 
 	ErrNotImplemented = errorsmod.Register(ModuleName, 60, "function not implemented")
 
@@ -108,4 +132,7 @@ var (
 	ErrHitMinScaledAssets         = errorsmod.Register(ModuleName, 66, "post-scaled pool assets can not be less than 1")
 	ErrNoGaugeToRedirect          = errorsmod.Register(ModuleName, 67, "could not find gauge to redirect")
 	ErrMustHaveTwoDenoms          = errorsmod.Register(ModuleName, 68, "can only have 2 denoms in CL pool")
+
+	ErrInvalidScalingFactorRateSource   = errorsmod.Register(ModuleName, 69, "invalid scaling factor rate source")
+	ErrScalingFactorsDrivenByRateSource = errorsmod.Register(ModuleName, 70, "scaling factors of the pool are driven by a rate source")
 )
@@ -110,3 +110,13 @@ type PoolIncentivesKeeper interface {
 type IncentivesKeeper interface {
 	GetEpochInfo(ctx sdk.Context) epochtypes.EpochInfo
 }
+
+// TwapKeeper defines the contract needed to be fulfilled for the TWAP keeper.
+type TwapKeeper interface {
+	GetArithmeticTwapToNow(ctx sdk.Context, poolId uint64, baseAssetDenom string, quoteAssetDenom string, startTime time.Time) (osmomath.Dec, error)
+}
+
+// WasmKeeper defines the contract needed to be fulfilled for the wasm keeper.
+type WasmKeeper interface {
+	QuerySmart(ctx sdk.Context, contractAddress sdk.AccAddress, queryMsg []byte) ([]byte, error)
+}
@@ -21,5 +21,10 @@ func (gs GenesisState) Validate() error {
 	if err := gs.Params.Validate(); err != nil {
 		return err
 	}
+	for _, record := range gs.ScalingFactorRateSources {
+		if err := record.RateSource.Validate(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
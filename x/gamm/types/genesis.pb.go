@@ -77,9 +77,12 @@ func (m *Params) GetPoolCreationFee() github_com_cosmos_cosmos_sdk_types.Coins {
 type GenesisState struct {
 	Pools []*types1.Any `protobuf:"bytes,1,rep,name=pools,proto3" json:"pools,omitempty"`
 	// will be renamed to next_pool_id in an upcoming version
-	NextPoolNumber   uint64                      `protobuf:"varint,2,opt,name=next_pool_number,json=nextPoolNumber,proto3" json:"next_pool_number,omitempty"`
-	Params           Params                      `protobuf:"bytes,3,opt,name=params,proto3" json:"params"`
-	MigrationRecords *migration.MigrationRecords `protobuf:"bytes,4,opt,name=migration_records,json=migrationRecords,proto3" json:"migration_records,omitempty"`
+	NextPoolNumber           uint64                            `protobuf:"varint,2,opt,name=next_pool_number,json=nextPoolNumber,proto3" json:"next_pool_number,omitempty"`
+	Params                   Params                            `protobuf:"bytes,3,opt,name=params,proto3" json:"params"`
+	MigrationRecords         *migration.MigrationRecords       `protobuf:"bytes,4,opt,name=migration_records,json=migrationRecords,proto3" json:"migration_records,omitempty"`
+	ScalingFactorRateSources []PoolIdToScalingFactorRateSource `protobuf:"bytes,5,rep,name=scaling_factor_rate_sources,json=scalingFactorRateSources,proto3" json:"scaling_factor_rate_sources"`
+	// ids of the balancer pools that opted into smooth weight changes at creation
+	SmoothWeightChangePoolIds []uint64 `protobuf:"varint,6,rep,packed,name=smooth_weight_change_pool_ids,json=smoothWeightChangePoolIds,proto3" json:"smooth_weight_change_pool_ids,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -143,6 +146,20 @@ func (m *GenesisState) GetMigrationRecords() *migration.MigrationRecords {
 	return nil
 }
 
+func (m *GenesisState) GetScalingFactorRateSources() []PoolIdToScalingFactorRateSource {
+	if m != nil {
+		return m.ScalingFactorRateSources
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSmoothWeightChangePoolIds() []uint64 {
+	if m != nil {
+		return m.SmoothWeightChangePoolIds
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "osmosis.gamm.v1beta1.Params")
 	proto.RegisterType((*GenesisState)(nil), "osmosis.gamm.v1beta1.GenesisState")
@@ -153,35 +170,41 @@ func init() {
 }
 
 var fileDescriptor_5a324eb7f1dd793e = []byte{
-	// 444 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0xcf, 0x6e, 0xd3, 0x40,
-	0x10, 0xc6, 0xe3, 0x36, 0x8d, 0x84, 0x8b, 0xa0, 0xb5, 0x72, 0x70, 0x2b, 0xe4, 0x04, 0x1f, 0x90,
-	0x2f, 0xd9, 0xa5, 0x01, 0x2e, 0xbd, 0x91, 0x4a, 0x20, 0x10, 0xa0, 0xca, 0xbd, 0x71, 0x89, 0xd6,
-	0xce, 0xd4, 0xb5, 0xf0, 0x7a, 0xa2, 0xdd, 0x4d, 0x55, 0xdf, 0x78, 0x04, 0x24, 0xee, 0x3c, 0x00,
-	0x67, 0x1e, 0xa2, 0xe2, 0xd4, 0x23, 0xa7, 0x80, 0x92, 0x37, 0xe0, 0x09, 0xd0, 0xfe, 0x31, 0x42,
-	0x34, 0x27, 0x7b, 0x66, 0x7e, 0xf3, 0xed, 0xcc, 0x37, 0x7e, 0x8c, 0x92, 0xa3, 0x2c, 0x25, 0x2d,
-	0x18, 0xe7, 0xf4, 0xf2, 0x28, 0x03, 0xc5, 0x8e, 0x68, 0x01, 0x35, 0xc8, 0x52, 0x92, 0xb9, 0x40,
-	0x85, 0x41, 0xdf, 0x31, 0x44, 0x33, 0xc4, 0x31, 0x87, 0xfd, 0x02, 0x0b, 0x34, 0x00, 0xd5, 0x7f,
-	0x96, 0x3d, 0x3c, 0x28, 0x10, 0x8b, 0x0a, 0xa8, 0x89, 0xb2, 0xc5, 0x39, 0x65, 0x75, 0xd3, 0x96,
-	0x72, 0xa3, 0x33, 0xb5, 0x3d, 0x36, 0x70, 0xa5, 0xc8, 0x46, 0x34, 0x63, 0x12, 0xfe, 0x0e, 0x91,
-	0x63, 0x59, 0xbb, 0xfa, 0xc3, 0x8d, 0x53, 0xca, 0x0b, 0x26, 0x60, 0x66, 0x91, 0xf8, 0x8b, 0xe7,
-	0xf7, 0x4e, 0x99, 0x60, 0x5c, 0x06, 0x9f, 0x3d, 0x7f, 0x7f, 0x8e, 0x58, 0x4d, 0x73, 0x01, 0x4c,
-	0x95, 0x58, 0x4f, 0xcf, 0x01, 0x42, 0x6f, 0xb8, 0x9d, 0xec, 0x8e, 0x0f, 0x88, 0x7b, 0x58, 0x3f,
-	0xd5, 0xee, 0x42, 0x4e, 0xb0, 0xac, 0x27, 0x6f, 0xae, 0x97, 0x83, 0xce, 0xef, 0xe5, 0x20, 0x6c,
-	0x18, 0xaf, 0x8e, 0xe3, 0x5b, 0x0a, 0xf1, 0xd7, 0x9f, 0x83, 0xa4, 0x28, 0xd5, 0xc5, 0x22, 0x23,
-	0x39, 0x72, 0xb7, 0x81, 0xfb, 0x8c, 0xe4, 0xec, 0x03, 0x55, 0xcd, 0x1c, 0xa4, 0x11, 0x93, 0xe9,
-	0x7d, 0xdd, 0x7f, 0xe2, 0xda, 0x5f, 0x00, 0xc4, 0x1f, 0xb7, 0xfc, 0xbb, 0x2f, 0xad, 0xaf, 0x67,
-	0x8a, 0x29, 0x08, 0x9e, 0xf9, 0x3b, 0x9a, 0x91, 0x6e, 0xb2, 0x3e, 0xb1, 0xd6, 0x91, 0xd6, 0x3a,
-	0xf2, 0xbc, 0x6e, 0x26, 0x77, 0xbe, 0x7f, 0x1b, 0xed, 0x9c, 0x22, 0x56, 0xaf, 0x52, 0x4b, 0x07,
-	0x89, 0xbf, 0x57, 0xc3, 0x95, 0x9a, 0x9a, 0xf9, 0xea, 0x05, 0xcf, 0x40, 0x84, 0x5b, 0x43, 0x2f,
-	0xe9, 0xa6, 0xf7, 0x74, 0x5e, 0xb3, 0xef, 0x4c, 0x36, 0x38, 0xf6, 0x7b, 0x73, 0xe3, 0x48, 0xb8,
-	0x3d, 0xf4, 0x92, 0xdd, 0xf1, 0x03, 0xb2, 0xe9, 0x90, 0xc4, 0xba, 0x36, 0xe9, 0xea, 0xf5, 0x53,
-	0xd7, 0x11, 0x9c, 0xf9, 0xfb, 0xbc, 0x2c, 0x84, 0x5d, 0x5e, 0x40, 0x8e, 0x62, 0x26, 0xc3, 0xae,
-	0x91, 0x79, 0xb4, 0x59, 0xe6, 0x6d, 0x8b, 0xa7, 0x96, 0x4e, 0xf7, 0xf8, 0x7f, 0x99, 0xc9, 0xeb,
-	0xeb, 0x55, 0xe4, 0xdd, 0xac, 0x22, 0xef, 0xd7, 0x2a, 0xf2, 0x3e, 0xad, 0xa3, 0xce, 0xcd, 0x3a,
-	0xea, 0xfc, 0x58, 0x47, 0x9d, 0xf7, 0x8f, 0xff, 0xf1, 0xd5, 0xa9, 0x8f, 0x2a, 0x96, 0xc9, 0x36,
-	0xa0, 0x97, 0xe3, 0xa7, 0xf4, 0xca, 0x9e, 0xdf, 0xb8, 0x9c, 0xf5, 0x8c, 0x4d, 0x4f, 0xfe, 0x04,
-	0x00, 0x00, 0xff, 0xff, 0x7f, 0xd4, 0xe2, 0x7f, 0xc1, 0x02, 0x00, 0x00,
+	// 538 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xcf, 0x6e, 0xd3, 0x40,
+	0x10, 0xc6, 0x63, 0xf2, 0x47, 0xc2, 0x45, 0xd0, 0x5a, 0x39, 0x38, 0x05, 0x9c, 0x90, 0x03, 0xf2,
+	0x25, 0x6b, 0x1a, 0xc8, 0xa5, 0x27, 0x48, 0xa4, 0x22, 0x10, 0xa0, 0xca, 0x41, 0x42, 0xe2, 0x62,
+	0xad, 0x9d, 0xcd, 0xc6, 0xc2, 0xf6, 0x44, 0x3b, 0x9b, 0xd2, 0xf0, 0x14, 0x48, 0xdc, 0x79, 0x00,
+	0xce, 0x9c, 0x39, 0x57, 0x9c, 0x7a, 0xe4, 0x54, 0x50, 0xf2, 0x06, 0x3c, 0x01, 0xf2, 0xee, 0x06,
+	0x21, 0x9a, 0x9e, 0x92, 0xd9, 0xf9, 0x7d, 0xe3, 0x6f, 0xbf, 0x59, 0xbb, 0x0b, 0x98, 0x03, 0xa6,
+	0x18, 0x70, 0x9a, 0xe7, 0xc1, 0xc9, 0x41, 0xcc, 0x24, 0x3d, 0x08, 0x38, 0x2b, 0x18, 0xa6, 0x48,
+	0xe6, 0x02, 0x24, 0x38, 0x4d, 0xc3, 0x90, 0x92, 0x21, 0x86, 0xd9, 0x6f, 0x72, 0xe0, 0xa0, 0x80,
+	0xa0, 0xfc, 0xa7, 0xd9, 0xfd, 0x16, 0x07, 0xe0, 0x19, 0x0b, 0x54, 0x15, 0x2f, 0xa6, 0x01, 0x2d,
+	0x96, 0x9b, 0x56, 0xa2, 0xe6, 0x44, 0x5a, 0xa3, 0x0b, 0xd3, 0xf2, 0x74, 0x15, 0xc4, 0x14, 0xd9,
+	0x5f, 0x13, 0x09, 0xa4, 0x85, 0xe9, 0xdf, 0xdb, 0xea, 0x12, 0x67, 0x54, 0xb0, 0x89, 0x41, 0x06,
+	0xdb, 0x91, 0x84, 0x66, 0x69, 0xc1, 0xa3, 0x29, 0x4d, 0x24, 0x88, 0x48, 0x50, 0xc9, 0x22, 0x84,
+	0x85, 0x48, 0x98, 0x96, 0x75, 0x3f, 0x5b, 0x76, 0xe3, 0x98, 0x0a, 0x9a, 0xa3, 0xf3, 0xc9, 0xb2,
+	0xf7, 0xe6, 0x00, 0x59, 0x94, 0x08, 0x46, 0x65, 0x0a, 0x45, 0x34, 0x65, 0xcc, 0xb5, 0x3a, 0x55,
+	0x7f, 0xa7, 0xdf, 0x22, 0xc6, 0x6f, 0xe9, 0x70, 0x13, 0x01, 0x19, 0x41, 0x5a, 0x0c, 0x5f, 0x9c,
+	0x5d, 0xb4, 0x2b, 0xbf, 0x2f, 0xda, 0xee, 0x92, 0xe6, 0xd9, 0x61, 0xf7, 0xd2, 0x84, 0xee, 0x97,
+	0x9f, 0x6d, 0x9f, 0xa7, 0x72, 0xb6, 0x88, 0x49, 0x02, 0xb9, 0xb9, 0xb8, 0xf9, 0xe9, 0xe1, 0xe4,
+	0x5d, 0x20, 0x97, 0x73, 0x86, 0x6a, 0x18, 0x86, 0xb7, 0x4a, 0xfd, 0xc8, 0xc8, 0x8f, 0x18, 0xeb,
+	0x7e, 0xab, 0xda, 0x37, 0x9e, 0xea, 0x75, 0x8c, 0x25, 0x95, 0xcc, 0x19, 0xd8, 0xf5, 0x92, 0x41,
+	0xe3, 0xac, 0x49, 0x74, 0xe2, 0x64, 0x93, 0x38, 0x79, 0x52, 0x2c, 0x87, 0xd7, 0xbf, 0x7f, 0xed,
+	0xd5, 0x8f, 0x01, 0xb2, 0x67, 0xa1, 0xa6, 0x1d, 0xdf, 0xde, 0x2d, 0xd8, 0xa9, 0x8c, 0x94, 0xbf,
+	0x62, 0x91, 0xc7, 0x4c, 0xb8, 0xd7, 0x3a, 0x96, 0x5f, 0x0b, 0x6f, 0x96, 0xe7, 0x25, 0xfb, 0x4a,
+	0x9d, 0x3a, 0x87, 0x76, 0x63, 0xae, 0x12, 0x71, 0xab, 0x1d, 0xcb, 0xdf, 0xe9, 0xdf, 0x21, 0xdb,
+	0xf6, 0x4f, 0x74, 0x6a, 0xc3, 0x5a, 0x79, 0xfd, 0xd0, 0x28, 0x9c, 0xb1, 0xbd, 0x97, 0xa7, 0x5c,
+	0xe8, 0xcb, 0x0b, 0x96, 0x80, 0x98, 0xa0, 0x5b, 0x53, 0x63, 0xee, 0x6f, 0x1f, 0xf3, 0x72, 0x83,
+	0x87, 0x9a, 0x0e, 0x77, 0xf3, 0xff, 0x4e, 0x9c, 0x0f, 0xf6, 0xed, 0xab, 0xf7, 0x88, 0x6e, 0x5d,
+	0xe5, 0x30, 0xb8, 0xc2, 0x65, 0x99, 0xc1, 0xe4, 0x35, 0x8c, 0xf5, 0x80, 0x23, 0xa5, 0x0f, 0xa9,
+	0x64, 0x63, 0xa5, 0x36, 0xf6, 0x5d, 0xdc, 0xde, 0x46, 0xe7, 0xb1, 0x7d, 0x17, 0x73, 0x00, 0x39,
+	0x8b, 0xde, 0xb3, 0x94, 0xcf, 0x64, 0x94, 0xcc, 0x68, 0xc1, 0x99, 0x8e, 0x31, 0x9d, 0xa0, 0xdb,
+	0xe8, 0x54, 0xfd, 0x5a, 0xd8, 0xd2, 0xd0, 0x1b, 0xc5, 0x8c, 0x14, 0xa2, 0xbf, 0x8c, 0xc3, 0xe7,
+	0x6f, 0x1f, 0xfc, 0xb3, 0x7d, 0x63, 0xb2, 0x97, 0xd1, 0x18, 0x37, 0x45, 0x70, 0xd2, 0x7f, 0x14,
+	0x9c, 0xea, 0x87, 0xab, 0xde, 0xc2, 0xd9, 0xca, 0xb3, 0xce, 0x57, 0x9e, 0xf5, 0x6b, 0xe5, 0x59,
+	0x1f, 0xd7, 0x5e, 0xe5, 0x7c, 0xed, 0x55, 0x7e, 0xac, 0xbd, 0x4a, 0xdc, 0x50, 0x4b, 0x7e, 0xf8,
+	0x67, 0x00, 0x29, 0xd5, 0x1e, 0xde, 0xb6, 0x03, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -241,6 +264,38 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SmoothWeightChangePoolIds) > 0 {
+		dAtA2 := make([]byte, len(m.SmoothWeightChangePoolIds)*10)
+		var j1 int
+		for _, num := range m.SmoothWeightChangePoolIds {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintGenesis(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ScalingFactorRateSources) > 0 {
+		for iNdEx := len(m.ScalingFactorRateSources) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScalingFactorRateSources[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if m.MigrationRecords != nil {
 		{
 			size, err := m.MigrationRecords.MarshalToSizedBuffer(dAtA[:i])
@@ -332,6 +387,19 @@ func (m *GenesisState) Size() (n int) {
 		l = m.MigrationRecords.Size()
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if len(m.ScalingFactorRateSources) > 0 {
+		for _, e := range m.ScalingFactorRateSources {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SmoothWeightChangePoolIds) > 0 {
+		l = 0
+		for _, e := range m.SmoothWeightChangePoolIds {
+			l += sovGenesis(uint64(e))
+		}
+		n += 1 + sovGenesis(uint64(l)) + l
+	}
 	return n
 }
 
@@ -576,6 +644,116 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScalingFactorRateSources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScalingFactorRateSources = append(m.ScalingFactorRateSources, PoolIdToScalingFactorRateSource{})
+			if err := m.ScalingFactorRateSources[len(m.ScalingFactorRateSources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.SmoothWeightChangePoolIds = append(m.SmoothWeightChangePoolIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.SmoothWeightChangePoolIds) == 0 {
+					m.SmoothWeightChangePoolIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.SmoothWeightChangePoolIds = append(m.SmoothWeightChangePoolIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field SmoothWeightChangePoolIds", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
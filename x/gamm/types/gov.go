@@ -17,6 +17,7 @@ const (
 	ProposalTypeReplaceMigrationRecords                      = "ReplaceMigrationRecords"
 	ProposalTypeCreateConcentratedLiquidityPoolAndLinktoCFMM = "CreateConcentratedLiquidityPoolAndLinktoCFMM"
 	ProposalTypeSetScalingFactorController                   = "SetScalingFactorController"
+	ProposalTypeSetScalingFactorRateSource                   = "SetScalingFactorRateSource"
 )
 
 // Init registers proposals to update and replace migration records.
@@ -25,6 +26,7 @@ func init() {
 	govtypesv1.RegisterProposalType(ProposalTypeReplaceMigrationRecords)
 	govtypesv1.RegisterProposalType(ProposalTypeCreateConcentratedLiquidityPoolAndLinktoCFMM)
 	govtypesv1.RegisterProposalType(ProposalTypeSetScalingFactorController)
+	govtypesv1.RegisterProposalType(ProposalTypeSetScalingFactorRateSource)
 }
 
 var (
@@ -32,6 +34,7 @@ var (
 	_ govtypesv1.Content = &ReplaceMigrationRecordsProposal{}
 	_ govtypesv1.Content = &CreateConcentratedLiquidityPoolsAndLinktoCFMMProposal{}
 	_ govtypesv1.Content = &SetScalingFactorControllerProposal{}
+	_ govtypesv1.Content = &SetScalingFactorRateSourceProposal{}
 )
 
 // NewReplacePoolIncentivesProposal returns a new instance of a replace migration record's proposal struct.
@@ -266,3 +269,64 @@ func (p SetScalingFactorControllerProposal) String() string {
 `, p.Title, p.Description, p.PoolId, p.ControllerAddress))
 	return b.String()
 }
+
+// NewSetScalingFactorRateSourceProposal returns a new instance of a set scaling factor rate source proposal struct.
+// A nil rate source removes the rate source of the pool.
+func NewSetScalingFactorRateSourceProposal(title, description string, poolId uint64, rateSource *ScalingFactorRateSource) govtypesv1.Content {
+	return &SetScalingFactorRateSourceProposal{
+		Title:       title,
+		Description: description,
+		PoolId:      poolId,
+		RateSource:  rateSource,
+	}
+}
+
+// GetTitle gets the title of the proposal
+func (p *SetScalingFactorRateSourceProposal) GetTitle() string { return p.Title }
+
+// GetDescription gets the description of the proposal
+func (p *SetScalingFactorRateSourceProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the router key for the proposal
+func (p *SetScalingFactorRateSourceProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal
+func (p *SetScalingFactorRateSourceProposal) ProposalType() string {
+	return ProposalTypeSetScalingFactorRateSource
+}
+
+// ValidateBasic validates a governance proposal's abstract and basic contents
+func (p *SetScalingFactorRateSourceProposal) ValidateBasic() error {
+	err := govtypesv1.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+	if p.PoolId == 0 {
+		return fmt.Errorf("pool id must be positive")
+	}
+	if p.RateSource == nil {
+		return nil
+	}
+	if p.RateSource.Twap != nil && p.RateSource.Twap.PoolId == p.PoolId {
+		return fmt.Errorf("pool %d can not follow its own TWAP", p.PoolId)
+	}
+
+	return p.RateSource.Validate()
+}
+
+// String returns a string containing the set scaling factor rate source proposal.
+func (p SetScalingFactorRateSourceProposal) String() string {
+	rateSourceStr := "None"
+	if p.RateSource != nil {
+		rateSourceStr = p.RateSource.String()
+	}
+
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Set Scaling Factor Rate Source Proposal:
+  Title:       %s
+  Description: %s
+  PoolId:      %d
+  RateSource:  %s
+`, p.Title, p.Description, p.PoolId, rateSourceStr))
+	return b.String()
+}
@@ -269,69 +269,117 @@ func (m *SetScalingFactorControllerProposal) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SetScalingFactorControllerProposal proto.InternalMessageInfo
 
+// SetScalingFactorRateSourceProposal is a gov Content type for setting the
+// on-chain rate source driving the scaling factors of a stableswap pool at
+// the end of each epoch. A proposal without a rate source removes the rate
+// source of the pool, which is then adjusted by its scaling factor controller
+// again.
+type SetScalingFactorRateSourceProposal struct {
+	Title       string                   `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string                   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	PoolId      uint64                   `protobuf:"varint,3,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	RateSource  *ScalingFactorRateSource `protobuf:"bytes,4,opt,name=rate_source,json=rateSource,proto3" json:"rate_source,omitempty"`
+}
+
+func (m *SetScalingFactorRateSourceProposal) Reset()      { *m = SetScalingFactorRateSourceProposal{} }
+func (*SetScalingFactorRateSourceProposal) ProtoMessage() {}
+func (*SetScalingFactorRateSourceProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f31b9a6c0dbbdfa3, []int{5}
+}
+func (m *SetScalingFactorRateSourceProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetScalingFactorRateSourceProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetScalingFactorRateSourceProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetScalingFactorRateSourceProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetScalingFactorRateSourceProposal.Merge(m, src)
+}
+func (m *SetScalingFactorRateSourceProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetScalingFactorRateSourceProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetScalingFactorRateSourceProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetScalingFactorRateSourceProposal proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*ReplaceMigrationRecordsProposal)(nil), "osmosis.gamm.v1beta1.ReplaceMigrationRecordsProposal")
 	proto.RegisterType((*UpdateMigrationRecordsProposal)(nil), "osmosis.gamm.v1beta1.UpdateMigrationRecordsProposal")
 	proto.RegisterType((*PoolRecordWithCFMMLink)(nil), "osmosis.gamm.v1beta1.PoolRecordWithCFMMLink")
 	proto.RegisterType((*CreateConcentratedLiquidityPoolsAndLinktoCFMMProposal)(nil), "osmosis.gamm.v1beta1.CreateConcentratedLiquidityPoolsAndLinktoCFMMProposal")
 	proto.RegisterType((*SetScalingFactorControllerProposal)(nil), "osmosis.gamm.v1beta1.SetScalingFactorControllerProposal")
+	proto.RegisterType((*SetScalingFactorRateSourceProposal)(nil), "osmosis.gamm.v1beta1.SetScalingFactorRateSourceProposal")
 }
 
 func init() { proto.RegisterFile("osmosis/gamm/v1beta1/gov.proto", fileDescriptor_f31b9a6c0dbbdfa3) }
 
 var fileDescriptor_f31b9a6c0dbbdfa3 = []byte{
-	// 805 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x55, 0x41, 0x6f, 0xe3, 0x44,
-	0x14, 0x8e, 0x93, 0x6c, 0x56, 0x4c, 0x77, 0x11, 0x35, 0x59, 0x12, 0x5a, 0x64, 0x07, 0x1f, 0x96,
-	0xb2, 0xa2, 0xf6, 0x66, 0x59, 0x2e, 0x41, 0x7b, 0x68, 0x02, 0x2b, 0x2d, 0x6a, 0x45, 0xe5, 0xee,
-	0x82, 0xe0, 0x62, 0x26, 0xe3, 0xa9, 0x33, 0x8a, 0x3d, 0x63, 0x66, 0xa6, 0xa5, 0xf9, 0x01, 0x08,
-	0xc4, 0x89, 0x23, 0xe2, 0xd4, 0x9f, 0xc0, 0x81, 0x1f, 0x51, 0xc1, 0xa5, 0x47, 0xc4, 0xc1, 0x42,
-	0xed, 0x01, 0xce, 0x39, 0x72, 0x01, 0xcd, 0x8c, 0x5d, 0x0a, 0x8d, 0xa8, 0x4a, 0x4f, 0x5c, 0xa2,
-	0xbc, 0xf7, 0x3e, 0x7f, 0x9f, 0xdf, 0xf7, 0xde, 0x93, 0x81, 0xc3, 0x44, 0xc6, 0x04, 0x11, 0x41,
-	0x02, 0xb3, 0x2c, 0xd8, 0xef, 0x8f, 0xb1, 0x84, 0xfd, 0x20, 0x61, 0xfb, 0x7e, 0xce, 0x99, 0x64,
-	0x76, 0xbb, 0xac, 0xfb, 0xaa, 0xee, 0x97, 0xf5, 0x95, 0x76, 0xc2, 0x12, 0xa6, 0x01, 0x81, 0xfa,
-	0x67, 0xb0, 0x2b, 0xde, 0x62, 0x2e, 0x4c, 0xb1, 0x22, 0x30, 0x98, 0x57, 0x17, 0x62, 0xc4, 0x04,
-	0x72, 0x1c, 0x97, 0x90, 0x97, 0x91, 0xc6, 0x44, 0x86, 0xdf, 0x04, 0x65, 0x69, 0x19, 0x66, 0x84,
-	0xb2, 0x40, 0xff, 0x9a, 0x94, 0xf7, 0x45, 0x1d, 0xb8, 0x21, 0xce, 0x53, 0x88, 0xf0, 0x16, 0x49,
-	0x38, 0x94, 0x84, 0xd1, 0x10, 0x23, 0xc6, 0x63, 0xb1, 0xcd, 0x59, 0xce, 0x04, 0x4c, 0xed, 0x36,
-	0xb8, 0x21, 0x89, 0x4c, 0x71, 0xd7, 0xea, 0x59, 0x6b, 0xcf, 0x85, 0x26, 0xb0, 0x7b, 0x60, 0x29,
-	0xc6, 0x02, 0x71, 0x92, 0xab, 0x67, 0xba, 0x75, 0x5d, 0x3b, 0x9f, 0xb2, 0x9f, 0x82, 0x9b, 0xdc,
-	0x50, 0x75, 0x1b, 0xbd, 0xc6, 0xda, 0xd2, 0x83, 0x87, 0xfe, 0x22, 0x3b, 0xfc, 0x21, 0x4c, 0x21,
-	0x45, 0x98, 0x3f, 0x65, 0x23, 0x46, 0x11, 0xa6, 0x92, 0x43, 0x89, 0xe3, 0x6d, 0xc6, 0xd2, 0x4d,
-	0x42, 0xa7, 0xc3, 0xe6, 0x51, 0xe1, 0xd6, 0xc2, 0x8a, 0x6a, 0xf0, 0xc1, 0x97, 0x87, 0x6e, 0xed,
-	0x9b, 0x43, 0xb7, 0xf6, 0xdb, 0xa1, 0x6b, 0xfd, 0xf0, 0xfd, 0xfa, 0x4a, 0xd9, 0xa2, 0x32, 0xbd,
-	0x62, 0x1c, 0x31, 0x2a, 0x31, 0x95, 0x5f, 0xfd, 0xfa, 0xdd, 0xbd, 0xd7, 0x2a, 0xc7, 0x2e, 0xe9,
-	0xd2, 0xfb, 0xbc, 0x0e, 0x9c, 0x67, 0x79, 0x0c, 0xe5, 0xff, 0xc5, 0x88, 0x67, 0x57, 0x33, 0xe2,
-	0x6e, 0x65, 0xc4, 0xbf, 0x37, 0xe9, 0xfd, 0xd8, 0x00, 0x2f, 0x29, 0x49, 0x93, 0xff, 0x90, 0xc8,
-	0xc9, 0xe8, 0xf1, 0xd6, 0x96, 0x7a, 0x01, 0xfb, 0x75, 0xd0, 0x8a, 0x31, 0x65, 0xd9, 0x7d, 0x63,
-	0xc0, 0x70, 0x79, 0x5e, 0xb8, 0xb7, 0x67, 0x30, 0x4b, 0x07, 0x9e, 0xc9, 0x7b, 0x61, 0x09, 0x38,
-	0x83, 0xf6, 0x8d, 0x1f, 0x17, 0xa0, 0xfd, 0x0a, 0xda, 0xb7, 0x07, 0xe0, 0x96, 0x24, 0x68, 0x1a,
-	0x89, 0x1c, 0x22, 0x42, 0x93, 0x6e, 0xa3, 0x67, 0xad, 0x35, 0x87, 0x9d, 0x79, 0xe1, 0xbe, 0x68,
-	0x1e, 0x38, 0x5f, 0xf5, 0xc2, 0x25, 0x15, 0xee, 0x98, 0xc8, 0xce, 0xc1, 0x1d, 0x7c, 0x90, 0x33,
-	0x8a, 0xa9, 0x8c, 0xa0, 0x8c, 0x72, 0x4e, 0x10, 0x8e, 0x18, 0xc5, 0xdd, 0xa6, 0x56, 0x7d, 0xa4,
-	0x1c, 0xfb, 0xb9, 0x70, 0xef, 0x18, 0x6b, 0x44, 0x3c, 0xf5, 0x09, 0x0b, 0x32, 0x28, 0x27, 0xfe,
-	0x13, 0x2a, 0xe7, 0x85, 0xfb, 0x8a, 0x51, 0x58, 0xc8, 0xe1, 0x85, 0x76, 0x95, 0xdf, 0x90, 0xdb,
-	0x2a, 0xfb, 0x3e, 0xc5, 0xf6, 0x27, 0xe0, 0xb6, 0xc8, 0x39, 0x86, 0x71, 0xb4, 0x0b, 0x91, 0x64,
-	0xbc, 0x7b, 0x43, 0x2b, 0xbd, 0x5d, 0x2a, 0xad, 0x5e, 0x54, 0xda, 0xc4, 0x09, 0x44, 0xb3, 0x77,
-	0x30, 0x9a, 0x17, 0x6e, 0xdb, 0xe8, 0xfd, 0x8d, 0xc1, 0x0b, 0x6f, 0x99, 0xf8, 0xb1, 0x0e, 0xed,
-	0x77, 0xc1, 0x0b, 0xe3, 0x72, 0x11, 0xa2, 0x9c, 0xb1, 0x34, 0x22, 0x71, 0xb7, 0xa5, 0x3d, 0x59,
-	0x9d, 0x17, 0x6e, 0xc7, 0x30, 0xfc, 0x13, 0xe1, 0x85, 0xcf, 0x57, 0x29, 0x35, 0xbc, 0x27, 0xf1,
-	0xa0, 0xa9, 0xd6, 0xc2, 0xfb, 0xbd, 0x0e, 0xde, 0x1a, 0x71, 0x0c, 0x25, 0x3e, 0xbf, 0x52, 0x9b,
-	0xe4, 0xd3, 0x3d, 0x12, 0x13, 0x39, 0x53, 0x58, 0xb1, 0x41, 0x63, 0x35, 0x5e, 0xc9, 0xd4, 0xa0,
-	0xaf, 0xbd, 0xec, 0xdf, 0x5a, 0x60, 0x55, 0xbf, 0x54, 0xb9, 0xa7, 0xd1, 0x67, 0x44, 0x4e, 0x22,
-	0xb4, 0x9b, 0x65, 0x51, 0x4a, 0xe8, 0xb4, 0xbc, 0x80, 0x37, 0x16, 0x5f, 0xc0, 0xe2, 0xc5, 0x1b,
-	0xfa, 0xca, 0xdd, 0x79, 0xe1, 0xde, 0x35, 0xcd, 0x23, 0xdd, 0x50, 0x84, 0x52, 0xd3, 0x3d, 0xa4,
-	0xb1, 0xa6, 0x8e, 0x24, 0xd3, 0x3a, 0x5e, 0xd8, 0xc9, 0xcf, 0x78, 0x84, 0x26, 0xda, 0xcd, 0x32,
-	0x45, 0x34, 0x48, 0xaf, 0x76, 0x33, 0x8f, 0xaa, 0x9b, 0xf9, 0x4f, 0x16, 0x7a, 0x7f, 0x58, 0xc0,
-	0xdb, 0xc1, 0x72, 0x07, 0xc1, 0x94, 0xd0, 0xc4, 0x8c, 0x57, 0xb1, 0x73, 0x96, 0xa6, 0x98, 0x5f,
-	0xdb, 0xe9, 0x0e, 0xb8, 0x59, 0xed, 0x87, 0xbe, 0x99, 0xb0, 0x95, 0xeb, 0xd1, 0xdb, 0xeb, 0xc0,
-	0x46, 0x67, 0x32, 0x11, 0x8c, 0x63, 0x8e, 0x85, 0x30, 0x27, 0x11, 0x2e, 0xff, 0x55, 0xd9, 0x30,
-	0x85, 0xc1, 0x47, 0x57, 0x33, 0xe5, 0x5e, 0x65, 0xca, 0xe5, 0xad, 0x0d, 0xdf, 0x3b, 0x3a, 0x71,
-	0xac, 0xe3, 0x13, 0xc7, 0xfa, 0xe5, 0xc4, 0xb1, 0xbe, 0x3e, 0x75, 0x6a, 0xc7, 0xa7, 0x4e, 0xed,
-	0xa7, 0x53, 0xa7, 0xf6, 0xf1, 0xfd, 0x84, 0xc8, 0xc9, 0xde, 0xd8, 0x47, 0x2c, 0x0b, 0x4a, 0xc2,
-	0xf5, 0x14, 0x8e, 0x45, 0x15, 0x04, 0xfb, 0x0f, 0x1e, 0x06, 0x07, 0xe6, 0x3b, 0x27, 0x67, 0x39,
-	0x16, 0xe3, 0x96, 0xfe, 0x62, 0xbd, 0xf9, 0x67, 0x00, 0x00, 0x00, 0xff, 0xff, 0x76, 0xe6, 0x52,
-	0x61, 0x74, 0x07, 0x00, 0x00,
+	// 861 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x56, 0x41, 0x6f, 0xdc, 0x44,
+	0x14, 0x5e, 0x6f, 0xd2, 0x54, 0xcc, 0xb6, 0x88, 0x98, 0x94, 0x98, 0x04, 0xd9, 0xc1, 0x87, 0x12,
+	0x2a, 0x62, 0x77, 0x43, 0x7b, 0x59, 0xd4, 0x43, 0x36, 0x50, 0xa9, 0x28, 0x81, 0xc8, 0x69, 0x41,
+	0x70, 0x19, 0x66, 0xc7, 0x13, 0xef, 0x28, 0xf6, 0x8c, 0x99, 0x99, 0x84, 0xe6, 0x07, 0x20, 0x10,
+	0x27, 0x8e, 0x88, 0x53, 0x7e, 0x02, 0x07, 0x7e, 0x44, 0x05, 0x97, 0x1e, 0x11, 0x07, 0x0b, 0x25,
+	0x07, 0x38, 0xef, 0x91, 0x0b, 0x68, 0x66, 0xec, 0x10, 0x88, 0xa1, 0x0a, 0x81, 0x43, 0x2f, 0x91,
+	0xdf, 0x7b, 0xdf, 0x7c, 0x6f, 0xde, 0xf7, 0xde, 0xcb, 0x2c, 0xf0, 0xb9, 0x2c, 0xb8, 0xa4, 0x32,
+	0xce, 0x50, 0x51, 0xc4, 0xfb, 0xfd, 0x11, 0x51, 0xa8, 0x1f, 0x67, 0x7c, 0x3f, 0x2a, 0x05, 0x57,
+	0xdc, 0x9d, 0xab, 0xe3, 0x91, 0x8e, 0x47, 0x75, 0x7c, 0x61, 0x2e, 0xe3, 0x19, 0x37, 0x80, 0x58,
+	0x7f, 0x59, 0xec, 0x42, 0xd8, 0xce, 0x45, 0x18, 0xd1, 0x04, 0x16, 0xf3, 0x72, 0x2b, 0x46, 0x8e,
+	0x91, 0x20, 0x69, 0x0d, 0xb9, 0xdd, 0x0e, 0xc1, 0x28, 0xa7, 0x2c, 0x83, 0x3b, 0x08, 0x2b, 0x2e,
+	0xa0, 0x40, 0x8a, 0x40, 0xc9, 0xf7, 0x04, 0x26, 0xf5, 0xb1, 0x17, 0xb1, 0x39, 0x07, 0xed, 0xb5,
+	0xac, 0x51, 0x87, 0x66, 0x51, 0x41, 0x19, 0x8f, 0xcd, 0x5f, 0xeb, 0x0a, 0x3f, 0xeb, 0x82, 0x20,
+	0x21, 0x65, 0x8e, 0x30, 0xd9, 0xa4, 0x99, 0x40, 0x8a, 0x72, 0x96, 0x10, 0xcc, 0x45, 0x2a, 0xb7,
+	0x04, 0x2f, 0xb9, 0x44, 0xb9, 0x3b, 0x07, 0x2e, 0x29, 0xaa, 0x72, 0xe2, 0x39, 0x4b, 0xce, 0xf2,
+	0x33, 0x89, 0x35, 0xdc, 0x25, 0xd0, 0x4b, 0x89, 0xc4, 0x82, 0x96, 0xfa, 0x8c, 0xd7, 0x35, 0xb1,
+	0xd3, 0x2e, 0xf7, 0x3e, 0xb8, 0x2c, 0x2c, 0x95, 0x37, 0xb5, 0x34, 0xb5, 0xdc, 0x5b, 0xbd, 0x15,
+	0xb5, 0xa9, 0x18, 0x0d, 0x51, 0x8e, 0x18, 0x26, 0xe2, 0x3e, 0x5f, 0xe7, 0x0c, 0x13, 0xa6, 0x74,
+	0x51, 0xe9, 0x16, 0xe7, 0xf9, 0x06, 0x65, 0xbb, 0xc3, 0xe9, 0x47, 0x55, 0xd0, 0x49, 0x1a, 0xaa,
+	0xc1, 0x7b, 0x9f, 0x1f, 0x06, 0x9d, 0xaf, 0x0e, 0x83, 0xce, 0x2f, 0x87, 0x81, 0xf3, 0xdd, 0xb7,
+	0x2b, 0x0b, 0x75, 0x89, 0xba, 0x57, 0x0d, 0xe3, 0x3a, 0x67, 0x8a, 0x30, 0xf5, 0xc5, 0xcf, 0xdf,
+	0xdc, 0x78, 0xa5, 0x51, 0xf1, 0x09, 0x55, 0x86, 0x9f, 0x76, 0x81, 0xff, 0xa0, 0x4c, 0x91, 0x7a,
+	0x5a, 0x84, 0x78, 0x70, 0x3e, 0x21, 0xae, 0x37, 0x42, 0xfc, 0x73, 0x91, 0xe1, 0xf7, 0x53, 0xe0,
+	0x05, 0x9d, 0xd2, 0xfa, 0xdf, 0xa7, 0x6a, 0xbc, 0x7e, 0x77, 0x73, 0x53, 0x5f, 0xc0, 0x7d, 0x15,
+	0xcc, 0xa4, 0x84, 0xf1, 0xe2, 0xa6, 0x15, 0x60, 0x38, 0x3b, 0xa9, 0x82, 0xab, 0x07, 0xa8, 0xc8,
+	0x07, 0xa1, 0xf5, 0x87, 0x49, 0x0d, 0x38, 0x81, 0xf6, 0xbd, 0x6e, 0x2b, 0xb4, 0xdf, 0x40, 0xfb,
+	0xee, 0x00, 0x5c, 0x51, 0x14, 0xef, 0x42, 0x59, 0x22, 0x4c, 0x59, 0xe6, 0x4d, 0x2d, 0x39, 0xcb,
+	0xd3, 0xc3, 0xf9, 0x49, 0x15, 0x3c, 0x6f, 0x0f, 0x9c, 0x8e, 0x86, 0x49, 0x4f, 0x9b, 0xdb, 0xd6,
+	0x72, 0x4b, 0x70, 0x8d, 0x3c, 0x2c, 0x39, 0x23, 0x4c, 0x41, 0xa4, 0x60, 0x29, 0x28, 0x26, 0x90,
+	0x33, 0xe2, 0x4d, 0x9b, 0xac, 0x77, 0xb4, 0x62, 0x3f, 0x56, 0xc1, 0x35, 0x2b, 0x8d, 0x4c, 0x77,
+	0x23, 0xca, 0xe3, 0x02, 0xa9, 0x71, 0x74, 0x8f, 0xa9, 0x49, 0x15, 0xbc, 0x64, 0x33, 0xb4, 0x72,
+	0x84, 0x89, 0xdb, 0xf8, 0xd7, 0xd4, 0x96, 0xf6, 0xbe, 0xcb, 0x88, 0xfb, 0x11, 0xb8, 0x2a, 0x4b,
+	0x41, 0x50, 0x5a, 0x6f, 0xa0, 0x77, 0xc9, 0x64, 0x7a, 0xa3, 0xce, 0xb4, 0x78, 0x36, 0xd3, 0x06,
+	0xc9, 0x10, 0x3e, 0x78, 0x93, 0xe0, 0x49, 0x15, 0xcc, 0xd9, 0x7c, 0x7f, 0x62, 0x08, 0x93, 0x2b,
+	0xd6, 0xbe, 0x6b, 0x4c, 0xf7, 0x2d, 0xf0, 0xdc, 0xa8, 0x1e, 0x04, 0x58, 0x72, 0x9e, 0x43, 0x9a,
+	0x7a, 0x33, 0x46, 0x93, 0xc5, 0x49, 0x15, 0xcc, 0x5b, 0x86, 0xbf, 0x22, 0xc2, 0xe4, 0xd9, 0xc6,
+	0xa5, 0x9b, 0x77, 0x2f, 0x1d, 0x4c, 0xeb, 0xb1, 0x08, 0x7f, 0xed, 0x82, 0xdb, 0xeb, 0x82, 0x20,
+	0x45, 0x4e, 0x8f, 0xd4, 0x06, 0xfd, 0x78, 0x8f, 0xa6, 0x54, 0x1d, 0x68, 0xac, 0x5c, 0x63, 0xa9,
+	0x6e, 0xaf, 0xe2, 0xba, 0xd1, 0x17, 0x1e, 0xf6, 0xaf, 0x1d, 0xb0, 0x68, 0x2e, 0x55, 0xcf, 0x29,
+	0xfc, 0x84, 0xaa, 0x31, 0xc4, 0x3b, 0x45, 0x01, 0x73, 0xca, 0x76, 0xeb, 0x0d, 0x78, 0xad, 0x7d,
+	0x03, 0xda, 0x07, 0x6f, 0x18, 0x69, 0x75, 0x27, 0x55, 0x70, 0xdd, 0x16, 0x8f, 0x4d, 0x41, 0x10,
+	0xe7, 0xb6, 0x7a, 0xc4, 0x52, 0x43, 0x0d, 0x15, 0x37, 0x79, 0xc2, 0x64, 0xbe, 0x3c, 0xe1, 0x91,
+	0x86, 0x68, 0xa7, 0x28, 0x34, 0xd1, 0x20, 0x3f, 0xdf, 0xce, 0xdc, 0x69, 0x76, 0xe6, 0x5f, 0x49,
+	0x18, 0xfe, 0xe6, 0x80, 0x70, 0x9b, 0xa8, 0x6d, 0xfb, 0x2f, 0xdb, 0xb6, 0x57, 0xb3, 0x0b, 0x9e,
+	0xe7, 0x44, 0x5c, 0x58, 0xe9, 0x79, 0x70, 0xb9, 0x99, 0x0f, 0xb3, 0x33, 0xc9, 0x4c, 0x69, 0x5a,
+	0xef, 0xae, 0x00, 0x17, 0x9f, 0xa4, 0x81, 0x28, 0x4d, 0x05, 0x91, 0xd2, 0xae, 0x44, 0x32, 0xfb,
+	0x47, 0x64, 0xcd, 0x06, 0x06, 0x1f, 0x9c, 0x4f, 0x94, 0x1b, 0x8d, 0x28, 0x4f, 0x2e, 0x2d, 0x3c,
+	0xec, 0x9e, 0x55, 0x20, 0x41, 0x8a, 0x6c, 0x9b, 0x17, 0xeb, 0xff, 0x53, 0xe0, 0x1d, 0xd0, 0x3b,
+	0xf5, 0x32, 0x9a, 0xd2, 0x7b, 0xab, 0x2b, 0xed, 0x33, 0xf7, 0x37, 0x97, 0x4b, 0x80, 0x38, 0xf9,
+	0xfe, 0xcf, 0x24, 0x3a, 0x5b, 0xfb, 0xf0, 0xed, 0x47, 0x47, 0xbe, 0xf3, 0xf8, 0xc8, 0x77, 0x7e,
+	0x3a, 0xf2, 0x9d, 0x2f, 0x8f, 0xfd, 0xce, 0xe3, 0x63, 0xbf, 0xf3, 0xc3, 0xb1, 0xdf, 0xf9, 0xf0,
+	0x66, 0x46, 0xd5, 0x78, 0x6f, 0x14, 0x61, 0x5e, 0xc4, 0x35, 0xe1, 0x4a, 0x8e, 0x46, 0xb2, 0x31,
+	0xe2, 0xfd, 0xd5, 0x5b, 0xf1, 0x43, 0xfb, 0xf3, 0x40, 0x1d, 0x94, 0x44, 0x8e, 0x66, 0xcc, 0xa3,
+	0xfe, 0xfa, 0xef, 0x03, 0x00, 0x01, 0x41, 0xcc, 0x64, 0xce, 0x08, 0x00, 0x00,
 }
 
 func (this *ReplaceMigrationRecordsProposal) Equal(that interface{}) bool {
@@ -511,6 +559,39 @@ func (this *SetScalingFactorControllerProposal) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *SetScalingFactorRateSourceProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SetScalingFactorRateSourceProposal)
+	if !ok {
+		that2, ok := that.(SetScalingFactorRateSourceProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if this.PoolId != that1.PoolId {
+		return false
+	}
+	if !this.RateSource.Equal(that1.RateSource) {
+		return false
+	}
+	return true
+}
 func (m *ReplaceMigrationRecordsProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -780,6 +861,60 @@ func (m *SetScalingFactorControllerProposal) MarshalToSizedBuffer(dAtA []byte) (
 	return len(dAtA) - i, nil
 }
 
+func (m *SetScalingFactorRateSourceProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetScalingFactorRateSourceProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetScalingFactorRateSourceProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RateSource != nil {
+		{
+			size, err := m.RateSource.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGov(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGov(v)
 	base := offset
@@ -911,6 +1046,30 @@ func (m *SetScalingFactorControllerProposal) Size() (n int) {
 	return n
 }
 
+func (m *SetScalingFactorRateSourceProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovGov(uint64(m.PoolId))
+	}
+	if m.RateSource != nil {
+		l = m.RateSource.Size()
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
 func sovGov(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1746,6 +1905,175 @@ func (m *SetScalingFactorControllerProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SetScalingFactorRateSourceProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetScalingFactorRateSourceProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetScalingFactorRateSourceProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateSource", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RateSource == nil {
+				m.RateSource = &ScalingFactorRateSource{}
+			}
+			if err := m.RateSource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGov(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
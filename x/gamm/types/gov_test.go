@@ -2,10 +2,13 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	proto "github.com/cosmos/gogoproto/proto"
 	"github.com/stretchr/testify/require"
 
+	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/v24/x/gamm/types"
 	gammmigration "github.com/osmosis-labs/osmosis/v24/x/gamm/types/migration"
 )
@@ -74,3 +77,132 @@ func TestUpdateMigrationRecordsProposalMarshalUnmarshal(t *testing.T) {
 		require.Equal(t, *test.proposal, decoded)
 	}
 }
+
+func TestSetScalingFactorRateSourceProposal_ValidateBasic(t *testing.T) {
+	validRateSource := func() *types.ScalingFactorRateSource {
+		return &types.ScalingFactorRateSource{
+			BaseDenom:         "stuosmo",
+			QuoteDenom:        "uosmo",
+			MaxChangePerEpoch: osmomath.MustNewDecFromStr("0.01"),
+			RedemptionRate:    &types.RedemptionRateSource{Rate: osmomath.MustNewDecFromStr("1.1")},
+		}
+	}
+	contractAddress := sdk.AccAddress("contract____________").String()
+
+	tests := map[string]struct {
+		rateSource  func() *types.ScalingFactorRateSource
+		zeroPoolId  bool
+		expectedErr bool
+	}{
+		"redemption rate source": {
+			rateSource: validRateSource,
+		},
+		"twap rate source": {
+			rateSource: func() *types.ScalingFactorRateSource {
+				rateSource := validRateSource()
+				rateSource.RedemptionRate = nil
+				rateSource.Twap = &types.TwapRateSource{PoolId: 2, Window: time.Hour}
+				return rateSource
+			},
+		},
+		"contract rate source": {
+			rateSource: func() *types.ScalingFactorRateSource {
+				rateSource := validRateSource()
+				rateSource.RedemptionRate = nil
+				rateSource.Contract = &types.ContractRateSource{ContractAddress: contractAddress, QueryMsg: []byte(`{"redemption_rate":{}}`)}
+				return rateSource
+			},
+		},
+		"removing the rate source": {
+			rateSource: func() *types.ScalingFactorRateSource { return nil },
+		},
+		"error: zero pool id": {
+			rateSource:  validRateSource,
+			zeroPoolId:  true,
+			expectedErr: true,
+		},
+		"error: twap of the pool itself": {
+			rateSource: func() *types.ScalingFactorRateSource {
+				rateSource := validRateSource()
+				rateSource.RedemptionRate = nil
+				rateSource.Twap = &types.TwapRateSource{PoolId: 1, Window: time.Hour}
+				return rateSource
+			},
+			expectedErr: true,
+		},
+		"error: zero twap window": {
+			rateSource: func() *types.ScalingFactorRateSource {
+				rateSource := validRateSource()
+				rateSource.RedemptionRate = nil
+				rateSource.Twap = &types.TwapRateSource{PoolId: 2}
+				return rateSource
+			},
+			expectedErr: true,
+		},
+		"error: invalid contract query msg": {
+			rateSource: func() *types.ScalingFactorRateSource {
+				rateSource := validRateSource()
+				rateSource.RedemptionRate = nil
+				rateSource.Contract = &types.ContractRateSource{ContractAddress: contractAddress, QueryMsg: []byte(`{`)}
+				return rateSource
+			},
+			expectedErr: true,
+		},
+		"error: no source": {
+			rateSource: func() *types.ScalingFactorRateSource {
+				rateSource := validRateSource()
+				rateSource.RedemptionRate = nil
+				return rateSource
+			},
+			expectedErr: true,
+		},
+		"error: two sources": {
+			rateSource: func() *types.ScalingFactorRateSource {
+				rateSource := validRateSource()
+				rateSource.Twap = &types.TwapRateSource{PoolId: 2, Window: time.Hour}
+				return rateSource
+			},
+			expectedErr: true,
+		},
+		"error: non positive redemption rate": {
+			rateSource: func() *types.ScalingFactorRateSource {
+				rateSource := validRateSource()
+				rateSource.RedemptionRate.Rate = osmomath.ZeroDec()
+				return rateSource
+			},
+			expectedErr: true,
+		},
+		"error: same base and quote denoms": {
+			rateSource: func() *types.ScalingFactorRateSource {
+				rateSource := validRateSource()
+				rateSource.QuoteDenom = rateSource.BaseDenom
+				return rateSource
+			},
+			expectedErr: true,
+		},
+		"error: max change per epoch of 100%": {
+			rateSource: func() *types.ScalingFactorRateSource {
+				rateSource := validRateSource()
+				rateSource.MaxChangePerEpoch = osmomath.OneDec()
+				return rateSource
+			},
+			expectedErr: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			poolId := uint64(1)
+			if tc.zeroPoolId {
+				poolId = 0
+			}
+			proposal := types.NewSetScalingFactorRateSourceProposal("title", "description", poolId, tc.rateSource())
+			err := proposal.ValidateBasic()
+			if tc.expectedErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -30,6 +30,13 @@ var (
 
 	KeyPrefixMigrationInfoBalancerPool = []byte{0x04}
 	KeyPrefixMigrationInfoCLPool       = []byte{0x05}
+
+	// KeyPrefixScalingFactorRateSource defines prefix to store the rate sources of stableswap scaling factors.
+	KeyPrefixScalingFactorRateSource = []byte{0x06}
+
+	// KeyPrefixSmoothWeightChangePools defines prefix to store the ids of the balancer pools that opted
+	// into smooth weight changes at creation.
+	KeyPrefixSmoothWeightChangePools = []byte{0x07}
 )
 
 func MustGetPoolIdFromShareDenom(denom string) uint64 {
@@ -68,3 +75,11 @@ func GetKeyPrefixMigrationInfoBalancerPool(balancerPoolId uint64) []byte {
 func GetKeyPrefixMigrationInfoPoolCLPool(concentratedPoolId uint64) []byte {
 	return append(KeyPrefixMigrationInfoCLPool, sdk.Uint64ToBigEndian(concentratedPoolId)...)
 }
+
+func GetKeyScalingFactorRateSource(poolId uint64) []byte {
+	return append(KeyPrefixScalingFactorRateSource, sdk.Uint64ToBigEndian(poolId)...)
+}
+
+func GetKeySmoothWeightChangePool(poolId uint64) []byte {
+	return append(KeyPrefixSmoothWeightChangePools, sdk.Uint64ToBigEndian(poolId)...)
+}
@@ -15,21 +15,25 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	migration "github.com/osmosis-labs/osmosis/v24/x/gamm/types/migration"
 	types2 "github.com/osmosis-labs/osmosis/v24/x/poolmanager/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -460,6 +464,10 @@ func (m *QueryCalcJoinPoolSharesRequest) GetTokensIn() github_com_cosmos_cosmos_
 type QueryCalcJoinPoolSharesResponse struct {
 	ShareOutAmount cosmossdk_io_math.Int                    `protobuf:"bytes,1,opt,name=share_out_amount,json=shareOutAmount,proto3,customtype=cosmossdk.io/math.Int" json:"share_out_amount" yaml:"share_out_amount"`
 	TokensOut      github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=tokens_out,json=tokensOut,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"tokens_out"`
+	// price_impact is the relative value lost by joining, including fees,
+	// compared to the shares the tokens joined are worth at the spot prices of
+	// the pool.
+	PriceImpact cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=price_impact,json=priceImpact,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"price_impact" yaml:"price_impact"`
 }
 
 func (m *QueryCalcJoinPoolSharesResponse) Reset()         { *m = QueryCalcJoinPoolSharesResponse{} }
@@ -596,23 +604,27 @@ func (m *QueryCalcExitPoolCoinsFromSharesResponse) GetTokensOut() github_com_cos
 	return nil
 }
 
-// =============================== PoolParams
-type QueryPoolParamsRequest struct {
-	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+// =============================== CalcJoinSwapShareAmountOut
+type QueryCalcJoinSwapShareAmountOutRequest struct {
+	PoolId         uint64                `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	TokenInDenom   string                `protobuf:"bytes,2,opt,name=token_in_denom,json=tokenInDenom,proto3" json:"token_in_denom,omitempty" yaml:"token_in_denom"`
+	ShareOutAmount cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=share_out_amount,json=shareOutAmount,proto3,customtype=cosmossdk.io/math.Int" json:"share_out_amount" yaml:"share_out_amount"`
 }
 
-func (m *QueryPoolParamsRequest) Reset()         { *m = QueryPoolParamsRequest{} }
-func (m *QueryPoolParamsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryPoolParamsRequest) ProtoMessage()    {}
-func (*QueryPoolParamsRequest) Descriptor() ([]byte, []int) {
+func (m *QueryCalcJoinSwapShareAmountOutRequest) Reset() {
+	*m = QueryCalcJoinSwapShareAmountOutRequest{}
+}
+func (m *QueryCalcJoinSwapShareAmountOutRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCalcJoinSwapShareAmountOutRequest) ProtoMessage()    {}
+func (*QueryCalcJoinSwapShareAmountOutRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_d9a717df9ca609ef, []int{12}
 }
-func (m *QueryPoolParamsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryCalcJoinSwapShareAmountOutRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryPoolParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryCalcJoinSwapShareAmountOutRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryPoolParamsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryCalcJoinSwapShareAmountOutRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -622,41 +634,54 @@ func (m *QueryPoolParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *QueryPoolParamsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryPoolParamsRequest.Merge(m, src)
+func (m *QueryCalcJoinSwapShareAmountOutRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCalcJoinSwapShareAmountOutRequest.Merge(m, src)
 }
-func (m *QueryPoolParamsRequest) XXX_Size() int {
+func (m *QueryCalcJoinSwapShareAmountOutRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryPoolParamsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryPoolParamsRequest.DiscardUnknown(m)
+func (m *QueryCalcJoinSwapShareAmountOutRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCalcJoinSwapShareAmountOutRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryPoolParamsRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryCalcJoinSwapShareAmountOutRequest proto.InternalMessageInfo
 
-func (m *QueryPoolParamsRequest) GetPoolId() uint64 {
+func (m *QueryCalcJoinSwapShareAmountOutRequest) GetPoolId() uint64 {
 	if m != nil {
 		return m.PoolId
 	}
 	return 0
 }
 
-type QueryPoolParamsResponse struct {
-	Params *types.Any `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+func (m *QueryCalcJoinSwapShareAmountOutRequest) GetTokenInDenom() string {
+	if m != nil {
+		return m.TokenInDenom
+	}
+	return ""
 }
 
-func (m *QueryPoolParamsResponse) Reset()         { *m = QueryPoolParamsResponse{} }
-func (m *QueryPoolParamsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryPoolParamsResponse) ProtoMessage()    {}
-func (*QueryPoolParamsResponse) Descriptor() ([]byte, []int) {
+type QueryCalcJoinSwapShareAmountOutResponse struct {
+	TokenInAmount cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=token_in_amount,json=tokenInAmount,proto3,customtype=cosmossdk.io/math.Int" json:"token_in_amount" yaml:"token_in_amount"`
+	// price_impact is the relative value lost by joining, including fees,
+	// compared to the shares the tokens joined are worth at the spot prices of
+	// the pool.
+	PriceImpact cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=price_impact,json=priceImpact,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"price_impact" yaml:"price_impact"`
+}
+
+func (m *QueryCalcJoinSwapShareAmountOutResponse) Reset() {
+	*m = QueryCalcJoinSwapShareAmountOutResponse{}
+}
+func (m *QueryCalcJoinSwapShareAmountOutResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCalcJoinSwapShareAmountOutResponse) ProtoMessage()    {}
+func (*QueryCalcJoinSwapShareAmountOutResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_d9a717df9ca609ef, []int{13}
 }
-func (m *QueryPoolParamsResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryCalcJoinSwapShareAmountOutResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryPoolParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryCalcJoinSwapShareAmountOutResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryPoolParamsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryCalcJoinSwapShareAmountOutResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -666,45 +691,38 @@ func (m *QueryPoolParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *QueryPoolParamsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryPoolParamsResponse.Merge(m, src)
+func (m *QueryCalcJoinSwapShareAmountOutResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCalcJoinSwapShareAmountOutResponse.Merge(m, src)
 }
-func (m *QueryPoolParamsResponse) XXX_Size() int {
+func (m *QueryCalcJoinSwapShareAmountOutResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryPoolParamsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryPoolParamsResponse.DiscardUnknown(m)
+func (m *QueryCalcJoinSwapShareAmountOutResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCalcJoinSwapShareAmountOutResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryPoolParamsResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryCalcJoinSwapShareAmountOutResponse proto.InternalMessageInfo
 
-func (m *QueryPoolParamsResponse) GetParams() *types.Any {
-	if m != nil {
-		return m.Params
-	}
-	return nil
+// =============================== CalcExitSwapExactAmountOut
+type QueryCalcExitSwapExactAmountOutRequest struct {
+	PoolId   uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	TokenOut string `protobuf:"bytes,2,opt,name=token_out,json=tokenOut,proto3" json:"token_out,omitempty" yaml:"token_out"`
 }
 
-// =============================== PoolLiquidity
-// Deprecated: please use the alternative in x/poolmanager
-//
-// Deprecated: Do not use.
-type QueryTotalPoolLiquidityRequest struct {
-	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+func (m *QueryCalcExitSwapExactAmountOutRequest) Reset() {
+	*m = QueryCalcExitSwapExactAmountOutRequest{}
 }
-
-func (m *QueryTotalPoolLiquidityRequest) Reset()         { *m = QueryTotalPoolLiquidityRequest{} }
-func (m *QueryTotalPoolLiquidityRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryTotalPoolLiquidityRequest) ProtoMessage()    {}
-func (*QueryTotalPoolLiquidityRequest) Descriptor() ([]byte, []int) {
+func (m *QueryCalcExitSwapExactAmountOutRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCalcExitSwapExactAmountOutRequest) ProtoMessage()    {}
+func (*QueryCalcExitSwapExactAmountOutRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_d9a717df9ca609ef, []int{14}
 }
-func (m *QueryTotalPoolLiquidityRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryCalcExitSwapExactAmountOutRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryTotalPoolLiquidityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryCalcExitSwapExactAmountOutRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryTotalPoolLiquidityRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryCalcExitSwapExactAmountOutRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -714,44 +732,54 @@ func (m *QueryTotalPoolLiquidityRequest) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *QueryTotalPoolLiquidityRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryTotalPoolLiquidityRequest.Merge(m, src)
+func (m *QueryCalcExitSwapExactAmountOutRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCalcExitSwapExactAmountOutRequest.Merge(m, src)
 }
-func (m *QueryTotalPoolLiquidityRequest) XXX_Size() int {
+func (m *QueryCalcExitSwapExactAmountOutRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryTotalPoolLiquidityRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryTotalPoolLiquidityRequest.DiscardUnknown(m)
+func (m *QueryCalcExitSwapExactAmountOutRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCalcExitSwapExactAmountOutRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryTotalPoolLiquidityRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryCalcExitSwapExactAmountOutRequest proto.InternalMessageInfo
 
-func (m *QueryTotalPoolLiquidityRequest) GetPoolId() uint64 {
+func (m *QueryCalcExitSwapExactAmountOutRequest) GetPoolId() uint64 {
 	if m != nil {
 		return m.PoolId
 	}
 	return 0
 }
 
-// Deprecated: please use the alternative in x/poolmanager
-//
-// Deprecated: Do not use.
-type QueryTotalPoolLiquidityResponse struct {
-	Liquidity github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=liquidity,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"liquidity" yaml:"liquidity"`
+func (m *QueryCalcExitSwapExactAmountOutRequest) GetTokenOut() string {
+	if m != nil {
+		return m.TokenOut
+	}
+	return ""
 }
 
-func (m *QueryTotalPoolLiquidityResponse) Reset()         { *m = QueryTotalPoolLiquidityResponse{} }
-func (m *QueryTotalPoolLiquidityResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryTotalPoolLiquidityResponse) ProtoMessage()    {}
-func (*QueryTotalPoolLiquidityResponse) Descriptor() ([]byte, []int) {
+type QueryCalcExitSwapExactAmountOutResponse struct {
+	ShareInAmount cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=share_in_amount,json=shareInAmount,proto3,customtype=cosmossdk.io/math.Int" json:"share_in_amount" yaml:"share_in_amount"`
+	// price_impact is the relative value lost by exiting, including fees,
+	// compared to the shares the tokens exited are worth at the spot prices of
+	// the pool.
+	PriceImpact cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=price_impact,json=priceImpact,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"price_impact" yaml:"price_impact"`
+}
+
+func (m *QueryCalcExitSwapExactAmountOutResponse) Reset() {
+	*m = QueryCalcExitSwapExactAmountOutResponse{}
+}
+func (m *QueryCalcExitSwapExactAmountOutResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCalcExitSwapExactAmountOutResponse) ProtoMessage()    {}
+func (*QueryCalcExitSwapExactAmountOutResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_d9a717df9ca609ef, []int{15}
 }
-func (m *QueryTotalPoolLiquidityResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryCalcExitSwapExactAmountOutResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryTotalPoolLiquidityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryCalcExitSwapExactAmountOutResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryTotalPoolLiquidityResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryCalcExitSwapExactAmountOutResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -761,42 +789,35 @@ func (m *QueryTotalPoolLiquidityResponse) XXX_Marshal(b []byte, deterministic bo
 		return b[:n], nil
 	}
 }
-func (m *QueryTotalPoolLiquidityResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryTotalPoolLiquidityResponse.Merge(m, src)
+func (m *QueryCalcExitSwapExactAmountOutResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCalcExitSwapExactAmountOutResponse.Merge(m, src)
 }
-func (m *QueryTotalPoolLiquidityResponse) XXX_Size() int {
+func (m *QueryCalcExitSwapExactAmountOutResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryTotalPoolLiquidityResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryTotalPoolLiquidityResponse.DiscardUnknown(m)
+func (m *QueryCalcExitSwapExactAmountOutResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCalcExitSwapExactAmountOutResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryTotalPoolLiquidityResponse proto.InternalMessageInfo
-
-func (m *QueryTotalPoolLiquidityResponse) GetLiquidity() github_com_cosmos_cosmos_sdk_types.Coins {
-	if m != nil {
-		return m.Liquidity
-	}
-	return nil
-}
+var xxx_messageInfo_QueryCalcExitSwapExactAmountOutResponse proto.InternalMessageInfo
 
-// =============================== TotalShares
-type QueryTotalSharesRequest struct {
+// =============================== PoolParams
+type QueryPoolParamsRequest struct {
 	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
 }
 
-func (m *QueryTotalSharesRequest) Reset()         { *m = QueryTotalSharesRequest{} }
-func (m *QueryTotalSharesRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryTotalSharesRequest) ProtoMessage()    {}
-func (*QueryTotalSharesRequest) Descriptor() ([]byte, []int) {
+func (m *QueryPoolParamsRequest) Reset()         { *m = QueryPoolParamsRequest{} }
+func (m *QueryPoolParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolParamsRequest) ProtoMessage()    {}
+func (*QueryPoolParamsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_d9a717df9ca609ef, []int{16}
 }
-func (m *QueryTotalSharesRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryPoolParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryTotalSharesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryPoolParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryTotalSharesRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryPoolParamsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -806,41 +827,41 @@ func (m *QueryTotalSharesRequest) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *QueryTotalSharesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryTotalSharesRequest.Merge(m, src)
+func (m *QueryPoolParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolParamsRequest.Merge(m, src)
 }
-func (m *QueryTotalSharesRequest) XXX_Size() int {
+func (m *QueryPoolParamsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryTotalSharesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryTotalSharesRequest.DiscardUnknown(m)
+func (m *QueryPoolParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolParamsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryTotalSharesRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryPoolParamsRequest proto.InternalMessageInfo
 
-func (m *QueryTotalSharesRequest) GetPoolId() uint64 {
+func (m *QueryPoolParamsRequest) GetPoolId() uint64 {
 	if m != nil {
 		return m.PoolId
 	}
 	return 0
 }
 
-type QueryTotalSharesResponse struct {
-	TotalShares types1.Coin `protobuf:"bytes,1,opt,name=total_shares,json=totalShares,proto3" json:"total_shares" yaml:"total_shares"`
+type QueryPoolParamsResponse struct {
+	Params *types.Any `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
 }
 
-func (m *QueryTotalSharesResponse) Reset()         { *m = QueryTotalSharesResponse{} }
-func (m *QueryTotalSharesResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryTotalSharesResponse) ProtoMessage()    {}
-func (*QueryTotalSharesResponse) Descriptor() ([]byte, []int) {
+func (m *QueryPoolParamsResponse) Reset()         { *m = QueryPoolParamsResponse{} }
+func (m *QueryPoolParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolParamsResponse) ProtoMessage()    {}
+func (*QueryPoolParamsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_d9a717df9ca609ef, []int{17}
 }
-func (m *QueryTotalSharesResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryPoolParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryTotalSharesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryPoolParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryTotalSharesResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryPoolParamsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -850,43 +871,42 @@ func (m *QueryTotalSharesResponse) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *QueryTotalSharesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryTotalSharesResponse.Merge(m, src)
+func (m *QueryPoolParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolParamsResponse.Merge(m, src)
 }
-func (m *QueryTotalSharesResponse) XXX_Size() int {
+func (m *QueryPoolParamsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryTotalSharesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryTotalSharesResponse.DiscardUnknown(m)
+func (m *QueryPoolParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolParamsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryTotalSharesResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryPoolParamsResponse proto.InternalMessageInfo
 
-func (m *QueryTotalSharesResponse) GetTotalShares() types1.Coin {
+func (m *QueryPoolParamsResponse) GetParams() *types.Any {
 	if m != nil {
-		return m.TotalShares
+		return m.Params
 	}
-	return types1.Coin{}
+	return nil
 }
 
-// =============================== CalcJoinPoolNoSwapShares
-type QueryCalcJoinPoolNoSwapSharesRequest struct {
-	PoolId   uint64                                   `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
-	TokensIn github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=tokens_in,json=tokensIn,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"tokens_in"`
+// =============================== PoolWeights
+type QueryPoolWeightsRequest struct {
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
 }
 
-func (m *QueryCalcJoinPoolNoSwapSharesRequest) Reset()         { *m = QueryCalcJoinPoolNoSwapSharesRequest{} }
-func (m *QueryCalcJoinPoolNoSwapSharesRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryCalcJoinPoolNoSwapSharesRequest) ProtoMessage()    {}
-func (*QueryCalcJoinPoolNoSwapSharesRequest) Descriptor() ([]byte, []int) {
+func (m *QueryPoolWeightsRequest) Reset()         { *m = QueryPoolWeightsRequest{} }
+func (m *QueryPoolWeightsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolWeightsRequest) ProtoMessage()    {}
+func (*QueryPoolWeightsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_d9a717df9ca609ef, []int{18}
 }
-func (m *QueryCalcJoinPoolNoSwapSharesRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryPoolWeightsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryCalcJoinPoolNoSwapSharesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryPoolWeightsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryCalcJoinPoolNoSwapSharesRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryPoolWeightsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -896,49 +916,44 @@ func (m *QueryCalcJoinPoolNoSwapSharesRequest) XXX_Marshal(b []byte, determinist
 		return b[:n], nil
 	}
 }
-func (m *QueryCalcJoinPoolNoSwapSharesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryCalcJoinPoolNoSwapSharesRequest.Merge(m, src)
+func (m *QueryPoolWeightsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolWeightsRequest.Merge(m, src)
 }
-func (m *QueryCalcJoinPoolNoSwapSharesRequest) XXX_Size() int {
+func (m *QueryPoolWeightsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryCalcJoinPoolNoSwapSharesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryCalcJoinPoolNoSwapSharesRequest.DiscardUnknown(m)
+func (m *QueryPoolWeightsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolWeightsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryCalcJoinPoolNoSwapSharesRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryPoolWeightsRequest proto.InternalMessageInfo
 
-func (m *QueryCalcJoinPoolNoSwapSharesRequest) GetPoolId() uint64 {
+func (m *QueryPoolWeightsRequest) GetPoolId() uint64 {
 	if m != nil {
 		return m.PoolId
 	}
 	return 0
 }
 
-func (m *QueryCalcJoinPoolNoSwapSharesRequest) GetTokensIn() github_com_cosmos_cosmos_sdk_types.Coins {
-	if m != nil {
-		return m.TokensIn
-	}
-	return nil
-}
-
-type QueryCalcJoinPoolNoSwapSharesResponse struct {
-	TokensOut github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=tokens_out,json=tokensOut,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"tokens_out" yaml:"tokens_out"`
-	SharesOut cosmossdk_io_math.Int                    `protobuf:"bytes,2,opt,name=shares_out,json=sharesOut,proto3,customtype=cosmossdk.io/math.Int" json:"shares_out"`
+// PoolWeight is the weight of a denom in a balancer pool, scaled by the
+// internal weight precision of the pool as in its pool assets.
+type PoolWeight struct {
+	Denom  string                `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	Weight cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=weight,proto3,customtype=cosmossdk.io/math.Int" json:"weight" yaml:"weight"`
 }
 
-func (m *QueryCalcJoinPoolNoSwapSharesResponse) Reset()         { *m = QueryCalcJoinPoolNoSwapSharesResponse{} }
-func (m *QueryCalcJoinPoolNoSwapSharesResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryCalcJoinPoolNoSwapSharesResponse) ProtoMessage()    {}
-func (*QueryCalcJoinPoolNoSwapSharesResponse) Descriptor() ([]byte, []int) {
+func (m *PoolWeight) Reset()         { *m = PoolWeight{} }
+func (m *PoolWeight) String() string { return proto.CompactTextString(m) }
+func (*PoolWeight) ProtoMessage()    {}
+func (*PoolWeight) Descriptor() ([]byte, []int) {
 	return fileDescriptor_d9a717df9ca609ef, []int{19}
 }
-func (m *QueryCalcJoinPoolNoSwapSharesResponse) XXX_Unmarshal(b []byte) error {
+func (m *PoolWeight) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryCalcJoinPoolNoSwapSharesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PoolWeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryCalcJoinPoolNoSwapSharesResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PoolWeight.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -948,47 +963,49 @@ func (m *QueryCalcJoinPoolNoSwapSharesResponse) XXX_Marshal(b []byte, determinis
 		return b[:n], nil
 	}
 }
-func (m *QueryCalcJoinPoolNoSwapSharesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryCalcJoinPoolNoSwapSharesResponse.Merge(m, src)
+func (m *PoolWeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolWeight.Merge(m, src)
 }
-func (m *QueryCalcJoinPoolNoSwapSharesResponse) XXX_Size() int {
+func (m *PoolWeight) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryCalcJoinPoolNoSwapSharesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryCalcJoinPoolNoSwapSharesResponse.DiscardUnknown(m)
+func (m *PoolWeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolWeight.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryCalcJoinPoolNoSwapSharesResponse proto.InternalMessageInfo
+var xxx_messageInfo_PoolWeight proto.InternalMessageInfo
 
-func (m *QueryCalcJoinPoolNoSwapSharesResponse) GetTokensOut() github_com_cosmos_cosmos_sdk_types.Coins {
+func (m *PoolWeight) GetDenom() string {
 	if m != nil {
-		return m.TokensOut
+		return m.Denom
 	}
-	return nil
+	return ""
 }
 
-// QuerySpotPriceRequest defines the gRPC request structure for a SpotPrice
-// query.
-//
-// Deprecated: Do not use.
-type QuerySpotPriceRequest struct {
-	PoolId          uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
-	BaseAssetDenom  string `protobuf:"bytes,2,opt,name=base_asset_denom,json=baseAssetDenom,proto3" json:"base_asset_denom,omitempty" yaml:"base_asset_denom"`
-	QuoteAssetDenom string `protobuf:"bytes,3,opt,name=quote_asset_denom,json=quoteAssetDenom,proto3" json:"quote_asset_denom,omitempty" yaml:"quote_asset_denom"`
+type QueryPoolWeightsResponse struct {
+	// current_weights are the weights of the pool at the block time.
+	CurrentWeights []PoolWeight `protobuf:"bytes,1,rep,name=current_weights,json=currentWeights,proto3" json:"current_weights" yaml:"current_weights"`
+	// target_weights are the weights the pool is changing to. They equal the
+	// current weights if no weight change is scheduled or in progress.
+	TargetWeights []PoolWeight `protobuf:"bytes,2,rep,name=target_weights,json=targetWeights,proto3" json:"target_weights" yaml:"target_weights"`
+	// start_time and end_time bound the weight change. They are unset if no
+	// weight change is scheduled or in progress.
+	StartTime *time.Time `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time,omitempty" yaml:"start_time"`
+	EndTime   *time.Time `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time,omitempty" yaml:"end_time"`
 }
 
-func (m *QuerySpotPriceRequest) Reset()         { *m = QuerySpotPriceRequest{} }
-func (m *QuerySpotPriceRequest) String() string { return proto.CompactTextString(m) }
-func (*QuerySpotPriceRequest) ProtoMessage()    {}
-func (*QuerySpotPriceRequest) Descriptor() ([]byte, []int) {
+func (m *QueryPoolWeightsResponse) Reset()         { *m = QueryPoolWeightsResponse{} }
+func (m *QueryPoolWeightsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolWeightsResponse) ProtoMessage()    {}
+func (*QueryPoolWeightsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_d9a717df9ca609ef, []int{20}
 }
-func (m *QuerySpotPriceRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryPoolWeightsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QuerySpotPriceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryPoolWeightsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QuerySpotPriceRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryPoolWeightsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -998,59 +1015,63 @@ func (m *QuerySpotPriceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *QuerySpotPriceRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QuerySpotPriceRequest.Merge(m, src)
+func (m *QueryPoolWeightsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolWeightsResponse.Merge(m, src)
 }
-func (m *QuerySpotPriceRequest) XXX_Size() int {
+func (m *QueryPoolWeightsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QuerySpotPriceRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QuerySpotPriceRequest.DiscardUnknown(m)
+func (m *QueryPoolWeightsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolWeightsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QuerySpotPriceRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryPoolWeightsResponse proto.InternalMessageInfo
 
-func (m *QuerySpotPriceRequest) GetPoolId() uint64 {
+func (m *QueryPoolWeightsResponse) GetCurrentWeights() []PoolWeight {
 	if m != nil {
-		return m.PoolId
+		return m.CurrentWeights
 	}
-	return 0
+	return nil
 }
 
-func (m *QuerySpotPriceRequest) GetBaseAssetDenom() string {
+func (m *QueryPoolWeightsResponse) GetTargetWeights() []PoolWeight {
 	if m != nil {
-		return m.BaseAssetDenom
+		return m.TargetWeights
 	}
-	return ""
+	return nil
 }
 
-func (m *QuerySpotPriceRequest) GetQuoteAssetDenom() string {
+func (m *QueryPoolWeightsResponse) GetStartTime() *time.Time {
 	if m != nil {
-		return m.QuoteAssetDenom
+		return m.StartTime
 	}
-	return ""
+	return nil
 }
 
-type QueryPoolsWithFilterRequest struct {
-	// String of the coins in single string separated by comma. Ex)
-	// 10uatom,100uosmo
-	MinLiquidity string             `protobuf:"bytes,1,opt,name=min_liquidity,json=minLiquidity,proto3" json:"min_liquidity,omitempty" yaml:"min_liquidity"`
-	PoolType     string             `protobuf:"bytes,2,opt,name=pool_type,json=poolType,proto3" json:"pool_type,omitempty"`
-	Pagination   *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+func (m *QueryPoolWeightsResponse) GetEndTime() *time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return nil
 }
 
-func (m *QueryPoolsWithFilterRequest) Reset()         { *m = QueryPoolsWithFilterRequest{} }
-func (m *QueryPoolsWithFilterRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryPoolsWithFilterRequest) ProtoMessage()    {}
-func (*QueryPoolsWithFilterRequest) Descriptor() ([]byte, []int) {
+// =============================== ScalingFactorRateSource
+type QueryScalingFactorRateSourceRequest struct {
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+}
+
+func (m *QueryScalingFactorRateSourceRequest) Reset()         { *m = QueryScalingFactorRateSourceRequest{} }
+func (m *QueryScalingFactorRateSourceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryScalingFactorRateSourceRequest) ProtoMessage()    {}
+func (*QueryScalingFactorRateSourceRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_d9a717df9ca609ef, []int{21}
 }
-func (m *QueryPoolsWithFilterRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryScalingFactorRateSourceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryPoolsWithFilterRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryScalingFactorRateSourceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryPoolsWithFilterRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryScalingFactorRateSourceRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1060,57 +1081,44 @@ func (m *QueryPoolsWithFilterRequest) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryPoolsWithFilterRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryPoolsWithFilterRequest.Merge(m, src)
+func (m *QueryScalingFactorRateSourceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScalingFactorRateSourceRequest.Merge(m, src)
 }
-func (m *QueryPoolsWithFilterRequest) XXX_Size() int {
+func (m *QueryScalingFactorRateSourceRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryPoolsWithFilterRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryPoolsWithFilterRequest.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_QueryPoolsWithFilterRequest proto.InternalMessageInfo
-
-func (m *QueryPoolsWithFilterRequest) GetMinLiquidity() string {
-	if m != nil {
-		return m.MinLiquidity
-	}
-	return ""
+func (m *QueryScalingFactorRateSourceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScalingFactorRateSourceRequest.DiscardUnknown(m)
 }
 
-func (m *QueryPoolsWithFilterRequest) GetPoolType() string {
-	if m != nil {
-		return m.PoolType
-	}
-	return ""
-}
+var xxx_messageInfo_QueryScalingFactorRateSourceRequest proto.InternalMessageInfo
 
-func (m *QueryPoolsWithFilterRequest) GetPagination() *query.PageRequest {
+func (m *QueryScalingFactorRateSourceRequest) GetPoolId() uint64 {
 	if m != nil {
-		return m.Pagination
+		return m.PoolId
 	}
-	return nil
+	return 0
 }
 
-type QueryPoolsWithFilterResponse struct {
-	Pools []*types.Any `protobuf:"bytes,1,rep,name=pools,proto3" json:"pools,omitempty"`
-	// pagination defines the pagination in the response.
-	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+type QueryScalingFactorRateSourceResponse struct {
+	// rate_source is unset if the scaling factors of the pool are not driven by
+	// a rate source.
+	RateSource     *ScalingFactorRateSource `protobuf:"bytes,1,opt,name=rate_source,json=rateSource,proto3" json:"rate_source,omitempty" yaml:"rate_source"`
+	ScalingFactors []uint64                 `protobuf:"varint,2,rep,packed,name=scaling_factors,json=scalingFactors,proto3" json:"scaling_factors,omitempty" yaml:"scaling_factors"`
 }
 
-func (m *QueryPoolsWithFilterResponse) Reset()         { *m = QueryPoolsWithFilterResponse{} }
-func (m *QueryPoolsWithFilterResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryPoolsWithFilterResponse) ProtoMessage()    {}
-func (*QueryPoolsWithFilterResponse) Descriptor() ([]byte, []int) {
+func (m *QueryScalingFactorRateSourceResponse) Reset()         { *m = QueryScalingFactorRateSourceResponse{} }
+func (m *QueryScalingFactorRateSourceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryScalingFactorRateSourceResponse) ProtoMessage()    {}
+func (*QueryScalingFactorRateSourceResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_d9a717df9ca609ef, []int{22}
 }
-func (m *QueryPoolsWithFilterResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryScalingFactorRateSourceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryPoolsWithFilterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryScalingFactorRateSourceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryPoolsWithFilterResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryScalingFactorRateSourceResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1120,53 +1128,52 @@ func (m *QueryPoolsWithFilterResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryPoolsWithFilterResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryPoolsWithFilterResponse.Merge(m, src)
+func (m *QueryScalingFactorRateSourceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScalingFactorRateSourceResponse.Merge(m, src)
 }
-func (m *QueryPoolsWithFilterResponse) XXX_Size() int {
+func (m *QueryScalingFactorRateSourceResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryPoolsWithFilterResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryPoolsWithFilterResponse.DiscardUnknown(m)
+func (m *QueryScalingFactorRateSourceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScalingFactorRateSourceResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryPoolsWithFilterResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryScalingFactorRateSourceResponse proto.InternalMessageInfo
 
-func (m *QueryPoolsWithFilterResponse) GetPools() []*types.Any {
+func (m *QueryScalingFactorRateSourceResponse) GetRateSource() *ScalingFactorRateSource {
 	if m != nil {
-		return m.Pools
+		return m.RateSource
 	}
 	return nil
 }
 
-func (m *QueryPoolsWithFilterResponse) GetPagination() *query.PageResponse {
+func (m *QueryScalingFactorRateSourceResponse) GetScalingFactors() []uint64 {
 	if m != nil {
-		return m.Pagination
+		return m.ScalingFactors
 	}
 	return nil
 }
 
-// QuerySpotPriceResponse defines the gRPC response structure for a SpotPrice
-// query.
+// =============================== PoolLiquidity
+// Deprecated: please use the alternative in x/poolmanager
 //
 // Deprecated: Do not use.
-type QuerySpotPriceResponse struct {
-	// String of the Dec. Ex) 10.203uatom
-	SpotPrice string `protobuf:"bytes,1,opt,name=spot_price,json=spotPrice,proto3" json:"spot_price,omitempty" yaml:"spot_price"`
+type QueryTotalPoolLiquidityRequest struct {
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
 }
 
-func (m *QuerySpotPriceResponse) Reset()         { *m = QuerySpotPriceResponse{} }
-func (m *QuerySpotPriceResponse) String() string { return proto.CompactTextString(m) }
-func (*QuerySpotPriceResponse) ProtoMessage()    {}
-func (*QuerySpotPriceResponse) Descriptor() ([]byte, []int) {
+func (m *QueryTotalPoolLiquidityRequest) Reset()         { *m = QueryTotalPoolLiquidityRequest{} }
+func (m *QueryTotalPoolLiquidityRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTotalPoolLiquidityRequest) ProtoMessage()    {}
+func (*QueryTotalPoolLiquidityRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_d9a717df9ca609ef, []int{23}
 }
-func (m *QuerySpotPriceResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryTotalPoolLiquidityRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QuerySpotPriceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryTotalPoolLiquidityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QuerySpotPriceResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryTotalPoolLiquidityRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1176,47 +1183,44 @@ func (m *QuerySpotPriceResponse) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *QuerySpotPriceResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QuerySpotPriceResponse.Merge(m, src)
+func (m *QueryTotalPoolLiquidityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTotalPoolLiquidityRequest.Merge(m, src)
 }
-func (m *QuerySpotPriceResponse) XXX_Size() int {
+func (m *QueryTotalPoolLiquidityRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QuerySpotPriceResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QuerySpotPriceResponse.DiscardUnknown(m)
+func (m *QueryTotalPoolLiquidityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTotalPoolLiquidityRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QuerySpotPriceResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryTotalPoolLiquidityRequest proto.InternalMessageInfo
 
-func (m *QuerySpotPriceResponse) GetSpotPrice() string {
+func (m *QueryTotalPoolLiquidityRequest) GetPoolId() uint64 {
 	if m != nil {
-		return m.SpotPrice
+		return m.PoolId
 	}
-	return ""
+	return 0
 }
 
-// =============================== EstimateSwapExactAmountIn
+// Deprecated: please use the alternative in x/poolmanager
 //
 // Deprecated: Do not use.
-type QuerySwapExactAmountInRequest struct {
-	Sender  string                     `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
-	PoolId  uint64                     `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
-	TokenIn string                     `protobuf:"bytes,3,opt,name=token_in,json=tokenIn,proto3" json:"token_in,omitempty" yaml:"token_in"`
-	Routes  []types2.SwapAmountInRoute `protobuf:"bytes,4,rep,name=routes,proto3" json:"routes" yaml:"routes"`
+type QueryTotalPoolLiquidityResponse struct {
+	Liquidity github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=liquidity,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"liquidity" yaml:"liquidity"`
 }
 
-func (m *QuerySwapExactAmountInRequest) Reset()         { *m = QuerySwapExactAmountInRequest{} }
-func (m *QuerySwapExactAmountInRequest) String() string { return proto.CompactTextString(m) }
-func (*QuerySwapExactAmountInRequest) ProtoMessage()    {}
-func (*QuerySwapExactAmountInRequest) Descriptor() ([]byte, []int) {
+func (m *QueryTotalPoolLiquidityResponse) Reset()         { *m = QueryTotalPoolLiquidityResponse{} }
+func (m *QueryTotalPoolLiquidityResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTotalPoolLiquidityResponse) ProtoMessage()    {}
+func (*QueryTotalPoolLiquidityResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_d9a717df9ca609ef, []int{24}
 }
-func (m *QuerySwapExactAmountInRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryTotalPoolLiquidityResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QuerySwapExactAmountInRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryTotalPoolLiquidityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QuerySwapExactAmountInRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryTotalPoolLiquidityResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1226,63 +1230,42 @@ func (m *QuerySwapExactAmountInRequest) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *QuerySwapExactAmountInRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QuerySwapExactAmountInRequest.Merge(m, src)
+func (m *QueryTotalPoolLiquidityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTotalPoolLiquidityResponse.Merge(m, src)
 }
-func (m *QuerySwapExactAmountInRequest) XXX_Size() int {
+func (m *QueryTotalPoolLiquidityResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QuerySwapExactAmountInRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QuerySwapExactAmountInRequest.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_QuerySwapExactAmountInRequest proto.InternalMessageInfo
-
-func (m *QuerySwapExactAmountInRequest) GetSender() string {
-	if m != nil {
-		return m.Sender
-	}
-	return ""
-}
-
-func (m *QuerySwapExactAmountInRequest) GetPoolId() uint64 {
-	if m != nil {
-		return m.PoolId
-	}
-	return 0
+func (m *QueryTotalPoolLiquidityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTotalPoolLiquidityResponse.DiscardUnknown(m)
 }
 
-func (m *QuerySwapExactAmountInRequest) GetTokenIn() string {
-	if m != nil {
-		return m.TokenIn
-	}
-	return ""
-}
+var xxx_messageInfo_QueryTotalPoolLiquidityResponse proto.InternalMessageInfo
 
-func (m *QuerySwapExactAmountInRequest) GetRoutes() []types2.SwapAmountInRoute {
+func (m *QueryTotalPoolLiquidityResponse) GetLiquidity() github_com_cosmos_cosmos_sdk_types.Coins {
 	if m != nil {
-		return m.Routes
+		return m.Liquidity
 	}
 	return nil
 }
 
-// Deprecated: Do not use.
-type QuerySwapExactAmountInResponse struct {
-	TokenOutAmount cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=token_out_amount,json=tokenOutAmount,proto3,customtype=cosmossdk.io/math.Int" json:"token_out_amount" yaml:"token_out_amount"`
+// =============================== TotalShares
+type QueryTotalSharesRequest struct {
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
 }
 
-func (m *QuerySwapExactAmountInResponse) Reset()         { *m = QuerySwapExactAmountInResponse{} }
-func (m *QuerySwapExactAmountInResponse) String() string { return proto.CompactTextString(m) }
-func (*QuerySwapExactAmountInResponse) ProtoMessage()    {}
-func (*QuerySwapExactAmountInResponse) Descriptor() ([]byte, []int) {
+func (m *QueryTotalSharesRequest) Reset()         { *m = QueryTotalSharesRequest{} }
+func (m *QueryTotalSharesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTotalSharesRequest) ProtoMessage()    {}
+func (*QueryTotalSharesRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_d9a717df9ca609ef, []int{25}
 }
-func (m *QuerySwapExactAmountInResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryTotalSharesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QuerySwapExactAmountInResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryTotalSharesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QuerySwapExactAmountInResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryTotalSharesRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1292,40 +1275,41 @@ func (m *QuerySwapExactAmountInResponse) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *QuerySwapExactAmountInResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QuerySwapExactAmountInResponse.Merge(m, src)
+func (m *QueryTotalSharesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTotalSharesRequest.Merge(m, src)
 }
-func (m *QuerySwapExactAmountInResponse) XXX_Size() int {
+func (m *QueryTotalSharesRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QuerySwapExactAmountInResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QuerySwapExactAmountInResponse.DiscardUnknown(m)
+func (m *QueryTotalSharesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTotalSharesRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QuerySwapExactAmountInResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryTotalSharesRequest proto.InternalMessageInfo
 
-// =============================== EstimateSwapExactAmountOut
-//
-// Deprecated: Do not use.
-type QuerySwapExactAmountOutRequest struct {
-	Sender   string                      `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
-	PoolId   uint64                      `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
-	Routes   []types2.SwapAmountOutRoute `protobuf:"bytes,3,rep,name=routes,proto3" json:"routes" yaml:"routes"`
-	TokenOut string                      `protobuf:"bytes,4,opt,name=token_out,json=tokenOut,proto3" json:"token_out,omitempty" yaml:"token_out"`
+func (m *QueryTotalSharesRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
 }
 
-func (m *QuerySwapExactAmountOutRequest) Reset()         { *m = QuerySwapExactAmountOutRequest{} }
-func (m *QuerySwapExactAmountOutRequest) String() string { return proto.CompactTextString(m) }
-func (*QuerySwapExactAmountOutRequest) ProtoMessage()    {}
-func (*QuerySwapExactAmountOutRequest) Descriptor() ([]byte, []int) {
+type QueryTotalSharesResponse struct {
+	TotalShares types1.Coin `protobuf:"bytes,1,opt,name=total_shares,json=totalShares,proto3" json:"total_shares" yaml:"total_shares"`
+}
+
+func (m *QueryTotalSharesResponse) Reset()         { *m = QueryTotalSharesResponse{} }
+func (m *QueryTotalSharesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTotalSharesResponse) ProtoMessage()    {}
+func (*QueryTotalSharesResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_d9a717df9ca609ef, []int{26}
 }
-func (m *QuerySwapExactAmountOutRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryTotalSharesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QuerySwapExactAmountOutRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryTotalSharesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QuerySwapExactAmountOutRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryTotalSharesResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1335,63 +1319,43 @@ func (m *QuerySwapExactAmountOutRequest) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *QuerySwapExactAmountOutRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QuerySwapExactAmountOutRequest.Merge(m, src)
+func (m *QueryTotalSharesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTotalSharesResponse.Merge(m, src)
 }
-func (m *QuerySwapExactAmountOutRequest) XXX_Size() int {
+func (m *QueryTotalSharesResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QuerySwapExactAmountOutRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QuerySwapExactAmountOutRequest.DiscardUnknown(m)
+func (m *QueryTotalSharesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTotalSharesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QuerySwapExactAmountOutRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryTotalSharesResponse proto.InternalMessageInfo
 
-func (m *QuerySwapExactAmountOutRequest) GetSender() string {
+func (m *QueryTotalSharesResponse) GetTotalShares() types1.Coin {
 	if m != nil {
-		return m.Sender
+		return m.TotalShares
 	}
-	return ""
+	return types1.Coin{}
 }
 
-func (m *QuerySwapExactAmountOutRequest) GetPoolId() uint64 {
-	if m != nil {
-		return m.PoolId
-	}
-	return 0
+// =============================== CalcJoinPoolNoSwapShares
+type QueryCalcJoinPoolNoSwapSharesRequest struct {
+	PoolId   uint64                                   `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	TokensIn github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=tokens_in,json=tokensIn,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"tokens_in"`
 }
 
-func (m *QuerySwapExactAmountOutRequest) GetRoutes() []types2.SwapAmountOutRoute {
-	if m != nil {
-		return m.Routes
-	}
-	return nil
+func (m *QueryCalcJoinPoolNoSwapSharesRequest) Reset()         { *m = QueryCalcJoinPoolNoSwapSharesRequest{} }
+func (m *QueryCalcJoinPoolNoSwapSharesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCalcJoinPoolNoSwapSharesRequest) ProtoMessage()    {}
+func (*QueryCalcJoinPoolNoSwapSharesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a717df9ca609ef, []int{27}
 }
-
-func (m *QuerySwapExactAmountOutRequest) GetTokenOut() string {
-	if m != nil {
-		return m.TokenOut
-	}
-	return ""
-}
-
-// Deprecated: Do not use.
-type QuerySwapExactAmountOutResponse struct {
-	TokenInAmount cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=token_in_amount,json=tokenInAmount,proto3,customtype=cosmossdk.io/math.Int" json:"token_in_amount" yaml:"token_in_amount"`
-}
-
-func (m *QuerySwapExactAmountOutResponse) Reset()         { *m = QuerySwapExactAmountOutResponse{} }
-func (m *QuerySwapExactAmountOutResponse) String() string { return proto.CompactTextString(m) }
-func (*QuerySwapExactAmountOutResponse) ProtoMessage()    {}
-func (*QuerySwapExactAmountOutResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d9a717df9ca609ef, []int{27}
-}
-func (m *QuerySwapExactAmountOutResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryCalcJoinPoolNoSwapSharesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QuerySwapExactAmountOutResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryCalcJoinPoolNoSwapSharesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QuerySwapExactAmountOutResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryCalcJoinPoolNoSwapSharesRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1401,33 +1365,49 @@ func (m *QuerySwapExactAmountOutResponse) XXX_Marshal(b []byte, deterministic bo
 		return b[:n], nil
 	}
 }
-func (m *QuerySwapExactAmountOutResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QuerySwapExactAmountOutResponse.Merge(m, src)
+func (m *QueryCalcJoinPoolNoSwapSharesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCalcJoinPoolNoSwapSharesRequest.Merge(m, src)
 }
-func (m *QuerySwapExactAmountOutResponse) XXX_Size() int {
+func (m *QueryCalcJoinPoolNoSwapSharesRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QuerySwapExactAmountOutResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QuerySwapExactAmountOutResponse.DiscardUnknown(m)
+func (m *QueryCalcJoinPoolNoSwapSharesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCalcJoinPoolNoSwapSharesRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QuerySwapExactAmountOutResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryCalcJoinPoolNoSwapSharesRequest proto.InternalMessageInfo
 
-type QueryTotalLiquidityRequest struct {
+func (m *QueryCalcJoinPoolNoSwapSharesRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
 }
 
-func (m *QueryTotalLiquidityRequest) Reset()         { *m = QueryTotalLiquidityRequest{} }
-func (m *QueryTotalLiquidityRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryTotalLiquidityRequest) ProtoMessage()    {}
-func (*QueryTotalLiquidityRequest) Descriptor() ([]byte, []int) {
+func (m *QueryCalcJoinPoolNoSwapSharesRequest) GetTokensIn() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TokensIn
+	}
+	return nil
+}
+
+type QueryCalcJoinPoolNoSwapSharesResponse struct {
+	TokensOut github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=tokens_out,json=tokensOut,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"tokens_out" yaml:"tokens_out"`
+	SharesOut cosmossdk_io_math.Int                    `protobuf:"bytes,2,opt,name=shares_out,json=sharesOut,proto3,customtype=cosmossdk.io/math.Int" json:"shares_out"`
+}
+
+func (m *QueryCalcJoinPoolNoSwapSharesResponse) Reset()         { *m = QueryCalcJoinPoolNoSwapSharesResponse{} }
+func (m *QueryCalcJoinPoolNoSwapSharesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCalcJoinPoolNoSwapSharesResponse) ProtoMessage()    {}
+func (*QueryCalcJoinPoolNoSwapSharesResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_d9a717df9ca609ef, []int{28}
 }
-func (m *QueryTotalLiquidityRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryCalcJoinPoolNoSwapSharesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryTotalLiquidityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryCalcJoinPoolNoSwapSharesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryTotalLiquidityRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryCalcJoinPoolNoSwapSharesResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1437,34 +1417,47 @@ func (m *QueryTotalLiquidityRequest) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *QueryTotalLiquidityRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryTotalLiquidityRequest.Merge(m, src)
+func (m *QueryCalcJoinPoolNoSwapSharesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCalcJoinPoolNoSwapSharesResponse.Merge(m, src)
 }
-func (m *QueryTotalLiquidityRequest) XXX_Size() int {
+func (m *QueryCalcJoinPoolNoSwapSharesResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryTotalLiquidityRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryTotalLiquidityRequest.DiscardUnknown(m)
+func (m *QueryCalcJoinPoolNoSwapSharesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCalcJoinPoolNoSwapSharesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryTotalLiquidityRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryCalcJoinPoolNoSwapSharesResponse proto.InternalMessageInfo
 
-type QueryTotalLiquidityResponse struct {
-	Liquidity github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=liquidity,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"liquidity" yaml:"liquidity"`
+func (m *QueryCalcJoinPoolNoSwapSharesResponse) GetTokensOut() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TokensOut
+	}
+	return nil
 }
 
-func (m *QueryTotalLiquidityResponse) Reset()         { *m = QueryTotalLiquidityResponse{} }
-func (m *QueryTotalLiquidityResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryTotalLiquidityResponse) ProtoMessage()    {}
-func (*QueryTotalLiquidityResponse) Descriptor() ([]byte, []int) {
+// QuerySpotPriceRequest defines the gRPC request structure for a SpotPrice
+// query.
+//
+// Deprecated: Do not use.
+type QuerySpotPriceRequest struct {
+	PoolId          uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	BaseAssetDenom  string `protobuf:"bytes,2,opt,name=base_asset_denom,json=baseAssetDenom,proto3" json:"base_asset_denom,omitempty" yaml:"base_asset_denom"`
+	QuoteAssetDenom string `protobuf:"bytes,3,opt,name=quote_asset_denom,json=quoteAssetDenom,proto3" json:"quote_asset_denom,omitempty" yaml:"quote_asset_denom"`
+}
+
+func (m *QuerySpotPriceRequest) Reset()         { *m = QuerySpotPriceRequest{} }
+func (m *QuerySpotPriceRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySpotPriceRequest) ProtoMessage()    {}
+func (*QuerySpotPriceRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_d9a717df9ca609ef, []int{29}
 }
-func (m *QueryTotalLiquidityResponse) XXX_Unmarshal(b []byte) error {
+func (m *QuerySpotPriceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryTotalLiquidityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QuerySpotPriceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryTotalLiquidityResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QuerySpotPriceRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1474,46 +1467,59 @@ func (m *QueryTotalLiquidityResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryTotalLiquidityResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryTotalLiquidityResponse.Merge(m, src)
+func (m *QuerySpotPriceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySpotPriceRequest.Merge(m, src)
 }
-func (m *QueryTotalLiquidityResponse) XXX_Size() int {
+func (m *QuerySpotPriceRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryTotalLiquidityResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryTotalLiquidityResponse.DiscardUnknown(m)
+func (m *QuerySpotPriceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySpotPriceRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryTotalLiquidityResponse proto.InternalMessageInfo
+var xxx_messageInfo_QuerySpotPriceRequest proto.InternalMessageInfo
 
-func (m *QueryTotalLiquidityResponse) GetLiquidity() github_com_cosmos_cosmos_sdk_types.Coins {
+func (m *QuerySpotPriceRequest) GetPoolId() uint64 {
 	if m != nil {
-		return m.Liquidity
+		return m.PoolId
 	}
-	return nil
+	return 0
 }
 
-// =============================== QueryConcentratedPoolIdLinkFromCFMM
-type QueryConcentratedPoolIdLinkFromCFMMRequest struct {
-	CfmmPoolId uint64 `protobuf:"varint,1,opt,name=cfmm_pool_id,json=cfmmPoolId,proto3" json:"cfmm_pool_id,omitempty" yaml:"cfmm_pool_id"`
+func (m *QuerySpotPriceRequest) GetBaseAssetDenom() string {
+	if m != nil {
+		return m.BaseAssetDenom
+	}
+	return ""
 }
 
-func (m *QueryConcentratedPoolIdLinkFromCFMMRequest) Reset() {
-	*m = QueryConcentratedPoolIdLinkFromCFMMRequest{}
+func (m *QuerySpotPriceRequest) GetQuoteAssetDenom() string {
+	if m != nil {
+		return m.QuoteAssetDenom
+	}
+	return ""
 }
-func (m *QueryConcentratedPoolIdLinkFromCFMMRequest) String() string {
-	return proto.CompactTextString(m)
+
+type QueryPoolsWithFilterRequest struct {
+	// String of the coins in single string separated by comma. Ex)
+	// 10uatom,100uosmo
+	MinLiquidity string             `protobuf:"bytes,1,opt,name=min_liquidity,json=minLiquidity,proto3" json:"min_liquidity,omitempty" yaml:"min_liquidity"`
+	PoolType     string             `protobuf:"bytes,2,opt,name=pool_type,json=poolType,proto3" json:"pool_type,omitempty"`
+	Pagination   *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
-func (*QueryConcentratedPoolIdLinkFromCFMMRequest) ProtoMessage() {}
-func (*QueryConcentratedPoolIdLinkFromCFMMRequest) Descriptor() ([]byte, []int) {
+
+func (m *QueryPoolsWithFilterRequest) Reset()         { *m = QueryPoolsWithFilterRequest{} }
+func (m *QueryPoolsWithFilterRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolsWithFilterRequest) ProtoMessage()    {}
+func (*QueryPoolsWithFilterRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_d9a717df9ca609ef, []int{30}
 }
-func (m *QueryConcentratedPoolIdLinkFromCFMMRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryPoolsWithFilterRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryConcentratedPoolIdLinkFromCFMMRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryPoolsWithFilterRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryConcentratedPoolIdLinkFromCFMMRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryPoolsWithFilterRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1523,45 +1529,57 @@ func (m *QueryConcentratedPoolIdLinkFromCFMMRequest) XXX_Marshal(b []byte, deter
 		return b[:n], nil
 	}
 }
-func (m *QueryConcentratedPoolIdLinkFromCFMMRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryConcentratedPoolIdLinkFromCFMMRequest.Merge(m, src)
+func (m *QueryPoolsWithFilterRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolsWithFilterRequest.Merge(m, src)
 }
-func (m *QueryConcentratedPoolIdLinkFromCFMMRequest) XXX_Size() int {
+func (m *QueryPoolsWithFilterRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryConcentratedPoolIdLinkFromCFMMRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryConcentratedPoolIdLinkFromCFMMRequest.DiscardUnknown(m)
+func (m *QueryPoolsWithFilterRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolsWithFilterRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryConcentratedPoolIdLinkFromCFMMRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryPoolsWithFilterRequest proto.InternalMessageInfo
 
-func (m *QueryConcentratedPoolIdLinkFromCFMMRequest) GetCfmmPoolId() uint64 {
+func (m *QueryPoolsWithFilterRequest) GetMinLiquidity() string {
 	if m != nil {
-		return m.CfmmPoolId
+		return m.MinLiquidity
 	}
-	return 0
+	return ""
 }
 
-type QueryConcentratedPoolIdLinkFromCFMMResponse struct {
-	ConcentratedPoolId uint64 `protobuf:"varint,1,opt,name=concentrated_pool_id,json=concentratedPoolId,proto3" json:"concentrated_pool_id,omitempty"`
+func (m *QueryPoolsWithFilterRequest) GetPoolType() string {
+	if m != nil {
+		return m.PoolType
+	}
+	return ""
 }
 
-func (m *QueryConcentratedPoolIdLinkFromCFMMResponse) Reset() {
-	*m = QueryConcentratedPoolIdLinkFromCFMMResponse{}
+func (m *QueryPoolsWithFilterRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
-func (m *QueryConcentratedPoolIdLinkFromCFMMResponse) String() string {
-	return proto.CompactTextString(m)
+
+type QueryPoolsWithFilterResponse struct {
+	Pools []*types.Any `protobuf:"bytes,1,rep,name=pools,proto3" json:"pools,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
-func (*QueryConcentratedPoolIdLinkFromCFMMResponse) ProtoMessage() {}
-func (*QueryConcentratedPoolIdLinkFromCFMMResponse) Descriptor() ([]byte, []int) {
+
+func (m *QueryPoolsWithFilterResponse) Reset()         { *m = QueryPoolsWithFilterResponse{} }
+func (m *QueryPoolsWithFilterResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolsWithFilterResponse) ProtoMessage()    {}
+func (*QueryPoolsWithFilterResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_d9a717df9ca609ef, []int{31}
 }
-func (m *QueryConcentratedPoolIdLinkFromCFMMResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryPoolsWithFilterResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryConcentratedPoolIdLinkFromCFMMResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryPoolsWithFilterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryConcentratedPoolIdLinkFromCFMMResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryPoolsWithFilterResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1571,41 +1589,53 @@ func (m *QueryConcentratedPoolIdLinkFromCFMMResponse) XXX_Marshal(b []byte, dete
 		return b[:n], nil
 	}
 }
-func (m *QueryConcentratedPoolIdLinkFromCFMMResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryConcentratedPoolIdLinkFromCFMMResponse.Merge(m, src)
+func (m *QueryPoolsWithFilterResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolsWithFilterResponse.Merge(m, src)
 }
-func (m *QueryConcentratedPoolIdLinkFromCFMMResponse) XXX_Size() int {
+func (m *QueryPoolsWithFilterResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryConcentratedPoolIdLinkFromCFMMResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryConcentratedPoolIdLinkFromCFMMResponse.DiscardUnknown(m)
+func (m *QueryPoolsWithFilterResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolsWithFilterResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryConcentratedPoolIdLinkFromCFMMResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryPoolsWithFilterResponse proto.InternalMessageInfo
 
-func (m *QueryConcentratedPoolIdLinkFromCFMMResponse) GetConcentratedPoolId() uint64 {
+func (m *QueryPoolsWithFilterResponse) GetPools() []*types.Any {
 	if m != nil {
-		return m.ConcentratedPoolId
+		return m.Pools
 	}
-	return 0
+	return nil
 }
 
-// =============================== QueryCFMMConcentratedPoolLinks
-type QueryCFMMConcentratedPoolLinksRequest struct {
+func (m *QueryPoolsWithFilterResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryCFMMConcentratedPoolLinksRequest) Reset()         { *m = QueryCFMMConcentratedPoolLinksRequest{} }
-func (m *QueryCFMMConcentratedPoolLinksRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryCFMMConcentratedPoolLinksRequest) ProtoMessage()    {}
-func (*QueryCFMMConcentratedPoolLinksRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d9a717df9ca609ef, []int{32}
+// QuerySpotPriceResponse defines the gRPC response structure for a SpotPrice
+// query.
+//
+// Deprecated: Do not use.
+type QuerySpotPriceResponse struct {
+	// String of the Dec. Ex) 10.203uatom
+	SpotPrice string `protobuf:"bytes,1,opt,name=spot_price,json=spotPrice,proto3" json:"spot_price,omitempty" yaml:"spot_price"`
 }
-func (m *QueryCFMMConcentratedPoolLinksRequest) XXX_Unmarshal(b []byte) error {
+
+func (m *QuerySpotPriceResponse) Reset()         { *m = QuerySpotPriceResponse{} }
+func (m *QuerySpotPriceResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySpotPriceResponse) ProtoMessage()    {}
+func (*QuerySpotPriceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a717df9ca609ef, []int{32}
+}
+func (m *QuerySpotPriceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryCFMMConcentratedPoolLinksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QuerySpotPriceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryCFMMConcentratedPoolLinksRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QuerySpotPriceResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1615,36 +1645,47 @@ func (m *QueryCFMMConcentratedPoolLinksRequest) XXX_Marshal(b []byte, determinis
 		return b[:n], nil
 	}
 }
-func (m *QueryCFMMConcentratedPoolLinksRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryCFMMConcentratedPoolLinksRequest.Merge(m, src)
+func (m *QuerySpotPriceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySpotPriceResponse.Merge(m, src)
 }
-func (m *QueryCFMMConcentratedPoolLinksRequest) XXX_Size() int {
+func (m *QuerySpotPriceResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryCFMMConcentratedPoolLinksRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryCFMMConcentratedPoolLinksRequest.DiscardUnknown(m)
+func (m *QuerySpotPriceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySpotPriceResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryCFMMConcentratedPoolLinksRequest proto.InternalMessageInfo
+var xxx_messageInfo_QuerySpotPriceResponse proto.InternalMessageInfo
 
-type QueryCFMMConcentratedPoolLinksResponse struct {
-	MigrationRecords *migration.MigrationRecords `protobuf:"bytes,1,opt,name=migration_records,json=migrationRecords,proto3" json:"migration_records,omitempty"`
+func (m *QuerySpotPriceResponse) GetSpotPrice() string {
+	if m != nil {
+		return m.SpotPrice
+	}
+	return ""
 }
 
-func (m *QueryCFMMConcentratedPoolLinksResponse) Reset() {
-	*m = QueryCFMMConcentratedPoolLinksResponse{}
+// =============================== EstimateSwapExactAmountIn
+//
+// Deprecated: Do not use.
+type QuerySwapExactAmountInRequest struct {
+	Sender  string                     `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	PoolId  uint64                     `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	TokenIn string                     `protobuf:"bytes,3,opt,name=token_in,json=tokenIn,proto3" json:"token_in,omitempty" yaml:"token_in"`
+	Routes  []types2.SwapAmountInRoute `protobuf:"bytes,4,rep,name=routes,proto3" json:"routes" yaml:"routes"`
 }
-func (m *QueryCFMMConcentratedPoolLinksResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryCFMMConcentratedPoolLinksResponse) ProtoMessage()    {}
-func (*QueryCFMMConcentratedPoolLinksResponse) Descriptor() ([]byte, []int) {
+
+func (m *QuerySwapExactAmountInRequest) Reset()         { *m = QuerySwapExactAmountInRequest{} }
+func (m *QuerySwapExactAmountInRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySwapExactAmountInRequest) ProtoMessage()    {}
+func (*QuerySwapExactAmountInRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_d9a717df9ca609ef, []int{33}
 }
-func (m *QueryCFMMConcentratedPoolLinksResponse) XXX_Unmarshal(b []byte) error {
+func (m *QuerySwapExactAmountInRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryCFMMConcentratedPoolLinksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QuerySwapExactAmountInRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryCFMMConcentratedPoolLinksResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QuerySwapExactAmountInRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1654,1505 +1695,1533 @@ func (m *QueryCFMMConcentratedPoolLinksResponse) XXX_Marshal(b []byte, determini
 		return b[:n], nil
 	}
 }
-func (m *QueryCFMMConcentratedPoolLinksResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryCFMMConcentratedPoolLinksResponse.Merge(m, src)
+func (m *QuerySwapExactAmountInRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySwapExactAmountInRequest.Merge(m, src)
 }
-func (m *QueryCFMMConcentratedPoolLinksResponse) XXX_Size() int {
+func (m *QuerySwapExactAmountInRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryCFMMConcentratedPoolLinksResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryCFMMConcentratedPoolLinksResponse.DiscardUnknown(m)
+func (m *QuerySwapExactAmountInRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySwapExactAmountInRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryCFMMConcentratedPoolLinksResponse proto.InternalMessageInfo
+var xxx_messageInfo_QuerySwapExactAmountInRequest proto.InternalMessageInfo
 
-func (m *QueryCFMMConcentratedPoolLinksResponse) GetMigrationRecords() *migration.MigrationRecords {
+func (m *QuerySwapExactAmountInRequest) GetSender() string {
 	if m != nil {
-		return m.MigrationRecords
+		return m.Sender
 	}
-	return nil
+	return ""
 }
 
-func init() {
-	proto.RegisterType((*QueryPoolRequest)(nil), "osmosis.gamm.v1beta1.QueryPoolRequest")
-	proto.RegisterType((*QueryPoolResponse)(nil), "osmosis.gamm.v1beta1.QueryPoolResponse")
-	proto.RegisterType((*QueryPoolsRequest)(nil), "osmosis.gamm.v1beta1.QueryPoolsRequest")
-	proto.RegisterType((*QueryPoolsResponse)(nil), "osmosis.gamm.v1beta1.QueryPoolsResponse")
-	proto.RegisterType((*QueryNumPoolsRequest)(nil), "osmosis.gamm.v1beta1.QueryNumPoolsRequest")
-	proto.RegisterType((*QueryNumPoolsResponse)(nil), "osmosis.gamm.v1beta1.QueryNumPoolsResponse")
-	proto.RegisterType((*QueryPoolTypeRequest)(nil), "osmosis.gamm.v1beta1.QueryPoolTypeRequest")
-	proto.RegisterType((*QueryPoolTypeResponse)(nil), "osmosis.gamm.v1beta1.QueryPoolTypeResponse")
-	proto.RegisterType((*QueryCalcJoinPoolSharesRequest)(nil), "osmosis.gamm.v1beta1.QueryCalcJoinPoolSharesRequest")
-	proto.RegisterType((*QueryCalcJoinPoolSharesResponse)(nil), "osmosis.gamm.v1beta1.QueryCalcJoinPoolSharesResponse")
-	proto.RegisterType((*QueryCalcExitPoolCoinsFromSharesRequest)(nil), "osmosis.gamm.v1beta1.QueryCalcExitPoolCoinsFromSharesRequest")
-	proto.RegisterType((*QueryCalcExitPoolCoinsFromSharesResponse)(nil), "osmosis.gamm.v1beta1.QueryCalcExitPoolCoinsFromSharesResponse")
-	proto.RegisterType((*QueryPoolParamsRequest)(nil), "osmosis.gamm.v1beta1.QueryPoolParamsRequest")
-	proto.RegisterType((*QueryPoolParamsResponse)(nil), "osmosis.gamm.v1beta1.QueryPoolParamsResponse")
-	proto.RegisterType((*QueryTotalPoolLiquidityRequest)(nil), "osmosis.gamm.v1beta1.QueryTotalPoolLiquidityRequest")
-	proto.RegisterType((*QueryTotalPoolLiquidityResponse)(nil), "osmosis.gamm.v1beta1.QueryTotalPoolLiquidityResponse")
-	proto.RegisterType((*QueryTotalSharesRequest)(nil), "osmosis.gamm.v1beta1.QueryTotalSharesRequest")
-	proto.RegisterType((*QueryTotalSharesResponse)(nil), "osmosis.gamm.v1beta1.QueryTotalSharesResponse")
-	proto.RegisterType((*QueryCalcJoinPoolNoSwapSharesRequest)(nil), "osmosis.gamm.v1beta1.QueryCalcJoinPoolNoSwapSharesRequest")
-	proto.RegisterType((*QueryCalcJoinPoolNoSwapSharesResponse)(nil), "osmosis.gamm.v1beta1.QueryCalcJoinPoolNoSwapSharesResponse")
-	proto.RegisterType((*QuerySpotPriceRequest)(nil), "osmosis.gamm.v1beta1.QuerySpotPriceRequest")
-	proto.RegisterType((*QueryPoolsWithFilterRequest)(nil), "osmosis.gamm.v1beta1.QueryPoolsWithFilterRequest")
-	proto.RegisterType((*QueryPoolsWithFilterResponse)(nil), "osmosis.gamm.v1beta1.QueryPoolsWithFilterResponse")
-	proto.RegisterType((*QuerySpotPriceResponse)(nil), "osmosis.gamm.v1beta1.QuerySpotPriceResponse")
-	proto.RegisterType((*QuerySwapExactAmountInRequest)(nil), "osmosis.gamm.v1beta1.QuerySwapExactAmountInRequest")
-	proto.RegisterType((*QuerySwapExactAmountInResponse)(nil), "osmosis.gamm.v1beta1.QuerySwapExactAmountInResponse")
-	proto.RegisterType((*QuerySwapExactAmountOutRequest)(nil), "osmosis.gamm.v1beta1.QuerySwapExactAmountOutRequest")
-	proto.RegisterType((*QuerySwapExactAmountOutResponse)(nil), "osmosis.gamm.v1beta1.QuerySwapExactAmountOutResponse")
-	proto.RegisterType((*QueryTotalLiquidityRequest)(nil), "osmosis.gamm.v1beta1.QueryTotalLiquidityRequest")
-	proto.RegisterType((*QueryTotalLiquidityResponse)(nil), "osmosis.gamm.v1beta1.QueryTotalLiquidityResponse")
-	proto.RegisterType((*QueryConcentratedPoolIdLinkFromCFMMRequest)(nil), "osmosis.gamm.v1beta1.QueryConcentratedPoolIdLinkFromCFMMRequest")
-	proto.RegisterType((*QueryConcentratedPoolIdLinkFromCFMMResponse)(nil), "osmosis.gamm.v1beta1.QueryConcentratedPoolIdLinkFromCFMMResponse")
-	proto.RegisterType((*QueryCFMMConcentratedPoolLinksRequest)(nil), "osmosis.gamm.v1beta1.QueryCFMMConcentratedPoolLinksRequest")
-	proto.RegisterType((*QueryCFMMConcentratedPoolLinksResponse)(nil), "osmosis.gamm.v1beta1.QueryCFMMConcentratedPoolLinksResponse")
+func (m *QuerySwapExactAmountInRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
 }
 
-func init() { proto.RegisterFile("osmosis/gamm/v1beta1/query.proto", fileDescriptor_d9a717df9ca609ef) }
-
-var fileDescriptor_d9a717df9ca609ef = []byte{
-	// 2062 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0x4d, 0x6c, 0x1c, 0x49,
-	0x15, 0x76, 0x3b, 0x8e, 0xd7, 0x7e, 0x49, 0xfc, 0x53, 0xeb, 0x6c, 0x26, 0xe3, 0x64, 0x26, 0x5b,
-	0xec, 0xda, 0xd9, 0xc4, 0xee, 0xb6, 0x1d, 0xaf, 0x76, 0x31, 0xc9, 0x6e, 0x6c, 0xaf, 0x9d, 0xd8,
-	0x8a, 0x63, 0x6f, 0x67, 0x25, 0x04, 0x08, 0x5a, 0xed, 0x99, 0xce, 0xb8, 0xd7, 0xd3, 0x5d, 0x93,
-	0xe9, 0xea, 0x8d, 0xad, 0x95, 0xb5, 0x12, 0x07, 0x94, 0xe5, 0xb2, 0x48, 0xc0, 0x9e, 0x10, 0x5c,
-	0x56, 0x08, 0x71, 0x46, 0xe2, 0xc4, 0x01, 0x71, 0x89, 0x38, 0x45, 0xc0, 0x01, 0x71, 0x18, 0x50,
-	0x02, 0x9c, 0xb8, 0xe0, 0x0b, 0x57, 0x54, 0x3f, 0xfd, 0x33, 0x33, 0x3d, 0x3d, 0x3f, 0xab, 0x48,
-	0xd9, 0x93, 0x3d, 0x55, 0xef, 0xbd, 0xfa, 0xbe, 0xf7, 0x5e, 0xbd, 0x7e, 0xf5, 0xe0, 0x12, 0xf1,
-	0x1c, 0xe2, 0xd9, 0x9e, 0x56, 0x32, 0x1d, 0x47, 0xfb, 0x68, 0x7e, 0xd7, 0xa2, 0xe6, 0xbc, 0xf6,
-	0xc0, 0xb7, 0xaa, 0x87, 0x6a, 0xa5, 0x4a, 0x28, 0x41, 0x13, 0x52, 0x42, 0x65, 0x12, 0xaa, 0x94,
-	0xc8, 0x4e, 0x94, 0x48, 0x89, 0x70, 0x01, 0x8d, 0xfd, 0x27, 0x64, 0xb3, 0x17, 0x13, 0xad, 0xd1,
-	0x03, 0xb9, 0x3d, 0x13, 0x6c, 0x57, 0x08, 0x29, 0x3b, 0xa6, 0x6b, 0x96, 0xac, 0x6a, 0x28, 0xe5,
-	0x3d, 0x34, 0x2b, 0x46, 0x95, 0xf8, 0xd4, 0x92, 0xd2, 0xb9, 0x02, 0x17, 0xd7, 0x76, 0x4d, 0xcf,
-	0x0a, 0xa5, 0x0a, 0xc4, 0x76, 0xe5, 0xfe, 0x95, 0xf8, 0x3e, 0x47, 0x1c, 0x4a, 0x55, 0xcc, 0x92,
-	0xed, 0x9a, 0xd4, 0x26, 0x81, 0xec, 0x85, 0x12, 0x21, 0xa5, 0xb2, 0xa5, 0x99, 0x15, 0x5b, 0x33,
-	0x5d, 0x97, 0x50, 0xbe, 0xe9, 0xc9, 0xdd, 0xf3, 0x72, 0x97, 0xff, 0xda, 0xf5, 0xef, 0x6b, 0xa6,
-	0x7b, 0x18, 0x6c, 0x89, 0x43, 0x0c, 0x41, 0x55, 0xfc, 0x90, 0x5b, 0xaf, 0x26, 0x92, 0xf5, 0xf6,
-	0xcc, 0xaa, 0x55, 0x14, 0x22, 0x78, 0x15, 0xc6, 0xde, 0x67, 0xc0, 0x76, 0x08, 0x29, 0xeb, 0xd6,
-	0x03, 0xdf, 0xf2, 0x28, 0xba, 0x0a, 0x2f, 0x31, 0xfa, 0x86, 0x5d, 0xcc, 0x28, 0x97, 0x94, 0xcb,
-	0x03, 0x2b, 0xe8, 0xb8, 0x96, 0x1f, 0x39, 0x34, 0x9d, 0xf2, 0x12, 0x96, 0x1b, 0x58, 0x1f, 0x64,
-	0xff, 0x6d, 0x14, 0x97, 0xfa, 0x33, 0x0a, 0xbe, 0x03, 0xe3, 0x31, 0x23, 0x5e, 0x85, 0xb8, 0x9e,
-	0x85, 0xae, 0xc1, 0x00, 0x13, 0xe1, 0x26, 0x4e, 0x2d, 0x4c, 0xa8, 0x82, 0x81, 0x1a, 0x30, 0x50,
-	0x97, 0xdd, 0xc3, 0x95, 0xe1, 0x3f, 0xfe, 0x66, 0xf6, 0x24, 0xd3, 0xda, 0xd0, 0xb9, 0x30, 0xb7,
-	0xf6, 0x9d, 0x98, 0x35, 0x2f, 0xc0, 0xb4, 0x0e, 0x10, 0xb9, 0x2c, 0xd3, 0xcf, 0x6d, 0x4e, 0xa9,
-	0x92, 0x2d, 0xf3, 0xaf, 0x2a, 0x32, 0x42, 0x92, 0x54, 0x77, 0xcc, 0x92, 0x25, 0x75, 0xf5, 0x98,
-	0x26, 0xfe, 0x89, 0x02, 0x28, 0x6e, 0x5d, 0x82, 0x7d, 0x13, 0x4e, 0xb2, 0xf3, 0xbd, 0x8c, 0x72,
-	0xe9, 0x44, 0x27, 0x68, 0x85, 0x34, 0xba, 0x95, 0x80, 0x6a, 0xba, 0x2d, 0x2a, 0x71, 0x66, 0x1d,
-	0xac, 0x2c, 0x4c, 0x70, 0x54, 0x77, 0x7d, 0x27, 0x4e, 0x9b, 0xfb, 0xe3, 0x2e, 0x9c, 0x6d, 0xd8,
-	0x93, 0xa0, 0xe7, 0x61, 0xd8, 0xf5, 0x1d, 0x23, 0x00, 0xce, 0x22, 0x35, 0x71, 0x5c, 0xcb, 0x8f,
-	0x89, 0x48, 0x85, 0x5b, 0x58, 0x1f, 0x72, 0xa5, 0x2a, 0xb7, 0xb7, 0x2a, 0xcf, 0x62, 0x2b, 0x1f,
-	0x1c, 0x56, 0xac, 0x5e, 0xc2, 0x8e, 0x37, 0x25, 0xa8, 0xc8, 0x48, 0x04, 0x8a, 0x0b, 0xd3, 0xc3,
-	0x8a, 0xc5, 0xed, 0x0c, 0xc7, 0x41, 0x85, 0x5b, 0x58, 0x1f, 0xaa, 0x48, 0x55, 0xfc, 0x5b, 0x05,
-	0x72, 0xdc, 0xd8, 0xaa, 0x59, 0x2e, 0x6c, 0x12, 0xdb, 0x65, 0x46, 0xef, 0xb1, 0x2c, 0xf5, 0x7a,
-	0xc1, 0x86, 0xf6, 0x60, 0x98, 0x92, 0x7d, 0xcb, 0xf5, 0x0c, 0x9b, 0x05, 0x85, 0x05, 0xf4, 0x7c,
-	0x5d, 0x50, 0x82, 0x70, 0xac, 0x12, 0xdb, 0x5d, 0x99, 0x7b, 0x5c, 0xcb, 0xf7, 0xfd, 0xfa, 0xef,
-	0xf9, 0xcb, 0x25, 0x9b, 0xee, 0xf9, 0xbb, 0x6a, 0x81, 0x38, 0xf2, 0x16, 0xc9, 0x3f, 0xb3, 0x5e,
-	0x71, 0x5f, 0x63, 0x98, 0x3d, 0xae, 0xe0, 0xe9, 0x43, 0xc2, 0xfa, 0x86, 0x8b, 0xff, 0xab, 0x40,
-	0xbe, 0x25, 0x72, 0xe9, 0x90, 0x5d, 0x18, 0xe3, 0x37, 0xce, 0x20, 0x3e, 0x35, 0x4c, 0x87, 0xf8,
-	0x2e, 0x95, 0x7e, 0x79, 0x9b, 0x9d, 0xfc, 0xb7, 0x5a, 0xfe, 0xac, 0x38, 0xc7, 0x2b, 0xee, 0xab,
-	0x36, 0xd1, 0x1c, 0x93, 0xee, 0xa9, 0x1b, 0x2e, 0x3d, 0xae, 0xe5, 0xcf, 0x09, 0x82, 0x8d, 0xea,
-	0x58, 0x1f, 0xe1, 0x4b, 0xdb, 0x3e, 0x5d, 0xe6, 0x0b, 0xe8, 0x43, 0x00, 0xc9, 0x98, 0xf8, 0xf4,
-	0x79, 0x50, 0x96, 0x0e, 0xdd, 0xf6, 0x29, 0xfe, 0x54, 0x81, 0xe9, 0x90, 0xf3, 0xda, 0x81, 0x4d,
-	0x19, 0x67, 0x2e, 0xb5, 0x5e, 0x25, 0x4e, 0x7d, 0xd8, 0xce, 0x35, 0x84, 0x2d, 0x0c, 0xd1, 0x1a,
-	0x8c, 0x0a, 0x56, 0xb6, 0x1b, 0xf8, 0xa4, 0x9f, 0xfb, 0xe4, 0x62, 0xaa, 0x4f, 0xf4, 0x33, 0x5c,
-	0x6b, 0xc3, 0x15, 0xbc, 0xf1, 0xe7, 0x0a, 0x5c, 0x6e, 0x8f, 0x45, 0x06, 0xa2, 0xde, 0x49, 0xca,
-	0x73, 0x75, 0xd2, 0x1a, 0xbc, 0x12, 0x5e, 0x8f, 0x1d, 0xb3, 0x6a, 0x3a, 0x3d, 0x65, 0x32, 0xbe,
-	0x05, 0xe7, 0x9a, 0xcc, 0x48, 0x36, 0x33, 0x30, 0x58, 0xe1, 0x2b, 0x69, 0x05, 0x56, 0x97, 0x32,
-	0xf8, 0x7d, 0x79, 0xc3, 0x3e, 0x20, 0xd4, 0x2c, 0x33, 0x6b, 0x77, 0xec, 0x07, 0xbe, 0x5d, 0xb4,
-	0xe9, 0x61, 0xcf, 0x45, 0xff, 0x8b, 0x20, 0xf7, 0x93, 0x6c, 0x4a, 0x90, 0x47, 0x30, 0x5c, 0x0e,
-	0x16, 0xdb, 0x7b, 0xfc, 0x3d, 0xe6, 0xf1, 0xa8, 0x56, 0x84, 0x9a, 0xb8, 0xbb, 0x28, 0x84, 0x7a,
-	0x1c, 0xe6, 0xba, 0x74, 0x21, 0x47, 0xd9, 0x7b, 0x51, 0xc1, 0x3e, 0x64, 0x9a, 0xed, 0x48, 0x9a,
-	0xdf, 0x82, 0xd3, 0x94, 0x2d, 0x1b, 0x3c, 0x3b, 0x83, 0x88, 0xa4, 0x30, 0x9d, 0x94, 0x4c, 0x5f,
-	0x16, 0x87, 0xc5, 0x95, 0xb1, 0x7e, 0x8a, 0x46, 0x47, 0xe0, 0xdf, 0x29, 0xf0, 0x5a, 0x53, 0x85,
-	0xb9, 0x4b, 0xee, 0x3d, 0x34, 0x2b, 0x5f, 0x89, 0x0a, 0xf9, 0x6f, 0x05, 0x5e, 0x6f, 0x83, 0x5f,
-	0x3a, 0xf1, 0x93, 0xee, 0xae, 0xe7, 0x9a, 0x74, 0xe1, 0x78, 0xe0, 0xc2, 0x40, 0x15, 0xf7, 0x78,
-	0x67, 0xd1, 0x75, 0x00, 0x11, 0x02, 0x59, 0x44, 0x3b, 0x28, 0x47, 0xc3, 0x42, 0x81, 0xdd, 0xf8,
-	0xff, 0x28, 0xf2, 0x8b, 0x78, 0xaf, 0x42, 0xe8, 0x4e, 0xd5, 0x2e, 0xf4, 0xf4, 0x5d, 0x45, 0x6b,
-	0x30, 0xc6, 0xb8, 0x1a, 0xa6, 0xe7, 0x59, 0xd4, 0x28, 0x5a, 0x2e, 0x71, 0x24, 0x94, 0xc9, 0xe8,
-	0x83, 0xd0, 0x28, 0x81, 0xf5, 0x11, 0xb6, 0xb4, 0xcc, 0x56, 0xde, 0x63, 0x0b, 0xe8, 0x36, 0x8c,
-	0x3f, 0xf0, 0x09, 0xad, 0xb7, 0x73, 0x82, 0xdb, 0xb9, 0x70, 0x5c, 0xcb, 0x67, 0x84, 0x9d, 0x26,
-	0x11, 0xac, 0x8f, 0xf2, 0xb5, 0xc8, 0x12, 0xbb, 0x43, 0x9b, 0x03, 0x43, 0x03, 0x63, 0x27, 0xf5,
-	0x53, 0x0f, 0x6d, 0xba, 0xc7, 0x02, 0xb7, 0x6e, 0x59, 0xf8, 0xf7, 0x0a, 0x4c, 0x46, 0x7d, 0xd4,
-	0x37, 0x6d, 0xba, 0xb7, 0x6e, 0x97, 0xa9, 0x55, 0x0d, 0x48, 0xdf, 0x80, 0x33, 0x8e, 0xed, 0x1a,
-	0xf1, 0xdb, 0xcf, 0x0e, 0xcf, 0x1c, 0xd7, 0xf2, 0x13, 0xe2, 0xf0, 0xba, 0x6d, 0xac, 0x9f, 0x76,
-	0x6c, 0x37, 0x2c, 0x20, 0x68, 0x32, 0xde, 0x45, 0x70, 0xfe, 0x51, 0xbf, 0xd0, 0xd0, 0x0b, 0x9e,
-	0xe8, 0xb9, 0x17, 0xfc, 0xb9, 0x02, 0x17, 0x92, 0x39, 0xbc, 0x20, 0x5d, 0xa1, 0x2e, 0xbf, 0x22,
-	0xb1, 0x94, 0x92, 0xc8, 0x16, 0x01, 0xbc, 0x0a, 0xa1, 0x46, 0x85, 0xad, 0x4a, 0xdf, 0x9e, 0x8d,
-	0x6e, 0x43, 0xb4, 0x87, 0xf5, 0x61, 0x2f, 0xd0, 0xe6, 0xf5, 0xf0, 0x87, 0xfd, 0x70, 0x51, 0x18,
-	0x7d, 0x68, 0x56, 0xd6, 0x0e, 0xcc, 0x82, 0xec, 0x21, 0x36, 0xdc, 0x20, 0x74, 0x6f, 0xc0, 0xa0,
-	0x67, 0xb9, 0x45, 0xab, 0x2a, 0xed, 0x8e, 0x1f, 0xd7, 0xf2, 0x67, 0xa4, 0x5d, 0xbe, 0x8e, 0x75,
-	0x29, 0x10, 0x4f, 0xed, 0xfe, 0xb6, 0xa9, 0xad, 0x82, 0x28, 0x0b, 0xac, 0xe6, 0x88, 0x54, 0x7c,
-	0xf9, 0xb8, 0x96, 0x1f, 0x8d, 0xdd, 0x5f, 0xc3, 0x76, 0xb1, 0xfe, 0x12, 0xff, 0x77, 0xc3, 0x45,
-	0xdf, 0x85, 0x41, 0xfe, 0xd8, 0xf2, 0x32, 0x03, 0xdc, 0xfd, 0xaa, 0x1a, 0xbc, 0xf3, 0x62, 0x8f,
-	0xb3, 0xd0, 0x89, 0x8c, 0x4e, 0xc8, 0x84, 0xa9, 0xad, 0x9c, 0x95, 0x15, 0x42, 0x62, 0x17, 0xb6,
-	0xb0, 0x2e, 0x8d, 0x72, 0x67, 0x3c, 0x0a, 0x3a, 0xcf, 0x04, 0x67, 0x44, 0xed, 0x9b, 0xc0, 0xd6,
-	0x73, 0xfb, 0xd6, 0xa8, 0x8e, 0xf5, 0x11, 0xbe, 0x14, 0xb6, 0x6f, 0x1c, 0xca, 0x67, 0xfd, 0xc9,
-	0x50, 0xb6, 0x7d, 0xfa, 0xbc, 0x03, 0xf3, 0xbd, 0xd0, 0xd1, 0x27, 0xb8, 0xa3, 0xb5, 0x0e, 0x1d,
-	0xcd, 0xa0, 0x75, 0xe0, 0x69, 0xf6, 0x24, 0x08, 0x7d, 0x90, 0x19, 0x68, 0x7c, 0x12, 0x84, 0x5b,
-	0x58, 0x7e, 0x36, 0xb6, 0x7d, 0xe1, 0x91, 0x1f, 0x04, 0x0d, 0x46, 0x92, 0x47, 0x64, 0x74, 0x0c,
-	0x18, 0x0d, 0x32, 0xa7, 0x3e, 0x38, 0x6f, 0xb5, 0x0b, 0xce, 0x2b, 0xf5, 0x79, 0x17, 0xc6, 0xe6,
-	0x8c, 0x4c, 0xbf, 0x58, 0x68, 0x2e, 0x40, 0x36, 0xfa, 0xf4, 0x37, 0x36, 0x4e, 0xf8, 0x67, 0x41,
-	0x25, 0x6c, 0xdc, 0x7e, 0x21, 0x7a, 0x20, 0x5c, 0x82, 0x2b, 0xe2, 0xfb, 0x4b, 0xdc, 0x82, 0xe5,
-	0xd2, 0xaa, 0x49, 0xad, 0x22, 0xaf, 0x56, 0xc5, 0x3b, 0xb6, 0xbb, 0xcf, 0xda, 0xe4, 0xd5, 0xf5,
-	0xad, 0xad, 0x20, 0xc5, 0xbe, 0x0e, 0xa7, 0x0b, 0xf7, 0x1d, 0xf1, 0x70, 0x8c, 0x3e, 0x58, 0xe7,
-	0xa2, 0x56, 0x25, 0xbe, 0x8b, 0x75, 0x60, 0x3f, 0x85, 0x35, 0x6c, 0xc0, 0xd5, 0x8e, 0x0e, 0x92,
-	0x6e, 0x99, 0x83, 0x89, 0x42, 0x4c, 0xb2, 0xfe, 0x44, 0x1d, 0x15, 0x9a, 0xac, 0xe0, 0xe9, 0xa0,
-	0x93, 0x58, 0xdf, 0xda, 0x6a, 0x3c, 0x84, 0x1d, 0x11, 0xb4, 0x42, 0xf8, 0x08, 0xa6, 0xda, 0x09,
-	0x4a, 0x10, 0xf7, 0x60, 0xdc, 0xb1, 0x4b, 0x55, 0x5e, 0x6d, 0x8d, 0xaa, 0x55, 0x20, 0xd5, 0x62,
-	0xd0, 0xbd, 0x4d, 0xa9, 0x49, 0x53, 0x25, 0x75, 0x2b, 0x10, 0xd7, 0x85, 0xb4, 0x3e, 0xe6, 0x34,
-	0xac, 0x2c, 0x3c, 0xca, 0xc0, 0x49, 0x7e, 0x3e, 0xfa, 0x04, 0xf8, 0x87, 0xc1, 0x43, 0xd3, 0xc9,
-	0xc6, 0x9a, 0xc6, 0x1c, 0xd9, 0xcb, 0xed, 0x05, 0x05, 0x74, 0xfc, 0xb5, 0xef, 0xff, 0xf9, 0x9f,
-	0x3f, 0xee, 0xbf, 0x88, 0x26, 0xb5, 0xc4, 0x21, 0x8f, 0xf8, 0x12, 0x7d, 0xa6, 0xc0, 0x50, 0x30,
-	0x36, 0x40, 0x57, 0x52, 0x6c, 0x37, 0xcc, 0x1d, 0xb2, 0x57, 0x3b, 0x92, 0x95, 0x50, 0xae, 0x70,
-	0x28, 0xaf, 0xa2, 0x7c, 0x32, 0x94, 0x70, 0x10, 0xf1, 0xa8, 0x5f, 0x41, 0x5f, 0x28, 0x30, 0x52,
-	0x7f, 0x51, 0xd0, 0x5c, 0xca, 0x59, 0x89, 0x57, 0x2e, 0x3b, 0xdf, 0x85, 0x86, 0xc4, 0x38, 0xcb,
-	0x31, 0x4e, 0xa3, 0xd7, 0x93, 0x31, 0x8a, 0x0e, 0x3c, 0xbc, 0x35, 0xe8, 0x97, 0x0a, 0x8c, 0x36,
-	0x74, 0x05, 0x68, 0xbe, 0x5d, 0x6c, 0x9a, 0xba, 0xa0, 0xec, 0x42, 0x37, 0x2a, 0x12, 0xe9, 0x0c,
-	0x47, 0x3a, 0x85, 0x5e, 0x4b, 0x46, 0x7a, 0x9f, 0x4b, 0xcb, 0x0b, 0xe3, 0xa1, 0x4f, 0x15, 0x18,
-	0x60, 0x96, 0xd0, 0x54, 0x9b, 0xa3, 0x02, 0x48, 0xd3, 0x6d, 0xe5, 0x24, 0x8e, 0xb9, 0x74, 0x8f,
-	0xf1, 0xe3, 0xb5, 0x8f, 0xe5, 0xb5, 0x3d, 0x62, 0xb1, 0xfd, 0x5c, 0x81, 0xa1, 0x60, 0x1e, 0x94,
-	0x9a, 0x6d, 0x0d, 0x93, 0xa7, 0xd4, 0x6c, 0x6b, 0x1c, 0x30, 0xe1, 0x79, 0x8e, 0xeb, 0x2a, 0x7a,
-	0xa3, 0x35, 0x2e, 0xde, 0x36, 0x46, 0xd8, 0xd0, 0x4f, 0x15, 0xc8, 0xb4, 0x7a, 0x7f, 0xa0, 0xa5,
-	0x94, 0xc3, 0xdb, 0x3c, 0xba, 0xb2, 0xdf, 0xe8, 0x49, 0x57, 0x12, 0xe9, 0x43, 0x7f, 0x50, 0x00,
-	0x35, 0x4f, 0x8e, 0xd0, 0x62, 0x87, 0x56, 0xeb, 0xb1, 0xbc, 0xd9, 0xa5, 0x96, 0x44, 0x71, 0x93,
-	0xbb, 0x73, 0x09, 0xbd, 0xdd, 0x51, 0x98, 0xb5, 0x0f, 0x89, 0xed, 0x1a, 0x7c, 0x10, 0x6e, 0xb1,
-	0x2f, 0xb2, 0x61, 0xbb, 0xe8, 0x5f, 0x0a, 0x4c, 0xa6, 0xcc, 0x5f, 0xd0, 0x8d, 0x36, 0xc0, 0xd2,
-	0x67, 0x48, 0xd9, 0x77, 0x7a, 0x55, 0x97, 0x04, 0x6f, 0x71, 0x82, 0xcb, 0xe8, 0xdd, 0xce, 0x08,
-	0x5a, 0x07, 0x36, 0x15, 0x04, 0xc5, 0x80, 0x4a, 0xf4, 0x05, 0x8c, 0xe7, 0x2f, 0x14, 0x80, 0x68,
-	0x10, 0x83, 0x66, 0xda, 0x24, 0x6d, 0xdd, 0xd8, 0x27, 0x3b, 0xdb, 0xa1, 0xb4, 0x04, 0xbd, 0xc8,
-	0x41, 0xab, 0x68, 0xa6, 0x33, 0xd0, 0x62, 0xca, 0x83, 0x1e, 0x2b, 0x80, 0x9a, 0xa7, 0x31, 0xa9,
-	0xf9, 0xd4, 0x72, 0x20, 0x94, 0x9a, 0x4f, 0xad, 0x47, 0x3e, 0x78, 0x8d, 0x23, 0xbf, 0x8e, 0x96,
-	0x3a, 0x43, 0x2e, 0x0a, 0x2f, 0xff, 0x19, 0x56, 0x5f, 0x56, 0x4b, 0x7e, 0xa5, 0xc0, 0xa9, 0xd8,
-	0xa8, 0x05, 0xcd, 0xb6, 0x43, 0x53, 0x9f, 0x34, 0x6a, 0xa7, 0xe2, 0x12, 0xf5, 0x12, 0x47, 0xbd,
-	0x88, 0x16, 0xba, 0x41, 0x2d, 0x1e, 0xff, 0x2c, 0x2f, 0x86, 0xc3, 0x17, 0x1a, 0x4a, 0xab, 0x65,
-	0x8d, 0xa3, 0x81, 0xec, 0x4c, 0x67, 0xc2, 0x12, 0xe4, 0x5b, 0x5d, 0x26, 0x05, 0x53, 0xe6, 0x1f,
-	0xdd, 0x27, 0x0a, 0x9c, 0x5f, 0xf3, 0xa8, 0xed, 0x98, 0xd4, 0x6a, 0x7a, 0xe9, 0xa0, 0x6b, 0x69,
-	0x20, 0x5a, 0x3c, 0x12, 0xb3, 0x8b, 0xdd, 0x29, 0x49, 0x06, 0xb7, 0x39, 0x83, 0x77, 0xd1, 0x8d,
-	0x64, 0x06, 0xb1, 0x5b, 0x28, 0xd1, 0x6a, 0xb1, 0x52, 0x13, 0xde, 0x44, 0x46, 0xe9, 0x2f, 0x0a,
-	0x64, 0x5b, 0x50, 0xda, 0xf6, 0x29, 0xea, 0x02, 0x5e, 0xf4, 0xc0, 0x4a, 0x4d, 0xf9, 0xd6, 0x8f,
-	0x10, 0xbc, 0xc1, 0x59, 0xdd, 0x44, 0xef, 0x7c, 0x09, 0x56, 0xc4, 0xa7, 0x8c, 0xd6, 0xff, 0x14,
-	0xc8, 0xa5, 0x37, 0xd0, 0xe8, 0x66, 0x5a, 0x3d, 0xec, 0xa4, 0xc9, 0xcf, 0x2e, 0x7f, 0x09, 0x0b,
-	0x92, 0xf2, 0x0e, 0xa7, 0xbc, 0x89, 0x6e, 0x27, 0x53, 0x4e, 0xea, 0xec, 0x8d, 0xb2, 0xed, 0xee,
-	0x1b, 0xf7, 0xab, 0xc4, 0x31, 0xd8, 0xab, 0x41, 0xfb, 0x38, 0xfe, 0x94, 0x38, 0x42, 0x7f, 0x52,
-	0xe0, 0x7c, 0xcb, 0x86, 0x1d, 0xa5, 0x7e, 0x68, 0xdb, 0xbc, 0x07, 0xb2, 0xd7, 0x7b, 0x53, 0xee,
-	0xac, 0x34, 0x70, 0x16, 0xcd, 0x7c, 0x19, 0x59, 0x6f, 0x65, 0xf3, 0xf1, 0xd3, 0x9c, 0xf2, 0xe4,
-	0x69, 0x4e, 0xf9, 0xc7, 0xd3, 0x9c, 0xf2, 0xa3, 0x67, 0xb9, 0xbe, 0x27, 0xcf, 0x72, 0x7d, 0x7f,
-	0x7d, 0x96, 0xeb, 0xfb, 0xf6, 0x5c, 0xec, 0x2d, 0x27, 0xed, 0xce, 0x96, 0xcd, 0x5d, 0x2f, 0x3c,
-	0xe4, 0xa3, 0x85, 0x45, 0xed, 0x40, 0x1c, 0xc5, 0x5f, 0x76, 0xbb, 0x83, 0x7c, 0xea, 0x74, 0xed,
-	0xff, 0x01, 0x00, 0x00, 0xff, 0xff, 0xa8, 0x06, 0xf7, 0x61, 0x10, 0x1f, 0x00, 0x00,
+func (m *QuerySwapExactAmountInRequest) GetTokenIn() string {
+	if m != nil {
+		return m.TokenIn
+	}
+	return ""
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	Pools(ctx context.Context, in *QueryPoolsRequest, opts ...grpc.CallOption) (*QueryPoolsResponse, error)
-	// Deprecated: please use the alternative in x/poolmanager
-	NumPools(ctx context.Context, in *QueryNumPoolsRequest, opts ...grpc.CallOption) (*QueryNumPoolsResponse, error)
-	TotalLiquidity(ctx context.Context, in *QueryTotalLiquidityRequest, opts ...grpc.CallOption) (*QueryTotalLiquidityResponse, error)
-	// PoolsWithFilter allows you to query specific pools with requested
-	// parameters
-	PoolsWithFilter(ctx context.Context, in *QueryPoolsWithFilterRequest, opts ...grpc.CallOption) (*QueryPoolsWithFilterResponse, error)
-	// Deprecated: please use the alternative in x/poolmanager
-	Pool(ctx context.Context, in *QueryPoolRequest, opts ...grpc.CallOption) (*QueryPoolResponse, error)
-	// PoolType returns the type of the pool.
-	// Returns "Balancer" as a string literal when the pool is a balancer pool.
-	// Errors if the pool is failed to be type caseted.
-	PoolType(ctx context.Context, in *QueryPoolTypeRequest, opts ...grpc.CallOption) (*QueryPoolTypeResponse, error)
-	// Simulates joining pool without a swap. Returns the amount of shares you'd
-	// get and tokens needed to provide
-	CalcJoinPoolNoSwapShares(ctx context.Context, in *QueryCalcJoinPoolNoSwapSharesRequest, opts ...grpc.CallOption) (*QueryCalcJoinPoolNoSwapSharesResponse, error)
-	CalcJoinPoolShares(ctx context.Context, in *QueryCalcJoinPoolSharesRequest, opts ...grpc.CallOption) (*QueryCalcJoinPoolSharesResponse, error)
-	CalcExitPoolCoinsFromShares(ctx context.Context, in *QueryCalcExitPoolCoinsFromSharesRequest, opts ...grpc.CallOption) (*QueryCalcExitPoolCoinsFromSharesResponse, error)
-	PoolParams(ctx context.Context, in *QueryPoolParamsRequest, opts ...grpc.CallOption) (*QueryPoolParamsResponse, error)
-	// Deprecated: please use the alternative in x/poolmanager
-	TotalPoolLiquidity(ctx context.Context, in *QueryTotalPoolLiquidityRequest, opts ...grpc.CallOption) (*QueryTotalPoolLiquidityResponse, error)
-	TotalShares(ctx context.Context, in *QueryTotalSharesRequest, opts ...grpc.CallOption) (*QueryTotalSharesResponse, error)
-	// SpotPrice defines a gRPC query handler that returns the spot price given
-	// a base denomination and a quote denomination.
-	SpotPrice(ctx context.Context, in *QuerySpotPriceRequest, opts ...grpc.CallOption) (*QuerySpotPriceResponse, error)
-	// Deprecated: please use the alternative in x/poolmanager
-	EstimateSwapExactAmountIn(ctx context.Context, in *QuerySwapExactAmountInRequest, opts ...grpc.CallOption) (*QuerySwapExactAmountInResponse, error)
-	// Deprecated: please use the alternative in x/poolmanager
-	EstimateSwapExactAmountOut(ctx context.Context, in *QuerySwapExactAmountOutRequest, opts ...grpc.CallOption) (*QuerySwapExactAmountOutResponse, error)
-	// ConcentratedPoolIdLinkFromBalancer returns the pool id of the concentrated
-	// pool that is linked with the given CFMM pool.
-	ConcentratedPoolIdLinkFromCFMM(ctx context.Context, in *QueryConcentratedPoolIdLinkFromCFMMRequest, opts ...grpc.CallOption) (*QueryConcentratedPoolIdLinkFromCFMMResponse, error)
-	// CFMMConcentratedPoolLinks returns migration links between CFMM and
-	// Concentrated pools.
-	CFMMConcentratedPoolLinks(ctx context.Context, in *QueryCFMMConcentratedPoolLinksRequest, opts ...grpc.CallOption) (*QueryCFMMConcentratedPoolLinksResponse, error)
+func (m *QuerySwapExactAmountInRequest) GetRoutes() []types2.SwapAmountInRoute {
+	if m != nil {
+		return m.Routes
+	}
+	return nil
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+// Deprecated: Do not use.
+type QuerySwapExactAmountInResponse struct {
+	TokenOutAmount cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=token_out_amount,json=tokenOutAmount,proto3,customtype=cosmossdk.io/math.Int" json:"token_out_amount" yaml:"token_out_amount"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QuerySwapExactAmountInResponse) Reset()         { *m = QuerySwapExactAmountInResponse{} }
+func (m *QuerySwapExactAmountInResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySwapExactAmountInResponse) ProtoMessage()    {}
+func (*QuerySwapExactAmountInResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a717df9ca609ef, []int{34}
 }
-
-func (c *queryClient) Pools(ctx context.Context, in *QueryPoolsRequest, opts ...grpc.CallOption) (*QueryPoolsResponse, error) {
-	out := new(QueryPoolsResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/Pools", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QuerySwapExactAmountInResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-// Deprecated: Do not use.
-func (c *queryClient) NumPools(ctx context.Context, in *QueryNumPoolsRequest, opts ...grpc.CallOption) (*QueryNumPoolsResponse, error) {
-	out := new(QueryNumPoolsResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/NumPools", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QuerySwapExactAmountInResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySwapExactAmountInResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *queryClient) TotalLiquidity(ctx context.Context, in *QueryTotalLiquidityRequest, opts ...grpc.CallOption) (*QueryTotalLiquidityResponse, error) {
-	out := new(QueryTotalLiquidityResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/TotalLiquidity", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QuerySwapExactAmountInResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySwapExactAmountInResponse.Merge(m, src)
 }
-
-func (c *queryClient) PoolsWithFilter(ctx context.Context, in *QueryPoolsWithFilterRequest, opts ...grpc.CallOption) (*QueryPoolsWithFilterResponse, error) {
-	out := new(QueryPoolsWithFilterResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/PoolsWithFilter", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QuerySwapExactAmountInResponse) XXX_Size() int {
+	return m.Size()
 }
+func (m *QuerySwapExactAmountInResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySwapExactAmountInResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySwapExactAmountInResponse proto.InternalMessageInfo
 
+// =============================== EstimateSwapExactAmountOut
+//
 // Deprecated: Do not use.
-func (c *queryClient) Pool(ctx context.Context, in *QueryPoolRequest, opts ...grpc.CallOption) (*QueryPoolResponse, error) {
-	out := new(QueryPoolResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/Pool", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QuerySwapExactAmountOutRequest struct {
+	Sender   string                      `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	PoolId   uint64                      `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	Routes   []types2.SwapAmountOutRoute `protobuf:"bytes,3,rep,name=routes,proto3" json:"routes" yaml:"routes"`
+	TokenOut string                      `protobuf:"bytes,4,opt,name=token_out,json=tokenOut,proto3" json:"token_out,omitempty" yaml:"token_out"`
 }
 
-func (c *queryClient) PoolType(ctx context.Context, in *QueryPoolTypeRequest, opts ...grpc.CallOption) (*QueryPoolTypeResponse, error) {
-	out := new(QueryPoolTypeResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/PoolType", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QuerySwapExactAmountOutRequest) Reset()         { *m = QuerySwapExactAmountOutRequest{} }
+func (m *QuerySwapExactAmountOutRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySwapExactAmountOutRequest) ProtoMessage()    {}
+func (*QuerySwapExactAmountOutRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a717df9ca609ef, []int{35}
 }
-
-func (c *queryClient) CalcJoinPoolNoSwapShares(ctx context.Context, in *QueryCalcJoinPoolNoSwapSharesRequest, opts ...grpc.CallOption) (*QueryCalcJoinPoolNoSwapSharesResponse, error) {
-	out := new(QueryCalcJoinPoolNoSwapSharesResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/CalcJoinPoolNoSwapShares", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QuerySwapExactAmountOutRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) CalcJoinPoolShares(ctx context.Context, in *QueryCalcJoinPoolSharesRequest, opts ...grpc.CallOption) (*QueryCalcJoinPoolSharesResponse, error) {
-	out := new(QueryCalcJoinPoolSharesResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/CalcJoinPoolShares", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QuerySwapExactAmountOutRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySwapExactAmountOutRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *queryClient) CalcExitPoolCoinsFromShares(ctx context.Context, in *QueryCalcExitPoolCoinsFromSharesRequest, opts ...grpc.CallOption) (*QueryCalcExitPoolCoinsFromSharesResponse, error) {
-	out := new(QueryCalcExitPoolCoinsFromSharesResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/CalcExitPoolCoinsFromShares", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QuerySwapExactAmountOutRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySwapExactAmountOutRequest.Merge(m, src)
 }
-
-func (c *queryClient) PoolParams(ctx context.Context, in *QueryPoolParamsRequest, opts ...grpc.CallOption) (*QueryPoolParamsResponse, error) {
-	out := new(QueryPoolParamsResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/PoolParams", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QuerySwapExactAmountOutRequest) XXX_Size() int {
+	return m.Size()
 }
-
-// Deprecated: Do not use.
-func (c *queryClient) TotalPoolLiquidity(ctx context.Context, in *QueryTotalPoolLiquidityRequest, opts ...grpc.CallOption) (*QueryTotalPoolLiquidityResponse, error) {
-	out := new(QueryTotalPoolLiquidityResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/TotalPoolLiquidity", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QuerySwapExactAmountOutRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySwapExactAmountOutRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) TotalShares(ctx context.Context, in *QueryTotalSharesRequest, opts ...grpc.CallOption) (*QueryTotalSharesResponse, error) {
-	out := new(QueryTotalSharesResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/TotalShares", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
+var xxx_messageInfo_QuerySwapExactAmountOutRequest proto.InternalMessageInfo
 
-// Deprecated: Do not use.
-func (c *queryClient) SpotPrice(ctx context.Context, in *QuerySpotPriceRequest, opts ...grpc.CallOption) (*QuerySpotPriceResponse, error) {
-	out := new(QuerySpotPriceResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/SpotPrice", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QuerySwapExactAmountOutRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return out, nil
+	return ""
 }
 
-// Deprecated: Do not use.
-func (c *queryClient) EstimateSwapExactAmountIn(ctx context.Context, in *QuerySwapExactAmountInRequest, opts ...grpc.CallOption) (*QuerySwapExactAmountInResponse, error) {
-	out := new(QuerySwapExactAmountInResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/EstimateSwapExactAmountIn", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QuerySwapExactAmountOutRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	return out, nil
+	return 0
 }
 
-// Deprecated: Do not use.
-func (c *queryClient) EstimateSwapExactAmountOut(ctx context.Context, in *QuerySwapExactAmountOutRequest, opts ...grpc.CallOption) (*QuerySwapExactAmountOutResponse, error) {
-	out := new(QuerySwapExactAmountOutResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/EstimateSwapExactAmountOut", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QuerySwapExactAmountOutRequest) GetRoutes() []types2.SwapAmountOutRoute {
+	if m != nil {
+		return m.Routes
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) ConcentratedPoolIdLinkFromCFMM(ctx context.Context, in *QueryConcentratedPoolIdLinkFromCFMMRequest, opts ...grpc.CallOption) (*QueryConcentratedPoolIdLinkFromCFMMResponse, error) {
-	out := new(QueryConcentratedPoolIdLinkFromCFMMResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/ConcentratedPoolIdLinkFromCFMM", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QuerySwapExactAmountOutRequest) GetTokenOut() string {
+	if m != nil {
+		return m.TokenOut
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) CFMMConcentratedPoolLinks(ctx context.Context, in *QueryCFMMConcentratedPoolLinksRequest, opts ...grpc.CallOption) (*QueryCFMMConcentratedPoolLinksResponse, error) {
-	out := new(QueryCFMMConcentratedPoolLinksResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/CFMMConcentratedPoolLinks", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// Deprecated: Do not use.
+type QuerySwapExactAmountOutResponse struct {
+	TokenInAmount cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=token_in_amount,json=tokenInAmount,proto3,customtype=cosmossdk.io/math.Int" json:"token_in_amount" yaml:"token_in_amount"`
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	Pools(context.Context, *QueryPoolsRequest) (*QueryPoolsResponse, error)
-	// Deprecated: please use the alternative in x/poolmanager
-	NumPools(context.Context, *QueryNumPoolsRequest) (*QueryNumPoolsResponse, error)
-	TotalLiquidity(context.Context, *QueryTotalLiquidityRequest) (*QueryTotalLiquidityResponse, error)
-	// PoolsWithFilter allows you to query specific pools with requested
-	// parameters
-	PoolsWithFilter(context.Context, *QueryPoolsWithFilterRequest) (*QueryPoolsWithFilterResponse, error)
-	// Deprecated: please use the alternative in x/poolmanager
-	Pool(context.Context, *QueryPoolRequest) (*QueryPoolResponse, error)
-	// PoolType returns the type of the pool.
-	// Returns "Balancer" as a string literal when the pool is a balancer pool.
-	// Errors if the pool is failed to be type caseted.
-	PoolType(context.Context, *QueryPoolTypeRequest) (*QueryPoolTypeResponse, error)
-	// Simulates joining pool without a swap. Returns the amount of shares you'd
-	// get and tokens needed to provide
-	CalcJoinPoolNoSwapShares(context.Context, *QueryCalcJoinPoolNoSwapSharesRequest) (*QueryCalcJoinPoolNoSwapSharesResponse, error)
-	CalcJoinPoolShares(context.Context, *QueryCalcJoinPoolSharesRequest) (*QueryCalcJoinPoolSharesResponse, error)
-	CalcExitPoolCoinsFromShares(context.Context, *QueryCalcExitPoolCoinsFromSharesRequest) (*QueryCalcExitPoolCoinsFromSharesResponse, error)
-	PoolParams(context.Context, *QueryPoolParamsRequest) (*QueryPoolParamsResponse, error)
-	// Deprecated: please use the alternative in x/poolmanager
-	TotalPoolLiquidity(context.Context, *QueryTotalPoolLiquidityRequest) (*QueryTotalPoolLiquidityResponse, error)
-	TotalShares(context.Context, *QueryTotalSharesRequest) (*QueryTotalSharesResponse, error)
-	// SpotPrice defines a gRPC query handler that returns the spot price given
-	// a base denomination and a quote denomination.
-	SpotPrice(context.Context, *QuerySpotPriceRequest) (*QuerySpotPriceResponse, error)
-	// Deprecated: please use the alternative in x/poolmanager
-	EstimateSwapExactAmountIn(context.Context, *QuerySwapExactAmountInRequest) (*QuerySwapExactAmountInResponse, error)
-	// Deprecated: please use the alternative in x/poolmanager
-	EstimateSwapExactAmountOut(context.Context, *QuerySwapExactAmountOutRequest) (*QuerySwapExactAmountOutResponse, error)
-	// ConcentratedPoolIdLinkFromBalancer returns the pool id of the concentrated
-	// pool that is linked with the given CFMM pool.
-	ConcentratedPoolIdLinkFromCFMM(context.Context, *QueryConcentratedPoolIdLinkFromCFMMRequest) (*QueryConcentratedPoolIdLinkFromCFMMResponse, error)
-	// CFMMConcentratedPoolLinks returns migration links between CFMM and
-	// Concentrated pools.
-	CFMMConcentratedPoolLinks(context.Context, *QueryCFMMConcentratedPoolLinksRequest) (*QueryCFMMConcentratedPoolLinksResponse, error)
+func (m *QuerySwapExactAmountOutResponse) Reset()         { *m = QuerySwapExactAmountOutResponse{} }
+func (m *QuerySwapExactAmountOutResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySwapExactAmountOutResponse) ProtoMessage()    {}
+func (*QuerySwapExactAmountOutResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a717df9ca609ef, []int{36}
 }
-
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QuerySwapExactAmountOutResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (*UnimplementedQueryServer) Pools(ctx context.Context, req *QueryPoolsRequest) (*QueryPoolsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Pools not implemented")
+func (m *QuerySwapExactAmountOutResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySwapExactAmountOutResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) NumPools(ctx context.Context, req *QueryNumPoolsRequest) (*QueryNumPoolsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method NumPools not implemented")
+func (m *QuerySwapExactAmountOutResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySwapExactAmountOutResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) TotalLiquidity(ctx context.Context, req *QueryTotalLiquidityRequest) (*QueryTotalLiquidityResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TotalLiquidity not implemented")
+func (m *QuerySwapExactAmountOutResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) PoolsWithFilter(ctx context.Context, req *QueryPoolsWithFilterRequest) (*QueryPoolsWithFilterResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PoolsWithFilter not implemented")
+func (m *QuerySwapExactAmountOutResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySwapExactAmountOutResponse.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) Pool(ctx context.Context, req *QueryPoolRequest) (*QueryPoolResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Pool not implemented")
+
+var xxx_messageInfo_QuerySwapExactAmountOutResponse proto.InternalMessageInfo
+
+type QueryTotalLiquidityRequest struct {
 }
-func (*UnimplementedQueryServer) PoolType(ctx context.Context, req *QueryPoolTypeRequest) (*QueryPoolTypeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PoolType not implemented")
+
+func (m *QueryTotalLiquidityRequest) Reset()         { *m = QueryTotalLiquidityRequest{} }
+func (m *QueryTotalLiquidityRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTotalLiquidityRequest) ProtoMessage()    {}
+func (*QueryTotalLiquidityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a717df9ca609ef, []int{37}
 }
-func (*UnimplementedQueryServer) CalcJoinPoolNoSwapShares(ctx context.Context, req *QueryCalcJoinPoolNoSwapSharesRequest) (*QueryCalcJoinPoolNoSwapSharesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CalcJoinPoolNoSwapShares not implemented")
+func (m *QueryTotalLiquidityRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) CalcJoinPoolShares(ctx context.Context, req *QueryCalcJoinPoolSharesRequest) (*QueryCalcJoinPoolSharesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CalcJoinPoolShares not implemented")
+func (m *QueryTotalLiquidityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTotalLiquidityRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) CalcExitPoolCoinsFromShares(ctx context.Context, req *QueryCalcExitPoolCoinsFromSharesRequest) (*QueryCalcExitPoolCoinsFromSharesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CalcExitPoolCoinsFromShares not implemented")
+func (m *QueryTotalLiquidityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTotalLiquidityRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) PoolParams(ctx context.Context, req *QueryPoolParamsRequest) (*QueryPoolParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PoolParams not implemented")
+func (m *QueryTotalLiquidityRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) TotalPoolLiquidity(ctx context.Context, req *QueryTotalPoolLiquidityRequest) (*QueryTotalPoolLiquidityResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TotalPoolLiquidity not implemented")
+func (m *QueryTotalLiquidityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTotalLiquidityRequest.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) TotalShares(ctx context.Context, req *QueryTotalSharesRequest) (*QueryTotalSharesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TotalShares not implemented")
+
+var xxx_messageInfo_QueryTotalLiquidityRequest proto.InternalMessageInfo
+
+type QueryTotalLiquidityResponse struct {
+	Liquidity github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=liquidity,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"liquidity" yaml:"liquidity"`
 }
-func (*UnimplementedQueryServer) SpotPrice(ctx context.Context, req *QuerySpotPriceRequest) (*QuerySpotPriceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SpotPrice not implemented")
+
+func (m *QueryTotalLiquidityResponse) Reset()         { *m = QueryTotalLiquidityResponse{} }
+func (m *QueryTotalLiquidityResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTotalLiquidityResponse) ProtoMessage()    {}
+func (*QueryTotalLiquidityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a717df9ca609ef, []int{38}
 }
-func (*UnimplementedQueryServer) EstimateSwapExactAmountIn(ctx context.Context, req *QuerySwapExactAmountInRequest) (*QuerySwapExactAmountInResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EstimateSwapExactAmountIn not implemented")
+func (m *QueryTotalLiquidityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) EstimateSwapExactAmountOut(ctx context.Context, req *QuerySwapExactAmountOutRequest) (*QuerySwapExactAmountOutResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EstimateSwapExactAmountOut not implemented")
+func (m *QueryTotalLiquidityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTotalLiquidityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) ConcentratedPoolIdLinkFromCFMM(ctx context.Context, req *QueryConcentratedPoolIdLinkFromCFMMRequest) (*QueryConcentratedPoolIdLinkFromCFMMResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ConcentratedPoolIdLinkFromCFMM not implemented")
+func (m *QueryTotalLiquidityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTotalLiquidityResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) CFMMConcentratedPoolLinks(ctx context.Context, req *QueryCFMMConcentratedPoolLinksRequest) (*QueryCFMMConcentratedPoolLinksResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CFMMConcentratedPoolLinks not implemented")
+func (m *QueryTotalLiquidityResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryTotalLiquidityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTotalLiquidityResponse.DiscardUnknown(m)
 }
 
-func _Query_Pools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPoolsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Pools(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.gamm.v1beta1.Query/Pools",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Pools(ctx, req.(*QueryPoolsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
+var xxx_messageInfo_QueryTotalLiquidityResponse proto.InternalMessageInfo
 
-func _Query_NumPools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryNumPoolsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).NumPools(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.gamm.v1beta1.Query/NumPools",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).NumPools(ctx, req.(*QueryNumPoolsRequest))
+func (m *QueryTotalLiquidityResponse) GetLiquidity() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Liquidity
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_TotalLiquidity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTotalLiquidityRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).TotalLiquidity(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.gamm.v1beta1.Query/TotalLiquidity",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TotalLiquidity(ctx, req.(*QueryTotalLiquidityRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// =============================== QueryConcentratedPoolIdLinkFromCFMM
+type QueryConcentratedPoolIdLinkFromCFMMRequest struct {
+	CfmmPoolId uint64 `protobuf:"varint,1,opt,name=cfmm_pool_id,json=cfmmPoolId,proto3" json:"cfmm_pool_id,omitempty" yaml:"cfmm_pool_id"`
 }
 
-func _Query_PoolsWithFilter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPoolsWithFilterRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).PoolsWithFilter(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.gamm.v1beta1.Query/PoolsWithFilter",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PoolsWithFilter(ctx, req.(*QueryPoolsWithFilterRequest))
+func (m *QueryConcentratedPoolIdLinkFromCFMMRequest) Reset() {
+	*m = QueryConcentratedPoolIdLinkFromCFMMRequest{}
+}
+func (m *QueryConcentratedPoolIdLinkFromCFMMRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryConcentratedPoolIdLinkFromCFMMRequest) ProtoMessage() {}
+func (*QueryConcentratedPoolIdLinkFromCFMMRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a717df9ca609ef, []int{39}
+}
+func (m *QueryConcentratedPoolIdLinkFromCFMMRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConcentratedPoolIdLinkFromCFMMRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConcentratedPoolIdLinkFromCFMMRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryConcentratedPoolIdLinkFromCFMMRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConcentratedPoolIdLinkFromCFMMRequest.Merge(m, src)
+}
+func (m *QueryConcentratedPoolIdLinkFromCFMMRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConcentratedPoolIdLinkFromCFMMRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConcentratedPoolIdLinkFromCFMMRequest.DiscardUnknown(m)
 }
 
-func _Query_Pool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPoolRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Pool(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.gamm.v1beta1.Query/Pool",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Pool(ctx, req.(*QueryPoolRequest))
+var xxx_messageInfo_QueryConcentratedPoolIdLinkFromCFMMRequest proto.InternalMessageInfo
+
+func (m *QueryConcentratedPoolIdLinkFromCFMMRequest) GetCfmmPoolId() uint64 {
+	if m != nil {
+		return m.CfmmPoolId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_PoolType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPoolTypeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).PoolType(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.gamm.v1beta1.Query/PoolType",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PoolType(ctx, req.(*QueryPoolTypeRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryConcentratedPoolIdLinkFromCFMMResponse struct {
+	ConcentratedPoolId uint64 `protobuf:"varint,1,opt,name=concentrated_pool_id,json=concentratedPoolId,proto3" json:"concentrated_pool_id,omitempty"`
 }
 
-func _Query_CalcJoinPoolNoSwapShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryCalcJoinPoolNoSwapSharesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).CalcJoinPoolNoSwapShares(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.gamm.v1beta1.Query/CalcJoinPoolNoSwapShares",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).CalcJoinPoolNoSwapShares(ctx, req.(*QueryCalcJoinPoolNoSwapSharesRequest))
+func (m *QueryConcentratedPoolIdLinkFromCFMMResponse) Reset() {
+	*m = QueryConcentratedPoolIdLinkFromCFMMResponse{}
+}
+func (m *QueryConcentratedPoolIdLinkFromCFMMResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryConcentratedPoolIdLinkFromCFMMResponse) ProtoMessage() {}
+func (*QueryConcentratedPoolIdLinkFromCFMMResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a717df9ca609ef, []int{40}
+}
+func (m *QueryConcentratedPoolIdLinkFromCFMMResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConcentratedPoolIdLinkFromCFMMResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConcentratedPoolIdLinkFromCFMMResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryConcentratedPoolIdLinkFromCFMMResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConcentratedPoolIdLinkFromCFMMResponse.Merge(m, src)
+}
+func (m *QueryConcentratedPoolIdLinkFromCFMMResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConcentratedPoolIdLinkFromCFMMResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConcentratedPoolIdLinkFromCFMMResponse.DiscardUnknown(m)
 }
 
-func _Query_CalcJoinPoolShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryCalcJoinPoolSharesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).CalcJoinPoolShares(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.gamm.v1beta1.Query/CalcJoinPoolShares",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).CalcJoinPoolShares(ctx, req.(*QueryCalcJoinPoolSharesRequest))
+var xxx_messageInfo_QueryConcentratedPoolIdLinkFromCFMMResponse proto.InternalMessageInfo
+
+func (m *QueryConcentratedPoolIdLinkFromCFMMResponse) GetConcentratedPoolId() uint64 {
+	if m != nil {
+		return m.ConcentratedPoolId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_CalcExitPoolCoinsFromShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryCalcExitPoolCoinsFromSharesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).CalcExitPoolCoinsFromShares(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.gamm.v1beta1.Query/CalcExitPoolCoinsFromShares",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).CalcExitPoolCoinsFromShares(ctx, req.(*QueryCalcExitPoolCoinsFromSharesRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// =============================== QueryCFMMConcentratedPoolLinks
+type QueryCFMMConcentratedPoolLinksRequest struct {
 }
 
-func _Query_PoolParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPoolParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).PoolParams(ctx, in)
+func (m *QueryCFMMConcentratedPoolLinksRequest) Reset()         { *m = QueryCFMMConcentratedPoolLinksRequest{} }
+func (m *QueryCFMMConcentratedPoolLinksRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCFMMConcentratedPoolLinksRequest) ProtoMessage()    {}
+func (*QueryCFMMConcentratedPoolLinksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a717df9ca609ef, []int{41}
+}
+func (m *QueryCFMMConcentratedPoolLinksRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCFMMConcentratedPoolLinksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCFMMConcentratedPoolLinksRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.gamm.v1beta1.Query/PoolParams",
+}
+func (m *QueryCFMMConcentratedPoolLinksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCFMMConcentratedPoolLinksRequest.Merge(m, src)
+}
+func (m *QueryCFMMConcentratedPoolLinksRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCFMMConcentratedPoolLinksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCFMMConcentratedPoolLinksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCFMMConcentratedPoolLinksRequest proto.InternalMessageInfo
+
+type QueryCFMMConcentratedPoolLinksResponse struct {
+	MigrationRecords *migration.MigrationRecords `protobuf:"bytes,1,opt,name=migration_records,json=migrationRecords,proto3" json:"migration_records,omitempty"`
+}
+
+func (m *QueryCFMMConcentratedPoolLinksResponse) Reset() {
+	*m = QueryCFMMConcentratedPoolLinksResponse{}
+}
+func (m *QueryCFMMConcentratedPoolLinksResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCFMMConcentratedPoolLinksResponse) ProtoMessage()    {}
+func (*QueryCFMMConcentratedPoolLinksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a717df9ca609ef, []int{42}
+}
+func (m *QueryCFMMConcentratedPoolLinksResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCFMMConcentratedPoolLinksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCFMMConcentratedPoolLinksResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PoolParams(ctx, req.(*QueryPoolParamsRequest))
+}
+func (m *QueryCFMMConcentratedPoolLinksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCFMMConcentratedPoolLinksResponse.Merge(m, src)
+}
+func (m *QueryCFMMConcentratedPoolLinksResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCFMMConcentratedPoolLinksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCFMMConcentratedPoolLinksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCFMMConcentratedPoolLinksResponse proto.InternalMessageInfo
+
+func (m *QueryCFMMConcentratedPoolLinksResponse) GetMigrationRecords() *migration.MigrationRecords {
+	if m != nil {
+		return m.MigrationRecords
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_TotalPoolLiquidity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTotalPoolLiquidityRequest)
-	if err := dec(in); err != nil {
+func init() {
+	proto.RegisterType((*QueryPoolRequest)(nil), "osmosis.gamm.v1beta1.QueryPoolRequest")
+	proto.RegisterType((*QueryPoolResponse)(nil), "osmosis.gamm.v1beta1.QueryPoolResponse")
+	proto.RegisterType((*QueryPoolsRequest)(nil), "osmosis.gamm.v1beta1.QueryPoolsRequest")
+	proto.RegisterType((*QueryPoolsResponse)(nil), "osmosis.gamm.v1beta1.QueryPoolsResponse")
+	proto.RegisterType((*QueryNumPoolsRequest)(nil), "osmosis.gamm.v1beta1.QueryNumPoolsRequest")
+	proto.RegisterType((*QueryNumPoolsResponse)(nil), "osmosis.gamm.v1beta1.QueryNumPoolsResponse")
+	proto.RegisterType((*QueryPoolTypeRequest)(nil), "osmosis.gamm.v1beta1.QueryPoolTypeRequest")
+	proto.RegisterType((*QueryPoolTypeResponse)(nil), "osmosis.gamm.v1beta1.QueryPoolTypeResponse")
+	proto.RegisterType((*QueryCalcJoinPoolSharesRequest)(nil), "osmosis.gamm.v1beta1.QueryCalcJoinPoolSharesRequest")
+	proto.RegisterType((*QueryCalcJoinPoolSharesResponse)(nil), "osmosis.gamm.v1beta1.QueryCalcJoinPoolSharesResponse")
+	proto.RegisterType((*QueryCalcExitPoolCoinsFromSharesRequest)(nil), "osmosis.gamm.v1beta1.QueryCalcExitPoolCoinsFromSharesRequest")
+	proto.RegisterType((*QueryCalcExitPoolCoinsFromSharesResponse)(nil), "osmosis.gamm.v1beta1.QueryCalcExitPoolCoinsFromSharesResponse")
+	proto.RegisterType((*QueryCalcJoinSwapShareAmountOutRequest)(nil), "osmosis.gamm.v1beta1.QueryCalcJoinSwapShareAmountOutRequest")
+	proto.RegisterType((*QueryCalcJoinSwapShareAmountOutResponse)(nil), "osmosis.gamm.v1beta1.QueryCalcJoinSwapShareAmountOutResponse")
+	proto.RegisterType((*QueryCalcExitSwapExactAmountOutRequest)(nil), "osmosis.gamm.v1beta1.QueryCalcExitSwapExactAmountOutRequest")
+	proto.RegisterType((*QueryCalcExitSwapExactAmountOutResponse)(nil), "osmosis.gamm.v1beta1.QueryCalcExitSwapExactAmountOutResponse")
+	proto.RegisterType((*QueryPoolParamsRequest)(nil), "osmosis.gamm.v1beta1.QueryPoolParamsRequest")
+	proto.RegisterType((*QueryPoolParamsResponse)(nil), "osmosis.gamm.v1beta1.QueryPoolParamsResponse")
+	proto.RegisterType((*QueryPoolWeightsRequest)(nil), "osmosis.gamm.v1beta1.QueryPoolWeightsRequest")
+	proto.RegisterType((*PoolWeight)(nil), "osmosis.gamm.v1beta1.PoolWeight")
+	proto.RegisterType((*QueryPoolWeightsResponse)(nil), "osmosis.gamm.v1beta1.QueryPoolWeightsResponse")
+	proto.RegisterType((*QueryScalingFactorRateSourceRequest)(nil), "osmosis.gamm.v1beta1.QueryScalingFactorRateSourceRequest")
+	proto.RegisterType((*QueryScalingFactorRateSourceResponse)(nil), "osmosis.gamm.v1beta1.QueryScalingFactorRateSourceResponse")
+	proto.RegisterType((*QueryTotalPoolLiquidityRequest)(nil), "osmosis.gamm.v1beta1.QueryTotalPoolLiquidityRequest")
+	proto.RegisterType((*QueryTotalPoolLiquidityResponse)(nil), "osmosis.gamm.v1beta1.QueryTotalPoolLiquidityResponse")
+	proto.RegisterType((*QueryTotalSharesRequest)(nil), "osmosis.gamm.v1beta1.QueryTotalSharesRequest")
+	proto.RegisterType((*QueryTotalSharesResponse)(nil), "osmosis.gamm.v1beta1.QueryTotalSharesResponse")
+	proto.RegisterType((*QueryCalcJoinPoolNoSwapSharesRequest)(nil), "osmosis.gamm.v1beta1.QueryCalcJoinPoolNoSwapSharesRequest")
+	proto.RegisterType((*QueryCalcJoinPoolNoSwapSharesResponse)(nil), "osmosis.gamm.v1beta1.QueryCalcJoinPoolNoSwapSharesResponse")
+	proto.RegisterType((*QuerySpotPriceRequest)(nil), "osmosis.gamm.v1beta1.QuerySpotPriceRequest")
+	proto.RegisterType((*QueryPoolsWithFilterRequest)(nil), "osmosis.gamm.v1beta1.QueryPoolsWithFilterRequest")
+	proto.RegisterType((*QueryPoolsWithFilterResponse)(nil), "osmosis.gamm.v1beta1.QueryPoolsWithFilterResponse")
+	proto.RegisterType((*QuerySpotPriceResponse)(nil), "osmosis.gamm.v1beta1.QuerySpotPriceResponse")
+	proto.RegisterType((*QuerySwapExactAmountInRequest)(nil), "osmosis.gamm.v1beta1.QuerySwapExactAmountInRequest")
+	proto.RegisterType((*QuerySwapExactAmountInResponse)(nil), "osmosis.gamm.v1beta1.QuerySwapExactAmountInResponse")
+	proto.RegisterType((*QuerySwapExactAmountOutRequest)(nil), "osmosis.gamm.v1beta1.QuerySwapExactAmountOutRequest")
+	proto.RegisterType((*QuerySwapExactAmountOutResponse)(nil), "osmosis.gamm.v1beta1.QuerySwapExactAmountOutResponse")
+	proto.RegisterType((*QueryTotalLiquidityRequest)(nil), "osmosis.gamm.v1beta1.QueryTotalLiquidityRequest")
+	proto.RegisterType((*QueryTotalLiquidityResponse)(nil), "osmosis.gamm.v1beta1.QueryTotalLiquidityResponse")
+	proto.RegisterType((*QueryConcentratedPoolIdLinkFromCFMMRequest)(nil), "osmosis.gamm.v1beta1.QueryConcentratedPoolIdLinkFromCFMMRequest")
+	proto.RegisterType((*QueryConcentratedPoolIdLinkFromCFMMResponse)(nil), "osmosis.gamm.v1beta1.QueryConcentratedPoolIdLinkFromCFMMResponse")
+	proto.RegisterType((*QueryCFMMConcentratedPoolLinksRequest)(nil), "osmosis.gamm.v1beta1.QueryCFMMConcentratedPoolLinksRequest")
+	proto.RegisterType((*QueryCFMMConcentratedPoolLinksResponse)(nil), "osmosis.gamm.v1beta1.QueryCFMMConcentratedPoolLinksResponse")
+}
+
+func init() { proto.RegisterFile("osmosis/gamm/v1beta1/query.proto", fileDescriptor_d9a717df9ca609ef) }
+
+var fileDescriptor_d9a717df9ca609ef = []byte{
+	// 2605 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0x4b, 0x6c, 0x1c, 0xc7,
+	0xd1, 0xd6, 0x90, 0x14, 0x4d, 0x16, 0x29, 0x3e, 0xda, 0xa4, 0x48, 0x0d, 0x25, 0xae, 0xdc, 0xb6,
+	0x49, 0x59, 0x22, 0x77, 0x45, 0x8a, 0x84, 0x6d, 0xfe, 0x92, 0x25, 0x91, 0x22, 0x25, 0x0a, 0x7a,
+	0x79, 0x28, 0xc0, 0xf8, 0x13, 0x38, 0x83, 0xe1, 0xee, 0x70, 0x39, 0xd6, 0xce, 0xcc, 0x6a, 0xa6,
+	0xd7, 0x12, 0xe1, 0x08, 0x06, 0x72, 0x08, 0xe4, 0x5c, 0x6c, 0xc0, 0x89, 0x4f, 0x41, 0x72, 0x88,
+	0x11, 0x04, 0x39, 0x07, 0xc8, 0x29, 0x87, 0x20, 0x17, 0x21, 0x27, 0x21, 0x0e, 0x90, 0xc0, 0x87,
+	0x75, 0x20, 0x25, 0x01, 0x02, 0xe4, 0xc4, 0x4b, 0x80, 0x9c, 0x82, 0xee, 0xae, 0x79, 0xec, 0xec,
+	0x7b, 0x65, 0x01, 0x3a, 0x89, 0xdb, 0x5d, 0x8f, 0xaf, 0x1e, 0xdd, 0x5d, 0x55, 0x23, 0x38, 0xee,
+	0xfa, 0xb6, 0xeb, 0x5b, 0x7e, 0x26, 0x6f, 0xd8, 0x76, 0xe6, 0xc3, 0x85, 0x6d, 0x93, 0x19, 0x0b,
+	0x99, 0xbb, 0x25, 0xd3, 0xdb, 0x4b, 0x17, 0x3d, 0x97, 0xb9, 0x64, 0x0c, 0x29, 0xd2, 0x9c, 0x22,
+	0x8d, 0x14, 0xea, 0x58, 0xde, 0xcd, 0xbb, 0x82, 0x20, 0xc3, 0xff, 0x92, 0xb4, 0xea, 0xb1, 0x9a,
+	0xd2, 0xd8, 0x7d, 0xdc, 0x9e, 0x0b, 0xb6, 0x8b, 0xae, 0x5b, 0xb0, 0x0d, 0xc7, 0xc8, 0x9b, 0x5e,
+	0x48, 0xe5, 0xdf, 0x33, 0x8a, 0xba, 0xe7, 0x96, 0x98, 0x89, 0xd4, 0xd3, 0x59, 0x41, 0x9e, 0xd9,
+	0x36, 0x7c, 0x33, 0xa4, 0xca, 0xba, 0x96, 0x83, 0xfb, 0x27, 0xe3, 0xfb, 0x02, 0x71, 0x48, 0x55,
+	0x34, 0xf2, 0x96, 0x63, 0x30, 0xcb, 0x0d, 0x68, 0x8f, 0xe6, 0x5d, 0x37, 0x5f, 0x30, 0x33, 0x46,
+	0xd1, 0xca, 0x18, 0x8e, 0xe3, 0x32, 0xb1, 0xe9, 0xe3, 0xee, 0x11, 0xdc, 0x15, 0xbf, 0xb6, 0x4b,
+	0x3b, 0x19, 0xc3, 0x41, 0xeb, 0xd5, 0x54, 0x72, 0x8b, 0x59, 0xb6, 0xe9, 0x33, 0xc3, 0x2e, 0x06,
+	0xbc, 0x12, 0x85, 0x2e, 0x7d, 0x21, 0x7f, 0xe0, 0xd6, 0x2b, 0x35, 0xbd, 0xe1, 0xef, 0x1a, 0x9e,
+	0x99, 0x43, 0x92, 0xe5, 0xda, 0x24, 0x59, 0xa3, 0x60, 0x39, 0x79, 0x7d, 0xc7, 0xc8, 0x32, 0xd7,
+	0xd3, 0x3d, 0x83, 0x99, 0xba, 0xef, 0x96, 0xbc, 0x2c, 0xba, 0x86, 0xae, 0xc1, 0xc8, 0xbb, 0xdc,
+	0xe0, 0x5b, 0xae, 0x5b, 0xd0, 0xcc, 0xbb, 0x25, 0xd3, 0x67, 0xe4, 0x14, 0xbc, 0xc4, 0xdd, 0xaa,
+	0x5b, 0xb9, 0x49, 0xe5, 0xb8, 0x72, 0xa2, 0x67, 0x95, 0xec, 0x97, 0x53, 0x43, 0x7b, 0x86, 0x5d,
+	0x58, 0xa1, 0xb8, 0x41, 0xb5, 0x5e, 0xfe, 0xd7, 0x66, 0x6e, 0xa5, 0x6b, 0x52, 0xa1, 0xd7, 0x60,
+	0x34, 0x26, 0xc4, 0x2f, 0xba, 0x8e, 0x6f, 0x92, 0x33, 0xd0, 0xc3, 0x49, 0x84, 0x88, 0x81, 0xc5,
+	0xb1, 0xb4, 0x34, 0x3f, 0x1d, 0x98, 0x9f, 0xbe, 0xe8, 0xec, 0xad, 0xf6, 0xff, 0xf1, 0x37, 0xf3,
+	0x07, 0x39, 0xd7, 0xa6, 0x26, 0x88, 0x85, 0xb4, 0xef, 0xc6, 0xa4, 0xf9, 0x01, 0xa6, 0x0d, 0x80,
+	0x28, 0x14, 0x93, 0x5d, 0x42, 0xe6, 0x4c, 0x1a, 0x9d, 0xc4, 0xe3, 0x96, 0x96, 0x99, 0x86, 0x86,
+	0xa7, 0x6f, 0x19, 0x79, 0x13, 0x79, 0xb5, 0x18, 0x27, 0xfd, 0xb1, 0x02, 0x24, 0x2e, 0x1d, 0xc1,
+	0x2e, 0xc3, 0x41, 0xae, 0xdf, 0x9f, 0x54, 0x8e, 0x77, 0xb7, 0x82, 0x56, 0x52, 0x93, 0xcb, 0x35,
+	0x50, 0xcd, 0x36, 0x45, 0x25, 0x75, 0x56, 0xc0, 0x52, 0x61, 0x4c, 0xa0, 0xba, 0x51, 0xb2, 0xe3,
+	0x66, 0x0b, 0x7f, 0xdc, 0x80, 0xf1, 0xc4, 0x1e, 0x82, 0x5e, 0x80, 0x7e, 0xa7, 0x64, 0xeb, 0x01,
+	0x70, 0x1e, 0xa9, 0xb1, 0xfd, 0x72, 0x6a, 0x44, 0x46, 0x2a, 0xdc, 0xa2, 0x5a, 0x9f, 0x83, 0xac,
+	0x42, 0xde, 0x1a, 0xea, 0xe2, 0x2b, 0xb7, 0xf7, 0x8a, 0x66, 0x27, 0x61, 0xa7, 0x57, 0x61, 0x3c,
+	0x21, 0x24, 0x02, 0x25, 0x88, 0xd9, 0x5e, 0xd1, 0x14, 0x72, 0xfa, 0xe3, 0xa0, 0xc2, 0x2d, 0xaa,
+	0xf5, 0x15, 0x91, 0x95, 0xfe, 0x56, 0x81, 0x69, 0x21, 0x6c, 0xcd, 0x28, 0x64, 0xaf, 0xba, 0x96,
+	0xc3, 0x85, 0x6e, 0xf1, 0xe4, 0xf6, 0x3b, 0xc1, 0x46, 0x76, 0xa1, 0x9f, 0xb9, 0x77, 0x4c, 0xc7,
+	0xd7, 0x2d, 0x1e, 0x14, 0x1e, 0xd0, 0x23, 0x15, 0x41, 0x09, 0xc2, 0xb1, 0xe6, 0x5a, 0xce, 0xea,
+	0xe9, 0x47, 0xe5, 0xd4, 0x81, 0x5f, 0x7f, 0x93, 0x3a, 0x91, 0xb7, 0xd8, 0x6e, 0x69, 0x3b, 0x9d,
+	0x75, 0x6d, 0x3c, 0x7c, 0xf8, 0xcf, 0xbc, 0x9f, 0xbb, 0x93, 0xe1, 0x98, 0x7d, 0xc1, 0xe0, 0x6b,
+	0x7d, 0x52, 0xfa, 0xa6, 0x43, 0x1f, 0x77, 0x41, 0xaa, 0x2e, 0x72, 0x74, 0xc8, 0x36, 0x8c, 0x88,
+	0x83, 0xaa, 0xbb, 0x25, 0xa6, 0x1b, 0xb6, 0x5b, 0x72, 0x18, 0xfa, 0xe5, 0x2d, 0xae, 0xf9, 0xeb,
+	0x72, 0x6a, 0x5c, 0xea, 0xf1, 0x73, 0x77, 0xd2, 0x96, 0x9b, 0xb1, 0x0d, 0xb6, 0x9b, 0xde, 0x74,
+	0xd8, 0x7e, 0x39, 0x35, 0x21, 0x0d, 0x4c, 0xb2, 0x53, 0x6d, 0x48, 0x2c, 0xdd, 0x2c, 0xb1, 0x8b,
+	0x62, 0x81, 0x7c, 0x00, 0x80, 0x16, 0xbb, 0x25, 0xf6, 0x3c, 0x4c, 0x46, 0x87, 0xde, 0x2c, 0x31,
+	0xf2, 0x3e, 0x0c, 0x16, 0x3d, 0x2b, 0x6b, 0xea, 0x96, 0x5d, 0x34, 0xb2, 0x6c, 0xb2, 0x5b, 0xd8,
+	0xb2, 0x82, 0xb6, 0x4c, 0x55, 0xdb, 0x72, 0xcd, 0xcc, 0x1b, 0xd9, 0xbd, 0x4b, 0x66, 0x76, 0xbf,
+	0x9c, 0x7a, 0x19, 0x43, 0x16, 0x13, 0x40, 0xb5, 0x01, 0xf1, 0x73, 0x53, 0xfe, 0xfa, 0x44, 0x81,
+	0xd9, 0xd0, 0xa5, 0xeb, 0xf7, 0x2d, 0xc6, 0x5d, 0x2a, 0x40, 0x6c, 0x78, 0xae, 0x5d, 0x99, 0x15,
+	0x13, 0x89, 0xac, 0x08, 0x33, 0x60, 0x1d, 0x86, 0xa5, 0xd3, 0x2c, 0x27, 0x70, 0x79, 0x97, 0x80,
+	0x79, 0xac, 0xa1, 0xcb, 0xb5, 0x43, 0x82, 0x6b, 0xd3, 0x91, 0x6e, 0xa5, 0x5f, 0x28, 0x70, 0xa2,
+	0x39, 0x16, 0x8c, 0x73, 0x65, 0x0c, 0x94, 0xe7, 0x19, 0x03, 0xfa, 0x5f, 0x05, 0x66, 0x2a, 0xf2,
+	0x6e, 0xeb, 0x9e, 0x51, 0x14, 0x78, 0x24, 0xf2, 0x9b, 0x25, 0xd6, 0xd1, 0xc9, 0x39, 0x0f, 0x43,
+	0x42, 0x09, 0xf7, 0x5b, 0xce, 0x74, 0x5c, 0x1b, 0xdd, 0x76, 0x64, 0xbf, 0x9c, 0x1a, 0x97, 0x3c,
+	0x95, 0xfb, 0x54, 0x1b, 0x14, 0x0b, 0x9b, 0xce, 0x25, 0xfe, 0xb3, 0x66, 0xb2, 0x77, 0x7f, 0xbb,
+	0xc9, 0x4e, 0xff, 0x15, 0xcf, 0x90, 0x7a, 0xc6, 0x63, 0x50, 0x74, 0x18, 0x0e, 0x01, 0x57, 0x9c,
+	0xbd, 0x37, 0x9b, 0xc1, 0x39, 0x9c, 0x30, 0x37, 0x40, 0x73, 0x08, 0xed, 0xc5, 0x93, 0x97, 0x3c,
+	0x0d, 0x5d, 0xdf, 0xee, 0x69, 0x78, 0x18, 0x0f, 0x34, 0xcf, 0x40, 0x6e, 0xeb, 0xfa, 0x7d, 0x23,
+	0xcb, 0x9e, 0x2d, 0xd0, 0x0b, 0x78, 0x45, 0xe2, 0x7d, 0x91, 0xb8, 0xa5, 0xc3, 0x2d, 0x8a, 0x77,
+	0xdd, 0xcd, 0x52, 0xc2, 0xed, 0xf5, 0xa0, 0x44, 0x6e, 0x4f, 0x9e, 0xbf, 0xf6, 0xdc, 0x9e, 0xe0,
+	0xa6, 0x89, 0x93, 0xf9, 0xbc, 0xdd, 0xbe, 0x0e, 0x87, 0xc3, 0xd7, 0xed, 0x96, 0xe1, 0x19, 0x76,
+	0x47, 0x0f, 0x11, 0xbd, 0x0c, 0x13, 0x55, 0x62, 0xd0, 0x43, 0x73, 0xd0, 0x5b, 0x14, 0x2b, 0x8d,
+	0xea, 0x23, 0x0d, 0x69, 0xe8, 0x46, 0x4c, 0xd0, 0x7b, 0xa6, 0x95, 0xdf, 0x65, 0x9d, 0x01, 0xfa,
+	0x3e, 0x40, 0x24, 0x82, 0xcc, 0xc0, 0x41, 0x79, 0xc8, 0x65, 0x6c, 0x46, 0xf6, 0xcb, 0xa9, 0x41,
+	0xc9, 0x88, 0x67, 0x5b, 0x6e, 0x93, 0x0d, 0xe8, 0xbd, 0x27, 0x38, 0xd0, 0xcd, 0xe9, 0x66, 0x41,
+	0x3c, 0x24, 0xa5, 0x48, 0x26, 0xaa, 0x21, 0x37, 0xfd, 0xbc, 0x1b, 0x26, 0xab, 0xcd, 0x40, 0x87,
+	0x58, 0x30, 0x9c, 0x2d, 0x79, 0x9e, 0xe9, 0x30, 0x5d, 0x92, 0x07, 0xb5, 0xd8, 0xf1, 0x74, 0xad,
+	0xb6, 0x21, 0x1d, 0xc9, 0x58, 0x9d, 0xe6, 0x78, 0xa2, 0xdc, 0x49, 0x88, 0xa1, 0xda, 0x10, 0xae,
+	0xa0, 0x4a, 0xb2, 0x03, 0x43, 0xcc, 0xf0, 0xf2, 0x66, 0xa4, 0xa9, 0xab, 0x45, 0x4d, 0xc7, 0x50,
+	0x53, 0x70, 0x17, 0x56, 0x48, 0xe1, 0x77, 0x83, 0x58, 0x08, 0xf4, 0xdc, 0x06, 0xf0, 0x99, 0xe1,
+	0x31, 0x9d, 0x57, 0xfa, 0xe2, 0x1a, 0x1c, 0x58, 0x54, 0xab, 0xe2, 0x7c, 0x3b, 0x68, 0x03, 0xc4,
+	0x2d, 0x3b, 0x8a, 0xf9, 0x1f, 0xf2, 0xd1, 0xcf, 0xbe, 0x49, 0x29, 0x5a, 0xbf, 0x58, 0xe0, 0xa4,
+	0xe4, 0x06, 0xf4, 0x99, 0x4e, 0x4e, 0xca, 0xec, 0x69, 0x2a, 0x73, 0x62, 0xbf, 0x9c, 0x1a, 0x96,
+	0x32, 0x03, 0x2e, 0x29, 0xf1, 0x25, 0xd3, 0xc9, 0x71, 0x32, 0xaa, 0xc1, 0xab, 0x22, 0x28, 0x5b,
+	0xb2, 0x55, 0xd8, 0x10, 0x9d, 0x82, 0x66, 0x30, 0x73, 0x4b, 0xf4, 0x09, 0x1d, 0xe5, 0xd9, 0x57,
+	0x0a, 0xbc, 0xd6, 0x58, 0x28, 0x46, 0x7d, 0x07, 0x06, 0x62, 0x3d, 0x09, 0x9e, 0x85, 0xf9, 0xda,
+	0x71, 0xa8, 0x23, 0x6b, 0xf5, 0xf0, 0x7e, 0x39, 0x45, 0x24, 0x90, 0x98, 0x2c, 0xaa, 0x81, 0x17,
+	0xd2, 0x90, 0x35, 0x18, 0xae, 0x6c, 0x85, 0x64, 0xcc, 0x7b, 0x56, 0xd5, 0xd8, 0x9d, 0x53, 0x49,
+	0xc0, 0x1f, 0x9e, 0xb8, 0x46, 0x9f, 0xbe, 0x8b, 0x65, 0xea, 0x6d, 0x97, 0x19, 0x05, 0x9e, 0x15,
+	0xd7, 0xac, 0xbb, 0x25, 0x2b, 0x67, 0xb1, 0xbd, 0x8e, 0x3b, 0xa7, 0x2f, 0x15, 0x48, 0xd5, 0x95,
+	0x89, 0x3e, 0x7a, 0x00, 0xfd, 0x85, 0x60, 0xb1, 0x79, 0x5d, 0x71, 0x09, 0x53, 0x14, 0xaf, 0xf2,
+	0x90, 0x93, 0xb6, 0x57, 0x6b, 0x84, 0x7c, 0x02, 0x66, 0x70, 0xff, 0x08, 0x94, 0x9d, 0x57, 0xe6,
+	0xb4, 0x04, 0x93, 0xd5, 0x72, 0xd0, 0xcc, 0xff, 0x87, 0x41, 0xc6, 0x97, 0x75, 0x71, 0xd3, 0x07,
+	0xf7, 0x62, 0x03, 0x4b, 0xa7, 0xd0, 0xd2, 0x97, 0x83, 0x47, 0x2b, 0x62, 0xa6, 0xda, 0x00, 0x8b,
+	0x54, 0xd0, 0xdf, 0x05, 0xe9, 0x18, 0x2f, 0xd3, 0x6f, 0xb8, 0x61, 0xdd, 0xf0, 0xa2, 0xb7, 0x19,
+	0xff, 0x54, 0xe0, 0xf5, 0x26, 0xf8, 0xd1, 0x89, 0x1f, 0xb7, 0x57, 0x84, 0xae, 0xa3, 0x0b, 0x47,
+	0x63, 0xef, 0xbe, 0x60, 0xa5, 0x9d, 0x76, 0x07, 0x67, 0x01, 0x64, 0x08, 0x62, 0x95, 0x45, 0x93,
+	0xa2, 0xbb, 0x5f, 0x32, 0xf0, 0x1a, 0xe3, 0xdf, 0x0a, 0xb6, 0x95, 0x5b, 0x45, 0x97, 0xdd, 0xe2,
+	0x0f, 0x72, 0x47, 0x91, 0x59, 0x87, 0x11, 0x6e, 0xab, 0x6e, 0xf8, 0xbe, 0xc9, 0x2a, 0x0a, 0xd9,
+	0xa9, 0xa8, 0xd0, 0x4c, 0x52, 0x50, 0x6d, 0x88, 0x2f, 0x5d, 0xe4, 0x2b, 0xb2, 0x98, 0xbd, 0x02,
+	0xa3, 0x77, 0x4b, 0x2e, 0xab, 0x94, 0x23, 0xab, 0xd9, 0xa3, 0xfb, 0xe5, 0xd4, 0xa4, 0x94, 0x53,
+	0x45, 0x42, 0xb5, 0x61, 0xb1, 0x16, 0x49, 0xe2, 0x67, 0xe8, 0x6a, 0x4f, 0x5f, 0xcf, 0xc8, 0x41,
+	0x6d, 0xe0, 0x9e, 0xc5, 0x76, 0x79, 0xe0, 0x36, 0x4c, 0x93, 0xfe, 0x5e, 0x81, 0xa9, 0x68, 0x18,
+	0xf1, 0x9e, 0xc5, 0x76, 0x37, 0xac, 0x02, 0x33, 0xbd, 0xc0, 0xe8, 0x73, 0x70, 0xc8, 0xb6, 0x1c,
+	0x3d, 0x7e, 0xfa, 0xb9, 0xf2, 0xc9, 0xfd, 0x72, 0x6a, 0x4c, 0x2a, 0xaf, 0xd8, 0xa6, 0xda, 0xa0,
+	0x6d, 0x39, 0xe1, 0x05, 0x42, 0xa6, 0xe2, 0xad, 0xb8, 0xb0, 0x3f, 0x6a, 0xba, 0x13, 0x03, 0x95,
+	0xee, 0x8e, 0x07, 0x2a, 0x3f, 0x53, 0xe0, 0x68, 0x6d, 0x1b, 0x5e, 0x90, 0xd1, 0x8a, 0x06, 0x87,
+	0x93, 0x29, 0x85, 0xc8, 0x96, 0x00, 0xfc, 0xa2, 0xcb, 0x74, 0x51, 0xf9, 0xa1, 0x6f, 0xc7, 0x63,
+	0x6f, 0x70, 0xb8, 0x47, 0xb5, 0x7e, 0x3f, 0xe0, 0x16, 0xf7, 0xe1, 0x8f, 0xba, 0xe0, 0x98, 0x14,
+	0x5a, 0x59, 0x03, 0x6f, 0x3a, 0x41, 0xe8, 0xde, 0x80, 0x5e, 0xdf, 0x74, 0x72, 0xa6, 0x87, 0x72,
+	0x47, 0xa3, 0xb2, 0x48, 0xae, 0x53, 0x0d, 0x09, 0xe2, 0xa9, 0xdd, 0xd5, 0x34, 0xb5, 0xd3, 0xd0,
+	0x17, 0xb4, 0x24, 0x98, 0x8a, 0x2f, 0x47, 0x2f, 0x7c, 0xb0, 0x43, 0xb5, 0x97, 0xb0, 0x4b, 0x21,
+	0xef, 0x43, 0xaf, 0x98, 0x84, 0xfa, 0x93, 0x3d, 0xc2, 0xfd, 0xe9, 0xf0, 0x6d, 0x8d, 0x4d, 0x4e,
+	0xa3, 0x27, 0xf6, 0x9e, 0x51, 0x0c, 0x2d, 0xe1, 0x6c, 0xab, 0xe3, 0x78, 0x43, 0x20, 0x76, 0x29,
+	0x8b, 0x6a, 0x28, 0x54, 0x38, 0xe3, 0x61, 0x30, 0xbe, 0xa9, 0xe1, 0x8c, 0x68, 0x06, 0x12, 0xf6,
+	0x14, 0x9d, 0xcd, 0x40, 0x92, 0xec, 0x54, 0x1b, 0x0a, 0x3a, 0x13, 0xa9, 0x4d, 0x40, 0xf9, 0xb4,
+	0xab, 0x36, 0x94, 0x58, 0x9b, 0xf4, 0xbc, 0x02, 0xf3, 0xbd, 0xd0, 0xd1, 0xdd, 0xc2, 0xd1, 0x99,
+	0x16, 0x1d, 0xcd, 0xa1, 0xb5, 0xe0, 0xe9, 0xca, 0x8e, 0xad, 0xa7, 0x95, 0x8e, 0x4d, 0x78, 0xe4,
+	0x87, 0x41, 0x81, 0xd1, 0xb8, 0x5b, 0x7b, 0xae, 0x4d, 0xb2, 0x00, 0x72, 0x14, 0xd4, 0xe8, 0xe9,
+	0x4f, 0x16, 0x4e, 0xf4, 0xa7, 0xc1, 0x4d, 0x98, 0xdc, 0x7e, 0x21, 0x6a, 0x20, 0x9a, 0x87, 0x93,
+	0xf2, 0xfd, 0x75, 0x9d, 0xac, 0xe9, 0x30, 0xcf, 0x60, 0x66, 0x4e, 0xdc, 0x56, 0xb9, 0x6b, 0x96,
+	0x73, 0x87, 0x0f, 0x83, 0xd6, 0x36, 0xae, 0x5f, 0x0f, 0x52, 0xec, 0x6d, 0x18, 0xcc, 0xee, 0xd8,
+	0xb6, 0x1e, 0x24, 0x8f, 0x7c, 0xb0, 0x26, 0xa2, 0x52, 0x25, 0xbe, 0x4b, 0x35, 0xe0, 0x3f, 0xa5,
+	0x34, 0xaa, 0xc3, 0xa9, 0x96, 0x14, 0xa1, 0x5b, 0x4e, 0xc3, 0x58, 0x36, 0x46, 0x59, 0xa9, 0x51,
+	0x23, 0xd9, 0x2a, 0x29, 0x74, 0x36, 0xa8, 0x24, 0x36, 0xae, 0x5f, 0x4f, 0x2a, 0xe1, 0x2a, 0x82,
+	0x52, 0x88, 0x3e, 0x80, 0x99, 0x66, 0x84, 0x08, 0x62, 0x0b, 0x46, 0x6d, 0x2b, 0xef, 0x89, 0xdb,
+	0x56, 0xf7, 0xcc, 0xac, 0xeb, 0xe5, 0x82, 0xea, 0x6d, 0xa6, 0x76, 0x25, 0x7f, 0x3d, 0x20, 0xd7,
+	0x24, 0xb5, 0x36, 0x62, 0x27, 0x56, 0x16, 0xff, 0x32, 0x0d, 0x07, 0x85, 0x7e, 0xf2, 0x31, 0x88,
+	0x87, 0xc1, 0x27, 0xb3, 0xb5, 0x85, 0x55, 0x7d, 0x2b, 0x50, 0x4f, 0x34, 0x27, 0x94, 0xd0, 0xe9,
+	0xab, 0x3f, 0xf8, 0xea, 0xef, 0x9f, 0x77, 0x1d, 0x23, 0x53, 0x99, 0x9a, 0x5f, 0x4f, 0xe4, 0x4b,
+	0xf4, 0xa9, 0x02, 0x7d, 0xc1, 0xec, 0x9d, 0x9c, 0x6c, 0x20, 0x3b, 0x31, 0xbc, 0x57, 0x4f, 0xb5,
+	0x44, 0x8b, 0x50, 0x4e, 0x0a, 0x28, 0xaf, 0x90, 0x54, 0x6d, 0x28, 0xe1, 0x34, 0xff, 0x61, 0x97,
+	0x42, 0xbe, 0x54, 0x60, 0xa8, 0xf2, 0xa0, 0x90, 0xd3, 0x0d, 0x74, 0xd5, 0x3c, 0x72, 0xea, 0x42,
+	0x1b, 0x1c, 0x88, 0x71, 0x5e, 0x60, 0x9c, 0x25, 0xaf, 0xd7, 0xc6, 0x28, 0x2b, 0xf0, 0xf0, 0xd4,
+	0x90, 0x5f, 0x2a, 0x30, 0x9c, 0xa8, 0x0a, 0xc8, 0x42, 0xb3, 0xd8, 0x54, 0x55, 0x41, 0xea, 0x62,
+	0x3b, 0x2c, 0x88, 0x74, 0x4e, 0x20, 0x9d, 0x21, 0xaf, 0xd5, 0x46, 0xba, 0x23, 0xa8, 0xf1, 0xc0,
+	0xf8, 0xe4, 0x13, 0x05, 0x7a, 0xb8, 0x24, 0x32, 0xd3, 0x44, 0x55, 0x00, 0x69, 0xb6, 0x29, 0x1d,
+	0xe2, 0x38, 0xdd, 0xd8, 0x63, 0x42, 0x7d, 0xe6, 0x23, 0x3c, 0xb6, 0x0f, 0x78, 0x6c, 0xbf, 0x50,
+	0xa0, 0x2f, 0xf8, 0xa8, 0xd2, 0x30, 0xdb, 0x12, 0x9f, 0x6f, 0xd4, 0x53, 0x2d, 0xd1, 0x22, 0xae,
+	0x05, 0x81, 0xeb, 0x14, 0x79, 0xa3, 0x3e, 0x2e, 0x51, 0x36, 0x46, 0xd8, 0xc8, 0x4f, 0x14, 0x98,
+	0xac, 0xd7, 0x7f, 0x90, 0x95, 0x06, 0xca, 0x9b, 0x34, 0x5d, 0xea, 0xff, 0x75, 0xc4, 0x8b, 0x86,
+	0x1c, 0x20, 0x7f, 0x50, 0x80, 0x54, 0x7f, 0x7e, 0x21, 0x4b, 0x2d, 0x4a, 0xad, 0xc4, 0xb2, 0xdc,
+	0x26, 0x17, 0xa2, 0xb8, 0x20, 0xdc, 0xb9, 0x42, 0xde, 0x6a, 0x29, 0xcc, 0x99, 0x0f, 0x5c, 0xcb,
+	0xd1, 0xc5, 0x57, 0x6a, 0x93, 0xbf, 0xc8, 0xba, 0xe5, 0x90, 0x7f, 0x28, 0x30, 0xd5, 0xe0, 0x2b,
+	0x03, 0x39, 0xd7, 0x04, 0x58, 0xe3, 0x2f, 0x25, 0xea, 0x3b, 0x9d, 0xb2, 0xa3, 0x81, 0x97, 0x85,
+	0x81, 0x17, 0xc9, 0xf9, 0xd6, 0x0c, 0x34, 0xef, 0x5b, 0x4c, 0x1a, 0x28, 0x07, 0xb9, 0xb2, 0x2e,
+	0xe0, 0x76, 0x3e, 0x55, 0x40, 0xad, 0x3f, 0xb7, 0x27, 0x67, 0x5b, 0xf0, 0x7f, 0xdd, 0x6f, 0x1d,
+	0xea, 0xb9, 0x0e, 0xb9, 0xd1, 0xc8, 0x2b, 0xc2, 0xc8, 0x55, 0x72, 0xa1, 0xdd, 0x28, 0x56, 0x18,
+	0xe9, 0x96, 0x58, 0x68, 0x65, 0xed, 0x31, 0x79, 0x53, 0x2b, 0x1b, 0x0e, 0xfa, 0xd5, 0x73, 0x1d,
+	0x72, 0x77, 0x66, 0x65, 0x14, 0x4a, 0x99, 0xab, 0x31, 0x2b, 0x7f, 0xae, 0x00, 0x44, 0xa3, 0x6d,
+	0x32, 0xd7, 0xe4, 0x02, 0xaa, 0x18, 0xa4, 0xab, 0xf3, 0x2d, 0x52, 0x23, 0xea, 0x25, 0x81, 0x3a,
+	0x4d, 0xe6, 0x5a, 0x43, 0x2d, 0xe7, 0xe6, 0xe4, 0x17, 0x0a, 0x0c, 0xc4, 0x86, 0xcd, 0xa4, 0x99,
+	0xd2, 0xca, 0xd9, 0xba, 0x9a, 0x6e, 0x95, 0x1c, 0x41, 0x2e, 0x0b, 0x90, 0x19, 0x32, 0xdf, 0x1a,
+	0x48, 0x9c, 0x1b, 0x93, 0xaf, 0x15, 0x98, 0xa8, 0x33, 0xdc, 0x24, 0x6f, 0x37, 0x80, 0xd0, 0x78,
+	0x62, 0xab, 0xae, 0x74, 0xc2, 0xda, 0x59, 0x92, 0xd4, 0xff, 0x6f, 0x26, 0xe4, 0x91, 0x02, 0xa4,
+	0x7a, 0xb8, 0xd9, 0xf0, 0x7a, 0xae, 0x3b, 0x5f, 0x55, 0x97, 0xdb, 0xe4, 0x42, 0x6b, 0xd6, 0x85,
+	0x35, 0x67, 0xc9, 0x4a, 0x6b, 0xd6, 0xc8, 0x3a, 0x46, 0xfc, 0x0c, 0x8b, 0x19, 0xfe, 0x34, 0xff,
+	0x4a, 0x81, 0x81, 0xd8, 0xe4, 0xb2, 0x61, 0x36, 0x55, 0x4f, 0x4a, 0xd5, 0x74, 0xab, 0xe4, 0x88,
+	0x7a, 0x45, 0xa0, 0x5e, 0x22, 0x8b, 0xed, 0xa0, 0x96, 0xb3, 0x34, 0x7e, 0x34, 0xfb, 0xc3, 0x81,
+	0x07, 0x69, 0x54, 0x1a, 0x24, 0x27, 0x6d, 0xea, 0x5c, 0x6b, 0xc4, 0x08, 0xf2, 0xcd, 0x36, 0xcf,
+	0x25, 0x67, 0x16, 0x35, 0xec, 0x63, 0x05, 0x8e, 0xac, 0xfb, 0xcc, 0xb2, 0x79, 0xf2, 0x25, 0x07,
+	0x07, 0xe4, 0x4c, 0x23, 0x10, 0x75, 0x66, 0x2e, 0xea, 0x52, 0x7b, 0x4c, 0x15, 0xa9, 0x7e, 0x9e,
+	0x9c, 0xab, 0x6d, 0x41, 0x84, 0xdd, 0x44, 0xb4, 0x99, 0xea, 0xdb, 0xd0, 0x72, 0xb8, 0x49, 0x7f,
+	0x56, 0x40, 0xad, 0x63, 0x12, 0xbf, 0xf5, 0xdb, 0x80, 0x17, 0xbb, 0xed, 0x97, 0xdb, 0xe4, 0x42,
+	0xab, 0x36, 0x85, 0x55, 0x17, 0xc8, 0x3b, 0xcf, 0x60, 0x95, 0x5b, 0x62, 0xdc, 0xac, 0xff, 0x28,
+	0x30, 0xdd, 0xb8, 0x1f, 0x25, 0x17, 0x1a, 0x3d, 0x49, 0xad, 0xf4, 0xcc, 0xea, 0xc5, 0x67, 0x90,
+	0x80, 0x26, 0xdf, 0x12, 0x26, 0x5f, 0x25, 0x57, 0x6a, 0x9b, 0x5c, 0xab, 0x51, 0xd6, 0x0b, 0x96,
+	0x73, 0x47, 0xdf, 0xf1, 0x5c, 0x5b, 0xe7, 0x4d, 0x78, 0xe6, 0xa3, 0x78, 0x67, 0xfe, 0x80, 0xfc,
+	0x49, 0x81, 0x23, 0x75, 0xfb, 0x5f, 0xd2, 0xb0, 0x6e, 0x6d, 0xd2, 0x5e, 0xab, 0x67, 0x3b, 0x63,
+	0x6e, 0xed, 0x6a, 0x10, 0x56, 0x54, 0xdb, 0xcb, 0x8d, 0xf5, 0x57, 0xaf, 0x3e, 0x7a, 0x32, 0xad,
+	0x3c, 0x7e, 0x32, 0xad, 0xfc, 0xed, 0xc9, 0xb4, 0xf2, 0xd9, 0xd3, 0xe9, 0x03, 0x8f, 0x9f, 0x4e,
+	0x1f, 0xf8, 0xeb, 0xd3, 0xe9, 0x03, 0xdf, 0x39, 0x1d, 0x1b, 0x8d, 0xa0, 0xdc, 0xf9, 0x82, 0xb1,
+	0xed, 0x87, 0x4a, 0x3e, 0x5c, 0x5c, 0xca, 0xdc, 0x97, 0xaa, 0xc4, 0xa0, 0x64, 0xbb, 0x57, 0x0c,
+	0x71, 0xcf, 0xfc, 0x6f, 0x00, 0x76, 0x8d, 0xb0, 0x92, 0xfc, 0x29, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	Pools(ctx context.Context, in *QueryPoolsRequest, opts ...grpc.CallOption) (*QueryPoolsResponse, error)
+	// Deprecated: please use the alternative in x/poolmanager
+	NumPools(ctx context.Context, in *QueryNumPoolsRequest, opts ...grpc.CallOption) (*QueryNumPoolsResponse, error)
+	TotalLiquidity(ctx context.Context, in *QueryTotalLiquidityRequest, opts ...grpc.CallOption) (*QueryTotalLiquidityResponse, error)
+	// PoolsWithFilter allows you to query specific pools with requested
+	// parameters
+	PoolsWithFilter(ctx context.Context, in *QueryPoolsWithFilterRequest, opts ...grpc.CallOption) (*QueryPoolsWithFilterResponse, error)
+	// Deprecated: please use the alternative in x/poolmanager
+	Pool(ctx context.Context, in *QueryPoolRequest, opts ...grpc.CallOption) (*QueryPoolResponse, error)
+	// PoolType returns the type of the pool.
+	// Returns "Balancer" as a string literal when the pool is a balancer pool.
+	// Errors if the pool is failed to be type caseted.
+	PoolType(ctx context.Context, in *QueryPoolTypeRequest, opts ...grpc.CallOption) (*QueryPoolTypeResponse, error)
+	// Simulates joining pool without a swap. Returns the amount of shares you'd
+	// get and tokens needed to provide
+	CalcJoinPoolNoSwapShares(ctx context.Context, in *QueryCalcJoinPoolNoSwapSharesRequest, opts ...grpc.CallOption) (*QueryCalcJoinPoolNoSwapSharesResponse, error)
+	CalcJoinPoolShares(ctx context.Context, in *QueryCalcJoinPoolSharesRequest, opts ...grpc.CallOption) (*QueryCalcJoinPoolSharesResponse, error)
+	CalcExitPoolCoinsFromShares(ctx context.Context, in *QueryCalcExitPoolCoinsFromSharesRequest, opts ...grpc.CallOption) (*QueryCalcExitPoolCoinsFromSharesResponse, error)
+	// CalcJoinSwapShareAmountOut simulates joining a pool with a single asset for
+	// an exact amount of shares. Returns the amount of tokens needed and the
+	// implied price impact of the join.
+	CalcJoinSwapShareAmountOut(ctx context.Context, in *QueryCalcJoinSwapShareAmountOutRequest, opts ...grpc.CallOption) (*QueryCalcJoinSwapShareAmountOutResponse, error)
+	// CalcExitSwapExactAmountOut simulates exiting a pool with a single asset
+	// for an exact amount of tokens. Returns the amount of shares needed and the
+	// implied price impact of the exit.
+	CalcExitSwapExactAmountOut(ctx context.Context, in *QueryCalcExitSwapExactAmountOutRequest, opts ...grpc.CallOption) (*QueryCalcExitSwapExactAmountOutResponse, error)
+	PoolParams(ctx context.Context, in *QueryPoolParamsRequest, opts ...grpc.CallOption) (*QueryPoolParamsResponse, error)
+	// PoolWeights returns the current weights of a balancer pool, and the
+	// weights it is changing to if a smooth weight change is scheduled or in
+	// progress.
+	PoolWeights(ctx context.Context, in *QueryPoolWeightsRequest, opts ...grpc.CallOption) (*QueryPoolWeightsResponse, error)
+	// ScalingFactorRateSource returns the on-chain rate source driving the
+	// scaling factors of a stableswap pool, if any.
+	ScalingFactorRateSource(ctx context.Context, in *QueryScalingFactorRateSourceRequest, opts ...grpc.CallOption) (*QueryScalingFactorRateSourceResponse, error)
+	// Deprecated: please use the alternative in x/poolmanager
+	TotalPoolLiquidity(ctx context.Context, in *QueryTotalPoolLiquidityRequest, opts ...grpc.CallOption) (*QueryTotalPoolLiquidityResponse, error)
+	TotalShares(ctx context.Context, in *QueryTotalSharesRequest, opts ...grpc.CallOption) (*QueryTotalSharesResponse, error)
+	// SpotPrice defines a gRPC query handler that returns the spot price given
+	// a base denomination and a quote denomination.
+	SpotPrice(ctx context.Context, in *QuerySpotPriceRequest, opts ...grpc.CallOption) (*QuerySpotPriceResponse, error)
+	// Deprecated: please use the alternative in x/poolmanager
+	EstimateSwapExactAmountIn(ctx context.Context, in *QuerySwapExactAmountInRequest, opts ...grpc.CallOption) (*QuerySwapExactAmountInResponse, error)
+	// Deprecated: please use the alternative in x/poolmanager
+	EstimateSwapExactAmountOut(ctx context.Context, in *QuerySwapExactAmountOutRequest, opts ...grpc.CallOption) (*QuerySwapExactAmountOutResponse, error)
+	// ConcentratedPoolIdLinkFromBalancer returns the pool id of the concentrated
+	// pool that is linked with the given CFMM pool.
+	ConcentratedPoolIdLinkFromCFMM(ctx context.Context, in *QueryConcentratedPoolIdLinkFromCFMMRequest, opts ...grpc.CallOption) (*QueryConcentratedPoolIdLinkFromCFMMResponse, error)
+	// CFMMConcentratedPoolLinks returns migration links between CFMM and
+	// Concentrated pools.
+	CFMMConcentratedPoolLinks(ctx context.Context, in *QueryCFMMConcentratedPoolLinksRequest, opts ...grpc.CallOption) (*QueryCFMMConcentratedPoolLinksResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Pools(ctx context.Context, in *QueryPoolsRequest, opts ...grpc.CallOption) (*QueryPoolsResponse, error) {
+	out := new(QueryPoolsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/Pools", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).TotalPoolLiquidity(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.gamm.v1beta1.Query/TotalPoolLiquidity",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TotalPoolLiquidity(ctx, req.(*QueryTotalPoolLiquidityRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_TotalShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTotalSharesRequest)
-	if err := dec(in); err != nil {
+// Deprecated: Do not use.
+func (c *queryClient) NumPools(ctx context.Context, in *QueryNumPoolsRequest, opts ...grpc.CallOption) (*QueryNumPoolsResponse, error) {
+	out := new(QueryNumPoolsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/NumPools", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).TotalShares(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.gamm.v1beta1.Query/TotalShares",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TotalShares(ctx, req.(*QueryTotalSharesRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_SpotPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuerySpotPriceRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) TotalLiquidity(ctx context.Context, in *QueryTotalLiquidityRequest, opts ...grpc.CallOption) (*QueryTotalLiquidityResponse, error) {
+	out := new(QueryTotalLiquidityResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/TotalLiquidity", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).SpotPrice(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.gamm.v1beta1.Query/SpotPrice",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).SpotPrice(ctx, req.(*QuerySpotPriceRequest))
+	return out, nil
+}
+
+func (c *queryClient) PoolsWithFilter(ctx context.Context, in *QueryPoolsWithFilterRequest, opts ...grpc.CallOption) (*QueryPoolsWithFilterResponse, error) {
+	out := new(QueryPoolsWithFilterResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/PoolsWithFilter", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_EstimateSwapExactAmountIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuerySwapExactAmountInRequest)
-	if err := dec(in); err != nil {
+// Deprecated: Do not use.
+func (c *queryClient) Pool(ctx context.Context, in *QueryPoolRequest, opts ...grpc.CallOption) (*QueryPoolResponse, error) {
+	out := new(QueryPoolResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/Pool", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).EstimateSwapExactAmountIn(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.gamm.v1beta1.Query/EstimateSwapExactAmountIn",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).EstimateSwapExactAmountIn(ctx, req.(*QuerySwapExactAmountInRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_EstimateSwapExactAmountOut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuerySwapExactAmountOutRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) PoolType(ctx context.Context, in *QueryPoolTypeRequest, opts ...grpc.CallOption) (*QueryPoolTypeResponse, error) {
+	out := new(QueryPoolTypeResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/PoolType", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).EstimateSwapExactAmountOut(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.gamm.v1beta1.Query/EstimateSwapExactAmountOut",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).EstimateSwapExactAmountOut(ctx, req.(*QuerySwapExactAmountOutRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_ConcentratedPoolIdLinkFromCFMM_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryConcentratedPoolIdLinkFromCFMMRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) CalcJoinPoolNoSwapShares(ctx context.Context, in *QueryCalcJoinPoolNoSwapSharesRequest, opts ...grpc.CallOption) (*QueryCalcJoinPoolNoSwapSharesResponse, error) {
+	out := new(QueryCalcJoinPoolNoSwapSharesResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/CalcJoinPoolNoSwapShares", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).ConcentratedPoolIdLinkFromCFMM(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.gamm.v1beta1.Query/ConcentratedPoolIdLinkFromCFMM",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ConcentratedPoolIdLinkFromCFMM(ctx, req.(*QueryConcentratedPoolIdLinkFromCFMMRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_CFMMConcentratedPoolLinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryCFMMConcentratedPoolLinksRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) CalcJoinPoolShares(ctx context.Context, in *QueryCalcJoinPoolSharesRequest, opts ...grpc.CallOption) (*QueryCalcJoinPoolSharesResponse, error) {
+	out := new(QueryCalcJoinPoolSharesResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/CalcJoinPoolShares", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).CFMMConcentratedPoolLinks(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.gamm.v1beta1.Query/CFMMConcentratedPoolLinks",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).CFMMConcentratedPoolLinks(ctx, req.(*QueryCFMMConcentratedPoolLinksRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "osmosis.gamm.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Pools",
-			Handler:    _Query_Pools_Handler,
-		},
-		{
-			MethodName: "NumPools",
-			Handler:    _Query_NumPools_Handler,
-		},
-		{
-			MethodName: "TotalLiquidity",
-			Handler:    _Query_TotalLiquidity_Handler,
-		},
-		{
-			MethodName: "PoolsWithFilter",
-			Handler:    _Query_PoolsWithFilter_Handler,
-		},
-		{
-			MethodName: "Pool",
-			Handler:    _Query_Pool_Handler,
-		},
-		{
-			MethodName: "PoolType",
-			Handler:    _Query_PoolType_Handler,
-		},
-		{
-			MethodName: "CalcJoinPoolNoSwapShares",
-			Handler:    _Query_CalcJoinPoolNoSwapShares_Handler,
-		},
-		{
-			MethodName: "CalcJoinPoolShares",
-			Handler:    _Query_CalcJoinPoolShares_Handler,
-		},
-		{
-			MethodName: "CalcExitPoolCoinsFromShares",
-			Handler:    _Query_CalcExitPoolCoinsFromShares_Handler,
-		},
-		{
-			MethodName: "PoolParams",
-			Handler:    _Query_PoolParams_Handler,
-		},
-		{
-			MethodName: "TotalPoolLiquidity",
-			Handler:    _Query_TotalPoolLiquidity_Handler,
-		},
-		{
-			MethodName: "TotalShares",
-			Handler:    _Query_TotalShares_Handler,
-		},
-		{
-			MethodName: "SpotPrice",
-			Handler:    _Query_SpotPrice_Handler,
-		},
-		{
-			MethodName: "EstimateSwapExactAmountIn",
-			Handler:    _Query_EstimateSwapExactAmountIn_Handler,
-		},
-		{
-			MethodName: "EstimateSwapExactAmountOut",
-			Handler:    _Query_EstimateSwapExactAmountOut_Handler,
-		},
-		{
-			MethodName: "ConcentratedPoolIdLinkFromCFMM",
-			Handler:    _Query_ConcentratedPoolIdLinkFromCFMM_Handler,
-		},
-		{
-			MethodName: "CFMMConcentratedPoolLinks",
-			Handler:    _Query_CFMMConcentratedPoolLinks_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "osmosis/gamm/v1beta1/query.proto",
+	return out, nil
 }
 
-func (m *QueryPoolRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) CalcExitPoolCoinsFromShares(ctx context.Context, in *QueryCalcExitPoolCoinsFromSharesRequest, opts ...grpc.CallOption) (*QueryCalcExitPoolCoinsFromSharesResponse, error) {
+	out := new(QueryCalcExitPoolCoinsFromSharesResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/CalcExitPoolCoinsFromShares", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryPoolRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
+func (c *queryClient) CalcJoinSwapShareAmountOut(ctx context.Context, in *QueryCalcJoinSwapShareAmountOutRequest, opts ...grpc.CallOption) (*QueryCalcJoinSwapShareAmountOutResponse, error) {
+	out := new(QueryCalcJoinSwapShareAmountOutResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/CalcJoinSwapShareAmountOut", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryPoolResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) CalcExitSwapExactAmountOut(ctx context.Context, in *QueryCalcExitSwapExactAmountOutRequest, opts ...grpc.CallOption) (*QueryCalcExitSwapExactAmountOutResponse, error) {
+	out := new(QueryCalcExitSwapExactAmountOutResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/CalcExitSwapExactAmountOut", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryPoolResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pool != nil {
-		{
-			size, err := m.Pool.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) PoolParams(ctx context.Context, in *QueryPoolParamsRequest, opts ...grpc.CallOption) (*QueryPoolParamsResponse, error) {
+	out := new(QueryPoolParamsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/PoolParams", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryPoolsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) PoolWeights(ctx context.Context, in *QueryPoolWeightsRequest, opts ...grpc.CallOption) (*QueryPoolWeightsResponse, error) {
+	out := new(QueryPoolWeightsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/PoolWeights", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryPoolsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ScalingFactorRateSource(ctx context.Context, in *QueryScalingFactorRateSourceRequest, opts ...grpc.CallOption) (*QueryScalingFactorRateSourceResponse, error) {
+	out := new(QueryScalingFactorRateSourceResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/ScalingFactorRateSource", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryPoolsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+// Deprecated: Do not use.
+func (c *queryClient) TotalPoolLiquidity(ctx context.Context, in *QueryTotalPoolLiquidityRequest, opts ...grpc.CallOption) (*QueryTotalPoolLiquidityResponse, error) {
+	out := new(QueryTotalPoolLiquidityResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/TotalPoolLiquidity", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryPoolsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) TotalShares(ctx context.Context, in *QueryTotalSharesRequest, opts ...grpc.CallOption) (*QueryTotalSharesResponse, error) {
+	out := new(QueryTotalSharesResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/TotalShares", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryPoolsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryPoolsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Pools) > 0 {
-		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+// Deprecated: Do not use.
+func (c *queryClient) SpotPrice(ctx context.Context, in *QuerySpotPriceRequest, opts ...grpc.CallOption) (*QuerySpotPriceResponse, error) {
+	out := new(QuerySpotPriceResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/SpotPrice", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryNumPoolsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+// Deprecated: Do not use.
+func (c *queryClient) EstimateSwapExactAmountIn(ctx context.Context, in *QuerySwapExactAmountInRequest, opts ...grpc.CallOption) (*QuerySwapExactAmountInResponse, error) {
+	out := new(QuerySwapExactAmountInResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/EstimateSwapExactAmountIn", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryNumPoolsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryNumPoolsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryNumPoolsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+// Deprecated: Do not use.
+func (c *queryClient) EstimateSwapExactAmountOut(ctx context.Context, in *QuerySwapExactAmountOutRequest, opts ...grpc.CallOption) (*QuerySwapExactAmountOutResponse, error) {
+	out := new(QuerySwapExactAmountOutResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/EstimateSwapExactAmountOut", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryNumPoolsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryNumPoolsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.NumPools != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.NumPools))
-		i--
-		dAtA[i] = 0x8
+func (c *queryClient) ConcentratedPoolIdLinkFromCFMM(ctx context.Context, in *QueryConcentratedPoolIdLinkFromCFMMRequest, opts ...grpc.CallOption) (*QueryConcentratedPoolIdLinkFromCFMMResponse, error) {
+	out := new(QueryConcentratedPoolIdLinkFromCFMMResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/ConcentratedPoolIdLinkFromCFMM", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryPoolTypeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) CFMMConcentratedPoolLinks(ctx context.Context, in *QueryCFMMConcentratedPoolLinksRequest, opts ...grpc.CallOption) (*QueryCFMMConcentratedPoolLinksResponse, error) {
+	out := new(QueryCFMMConcentratedPoolLinksResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/CFMMConcentratedPoolLinks", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryPoolTypeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	Pools(context.Context, *QueryPoolsRequest) (*QueryPoolsResponse, error)
+	// Deprecated: please use the alternative in x/poolmanager
+	NumPools(context.Context, *QueryNumPoolsRequest) (*QueryNumPoolsResponse, error)
+	TotalLiquidity(context.Context, *QueryTotalLiquidityRequest) (*QueryTotalLiquidityResponse, error)
+	// PoolsWithFilter allows you to query specific pools with requested
+	// parameters
+	PoolsWithFilter(context.Context, *QueryPoolsWithFilterRequest) (*QueryPoolsWithFilterResponse, error)
+	// Deprecated: please use the alternative in x/poolmanager
+	Pool(context.Context, *QueryPoolRequest) (*QueryPoolResponse, error)
+	// PoolType returns the type of the pool.
+	// Returns "Balancer" as a string literal when the pool is a balancer pool.
+	// Errors if the pool is failed to be type caseted.
+	PoolType(context.Context, *QueryPoolTypeRequest) (*QueryPoolTypeResponse, error)
+	// Simulates joining pool without a swap. Returns the amount of shares you'd
+	// get and tokens needed to provide
+	CalcJoinPoolNoSwapShares(context.Context, *QueryCalcJoinPoolNoSwapSharesRequest) (*QueryCalcJoinPoolNoSwapSharesResponse, error)
+	CalcJoinPoolShares(context.Context, *QueryCalcJoinPoolSharesRequest) (*QueryCalcJoinPoolSharesResponse, error)
+	CalcExitPoolCoinsFromShares(context.Context, *QueryCalcExitPoolCoinsFromSharesRequest) (*QueryCalcExitPoolCoinsFromSharesResponse, error)
+	// CalcJoinSwapShareAmountOut simulates joining a pool with a single asset for
+	// an exact amount of shares. Returns the amount of tokens needed and the
+	// implied price impact of the join.
+	CalcJoinSwapShareAmountOut(context.Context, *QueryCalcJoinSwapShareAmountOutRequest) (*QueryCalcJoinSwapShareAmountOutResponse, error)
+	// CalcExitSwapExactAmountOut simulates exiting a pool with a single asset
+	// for an exact amount of tokens. Returns the amount of shares needed and the
+	// implied price impact of the exit.
+	CalcExitSwapExactAmountOut(context.Context, *QueryCalcExitSwapExactAmountOutRequest) (*QueryCalcExitSwapExactAmountOutResponse, error)
+	PoolParams(context.Context, *QueryPoolParamsRequest) (*QueryPoolParamsResponse, error)
+	// PoolWeights returns the current weights of a balancer pool, and the
+	// weights it is changing to if a smooth weight change is scheduled or in
+	// progress.
+	PoolWeights(context.Context, *QueryPoolWeightsRequest) (*QueryPoolWeightsResponse, error)
+	// ScalingFactorRateSource returns the on-chain rate source driving the
+	// scaling factors of a stableswap pool, if any.
+	ScalingFactorRateSource(context.Context, *QueryScalingFactorRateSourceRequest) (*QueryScalingFactorRateSourceResponse, error)
+	// Deprecated: please use the alternative in x/poolmanager
+	TotalPoolLiquidity(context.Context, *QueryTotalPoolLiquidityRequest) (*QueryTotalPoolLiquidityResponse, error)
+	TotalShares(context.Context, *QueryTotalSharesRequest) (*QueryTotalSharesResponse, error)
+	// SpotPrice defines a gRPC query handler that returns the spot price given
+	// a base denomination and a quote denomination.
+	SpotPrice(context.Context, *QuerySpotPriceRequest) (*QuerySpotPriceResponse, error)
+	// Deprecated: please use the alternative in x/poolmanager
+	EstimateSwapExactAmountIn(context.Context, *QuerySwapExactAmountInRequest) (*QuerySwapExactAmountInResponse, error)
+	// Deprecated: please use the alternative in x/poolmanager
+	EstimateSwapExactAmountOut(context.Context, *QuerySwapExactAmountOutRequest) (*QuerySwapExactAmountOutResponse, error)
+	// ConcentratedPoolIdLinkFromBalancer returns the pool id of the concentrated
+	// pool that is linked with the given CFMM pool.
+	ConcentratedPoolIdLinkFromCFMM(context.Context, *QueryConcentratedPoolIdLinkFromCFMMRequest) (*QueryConcentratedPoolIdLinkFromCFMMResponse, error)
+	// CFMMConcentratedPoolLinks returns migration links between CFMM and
+	// Concentrated pools.
+	CFMMConcentratedPoolLinks(context.Context, *QueryCFMMConcentratedPoolLinksRequest) (*QueryCFMMConcentratedPoolLinksResponse, error)
 }
 
-func (m *QueryPoolTypeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *QueryPoolTypeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) Pools(ctx context.Context, req *QueryPoolsRequest) (*QueryPoolsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Pools not implemented")
 }
-
-func (m *QueryPoolTypeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) NumPools(ctx context.Context, req *QueryNumPoolsRequest) (*QueryNumPoolsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NumPools not implemented")
+}
+func (*UnimplementedQueryServer) TotalLiquidity(ctx context.Context, req *QueryTotalLiquidityRequest) (*QueryTotalLiquidityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalLiquidity not implemented")
+}
+func (*UnimplementedQueryServer) PoolsWithFilter(ctx context.Context, req *QueryPoolsWithFilterRequest) (*QueryPoolsWithFilterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolsWithFilter not implemented")
+}
+func (*UnimplementedQueryServer) Pool(ctx context.Context, req *QueryPoolRequest) (*QueryPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Pool not implemented")
+}
+func (*UnimplementedQueryServer) PoolType(ctx context.Context, req *QueryPoolTypeRequest) (*QueryPoolTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolType not implemented")
+}
+func (*UnimplementedQueryServer) CalcJoinPoolNoSwapShares(ctx context.Context, req *QueryCalcJoinPoolNoSwapSharesRequest) (*QueryCalcJoinPoolNoSwapSharesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CalcJoinPoolNoSwapShares not implemented")
+}
+func (*UnimplementedQueryServer) CalcJoinPoolShares(ctx context.Context, req *QueryCalcJoinPoolSharesRequest) (*QueryCalcJoinPoolSharesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CalcJoinPoolShares not implemented")
+}
+func (*UnimplementedQueryServer) CalcExitPoolCoinsFromShares(ctx context.Context, req *QueryCalcExitPoolCoinsFromSharesRequest) (*QueryCalcExitPoolCoinsFromSharesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CalcExitPoolCoinsFromShares not implemented")
+}
+func (*UnimplementedQueryServer) CalcJoinSwapShareAmountOut(ctx context.Context, req *QueryCalcJoinSwapShareAmountOutRequest) (*QueryCalcJoinSwapShareAmountOutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CalcJoinSwapShareAmountOut not implemented")
+}
+func (*UnimplementedQueryServer) CalcExitSwapExactAmountOut(ctx context.Context, req *QueryCalcExitSwapExactAmountOutRequest) (*QueryCalcExitSwapExactAmountOutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CalcExitSwapExactAmountOut not implemented")
+}
+func (*UnimplementedQueryServer) PoolParams(ctx context.Context, req *QueryPoolParamsRequest) (*QueryPoolParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolParams not implemented")
+}
+func (*UnimplementedQueryServer) PoolWeights(ctx context.Context, req *QueryPoolWeightsRequest) (*QueryPoolWeightsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolWeights not implemented")
+}
+func (*UnimplementedQueryServer) ScalingFactorRateSource(ctx context.Context, req *QueryScalingFactorRateSourceRequest) (*QueryScalingFactorRateSourceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScalingFactorRateSource not implemented")
+}
+func (*UnimplementedQueryServer) TotalPoolLiquidity(ctx context.Context, req *QueryTotalPoolLiquidityRequest) (*QueryTotalPoolLiquidityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalPoolLiquidity not implemented")
+}
+func (*UnimplementedQueryServer) TotalShares(ctx context.Context, req *QueryTotalSharesRequest) (*QueryTotalSharesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalShares not implemented")
+}
+func (*UnimplementedQueryServer) SpotPrice(ctx context.Context, req *QuerySpotPriceRequest) (*QuerySpotPriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SpotPrice not implemented")
+}
+func (*UnimplementedQueryServer) EstimateSwapExactAmountIn(ctx context.Context, req *QuerySwapExactAmountInRequest) (*QuerySwapExactAmountInResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateSwapExactAmountIn not implemented")
+}
+func (*UnimplementedQueryServer) EstimateSwapExactAmountOut(ctx context.Context, req *QuerySwapExactAmountOutRequest) (*QuerySwapExactAmountOutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateSwapExactAmountOut not implemented")
+}
+func (*UnimplementedQueryServer) ConcentratedPoolIdLinkFromCFMM(ctx context.Context, req *QueryConcentratedPoolIdLinkFromCFMMRequest) (*QueryConcentratedPoolIdLinkFromCFMMResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConcentratedPoolIdLinkFromCFMM not implemented")
+}
+func (*UnimplementedQueryServer) CFMMConcentratedPoolLinks(ctx context.Context, req *QueryCFMMConcentratedPoolLinksRequest) (*QueryCFMMConcentratedPoolLinksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CFMMConcentratedPoolLinks not implemented")
 }
 
-func (m *QueryPoolTypeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PoolType) > 0 {
-		i -= len(m.PoolType)
-		copy(dAtA[i:], m.PoolType)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.PoolType)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func (m *QueryCalcJoinPoolSharesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_Pools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).Pools(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.v1beta1.Query/Pools",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Pools(ctx, req.(*QueryPoolsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryCalcJoinPoolSharesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryCalcJoinPoolSharesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TokensIn) > 0 {
-		for iNdEx := len(m.TokensIn) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TokensIn[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+func _Query_NumPools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNumPoolsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
+	if interceptor == nil {
+		return srv.(QueryServer).NumPools(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.v1beta1.Query/NumPools",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NumPools(ctx, req.(*QueryNumPoolsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryCalcJoinPoolSharesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_TotalLiquidity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTotalLiquidityRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryCalcJoinPoolSharesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryCalcJoinPoolSharesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TokensOut) > 0 {
-		for iNdEx := len(m.TokensOut) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TokensOut[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).TotalLiquidity(ctx, in)
 	}
-	{
-		size := m.ShareOutAmount.Size()
-		i -= size
-		if _, err := m.ShareOutAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.v1beta1.Query/TotalLiquidity",
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalLiquidity(ctx, req.(*QueryTotalLiquidityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryCalcExitPoolCoinsFromSharesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_PoolsWithFilter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolsWithFilterRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).PoolsWithFilter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.v1beta1.Query/PoolsWithFilter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoolsWithFilter(ctx, req.(*QueryPoolsWithFilterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryCalcExitPoolCoinsFromSharesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_Pool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Pool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.v1beta1.Query/Pool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Pool(ctx, req.(*QueryPoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryCalcExitPoolCoinsFromSharesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.ShareInAmount.Size()
-		i -= size
-		if _, err := m.ShareInAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func _Query_PoolType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0x12
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
+	if interceptor == nil {
+		return srv.(QueryServer).PoolType(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.v1beta1.Query/PoolType",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoolType(ctx, req.(*QueryPoolTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryCalcExitPoolCoinsFromSharesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_CalcJoinPoolNoSwapShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCalcJoinPoolNoSwapSharesRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).CalcJoinPoolNoSwapShares(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.v1beta1.Query/CalcJoinPoolNoSwapShares",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CalcJoinPoolNoSwapShares(ctx, req.(*QueryCalcJoinPoolNoSwapSharesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryCalcExitPoolCoinsFromSharesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_CalcJoinPoolShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCalcJoinPoolSharesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CalcJoinPoolShares(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.v1beta1.Query/CalcJoinPoolShares",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CalcJoinPoolShares(ctx, req.(*QueryCalcJoinPoolSharesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryCalcExitPoolCoinsFromSharesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TokensOut) > 0 {
-		for iNdEx := len(m.TokensOut) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TokensOut[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func _Query_CalcExitPoolCoinsFromShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCalcExitPoolCoinsFromSharesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).CalcExitPoolCoinsFromShares(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.v1beta1.Query/CalcExitPoolCoinsFromShares",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CalcExitPoolCoinsFromShares(ctx, req.(*QueryCalcExitPoolCoinsFromSharesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPoolParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_CalcJoinSwapShareAmountOut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCalcJoinSwapShareAmountOutRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).CalcJoinSwapShareAmountOut(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.v1beta1.Query/CalcJoinSwapShareAmountOut",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CalcJoinSwapShareAmountOut(ctx, req.(*QueryCalcJoinSwapShareAmountOutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPoolParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryPoolParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
+func _Query_CalcExitSwapExactAmountOut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCalcExitSwapExactAmountOutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).CalcExitSwapExactAmountOut(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.v1beta1.Query/CalcExitSwapExactAmountOut",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CalcExitSwapExactAmountOut(ctx, req.(*QueryCalcExitSwapExactAmountOutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPoolParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_PoolParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolParamsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryPoolParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryPoolParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Params != nil {
-		{
-			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(QueryServer).PoolParams(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.v1beta1.Query/PoolParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoolParams(ctx, req.(*QueryPoolParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryTotalPoolLiquidityRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_PoolWeights_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolWeightsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryTotalPoolLiquidityRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryTotalPoolLiquidityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
+	if interceptor == nil {
+		return srv.(QueryServer).PoolWeights(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.v1beta1.Query/PoolWeights",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoolWeights(ctx, req.(*QueryPoolWeightsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryTotalPoolLiquidityResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_ScalingFactorRateSource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScalingFactorRateSourceRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryTotalPoolLiquidityResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(QueryServer).ScalingFactorRateSource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.v1beta1.Query/ScalingFactorRateSource",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScalingFactorRateSource(ctx, req.(*QueryScalingFactorRateSourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryTotalPoolLiquidityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Liquidity) > 0 {
-		for iNdEx := len(m.Liquidity) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Liquidity[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func _Query_TotalPoolLiquidity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTotalPoolLiquidityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).TotalPoolLiquidity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.v1beta1.Query/TotalPoolLiquidity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalPoolLiquidity(ctx, req.(*QueryTotalPoolLiquidityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryTotalSharesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_TotalShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTotalSharesRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).TotalShares(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.v1beta1.Query/TotalShares",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalShares(ctx, req.(*QueryTotalSharesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryTotalSharesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_SpotPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySpotPriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SpotPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.v1beta1.Query/SpotPrice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SpotPrice(ctx, req.(*QuerySpotPriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryTotalSharesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
+func _Query_EstimateSwapExactAmountIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySwapExactAmountInRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).EstimateSwapExactAmountIn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.v1beta1.Query/EstimateSwapExactAmountIn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EstimateSwapExactAmountIn(ctx, req.(*QuerySwapExactAmountInRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryTotalSharesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_EstimateSwapExactAmountOut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySwapExactAmountOutRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).EstimateSwapExactAmountOut(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.v1beta1.Query/EstimateSwapExactAmountOut",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EstimateSwapExactAmountOut(ctx, req.(*QuerySwapExactAmountOutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryTotalSharesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_ConcentratedPoolIdLinkFromCFMM_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConcentratedPoolIdLinkFromCFMMRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ConcentratedPoolIdLinkFromCFMM(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.v1beta1.Query/ConcentratedPoolIdLinkFromCFMM",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ConcentratedPoolIdLinkFromCFMM(ctx, req.(*QueryConcentratedPoolIdLinkFromCFMMRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryTotalSharesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.TotalShares.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func _Query_CFMMConcentratedPoolLinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCFMMConcentratedPoolLinksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).CFMMConcentratedPoolLinks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.v1beta1.Query/CFMMConcentratedPoolLinks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CFMMConcentratedPoolLinks(ctx, req.(*QueryCFMMConcentratedPoolLinksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryCalcJoinPoolNoSwapSharesRequest) Marshal() (dAtA []byte, err error) {
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "osmosis.gamm.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Pools",
+			Handler:    _Query_Pools_Handler,
+		},
+		{
+			MethodName: "NumPools",
+			Handler:    _Query_NumPools_Handler,
+		},
+		{
+			MethodName: "TotalLiquidity",
+			Handler:    _Query_TotalLiquidity_Handler,
+		},
+		{
+			MethodName: "PoolsWithFilter",
+			Handler:    _Query_PoolsWithFilter_Handler,
+		},
+		{
+			MethodName: "Pool",
+			Handler:    _Query_Pool_Handler,
+		},
+		{
+			MethodName: "PoolType",
+			Handler:    _Query_PoolType_Handler,
+		},
+		{
+			MethodName: "CalcJoinPoolNoSwapShares",
+			Handler:    _Query_CalcJoinPoolNoSwapShares_Handler,
+		},
+		{
+			MethodName: "CalcJoinPoolShares",
+			Handler:    _Query_CalcJoinPoolShares_Handler,
+		},
+		{
+			MethodName: "CalcExitPoolCoinsFromShares",
+			Handler:    _Query_CalcExitPoolCoinsFromShares_Handler,
+		},
+		{
+			MethodName: "CalcJoinSwapShareAmountOut",
+			Handler:    _Query_CalcJoinSwapShareAmountOut_Handler,
+		},
+		{
+			MethodName: "CalcExitSwapExactAmountOut",
+			Handler:    _Query_CalcExitSwapExactAmountOut_Handler,
+		},
+		{
+			MethodName: "PoolParams",
+			Handler:    _Query_PoolParams_Handler,
+		},
+		{
+			MethodName: "PoolWeights",
+			Handler:    _Query_PoolWeights_Handler,
+		},
+		{
+			MethodName: "ScalingFactorRateSource",
+			Handler:    _Query_ScalingFactorRateSource_Handler,
+		},
+		{
+			MethodName: "TotalPoolLiquidity",
+			Handler:    _Query_TotalPoolLiquidity_Handler,
+		},
+		{
+			MethodName: "TotalShares",
+			Handler:    _Query_TotalShares_Handler,
+		},
+		{
+			MethodName: "SpotPrice",
+			Handler:    _Query_SpotPrice_Handler,
+		},
+		{
+			MethodName: "EstimateSwapExactAmountIn",
+			Handler:    _Query_EstimateSwapExactAmountIn_Handler,
+		},
+		{
+			MethodName: "EstimateSwapExactAmountOut",
+			Handler:    _Query_EstimateSwapExactAmountOut_Handler,
+		},
+		{
+			MethodName: "ConcentratedPoolIdLinkFromCFMM",
+			Handler:    _Query_ConcentratedPoolIdLinkFromCFMM_Handler,
+		},
+		{
+			MethodName: "CFMMConcentratedPoolLinks",
+			Handler:    _Query_CFMMConcentratedPoolLinks_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "osmosis/gamm/v1beta1/query.proto",
+}
+
+func (m *QueryPoolRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3162,30 +3231,16 @@ func (m *QueryCalcJoinPoolNoSwapSharesRequest) Marshal() (dAtA []byte, err error
 	return dAtA[:n], nil
 }
 
-func (m *QueryCalcJoinPoolNoSwapSharesRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryPoolRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryCalcJoinPoolNoSwapSharesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.TokensIn) > 0 {
-		for iNdEx := len(m.TokensIn) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TokensIn[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
 	if m.PoolId != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
 		i--
@@ -3194,7 +3249,7 @@ func (m *QueryCalcJoinPoolNoSwapSharesRequest) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryCalcJoinPoolNoSwapSharesResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryPoolResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3204,86 +3259,32 @@ func (m *QueryCalcJoinPoolNoSwapSharesResponse) Marshal() (dAtA []byte, err erro
 	return dAtA[:n], nil
 }
 
-func (m *QueryCalcJoinPoolNoSwapSharesResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryPoolResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryCalcJoinPoolNoSwapSharesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.SharesOut.Size()
-		i -= size
-		if _, err := m.SharesOut.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.TokensOut) > 0 {
-		for iNdEx := len(m.TokensOut) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TokensOut[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
+	if m.Pool != nil {
+		{
+			size, err := m.Pool.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0xa
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *QuerySpotPriceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *QuerySpotPriceRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QuerySpotPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.QuoteAssetDenom) > 0 {
-		i -= len(m.QuoteAssetDenom)
-		copy(dAtA[i:], m.QuoteAssetDenom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.QuoteAssetDenom)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.BaseAssetDenom) > 0 {
-		i -= len(m.BaseAssetDenom)
-		copy(dAtA[i:], m.BaseAssetDenom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BaseAssetDenom)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryPoolsWithFilterRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryPoolsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3293,12 +3294,12 @@ func (m *QueryPoolsWithFilterRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryPoolsWithFilterRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryPoolsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPoolsWithFilterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryPoolsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3313,26 +3314,12 @@ func (m *QueryPoolsWithFilterRequest) MarshalToSizedBuffer(dAtA []byte) (int, er
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.PoolType) > 0 {
-		i -= len(m.PoolType)
-		copy(dAtA[i:], m.PoolType)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.PoolType)))
-		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.MinLiquidity) > 0 {
-		i -= len(m.MinLiquidity)
-		copy(dAtA[i:], m.MinLiquidity)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.MinLiquidity)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryPoolsWithFilterResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryPoolsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3342,12 +3329,12 @@ func (m *QueryPoolsWithFilterResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryPoolsWithFilterResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryPoolsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPoolsWithFilterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryPoolsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3381,7 +3368,7 @@ func (m *QueryPoolsWithFilterResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func (m *QuerySpotPriceResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryNumPoolsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3391,27 +3378,20 @@ func (m *QuerySpotPriceResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QuerySpotPriceResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryNumPoolsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QuerySpotPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryNumPoolsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.SpotPrice) > 0 {
-		i -= len(m.SpotPrice)
-		copy(dAtA[i:], m.SpotPrice)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.SpotPrice)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QuerySwapExactAmountInRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryNumPoolsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3421,53 +3401,53 @@ func (m *QuerySwapExactAmountInRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QuerySwapExactAmountInRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryNumPoolsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QuerySwapExactAmountInRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryNumPoolsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Routes) > 0 {
-		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if len(m.TokenIn) > 0 {
-		i -= len(m.TokenIn)
-		copy(dAtA[i:], m.TokenIn)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenIn)))
+	if m.NumPools != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumPools))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolTypeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolTypeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolTypeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.PoolId != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
 		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QuerySwapExactAmountInResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryPoolTypeResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3477,30 +3457,27 @@ func (m *QuerySwapExactAmountInResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QuerySwapExactAmountInResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryPoolTypeResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QuerySwapExactAmountInResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryPoolTypeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.TokenOutAmount.Size()
-		i -= size
-		if _, err := m.TokenOutAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	if len(m.PoolType) > 0 {
+		i -= len(m.PoolType)
+		copy(dAtA[i:], m.PoolType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PoolType)))
+		i--
+		dAtA[i] = 0xa
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QuerySwapExactAmountOutRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryCalcJoinPoolSharesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3510,27 +3487,20 @@ func (m *QuerySwapExactAmountOutRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QuerySwapExactAmountOutRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryCalcJoinPoolSharesRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QuerySwapExactAmountOutRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryCalcJoinPoolSharesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.TokenOut) > 0 {
-		i -= len(m.TokenOut)
-		copy(dAtA[i:], m.TokenOut)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenOut)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Routes) > 0 {
-		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.TokensIn) > 0 {
+		for iNdEx := len(m.TokensIn) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.TokensIn[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3538,25 +3508,18 @@ func (m *QuerySwapExactAmountOutRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 				i = encodeVarintQuery(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0x12
 		}
 	}
 	if m.PoolId != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
 		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QuerySwapExactAmountOutResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryCalcJoinPoolSharesResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3566,20 +3529,44 @@ func (m *QuerySwapExactAmountOutResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QuerySwapExactAmountOutResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryCalcJoinPoolSharesResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QuerySwapExactAmountOutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryCalcJoinPoolSharesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size := m.TokenInAmount.Size()
+		size := m.PriceImpact.Size()
 		i -= size
-		if _, err := m.TokenInAmount.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.PriceImpact.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.TokensOut) > 0 {
+		for iNdEx := len(m.TokensOut) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokensOut[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size := m.ShareOutAmount.Size()
+		i -= size
+		if _, err := m.ShareOutAmount.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintQuery(dAtA, i, uint64(size))
@@ -3589,7 +3576,7 @@ func (m *QuerySwapExactAmountOutResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryTotalLiquidityRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryCalcExitPoolCoinsFromSharesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3599,20 +3586,35 @@ func (m *QueryTotalLiquidityRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryTotalLiquidityRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryCalcExitPoolCoinsFromSharesRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTotalLiquidityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryCalcExitPoolCoinsFromSharesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.ShareInAmount.Size()
+		i -= size
+		if _, err := m.ShareInAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryTotalLiquidityResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryCalcExitPoolCoinsFromSharesResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3622,20 +3624,20 @@ func (m *QueryTotalLiquidityResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryTotalLiquidityResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryCalcExitPoolCoinsFromSharesResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTotalLiquidityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryCalcExitPoolCoinsFromSharesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Liquidity) > 0 {
-		for iNdEx := len(m.Liquidity) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.TokensOut) > 0 {
+		for iNdEx := len(m.TokensOut) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Liquidity[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.TokensOut[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3649,7 +3651,7 @@ func (m *QueryTotalLiquidityResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryConcentratedPoolIdLinkFromCFMMRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryCalcJoinSwapShareAmountOutRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3659,25 +3661,42 @@ func (m *QueryConcentratedPoolIdLinkFromCFMMRequest) Marshal() (dAtA []byte, err
 	return dAtA[:n], nil
 }
 
-func (m *QueryConcentratedPoolIdLinkFromCFMMRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryCalcJoinSwapShareAmountOutRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryConcentratedPoolIdLinkFromCFMMRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryCalcJoinSwapShareAmountOutRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.CfmmPoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.CfmmPoolId))
+	{
+		size := m.ShareOutAmount.Size()
+		i -= size
+		if _, err := m.ShareOutAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.TokenInDenom) > 0 {
+		i -= len(m.TokenInDenom)
+		copy(dAtA[i:], m.TokenInDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenInDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryConcentratedPoolIdLinkFromCFMMResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryCalcJoinSwapShareAmountOutResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3687,25 +3706,40 @@ func (m *QueryConcentratedPoolIdLinkFromCFMMResponse) Marshal() (dAtA []byte, er
 	return dAtA[:n], nil
 }
 
-func (m *QueryConcentratedPoolIdLinkFromCFMMResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryCalcJoinSwapShareAmountOutResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryConcentratedPoolIdLinkFromCFMMResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryCalcJoinSwapShareAmountOutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ConcentratedPoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ConcentratedPoolId))
-		i--
-		dAtA[i] = 0x8
+	{
+		size := m.PriceImpact.Size()
+		i -= size
+		if _, err := m.PriceImpact.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.TokenInAmount.Size()
+		i -= size
+		if _, err := m.TokenInAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryCFMMConcentratedPoolLinksRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryCalcExitSwapExactAmountOutRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3715,20 +3749,32 @@ func (m *QueryCFMMConcentratedPoolLinksRequest) Marshal() (dAtA []byte, err erro
 	return dAtA[:n], nil
 }
 
-func (m *QueryCFMMConcentratedPoolLinksRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryCalcExitSwapExactAmountOutRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryCFMMConcentratedPoolLinksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryCalcExitSwapExactAmountOutRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.TokenOut) > 0 {
+		i -= len(m.TokenOut)
+		copy(dAtA[i:], m.TokenOut)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenOut)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryCFMMConcentratedPoolLinksResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryCalcExitSwapExactAmountOutResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3738,543 +3784,2607 @@ func (m *QueryCFMMConcentratedPoolLinksResponse) Marshal() (dAtA []byte, err err
 	return dAtA[:n], nil
 }
 
-func (m *QueryCFMMConcentratedPoolLinksResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryCalcExitSwapExactAmountOutResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryCFMMConcentratedPoolLinksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryCalcExitSwapExactAmountOutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.MigrationRecords != nil {
-		{
-			size, err := m.MigrationRecords.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+	{
+		size := m.PriceImpact.Size()
+		i -= size
+		if _, err := m.PriceImpact.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0xa
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.ShareInAmount.Size()
+		i -= size
+		if _, err := m.ShareInAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryPoolParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryPoolRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QueryPoolParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPoolResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pool != nil {
-		l = m.Pool.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryPoolParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryPoolsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryPoolParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPoolsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryPoolParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Pools) > 0 {
-		for _, e := range m.Pools {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Params != nil {
+		{
+			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryNumPoolsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPoolWeightsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryNumPoolsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.NumPools != 0 {
-		n += 1 + sovQuery(uint64(m.NumPools))
-	}
-	return n
+func (m *QueryPoolWeightsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPoolTypeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryPoolWeightsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPoolTypeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PoolWeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolWeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolWeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.PoolType)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryCalcJoinPoolSharesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPoolWeightsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolWeightsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolWeightsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
+	if m.EndTime != nil {
+		n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime):])
+		if err5 != nil {
+			return 0, err5
+		}
+		i -= n5
+		i = encodeVarintQuery(dAtA, i, uint64(n5))
+		i--
+		dAtA[i] = 0x22
 	}
-	if len(m.TokensIn) > 0 {
-		for _, e := range m.TokensIn {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.StartTime != nil {
+		n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.StartTime):])
+		if err6 != nil {
+			return 0, err6
 		}
+		i -= n6
+		i = encodeVarintQuery(dAtA, i, uint64(n6))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
-}
-
-func (m *QueryCalcJoinPoolSharesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.TargetWeights) > 0 {
+		for iNdEx := len(m.TargetWeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TargetWeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	var l int
-	_ = l
-	l = m.ShareOutAmount.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	if len(m.TokensOut) > 0 {
-		for _, e := range m.TokensOut {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.CurrentWeights) > 0 {
+		for iNdEx := len(m.CurrentWeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CurrentWeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryCalcExitPoolCoinsFromSharesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryScalingFactorRateSourceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScalingFactorRateSourceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScalingFactorRateSourceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
 	}
-	l = m.ShareInAmount.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryCalcExitPoolCoinsFromSharesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryScalingFactorRateSourceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.TokensOut) > 0 {
-		for _, e := range m.TokensOut {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryPoolParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
-	}
-	return n
+func (m *QueryScalingFactorRateSourceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPoolParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryScalingFactorRateSourceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Params != nil {
-		l = m.Params.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ScalingFactors) > 0 {
+		dAtA8 := make([]byte, len(m.ScalingFactors)*10)
+		var j7 int
+		for _, num := range m.ScalingFactors {
+			for num >= 1<<7 {
+				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j7++
+			}
+			dAtA8[j7] = uint8(num)
+			j7++
+		}
+		i -= j7
+		copy(dAtA[i:], dAtA8[:j7])
+		i = encodeVarintQuery(dAtA, i, uint64(j7))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if m.RateSource != nil {
+		{
+			size, err := m.RateSource.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryTotalPoolLiquidityRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryTotalPoolLiquidityRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalPoolLiquidityRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalPoolLiquidityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryTotalPoolLiquidityResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryTotalPoolLiquidityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalPoolLiquidityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalPoolLiquidityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if len(m.Liquidity) > 0 {
-		for _, e := range m.Liquidity {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+		for iNdEx := len(m.Liquidity) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Liquidity[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryTotalSharesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
+func (m *QueryTotalSharesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryTotalSharesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.TotalShares.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryTotalSharesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryCalcJoinPoolNoSwapSharesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryTotalSharesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
-	}
-	if len(m.TokensIn) > 0 {
-		for _, e := range m.TokensIn {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryCalcJoinPoolNoSwapSharesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryTotalSharesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalSharesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalSharesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.TokensOut) > 0 {
-		for _, e := range m.TokensOut {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.TotalShares.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	l = m.SharesOut.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QuerySpotPriceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
-	}
-	l = len(m.BaseAssetDenom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.QuoteAssetDenom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryCalcJoinPoolNoSwapSharesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryPoolsWithFilterRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryCalcJoinPoolNoSwapSharesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCalcJoinPoolNoSwapSharesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.MinLiquidity)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.PoolType)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.TokensIn) > 0 {
+		for iNdEx := len(m.TokensIn) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokensIn[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPoolsWithFilterResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Pools) > 0 {
-		for _, e := range m.Pools {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryCalcJoinPoolNoSwapSharesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QuerySpotPriceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.SpotPrice)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryCalcJoinPoolNoSwapSharesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QuerySwapExactAmountInRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryCalcJoinPoolNoSwapSharesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
-	}
-	l = len(m.TokenIn)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	{
+		size := m.SharesOut.Size()
+		i -= size
+		if _, err := m.SharesOut.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	if len(m.Routes) > 0 {
-		for _, e := range m.Routes {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	if len(m.TokensOut) > 0 {
+		for iNdEx := len(m.TokensOut) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokensOut[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QuerySwapExactAmountInResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySpotPriceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.TokenOutAmount.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QuerySwapExactAmountOutRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QuerySpotPriceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySpotPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
+	if len(m.QuoteAssetDenom) > 0 {
+		i -= len(m.QuoteAssetDenom)
+		copy(dAtA[i:], m.QuoteAssetDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.QuoteAssetDenom)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.Routes) > 0 {
-		for _, e := range m.Routes {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if len(m.BaseAssetDenom) > 0 {
+		i -= len(m.BaseAssetDenom)
+		copy(dAtA[i:], m.BaseAssetDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BaseAssetDenom)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.TokenOut)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QuerySwapExactAmountOutResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPoolsWithFilterRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.TokenInAmount.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryTotalLiquidityRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *QueryPoolsWithFilterRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTotalLiquidityResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryPoolsWithFilterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Liquidity) > 0 {
-		for _, e := range m.Liquidity {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
-}
-
-func (m *QueryConcentratedPoolIdLinkFromCFMMRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.PoolType) > 0 {
+		i -= len(m.PoolType)
+		copy(dAtA[i:], m.PoolType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PoolType)))
+		i--
+		dAtA[i] = 0x12
 	}
-	var l int
-	_ = l
-	if m.CfmmPoolId != 0 {
-		n += 1 + sovQuery(uint64(m.CfmmPoolId))
+	if len(m.MinLiquidity) > 0 {
+		i -= len(m.MinLiquidity)
+		copy(dAtA[i:], m.MinLiquidity)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MinLiquidity)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryConcentratedPoolIdLinkFromCFMMResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ConcentratedPoolId != 0 {
-		n += 1 + sovQuery(uint64(m.ConcentratedPoolId))
+func (m *QueryPoolsWithFilterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryCFMMConcentratedPoolLinksRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *QueryPoolsWithFilterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryCFMMConcentratedPoolLinksResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryPoolsWithFilterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.MigrationRecords != nil {
-		l = m.MigrationRecords.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Pools) > 0 {
+		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySpotPriceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySpotPriceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySpotPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SpotPrice) > 0 {
+		i -= len(m.SpotPrice)
+		copy(dAtA[i:], m.SpotPrice)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SpotPrice)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySwapExactAmountInRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySwapExactAmountInRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySwapExactAmountInRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.TokenIn) > 0 {
+		i -= len(m.TokenIn)
+		copy(dAtA[i:], m.TokenIn)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenIn)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySwapExactAmountInResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySwapExactAmountInResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySwapExactAmountInResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TokenOutAmount.Size()
+		i -= size
+		if _, err := m.TokenOutAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySwapExactAmountOutRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySwapExactAmountOutRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySwapExactAmountOutRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokenOut) > 0 {
+		i -= len(m.TokenOut)
+		copy(dAtA[i:], m.TokenOut)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenOut)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySwapExactAmountOutResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySwapExactAmountOutResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySwapExactAmountOutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TokenInAmount.Size()
+		i -= size
+		if _, err := m.TokenInAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalLiquidityRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalLiquidityRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalLiquidityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalLiquidityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalLiquidityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalLiquidityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Liquidity) > 0 {
+		for iNdEx := len(m.Liquidity) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Liquidity[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConcentratedPoolIdLinkFromCFMMRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConcentratedPoolIdLinkFromCFMMRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConcentratedPoolIdLinkFromCFMMRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CfmmPoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CfmmPoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConcentratedPoolIdLinkFromCFMMResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConcentratedPoolIdLinkFromCFMMResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConcentratedPoolIdLinkFromCFMMResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ConcentratedPoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ConcentratedPoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCFMMConcentratedPoolLinksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCFMMConcentratedPoolLinksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCFMMConcentratedPoolLinksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCFMMConcentratedPoolLinksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCFMMConcentratedPoolLinksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCFMMConcentratedPoolLinksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MigrationRecords != nil {
+		{
+			size, err := m.MigrationRecords.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryPoolRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *QueryPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pool != nil {
+		l = m.Pool.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPoolsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPoolsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pools) > 0 {
+		for _, e := range m.Pools {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNumPoolsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryNumPoolsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NumPools != 0 {
+		n += 1 + sovQuery(uint64(m.NumPools))
+	}
+	return n
+}
+
+func (m *QueryPoolTypeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *QueryPoolTypeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PoolType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCalcJoinPoolSharesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	if len(m.TokensIn) > 0 {
+		for _, e := range m.TokensIn {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryCalcJoinPoolSharesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ShareOutAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.TokensOut) > 0 {
+		for _, e := range m.TokensOut {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.PriceImpact.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryCalcExitPoolCoinsFromSharesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = m.ShareInAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryCalcExitPoolCoinsFromSharesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TokensOut) > 0 {
+		for _, e := range m.TokensOut {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryCalcJoinSwapShareAmountOutRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = len(m.TokenInDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.ShareOutAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryCalcJoinSwapShareAmountOutResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TokenInAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.PriceImpact.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryCalcExitSwapExactAmountOutRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = len(m.TokenOut)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCalcExitSwapExactAmountOutResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ShareInAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.PriceImpact.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPoolParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *QueryPoolParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPoolWeightsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *PoolWeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPoolWeightsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CurrentWeights) > 0 {
+		for _, e := range m.CurrentWeights {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.TargetWeights) > 0 {
+		for _, e := range m.TargetWeights {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.StartTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.StartTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.EndTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryScalingFactorRateSourceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *QueryScalingFactorRateSourceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RateSource != nil {
+		l = m.RateSource.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.ScalingFactors) > 0 {
+		l = 0
+		for _, e := range m.ScalingFactors {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *QueryTotalPoolLiquidityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *QueryTotalPoolLiquidityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Liquidity) > 0 {
+		for _, e := range m.Liquidity {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryTotalSharesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *QueryTotalSharesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TotalShares.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryCalcJoinPoolNoSwapSharesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	if len(m.TokensIn) > 0 {
+		for _, e := range m.TokensIn {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryCalcJoinPoolNoSwapSharesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TokensOut) > 0 {
+		for _, e := range m.TokensOut {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.SharesOut.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySpotPriceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = len(m.BaseAssetDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.QuoteAssetDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPoolsWithFilterRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MinLiquidity)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.PoolType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPoolsWithFilterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pools) > 0 {
+		for _, e := range m.Pools {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySpotPriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SpotPrice)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySwapExactAmountInRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = len(m.TokenIn)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuerySwapExactAmountInResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TokenOutAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySwapExactAmountOutRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.TokenOut)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySwapExactAmountOutResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TokenInAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTotalLiquidityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryTotalLiquidityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Liquidity) > 0 {
+		for _, e := range m.Liquidity {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryConcentratedPoolIdLinkFromCFMMRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CfmmPoolId != 0 {
+		n += 1 + sovQuery(uint64(m.CfmmPoolId))
+	}
+	return n
+}
+
+func (m *QueryConcentratedPoolIdLinkFromCFMMResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ConcentratedPoolId != 0 {
+		n += 1 + sovQuery(uint64(m.ConcentratedPoolId))
+	}
+	return n
+}
+
+func (m *QueryCFMMConcentratedPoolLinksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryCFMMConcentratedPoolLinksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MigrationRecords != nil {
+		l = m.MigrationRecords.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryPoolRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pool == nil {
+				m.Pool = &types.Any{}
+			}
+			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pools = append(m.Pools, &types.Any{})
+			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNumPoolsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNumPoolsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNumPoolsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNumPoolsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNumPoolsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNumPoolsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumPools", wireType)
+			}
+			m.NumPools = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumPools |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolTypeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolTypeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolTypeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolTypeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolTypeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolTypeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCalcJoinPoolSharesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCalcJoinPoolSharesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCalcJoinPoolSharesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokensIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokensIn = append(m.TokensIn, types1.Coin{})
+			if err := m.TokensIn[len(m.TokensIn)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCalcJoinPoolSharesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCalcJoinPoolSharesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCalcJoinPoolSharesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareOutAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ShareOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokensOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokensOut = append(m.TokensOut, types1.Coin{})
+			if err := m.TokensOut[len(m.TokensOut)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceImpact", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PriceImpact.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryPoolRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCalcExitPoolCoinsFromSharesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4297,10 +6407,10 @@ func (m *QueryPoolRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCalcExitPoolCoinsFromSharesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCalcExitPoolCoinsFromSharesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4322,6 +6432,40 @@ func (m *QueryPoolRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareInAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ShareInAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4343,7 +6487,7 @@ func (m *QueryPoolRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPoolResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCalcExitPoolCoinsFromSharesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4366,15 +6510,15 @@ func (m *QueryPoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCalcExitPoolCoinsFromSharesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCalcExitPoolCoinsFromSharesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokensOut", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4401,10 +6545,8 @@ func (m *QueryPoolResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pool == nil {
-				m.Pool = &types.Any{}
-			}
-			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TokensOut = append(m.TokensOut, types1.Coin{})
+			if err := m.TokensOut[len(m.TokensOut)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4429,7 +6571,7 @@ func (m *QueryPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPoolsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCalcJoinSwapShareAmountOutRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4452,17 +6594,36 @@ func (m *QueryPoolsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCalcJoinSwapShareAmountOutRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCalcJoinSwapShareAmountOutRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInDenom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4472,25 +6633,55 @@ func (m *QueryPoolsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			m.TokenInDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareOutAmount", wireType)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ShareOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4515,7 +6706,7 @@ func (m *QueryPoolsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPoolsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCalcJoinSwapShareAmountOutResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4538,17 +6729,17 @@ func (m *QueryPoolsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCalcJoinSwapShareAmountOutResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCalcJoinSwapShareAmountOutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInAmount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4558,31 +6749,31 @@ func (m *QueryPoolsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pools = append(m.Pools, &types.Any{})
-			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TokenInAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceImpact", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4592,25 +6783,23 @@ func (m *QueryPoolsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PriceImpact.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4635,7 +6824,7 @@ func (m *QueryPoolsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryNumPoolsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCalcExitSwapExactAmountOutRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4658,12 +6847,63 @@ func (m *QueryNumPoolsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryNumPoolsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCalcExitSwapExactAmountOutRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryNumPoolsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCalcExitSwapExactAmountOutRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenOut = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4685,7 +6925,7 @@ func (m *QueryNumPoolsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryNumPoolsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCalcExitSwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4695,30 +6935,64 @@ func (m *QueryNumPoolsResponse) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowQuery
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCalcExitSwapExactAmountOutResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCalcExitSwapExactAmountOutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareInAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.ShareInAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryNumPoolsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryNumPoolsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumPools", wireType)
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceImpact", wireType)
 			}
-			m.NumPools = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4728,11 +7002,26 @@ func (m *QueryNumPoolsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NumPools |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PriceImpact.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4754,7 +7043,7 @@ func (m *QueryNumPoolsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPoolTypeRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPoolParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4777,10 +7066,10 @@ func (m *QueryPoolTypeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolTypeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPoolParamsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolTypeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPoolParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4823,7 +7112,7 @@ func (m *QueryPoolTypeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPoolTypeResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPoolParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4846,17 +7135,17 @@ func (m *QueryPoolTypeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolTypeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPoolParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolTypeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPoolParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4866,23 +7155,27 @@ func (m *QueryPoolTypeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PoolType = string(dAtA[iNdEx:postIndex])
+			if m.Params == nil {
+				m.Params = &types.Any{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4905,7 +7198,7 @@ func (m *QueryPoolTypeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCalcJoinPoolSharesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPoolWeightsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4928,10 +7221,10 @@ func (m *QueryCalcJoinPoolSharesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCalcJoinPoolSharesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPoolWeightsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCalcJoinPoolSharesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPoolWeightsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4953,40 +7246,6 @@ func (m *QueryCalcJoinPoolSharesRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokensIn", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TokensIn = append(m.TokensIn, types1.Coin{})
-			if err := m.TokensIn[len(m.TokensIn)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5008,7 +7267,7 @@ func (m *QueryCalcJoinPoolSharesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCalcJoinPoolSharesResponse) Unmarshal(dAtA []byte) error {
+func (m *PoolWeight) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5031,15 +7290,15 @@ func (m *QueryCalcJoinPoolSharesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCalcJoinPoolSharesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PoolWeight: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCalcJoinPoolSharesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PoolWeight: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShareOutAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5067,15 +7326,13 @@ func (m *QueryCalcJoinPoolSharesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ShareOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokensOut", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5085,23 +7342,23 @@ func (m *QueryCalcJoinPoolSharesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokensOut = append(m.TokensOut, types1.Coin{})
-			if err := m.TokensOut[len(m.TokensOut)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5126,7 +7383,7 @@ func (m *QueryCalcJoinPoolSharesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCalcExitPoolCoinsFromSharesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPoolWeightsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5149,17 +7406,17 @@ func (m *QueryCalcExitPoolCoinsFromSharesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCalcExitPoolCoinsFromSharesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPoolWeightsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCalcExitPoolCoinsFromSharesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPoolWeightsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentWeights", wireType)
 			}
-			m.PoolId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5169,16 +7426,31 @@ func (m *QueryCalcExitPoolCoinsFromSharesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrentWeights = append(m.CurrentWeights, PoolWeight{})
+			if err := m.CurrentWeights[len(m.CurrentWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShareInAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetWeights", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5188,79 +7460,65 @@ func (m *QueryCalcExitPoolCoinsFromSharesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ShareInAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TargetWeights = append(m.TargetWeights, PoolWeight{})
+			if err := m.TargetWeights[len(m.TargetWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryCalcExitPoolCoinsFromSharesResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.StartTime == nil {
+				m.StartTime = new(time.Time)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCalcExitPoolCoinsFromSharesResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCalcExitPoolCoinsFromSharesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokensOut", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5287,8 +7545,10 @@ func (m *QueryCalcExitPoolCoinsFromSharesResponse) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokensOut = append(m.TokensOut, types1.Coin{})
-			if err := m.TokensOut[len(m.TokensOut)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.EndTime == nil {
+				m.EndTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5313,7 +7573,7 @@ func (m *QueryCalcExitPoolCoinsFromSharesResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryPoolParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryScalingFactorRateSourceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5336,10 +7596,10 @@ func (m *QueryPoolParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryScalingFactorRateSourceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryScalingFactorRateSourceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5382,7 +7642,7 @@ func (m *QueryPoolParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPoolParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryScalingFactorRateSourceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5405,15 +7665,15 @@ func (m *QueryPoolParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryScalingFactorRateSourceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryScalingFactorRateSourceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RateSource", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5440,13 +7700,89 @@ func (m *QueryPoolParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Params == nil {
-				m.Params = &types.Any{}
+			if m.RateSource == nil {
+				m.RateSource = &ScalingFactorRateSource{}
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RateSource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ScalingFactors = append(m.ScalingFactors, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ScalingFactors) == 0 {
+					m.ScalingFactors = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ScalingFactors = append(m.ScalingFactors, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScalingFactors", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
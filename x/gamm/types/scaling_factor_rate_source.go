@@ -0,0 +1,86 @@
+package types
+
+import (
+	"encoding/json"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+)
+
+// ContractRateResponse is the response expected from the contract of a ContractRateSource.
+type ContractRateResponse struct {
+	Rate osmomath.Dec `json:"rate"`
+}
+
+// Validate performs the stateless checks on the rate source.
+func (s ScalingFactorRateSource) Validate() error {
+	if err := sdk.ValidateDenom(s.BaseDenom); err != nil {
+		return errorsmod.Wrapf(ErrInvalidScalingFactorRateSource, "invalid base denom: %s", err)
+	}
+	if err := sdk.ValidateDenom(s.QuoteDenom); err != nil {
+		return errorsmod.Wrapf(ErrInvalidScalingFactorRateSource, "invalid quote denom: %s", err)
+	}
+	if s.BaseDenom == s.QuoteDenom {
+		return errorsmod.Wrapf(ErrInvalidScalingFactorRateSource, "base and quote denoms must differ, got (%s)", s.BaseDenom)
+	}
+
+	if s.MaxChangePerEpoch.IsNil() || !s.MaxChangePerEpoch.IsPositive() || s.MaxChangePerEpoch.GTE(osmomath.OneDec()) {
+		return errorsmod.Wrapf(ErrInvalidScalingFactorRateSource, "max change per epoch (%s) must be in (0, 1)", s.MaxChangePerEpoch)
+	}
+
+	numSources := 0
+	if s.Twap != nil {
+		numSources++
+		if s.Twap.PoolId == 0 {
+			return errorsmod.Wrap(ErrInvalidScalingFactorRateSource, "twap pool id must be positive")
+		}
+		if s.Twap.Window <= 0 {
+			return errorsmod.Wrapf(ErrInvalidScalingFactorRateSource, "twap window (%s) must be positive", s.Twap.Window)
+		}
+	}
+	if s.Contract != nil {
+		numSources++
+		if _, err := sdk.AccAddressFromBech32(s.Contract.ContractAddress); err != nil {
+			return errorsmod.Wrapf(ErrInvalidScalingFactorRateSource, "invalid contract address: %s", err)
+		}
+		if !json.Valid(s.Contract.QueryMsg) {
+			return errorsmod.Wrap(ErrInvalidScalingFactorRateSource, "contract query msg must be valid JSON")
+		}
+	}
+	if s.RedemptionRate != nil {
+		numSources++
+		if s.RedemptionRate.Rate.IsNil() || !s.RedemptionRate.Rate.IsPositive() {
+			return NonPositiveRateError{Rate: s.RedemptionRate.Rate}
+		}
+	}
+	if numSources != 1 {
+		return errorsmod.Wrapf(ErrInvalidScalingFactorRateSource, "exactly one of twap, contract and redemption rate must be set, got (%d)", numSources)
+	}
+
+	return nil
+}
+
+// BoundScalingFactor returns the scaling factor the current scaling factor moves to in a single epoch
+// when following the target scaling factor, which is at most max change per epoch of the current one away.
+// The result is rounded to the nearest integer, and is at least 1.
+func (s ScalingFactorRateSource) BoundScalingFactor(current uint64, target osmomath.Dec) uint64 {
+	currentDec := osmomath.NewDecFromInt(osmomath.NewIntFromUint64(current))
+	lowerBound := currentDec.Mul(osmomath.OneDec().Sub(s.MaxChangePerEpoch))
+	upperBound := currentDec.Mul(osmomath.OneDec().Add(s.MaxChangePerEpoch))
+	if target.LT(lowerBound) {
+		target = lowerBound
+	} else if target.GT(upperBound) {
+		target = upperBound
+	}
+
+	bounded := target.RoundInt()
+	if !bounded.IsPositive() {
+		return 1
+	}
+	if !bounded.IsUint64() {
+		return current
+	}
+	return bounded.Uint64()
+}
@@ -16,6 +16,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
+	cosmwasmpooltypes "github.com/osmosis-labs/osmosis/v24/x/cosmwasmpool/types"
 	"github.com/osmosis-labs/osmosis/v24/x/incentives/types"
 	lockuptypes "github.com/osmosis-labs/osmosis/v24/x/lockup/types"
 	poolmanagertypes "github.com/osmosis-labs/osmosis/v24/x/poolmanager/types"
@@ -224,7 +225,9 @@ func (k Keeper) CreateGauge(ctx sdk.Context, isPerpetual bool, owner sdk.AccAddr
 			// superfluid synthetic locks. These locks have the following format:
 			// "cl/pool/1/superbonding/osmovaloper1wcfyglfgjs2xtsyqu7pl60d0mpw5g7f4wh7pnm"
 			// See x/superfluid module README for details.
-			if !k.bk.HasSupply(ctx, distrTo.Denom) && !strings.Contains(distrTo.Denom, "osmovaloper") {
+			// The LP shares of cosmwasm pools are only minted on the first join, after the pool
+			// gauges are created. See x/cosmwasmpool module README for details.
+			if !k.bk.HasSupply(ctx, distrTo.Denom) && !strings.Contains(distrTo.Denom, "osmovaloper") && !k.isCosmWasmPoolShareDenom(ctx, distrTo.Denom) {
 				return 0, fmt.Errorf("denom does not exist: %s", distrTo.Denom)
 			}
 		}
@@ -272,6 +275,21 @@ func (k Keeper) CreateGauge(ctx sdk.Context, isPerpetual bool, owner sdk.AccAddr
 	return gauge.Id, nil
 }
 
+// isCosmWasmPoolShareDenom returns true if the given denom is the LP share denom of an existing
+// cosmwasm pool whose contract supports joining and exiting, and hence mints LP shares.
+func (k Keeper) isCosmWasmPoolShareDenom(ctx sdk.Context, denom string) bool {
+	poolId, err := cosmwasmpooltypes.GetPoolIdFromShareDenom(denom)
+	if err != nil {
+		return false
+	}
+	pool, err := k.pmk.GetPool(ctx, poolId)
+	if err != nil {
+		return false
+	}
+	cosmwasmPool, ok := pool.(cosmwasmpooltypes.CosmWasmExtension)
+	return ok && cosmwasmPool.SupportsCapability(cosmwasmpooltypes.CapabilityJoinExit)
+}
+
 // GetGaugeByID returns gauge from gauge ID.
 func (k Keeper) GetGaugeByID(ctx sdk.Context, gaugeID uint64) (*types.Gauge, error) {
 	gauge := types.Gauge{}
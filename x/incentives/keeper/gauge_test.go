@@ -9,6 +9,7 @@ import (
 
 	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/osmoutils"
+	cosmwasmpooltypes "github.com/osmosis-labs/osmosis/v24/x/cosmwasmpool/types"
 	incentiveskeeper "github.com/osmosis-labs/osmosis/v24/x/incentives/keeper"
 	"github.com/osmosis-labs/osmosis/v24/x/incentives/types"
 	lockuptypes "github.com/osmosis-labs/osmosis/v24/x/lockup/types"
@@ -655,6 +656,76 @@ func (s *KeeperTestSuite) TestCreateGauge_NoLockGauges() {
 	}
 }
 
+// Tests that CreateGauge only allows gauges on the not yet minted LP shares of cosmwasm pools
+// if the pool exists and its contract mints LP shares.
+func (s *KeeperTestSuite) TestCreateGauge_CosmWasmPoolShares() {
+	testCases := map[string]struct {
+		isCosmWasmPool bool
+		hasLPShares    bool
+		denom          func(poolId uint64) string
+
+		expectErr bool
+	}{
+		"cosmwasm pool with LP shares": {
+			isCosmWasmPool: true,
+			hasLPShares:    true,
+			denom:          cosmwasmpooltypes.GetPoolShareDenom,
+		},
+		"error: cosmwasm pool without LP shares": {
+			isCosmWasmPool: true,
+			denom:          cosmwasmpooltypes.GetPoolShareDenom,
+			expectErr:      true,
+		},
+		"error: pool is not a cosmwasm pool": {
+			denom:     cosmwasmpooltypes.GetPoolShareDenom,
+			expectErr: true,
+		},
+		"error: pool does not exist": {
+			denom:     func(poolId uint64) string { return cosmwasmpooltypes.GetPoolShareDenom(poolId + 1) },
+			expectErr: true,
+		},
+		"error: invalid pool id": {
+			denom: func(poolId uint64) string {
+				return fmt.Sprintf("%s%dabc", cosmwasmpooltypes.PoolShareDenomPrefix, poolId)
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			s.SetupTest()
+
+			var poolId uint64
+			if tc.isCosmWasmPool {
+				pool := s.PrepareCosmWasmPool()
+				if tc.hasLPShares {
+					pool.SetCapabilities(cosmwasmpooltypes.MaxSupportedInterfaceVersion, []string{cosmwasmpooltypes.CapabilityJoinExit})
+					s.App.CosmwasmPoolKeeper.SetPool(s.Ctx, pool)
+				}
+				poolId = pool.GetId()
+			} else {
+				poolId = s.PrepareBalancerPool()
+			}
+
+			s.FundAcc(s.TestAccs[0], defaultGaugeCreationCoins)
+			distrTo := lockuptypes.QueryCondition{
+				LockQueryType: lockuptypes.ByDuration,
+				Denom:         tc.denom(poolId),
+				Duration:      time.Hour,
+			}
+
+			// System under test
+			_, err := s.App.IncentivesKeeper.CreateGauge(s.Ctx, defaultIsPerpetualParam, s.TestAccs[0], defaultGaugeCreationCoins, distrTo, defaultTime, defaultNumEpochPaidOver, zeroPoolId)
+			if tc.expectErr {
+				s.Require().ErrorContains(err, "denom does not exist")
+				return
+			}
+			s.Require().NoError(err)
+		})
+	}
+}
+
 // Tests that CreateGauge can create ByGroup gauges correctly.
 // Additionally, validates that no ref keys are created for the group gauge.
 func (s *KeeperTestSuite) TestCreateGauge_Group() {
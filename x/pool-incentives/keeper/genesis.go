@@ -47,10 +47,8 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 			panic(err)
 		}
 
-		if pool.GetType() == poolmanagertypes.CosmWasm {
-			// TODO: remove this post-v19. In v19 we did not create a hook for cw pool gauges.
-			// Fix tracked in:
-			// https://github.com/osmosis-labs/osmosis/issues/6122
+		if pool.GetType() == poolmanagertypes.CosmWasm && !hasCosmWasmPoolShares(pool) {
+			// Only cosmwasm pools with LP shares have lockable gauges.
 			continue
 		}
 
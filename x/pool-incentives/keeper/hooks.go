@@ -81,3 +81,23 @@ func (h Hooks) AfterLastPoolPositionRemoved(ctx sdk.Context, sender sdk.AccAddre
 // AfterConcentratedPoolSwap is a noop.
 func (h Hooks) AfterConcentratedPoolSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins) {
 }
+
+// AfterCosmWasmPoolCreated creates a gauge for each lockable duration if the pool mints LP shares.
+func (h Hooks) AfterCosmWasmPoolCreated(ctx sdk.Context, sender sdk.AccAddress, poolId uint64) {
+	err := h.k.CreateCosmWasmPoolGauges(ctx, poolId)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// AfterCosmWasmPoolSwap is a noop.
+func (h Hooks) AfterCosmWasmPoolSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins) {
+}
+
+// AfterCosmWasmPoolJoin is a noop.
+func (h Hooks) AfterCosmWasmPoolJoin(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, tokensIn sdk.Coins, shareOutAmount osmomath.Int) {
+}
+
+// AfterCosmWasmPoolExit is a noop.
+func (h Hooks) AfterCosmWasmPoolExit(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, shareInAmount osmomath.Int, tokensOut sdk.Coins) {
+}
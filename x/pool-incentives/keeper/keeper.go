@@ -9,6 +9,7 @@ import (
 	"github.com/cometbft/cometbft/libs/log"
 
 	"github.com/osmosis-labs/osmosis/osmoutils"
+	cosmwasmpooltypes "github.com/osmosis-labs/osmosis/v24/x/cosmwasmpool/types"
 	gammtypes "github.com/osmosis-labs/osmosis/v24/x/gamm/types"
 	incentivestypes "github.com/osmosis-labs/osmosis/v24/x/incentives/types"
 	lockuptypes "github.com/osmosis-labs/osmosis/v24/x/lockup/types"
@@ -70,6 +71,36 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 
 // CreateLockablePoolGauges create multiple gauges based on lockableDurations.
 func (k Keeper) CreateLockablePoolGauges(ctx sdk.Context, poolId uint64) error {
+	return k.createLockablePoolGauges(ctx, poolId, gammtypes.GetPoolShareDenom(poolId))
+}
+
+// CreateCosmWasmPoolGauges creates the lockable gauges of a cosmwasm pool whose contract supports
+// joining and exiting, distributing to locks of its LP shares. It is a no-op for other cosmwasm pools,
+// since they have no LP shares to lock.
+func (k Keeper) CreateCosmWasmPoolGauges(ctx sdk.Context, poolId uint64) error {
+	pool, err := k.poolmanagerKeeper.GetPool(ctx, poolId)
+	if err != nil {
+		return err
+	}
+	if pool.GetType() != poolmanagertypes.CosmWasm {
+		return fmt.Errorf("pool %d is not cosmwasm pool", poolId)
+	}
+	if !hasCosmWasmPoolShares(pool) {
+		return nil
+	}
+	return k.createLockablePoolGauges(ctx, poolId, cosmwasmpooltypes.GetPoolShareDenom(poolId))
+}
+
+// hasCosmWasmPoolShares returns true if the given cosmwasm pool mints LP shares,
+// i.e. if its contract supports joining and exiting.
+func hasCosmWasmPoolShares(pool poolmanagertypes.PoolI) bool {
+	cosmwasmPool, ok := pool.(cosmwasmpooltypes.CosmWasmExtension)
+	return ok && cosmwasmPool.SupportsCapability(cosmwasmpooltypes.CapabilityJoinExit)
+}
+
+// createLockablePoolGauges creates a gauge distributing to locks of the given share denom
+// for each lockable duration.
+func (k Keeper) createLockablePoolGauges(ctx sdk.Context, poolId uint64, shareDenom string) error {
 	// Create the same number of gauges as there are LockableDurations
 	for _, lockableDuration := range k.GetLockableDurations(ctx) {
 		gaugeId, err := k.incentivesKeeper.CreateGauge(
@@ -79,7 +110,7 @@ func (k Keeper) CreateLockablePoolGauges(ctx sdk.Context, poolId uint64) error {
 			sdk.Coins{},
 			lockuptypes.QueryCondition{
 				LockQueryType: lockuptypes.ByDuration,
-				Denom:         gammtypes.GetPoolShareDenom(poolId),
+				Denom:         shareDenom,
 				Duration:      lockableDuration,
 				Timestamp:     time.Time{},
 			},
@@ -183,7 +214,7 @@ func (k Keeper) SetPoolGaugeIdNoLock(ctx sdk.Context, poolId uint64, gaugeId uin
 // For CL pools, it assumes the gauge with the incentive module epoch duration.
 // Returns gauge ID on success, returns error if:
 // - fails to get pool
-// - given pool type does not support incentives (e.g. CW pools at the time of writing)
+// - given pool type does not support incentives, or is a CW pool whose contract does not mint LP shares
 // - fails to get the gauge ID for the given poolID and inferred lockable duration
 func (k Keeper) GetInternalGaugeIDForPool(ctx sdk.Context, poolID uint64) (uint64, error) {
 	pool, err := k.poolmanagerKeeper.GetPool(ctx, poolID)
@@ -201,6 +232,15 @@ func (k Keeper) GetInternalGaugeIDForPool(ctx sdk.Context, poolID uint64) (uint6
 		if err != nil {
 			return 0, err
 		}
+	case poolmanagertypes.CosmWasm:
+		// Only cosmwasm pools with LP shares have lockable gauges.
+		if !hasCosmWasmPoolShares(pool) {
+			return 0, types.UnsupportedPoolTypeError{PoolID: poolID, PoolType: poolType}
+		}
+		gaugeDuration, err = k.GetLongestLockableDuration(ctx)
+		if err != nil {
+			return 0, err
+		}
 	default:
 		return 0, types.UnsupportedPoolTypeError{PoolID: poolID, PoolType: poolType}
 	}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/v24/app/apptesting"
+	cosmwasmpooltypes "github.com/osmosis-labs/osmosis/v24/x/cosmwasmpool/types"
 	gammtypes "github.com/osmosis-labs/osmosis/v24/x/gamm/types"
 	incentivestypes "github.com/osmosis-labs/osmosis/v24/x/incentives/types"
 	"github.com/osmosis-labs/osmosis/v24/x/pool-incentives/types"
@@ -183,6 +184,78 @@ func (s *KeeperTestSuite) TestCreateLockablePoolGauges() {
 	}
 }
 
+func (s *KeeperTestSuite) TestCreateCosmWasmPoolGauges() {
+	tests := map[string]struct {
+		poolType     poolmanagertypes.PoolType
+		hasLPShares  bool
+		expectGauges bool
+		expectedErr  bool
+	}{
+		"cosmwasm pool with LP shares": {
+			poolType:     poolmanagertypes.CosmWasm,
+			hasLPShares:  true,
+			expectGauges: true,
+		},
+		"cosmwasm pool without LP shares": {
+			poolType: poolmanagertypes.CosmWasm,
+		},
+		"error: balancer pool": {
+			poolType:    poolmanagertypes.Balancer,
+			expectedErr: true,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+
+			var poolId uint64
+			if tc.poolType == poolmanagertypes.CosmWasm {
+				pool := s.PrepareCosmWasmPool()
+				if tc.hasLPShares {
+					pool.SetCapabilities(cosmwasmpooltypes.MaxSupportedInterfaceVersion, []string{cosmwasmpooltypes.CapabilityJoinExit})
+					s.App.CosmwasmPoolKeeper.SetPool(s.Ctx, pool)
+				}
+				poolId = pool.GetId()
+			} else {
+				poolId = s.PrepareBalancerPool()
+			}
+
+			err := s.App.PoolIncentivesKeeper.CreateCosmWasmPoolGauges(s.Ctx, poolId)
+			if tc.expectedErr {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			for _, duration := range s.App.PoolIncentivesKeeper.GetLockableDurations(s.Ctx) {
+				gaugeId, err := s.App.PoolIncentivesKeeper.GetPoolGaugeId(s.Ctx, poolId, duration)
+				if !tc.expectGauges {
+					s.Require().ErrorIs(err, types.NoGaugeAssociatedWithPoolError{PoolId: poolId, Duration: duration})
+					continue
+				}
+				s.Require().NoError(err)
+
+				gaugeInfo, err := s.App.IncentivesKeeper.GetGaugeByID(s.Ctx, gaugeId)
+				s.Require().NoError(err)
+				s.Require().True(gaugeInfo.IsPerpetual)
+				s.Require().Equal(duration, gaugeInfo.DistributeTo.Duration)
+				s.Require().Equal(cosmwasmpooltypes.GetPoolShareDenom(poolId), gaugeInfo.DistributeTo.Denom)
+			}
+
+			if tc.expectGauges {
+				longestLockableDuration, err := s.App.PoolIncentivesKeeper.GetLongestLockableDuration(s.Ctx)
+				s.Require().NoError(err)
+				expectedGaugeId, err := s.App.PoolIncentivesKeeper.GetPoolGaugeId(s.Ctx, poolId, longestLockableDuration)
+				s.Require().NoError(err)
+				gaugeId, err := s.App.PoolIncentivesKeeper.GetInternalGaugeIDForPool(s.Ctx, poolId)
+				s.Require().NoError(err)
+				s.Require().Equal(expectedGaugeId, gaugeId)
+			}
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestCreateConcentratedLiquidityPoolGauge() {
 	tests := []struct {
 		name            string
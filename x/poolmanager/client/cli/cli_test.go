@@ -3,6 +3,7 @@ package cli_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/cosmos/gogoproto/proto"
 	"github.com/stretchr/testify/suite"
@@ -185,6 +186,98 @@ func TestNewSwapExactAmountInCmd(t *testing.T) {
 	osmocli.RunTxTestCases(t, desc, tcs)
 }
 
+func TestNewSwapExactAmountInWithPriceLimitCmd(t *testing.T) {
+	desc, _ := cli.NewSwapExactAmountInWithPriceLimitCmd()
+	tcs := map[string]osmocli.TxCliTestCase[*types.MsgSwapExactAmountInWithPriceLimit]{
+		"swap exact amount in with max price impact": {
+			Cmd: "10stake 0.01 0 false --swap-route-pool-ids=1 --swap-route-denoms=node0token --from=" + testAddresses[0].String(),
+			ExpectedMsg: &types.MsgSwapExactAmountInWithPriceLimit{
+				Sender:         testAddresses[0].String(),
+				Routes:         []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: "node0token"}},
+				TokenIn:        sdk.NewInt64Coin("stake", 10),
+				MaxPriceImpact: osmomath.MustNewDecFromStr("0.01"),
+				LimitPrice:     osmomath.ZeroDec(),
+			},
+		},
+		"swap exact amount in with limit price and partial fill": {
+			Cmd: "10stake 0 1.5 true --swap-route-pool-ids=1 --swap-route-denoms=node0token --from=" + testAddresses[0].String(),
+			ExpectedMsg: &types.MsgSwapExactAmountInWithPriceLimit{
+				Sender:           testAddresses[0].String(),
+				Routes:           []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: "node0token"}},
+				TokenIn:          sdk.NewInt64Coin("stake", 10),
+				MaxPriceImpact:   osmomath.ZeroDec(),
+				LimitPrice:       osmomath.MustNewDecFromStr("1.5"),
+				AllowPartialFill: true,
+			},
+		},
+	}
+	osmocli.RunTxTestCases(t, desc, tcs)
+}
+
+func TestNewCreateTwapOrderCmd(t *testing.T) {
+	desc, _ := cli.NewCreateTwapOrderCmd()
+	tcs := map[string]osmocli.TxCliTestCase[*types.MsgCreateTwapOrder]{
+		"create twap order": {
+			Cmd: "1000stake 10 1h 0.02 --swap-route-pool-ids=1 --swap-route-denoms=node0token --from=" + testAddresses[0].String(),
+			ExpectedMsg: &types.MsgCreateTwapOrder{
+				Sender:      testAddresses[0].String(),
+				Routes:      []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: "node0token"}},
+				TokenIn:     sdk.NewInt64Coin("stake", 1000),
+				NumSlices:   10,
+				Interval:    time.Hour,
+				MaxSlippage: osmomath.MustNewDecFromStr("0.02"),
+			},
+		},
+	}
+	osmocli.RunTxTestCases(t, desc, tcs)
+}
+
+func TestNewCancelTwapOrderCmd(t *testing.T) {
+	desc, _ := cli.NewCancelTwapOrderCmd()
+	tcs := map[string]osmocli.TxCliTestCase[*types.MsgCancelTwapOrder]{
+		"cancel twap order": {
+			Cmd: "1 --from=" + testAddresses[0].String(),
+			ExpectedMsg: &types.MsgCancelTwapOrder{
+				Sender:  testAddresses[0].String(),
+				OrderId: 1,
+			},
+		},
+	}
+	osmocli.RunTxTestCases(t, desc, tcs)
+}
+
+func TestNewJoinPoolCmd(t *testing.T) {
+	desc, _ := cli.NewJoinPoolCmd()
+	tcs := map[string]osmocli.TxCliTestCase[*types.MsgJoinPool]{
+		"join pool": {
+			Cmd: "1 100node0token,100stake 10 --from=" + testAddresses[0].String(),
+			ExpectedMsg: &types.MsgJoinPool{
+				Sender:            testAddresses[0].String(),
+				PoolId:            1,
+				TokensIn:          sdk.NewCoins(sdk.NewInt64Coin("node0token", 100), sdk.NewInt64Coin("stake", 100)),
+				ShareOutMinAmount: osmomath.NewInt(10),
+			},
+		},
+	}
+	osmocli.RunTxTestCases(t, desc, tcs)
+}
+
+func TestNewExitPoolCmd(t *testing.T) {
+	desc, _ := cli.NewExitPoolCmd()
+	tcs := map[string]osmocli.TxCliTestCase[*types.MsgExitPool]{
+		"exit pool": {
+			Cmd: "1 10 1node0token,1stake --from=" + testAddresses[0].String(),
+			ExpectedMsg: &types.MsgExitPool{
+				Sender:        testAddresses[0].String(),
+				PoolId:        1,
+				ShareInAmount: osmomath.NewInt(10),
+				TokenOutMins:  sdk.NewCoins(sdk.NewInt64Coin("node0token", 1), sdk.NewInt64Coin("stake", 1)),
+			},
+		},
+	}
+	osmocli.RunTxTestCases(t, desc, tcs)
+}
+
 func TestGetCmdNumPools(t *testing.T) {
 	desc, _ := cli.GetCmdNumPools()
 	tcs := map[string]osmocli.QueryCliTestCase[*queryproto.NumPoolsRequest]{
@@ -27,6 +27,16 @@ const (
 	FlagSwapRouteDenoms = "swap-route-denoms"
 	// Will be parsed to string.
 	FlagRoutesFile = "routes-file"
+	// Will be parsed to []osmomath.Dec.
+	FlagPriceChanges = "price-changes"
+	// Will be parsed to string.
+	FlagSender = "sender"
+	// Will be parsed to uint32.
+	FlagMaxRoutes = "max-routes"
+	// Will be parsed to uint32.
+	FlagMaxHops = "max-hops"
+	// Will be parsed to osmomath.Dec.
+	FlagMaxSlippage = "max-slippage"
 )
 
 type createBalancerPoolInputs struct {
@@ -93,6 +103,23 @@ func FlagSetSwapAmountOutRoutes() *flag.FlagSet {
 	return fs
 }
 
+func FlagSetPoolDepth() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.StringSlice(FlagPriceChanges, []string{}, "comma-separated relative price changes to compute the depth at, e.g. 0.01,0.02 (defaults to 0.01,0.02,0.05,0.1)")
+	return fs
+}
+
+func FlagSetOptimalSplitRoute() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.String(FlagSender, "", "sender of the returned swap message")
+	fs.Uint32(FlagMaxRoutes, 0, "maximum number of routes to split the token in across, at most 5 (defaults to 3)")
+	fs.Uint32(FlagMaxHops, 0, "maximum number of pools in each route, at most 3 (defaults to 2)")
+	fs.String(FlagMaxSlippage, "0", "fraction of the estimated token out amount the token out min amount of the swap message is lowered by")
+	return fs
+}
+
 func FlagSetCreatePool() *flag.FlagSet {
 	fs := flag.NewFlagSet("", flag.ContinueOnError)
 
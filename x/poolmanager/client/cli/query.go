@@ -7,6 +7,7 @@ import (
 	"github.com/spf13/cobra"
 	flag "github.com/spf13/pflag"
 
+	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/osmoutils/osmocli"
 	"github.com/osmosis-labs/osmosis/v24/x/poolmanager/client/queryproto"
 	"github.com/osmosis-labs/osmosis/v24/x/poolmanager/types"
@@ -23,6 +24,7 @@ func GetQueryCmd() *cobra.Command {
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdNumPools)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdEstimateSwapExactAmountIn)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdEstimateSwapExactAmountOut)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdEstimateSwapExactAmountInWithTrace)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdEstimateSinglePoolSwapExactAmountIn)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdEstimateSinglePoolSwapExactAmountOut)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdSpotPrice)
@@ -33,6 +35,9 @@ func GetQueryCmd() *cobra.Command {
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdTradingPairTakerFee)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdEstimateTradeBasedOnPriceImpact)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdListPoolsByDenom)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdPoolDepth)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdOptimalSplitRoute)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdTwapOrder)
 	cmd.AddCommand(
 		osmocli.GetParams[*queryproto.ParamsRequest](
 			types.ModuleName, queryproto.NewQueryClient),
@@ -55,6 +60,21 @@ func GetCmdEstimateSwapExactAmountIn() (*osmocli.QueryDescriptor, *queryproto.Es
 	}, &queryproto.EstimateSwapExactAmountInRequest{}
 }
 
+// GetCmdEstimateSwapExactAmountInWithTrace returns estimation of output coin when amount of x token input,
+// together with the execution trace of each hop of the route.
+func GetCmdEstimateSwapExactAmountInWithTrace() (*osmocli.QueryDescriptor, *queryproto.EstimateSwapExactAmountInWithTraceRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "estimate-swap-exact-amount-in-with-trace",
+		Short: "Query estimate-swap-exact-amount-in-with-trace",
+		Long: `Query estimate-swap-exact-amount-in-with-trace.{{.ExampleHeader}}
+{{.CommandPrefix}} estimate-swap-exact-amount-in-with-trace 1000stake --swap-route-pool-ids=2 --swap-route-denoms=uosmo`,
+		ParseQuery:          EstimateSwapExactAmountInWithTraceParseArgs,
+		Flags:               osmocli.FlagDesc{RequiredFlags: []*flag.FlagSet{FlagSetMultihopSwapRoutes()}},
+		QueryFnName:         "EstimateSwapExactAmountInWithTrace",
+		CustomFlagOverrides: customRouterFlagOverride,
+	}, &queryproto.EstimateSwapExactAmountInWithTraceRequest{}
+}
+
 // GetCmdEstimateSwapExactAmountOut returns estimation of input coin to get exact amount of x token output.
 func GetCmdEstimateSwapExactAmountOut() (*osmocli.QueryDescriptor, *queryproto.EstimateSwapExactAmountOutRequest) {
 	return &osmocli.QueryDescriptor{
@@ -97,6 +117,16 @@ func GetCmdPool() (*osmocli.QueryDescriptor, *queryproto.PoolRequest) {
 	}, &queryproto.PoolRequest{}
 }
 
+// GetCmdTwapOrder returns the TWAP order with the given id.
+func GetCmdTwapOrder() (*osmocli.QueryDescriptor, *queryproto.TwapOrderRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "twap-order",
+		Short: "Query a TWAP order",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} twap-order 1`,
+	}, &queryproto.TwapOrderRequest{}
+}
+
 func GetCmdSpotPrice() (*osmocli.QueryDescriptor, *queryproto.SpotPriceRequest) {
 	return &osmocli.QueryDescriptor{
 		Use:   "spot-price",
@@ -116,6 +146,101 @@ func GetCmdListPoolsByDenom() (*osmocli.QueryDescriptor, *queryproto.ListPoolsBy
 	}, &queryproto.ListPoolsByDenomRequest{}
 }
 
+// GetCmdPoolDepth returns the depth of a pool around the spot price of the base asset.
+func GetCmdPoolDepth() (*osmocli.QueryDescriptor, *queryproto.PoolDepthRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "pool-depth",
+		Short: "Query the depth of a pool at price levels around the spot price of the base asset",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} pool-depth 1 uosmo uion --price-changes=0.01,0.02`,
+		ParseQuery:          PoolDepthParseArgs,
+		Flags:               osmocli.FlagDesc{OptionalFlags: []*flag.FlagSet{FlagSetPoolDepth()}},
+		QueryFnName:         "PoolDepth",
+		CustomFlagOverrides: map[string]string{"PriceChanges": FlagPriceChanges},
+	}, &queryproto.PoolDepthRequest{}
+}
+
+func PoolDepthParseArgs(args []string, fs *flag.FlagSet) (proto.Message, error) {
+	poolID, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	priceChangeStrs, err := fs.GetStringSlice(FlagPriceChanges)
+	if err != nil {
+		return nil, err
+	}
+	priceChanges := make([]osmomath.Dec, len(priceChangeStrs))
+	for i, priceChangeStr := range priceChangeStrs {
+		priceChanges[i], err = osmomath.NewDecFromStr(priceChangeStr)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &queryproto.PoolDepthRequest{
+		PoolId:          poolID,
+		BaseAssetDenom:  args[1],
+		QuoteAssetDenom: args[2],
+		PriceChanges:    priceChanges,
+	}, nil
+}
+
+// GetCmdOptimalSplitRoute returns the routes maximizing the token out amount of swapping the token in,
+// together with the message executing the swap through them.
+func GetCmdOptimalSplitRoute() (*osmocli.QueryDescriptor, *queryproto.OptimalSplitRouteRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "optimal-split-route",
+		Short: "Query the routes maximizing the token out amount of a swap, split across up to max-routes routes",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} optimal-split-route 1000000uosmo uion --max-routes=3 --max-hops=2 --max-slippage=0.01 --sender=osmo1...`,
+		ParseQuery:  OptimalSplitRouteParseArgs,
+		Flags:       osmocli.FlagDesc{OptionalFlags: []*flag.FlagSet{FlagSetOptimalSplitRoute()}},
+		QueryFnName: "OptimalSplitRoute",
+		CustomFlagOverrides: map[string]string{
+			"Sender":      FlagSender,
+			"MaxRoutes":   FlagMaxRoutes,
+			"MaxHops":     FlagMaxHops,
+			"MaxSlippage": FlagMaxSlippage,
+		},
+	}, &queryproto.OptimalSplitRouteRequest{}
+}
+
+func OptimalSplitRouteParseArgs(args []string, fs *flag.FlagSet) (proto.Message, error) {
+	sender, err := fs.GetString(FlagSender)
+	if err != nil {
+		return nil, err
+	}
+
+	maxRoutes, err := fs.GetUint32(FlagMaxRoutes)
+	if err != nil {
+		return nil, err
+	}
+
+	maxHops, err := fs.GetUint32(FlagMaxHops)
+	if err != nil {
+		return nil, err
+	}
+
+	maxSlippageStr, err := fs.GetString(FlagMaxSlippage)
+	if err != nil {
+		return nil, err
+	}
+	maxSlippage, err := osmomath.NewDecFromStr(maxSlippageStr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &queryproto.OptimalSplitRouteRequest{
+		Sender:        sender,
+		TokenIn:       args[0],
+		TokenOutDenom: args[1],
+		MaxRoutes:     maxRoutes,
+		MaxHops:       maxHops,
+		MaxSlippage:   maxSlippage,
+	}, nil
+}
+
 func EstimateSwapExactAmountInParseArgs(args []string, fs *flag.FlagSet) (proto.Message, error) {
 	poolID, err := strconv.Atoi(args[0])
 	if err != nil {
@@ -134,6 +259,18 @@ func EstimateSwapExactAmountInParseArgs(args []string, fs *flag.FlagSet) (proto.
 	}, nil
 }
 
+func EstimateSwapExactAmountInWithTraceParseArgs(args []string, fs *flag.FlagSet) (proto.Message, error) {
+	routes, err := swapAmountInRoutes(fs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &queryproto.EstimateSwapExactAmountInWithTraceRequest{
+		TokenIn: args[0],
+		Routes:  routes,
+	}, nil
+}
+
 func EstimateSwapExactAmountOutParseArgs(args []string, fs *flag.FlagSet) (proto.Message, error) {
 	poolID, err := strconv.Atoi(args[0])
 	if err != nil {
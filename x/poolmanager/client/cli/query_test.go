@@ -71,6 +71,16 @@ func (s *QueryTestSuite) TestQueriesNeverAlterState() {
 			},
 			&poolmanagerqueryproto.EstimateTradeBasedOnPriceImpactResponse{},
 		},
+		{
+			"Query optimal split route",
+			"/osmosis.poolmanager.v1beta1.Query/OptimalSplitRoute",
+			&poolmanagerqueryproto.OptimalSplitRouteRequest{
+				TokenIn:       "10bar",
+				TokenOutDenom: "baz",
+				MaxSlippage:   sdk.MustNewDecFromStr("0.01"),
+			},
+			&poolmanagerqueryproto.OptimalSplitRouteResponse{},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -37,6 +37,11 @@ func NewTxCmd() *cobra.Command {
 	osmocli.AddTxCmd(txCmd, NewSwapExactAmountOutCmd)
 	osmocli.AddTxCmd(txCmd, NewSplitRouteSwapExactAmountIn)
 	osmocli.AddTxCmd(txCmd, NewSplitRouteSwapExactAmountOut)
+	osmocli.AddTxCmd(txCmd, NewSwapExactAmountInWithPriceLimitCmd)
+	osmocli.AddTxCmd(txCmd, NewCreateTwapOrderCmd)
+	osmocli.AddTxCmd(txCmd, NewCancelTwapOrderCmd)
+	osmocli.AddTxCmd(txCmd, NewJoinPoolCmd)
+	osmocli.AddTxCmd(txCmd, NewExitPoolCmd)
 	txCmd.AddCommand(NewSetDenomPairTakerFeeCmd())
 
 	txCmd.AddCommand(
@@ -58,6 +63,56 @@ func NewSwapExactAmountInCmd() (*osmocli.TxCliDesc, *types.MsgSwapExactAmountIn)
 	}, &types.MsgSwapExactAmountIn{}
 }
 
+func NewSwapExactAmountInWithPriceLimitCmd() (*osmocli.TxCliDesc, *types.MsgSwapExactAmountInWithPriceLimit) {
+	return &osmocli.TxCliDesc{
+		Use:     "swap-exact-amount-in-with-price-limit",
+		Short:   "swap exact amount in as long as the execution price is within a max price impact, or a limit price",
+		Long:    "Swap exact amount in as long as the execution price is within a max price impact, or a limit price. Exactly one of them must be positive, the other one zero. If allow-partial-fill is true, swap as much of the token in as the limit allows.",
+		Example: "osmosisd tx poolmanager swap-exact-amount-in-with-price-limit 2000000uosmo 0.01 0 true --swap-route-pool-ids 5 --swap-route-denoms uion --from val --keyring-backend test -b=block --chain-id=localosmosis --fees 10000uosmo",
+		CustomFieldParsers: map[string]osmocli.CustomFieldParserFn{
+			"Routes": osmocli.FlagOnlyParser(swapAmountInRoutes),
+		},
+		Flags: osmocli.FlagDesc{RequiredFlags: []*flag.FlagSet{FlagSetMultihopSwapRoutes()}},
+	}, &types.MsgSwapExactAmountInWithPriceLimit{}
+}
+
+func NewCreateTwapOrderCmd() (*osmocli.TxCliDesc, *types.MsgCreateTwapOrder) {
+	return &osmocli.TxCliDesc{
+		Use:     "create-twap-order",
+		Short:   "create a TWAP order swapping the token in along the route in equal slices spaced by an interval",
+		Long:    "Create a TWAP order escrowing the token in and swapping it along the route in num-slices equal slices, the first at the end of the current block and the following ones every interval. Every slice must swap out at least the amount given by the TWAP of the route reduced by max-slippage, or is skipped and its token in spread over the following slices.",
+		Example: "osmosisd tx poolmanager create-twap-order 1000000000uosmo 24 1h 0.02 --swap-route-pool-ids 1 --swap-route-denoms uion --from val --keyring-backend test -b=block --chain-id=localosmosis --fees 10000uosmo",
+		CustomFieldParsers: map[string]osmocli.CustomFieldParserFn{
+			"Routes": osmocli.FlagOnlyParser(swapAmountInRoutes),
+		},
+		Flags: osmocli.FlagDesc{RequiredFlags: []*flag.FlagSet{FlagSetMultihopSwapRoutes()}},
+	}, &types.MsgCreateTwapOrder{}
+}
+
+func NewCancelTwapOrderCmd() (*osmocli.TxCliDesc, *types.MsgCancelTwapOrder) {
+	return &osmocli.TxCliDesc{
+		Use:     "cancel-twap-order",
+		Short:   "cancel a TWAP order and return its remaining token in",
+		Example: "osmosisd tx poolmanager cancel-twap-order 1 --from val --keyring-backend test -b=block --chain-id=localosmosis --fees 10000uosmo",
+	}, &types.MsgCancelTwapOrder{}
+}
+
+func NewJoinPoolCmd() (*osmocli.TxCliDesc, *types.MsgJoinPool) {
+	return &osmocli.TxCliDesc{
+		Use:     "join-pool",
+		Short:   "join a pool whose module supports joins through poolmanager, in exchange for LP shares",
+		Example: "osmosisd tx poolmanager join-pool 1 1000000uosmo,1000000uion 1 --from val --keyring-backend test -b=block --chain-id=localosmosis --fees 10000uosmo",
+	}, &types.MsgJoinPool{}
+}
+
+func NewExitPoolCmd() (*osmocli.TxCliDesc, *types.MsgExitPool) {
+	return &osmocli.TxCliDesc{
+		Use:     "exit-pool",
+		Short:   "exit a pool whose module supports exits through poolmanager, burning LP shares",
+		Example: "osmosisd tx poolmanager exit-pool 1 1000000 1uosmo,1uion --from val --keyring-backend test -b=block --chain-id=localosmosis --fees 10000uosmo",
+	}, &types.MsgExitPool{}
+}
+
 func NewSwapExactAmountOutCmd() (*osmocli.TxCliDesc, *types.MsgSwapExactAmountOut) {
 	// Can't get rid of this parser without a break, because the args are out of order.
 	return &osmocli.TxCliDesc{
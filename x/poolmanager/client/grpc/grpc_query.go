@@ -21,6 +21,16 @@ type Querier struct {
 
 var _ queryproto.QueryServer = Querier{}
 
+func (q Querier) TwapOrder(grpcCtx context.Context,
+	req *queryproto.TwapOrderRequest,
+) (*queryproto.TwapOrderResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.TwapOrder(ctx, *req)
+}
+
 func (q Querier) TradingPairTakerFee(grpcCtx context.Context,
 	req *queryproto.TradingPairTakerFeeRequest,
 ) (*queryproto.TradingPairTakerFeeResponse, error) {
@@ -71,6 +81,16 @@ func (q Querier) SpotPrice(grpcCtx context.Context,
 	return q.Q.SpotPrice(ctx, *req)
 }
 
+func (q Querier) PoolDepth(grpcCtx context.Context,
+	req *queryproto.PoolDepthRequest,
+) (*queryproto.PoolDepthResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.PoolDepth(ctx, *req)
+}
+
 func (q Querier) Pool(grpcCtx context.Context,
 	req *queryproto.PoolRequest,
 ) (*queryproto.PoolResponse, error) {
@@ -91,6 +111,16 @@ func (q Querier) Params(grpcCtx context.Context,
 	return q.Q.Params(ctx, *req)
 }
 
+func (q Querier) OptimalSplitRoute(grpcCtx context.Context,
+	req *queryproto.OptimalSplitRouteRequest,
+) (*queryproto.OptimalSplitRouteResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.OptimalSplitRoute(ctx, *req)
+}
+
 func (q Querier) NumPools(grpcCtx context.Context,
 	req *queryproto.NumPoolsRequest,
 ) (*queryproto.NumPoolsResponse, error) {
@@ -141,6 +171,16 @@ func (q Querier) EstimateSwapExactAmountOut(grpcCtx context.Context,
 	return q.Q.EstimateSwapExactAmountOut(ctx, *req)
 }
 
+func (q Querier) EstimateSwapExactAmountInWithTrace(grpcCtx context.Context,
+	req *queryproto.EstimateSwapExactAmountInWithTraceRequest,
+) (*queryproto.EstimateSwapExactAmountInWithTraceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.EstimateSwapExactAmountInWithTrace(ctx, *req)
+}
+
 func (q Querier) EstimateSwapExactAmountInWithPrimitiveTypes(grpcCtx context.Context,
 	req *queryproto.EstimateSwapExactAmountInWithPrimitiveTypesRequest,
 ) (*queryproto.EstimateSwapExactAmountInResponse, error) {
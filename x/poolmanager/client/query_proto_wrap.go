@@ -7,6 +7,7 @@ import (
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 
+	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/v24/x/poolmanager"
 	"github.com/osmosis-labs/osmosis/v24/x/poolmanager/client/queryproto"
 	"github.com/osmosis-labs/osmosis/v24/x/poolmanager/client/queryprotov2"
@@ -61,6 +62,29 @@ func (q Querier) EstimateSwapExactAmountIn(ctx sdk.Context, req queryproto.Estim
 	}, nil
 }
 
+// EstimateSwapExactAmountInWithTrace estimates the token out amount of a swap along the given routes,
+// together with the execution trace of each hop.
+func (q Querier) EstimateSwapExactAmountInWithTrace(ctx sdk.Context, req queryproto.EstimateSwapExactAmountInWithTraceRequest) (*queryproto.EstimateSwapExactAmountInWithTraceResponse, error) {
+	if req.TokenIn == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid token")
+	}
+
+	tokenIn, err := sdk.ParseCoinNormalized(req.TokenIn)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid token: %s", err.Error())
+	}
+
+	tokenOutAmount, hops, err := q.K.MultihopEstimateOutGivenExactAmountInWithTrace(ctx, req.Routes, tokenIn)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &queryproto.EstimateSwapExactAmountInWithTraceResponse{
+		TokenOutAmount: tokenOutAmount,
+		Hops:           hops,
+	}, nil
+}
+
 // EstimateSwapExactAmountInWithPrimitiveTypes runs same logic with EstimateSwapExactAmountIn
 // but instead takes array of primitive types in the request to support query through grpc-gateway.
 func (q Querier) EstimateSwapExactAmountInWithPrimitiveTypes(ctx sdk.Context, req queryproto.EstimateSwapExactAmountInWithPrimitiveTypesRequest) (*queryproto.EstimateSwapExactAmountInResponse, error) {
@@ -332,6 +356,85 @@ func (q Querier) TradingPairTakerFee(ctx sdk.Context, req queryproto.TradingPair
 	}, nil
 }
 
+// PoolDepth returns the depth of the pool around the spot price of the given base asset.
+func (q Querier) PoolDepth(ctx sdk.Context, req queryproto.PoolDepthRequest) (*queryproto.PoolDepthResponse, error) {
+	if req.BaseAssetDenom == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid base asset denom")
+	}
+
+	if req.QuoteAssetDenom == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid quote asset denom")
+	}
+
+	spotPrice, asks, bids, err := q.K.GetPoolDepth(ctx, req.PoolId, req.BaseAssetDenom, req.QuoteAssetDenom, req.PriceChanges)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &queryproto.PoolDepthResponse{
+		SpotPrice: spotPrice.Dec(),
+		Asks:      asks,
+		Bids:      bids,
+	}, nil
+}
+
+// OptimalSplitRoute returns the routes maximizing the token out amount of swapping the given token in,
+// together with the message executing the swap through them.
+func (q Querier) OptimalSplitRoute(ctx sdk.Context, req queryproto.OptimalSplitRouteRequest) (*queryproto.OptimalSplitRouteResponse, error) {
+	if req.Sender != "" {
+		if _, err := sdk.AccAddressFromBech32(req.Sender); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid sender: %s", err.Error())
+		}
+	}
+
+	if req.TokenIn == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid token")
+	}
+
+	tokenIn, err := sdk.ParseCoinNormalized(req.TokenIn)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid token: %s", err.Error())
+	}
+
+	if err := sdk.ValidateDenom(req.TokenOutDenom); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid token out denom: %s", err.Error())
+	}
+
+	maxSlippage := req.MaxSlippage
+	if maxSlippage.IsNil() {
+		maxSlippage = osmomath.ZeroDec()
+	}
+	if maxSlippage.IsNegative() || maxSlippage.GTE(osmomath.OneDec()) {
+		return nil, status.Error(codes.InvalidArgument, "max slippage must be in [0, 1)")
+	}
+
+	routes, tokenOutAmount, err := q.K.FindOptimalSplitRoute(ctx, tokenIn, req.TokenOutDenom, req.MaxRoutes, req.MaxHops)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &queryproto.OptimalSplitRouteResponse{
+		Routes:         routes,
+		TokenOutAmount: tokenOutAmount,
+		Msg: types.MsgSplitRouteSwapExactAmountIn{
+			Sender:            req.Sender,
+			Routes:            routes,
+			TokenInDenom:      tokenIn.Denom,
+			TokenOutMinAmount: tokenOutAmount.ToLegacyDec().Mul(osmomath.OneDec().Sub(maxSlippage)).TruncateInt(),
+		},
+	}, nil
+}
+
+// TwapOrder returns the TWAP order with the given id.
+func (q Querier) TwapOrder(ctx sdk.Context, req queryproto.TwapOrderRequest) (*queryproto.TwapOrderResponse, error) {
+	order, err := q.K.GetTwapOrder(ctx, req.OrderId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &queryproto.TwapOrderResponse{Order: order}, nil
+}
+
 // EstimateTradeBasedOnPriceImpact returns the input and output amount of coins for a pool trade
 // based on external price and maximum price impact.
 func (q Querier) EstimateTradeBasedOnPriceImpact(
@@ -0,0 +1,58 @@
+package poolmanager
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v24/x/poolmanager/types"
+)
+
+// JoinPool transfers the tokens in from the sender to the given pool in exchange for LP shares
+// of the pool, returning the shares minted to the sender.
+//
+// Returns error if the pool does not exist, is not active, or its module does not implement
+// types.JoinExitPoolModuleI. Pool modules with their own join messages, such as gamm, are
+// not routed through here.
+func (k Keeper) JoinPool(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, tokensIn sdk.Coins, shareOutMinAmount osmomath.Int) (sdk.Coin, error) {
+	joinExitModule, pool, err := k.getJoinExitPoolModuleAndPool(ctx, poolId)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return joinExitModule.JoinPool(ctx, sender, pool, tokensIn, shareOutMinAmount)
+}
+
+// ExitPool burns the given amount of LP shares of the sender in exchange for a part of the
+// liquidity of the given pool, returning the tokens transferred to the sender.
+//
+// Returns error if the pool does not exist, is not active, or its module does not implement
+// types.JoinExitPoolModuleI.
+func (k Keeper) ExitPool(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, shareInAmount osmomath.Int, tokenOutMins sdk.Coins) (sdk.Coins, error) {
+	joinExitModule, pool, err := k.getJoinExitPoolModuleAndPool(ctx, poolId)
+	if err != nil {
+		return sdk.Coins{}, err
+	}
+
+	return joinExitModule.ExitPool(ctx, sender, pool, shareInAmount, tokenOutMins)
+}
+
+// getJoinExitPoolModuleAndPool returns the module of the given pool as a types.JoinExitPoolModuleI,
+// together with the pool. Returns error if the pool is not active or its module does not support
+// joins and exits through poolmanager.
+func (k Keeper) getJoinExitPoolModuleAndPool(ctx sdk.Context, poolId uint64) (types.JoinExitPoolModuleI, types.PoolI, error) {
+	poolModule, pool, err := k.GetPoolModuleAndPool(ctx, poolId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	joinExitModule, ok := poolModule.(types.JoinExitPoolModuleI)
+	if !ok {
+		return nil, nil, types.JoinExitNotSupportedError{PoolId: poolId, PoolType: pool.GetType()}
+	}
+
+	if !pool.IsActive(ctx) {
+		return nil, nil, types.InactivePoolError{PoolId: poolId}
+	}
+
+	return joinExitModule, pool, nil
+}
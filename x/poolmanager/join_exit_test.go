@@ -0,0 +1,65 @@
+package poolmanager_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v24/app/apptesting"
+	cosmwasmpooltypes "github.com/osmosis-labs/osmosis/v24/x/cosmwasmpool/types"
+	"github.com/osmosis-labs/osmosis/v24/x/poolmanager/types"
+)
+
+// TestJoinPool_ExitPool tests that joins and exits are routed to pool modules that support them.
+// The joins and exits themselves are tested in the pool modules.
+func (s *KeeperTestSuite) TestJoinPool_ExitPool() {
+	tests := map[string]struct {
+		poolType    types.PoolType
+		poolId      uint64
+		expectedErr error
+	}{
+		"balancer pool: not supported": {
+			poolType:    types.Balancer,
+			poolId:      1,
+			expectedErr: types.JoinExitNotSupportedError{PoolId: 1, PoolType: types.Balancer},
+		},
+		"concentrated pool: not supported": {
+			poolType:    types.Concentrated,
+			poolId:      1,
+			expectedErr: types.JoinExitNotSupportedError{PoolId: 1, PoolType: types.Concentrated},
+		},
+		"cosmwasm pool: routed to the pool module, which rejects pools without the capability": {
+			poolType:    types.CosmWasm,
+			poolId:      1,
+			expectedErr: cosmwasmpooltypes.UnsupportedCapabilityError{PoolId: 1, Capability: cosmwasmpooltypes.CapabilityJoinExit},
+		},
+		"pool does not exist": {
+			poolType:    types.Balancer,
+			poolId:      2,
+			expectedErr: types.FailedToFindRouteError{PoolId: 2},
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+
+			switch tc.poolType {
+			case types.Balancer:
+				s.PrepareBalancerPool()
+			case types.Concentrated:
+				s.PrepareConcentratedPool()
+			case types.CosmWasm:
+				s.PrepareCosmWasmPool()
+			}
+
+			tokensIn := sdk.NewCoins(sdk.NewInt64Coin(apptesting.DefaultTransmuterDenomA, 100))
+			s.FundAcc(s.TestAccs[1], tokensIn)
+
+			_, err := s.App.PoolManagerKeeper.JoinPool(s.Ctx, s.TestAccs[1], tc.poolId, tokensIn, osmomath.ZeroInt())
+			s.Require().ErrorIs(err, tc.expectedErr)
+
+			_, err = s.App.PoolManagerKeeper.ExitPool(s.Ctx, s.TestAccs[1], tc.poolId, osmomath.OneInt(), sdk.Coins{})
+			s.Require().ErrorIs(err, tc.expectedErr)
+		})
+	}
+}
@@ -23,6 +23,7 @@ type Keeper struct {
 	communityPoolKeeper  types.CommunityPoolI
 	stakingKeeper        types.StakingKeeper
 	protorevKeeper       types.ProtorevKeeper
+	twapKeeper           types.TwapKeeper
 
 	// routes is a map to get the pool module by id.
 	routes map[types.PoolType]types.PoolModuleI
@@ -121,6 +122,14 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
 	for _, denomPairTakerFee := range genState.DenomPairTakerFeeStore {
 		k.SetDenomPairTakerFee(ctx, denomPairTakerFee.Denom0, denomPairTakerFee.Denom1, denomPairTakerFee.TakerFee)
 	}
+
+	// Set the TWAP orders KVStore.
+	if genState.NextTwapOrderId != 0 {
+		k.SetNextTwapOrderId(ctx, genState.NextTwapOrderId)
+	}
+	for _, order := range genState.TwapOrders {
+		k.setTwapOrder(ctx, order)
+	}
 }
 
 // ExportGenesis returns the poolmanager module's exported genesis.
@@ -152,6 +161,11 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		TakerFeesToCommunityPool:   k.GetTakerFeeTrackerForCommunityPool(ctx),
 		HeightAccountingStartsFrom: k.GetTakerFeeTrackerStartHeight(ctx),
 	}
+	twapOrders, err := k.GetAllTwapOrders(ctx)
+	if err != nil {
+		panic(err)
+	}
+
 	return &types.GenesisState{
 		Params:                 k.GetParams(ctx),
 		NextPoolId:             k.GetNextPoolId(ctx),
@@ -159,6 +173,8 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		TakerFeesTracker:       &takerFeesTracker,
 		PoolVolumes:            poolVolumes,
 		DenomPairTakerFeeStore: denomPairTakerFees,
+		TwapOrders:             twapOrders,
+		NextTwapOrderId:        k.GetNextTwapOrderId(ctx),
 	}
 }
 
@@ -190,3 +206,8 @@ func (k *Keeper) SetStakingKeeper(stakingKeeper types.StakingKeeper) {
 func (k *Keeper) SetProtorevKeeper(protorevKeeper types.ProtorevKeeper) {
 	k.protorevKeeper = protorevKeeper
 }
+
+// SetTwapKeeper sets twap keeper
+func (k *Keeper) SetTwapKeeper(twapKeeper types.TwapKeeper) {
+	k.twapKeeper = twapKeeper
+}
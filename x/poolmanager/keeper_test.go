@@ -2,6 +2,7 @@ package poolmanager_test
 
 import (
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/suite"
@@ -73,6 +74,24 @@ var (
 			TakerFee: osmomath.MustNewDecFromStr("0.002"),
 		},
 	}
+
+	testTwapOrders = []types.TwapOrder{
+		{
+			Id:                1,
+			Owner:             "osmo106x8q2nv7xsg7qrec2zgdf3vvq0t3gn49zvaha",
+			Routes:            []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: "uion"}},
+			TokenInDenom:      "uosmo",
+			TotalAmount:       osmomath.NewInt(1000),
+			RemainingAmount:   osmomath.NewInt(600),
+			TokenOutAmount:    osmomath.NewInt(390),
+			NumSlices:         10,
+			SlicesExecuted:    4,
+			Interval:          time.Hour,
+			NextExecutionTime: time.Unix(1_700_000_000, 0).UTC(),
+			MaxSlippage:       osmomath.MustNewDecFromStr("0.02"),
+		},
+	}
+	testNextTwapOrderId = uint64(2)
 )
 
 func TestKeeperTestSuite(t *testing.T) {
@@ -136,6 +155,8 @@ func (s *KeeperTestSuite) TestInitGenesis() {
 		TakerFeesTracker:       &testTakerFeesTracker,
 		PoolVolumes:            testPoolVolumes,
 		DenomPairTakerFeeStore: testDenomPairTakerFees,
+		TwapOrders:             testTwapOrders,
+		NextTwapOrderId:        testNextTwapOrderId,
 	})
 
 	params := s.App.PoolManagerKeeper.GetParams(s.Ctx)
@@ -160,6 +181,11 @@ func (s *KeeperTestSuite) TestInitGenesis() {
 	takerFee, err = s.App.PoolManagerKeeper.GetTradingPairTakerFee(s.Ctx, testDenomPairTakerFees[1].Denom0, testDenomPairTakerFees[1].Denom1)
 	s.Require().NoError(err)
 	s.Require().Equal(testDenomPairTakerFees[1].TakerFee, takerFee)
+
+	twapOrder, err := s.App.PoolManagerKeeper.GetTwapOrder(s.Ctx, testTwapOrders[0].Id)
+	s.Require().NoError(err)
+	s.Require().Equal(testTwapOrders[0], twapOrder)
+	s.Require().Equal(testNextTwapOrderId, s.App.PoolManagerKeeper.GetNextTwapOrderId(s.Ctx))
 }
 
 func (s *KeeperTestSuite) TestExportGenesis() {
@@ -184,6 +210,8 @@ func (s *KeeperTestSuite) TestExportGenesis() {
 		TakerFeesTracker:       &testTakerFeesTracker,
 		PoolVolumes:            testPoolVolumes,
 		DenomPairTakerFeeStore: testDenomPairTakerFees,
+		TwapOrders:             testTwapOrders,
+		NextTwapOrderId:        testNextTwapOrderId,
 	})
 
 	genesis := s.App.PoolManagerKeeper.ExportGenesis(s.Ctx)
@@ -202,4 +230,6 @@ func (s *KeeperTestSuite) TestExportGenesis() {
 	s.Require().Equal(testPoolVolumes[0].PoolVolume, genesis.PoolVolumes[0].PoolVolume)
 	s.Require().Equal(testPoolVolumes[1].PoolVolume, genesis.PoolVolumes[1].PoolVolume)
 	s.Require().Equal(testDenomPairTakerFees, genesis.DenomPairTakerFeeStore)
+	s.Require().Equal(testTwapOrders, genesis.TwapOrders)
+	s.Require().Equal(testNextTwapOrderId, genesis.NextTwapOrderId)
 }
@@ -56,6 +56,92 @@ func (server msgServer) SwapExactAmountOut(goCtx context.Context, msg *types.Msg
 	return &types.MsgSwapExactAmountOutResponse{TokenInAmount: tokenInAmount}, nil
 }
 
+func (server msgServer) SwapExactAmountInWithPriceLimit(goCtx context.Context, msg *types.MsgSwapExactAmountInWithPriceLimit) (*types.MsgSwapExactAmountInWithPriceLimitResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenInAmount, tokenOutAmount, err := server.keeper.RouteExactAmountInWithPriceLimit(ctx, sender, msg.Routes, msg.TokenIn, msg.MaxPriceImpact, msg.LimitPrice, msg.AllowPartialFill)
+	if err != nil {
+		return nil, err
+	}
+
+	// Swap event is handled in each pool module's SwapExactAmountIn
+
+	return &types.MsgSwapExactAmountInWithPriceLimitResponse{TokenInAmount: tokenInAmount, TokenOutAmount: tokenOutAmount}, nil
+}
+
+func (server msgServer) CreateTwapOrder(goCtx context.Context, msg *types.MsgCreateTwapOrder) (*types.MsgCreateTwapOrderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	orderId, err := server.keeper.CreateTwapOrder(ctx, sender, msg.Routes, msg.TokenIn, msg.NumSlices, msg.Interval, msg.MaxSlippage)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateTwapOrderResponse{OrderId: orderId}, nil
+}
+
+func (server msgServer) CancelTwapOrder(goCtx context.Context, msg *types.MsgCancelTwapOrder) (*types.MsgCancelTwapOrderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	refundedTokenIn, err := server.keeper.CancelTwapOrder(ctx, sender, msg.OrderId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelTwapOrderResponse{RefundedTokenIn: refundedTokenIn}, nil
+}
+
+func (server msgServer) JoinPool(goCtx context.Context, msg *types.MsgJoinPool) (*types.MsgJoinPoolResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	shareOut, err := server.keeper.JoinPool(ctx, sender, msg.PoolId, msg.TokensIn, msg.ShareOutMinAmount)
+	if err != nil {
+		return nil, err
+	}
+
+	// Add liquidity event is handled in the pool module's JoinPool
+
+	return &types.MsgJoinPoolResponse{ShareOut: shareOut}, nil
+}
+
+func (server msgServer) ExitPool(goCtx context.Context, msg *types.MsgExitPool) (*types.MsgExitPoolResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	tokensOut, err := server.keeper.ExitPool(ctx, sender, msg.PoolId, msg.ShareInAmount, msg.TokenOutMins)
+	if err != nil {
+		return nil, err
+	}
+
+	// Remove liquidity event is handled in the pool module's ExitPool
+
+	return &types.MsgExitPoolResponse{TokensOut: tokensOut}, nil
+}
+
 func (server msgServer) SplitRouteSwapExactAmountIn(goCtx context.Context, msg *types.MsgSplitRouteSwapExactAmountIn) (*types.MsgSplitRouteSwapExactAmountInResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
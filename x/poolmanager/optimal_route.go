@@ -0,0 +1,297 @@
+package poolmanager
+
+import (
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"golang.org/x/exp/slices"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v24/x/poolmanager/types"
+)
+
+const (
+	// defaultMaxSplitRoutes is the number of routes the token in is split across if none is given.
+	defaultMaxSplitRoutes = 3
+	// maxSplitRoutes bounds the number of routes the token in is split across.
+	maxSplitRoutes = 5
+	// defaultMaxRouteHops is the number of pools in each route if none is given.
+	defaultMaxRouteHops = 2
+	// maxRouteHops bounds the number of pools in each route.
+	maxRouteHops = 3
+	// maxCandidateRoutes bounds the number of candidate routes found by the router search.
+	maxCandidateRoutes = 64
+	// maxPartialRoutes bounds the number of partial routes extended at each hop of the router search.
+	maxPartialRoutes = 1024
+	// splitIncrements is the number of parts the token in is split into when allocating it across routes.
+	splitIncrements = 10
+	// optimalRouteGasBudget bounds the gas consumed by the whole router search, including reading the pools.
+	// Once it is consumed, the remaining candidates are not estimated and tokenIn is not split.
+	optimalRouteGasBudget = 50_000_000
+)
+
+// partialRoute is a route of the router search that does not end in the token out denom yet.
+type partialRoute struct {
+	route []types.SwapAmountInRoute
+	// denoms are the denoms visited by the route, starting with the token in denom.
+	denoms []string
+}
+
+// estimatedRoute is a candidate route, together with its token out amount for the whole token in.
+type estimatedRoute struct {
+	route          []types.SwapAmountInRoute
+	tokenOutAmount osmomath.Int
+}
+
+// FindOptimalSplitRoute searches the pools for the routes swapping tokenIn into tokenOutDenom with the highest
+// token out amounts, and splits tokenIn across up to maxRoutes of them. It returns the routes with the amount of
+// tokenIn swapped through each, together with their total estimated token out amount after taker fees.
+//
+// The search is bounded: routes have at most maxHops active pools, at most maxCandidateRoutes candidates are
+// estimated, shortest first, and the whole search consumes at most optimalRouteGasBudget. Running out of it
+// before the candidates are found is an error, while running out of it later stops estimating candidates, or
+// splitting tokenIn across them. The routes returned
+// have no pool in common, so that swapping through one does not change the estimate of the others. tokenIn is split
+// across them in splitIncrements parts, each allocated to the route with the highest marginal token out amount, and
+// the split is only kept if it beats swapping everything through the best route.
+//
+// maxRoutes and maxHops default to 3 and 2 if zero, and must be at most 5 and 3.
+func (k Keeper) FindOptimalSplitRoute(ctx sdk.Context, tokenIn sdk.Coin, tokenOutDenom string, maxRoutes, maxHops uint32) ([]types.SwapAmountInSplitRoute, osmomath.Int, error) {
+	if maxRoutes == 0 {
+		maxRoutes = defaultMaxSplitRoutes
+	}
+	if maxRoutes > maxSplitRoutes {
+		return nil, osmomath.Int{}, types.RouterSearchBoundError{Bound: "max routes", Value: maxRoutes, Limit: maxSplitRoutes}
+	}
+	if maxHops == 0 {
+		maxHops = defaultMaxRouteHops
+	}
+	if maxHops > maxRouteHops {
+		return nil, osmomath.Int{}, types.RouterSearchBoundError{Bound: "max hops", Value: maxHops, Limit: maxRouteHops}
+	}
+	if !tokenIn.Amount.IsPositive() {
+		return nil, osmomath.Int{}, fmt.Errorf("token in amount must be positive, was (%s)", tokenIn.Amount)
+	}
+	if tokenIn.Denom == tokenOutDenom {
+		return nil, osmomath.Int{}, fmt.Errorf("token in and token out denoms must differ, both were (%s)", tokenIn.Denom)
+	}
+
+	// The search runs on its own gas meter, whose consumption is charged to the caller afterwards.
+	searchGasLimit := uint64(optimalRouteGasBudget)
+	if gasRemaining := ctx.GasMeter().GasRemaining(); gasRemaining < searchGasLimit {
+		searchGasLimit = gasRemaining
+	}
+	searchCtx := ctx.WithGasMeter(sdk.NewGasMeter(searchGasLimit))
+	defer func() {
+		ctx.GasMeter().ConsumeGas(searchCtx.GasMeter().GasConsumedToLimit(), "optimal split route search")
+	}()
+
+	var candidates [][]types.SwapAmountInRoute
+	var err error
+	if !runWithinGasLimit(func() {
+		candidates, err = k.findCandidateRoutes(searchCtx, tokenIn.Denom, tokenOutDenom, int(maxHops))
+	}) {
+		return nil, osmomath.Int{}, types.RouterSearchOutOfGasError{GasLimit: searchGasLimit}
+	}
+	if err != nil {
+		return nil, osmomath.Int{}, err
+	}
+
+	estimatedRoutes := make([]estimatedRoute, 0, len(candidates))
+	for _, route := range candidates {
+		var tokenOutAmount osmomath.Int
+		if !runWithinGasLimit(func() {
+			tokenOutAmount, err = k.MultihopEstimateOutGivenExactAmountIn(searchCtx, route, tokenIn)
+		}) {
+			break
+		}
+		// Candidates that cannot be estimated, e.g. for lack of liquidity, are skipped.
+		if err != nil {
+			continue
+		}
+		estimatedRoutes = append(estimatedRoutes, estimatedRoute{route: route, tokenOutAmount: tokenOutAmount})
+	}
+	sort.SliceStable(estimatedRoutes, func(i, j int) bool {
+		return estimatedRoutes[i].tokenOutAmount.GT(estimatedRoutes[j].tokenOutAmount)
+	})
+
+	// Select the best routes that have no pool in common with a better one.
+	selectedRoutes := make([]estimatedRoute, 0, maxRoutes)
+	selectedPools := make(map[uint64]struct{})
+	for _, candidate := range estimatedRoutes {
+		if len(selectedRoutes) == int(maxRoutes) {
+			break
+		}
+		if routeUsesAnyPool(candidate.route, selectedPools) {
+			continue
+		}
+		for _, routeStep := range candidate.route {
+			selectedPools[routeStep.PoolId] = struct{}{}
+		}
+		selectedRoutes = append(selectedRoutes, candidate)
+	}
+	if len(selectedRoutes) == 0 {
+		return nil, osmomath.Int{}, types.NoRouteFoundError{TokenInDenom: tokenIn.Denom, TokenOutDenom: tokenOutDenom}
+	}
+
+	bestRoute := []types.SwapAmountInSplitRoute{{Pools: selectedRoutes[0].route, TokenInAmount: tokenIn.Amount}}
+	var splitRoutes []types.SwapAmountInSplitRoute
+	var splitTokenOutAmount osmomath.Int
+	ok := false
+	if !runWithinGasLimit(func() {
+		splitRoutes, splitTokenOutAmount, ok = k.splitAcrossRoutes(searchCtx, selectedRoutes, tokenIn)
+	}) {
+		return bestRoute, selectedRoutes[0].tokenOutAmount, nil
+	}
+	if !ok || !splitTokenOutAmount.GT(selectedRoutes[0].tokenOutAmount) {
+		return bestRoute, selectedRoutes[0].tokenOutAmount, nil
+	}
+	return splitRoutes, splitTokenOutAmount, nil
+}
+
+// findCandidateRoutes returns the routes of at most maxHops active pools from tokenInDenom to tokenOutDenom that
+// visit no denom and no pool twice, shortest first and then in increasing order of pool IDs. At most
+// maxCandidateRoutes routes are returned.
+func (k Keeper) findCandidateRoutes(ctx sdk.Context, tokenInDenom, tokenOutDenom string, maxHops int) ([][]types.SwapAmountInRoute, error) {
+	pools, err := k.AllPools(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	poolDenoms := make(map[uint64][]string, len(pools))
+	poolIdsByDenom := make(map[string][]uint64)
+	for _, pool := range pools {
+		if !pool.IsActive(ctx) {
+			continue
+		}
+		denoms := pool.GetPoolDenoms(ctx)
+		poolDenoms[pool.GetId()] = denoms
+		for _, denom := range denoms {
+			poolIdsByDenom[denom] = append(poolIdsByDenom[denom], pool.GetId())
+		}
+	}
+
+	candidates := [][]types.SwapAmountInRoute{}
+	partialRoutes := []partialRoute{{denoms: []string{tokenInDenom}}}
+	for hop := 0; hop < maxHops && len(partialRoutes) > 0; hop++ {
+		nextPartialRoutes := []partialRoute{}
+		for _, partial := range partialRoutes {
+			denomIn := partial.denoms[len(partial.denoms)-1]
+			for _, poolId := range poolIdsByDenom[denomIn] {
+				if routeUsesPool(partial.route, poolId) {
+					continue
+				}
+				for _, denomOut := range poolDenoms[poolId] {
+					if slices.Contains(partial.denoms, denomOut) {
+						continue
+					}
+
+					route := append(append([]types.SwapAmountInRoute{}, partial.route...), types.SwapAmountInRoute{PoolId: poolId, TokenOutDenom: denomOut})
+					if denomOut == tokenOutDenom {
+						candidates = append(candidates, route)
+						if len(candidates) == maxCandidateRoutes {
+							return candidates, nil
+						}
+						continue
+					}
+					if hop < maxHops-1 && len(nextPartialRoutes) < maxPartialRoutes {
+						denoms := append(append([]string{}, partial.denoms...), denomOut)
+						nextPartialRoutes = append(nextPartialRoutes, partialRoute{route: route, denoms: denoms})
+					}
+				}
+			}
+		}
+		partialRoutes = nextPartialRoutes
+	}
+	return candidates, nil
+}
+
+// splitAcrossRoutes splits tokenIn across the given routes in splitIncrements parts, allocating each part to the
+// route with the highest marginal token out amount. It returns the routes that were allocated a part, together with
+// their total token out amount, or false if tokenIn is too small to be split or a part could not be allocated.
+func (k Keeper) splitAcrossRoutes(ctx sdk.Context, routes []estimatedRoute, tokenIn sdk.Coin) ([]types.SwapAmountInSplitRoute, osmomath.Int, bool) {
+	partAmount := tokenIn.Amount.QuoRaw(splitIncrements)
+	if len(routes) < 2 || !partAmount.IsPositive() {
+		return nil, osmomath.Int{}, false
+	}
+
+	amountsIn := make([]osmomath.Int, len(routes))
+	amountsOut := make([]osmomath.Int, len(routes))
+	for i := range routes {
+		amountsIn[i], amountsOut[i] = osmomath.ZeroInt(), osmomath.ZeroInt()
+	}
+
+	remaining := tokenIn.Amount
+	for remaining.IsPositive() {
+		// The last part includes the remainder of the division.
+		part := partAmount
+		if remaining.LT(partAmount.MulRaw(2)) {
+			part = remaining
+		}
+
+		bestIndex, bestAmountOut, bestGain := -1, osmomath.Int{}, osmomath.Int{}
+		for i, route := range routes {
+			amountOut, err := k.MultihopEstimateOutGivenExactAmountIn(ctx, route.route, sdk.NewCoin(tokenIn.Denom, amountsIn[i].Add(part)))
+			if err != nil {
+				continue
+			}
+			gain := amountOut.Sub(amountsOut[i])
+			if bestIndex == -1 || gain.GT(bestGain) {
+				bestIndex, bestAmountOut, bestGain = i, amountOut, gain
+			}
+		}
+		if bestIndex == -1 {
+			return nil, osmomath.Int{}, false
+		}
+
+		amountsIn[bestIndex] = amountsIn[bestIndex].Add(part)
+		amountsOut[bestIndex] = bestAmountOut
+		remaining = remaining.Sub(part)
+	}
+
+	splitRoutes := make([]types.SwapAmountInSplitRoute, 0, len(routes))
+	tokenOutAmount := osmomath.ZeroInt()
+	for i, route := range routes {
+		if amountsIn[i].IsZero() {
+			continue
+		}
+		splitRoutes = append(splitRoutes, types.SwapAmountInSplitRoute{Pools: route.route, TokenInAmount: amountsIn[i]})
+		tokenOutAmount = tokenOutAmount.Add(amountsOut[i])
+	}
+	return splitRoutes, tokenOutAmount, true
+}
+
+// runWithinGasLimit runs f and returns false if it ran out of gas. Any other panic is propagated.
+func runWithinGasLimit(f func()) (completed bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(sdk.ErrorOutOfGas); !ok {
+				panic(r)
+			}
+			completed = false
+		}
+	}()
+	f()
+	return true
+}
+
+// routeUsesPool returns true if the route swaps through the given pool.
+func routeUsesPool(route []types.SwapAmountInRoute, poolId uint64) bool {
+	for _, routeStep := range route {
+		if routeStep.PoolId == poolId {
+			return true
+		}
+	}
+	return false
+}
+
+// routeUsesAnyPool returns true if the route swaps through any of the given pools.
+func routeUsesAnyPool(route []types.SwapAmountInRoute, poolIds map[uint64]struct{}) bool {
+	for _, routeStep := range route {
+		if _, ok := poolIds[routeStep.PoolId]; ok {
+			return true
+		}
+	}
+	return false
+}
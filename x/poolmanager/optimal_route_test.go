@@ -0,0 +1,160 @@
+package poolmanager_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v24/app/apptesting"
+	"github.com/osmosis-labs/osmosis/v24/x/poolmanager/types"
+)
+
+func (s *KeeperTestSuite) TestFindOptimalSplitRoute() {
+	var (
+		defaultAmount  = osmomath.NewInt(1_000_000_000)
+		defaultTokenIn = sdk.NewCoin(apptesting.FOO, osmomath.NewInt(100_000_000))
+	)
+
+	tests := map[string]struct {
+		// pools are the reserves of the balancer pools created, with pool IDs starting at 1.
+		pools         []sdk.Coins
+		tokenIn       sdk.Coin
+		tokenOutDenom string
+		maxRoutes     uint32
+		maxHops       uint32
+		// gasLimit is the gas limit of the context, if not zero.
+		gasLimit uint64
+
+		expectedRoutes [][]types.SwapAmountInRoute
+		expectSplit    bool
+		expectedError  error
+	}{
+		"single pool": {
+			pools:         []sdk.Coins{sdk.NewCoins(sdk.NewCoin(apptesting.FOO, defaultAmount), sdk.NewCoin(apptesting.BAR, defaultAmount))},
+			tokenIn:       defaultTokenIn,
+			tokenOutDenom: apptesting.BAR,
+
+			expectedRoutes: [][]types.SwapAmountInRoute{{{PoolId: 1, TokenOutDenom: apptesting.BAR}}},
+		},
+		"two hops through an intermediate denom": {
+			pools: []sdk.Coins{
+				sdk.NewCoins(sdk.NewCoin(apptesting.FOO, defaultAmount), sdk.NewCoin(apptesting.BAZ, defaultAmount)),
+				sdk.NewCoins(sdk.NewCoin(apptesting.BAZ, defaultAmount), sdk.NewCoin(apptesting.BAR, defaultAmount)),
+			},
+			tokenIn:       defaultTokenIn,
+			tokenOutDenom: apptesting.BAR,
+
+			expectedRoutes: [][]types.SwapAmountInRoute{{{PoolId: 1, TokenOutDenom: apptesting.BAZ}, {PoolId: 2, TokenOutDenom: apptesting.BAR}}},
+		},
+		"split across two equal pools": {
+			pools: []sdk.Coins{
+				sdk.NewCoins(sdk.NewCoin(apptesting.FOO, defaultAmount), sdk.NewCoin(apptesting.BAR, defaultAmount)),
+				sdk.NewCoins(sdk.NewCoin(apptesting.FOO, defaultAmount), sdk.NewCoin(apptesting.BAR, defaultAmount)),
+			},
+			tokenIn:       defaultTokenIn,
+			tokenOutDenom: apptesting.BAR,
+
+			expectedRoutes: [][]types.SwapAmountInRoute{{{PoolId: 1, TokenOutDenom: apptesting.BAR}}, {{PoolId: 2, TokenOutDenom: apptesting.BAR}}},
+			expectSplit:    true,
+		},
+		"max routes of one picks the deepest pool": {
+			pools: []sdk.Coins{
+				sdk.NewCoins(sdk.NewCoin(apptesting.FOO, defaultAmount), sdk.NewCoin(apptesting.BAR, defaultAmount)),
+				sdk.NewCoins(sdk.NewCoin(apptesting.FOO, defaultAmount.MulRaw(2)), sdk.NewCoin(apptesting.BAR, defaultAmount.MulRaw(2))),
+			},
+			tokenIn:       defaultTokenIn,
+			tokenOutDenom: apptesting.BAR,
+			maxRoutes:     1,
+
+			expectedRoutes: [][]types.SwapAmountInRoute{{{PoolId: 2, TokenOutDenom: apptesting.BAR}}},
+		},
+		"error: route longer than max hops": {
+			pools: []sdk.Coins{
+				sdk.NewCoins(sdk.NewCoin(apptesting.FOO, defaultAmount), sdk.NewCoin(apptesting.BAZ, defaultAmount)),
+				sdk.NewCoins(sdk.NewCoin(apptesting.BAZ, defaultAmount), sdk.NewCoin(apptesting.BAR, defaultAmount)),
+			},
+			tokenIn:       defaultTokenIn,
+			tokenOutDenom: apptesting.BAR,
+			maxHops:       1,
+
+			expectedError: types.NoRouteFoundError{TokenInDenom: apptesting.FOO, TokenOutDenom: apptesting.BAR},
+		},
+		"error: no pool with token out denom": {
+			pools:         []sdk.Coins{sdk.NewCoins(sdk.NewCoin(apptesting.FOO, defaultAmount), sdk.NewCoin(apptesting.BAR, defaultAmount))},
+			tokenIn:       defaultTokenIn,
+			tokenOutDenom: apptesting.BAZ,
+
+			expectedError: types.NoRouteFoundError{TokenInDenom: apptesting.FOO, TokenOutDenom: apptesting.BAZ},
+		},
+		"error: out of gas before finding the candidate routes": {
+			pools:         []sdk.Coins{sdk.NewCoins(sdk.NewCoin(apptesting.FOO, defaultAmount), sdk.NewCoin(apptesting.BAR, defaultAmount))},
+			tokenIn:       defaultTokenIn,
+			tokenOutDenom: apptesting.BAR,
+			gasLimit:      1_000,
+
+			expectedError: types.RouterSearchOutOfGasError{GasLimit: 1_000},
+		},
+		"error: too many routes": {
+			tokenIn:       defaultTokenIn,
+			tokenOutDenom: apptesting.BAR,
+			maxRoutes:     6,
+
+			expectedError: types.RouterSearchBoundError{Bound: "max routes", Value: 6, Limit: 5},
+		},
+		"error: too many hops": {
+			tokenIn:       defaultTokenIn,
+			tokenOutDenom: apptesting.BAR,
+			maxHops:       4,
+
+			expectedError: types.RouterSearchBoundError{Bound: "max hops", Value: 4, Limit: 3},
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			for _, poolCoins := range tc.pools {
+				s.PrepareBalancerPoolWithCoins(poolCoins...)
+			}
+
+			ctx := s.Ctx
+			if tc.gasLimit != 0 {
+				ctx = ctx.WithGasMeter(sdk.NewGasMeter(tc.gasLimit))
+			}
+
+			routes, tokenOutAmount, err := s.App.PoolManagerKeeper.FindOptimalSplitRoute(ctx, tc.tokenIn, tc.tokenOutDenom, tc.maxRoutes, tc.maxHops)
+			if tc.gasLimit != 0 {
+				// The gas consumed by the search is charged to the caller, up to its limit.
+				s.Require().Equal(tc.gasLimit, ctx.GasMeter().GasConsumed())
+			}
+			if tc.expectedError != nil {
+				s.Require().ErrorIs(err, tc.expectedError)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Len(routes, len(tc.expectedRoutes))
+
+			totalAmountIn := osmomath.ZeroInt()
+			for i, route := range routes {
+				s.Require().Equal(tc.expectedRoutes[i], route.Pools)
+				s.Require().True(route.TokenInAmount.IsPositive())
+				totalAmountIn = totalAmountIn.Add(route.TokenInAmount)
+			}
+			s.Require().Equal(tc.tokenIn.Amount, totalAmountIn)
+
+			// The split beats swapping everything through the first route.
+			bestSingleRouteAmount, err := s.App.PoolManagerKeeper.MultihopEstimateOutGivenExactAmountIn(s.Ctx, routes[0].Pools, tc.tokenIn)
+			s.Require().NoError(err)
+			if tc.expectSplit {
+				s.Require().True(tokenOutAmount.GT(bestSingleRouteAmount))
+			} else {
+				s.Require().Equal(bestSingleRouteAmount, tokenOutAmount)
+			}
+
+			// Executing the routes swaps out the estimated amount.
+			s.FundAcc(s.TestAccs[0], sdk.NewCoins(tc.tokenIn))
+			actualTokenOutAmount, err := s.App.PoolManagerKeeper.SplitRouteExactAmountIn(s.Ctx, s.TestAccs[0], routes, tc.tokenIn.Denom, tokenOutAmount)
+			s.Require().NoError(err)
+			s.Require().Equal(tokenOutAmount, actualTokenOutAmount)
+		})
+	}
+}
@@ -0,0 +1,216 @@
+package poolmanager
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	gammtypes "github.com/osmosis-labs/osmosis/v24/x/gamm/types"
+	"github.com/osmosis-labs/osmosis/v24/x/poolmanager/client/queryproto"
+	"github.com/osmosis-labs/osmosis/v24/x/poolmanager/types"
+)
+
+var (
+	// defaultDepthPriceChanges are the price changes that the depth of a pool is computed at
+	// if none are given.
+	defaultDepthPriceChanges = []osmomath.Dec{
+		osmomath.MustNewDecFromStr("0.01"),
+		osmomath.MustNewDecFromStr("0.02"),
+		osmomath.MustNewDecFromStr("0.05"),
+		osmomath.MustNewDecFromStr("0.1"),
+	}
+)
+
+const (
+	// depthSearchPrecision is the relative precision, as a fraction of the amount, at which the
+	// search for the amount swapped to reach a spot price in a CFMM pool stops.
+	depthSearchPrecision = 1_000_000
+	// maxDepthSearchDoublings bounds the number of times the search for the amount swapped to reach
+	// a spot price in a CFMM pool doubles its upper bound before giving up on reaching it.
+	maxDepthSearchDoublings = 64
+)
+
+// GetPoolDepth returns the spot price of the base asset in terms of the quote asset in the given pool,
+// together with the depth of the pool at each of the given relative price changes, sorted in increasing order:
+// - asks: the amounts of the base asset bought and of the quote asset sold to raise the spot price by the change.
+// - bids: the amounts of the base asset sold and of the quote asset bought to lower the spot price by the change.
+//
+// The amounts are cumulative from the spot price and exclude the spread factor. If no price changes are given,
+// the depth is computed at 1%, 2%, 5% and 10%.
+//
+// Pool modules implementing types.PoolDepthModuleI compute the amounts themselves, e.g. concentrated liquidity
+// from the liquidity of its ticks. For CFMM pools, the amounts are found by searching for the swap that moves
+// the spot price to each price level.
+//
+// Returns error if any of the price changes is not in (0, 1), the pool does not exist, its type does not
+// support depth, or a price level cannot be reached in a CFMM pool.
+func (k Keeper) GetPoolDepth(ctx sdk.Context, poolId uint64, baseAssetDenom, quoteAssetDenom string, priceChanges []osmomath.Dec) (osmomath.BigDec, []queryproto.PriceLevelDepth, []queryproto.PriceLevelDepth, error) {
+	if len(priceChanges) == 0 {
+		priceChanges = defaultDepthPriceChanges
+	}
+	for _, priceChange := range priceChanges {
+		if !priceChange.IsPositive() || priceChange.GTE(osmomath.OneDec()) {
+			return osmomath.BigDec{}, nil, nil, types.InvalidPriceChangeError{PriceChange: priceChange}
+		}
+	}
+	priceChanges = append([]osmomath.Dec{}, priceChanges...)
+	sort.SliceStable(priceChanges, func(i, j int) bool {
+		return priceChanges[i].LT(priceChanges[j])
+	})
+
+	swapModule, err := k.GetPoolModule(ctx, poolId)
+	if err != nil {
+		return osmomath.BigDec{}, nil, nil, err
+	}
+	pool, err := swapModule.GetPool(ctx, poolId)
+	if err != nil {
+		return osmomath.BigDec{}, nil, nil, err
+	}
+	spotPrice, err := swapModule.CalculateSpotPrice(ctx, poolId, quoteAssetDenom, baseAssetDenom)
+	if err != nil {
+		return osmomath.BigDec{}, nil, nil, err
+	}
+
+	askPrices := make([]osmomath.BigDec, len(priceChanges))
+	bidPrices := make([]osmomath.BigDec, len(priceChanges))
+	inverseBidPrices := make([]osmomath.BigDec, len(priceChanges))
+	for i, priceChange := range priceChanges {
+		askPrices[i] = spotPrice.MulDec(osmomath.OneDec().Add(priceChange))
+		bidPrices[i] = spotPrice.MulDec(osmomath.OneDec().Sub(priceChange))
+		// Selling the base asset lowers its price, i.e. raises the price of the quote asset in terms of the base asset.
+		inverseBidPrices[i] = osmomath.OneBigDec().Quo(bidPrices[i])
+	}
+
+	quoteAmountsIn, baseAmountsOut, err := k.calcAmountsToSpotPrices(ctx, swapModule, pool, quoteAssetDenom, baseAssetDenom, askPrices)
+	if err != nil {
+		return osmomath.BigDec{}, nil, nil, err
+	}
+	baseAmountsIn, quoteAmountsOut, err := k.calcAmountsToSpotPrices(ctx, swapModule, pool, baseAssetDenom, quoteAssetDenom, inverseBidPrices)
+	if err != nil {
+		return osmomath.BigDec{}, nil, nil, err
+	}
+
+	asks := make([]queryproto.PriceLevelDepth, len(priceChanges))
+	bids := make([]queryproto.PriceLevelDepth, len(priceChanges))
+	for i, priceChange := range priceChanges {
+		asks[i] = queryproto.PriceLevelDepth{
+			PriceChange: priceChange,
+			Price:       askPrices[i].Dec(),
+			BaseAmount:  sdk.NewCoin(baseAssetDenom, baseAmountsOut[i]),
+			QuoteAmount: sdk.NewCoin(quoteAssetDenom, quoteAmountsIn[i]),
+		}
+		bids[i] = queryproto.PriceLevelDepth{
+			PriceChange: priceChange,
+			Price:       bidPrices[i].Dec(),
+			BaseAmount:  sdk.NewCoin(baseAssetDenom, baseAmountsIn[i]),
+			QuoteAmount: sdk.NewCoin(quoteAssetDenom, quoteAmountsOut[i]),
+		}
+	}
+
+	return spotPrice, asks, bids, nil
+}
+
+// calcAmountsToSpotPrices returns the amounts of tokenInDenom swapped into and of tokenOutDenom swapped out of
+// the given pool to move the spot price of tokenOutDenom, quoted in tokenInDenom, up to each of the given
+// target spot prices, which must be in increasing order.
+//
+// The calculation is delegated to the pool module if it implements types.PoolDepthModuleI. Otherwise, the pool
+// must be a CFMM pool, and the amounts are searched for by simulating swaps against it.
+func (k Keeper) calcAmountsToSpotPrices(ctx sdk.Context, swapModule types.PoolModuleI, pool types.PoolI, tokenInDenom, tokenOutDenom string, targetSpotPrices []osmomath.BigDec) ([]osmomath.Int, []osmomath.Int, error) {
+	if depthModule, ok := swapModule.(types.PoolDepthModuleI); ok {
+		return depthModule.CalcAmountsToSpotPrices(ctx, pool, tokenInDenom, tokenOutDenom, targetSpotPrices)
+	}
+
+	cfmmPool, ok := pool.(gammtypes.CFMMPoolI)
+	if !ok {
+		return nil, nil, types.PoolDepthNotSupportedError{PoolId: pool.GetId(), PoolType: pool.GetType()}
+	}
+
+	amountsIn := make([]osmomath.Int, len(targetSpotPrices))
+	amountsOut := make([]osmomath.Int, len(targetSpotPrices))
+	amountIn := osmomath.ZeroInt()
+	for i, targetSpotPrice := range targetSpotPrices {
+		// The amounts are cumulative, so the search for each target starts from the amount found for the previous one.
+		var err error
+		amountIn, err = k.searchAmountInToSpotPrice(ctx, swapModule, cfmmPool, tokenInDenom, tokenOutDenom, targetSpotPrice, amountIn)
+		if err != nil {
+			return nil, nil, err
+		}
+		amountsIn[i] = amountIn
+		amountsOut[i] = osmomath.ZeroInt()
+		if amountIn.IsZero() {
+			continue
+		}
+
+		tokenOut, err := swapModule.CalcOutAmtGivenIn(ctx, pool, sdk.NewCoin(tokenInDenom, amountIn), tokenOutDenom, osmomath.ZeroDec())
+		if err != nil {
+			return nil, nil, err
+		}
+		amountsOut[i] = tokenOut.Amount
+	}
+
+	return amountsIn, amountsOut, nil
+}
+
+// searchAmountInToSpotPrice returns the amount of tokenInDenom that, swapped into the given CFMM pool, moves the
+// spot price of tokenOutDenom, quoted in tokenInDenom, up to just below the target spot price, within a relative
+// precision of 1 / depthSearchPrecision. The amount is at least lowerBound, which must not reach the target.
+//
+// The search first doubles an upper bound, starting from the pool's liquidity of tokenInDenom, until it reaches
+// the target, and then bisects between the bounds. Swaps that fail, e.g. because they are too large for the
+// pool's math, count as reaching the target. If the upper bound still does not reach the target after
+// maxDepthSearchDoublings doublings, a PoolDepthNotReachedError is returned.
+func (k Keeper) searchAmountInToSpotPrice(ctx sdk.Context, swapModule types.PoolModuleI, pool gammtypes.CFMMPoolI, tokenInDenom, tokenOutDenom string, targetSpotPrice osmomath.BigDec, lowerBound osmomath.Int) (osmomath.Int, error) {
+	reachesTarget := func(amountIn osmomath.Int) bool {
+		reached := true
+		_ = osmoutils.ApplyFuncIfNoErrorLogToDebug(ctx, func(ctx sdk.Context) error {
+			// Swapping mutates the pool, so each simulation is run against a fresh copy of it.
+			poolI, err := swapModule.GetPool(ctx, pool.GetId())
+			if err != nil {
+				return err
+			}
+			cfmmPool, ok := poolI.(gammtypes.CFMMPoolI)
+			if !ok {
+				return types.PoolDepthNotSupportedError{PoolId: pool.GetId(), PoolType: pool.GetType()}
+			}
+			if _, err := cfmmPool.SwapOutAmtGivenIn(ctx, sdk.NewCoins(sdk.NewCoin(tokenInDenom, amountIn)), tokenOutDenom, osmomath.ZeroDec()); err != nil {
+				return err
+			}
+			spotPrice, err := cfmmPool.SpotPrice(ctx, tokenInDenom, tokenOutDenom)
+			if err != nil {
+				return err
+			}
+			reached = spotPrice.GTE(targetSpotPrice)
+			return nil
+		})
+		return reached
+	}
+
+	low := lowerBound
+	high := pool.GetTotalPoolLiquidity(ctx).AmountOf(tokenInDenom)
+	if high.LTE(low) {
+		high = low.MulRaw(2)
+	}
+	if high.IsZero() {
+		high = osmomath.OneInt()
+	}
+	for i := 0; !reachesTarget(high); i++ {
+		if i == maxDepthSearchDoublings {
+			return osmomath.Int{}, types.PoolDepthNotReachedError{PoolId: pool.GetId(), TokenInDenom: tokenInDenom, TokenOutDenom: tokenOutDenom, TargetSpotPrice: targetSpotPrice}
+		}
+		low = high
+		high = high.MulRaw(2)
+	}
+
+	for high.Sub(low).GT(osmomath.OneInt()) && high.Sub(low).MulRaw(depthSearchPrecision).GT(low) {
+		mid := low.Add(high).QuoRaw(2)
+		if reachesTarget(mid) {
+			high = mid
+		} else {
+			low = mid
+		}
+	}
+	return low, nil
+}
@@ -0,0 +1,233 @@
+package poolmanager_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils/osmoassert"
+	"github.com/osmosis-labs/osmosis/v24/app/apptesting"
+	"github.com/osmosis-labs/osmosis/v24/tests/mocks"
+	"github.com/osmosis-labs/osmosis/v24/x/poolmanager/client/queryproto"
+	"github.com/osmosis-labs/osmosis/v24/x/poolmanager/types"
+)
+
+// expectedConstantProductDepth returns the depth of a constant product pool with equal weights and the given
+// reserves at the given price change, i.e. the amounts that move the reserve ratio by a factor of 1 +- priceChange.
+func expectedConstantProductDepth(baseReserve, quoteReserve osmomath.Int, priceChange osmomath.Dec) (askBase, askQuote, bidBase, bidQuote osmomath.Dec) {
+	sqrtUp, err := osmomath.MonotonicSqrt(osmomath.OneDec().Add(priceChange))
+	if err != nil {
+		panic(err)
+	}
+	sqrtDown, err := osmomath.MonotonicSqrt(osmomath.OneDec().Sub(priceChange))
+	if err != nil {
+		panic(err)
+	}
+	base, quote := baseReserve.ToLegacyDec(), quoteReserve.ToLegacyDec()
+
+	askBase = base.Sub(base.Quo(sqrtUp))
+	askQuote = quote.Mul(sqrtUp).Sub(quote)
+	bidBase = base.Quo(sqrtDown).Sub(base)
+	bidQuote = quote.Sub(quote.Mul(sqrtDown))
+	return askBase, askQuote, bidBase, bidQuote
+}
+
+func (s *KeeperTestSuite) TestGetPoolDepth() {
+	var (
+		balancerBaseReserve  = osmomath.NewInt(1_000_000_000)
+		balancerQuoteReserve = osmomath.NewInt(2_000_000_000)
+		priceChanges         = []osmomath.Dec{osmomath.MustNewDecFromStr("0.05"), osmomath.MustNewDecFromStr("0.02")}
+		sortedPriceChanges   = []osmomath.Dec{osmomath.MustNewDecFromStr("0.02"), osmomath.MustNewDecFromStr("0.05")}
+		defaultPriceChanges  = []osmomath.Dec{
+			osmomath.MustNewDecFromStr("0.01"),
+			osmomath.MustNewDecFromStr("0.02"),
+			osmomath.MustNewDecFromStr("0.05"),
+			osmomath.MustNewDecFromStr("0.1"),
+		}
+	)
+
+	tests := map[string]struct {
+		poolType        types.PoolType
+		noPool          bool
+		baseAssetDenom  string
+		quoteAssetDenom string
+		priceChanges    []osmomath.Dec
+
+		expectedSpotPrice    osmomath.BigDec
+		expectedPriceChanges []osmomath.Dec
+		baseReserve          osmomath.Int
+		quoteReserve         osmomath.Int
+		expectedError        error
+	}{
+		"balancer: default price changes": {
+			poolType:        types.Balancer,
+			baseAssetDenom:  apptesting.FOO,
+			quoteAssetDenom: apptesting.BAR,
+
+			expectedSpotPrice:    osmomath.NewBigDec(2),
+			expectedPriceChanges: defaultPriceChanges,
+			baseReserve:          balancerBaseReserve,
+			quoteReserve:         balancerQuoteReserve,
+		},
+		"balancer: unsorted price changes": {
+			poolType:        types.Balancer,
+			baseAssetDenom:  apptesting.FOO,
+			quoteAssetDenom: apptesting.BAR,
+			priceChanges:    priceChanges,
+
+			expectedSpotPrice:    osmomath.NewBigDec(2),
+			expectedPriceChanges: sortedPriceChanges,
+			baseReserve:          balancerBaseReserve,
+			quoteReserve:         balancerQuoteReserve,
+		},
+		"concentrated: base asset is token0": {
+			poolType:        types.Concentrated,
+			baseAssetDenom:  apptesting.ETH,
+			quoteAssetDenom: apptesting.USDC,
+
+			expectedSpotPrice:    osmomath.OneBigDec(),
+			expectedPriceChanges: defaultPriceChanges,
+			baseReserve:          apptesting.DefaultCoinAmount,
+			quoteReserve:         apptesting.DefaultCoinAmount,
+		},
+		"concentrated: base asset is token1": {
+			poolType:        types.Concentrated,
+			baseAssetDenom:  apptesting.USDC,
+			quoteAssetDenom: apptesting.ETH,
+			priceChanges:    priceChanges,
+
+			expectedSpotPrice:    osmomath.OneBigDec(),
+			expectedPriceChanges: sortedPriceChanges,
+			baseReserve:          apptesting.DefaultCoinAmount,
+			quoteReserve:         apptesting.DefaultCoinAmount,
+		},
+		"error: zero price change": {
+			poolType:        types.Balancer,
+			baseAssetDenom:  apptesting.FOO,
+			quoteAssetDenom: apptesting.BAR,
+			priceChanges:    []osmomath.Dec{osmomath.ZeroDec()},
+
+			expectedError: types.InvalidPriceChangeError{PriceChange: osmomath.ZeroDec()},
+		},
+		"error: price change of one": {
+			poolType:        types.Balancer,
+			baseAssetDenom:  apptesting.FOO,
+			quoteAssetDenom: apptesting.BAR,
+			priceChanges:    []osmomath.Dec{osmomath.OneDec()},
+
+			expectedError: types.InvalidPriceChangeError{PriceChange: osmomath.OneDec()},
+		},
+		"error: pool does not exist": {
+			noPool:          true,
+			baseAssetDenom:  apptesting.FOO,
+			quoteAssetDenom: apptesting.BAR,
+
+			expectedError: types.FailedToFindRouteError{PoolId: 1},
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+
+			poolId := uint64(1)
+			switch {
+			case tc.noPool:
+			case tc.poolType == types.Balancer:
+				poolId = s.PrepareBalancerPoolWithCoins(sdk.NewCoin(apptesting.FOO, balancerBaseReserve), sdk.NewCoin(apptesting.BAR, balancerQuoteReserve))
+			case tc.poolType == types.Concentrated:
+				poolId = s.PrepareConcentratedPoolWithCoinsAndFullRangePosition(apptesting.ETH, apptesting.USDC).GetId()
+			}
+
+			spotPrice, asks, bids, err := s.App.PoolManagerKeeper.GetPoolDepth(s.Ctx, poolId, tc.baseAssetDenom, tc.quoteAssetDenom, tc.priceChanges)
+			if tc.expectedError != nil {
+				s.Require().ErrorContains(err, tc.expectedError.Error())
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expectedSpotPrice, spotPrice)
+			s.Require().Len(asks, len(tc.expectedPriceChanges))
+			s.Require().Len(bids, len(tc.expectedPriceChanges))
+
+			errTolerance := osmomath.ErrTolerance{MultiplicativeTolerance: osmomath.MustNewDecFromStr("0.00001")}
+			for i, priceChange := range tc.expectedPriceChanges {
+				askBase, askQuote, bidBase, bidQuote := expectedConstantProductDepth(tc.baseReserve, tc.quoteReserve, priceChange)
+
+				s.Require().Equal(priceChange, asks[i].PriceChange)
+				s.Require().Equal(tc.expectedSpotPrice.Dec().Mul(osmomath.OneDec().Add(priceChange)), asks[i].Price)
+				s.Require().Equal(tc.baseAssetDenom, asks[i].BaseAmount.Denom)
+				s.Require().Equal(tc.quoteAssetDenom, asks[i].QuoteAmount.Denom)
+				osmoassert.Equal(s.T(), errTolerance, askBase, asks[i].BaseAmount.Amount.ToLegacyDec())
+				osmoassert.Equal(s.T(), errTolerance, askQuote, asks[i].QuoteAmount.Amount.ToLegacyDec())
+
+				s.Require().Equal(priceChange, bids[i].PriceChange)
+				s.Require().Equal(tc.expectedSpotPrice.Dec().Mul(osmomath.OneDec().Sub(priceChange)), bids[i].Price)
+				osmoassert.Equal(s.T(), errTolerance, bidBase, bids[i].BaseAmount.Amount.ToLegacyDec())
+				osmoassert.Equal(s.T(), errTolerance, bidQuote, bids[i].QuoteAmount.Amount.ToLegacyDec())
+			}
+		})
+	}
+}
+
+// TestGetPoolDepth_Stableswap tests that the depth of a stableswap pool is cumulative, and that swapping the
+// amounts of each price level moves the spot price to it.
+func (s *KeeperTestSuite) TestGetPoolDepth_Stableswap() {
+	s.SetupTest()
+	poolId := s.PrepareBasicStableswapPool()
+
+	spotPrice, asks, bids, err := s.App.PoolManagerKeeper.GetPoolDepth(s.Ctx, poolId, apptesting.FOO, apptesting.BAR, nil)
+	s.Require().NoError(err)
+
+	// The taker fee charged by the swaps below slightly reduces the price impact of the amounts.
+	errTolerance := osmomath.ErrTolerance{MultiplicativeTolerance: osmomath.MustNewDecFromStr("0.001")}
+
+	checkLevels := func(levels []queryproto.PriceLevelDepth, tokenOutDenom string, getAmountIn func(queryproto.PriceLevelDepth) sdk.Coin) {
+		for i, level := range levels {
+			s.Require().True(getAmountIn(level).Amount.IsPositive())
+			if i > 0 {
+				s.Require().True(level.BaseAmount.Amount.GT(levels[i-1].BaseAmount.Amount))
+				s.Require().True(level.QuoteAmount.Amount.GT(levels[i-1].QuoteAmount.Amount))
+			}
+
+			cacheCtx, _ := s.Ctx.CacheContext()
+			_, err := s.App.PoolManagerKeeper.SwapExactAmountIn(cacheCtx, s.TestAccs[0], poolId, getAmountIn(level), tokenOutDenom, osmomath.OneInt())
+			s.Require().NoError(err)
+			newSpotPrice, err := s.App.PoolManagerKeeper.RouteCalculateSpotPrice(cacheCtx, poolId, apptesting.BAR, apptesting.FOO)
+			s.Require().NoError(err)
+
+			osmoassert.Equal(s.T(), errTolerance, level.Price, newSpotPrice.Dec())
+		}
+	}
+
+	osmoassert.Equal(s.T(), errTolerance, osmomath.OneBigDec(), spotPrice)
+	checkLevels(asks, apptesting.FOO, func(level queryproto.PriceLevelDepth) sdk.Coin { return level.QuoteAmount })
+	checkLevels(bids, apptesting.BAR, func(level queryproto.PriceLevelDepth) sdk.Coin { return level.BaseAmount })
+}
+
+func (s *KeeperTestSuite) TestGetPoolDepth_PriceLevelNotReached() {
+	s.SetupTest()
+	ctrl := gomock.NewController(s.T())
+	defer ctrl.Finish()
+	poolId := s.PrepareBalancerPool()
+
+	// The spot price of the mock pool does not move, whatever the amount swapped into it.
+	mockPool := mocks.NewMockCFMMPoolI(ctrl)
+	mockPool.EXPECT().GetId().Return(poolId).AnyTimes()
+	mockPool.EXPECT().GetTotalPoolLiquidity(gomock.Any()).Return(sdk.NewCoins(sdk.NewCoin(apptesting.FOO, osmomath.NewInt(1_000_000)), sdk.NewCoin(apptesting.BAR, osmomath.NewInt(1_000_000)))).AnyTimes()
+	mockPool.EXPECT().SwapOutAmtGivenIn(gomock.Any(), gomock.Any(), apptesting.FOO, gomock.Any()).Return(sdk.NewCoin(apptesting.FOO, osmomath.OneInt()), nil).AnyTimes()
+	mockPool.EXPECT().SpotPrice(gomock.Any(), apptesting.BAR, apptesting.FOO).Return(osmomath.OneBigDec(), nil).AnyTimes()
+
+	mockPoolModule := mocks.NewMockPoolModuleI(ctrl)
+	mockPoolModule.EXPECT().GetPool(gomock.Any(), poolId).Return(mockPool, nil).AnyTimes()
+	mockPoolModule.EXPECT().CalculateSpotPrice(gomock.Any(), poolId, apptesting.BAR, apptesting.FOO).Return(osmomath.OneBigDec(), nil)
+	s.App.PoolManagerKeeper.SetPoolRoutesUnsafe(map[types.PoolType]types.PoolModuleI{types.Balancer: mockPoolModule})
+
+	_, _, _, err := s.App.PoolManagerKeeper.GetPoolDepth(s.Ctx, poolId, apptesting.FOO, apptesting.BAR, []osmomath.Dec{osmomath.MustNewDecFromStr("0.01")})
+
+	var notReachedErr types.PoolDepthNotReachedError
+	s.Require().ErrorAs(err, &notReachedErr)
+	s.Require().Equal(poolId, notReachedErr.PoolId)
+	s.Require().Equal(apptesting.BAR, notReachedErr.TokenInDenom)
+	s.Require().Equal(apptesting.FOO, notReachedErr.TokenOutDenom)
+	s.Require().Equal(osmomath.MustNewBigDecFromStr("1.01"), notReachedErr.TargetSpotPrice)
+}
@@ -173,9 +173,13 @@ func (k Keeper) SwapExactAmountIn(
 		return osmomath.Int{}, fmt.Errorf("pool %d is not active", pool.GetId())
 	}
 
-	tokenInAfterSubTakerFee, err := k.chargeTakerFee(ctx, tokenIn, tokenOutDenom, sender, true)
-	if err != nil {
-		return osmomath.Int{}, err
+	// Pools that charge the taker fee themselves receive the full token in.
+	tokenInAfterSubTakerFee := tokenIn
+	if !k.poolHandlesTakerFee(ctx, pool) {
+		tokenInAfterSubTakerFee, err = k.chargeTakerFee(ctx, tokenIn, tokenOutDenom, sender, true)
+		if err != nil {
+			return osmomath.Int{}, err
+		}
 	}
 
 	// routeStep to the pool-specific SwapExactAmountIn implementation.
@@ -274,7 +278,7 @@ func (k Keeper) multihopEstimateOutGivenExactAmountInInternal(
 		actualTokenIn := tokenIn
 		// apply taker fee if applicable
 		if applyTakerFee {
-			takerFee, err := k.GetTradingPairTakerFee(ctx, routeStep.TokenOutDenom, tokenIn.Denom)
+			takerFee, err := k.getPoolTakerFee(ctx, poolI, routeStep.TokenOutDenom, tokenIn.Denom)
 			if err != nil {
 				return osmomath.Int{}, err
 			}
@@ -375,9 +379,12 @@ func (k Keeper) RouteExactAmountOut(ctx sdk.Context,
 		}
 
 		tokenIn := sdk.NewCoin(routeStep.TokenInDenom, curTokenInAmount)
-		tokenInAfterAddTakerFee, err := k.chargeTakerFee(ctx, tokenIn, _tokenOut.Denom, sender, false)
-		if err != nil {
-			return osmomath.Int{}, err
+		tokenInAfterAddTakerFee := tokenIn
+		if !k.poolHandlesTakerFee(ctx, pool) {
+			tokenInAfterAddTakerFee, err = k.chargeTakerFee(ctx, tokenIn, _tokenOut.Denom, sender, false)
+			if err != nil {
+				return osmomath.Int{}, err
+			}
 		}
 
 		// Track volume for volume-splitting incentives
@@ -618,7 +625,7 @@ func (k Keeper) createMultihopExpectedSwapOuts(
 
 		spreadFactor := poolI.GetSpreadFactor(ctx)
 
-		takerFee, err := k.GetTradingPairTakerFee(ctx, routeStep.TokenInDenom, tokenOut.Denom)
+		takerFee, err := k.getPoolTakerFee(ctx, poolI, routeStep.TokenInDenom, tokenOut.Denom)
 		if err != nil {
 			return nil, err
 		}
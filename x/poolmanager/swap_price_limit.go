@@ -0,0 +1,131 @@
+package poolmanager
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v24/x/poolmanager/types"
+)
+
+// priceLimitSearchPrecision is the relative precision, as a fraction of the token in amount, at which the search
+// for the largest amount swapped within a price limit stops.
+const priceLimitSearchPrecision = 1_000_000
+
+// RouteExactAmountInWithPriceLimit swaps tokenIn along the route as long as the execution price, in units of the
+// token in per unit of the token out and including spread and taker fees, is at most a maximum price. The maximum
+// price is either limitPrice or, if maxPriceImpact is set instead, the spot price of the route before the swap
+// increased by maxPriceImpact. Exactly one of them must be set.
+//
+// If allowPartialFill is false, the whole tokenIn is swapped, and the swap fails if its execution price exceeds the
+// maximum price. Otherwise, the largest amount of tokenIn whose execution price is within the maximum price is
+// swapped, within a relative precision of 10^-6, and the rest is left with the sender.
+//
+// Returns the amount of the token in swapped and the amount of the token out.
+func (k Keeper) RouteExactAmountInWithPriceLimit(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	route []types.SwapAmountInRoute,
+	tokenIn sdk.Coin,
+	maxPriceImpact osmomath.Dec,
+	limitPrice osmomath.Dec,
+	allowPartialFill bool,
+) (tokenInAmount, tokenOutAmount osmomath.Int, err error) {
+	if err := types.SwapAmountInRoutes(route).Validate(); err != nil {
+		return osmomath.Int{}, osmomath.Int{}, err
+	}
+	if err := types.ValidatePriceLimit(maxPriceImpact, limitPrice); err != nil {
+		return osmomath.Int{}, osmomath.Int{}, err
+	}
+
+	var maxPrice osmomath.BigDec
+	if !limitPrice.IsNil() && limitPrice.IsPositive() {
+		maxPrice = osmomath.BigDecFromDec(limitPrice)
+	} else {
+		spotPrice, err := k.routeSpotPrice(ctx, route, tokenIn.Denom)
+		if err != nil {
+			return osmomath.Int{}, osmomath.Int{}, err
+		}
+		maxPrice = spotPrice.MulMut(osmomath.OneBigDec().AddMut(osmomath.BigDecFromDec(maxPriceImpact)))
+	}
+
+	tokenInAmount = tokenIn.Amount
+	if allowPartialFill {
+		tokenInAmount, err = k.maxAmountInWithinPrice(ctx, route, tokenIn, maxPrice)
+		if err != nil {
+			return osmomath.Int{}, osmomath.Int{}, err
+		}
+	}
+
+	tokenOutAmount, err = k.RouteExactAmountIn(ctx, sender, route, sdk.NewCoin(tokenIn.Denom, tokenInAmount), minTokenOutAmountAtPrice(tokenInAmount, maxPrice))
+	if err != nil {
+		return osmomath.Int{}, osmomath.Int{}, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgSwapExactAmountInWithPriceLimit,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+			sdk.NewAttribute(types.AttributeKeyTokensIn, sdk.NewCoin(tokenIn.Denom, tokenInAmount).String()),
+			sdk.NewAttribute(types.AttributeKeyTokensOut, tokenOutAmount.String()),
+		),
+	})
+
+	return tokenInAmount, tokenOutAmount, nil
+}
+
+// routeSpotPrice returns the spot price of the last token out of the route in terms of tokenInDenom, i.e. the
+// product of the spot prices of the token out of each hop in terms of its token in.
+func (k Keeper) routeSpotPrice(ctx sdk.Context, route []types.SwapAmountInRoute, tokenInDenom string) (osmomath.BigDec, error) {
+	spotPrice := osmomath.OneBigDec()
+	for _, routeStep := range route {
+		hopSpotPrice, err := k.RouteCalculateSpotPrice(ctx, routeStep.PoolId, tokenInDenom, routeStep.TokenOutDenom)
+		if err != nil {
+			return osmomath.BigDec{}, err
+		}
+		spotPrice.MulMut(hopSpotPrice)
+		tokenInDenom = routeStep.TokenOutDenom
+	}
+	return spotPrice, nil
+}
+
+// maxAmountInWithinPrice returns the largest amount of tokenIn whose estimated execution price along the route is
+// at most maxPrice, found with a binary search within a relative precision of 1 / priceLimitSearchPrecision.
+// The execution price is assumed to increase with the amount swapped.
+//
+// Returns error if no amount of tokenIn can be swapped within maxPrice.
+func (k Keeper) maxAmountInWithinPrice(ctx sdk.Context, route []types.SwapAmountInRoute, tokenIn sdk.Coin, maxPrice osmomath.BigDec) (osmomath.Int, error) {
+	isWithinPrice := func(amountIn osmomath.Int) bool {
+		tokenOutAmount, err := k.MultihopEstimateOutGivenExactAmountIn(ctx, route, sdk.NewCoin(tokenIn.Denom, amountIn))
+		return err == nil && tokenOutAmount.GTE(minTokenOutAmountAtPrice(amountIn, maxPrice))
+	}
+
+	if isWithinPrice(tokenIn.Amount) {
+		return tokenIn.Amount, nil
+	}
+
+	// low is always within the price, or zero, and high never is. The search goes on past the precision
+	// until an amount within the price is found, if any.
+	low, high := osmomath.ZeroInt(), tokenIn.Amount
+	precision := osmomath.MaxInt(tokenIn.Amount.QuoRaw(priceLimitSearchPrecision), osmomath.OneInt())
+	for high.Sub(low).GT(precision) || (low.IsZero() && high.GT(osmomath.OneInt())) {
+		mid := low.Add(high).QuoRaw(2)
+		if isWithinPrice(mid) {
+			low = mid
+		} else {
+			high = mid
+		}
+	}
+
+	if low.IsZero() {
+		return osmomath.Int{}, types.PriceLimitExceededError{MaxPrice: maxPrice}
+	}
+	return low, nil
+}
+
+// minTokenOutAmountAtPrice returns the smallest positive token out amount for which swapping amountIn has an
+// execution price of at most maxPrice.
+func minTokenOutAmountAtPrice(amountIn osmomath.Int, maxPrice osmomath.BigDec) osmomath.Int {
+	minTokenOutAmount := osmomath.BigDecFromSDKInt(amountIn).QuoRoundUpMut(maxPrice).CeilMut().TruncateInt()
+	return osmomath.MaxInt(osmomath.NewIntFromBigInt(minTokenOutAmount.BigInt()), osmomath.OneInt())
+}
@@ -0,0 +1,148 @@
+package poolmanager_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v24/app/apptesting"
+	"github.com/osmosis-labs/osmosis/v24/x/poolmanager/types"
+)
+
+func (s *KeeperTestSuite) TestRouteExactAmountInWithPriceLimit() {
+	var (
+		defaultAmount  = osmomath.NewInt(1_000_000_000)
+		defaultTokenIn = sdk.NewCoin(apptesting.FOO, osmomath.NewInt(100_000_000))
+		singleHopRoute = []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: apptesting.BAR}}
+	)
+
+	tests := map[string]struct {
+		// pools are the reserves of the balancer pools created, with pool IDs starting at 1.
+		pools            []sdk.Coins
+		route            []types.SwapAmountInRoute
+		tokenIn          sdk.Coin
+		maxPriceImpact   osmomath.Dec
+		limitPrice       osmomath.Dec
+		allowPartialFill bool
+
+		expectPartialFill    bool
+		expectedErrorContain string
+	}{
+		"full fill within max price impact": {
+			pools:          []sdk.Coins{sdk.NewCoins(sdk.NewCoin(apptesting.FOO, defaultAmount), sdk.NewCoin(apptesting.BAR, defaultAmount))},
+			route:          singleHopRoute,
+			tokenIn:        defaultTokenIn,
+			maxPriceImpact: osmomath.MustNewDecFromStr("0.5"),
+		},
+		"full fill within limit price": {
+			pools:      []sdk.Coins{sdk.NewCoins(sdk.NewCoin(apptesting.FOO, defaultAmount), sdk.NewCoin(apptesting.BAR, defaultAmount))},
+			route:      singleHopRoute,
+			tokenIn:    defaultTokenIn,
+			limitPrice: osmomath.MustNewDecFromStr("1.5"),
+		},
+		"multi-hop full fill within max price impact": {
+			pools: []sdk.Coins{
+				sdk.NewCoins(sdk.NewCoin(apptesting.FOO, defaultAmount), sdk.NewCoin(apptesting.BAZ, defaultAmount.MulRaw(2))),
+				sdk.NewCoins(sdk.NewCoin(apptesting.BAZ, defaultAmount), sdk.NewCoin(apptesting.BAR, defaultAmount)),
+			},
+			route:          []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: apptesting.BAZ}, {PoolId: 2, TokenOutDenom: apptesting.BAR}},
+			tokenIn:        defaultTokenIn,
+			maxPriceImpact: osmomath.MustNewDecFromStr("0.5"),
+		},
+		"partial fill when max price impact is exceeded": {
+			pools:            []sdk.Coins{sdk.NewCoins(sdk.NewCoin(apptesting.FOO, defaultAmount), sdk.NewCoin(apptesting.BAR, defaultAmount))},
+			route:            singleHopRoute,
+			tokenIn:          defaultTokenIn,
+			maxPriceImpact:   osmomath.MustNewDecFromStr("0.05"),
+			allowPartialFill: true,
+
+			expectPartialFill: true,
+		},
+		"partial fill when limit price is exceeded": {
+			pools:            []sdk.Coins{sdk.NewCoins(sdk.NewCoin(apptesting.FOO, defaultAmount), sdk.NewCoin(apptesting.BAR, defaultAmount))},
+			route:            singleHopRoute,
+			tokenIn:          defaultTokenIn,
+			limitPrice:       osmomath.MustNewDecFromStr("1.05"),
+			allowPartialFill: true,
+
+			expectPartialFill: true,
+		},
+		"error: max price impact exceeded without partial fill": {
+			pools:          []sdk.Coins{sdk.NewCoins(sdk.NewCoin(apptesting.FOO, defaultAmount), sdk.NewCoin(apptesting.BAR, defaultAmount))},
+			route:          singleHopRoute,
+			tokenIn:        defaultTokenIn,
+			maxPriceImpact: osmomath.MustNewDecFromStr("0.05"),
+
+			expectedErrorContain: "lesser than min amount",
+		},
+		"error: limit price below spot price with partial fill": {
+			pools:            []sdk.Coins{sdk.NewCoins(sdk.NewCoin(apptesting.FOO, defaultAmount), sdk.NewCoin(apptesting.BAR, defaultAmount))},
+			route:            singleHopRoute,
+			tokenIn:          defaultTokenIn,
+			limitPrice:       osmomath.MustNewDecFromStr("0.5"),
+			allowPartialFill: true,
+
+			expectedErrorContain: types.PriceLimitExceededError{MaxPrice: osmomath.MustNewBigDecFromStr("0.5")}.Error(),
+		},
+		"error: both max price impact and limit price set": {
+			pools:          []sdk.Coins{sdk.NewCoins(sdk.NewCoin(apptesting.FOO, defaultAmount), sdk.NewCoin(apptesting.BAR, defaultAmount))},
+			route:          singleHopRoute,
+			tokenIn:        defaultTokenIn,
+			maxPriceImpact: osmomath.MustNewDecFromStr("0.05"),
+			limitPrice:     osmomath.MustNewDecFromStr("1.05"),
+
+			expectedErrorContain: "exactly one of max price impact",
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			for _, poolCoins := range tc.pools {
+				s.PrepareBalancerPoolWithCoins(poolCoins...)
+			}
+			sender := s.TestAccs[0]
+			s.FundAcc(sender, sdk.NewCoins(tc.tokenIn))
+
+			// The maximum execution price of the swap, computed before the swap moves the spot price.
+			maxPrice := osmomath.ZeroBigDec()
+			if !tc.limitPrice.IsNil() {
+				maxPrice = osmomath.BigDecFromDec(tc.limitPrice)
+			} else if tc.expectedErrorContain == "" {
+				spotPrice := osmomath.OneBigDec()
+				tokenInDenom := tc.tokenIn.Denom
+				for _, routeStep := range tc.route {
+					hopSpotPrice, err := s.App.PoolManagerKeeper.RouteCalculateSpotPrice(s.Ctx, routeStep.PoolId, tokenInDenom, routeStep.TokenOutDenom)
+					s.Require().NoError(err)
+					spotPrice = spotPrice.Mul(hopSpotPrice)
+					tokenInDenom = routeStep.TokenOutDenom
+				}
+				maxPrice = spotPrice.Mul(osmomath.OneBigDec().Add(osmomath.BigDecFromDec(tc.maxPriceImpact)))
+			}
+
+			tokenInAmount, tokenOutAmount, err := s.App.PoolManagerKeeper.RouteExactAmountInWithPriceLimit(s.Ctx, sender, tc.route, tc.tokenIn, tc.maxPriceImpact, tc.limitPrice, tc.allowPartialFill)
+			if tc.expectedErrorContain != "" {
+				s.Require().ErrorContains(err, tc.expectedErrorContain)
+				s.Require().Equal(tc.tokenIn, s.App.BankKeeper.GetBalance(s.Ctx, sender, tc.tokenIn.Denom))
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().True(tokenOutAmount.IsPositive())
+
+			if tc.expectPartialFill {
+				s.Require().True(tokenInAmount.IsPositive())
+				s.Require().True(tokenInAmount.LT(tc.tokenIn.Amount))
+			} else {
+				s.Require().Equal(tc.tokenIn.Amount, tokenInAmount)
+			}
+
+			// The execution price is within the maximum price.
+			executionPrice := osmomath.BigDecFromSDKInt(tokenInAmount).Quo(osmomath.BigDecFromSDKInt(tokenOutAmount))
+			s.Require().True(executionPrice.LTE(maxPrice), "execution price %s exceeds max price %s", executionPrice, maxPrice)
+
+			// The amount not swapped is left with the sender.
+			tokenOutDenom := tc.route[len(tc.route)-1].TokenOutDenom
+			s.Require().Equal(tc.tokenIn.Amount.Sub(tokenInAmount).String(), s.App.BankKeeper.GetBalance(s.Ctx, sender, tc.tokenIn.Denom).Amount.String())
+			s.Require().Equal(tokenOutAmount.String(), s.App.BankKeeper.GetBalance(s.Ctx, sender, tokenOutDenom).Amount.String())
+		})
+	}
+}
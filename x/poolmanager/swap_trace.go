@@ -0,0 +1,121 @@
+package poolmanager
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	gammtypes "github.com/osmosis-labs/osmosis/v24/x/gamm/types"
+	"github.com/osmosis-labs/osmosis/v24/x/poolmanager/types"
+)
+
+// MultihopEstimateOutGivenExactAmountInWithTrace returns the same token out amount as
+// MultihopEstimateOutGivenExactAmountIn, together with the execution trace of the swap through each pool of the route:
+// the token in and out, the taker and spread fees charged, the spot price before and after, and for concentrated
+// liquidity pools, each step of the swap within the liquidity between the initialized ticks crossed.
+//
+// Pool modules implementing types.SwapTraceModuleI trace their swaps themselves. For CFMM pools, the spot price after
+// the swap is found by simulating the swap against a copy of the pool. For other pools, it is left at zero.
+func (k Keeper) MultihopEstimateOutGivenExactAmountInWithTrace(
+	ctx sdk.Context,
+	route []types.SwapAmountInRoute,
+	tokenIn sdk.Coin,
+) (tokenOutAmount osmomath.Int, hops []types.HopTrace, err error) {
+	// recover from panic
+	defer func() {
+		if r := recover(); r != nil {
+			tokenOutAmount, hops = osmomath.Int{}, nil
+			if isErr, d := osmoutils.IsOutOfGasError(r); isErr {
+				err = fmt.Errorf("function MultihopEstimateOutGivenExactAmountInWithTrace failed due to lack of gas: %v", d)
+			} else {
+				err = fmt.Errorf("function MultihopEstimateOutGivenExactAmountInWithTrace failed due to internal reason: %v", r)
+			}
+		}
+	}()
+
+	if err := types.SwapAmountInRoutes(route).Validate(); err != nil {
+		return osmomath.Int{}, nil, err
+	}
+
+	hops = make([]types.HopTrace, 0, len(route))
+	for _, routeStep := range route {
+		swapModule, poolI, err := k.GetPoolModuleAndPool(ctx, routeStep.PoolId)
+		if err != nil {
+			return osmomath.Int{}, nil, err
+		}
+
+		takerFee, err := k.getPoolTakerFee(ctx, poolI, routeStep.TokenOutDenom, tokenIn.Denom)
+		if err != nil {
+			return osmomath.Int{}, nil, err
+		}
+		tokenInAfterTakerFee, takerFeeCharged := CalcTakerFeeExactIn(tokenIn, takerFee)
+
+		spotPriceBefore, err := swapModule.CalculateSpotPrice(ctx, routeStep.PoolId, tokenIn.Denom, routeStep.TokenOutDenom)
+		if err != nil {
+			return osmomath.Int{}, nil, err
+		}
+
+		hop, err := k.calcOutAmtGivenInWithTrace(ctx, swapModule, poolI, tokenInAfterTakerFee, routeStep.TokenOutDenom, poolI.GetSpreadFactor(ctx))
+		if err != nil {
+			return osmomath.Int{}, nil, err
+		}
+		if !hop.TokenOut.Amount.IsPositive() {
+			return osmomath.Int{}, nil, errors.New("token amount must be positive")
+		}
+
+		hop.PoolId = routeStep.PoolId
+		hop.PoolType = poolI.GetType()
+		hop.TokenIn = tokenIn
+		hop.TakerFee = takerFeeCharged
+		hop.SpotPriceBefore = spotPriceBefore
+		hops = append(hops, hop)
+
+		// Chain output of current pool as the input for the next routed pool
+		tokenIn = hop.TokenOut
+	}
+	return tokenIn.Amount, hops, nil
+}
+
+// calcOutAmtGivenInWithTrace returns the token out of swapping tokenIn into the given pool, together with the trace
+// of the swap. Only the token out, spread factor, spread fee, spot price after and steps of the trace are set.
+//
+// The calculation is delegated to the pool module if it implements types.SwapTraceModuleI.
+func (k Keeper) calcOutAmtGivenInWithTrace(ctx sdk.Context, swapModule types.PoolModuleI, pool types.PoolI, tokenIn sdk.Coin, tokenOutDenom string, spreadFactor osmomath.Dec) (types.HopTrace, error) {
+	if traceModule, ok := swapModule.(types.SwapTraceModuleI); ok {
+		return traceModule.CalcOutAmtGivenInWithTrace(ctx, pool, tokenIn, tokenOutDenom, spreadFactor)
+	}
+
+	tokenOut, err := swapModule.CalcOutAmtGivenIn(ctx, pool, tokenIn, tokenOutDenom, spreadFactor)
+	if err != nil {
+		return types.HopTrace{}, err
+	}
+	hop := types.HopTrace{
+		TokenOut:       tokenOut,
+		SpreadFactor:   spreadFactor,
+		SpreadFee:      sdk.NewCoin(tokenIn.Denom, tokenIn.Amount.ToLegacyDec().MulTruncate(spreadFactor).TruncateInt()),
+		SpotPriceAfter: osmomath.ZeroBigDec(),
+		Steps:          []types.SwapStepTrace{},
+	}
+
+	// Swapping mutates the pool, so the swap is simulated against a fresh copy of it.
+	cacheCtx, _ := ctx.CacheContext()
+	poolCopy, err := swapModule.GetPool(cacheCtx, pool.GetId())
+	if err != nil {
+		return types.HopTrace{}, err
+	}
+	cfmmPool, ok := poolCopy.(gammtypes.CFMMPoolI)
+	if !ok {
+		return hop, nil
+	}
+	if _, err := cfmmPool.SwapOutAmtGivenIn(cacheCtx, sdk.NewCoins(tokenIn), tokenOutDenom, spreadFactor); err != nil {
+		return types.HopTrace{}, err
+	}
+	hop.SpotPriceAfter, err = cfmmPool.SpotPrice(cacheCtx, tokenIn.Denom, tokenOutDenom)
+	if err != nil {
+		return types.HopTrace{}, err
+	}
+	return hop, nil
+}
@@ -0,0 +1,117 @@
+package poolmanager_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils/osmoassert"
+	"github.com/osmosis-labs/osmosis/v24/app/apptesting"
+	"github.com/osmosis-labs/osmosis/v24/x/poolmanager"
+	"github.com/osmosis-labs/osmosis/v24/x/poolmanager/types"
+)
+
+func (s *KeeperTestSuite) TestMultihopEstimateOutGivenExactAmountInWithTrace() {
+	var (
+		takerFee       = osmomath.MustNewDecFromStr("0.01")
+		clSpreadFactor = osmomath.MustNewDecFromStr("0.003")
+		defaultTokenIn = sdk.NewCoin(apptesting.FOO, osmomath.NewInt(1_000_000))
+	)
+
+	tests := map[string]struct {
+		tokenIn       sdk.Coin
+		emptyRoute    bool
+		expectedError string
+	}{
+		"balancer then concentrated": {
+			tokenIn: defaultTokenIn,
+		},
+		"error: empty route": {
+			tokenIn:       defaultTokenIn,
+			emptyRoute:    true,
+			expectedError: "provided empty routes",
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+
+			poolManagerParams := s.App.PoolManagerKeeper.GetParams(s.Ctx)
+			poolManagerParams.TakerFeeParams.DefaultTakerFee = takerFee
+			s.App.PoolManagerKeeper.SetParams(s.Ctx, poolManagerParams)
+
+			balancerPoolId := s.PrepareBalancerPoolWithCoins(sdk.NewCoin(apptesting.FOO, osmomath.NewInt(1_000_000_000)), sdk.NewCoin(apptesting.BAR, osmomath.NewInt(2_000_000_000)))
+			clPool := s.PrepareCustomConcentratedPool(s.TestAccs[0], apptesting.BAR, apptesting.BAZ, apptesting.DefaultTickSpacing, clSpreadFactor)
+			s.CreateFullRangePosition(clPool, sdk.NewCoins(sdk.NewCoin(apptesting.BAR, apptesting.DefaultCoinAmount), sdk.NewCoin(apptesting.BAZ, apptesting.DefaultCoinAmount)))
+
+			route := []types.SwapAmountInRoute{
+				{PoolId: balancerPoolId, TokenOutDenom: apptesting.BAR},
+				{PoolId: clPool.GetId(), TokenOutDenom: apptesting.BAZ},
+			}
+			if tc.emptyRoute {
+				route = []types.SwapAmountInRoute{}
+			}
+
+			tokenOutAmount, hops, err := s.App.PoolManagerKeeper.MultihopEstimateOutGivenExactAmountInWithTrace(s.Ctx, route, tc.tokenIn)
+			if tc.expectedError != "" {
+				s.Require().ErrorContains(err, tc.expectedError)
+				return
+			}
+			s.Require().NoError(err)
+
+			expectedTokenOutAmount, err := s.App.PoolManagerKeeper.MultihopEstimateOutGivenExactAmountIn(s.Ctx, route, tc.tokenIn)
+			s.Require().NoError(err)
+			s.Require().Equal(expectedTokenOutAmount, tokenOutAmount)
+			s.Require().Len(hops, len(route))
+
+			// Execute the swaps hop by hop to compare the spot prices after each of them with the trace.
+			s.FundAcc(s.TestAccs[0], sdk.NewCoins(tc.tokenIn))
+			cacheCtx, _ := s.Ctx.CacheContext()
+			tokenIn := tc.tokenIn
+			for i, hop := range hops {
+				_, poolI, err := s.App.PoolManagerKeeper.GetPoolModuleAndPool(s.Ctx, route[i].PoolId)
+				s.Require().NoError(err)
+
+				tokenInAfterTakerFee, expectedTakerFee := poolmanager.CalcTakerFeeExactIn(tokenIn, takerFee)
+				spotPriceBefore, err := s.App.PoolManagerKeeper.RouteCalculateSpotPrice(cacheCtx, route[i].PoolId, tokenIn.Denom, route[i].TokenOutDenom)
+				s.Require().NoError(err)
+
+				s.Require().Equal(route[i].PoolId, hop.PoolId)
+				s.Require().Equal(poolI.GetType(), hop.PoolType)
+				s.Require().Equal(tokenIn, hop.TokenIn)
+				s.Require().Equal(route[i].TokenOutDenom, hop.TokenOut.Denom)
+				s.Require().Equal(expectedTakerFee, hop.TakerFee)
+				s.Require().Equal(poolI.GetSpreadFactor(s.Ctx), hop.SpreadFactor)
+				s.Require().Equal(tokenIn.Denom, hop.SpreadFee.Denom)
+				s.Require().Equal(spotPriceBefore, hop.SpotPriceBefore)
+
+				tokenOutAmount, err := s.App.PoolManagerKeeper.SwapExactAmountIn(cacheCtx, s.TestAccs[0], route[i].PoolId, tokenIn, route[i].TokenOutDenom, osmomath.OneInt())
+				s.Require().NoError(err)
+				s.Require().Equal(tokenOutAmount, hop.TokenOut.Amount)
+
+				spotPriceAfter, err := s.App.PoolManagerKeeper.RouteCalculateSpotPrice(cacheCtx, route[i].PoolId, tokenIn.Denom, route[i].TokenOutDenom)
+				s.Require().NoError(err)
+				osmoassert.Equal(s.T(), osmomath.ErrTolerance{MultiplicativeTolerance: osmomath.MustNewDecFromStr("0.000000001")}, spotPriceAfter, hop.SpotPriceAfter)
+				s.Require().True(hop.SpotPriceAfter.GT(hop.SpotPriceBefore))
+
+				if hop.PoolType != types.Concentrated {
+					s.Require().Empty(hop.Steps)
+					s.Require().Equal(tokenInAfterTakerFee.Amount.ToLegacyDec().MulTruncate(hop.SpreadFactor).TruncateInt(), hop.SpreadFee.Amount)
+				} else {
+					s.Require().NotEmpty(hop.Steps)
+					amountOut, spreadRewardCharge := osmomath.ZeroDec(), osmomath.ZeroDec()
+					for _, step := range hop.Steps {
+						// A full range position has no initialized tick to cross within the swap.
+						s.Require().False(step.TickCrossed)
+						amountOut = amountOut.Add(step.AmountOut)
+						spreadRewardCharge = spreadRewardCharge.Add(step.SpreadRewardCharge)
+					}
+					s.Require().Equal(hop.TokenOut.Amount, amountOut.TruncateInt())
+					s.Require().Equal(hop.SpreadFee.Amount, spreadRewardCharge.Ceil().TruncateInt())
+				}
+
+				tokenIn = hop.TokenOut
+			}
+		})
+	}
+}
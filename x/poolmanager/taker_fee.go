@@ -108,6 +108,29 @@ func (k Keeper) GetAllTradingPairTakerFees(ctx sdk.Context) ([]types.DenomPairTa
 	return takerFees, nil
 }
 
+// getPoolTakerFee returns the taker fee charged by poolmanager for swapping between denom0 and denom1
+// through the given pool. It is zero for pools that charge the taker fee themselves.
+func (k Keeper) getPoolTakerFee(ctx sdk.Context, pool types.PoolI, denom0, denom1 string) (osmomath.Dec, error) {
+	if k.poolHandlesTakerFee(ctx, pool) {
+		return osmomath.ZeroDec(), nil
+	}
+	return k.GetTradingPairTakerFee(ctx, denom0, denom1)
+}
+
+// poolHandlesTakerFee returns true if the pool charges the taker fee itself, and governance
+// allowed it to by adding its id to the custom taker fee pool ids. A pool declaring that it
+// charges the taker fee is not trusted on its own.
+func (k Keeper) poolHandlesTakerFee(ctx sdk.Context, pool types.PoolI) bool {
+	takerFeePool, ok := pool.(types.TakerFeeHandlingPool)
+	if !ok || !takerFeePool.HandlesTakerFee() {
+		return false
+	}
+
+	customTakerFeePoolIds := []uint64{}
+	k.paramSpace.Get(ctx, types.KeyCustomTakerFeePoolIds, &customTakerFeePoolIds)
+	return osmoutils.Contains(customTakerFeePoolIds, pool.GetId())
+}
+
 // chargeTakerFee extracts the taker fee from the given tokenIn and sends it to the appropriate
 // module account. It returns the tokenIn after the taker fee has been extracted.
 // If the sender is in the taker fee reduced whitelisted, it returns the tokenIn without extracting the taker fee.
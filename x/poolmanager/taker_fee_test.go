@@ -7,6 +7,9 @@ import (
 
 	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/v24/app/apptesting"
+	cwmodel "github.com/osmosis-labs/osmosis/v24/x/cosmwasmpool/model"
+	cwpooltypes "github.com/osmosis-labs/osmosis/v24/x/cosmwasmpool/types"
+	"github.com/osmosis-labs/osmosis/v24/x/poolmanager/types"
 	txfeestypes "github.com/osmosis-labs/osmosis/v24/x/txfees/types"
 )
 
@@ -141,3 +144,117 @@ func (s *KeeperTestSuite) TestChargeTakerFee() {
 		})
 	}
 }
+
+// validates that a pool declaring that it charges the taker fee itself is only
+// exempted from the poolmanager taker fee once governance approved its id.
+func (s *KeeperTestSuite) TestPoolHandlesTakerFeeRequiresGovernanceApproval() {
+	const poolId = uint64(7)
+
+	tests := map[string]struct {
+		capabilities          []string
+		customTakerFeePoolIds []uint64
+		expectedHandles       bool
+	}{
+		"declares custom taker fee, approved by governance": {
+			capabilities:          []string{cwpooltypes.CapabilityCustomTakerFee},
+			customTakerFeePoolIds: []uint64{1, poolId},
+			expectedHandles:       true,
+		},
+		"declares custom taker fee, not approved by governance": {
+			capabilities:          []string{cwpooltypes.CapabilityCustomTakerFee},
+			customTakerFeePoolIds: []uint64{1},
+			expectedHandles:       false,
+		},
+		"approved by governance, does not declare custom taker fee": {
+			capabilities:          []string{},
+			customTakerFeePoolIds: []uint64{poolId},
+			expectedHandles:       false,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			pool := cwmodel.NewCosmWasmPool(poolId, 1, nil)
+			pool.SetCapabilities(cwpooltypes.MaxSupportedInterfaceVersion, tc.capabilities)
+			s.App.PoolManagerKeeper.SetParam(s.Ctx, types.KeyCustomTakerFeePoolIds, tc.customTakerFeePoolIds)
+
+			s.Require().Equal(tc.expectedHandles, s.App.PoolManagerKeeper.PoolHandlesTakerFee(s.Ctx, pool))
+		})
+	}
+}
+
+// validates that the taker fee is not charged by poolmanager on swaps through
+// pools that charge it themselves, and that estimates agree with the swaps.
+func (s *KeeperTestSuite) TestPoolHandlesTakerFee() {
+	var (
+		takerFee      = osmomath.MustNewDecFromStr("0.01")
+		denomIn       = apptesting.DefaultTransmuterDenomA
+		denomOut      = apptesting.DefaultTransmuterDenomB
+		tokenIn       = sdk.NewCoin(denomIn, osmomath.NewInt(1000))
+		poolLiquidity = sdk.NewCoins(sdk.NewCoin(denomIn, osmomath.NewInt(10000)), sdk.NewCoin(denomOut, osmomath.NewInt(10000)))
+	)
+
+	tests := map[string]struct {
+		handlesTakerFee  bool
+		allowedByGov     bool
+		expectedTakerFee osmomath.Int
+	}{
+		"pool handles taker fee": {
+			handlesTakerFee:  true,
+			allowedByGov:     true,
+			expectedTakerFee: osmomath.ZeroInt(),
+		},
+		"pool handles taker fee without governance approval: poolmanager charges taker fee": {
+			handlesTakerFee:  true,
+			allowedByGov:     false,
+			expectedTakerFee: osmomath.NewInt(10),
+		},
+		"pool approved by governance does not handle taker fee: poolmanager charges taker fee": {
+			handlesTakerFee:  false,
+			allowedByGov:     true,
+			expectedTakerFee: osmomath.NewInt(10),
+		},
+		"poolmanager charges taker fee": {
+			handlesTakerFee:  false,
+			expectedTakerFee: osmomath.NewInt(10),
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			poolManager := s.App.PoolManagerKeeper
+
+			s.FundAcc(s.TestAccs[0], poolLiquidity)
+			pool := s.PrepareCustomTransmuterPool(s.TestAccs[0], []string{denomIn, denomOut})
+			s.JoinTransmuterPool(s.TestAccs[0], pool.GetId(), poolLiquidity)
+
+			if tc.handlesTakerFee {
+				pool.SetCapabilities(cwpooltypes.MaxSupportedInterfaceVersion, []string{cwpooltypes.CapabilityCustomTakerFee})
+				s.App.CosmwasmPoolKeeper.SetPool(s.Ctx, pool)
+			}
+			if tc.allowedByGov {
+				poolManager.SetParam(s.Ctx, types.KeyCustomTakerFeePoolIds, []uint64{pool.GetId()})
+			}
+
+			poolManager.SetDenomPairTakerFee(s.Ctx, denomIn, denomOut, takerFee)
+
+			route := []types.SwapAmountInRoute{{PoolId: pool.GetId(), TokenOutDenom: denomOut}}
+			estimatedTokenOut, err := poolManager.MultihopEstimateOutGivenExactAmountIn(s.Ctx, route, tokenIn)
+			s.Require().NoError(err)
+
+			// System under test.
+			s.FundAcc(s.TestAccs[1], sdk.NewCoins(tokenIn))
+			tokenOut, err := poolManager.RouteExactAmountIn(s.Ctx, s.TestAccs[1], route, tokenIn, osmomath.OneInt())
+			s.Require().NoError(err)
+
+			// The transmuter swaps 1:1, so the token out is the token in net of the taker fee.
+			s.Require().Equal(tokenIn.Amount.Sub(tc.expectedTakerFee), tokenOut)
+			s.Require().Equal(estimatedTokenOut, tokenOut)
+
+			takerFeeCollected := s.App.BankKeeper.GetBalance(s.Ctx, s.App.AccountKeeper.GetModuleAddress(txfeestypes.TakerFeeCollectorName), denomIn)
+			s.Require().Equal(tc.expectedTakerFee, takerFeeCollected.Amount)
+		})
+	}
+}
@@ -0,0 +1,301 @@
+package poolmanager
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gogotypes "github.com/cosmos/gogoproto/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v24/x/poolmanager/types"
+)
+
+const (
+	// maxTwapOrderSlicesPerBlock is the maximum number of TWAP order slices executed at the end of a block.
+	// The slices due and not executed are executed at the end of the following blocks, in order of their due time.
+	maxTwapOrderSlicesPerBlock = 100
+
+	// twapOrderMinLookback and twapOrderMaxLookback bound the duration of the TWAP that the token out of a TWAP order
+	// slice is compared to. The TWAP spans the interval of the order, within these bounds, so that the price cannot be
+	// moved for a few blocks to move the TWAP of an order with a short interval.
+	twapOrderMinLookback = 10 * time.Minute
+	twapOrderMaxLookback = time.Hour
+)
+
+// CreateTwapOrder escrows tokenIn from the sender and creates a TWAP order swapping it along the route in numSlices
+// equal slices. The first slice is executed at the end of the current block, and the following ones every interval.
+// Every slice must swap out at least the amount given by the arithmetic TWAP of the route, reduced by maxSlippage.
+// The sender is charged types.TwapOrderCreationFeePerSlice of the bond denom per slice, sent to the community pool.
+//
+// Returns the id of the order created.
+func (k Keeper) CreateTwapOrder(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	route []types.SwapAmountInRoute,
+	tokenIn sdk.Coin,
+	numSlices uint64,
+	interval time.Duration,
+	maxSlippage osmomath.Dec,
+) (uint64, error) {
+	if err := types.SwapAmountInRoutes(route).Validate(); err != nil {
+		return 0, err
+	}
+	if err := types.ValidateTwapOrderSlicing(tokenIn, numSlices, interval, maxSlippage); err != nil {
+		return 0, err
+	}
+
+	// Fail early if a hop of the route does not swap between denoms of its pool.
+	if _, err := k.routeSpotPrice(ctx, route, tokenIn.Denom); err != nil {
+		return 0, err
+	}
+
+	creationFee := sdk.NewCoins(sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), types.TwapOrderCreationFeePerSlice.Mul(osmomath.NewIntFromUint64(numSlices))))
+	if err := k.communityPoolKeeper.FundCommunityPool(ctx, creationFee, sender); err != nil {
+		return 0, err
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, sender, types.TwapOrderEscrowAddress, sdk.NewCoins(tokenIn)); err != nil {
+		return 0, err
+	}
+
+	orderId := k.GetNextTwapOrderId(ctx)
+	k.SetNextTwapOrderId(ctx, orderId+1)
+
+	k.setTwapOrder(ctx, types.TwapOrder{
+		Id:                orderId,
+		Owner:             sender.String(),
+		Routes:            route,
+		TokenInDenom:      tokenIn.Denom,
+		TotalAmount:       tokenIn.Amount,
+		RemainingAmount:   tokenIn.Amount,
+		TokenOutAmount:    osmomath.ZeroInt(),
+		NumSlices:         numSlices,
+		Interval:          interval,
+		NextExecutionTime: ctx.BlockTime(),
+		MaxSlippage:       maxSlippage,
+	})
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtTwapOrderCreated,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyOrderId, strconv.FormatUint(orderId, 10)),
+			sdk.NewAttribute(types.AttributeKeyOwner, sender.String()),
+			sdk.NewAttribute(types.AttributeKeyTokensIn, tokenIn.String()),
+		),
+	})
+
+	return orderId, nil
+}
+
+// CancelTwapOrder deletes the TWAP order with the given id and returns the token in still escrowed to its owner,
+// who must be the sender.
+//
+// Returns the token in returned.
+func (k Keeper) CancelTwapOrder(ctx sdk.Context, sender sdk.AccAddress, orderId uint64) (sdk.Coin, error) {
+	order, err := k.GetTwapOrder(ctx, orderId)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	if order.Owner != sender.String() {
+		return sdk.Coin{}, types.TwapOrderOwnerMismatchError{OrderId: orderId, Owner: order.Owner, Sender: sender.String()}
+	}
+
+	refund := sdk.NewCoin(order.TokenInDenom, order.RemainingAmount)
+	if err := k.bankKeeper.SendCoins(ctx, types.TwapOrderEscrowAddress, sender, sdk.NewCoins(refund)); err != nil {
+		return sdk.Coin{}, err
+	}
+	k.deleteTwapOrder(ctx, order)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtTwapOrderCancelled,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyOrderId, strconv.FormatUint(orderId, 10)),
+			sdk.NewAttribute(types.AttributeKeyOwner, order.Owner),
+			sdk.NewAttribute(types.AttributeKeyTokensRefunded, refund.String()),
+		),
+	})
+
+	return refund, nil
+}
+
+// ExecuteDueTwapOrders executes the next slice of the TWAP orders whose next slice is due at the current block time,
+// up to maxTwapOrderSlicesPerBlock of them in order of their due time.
+func (k Keeper) ExecuteDueTwapOrders(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.KeyTwapOrderQueuePrefix, storetypes.PrefixEndBytes(types.FormatTwapOrderQueueTimePrefix(ctx.BlockTime())))
+	orderIds := []uint64{}
+	for ; iterator.Valid() && len(orderIds) < maxTwapOrderSlicesPerBlock; iterator.Next() {
+		orderIds = append(orderIds, sdk.BigEndianToUint64(iterator.Value()))
+	}
+	iterator.Close()
+
+	for _, orderId := range orderIds {
+		order, err := k.GetTwapOrder(ctx, orderId)
+		if err != nil {
+			ctx.Logger().Error("failed to get due TWAP order", "order_id", orderId, "error", err)
+			continue
+		}
+		k.executeTwapOrderSlice(ctx, order)
+	}
+}
+
+// executeTwapOrderSlice swaps the next slice of the TWAP order, which is an equal share of its remaining amount over
+// its remaining slices, and sends the token out to its owner. If the swap fails, for example because its token out is
+// below the TWAP of the route reduced by the max slippage of the order, the slice is skipped and its token in is spread
+// over the following slices. After the last slice, the order is deleted and its remaining amount returned to its owner.
+func (k Keeper) executeTwapOrderSlice(ctx sdk.Context, order types.TwapOrder) {
+	owner := sdk.MustAccAddressFromBech32(order.Owner)
+	sliceIndex := order.SlicesExecuted
+	sliceTokenIn := sdk.NewCoin(order.TokenInDenom, order.RemainingAmount.QuoRaw(int64(order.NumSlices-order.SlicesExecuted)))
+	tokenOutDenom := order.Routes[len(order.Routes)-1].TokenOutDenom
+
+	var tokenOutAmount osmomath.Int
+	err := osmoutils.ApplyFuncIfNoError(ctx, func(cacheCtx sdk.Context) error {
+		minTokenOutAmount, err := k.twapOrderSliceMinTokenOutAmount(cacheCtx, order, sliceTokenIn.Amount)
+		if err != nil {
+			return err
+		}
+		tokenOutAmount, err = k.RouteExactAmountIn(cacheCtx, types.TwapOrderEscrowAddress, order.Routes, sliceTokenIn, minTokenOutAmount)
+		if err != nil {
+			return err
+		}
+		return k.bankKeeper.SendCoins(cacheCtx, types.TwapOrderEscrowAddress, owner, sdk.NewCoins(sdk.NewCoin(tokenOutDenom, tokenOutAmount)))
+	})
+
+	sliceEvent := sdk.NewEvent(
+		types.TypeEvtTwapOrderSlice,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(types.AttributeKeyOrderId, strconv.FormatUint(order.Id, 10)),
+		sdk.NewAttribute(types.AttributeKeySliceIndex, strconv.FormatUint(sliceIndex, 10)),
+		sdk.NewAttribute(types.AttributeKeyTokensIn, sliceTokenIn.String()),
+	)
+	if err != nil {
+		sliceEvent = sliceEvent.AppendAttributes(sdk.NewAttribute(types.AttributeKeyError, err.Error()))
+	} else {
+		sliceEvent = sliceEvent.AppendAttributes(sdk.NewAttribute(types.AttributeKeyTokensOut, sdk.NewCoin(tokenOutDenom, tokenOutAmount).String()))
+		order.RemainingAmount = order.RemainingAmount.Sub(sliceTokenIn.Amount)
+		order.TokenOutAmount = order.TokenOutAmount.Add(tokenOutAmount)
+	}
+	ctx.EventManager().EmitEvent(sliceEvent)
+
+	// The order is stored again under its next execution time, unless it is completed. A slice executed late, e.g.
+	// because the queue was full, does not make the next one due right away, so that late orders do not catch up by
+	// crowding out the queue.
+	k.deleteTwapOrder(ctx, order)
+	order.SlicesExecuted++
+	if order.SlicesExecuted < order.NumSlices {
+		order.NextExecutionTime = order.NextExecutionTime.Add(order.Interval)
+		if !order.NextExecutionTime.After(ctx.BlockTime()) {
+			order.NextExecutionTime = ctx.BlockTime().Add(order.Interval)
+		}
+		k.setTwapOrder(ctx, order)
+		return
+	}
+
+	refund := sdk.NewCoin(order.TokenInDenom, order.RemainingAmount)
+	if err := k.bankKeeper.SendCoins(ctx, types.TwapOrderEscrowAddress, owner, sdk.NewCoins(refund)); err != nil {
+		ctx.Logger().Error("failed to refund completed TWAP order", "order_id", order.Id, "error", err)
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtTwapOrderCompleted,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyOrderId, strconv.FormatUint(order.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyOwner, order.Owner),
+			sdk.NewAttribute(types.AttributeKeyTokensOut, sdk.NewCoin(tokenOutDenom, order.TokenOutAmount).String()),
+			sdk.NewAttribute(types.AttributeKeyTokensRefunded, refund.String()),
+		),
+	})
+}
+
+// twapOrderSliceMinTokenOutAmount returns the minimum token out amount of swapping amountIn in a slice of the order,
+// which is amountIn valued at the arithmetic TWAP of the route over the interval of the order, bounded by
+// twapOrderMinLookback and twapOrderMaxLookback, and reduced by the max slippage of the order.
+//
+// Returns error if the TWAP of the route cannot be computed, or may be faulty.
+func (k Keeper) twapOrderSliceMinTokenOutAmount(ctx sdk.Context, order types.TwapOrder, amountIn osmomath.Int) (osmomath.Int, error) {
+	lookback := order.Interval
+	if lookback < twapOrderMinLookback {
+		lookback = twapOrderMinLookback
+	}
+	if lookback > twapOrderMaxLookback {
+		lookback = twapOrderMaxLookback
+	}
+
+	twapPrice, err := k.twapKeeper.GetArithmeticTwapForRoute(ctx, order.TokenInDenom, order.Routes, ctx.BlockTime().Add(-lookback), ctx.BlockTime())
+	if err != nil {
+		return osmomath.Int{}, err
+	}
+	if !twapPrice.IsPositive() {
+		return osmomath.Int{}, fmt.Errorf("TWAP of the route (%s) must be positive", twapPrice)
+	}
+
+	minTokenOutAmount := twapPrice.MulInt(amountIn).MulMut(osmomath.OneDec().Sub(order.MaxSlippage)).TruncateInt()
+	return osmomath.MaxInt(minTokenOutAmount, osmomath.OneInt()), nil
+}
+
+// GetTwapOrder returns the TWAP order with the given id.
+// Returns error if the order does not exist, for example because it was completed or cancelled.
+func (k Keeper) GetTwapOrder(ctx sdk.Context, orderId uint64) (types.TwapOrder, error) {
+	store := ctx.KVStore(k.storeKey)
+	order := types.TwapOrder{}
+	found, err := osmoutils.Get(store, types.FormatTwapOrderKey(orderId), &order)
+	if err != nil {
+		return types.TwapOrder{}, err
+	}
+	if !found {
+		return types.TwapOrder{}, types.TwapOrderNotFoundError{OrderId: orderId}
+	}
+	return order, nil
+}
+
+// GetAllTwapOrders returns all the TWAP orders, in order of id.
+func (k Keeper) GetAllTwapOrders(ctx sdk.Context) ([]types.TwapOrder, error) {
+	store := ctx.KVStore(k.storeKey)
+	return osmoutils.GatherValuesFromStorePrefix(store, types.KeyTwapOrderPrefix, func(value []byte) (types.TwapOrder, error) {
+		order := types.TwapOrder{}
+		err := order.Unmarshal(value)
+		return order, err
+	})
+}
+
+// GetNextTwapOrderId returns the id of the next TWAP order created.
+func (k Keeper) GetNextTwapOrderId(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	nextOrderId := gogotypes.UInt64Value{}
+	found, err := osmoutils.Get(store, types.KeyNextTwapOrderId, &nextOrderId)
+	if err != nil {
+		panic(err)
+	}
+	if !found {
+		return 1
+	}
+	return nextOrderId.Value
+}
+
+// SetNextTwapOrderId sets the id of the next TWAP order created.
+func (k Keeper) SetNextTwapOrderId(ctx sdk.Context, orderId uint64) {
+	store := ctx.KVStore(k.storeKey)
+	osmoutils.MustSet(store, types.KeyNextTwapOrderId, &gogotypes.UInt64Value{Value: orderId})
+}
+
+// setTwapOrder stores the TWAP order, and its id in the queue of orders under the time of its next slice.
+func (k Keeper) setTwapOrder(ctx sdk.Context, order types.TwapOrder) {
+	store := ctx.KVStore(k.storeKey)
+	osmoutils.MustSet(store, types.FormatTwapOrderKey(order.Id), &order)
+	store.Set(types.FormatTwapOrderQueueKey(order.NextExecutionTime, order.Id), sdk.Uint64ToBigEndian(order.Id))
+}
+
+// deleteTwapOrder deletes the TWAP order, and its id from the queue of orders.
+func (k Keeper) deleteTwapOrder(ctx sdk.Context, order types.TwapOrder) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.FormatTwapOrderKey(order.Id))
+	store.Delete(types.FormatTwapOrderQueueKey(order.NextExecutionTime, order.Id))
+}
@@ -0,0 +1,277 @@
+package poolmanager_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v24/app/apptesting"
+	"github.com/osmosis-labs/osmosis/v24/x/poolmanager/types"
+)
+
+var (
+	defaultTwapOrderPoolAmount = osmomath.NewInt(1_000_000_000)
+	defaultTwapOrderTokenIn    = sdk.NewCoin(apptesting.FOO, osmomath.NewInt(4_000_000))
+	defaultTwapOrderRoute      = []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: apptesting.BAR}}
+	defaultTwapOrderInterval   = time.Minute
+)
+
+// setupTwapOrderPool creates a FOO/BAR balancer pool, and moves the block time past the longest lookback of the TWAP
+// of an order so that the TWAP of the pool over it is defined.
+func (s *KeeperTestSuite) setupTwapOrderPool() {
+	s.PrepareBalancerPoolWithCoins(sdk.NewCoin(apptesting.FOO, defaultTwapOrderPoolAmount), sdk.NewCoin(apptesting.BAR, defaultTwapOrderPoolAmount))
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(2 * time.Hour))
+}
+
+// twapOrderCreationFee returns the fee charged for creating a TWAP order of numSlices slices.
+func (s *KeeperTestSuite) twapOrderCreationFee(numSlices uint64) sdk.Coin {
+	bondDenom := s.App.StakingKeeper.BondDenom(s.Ctx)
+	return sdk.NewCoin(bondDenom, types.TwapOrderCreationFeePerSlice.Mul(osmomath.NewIntFromUint64(numSlices)))
+}
+
+// createTwapOrder funds the owner with the token in and the creation fee of the order, and creates it.
+func (s *KeeperTestSuite) createTwapOrder(owner sdk.AccAddress, numSlices uint64, interval time.Duration, maxSlippage osmomath.Dec) uint64 {
+	s.FundAcc(owner, sdk.NewCoins(defaultTwapOrderTokenIn, s.twapOrderCreationFee(numSlices)))
+	orderId, err := s.App.PoolManagerKeeper.CreateTwapOrder(s.Ctx, owner, defaultTwapOrderRoute, defaultTwapOrderTokenIn, numSlices, interval, maxSlippage)
+	s.Require().NoError(err)
+	return orderId
+}
+
+func (s *KeeperTestSuite) TestCreateTwapOrder() {
+	tests := map[string]struct {
+		route     []types.SwapAmountInRoute
+		tokenIn   sdk.Coin
+		numSlices uint64
+		interval  time.Duration
+		funds     sdk.Coins
+		// noCreationFee is true if the owner is not funded with the creation fee of the order.
+		noCreationFee bool
+
+		expectedErrorContains string
+	}{
+		"valid order": {
+			route:     defaultTwapOrderRoute,
+			tokenIn:   defaultTwapOrderTokenIn,
+			numSlices: 4,
+			funds:     sdk.NewCoins(defaultTwapOrderTokenIn),
+		},
+		"error: more slices than the token in amount": {
+			route:     defaultTwapOrderRoute,
+			tokenIn:   sdk.NewCoin(apptesting.FOO, osmomath.NewInt(3)),
+			numSlices: 4,
+			funds:     sdk.NewCoins(defaultTwapOrderTokenIn),
+
+			expectedErrorContains: types.InvalidNumSlicesError{NumSlices: 4, TokenInAmount: osmomath.NewInt(3)}.Error(),
+		},
+		"error: interval too short": {
+			route:     defaultTwapOrderRoute,
+			tokenIn:   defaultTwapOrderTokenIn,
+			numSlices: 4,
+			interval:  types.MinTwapOrderInterval - time.Second,
+			funds:     sdk.NewCoins(defaultTwapOrderTokenIn),
+
+			expectedErrorContains: types.InvalidTwapOrderIntervalError{Interval: types.MinTwapOrderInterval - time.Second}.Error(),
+		},
+		"error: route token out denom not in pool": {
+			route:     []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: apptesting.BAZ}},
+			tokenIn:   defaultTwapOrderTokenIn,
+			numSlices: 4,
+			funds:     sdk.NewCoins(defaultTwapOrderTokenIn),
+
+			expectedErrorContains: "does not exist in the pool",
+		},
+		"error: insufficient funds": {
+			route:     defaultTwapOrderRoute,
+			tokenIn:   defaultTwapOrderTokenIn,
+			numSlices: 4,
+			funds:     sdk.NewCoins(sdk.NewCoin(apptesting.FOO, defaultTwapOrderTokenIn.Amount.SubRaw(1))),
+
+			expectedErrorContains: sdkerrors.ErrInsufficientFunds.Error(),
+		},
+		"error: creation fee not funded": {
+			route:         defaultTwapOrderRoute,
+			tokenIn:       defaultTwapOrderTokenIn,
+			numSlices:     4,
+			funds:         sdk.NewCoins(defaultTwapOrderTokenIn),
+			noCreationFee: true,
+
+			expectedErrorContains: sdkerrors.ErrInsufficientFunds.Error(),
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			s.setupTwapOrderPool()
+			owner := apptesting.CreateRandomAccounts(1)[0]
+			s.FundAcc(owner, tc.funds)
+			creationFee := s.twapOrderCreationFee(tc.numSlices)
+			if !tc.noCreationFee {
+				s.FundAcc(owner, sdk.NewCoins(creationFee))
+			}
+			interval := tc.interval
+			if interval == 0 {
+				interval = defaultTwapOrderInterval
+			}
+			communityPoolBefore := s.App.DistrKeeper.GetFeePoolCommunityCoins(s.Ctx)
+
+			orderId, err := s.App.PoolManagerKeeper.CreateTwapOrder(s.Ctx, owner, tc.route, tc.tokenIn, tc.numSlices, interval, osmomath.MustNewDecFromStr("0.05"))
+			if tc.expectedErrorContains != "" {
+				s.Require().ErrorContains(err, tc.expectedErrorContains)
+				s.Require().Equal(uint64(1), s.App.PoolManagerKeeper.GetNextTwapOrderId(s.Ctx))
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(uint64(1), orderId)
+			s.Require().Equal(uint64(2), s.App.PoolManagerKeeper.GetNextTwapOrderId(s.Ctx))
+
+			order, err := s.App.PoolManagerKeeper.GetTwapOrder(s.Ctx, orderId)
+			s.Require().NoError(err)
+			s.Require().Equal(owner.String(), order.Owner)
+			s.Require().Equal(tc.tokenIn.Amount, order.RemainingAmount)
+			s.Require().Equal(s.Ctx.BlockTime(), order.NextExecutionTime)
+
+			// The token in is escrowed.
+			s.Require().Equal(tc.tokenIn, s.App.BankKeeper.GetBalance(s.Ctx, types.TwapOrderEscrowAddress, tc.tokenIn.Denom))
+			s.Require().True(s.App.BankKeeper.GetBalance(s.Ctx, owner, tc.tokenIn.Denom).IsZero())
+
+			// The creation fee is sent to the community pool.
+			s.Require().True(s.App.BankKeeper.GetBalance(s.Ctx, owner, creationFee.Denom).IsZero())
+			communityPoolAfter := s.App.DistrKeeper.GetFeePoolCommunityCoins(s.Ctx)
+			s.Require().Equal(creationFee.Amount, communityPoolAfter.AmountOf(creationFee.Denom).Sub(communityPoolBefore.AmountOf(creationFee.Denom)).TruncateInt())
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestExecuteDueTwapOrders() {
+	tests := map[string]struct {
+		maxSlippage osmomath.Dec
+
+		expectSlicesFail bool
+	}{
+		"slices within max slippage are executed": {
+			maxSlippage: osmomath.MustNewDecFromStr("0.05"),
+		},
+		"slices exceeding max slippage are skipped and the token in refunded": {
+			maxSlippage: osmomath.ZeroDec(),
+
+			expectSlicesFail: true,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			s.setupTwapOrderPool()
+			owner := s.TestAccs[0]
+
+			numSlices := uint64(4)
+			sliceAmount := defaultTwapOrderTokenIn.Amount.QuoRaw(int64(numSlices))
+			orderId := s.createTwapOrder(owner, numSlices, defaultTwapOrderInterval, tc.maxSlippage)
+
+			for i := uint64(0); i < numSlices; i++ {
+				expectedTokenOutAmount, err := s.App.PoolManagerKeeper.MultihopEstimateOutGivenExactAmountIn(s.Ctx, defaultTwapOrderRoute, sdk.NewCoin(apptesting.FOO, sliceAmount))
+				s.Require().NoError(err)
+				tokenOutBalanceBefore := s.App.BankKeeper.GetBalance(s.Ctx, owner, apptesting.BAR).Amount
+
+				s.App.PoolManagerKeeper.ExecuteDueTwapOrders(s.Ctx)
+
+				tokenOutBalance := s.App.BankKeeper.GetBalance(s.Ctx, owner, apptesting.BAR).Amount
+				if tc.expectSlicesFail {
+					s.Require().Equal(tokenOutBalanceBefore.String(), tokenOutBalance.String())
+				} else {
+					s.Require().Equal(expectedTokenOutAmount.String(), tokenOutBalance.Sub(tokenOutBalanceBefore).String())
+				}
+
+				if i == numSlices-1 {
+					break
+				}
+
+				order, err := s.App.PoolManagerKeeper.GetTwapOrder(s.Ctx, orderId)
+				s.Require().NoError(err)
+				s.Require().Equal(i+1, order.SlicesExecuted)
+				if tc.expectSlicesFail {
+					s.Require().Equal(defaultTwapOrderTokenIn.Amount, order.RemainingAmount)
+				} else {
+					s.Require().Equal(defaultTwapOrderTokenIn.Amount.Sub(sliceAmount.MulRaw(int64(i+1))), order.RemainingAmount)
+				}
+
+				// The next slice is not due until the interval has passed.
+				s.App.PoolManagerKeeper.ExecuteDueTwapOrders(s.Ctx)
+				order, err = s.App.PoolManagerKeeper.GetTwapOrder(s.Ctx, orderId)
+				s.Require().NoError(err)
+				s.Require().Equal(i+1, order.SlicesExecuted)
+
+				s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(defaultTwapOrderInterval))
+			}
+
+			// The order is completed, and whatever was not swapped is returned to the owner.
+			_, err := s.App.PoolManagerKeeper.GetTwapOrder(s.Ctx, orderId)
+			s.Require().ErrorIs(err, types.TwapOrderNotFoundError{OrderId: orderId})
+			s.Require().True(s.App.BankKeeper.GetAllBalances(s.Ctx, types.TwapOrderEscrowAddress).IsZero())
+			if tc.expectSlicesFail {
+				s.Require().Equal(defaultTwapOrderTokenIn, s.App.BankKeeper.GetBalance(s.Ctx, owner, apptesting.FOO))
+			} else {
+				s.Require().True(s.App.BankKeeper.GetBalance(s.Ctx, owner, apptesting.FOO).IsZero())
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestCancelTwapOrder() {
+	s.SetupTest()
+	s.setupTwapOrderPool()
+	owner := s.TestAccs[0]
+
+	numSlices := uint64(4)
+	orderId := s.createTwapOrder(owner, numSlices, defaultTwapOrderInterval, osmomath.MustNewDecFromStr("0.05"))
+	s.App.PoolManagerKeeper.ExecuteDueTwapOrders(s.Ctx)
+
+	// Only the owner can cancel the order.
+	_, err := s.App.PoolManagerKeeper.CancelTwapOrder(s.Ctx, s.TestAccs[1], orderId)
+	s.Require().ErrorIs(err, types.TwapOrderOwnerMismatchError{OrderId: orderId, Owner: owner.String(), Sender: s.TestAccs[1].String()})
+
+	_, err = s.App.PoolManagerKeeper.CancelTwapOrder(s.Ctx, owner, orderId+1)
+	s.Require().ErrorIs(err, types.TwapOrderNotFoundError{OrderId: orderId + 1})
+
+	refund, err := s.App.PoolManagerKeeper.CancelTwapOrder(s.Ctx, owner, orderId)
+	s.Require().NoError(err)
+	expectedRefund := sdk.NewCoin(apptesting.FOO, defaultTwapOrderTokenIn.Amount.Sub(defaultTwapOrderTokenIn.Amount.QuoRaw(int64(numSlices))))
+	s.Require().Equal(expectedRefund, refund)
+	s.Require().Equal(expectedRefund, s.App.BankKeeper.GetBalance(s.Ctx, owner, apptesting.FOO))
+	s.Require().True(s.App.BankKeeper.GetAllBalances(s.Ctx, types.TwapOrderEscrowAddress).IsZero())
+
+	// The cancelled order is no longer executed.
+	tokenOutBalance := s.App.BankKeeper.GetBalance(s.Ctx, owner, apptesting.BAR)
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(defaultTwapOrderInterval))
+	s.App.PoolManagerKeeper.ExecuteDueTwapOrders(s.Ctx)
+	s.Require().Equal(tokenOutBalance, s.App.BankKeeper.GetBalance(s.Ctx, owner, apptesting.BAR))
+	_, err = s.App.PoolManagerKeeper.GetTwapOrder(s.Ctx, orderId)
+	s.Require().ErrorIs(err, types.TwapOrderNotFoundError{OrderId: orderId})
+}
+
+func (s *KeeperTestSuite) TestLateTwapOrderSliceDoesNotCatchUp() {
+	s.SetupTest()
+	s.setupTwapOrderPool()
+	owner := s.TestAccs[0]
+
+	orderId := s.createTwapOrder(owner, 4, defaultTwapOrderInterval, osmomath.MustNewDecFromStr("0.05"))
+	s.App.PoolManagerKeeper.ExecuteDueTwapOrders(s.Ctx)
+
+	// The next slice is executed several intervals late, e.g. because the queue was full.
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(3 * defaultTwapOrderInterval))
+	s.App.PoolManagerKeeper.ExecuteDueTwapOrders(s.Ctx)
+
+	// The following slice is due an interval later, rather than right away.
+	order, err := s.App.PoolManagerKeeper.GetTwapOrder(s.Ctx, orderId)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(2), order.SlicesExecuted)
+	s.Require().Equal(s.Ctx.BlockTime().Add(defaultTwapOrderInterval), order.NextExecutionTime)
+
+	s.App.PoolManagerKeeper.ExecuteDueTwapOrders(s.Ctx)
+	order, err = s.App.PoolManagerKeeper.GetTwapOrder(s.Ctx, orderId)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(2), order.SlicesExecuted)
+}
@@ -15,6 +15,11 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgSwapExactAmountOut{}, "osmosis/poolmanager/swap-exact-amount-out", nil)
 	cdc.RegisterConcrete(&MsgSplitRouteSwapExactAmountIn{}, "osmosis/poolmanager/split-amount-in", nil)
 	cdc.RegisterConcrete(&MsgSplitRouteSwapExactAmountOut{}, "osmosis/poolmanager/split-amount-out", nil)
+	cdc.RegisterConcrete(&MsgSwapExactAmountInWithPriceLimit{}, "osmosis/poolmanager/swap-exact-amount-in-with-price-limit", nil)
+	cdc.RegisterConcrete(&MsgCreateTwapOrder{}, "osmosis/poolmanager/create-twap-order", nil)
+	cdc.RegisterConcrete(&MsgCancelTwapOrder{}, "osmosis/poolmanager/cancel-twap-order", nil)
+	cdc.RegisterConcrete(&MsgJoinPool{}, "osmosis/poolmanager/join-pool", nil)
+	cdc.RegisterConcrete(&MsgExitPool{}, "osmosis/poolmanager/exit-pool", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
@@ -24,6 +29,11 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgSwapExactAmountOut{},
 		&MsgSplitRouteSwapExactAmountIn{},
 		&MsgSplitRouteSwapExactAmountOut{},
+		&MsgSwapExactAmountInWithPriceLimit{},
+		&MsgCreateTwapOrder{},
+		&MsgCancelTwapOrder{},
+		&MsgJoinPool{},
+		&MsgExitPool{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
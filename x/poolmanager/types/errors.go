@@ -3,6 +3,7 @@ package types
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
 )
@@ -133,3 +134,128 @@ type InactivePoolError struct {
 func (e InactivePoolError) Error() string {
 	return fmt.Sprintf("Pool %d is not active.", e.PoolId)
 }
+
+type InvalidPriceChangeError struct {
+	PriceChange osmomath.Dec
+}
+
+func (e InvalidPriceChangeError) Error() string {
+	return fmt.Sprintf("price change (%s) must be greater than zero and less than one", e.PriceChange)
+}
+
+type PoolDepthNotSupportedError struct {
+	PoolId   uint64
+	PoolType PoolType
+}
+
+func (e PoolDepthNotSupportedError) Error() string {
+	return fmt.Sprintf("depth is not supported for pool (%d) of type (%s)", e.PoolId, e.PoolType)
+}
+
+type PoolDepthNotReachedError struct {
+	PoolId          uint64
+	TokenInDenom    string
+	TokenOutDenom   string
+	TargetSpotPrice osmomath.BigDec
+}
+
+func (e PoolDepthNotReachedError) Error() string {
+	return fmt.Sprintf("spot price of (%s) in terms of (%s) in pool (%d) cannot be moved to (%s) by swapping in (%s)",
+		e.TokenOutDenom, e.TokenInDenom, e.PoolId, e.TargetSpotPrice, e.TokenInDenom)
+}
+
+type JoinExitNotSupportedError struct {
+	PoolId   uint64
+	PoolType PoolType
+}
+
+func (e JoinExitNotSupportedError) Error() string {
+	return fmt.Sprintf("joining and exiting through poolmanager is not supported for pool (%d) of type (%s)", e.PoolId, e.PoolType)
+}
+
+type NoRouteFoundError struct {
+	TokenInDenom  string
+	TokenOutDenom string
+}
+
+func (e NoRouteFoundError) Error() string {
+	return fmt.Sprintf("no route found from (%s) to (%s)", e.TokenInDenom, e.TokenOutDenom)
+}
+
+type RouterSearchBoundError struct {
+	Bound string
+	Value uint32
+	Limit uint32
+}
+
+func (e RouterSearchBoundError) Error() string {
+	return fmt.Sprintf("router search %s (%d) must not exceed %d", e.Bound, e.Value, e.Limit)
+}
+
+type RouterSearchOutOfGasError struct {
+	GasLimit uint64
+}
+
+func (e RouterSearchOutOfGasError) Error() string {
+	return fmt.Sprintf("router search ran out of its gas limit (%d) before finding the candidate routes", e.GasLimit)
+}
+
+type InvalidPriceLimitError struct {
+	MaxPriceImpact osmomath.Dec
+	LimitPrice     osmomath.Dec
+}
+
+func (e InvalidPriceLimitError) Error() string {
+	return fmt.Sprintf("exactly one of max price impact (%s) and limit price (%s) must be set, and it must be positive", e.MaxPriceImpact, e.LimitPrice)
+}
+
+type PriceLimitExceededError struct {
+	MaxPrice osmomath.BigDec
+}
+
+func (e PriceLimitExceededError) Error() string {
+	return fmt.Sprintf("no amount of the token in can be swapped at an execution price of at most (%s)", e.MaxPrice)
+}
+
+type InvalidNumSlicesError struct {
+	NumSlices     uint64
+	TokenInAmount osmomath.Int
+}
+
+func (e InvalidNumSlicesError) Error() string {
+	return fmt.Sprintf("number of slices (%d) must be positive, at most %d and at most the token in amount (%s)", e.NumSlices, MaxTwapOrderSlices, e.TokenInAmount)
+}
+
+type InvalidTwapOrderIntervalError struct {
+	Interval time.Duration
+}
+
+func (e InvalidTwapOrderIntervalError) Error() string {
+	return fmt.Sprintf("TWAP order interval (%s) must be at least %s", e.Interval, MinTwapOrderInterval)
+}
+
+type InvalidMaxSlippageError struct {
+	MaxSlippage osmomath.Dec
+}
+
+func (e InvalidMaxSlippageError) Error() string {
+	return fmt.Sprintf("max slippage (%s) must be non-negative and less than one", e.MaxSlippage)
+}
+
+type TwapOrderNotFoundError struct {
+	OrderId uint64
+}
+
+func (e TwapOrderNotFoundError) Error() string {
+	return fmt.Sprintf("TWAP order (%d) not found", e.OrderId)
+}
+
+type TwapOrderOwnerMismatchError struct {
+	OrderId uint64
+	Owner   string
+	Sender  string
+}
+
+func (e TwapOrderOwnerMismatchError) Error() string {
+	return fmt.Sprintf("TWAP order (%d) is owned by (%s), not by (%s)", e.OrderId, e.Owner, e.Sender)
+}
@@ -10,4 +10,14 @@ const (
 	AttributeKeyDenom0           = "denom0"
 	AttributeKeyDenom1           = "denom1"
 	AttributeKeyTakerFee         = "taker_fee"
+
+	TypeEvtTwapOrderCreated    = "twap_order_created"
+	TypeEvtTwapOrderSlice      = "twap_order_slice"
+	TypeEvtTwapOrderCompleted  = "twap_order_completed"
+	TypeEvtTwapOrderCancelled  = "twap_order_cancelled"
+	AttributeKeyOrderId        = "order_id"
+	AttributeKeyOwner          = "owner"
+	AttributeKeySliceIndex     = "slice_index"
+	AttributeKeyError          = "error"
+	AttributeKeyTokensRefunded = "tokens_refunded"
 )
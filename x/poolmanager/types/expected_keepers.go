@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
@@ -95,6 +97,60 @@ type PoolModuleI interface {
 	GetTotalLiquidity(ctx sdk.Context) (sdk.Coins, error)
 }
 
+// PoolDepthModuleI is an optional extension of PoolModuleI for pool modules that compute the depth of
+// their pools from the distribution of their liquidity, rather than by simulating swaps.
+type PoolDepthModuleI interface {
+	// CalcAmountsToSpotPrices returns the amounts of tokenInDenom swapped into and of tokenOutDenom swapped
+	// out of the given pool to move the spot price of tokenOutDenom, quoted in tokenInDenom, from its
+	// current value up to each of the given target spot prices, which must be in increasing order.
+	// The amounts are cumulative and exclude the spread factor.
+	CalcAmountsToSpotPrices(
+		ctx sdk.Context,
+		poolI PoolI,
+		tokenInDenom string,
+		tokenOutDenom string,
+		targetSpotPrices []osmomath.BigDec,
+	) (amountsIn []osmomath.Int, amountsOut []osmomath.Int, err error)
+}
+
+// SwapTraceModuleI is an optional extension of PoolModuleI for pool modules that trace the execution of their
+// swaps themselves, rather than having it simulated against their pool model.
+type SwapTraceModuleI interface {
+	// CalcOutAmtGivenInWithTrace returns the same token out as CalcOutAmtGivenIn, together with the trace of the
+	// swap. Only the token out, spread factor, spread fee, spot price after and steps of the trace are set.
+	CalcOutAmtGivenInWithTrace(
+		ctx sdk.Context,
+		poolI PoolI,
+		tokenIn sdk.Coin,
+		tokenOutDenom string,
+		spreadFactor osmomath.Dec,
+	) (HopTrace, error)
+}
+
+// JoinExitPoolModuleI is an optional extension of PoolModuleI for pool modules whose pools can be joined
+// and exited through poolmanager, in exchange for LP shares minted and burned by the pool module.
+type JoinExitPoolModuleI interface {
+	// JoinPool transfers the tokens in from the sender to the pool and returns the LP shares minted to
+	// the sender in exchange. Returns error if fewer than shareOutMinAmount shares would be minted.
+	JoinPool(
+		ctx sdk.Context,
+		sender sdk.AccAddress,
+		poolI PoolI,
+		tokensIn sdk.Coins,
+		shareOutMinAmount osmomath.Int,
+	) (shareOut sdk.Coin, err error)
+	// ExitPool burns the given amount of LP shares of the sender and returns the tokens transferred from
+	// the pool to the sender in exchange. Returns error if any of the tokens out is below its minimum
+	// in tokenOutMins.
+	ExitPool(
+		ctx sdk.Context,
+		sender sdk.AccAddress,
+		poolI PoolI,
+		shareInAmount osmomath.Int,
+		tokenOutMins sdk.Coins,
+	) (tokensOut sdk.Coins, err error)
+}
+
 type PoolIncentivesKeeperI interface {
 	IsPoolIncentivized(ctx sdk.Context, poolId uint64) (bool, error)
 }
@@ -109,6 +165,11 @@ type StakingKeeper interface {
 	BondDenom(ctx sdk.Context) string
 }
 
+// TwapKeeper defines the contract needed to be fulfilled for the twap keeper.
+type TwapKeeper interface {
+	GetArithmeticTwapForRoute(ctx sdk.Context, baseAssetDenom string, routes SwapAmountInRoutes, startTime time.Time, endTime time.Time) (osmomath.Dec, error)
+}
+
 type ProtorevKeeper interface {
 	GetPoolForDenomPair(ctx sdk.Context, baseDenom, denomToMatch string) (uint64, error)
 }
@@ -2,6 +2,7 @@ package types
 
 import (
 	"errors"
+	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -9,8 +10,9 @@ import (
 // DefaultGenesis returns the default poolmanager genesis state.
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		Params:     DefaultParams(),
-		NextPoolId: 1,
+		Params:          DefaultParams(),
+		NextPoolId:      1,
+		NextTwapOrderId: 1,
 		TakerFeesTracker: &TakerFeesTracker{
 			TakerFeesToStakers:         sdk.NewCoins(),
 			TakerFeesToCommunityPool:   sdk.NewCoins(),
@@ -28,5 +30,18 @@ func (gs GenesisState) Validate() error {
 	if err := gs.Params.Validate(); err != nil {
 		return err
 	}
+	orderIds := make(map[uint64]bool, len(gs.TwapOrders))
+	for _, order := range gs.TwapOrders {
+		if err := order.Validate(); err != nil {
+			return err
+		}
+		if order.Id == 0 || order.Id >= gs.NextTwapOrderId {
+			return fmt.Errorf("TWAP order id (%d) must be positive and less than the next TWAP order id (%d)", order.Id, gs.NextTwapOrderId)
+		}
+		if orderIds[order.Id] {
+			return fmt.Errorf("duplicate TWAP order id (%d)", order.Id)
+		}
+		orderIds[order.Id] = true
+	}
 	return nil
 }
@@ -111,6 +111,10 @@ type GenesisState struct {
 	TakerFeesTracker       *TakerFeesTracker   `protobuf:"bytes,4,opt,name=taker_fees_tracker,json=takerFeesTracker,proto3" json:"taker_fees_tracker,omitempty"`
 	PoolVolumes            []*PoolVolume       `protobuf:"bytes,5,rep,name=pool_volumes,json=poolVolumes,proto3" json:"pool_volumes,omitempty"`
 	DenomPairTakerFeeStore []DenomPairTakerFee `protobuf:"bytes,6,rep,name=denom_pair_taker_fee_store,json=denomPairTakerFeeStore,proto3" json:"denom_pair_taker_fee_store"`
+	// twap_orders are the TWAP orders not yet completed or cancelled.
+	TwapOrders []TwapOrder `protobuf:"bytes,7,rep,name=twap_orders,json=twapOrders,proto3" json:"twap_orders"`
+	// next_twap_order_id is the id of the next TWAP order created.
+	NextTwapOrderId uint64 `protobuf:"varint,8,opt,name=next_twap_order_id,json=nextTwapOrderId,proto3" json:"next_twap_order_id,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -188,6 +192,20 @@ func (m *GenesisState) GetDenomPairTakerFeeStore() []DenomPairTakerFee {
 	return nil
 }
 
+func (m *GenesisState) GetTwapOrders() []TwapOrder {
+	if m != nil {
+		return m.TwapOrders
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextTwapOrderId() uint64 {
+	if m != nil {
+		return m.NextTwapOrderId
+	}
+	return 0
+}
+
 // TakerFeeParams consolidates the taker fee parameters for the poolmanager.
 type TakerFeeParams struct {
 	// default_taker_fee is the fee used when creating a new pool that doesn't
@@ -227,6 +245,11 @@ type TakerFeeParams struct {
 	// Initially, the taker fee is allowed to be bypassed completely. However
 	// In the future, we will charge a reduced taker fee instead of no fee at all.
 	ReducedFeeWhitelist []string `protobuf:"bytes,6,rep,name=reduced_fee_whitelist,json=reducedFeeWhitelist,proto3" json:"reduced_fee_whitelist,omitempty" yaml:"reduced_fee_whitelist"`
+	// custom_taker_fee_pool_ids is the list of the ids of the pools, approved by
+	// governance, that are trusted to charge the taker fee themselves.
+	// poolmanager does not charge the taker fee on swaps through these pools
+	// if they also declare that they charge it themselves.
+	CustomTakerFeePoolIds []uint64 `protobuf:"varint,7,rep,packed,name=custom_taker_fee_pool_ids,json=customTakerFeePoolIds,proto3" json:"custom_taker_fee_pool_ids,omitempty" yaml:"custom_taker_fee_pool_ids"`
 }
 
 func (m *TakerFeeParams) Reset()         { *m = TakerFeeParams{} }
@@ -297,6 +320,13 @@ func (m *TakerFeeParams) GetReducedFeeWhitelist() []string {
 	return nil
 }
 
+func (m *TakerFeeParams) GetCustomTakerFeePoolIds() []uint64 {
+	if m != nil {
+		return m.CustomTakerFeePoolIds
+	}
+	return nil
+}
+
 // TakerFeeDistributionPercentage defines what percent of the taker fee category
 // gets distributed to the available categories.
 type TakerFeeDistributionPercentage struct {
@@ -467,75 +497,81 @@ func init() {
 }
 
 var fileDescriptor_aa099d9fbdf68b35 = []byte{
-	// 1082 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x4d, 0x6f, 0xdc, 0xc4,
-	0x1b, 0x8f, 0xb3, 0xe9, 0xfe, 0x95, 0x49, 0xfe, 0x49, 0x3a, 0x90, 0xc6, 0x4d, 0xca, 0x7a, 0xe5,
-	0x56, 0x62, 0x11, 0x8a, 0x97, 0x06, 0x54, 0x24, 0xa0, 0x87, 0x6c, 0xa2, 0x20, 0x50, 0x69, 0x53,
-	0x27, 0x02, 0xa9, 0x1c, 0x46, 0xb3, 0xf6, 0x13, 0xaf, 0xb5, 0xb6, 0x67, 0x99, 0x19, 0xe7, 0x85,
-	0xaf, 0xc0, 0x05, 0xa9, 0x57, 0xce, 0x1c, 0xb8, 0x21, 0xf1, 0x21, 0x7a, 0xec, 0x11, 0x71, 0xd8,
-	0xa2, 0xe4, 0xcc, 0x65, 0x3f, 0x01, 0xf2, 0xcc, 0xec, 0x6b, 0x93, 0x6d, 0x80, 0xd3, 0xae, 0x9f,
-	0xe7, 0xf9, 0xfd, 0xfc, 0x7b, 0xde, 0x66, 0x8c, 0xde, 0x63, 0x22, 0x65, 0x22, 0x16, 0xf5, 0x0e,
-	0x63, 0x49, 0x4a, 0x33, 0x1a, 0x01, 0xaf, 0x1f, 0xdf, 0x6f, 0x82, 0xa4, 0xf7, 0xeb, 0x11, 0x64,
-	0x20, 0x62, 0xe1, 0x75, 0x38, 0x93, 0x0c, 0x6f, 0x98, 0x50, 0x6f, 0x24, 0xd4, 0x33, 0xa1, 0xeb,
-	0x6f, 0x47, 0x2c, 0x62, 0x2a, 0xae, 0x5e, 0xfc, 0xd3, 0x90, 0xf5, 0xdb, 0x11, 0x63, 0x51, 0x02,
-	0x75, 0xf5, 0xd4, 0xcc, 0x8f, 0xea, 0x34, 0x3b, 0xeb, 0xbb, 0x02, 0x45, 0x47, 0x34, 0x46, 0x3f,
-	0x18, 0x57, 0x65, 0x12, 0x15, 0xe6, 0x9c, 0xca, 0x98, 0x65, 0x7d, 0xbf, 0x8e, 0xae, 0x37, 0xa9,
-	0x80, 0x81, 0xd6, 0x80, 0xc5, 0x7d, 0xbf, 0x37, 0x2d, 0xa7, 0x94, 0x85, 0x79, 0x02, 0x84, 0xb3,
-	0x5c, 0x82, 0x89, 0xbf, 0x37, 0x2d, 0x5e, 0x9e, 0xea, 0x28, 0xb7, 0x37, 0x8b, 0xca, 0xfb, 0x94,
-	0xd3, 0x54, 0xe0, 0xe7, 0x16, 0xba, 0x59, 0xc4, 0x92, 0x80, 0x83, 0x12, 0x46, 0x8e, 0x00, 0x6c,
-	0xab, 0x5a, 0xaa, 0x2d, 0x6c, 0xdd, 0xf6, 0x4c, 0x2e, 0x85, 0xba, 0x7e, 0x79, 0xbc, 0x1d, 0x16,
-	0x67, 0x8d, 0x47, 0x2f, 0xba, 0xce, 0x4c, 0xaf, 0xeb, 0xd8, 0x67, 0x34, 0x4d, 0x3e, 0x71, 0x5f,
-	0x63, 0x70, 0x7f, 0x79, 0xe5, 0xd4, 0xa2, 0x58, 0xb6, 0xf2, 0xa6, 0x17, 0xb0, 0xd4, 0x14, 0xc5,
-	0xfc, 0x6c, 0x8a, 0xb0, 0x5d, 0x97, 0x67, 0x1d, 0x10, 0x8a, 0x4c, 0xf8, 0xcb, 0x05, 0x7e, 0xc7,
-	0xc0, 0xf7, 0x00, 0xf0, 0x31, 0x5a, 0x91, 0xb4, 0x0d, 0xbc, 0xa0, 0x22, 0x1d, 0xa5, 0xd4, 0x9e,
-	0xad, 0x5a, 0xb5, 0x85, 0xad, 0xf7, 0xbd, 0x29, 0xad, 0xf3, 0x0e, 0x0b, 0xd0, 0x1e, 0x80, 0x4e,
-	0xae, 0xe1, 0x18, 0x95, 0x6b, 0x5a, 0xe5, 0x24, 0xa5, 0xeb, 0x2f, 0xc9, 0x31, 0x00, 0x7e, 0x86,
-	0xd6, 0x68, 0x2e, 0x5b, 0x8c, 0xc7, 0xdf, 0x43, 0x48, 0xbe, 0xcb, 0x99, 0x04, 0x12, 0x42, 0xc6,
-	0x52, 0x61, 0x97, 0xaa, 0xa5, 0xda, 0x7c, 0xc3, 0xed, 0x75, 0x9d, 0x8a, 0x66, 0xbb, 0x22, 0xd0,
-	0xf5, 0x57, 0x87, 0x9e, 0xa7, 0x85, 0x63, 0x57, 0xdb, 0x5f, 0x95, 0xd0, 0xe2, 0xe7, 0x7a, 0x0a,
-	0x0f, 0x24, 0x95, 0x80, 0xab, 0x68, 0x31, 0x83, 0x53, 0x49, 0x54, 0xf1, 0xe2, 0xd0, 0xb6, 0xaa,
-	0x56, 0x6d, 0xce, 0x47, 0x85, 0x6d, 0x9f, 0xb1, 0xe4, 0x8b, 0x10, 0x6f, 0xa3, 0xf2, 0x58, 0xf2,
-	0x77, 0xa7, 0x26, 0x6f, 0x92, 0x9e, 0x2b, 0x92, 0xf6, 0x0d, 0x10, 0x3f, 0x41, 0x0b, 0x8a, 0x5f,
-	0x0d, 0x89, 0xce, 0x62, 0x61, 0xab, 0x36, 0x95, 0xe7, 0x2b, 0x35, 0x56, 0x7e, 0x01, 0x30, 0x64,
-	0xa8, 0x08, 0x53, 0x06, 0x81, 0xbf, 0x45, 0x78, 0x50, 0x47, 0x41, 0x24, 0xa7, 0x41, 0x1b, 0xb8,
-	0x3d, 0xa7, 0xf4, 0x6d, 0x5e, 0xab, 0x39, 0xe2, 0x50, 0x83, 0xfc, 0x15, 0x39, 0x61, 0xc1, 0x5f,
-	0xa2, 0x45, 0xa5, 0xf6, 0x98, 0x25, 0x79, 0x0a, 0xc2, 0xbe, 0xa1, 0xe4, 0xbe, 0x3b, 0x3d, 0x6d,
-	0xc6, 0x92, 0xaf, 0x55, 0xbc, 0xaf, 0x52, 0xd5, 0xff, 0x05, 0xee, 0xa0, 0x75, 0xd5, 0x11, 0xd2,
-	0xa1, 0x31, 0x27, 0xc3, 0xde, 0x0b, 0xc9, 0x38, 0xd8, 0x65, 0xc5, 0xec, 0x4d, 0x65, 0x56, 0x8d,
-	0xdb, 0xa7, 0x31, 0xef, 0x2b, 0x37, 0xe5, 0xb8, 0x15, 0x4e, 0x3a, 0x0e, 0x0a, 0x4e, 0xf7, 0x87,
-	0x32, 0x5a, 0x1a, 0x9f, 0x40, 0xdc, 0x44, 0x37, 0x43, 0x38, 0xa2, 0x79, 0x22, 0x87, 0x0a, 0x54,
-	0xa3, 0xe7, 0x1b, 0x0f, 0x0a, 0xae, 0x3f, 0xba, 0xce, 0x86, 0x5e, 0x0a, 0x11, 0xb6, 0xbd, 0x98,
-	0xd5, 0x53, 0x2a, 0x5b, 0xde, 0x23, 0x88, 0x68, 0x70, 0xb6, 0x0b, 0xc1, 0x79, 0xd7, 0x59, 0xde,
-	0xd5, 0xf8, 0x3e, 0xb1, 0xbf, 0x1c, 0x8e, 0x1b, 0xf0, 0x4f, 0x16, 0x52, 0xe7, 0xd9, 0x48, 0x8e,
-	0x61, 0x2c, 0x24, 0x8f, 0x9b, 0x79, 0xb1, 0x4f, 0x66, 0x76, 0x3e, 0xbd, 0x56, 0x6f, 0x76, 0x47,
-	0x80, 0xfb, 0xc0, 0x03, 0xc8, 0x24, 0x8d, 0xa0, 0x51, 0x2d, 0xb4, 0x9e, 0x77, 0x1d, 0xfb, 0x89,
-	0x48, 0xd9, 0x65, 0xb1, 0xbe, 0xcd, 0xae, 0xf0, 0xe0, 0x9f, 0x2d, 0xe4, 0x64, 0x2c, 0x23, 0xd3,
-	0x24, 0x96, 0xfe, 0xbb, 0xc4, 0xbb, 0x46, 0xe2, 0xc6, 0x63, 0x96, 0x5d, 0xa9, 0x72, 0x23, 0xbb,
-	0xda, 0x89, 0x77, 0xd0, 0x32, 0x0d, 0xd3, 0x38, 0x23, 0x34, 0x0c, 0x39, 0x08, 0x01, 0xc2, 0x9e,
-	0x53, 0x4b, 0xbf, 0xde, 0xeb, 0x3a, 0xb7, 0xcc, 0xd2, 0x8f, 0x07, 0xb8, 0xfe, 0x92, 0xb2, 0x6c,
-	0xf7, 0x0d, 0xf8, 0x57, 0x0b, 0x3d, 0x08, 0x58, 0x9a, 0xe6, 0x59, 0x2c, 0xcf, 0xf4, 0x6a, 0xeb,
-	0x29, 0x94, 0x8c, 0x88, 0x13, 0xda, 0x21, 0x45, 0x29, 0x4e, 0x5a, 0xb1, 0x84, 0x24, 0x16, 0x12,
-	0x42, 0x42, 0x85, 0x00, 0x29, 0x88, 0x64, 0xf6, 0x0d, 0x35, 0x16, 0xdb, 0xbd, 0xae, 0xf3, 0x50,
-	0xbf, 0xec, 0xdf, 0xf1, 0xb8, 0xbe, 0x37, 0x00, 0x16, 0xbb, 0xa1, 0xa6, 0xf8, 0x90, 0x1d, 0x9c,
-	0xd0, 0xce, 0x63, 0x96, 0x7d, 0x33, 0x84, 0x6c, 0x2b, 0xc4, 0x21, 0xc3, 0x87, 0x68, 0x95, 0x43,
-	0x98, 0x07, 0x10, 0xaa, 0xce, 0x0c, 0x58, 0xd5, 0x92, 0xcc, 0x37, 0xaa, 0xbd, 0xae, 0x73, 0x47,
-	0x2b, 0xba, 0x34, 0xcc, 0xf5, 0xdf, 0x32, 0xf6, 0x3d, 0x80, 0x01, 0xbf, 0xfb, 0x97, 0x85, 0x2a,
-	0xd3, 0x7b, 0x86, 0x8f, 0xd0, 0xb2, 0x90, 0xb4, 0x1d, 0x67, 0x11, 0xe1, 0x70, 0x42, 0x79, 0x28,
-	0xcc, 0x6e, 0x3c, 0xbc, 0xc6, 0x6e, 0x0c, 0x9b, 0x32, 0xc1, 0xe1, 0xfa, 0x4b, 0xc6, 0xe2, 0x6b,
-	0x03, 0x0e, 0xd0, 0xd2, 0x78, 0x2d, 0xd5, 0x4e, 0xcc, 0x37, 0x3e, 0xbb, 0xde, 0x6b, 0x56, 0x2f,
-	0x6b, 0x87, 0xeb, 0xff, 0x7f, 0xac, 0xcc, 0xee, 0x6f, 0xb3, 0x68, 0x65, 0xf2, 0x88, 0xc3, 0x3e,
-	0x5a, 0x1d, 0x3d, 0x2d, 0x19, 0x11, 0xea, 0x51, 0xbc, 0xf9, 0x86, 0xd5, 0x47, 0x0d, 0x1e, 0x1e,
-	0x91, 0xec, 0x40, 0x43, 0x31, 0x41, 0x77, 0xc6, 0x39, 0x5f, 0xcb, 0xed, 0x5a, 0xd4, 0xf6, 0x08,
-	0xf5, 0xce, 0x68, 0x26, 0xb8, 0x8d, 0xde, 0x69, 0x41, 0x1c, 0xb5, 0x24, 0xa1, 0x41, 0xc0, 0xf2,
-	0x4c, 0x16, 0xc5, 0x15, 0x92, 0x72, 0x29, 0xc8, 0x11, 0x67, 0xa9, 0x5a, 0xd7, 0x52, 0xa3, 0xd6,
-	0xeb, 0x3a, 0xf7, 0x74, 0x69, 0xa6, 0x86, 0xbb, 0xfe, 0xba, 0xf6, 0x6f, 0x0f, 0xdc, 0x07, 0xca,
-	0xbb, 0x57, 0x38, 0x9f, 0x5b, 0x08, 0x0d, 0x8f, 0x70, 0xbc, 0x86, 0xfe, 0x37, 0x7e, 0x1f, 0x96,
-	0x3b, 0xfa, 0x2e, 0x4c, 0xcc, 0x45, 0xa6, 0xaf, 0x86, 0x37, 0x27, 0xf9, 0x41, 0x91, 0xe4, 0x3f,
-	0xfa, 0x0a, 0x41, 0xc3, 0xdb, 0xa3, 0xf1, 0xf4, 0xc5, 0x79, 0xc5, 0x7a, 0x79, 0x5e, 0xb1, 0xfe,
-	0x3c, 0xaf, 0x58, 0x3f, 0x5e, 0x54, 0x66, 0x5e, 0x5e, 0x54, 0x66, 0x7e, 0xbf, 0xa8, 0xcc, 0x3c,
-	0xfb, 0x78, 0x84, 0xcf, 0x1c, 0x57, 0x9b, 0x09, 0x6d, 0x8a, 0xfe, 0x43, 0xfd, 0x78, 0xeb, 0xa3,
-	0xfa, 0xe9, 0xd8, 0xf7, 0x97, 0x7a, 0x49, 0xb3, 0xac, 0xbe, 0xbd, 0x3e, 0xfc, 0x3b, 0x00, 0x00,
-	0xff, 0xff, 0x6a, 0xf7, 0x09, 0x5d, 0xa7, 0x0a, 0x00, 0x00,
+	// 1169 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0x26, 0xae, 0x4b, 0xc6, 0x21, 0x4e, 0x07, 0xd2, 0x6c, 0x92, 0xe2, 0xb5, 0xb6, 0x11,
+	0x18, 0x95, 0xac, 0x69, 0x40, 0x45, 0x02, 0x7a, 0x88, 0x13, 0x05, 0x15, 0xb5, 0x4d, 0xba, 0x89,
+	0x40, 0x2a, 0x12, 0xa3, 0xf1, 0xee, 0xc4, 0x5e, 0xd9, 0xbb, 0x63, 0x66, 0x66, 0xf3, 0x87, 0x4f,
+	0x81, 0xd4, 0x2b, 0x67, 0x0e, 0xdc, 0x90, 0xf8, 0x10, 0x3d, 0xf6, 0x88, 0x40, 0x32, 0x28, 0x39,
+	0x22, 0x2e, 0xfe, 0x04, 0x68, 0xfe, 0xf8, 0x6f, 0x93, 0x6d, 0xa0, 0x27, 0x7b, 0xdf, 0xfb, 0xfd,
+	0x7e, 0xfb, 0xde, 0x9b, 0xf7, 0xde, 0x2c, 0x78, 0x9f, 0xf2, 0x98, 0xf2, 0x88, 0x57, 0x3b, 0x94,
+	0xb6, 0x63, 0x9c, 0xe0, 0x06, 0x61, 0xd5, 0xa3, 0xbb, 0x75, 0x22, 0xf0, 0xdd, 0x6a, 0x83, 0x24,
+	0x84, 0x47, 0xdc, 0xeb, 0x30, 0x2a, 0x28, 0x5c, 0x35, 0x50, 0x6f, 0x04, 0xea, 0x19, 0xe8, 0xca,
+	0xdb, 0x0d, 0xda, 0xa0, 0x0a, 0x57, 0x95, 0xff, 0x34, 0x65, 0x65, 0xb9, 0x41, 0x69, 0xa3, 0x4d,
+	0xaa, 0xea, 0xa9, 0x9e, 0x1e, 0x56, 0x71, 0x72, 0xda, 0x77, 0x05, 0x4a, 0x0e, 0x69, 0x8e, 0x7e,
+	0x30, 0xae, 0xd2, 0x24, 0x2b, 0x4c, 0x19, 0x16, 0x11, 0x4d, 0xfa, 0x7e, 0x8d, 0xae, 0xd6, 0x31,
+	0x27, 0x83, 0x58, 0x03, 0x1a, 0xf5, 0xfd, 0x5e, 0x56, 0x4e, 0x31, 0x0d, 0xd3, 0x36, 0x41, 0x8c,
+	0xa6, 0x82, 0x18, 0xfc, 0x5a, 0x16, 0x5e, 0x9c, 0x18, 0xd4, 0x07, 0x99, 0xa8, 0x63, 0xdc, 0x41,
+	0x94, 0x85, 0x84, 0x69, 0xb4, 0xdb, 0x9b, 0x06, 0xf9, 0x3d, 0xcc, 0x70, 0xcc, 0xe1, 0x33, 0x0b,
+	0xdc, 0x90, 0x1c, 0x14, 0x30, 0xa2, 0xd2, 0x40, 0x87, 0x84, 0xd8, 0x56, 0x79, 0xa6, 0x52, 0xd8,
+	0x58, 0xf6, 0x4c, 0xe6, 0x32, 0x97, 0x7e, 0x31, 0xbd, 0x2d, 0x1a, 0x25, 0xb5, 0x87, 0xcf, 0xbb,
+	0xce, 0x54, 0xaf, 0xeb, 0xd8, 0xa7, 0x38, 0x6e, 0x7f, 0xea, 0xbe, 0xa4, 0xe0, 0xfe, 0xfc, 0xa7,
+	0x53, 0x69, 0x44, 0xa2, 0x99, 0xd6, 0xbd, 0x80, 0xc6, 0xa6, 0x84, 0xe6, 0x67, 0x9d, 0x87, 0xad,
+	0xaa, 0x38, 0xed, 0x10, 0xae, 0xc4, 0xb8, 0x5f, 0x94, 0xfc, 0x2d, 0x43, 0xdf, 0x21, 0x04, 0x1e,
+	0x81, 0x05, 0x81, 0x5b, 0x84, 0x49, 0x29, 0xd4, 0x51, 0x91, 0xda, 0xd3, 0x65, 0xab, 0x52, 0xd8,
+	0xb8, 0xe3, 0x65, 0x1c, 0xb4, 0x77, 0x20, 0x49, 0x3b, 0x84, 0xe8, 0xe4, 0x6a, 0x8e, 0x89, 0x72,
+	0x49, 0x47, 0x39, 0x29, 0xe9, 0xfa, 0xf3, 0x62, 0x8c, 0x00, 0x9f, 0x82, 0x25, 0x9c, 0x8a, 0x26,
+	0x65, 0xd1, 0xf7, 0x24, 0x44, 0xdf, 0xa5, 0x54, 0x10, 0x14, 0x92, 0x84, 0xc6, 0xdc, 0x9e, 0x29,
+	0xcf, 0x54, 0x66, 0x6b, 0x6e, 0xaf, 0xeb, 0x94, 0xb4, 0xda, 0x25, 0x40, 0xd7, 0x5f, 0x1c, 0x7a,
+	0x9e, 0x48, 0xc7, 0xb6, 0xb6, 0xff, 0x91, 0x03, 0x73, 0x5f, 0xe8, 0x9e, 0xdd, 0x17, 0x58, 0x10,
+	0x58, 0x06, 0x73, 0x09, 0x39, 0x11, 0x48, 0x15, 0x2f, 0x0a, 0x6d, 0xab, 0x6c, 0x55, 0x72, 0x3e,
+	0x90, 0xb6, 0x3d, 0x4a, 0xdb, 0x0f, 0x42, 0xb8, 0x09, 0xf2, 0x63, 0xc9, 0xdf, 0xce, 0x4c, 0xde,
+	0x24, 0x9d, 0x93, 0x49, 0xfb, 0x86, 0x08, 0x77, 0x41, 0x41, 0xe9, 0xab, 0x96, 0xd2, 0x59, 0x14,
+	0x36, 0x2a, 0x99, 0x3a, 0x8f, 0x54, 0x13, 0xfa, 0x92, 0x60, 0xc4, 0x80, 0x84, 0x29, 0x03, 0x87,
+	0xdf, 0x00, 0x38, 0xa8, 0x23, 0x47, 0x82, 0xe1, 0xa0, 0x45, 0x98, 0x9d, 0x53, 0xf1, 0xad, 0x5f,
+	0xe9, 0x70, 0xf8, 0x81, 0x26, 0xf9, 0x0b, 0x62, 0xc2, 0x02, 0xbf, 0x04, 0x73, 0x2a, 0xda, 0x23,
+	0xda, 0x4e, 0x63, 0xc2, 0xed, 0x6b, 0x2a, 0xdc, 0xf7, 0xb2, 0xd3, 0xa6, 0xb4, 0xfd, 0x95, 0xc2,
+	0xfb, 0x85, 0xce, 0xe0, 0x3f, 0x87, 0x1d, 0xb0, 0xa2, 0x4e, 0x04, 0x75, 0x70, 0xc4, 0xd0, 0xf0,
+	0xec, 0xb9, 0xa0, 0x8c, 0xd8, 0x79, 0xa5, 0xec, 0x65, 0x2a, 0xab, 0x83, 0xdb, 0xc3, 0x11, 0xeb,
+	0x47, 0x6e, 0xca, 0x71, 0x33, 0x9c, 0x74, 0xec, 0x4b, 0x4d, 0xf8, 0x08, 0x14, 0x86, 0xa3, 0xc6,
+	0xed, 0xeb, 0xea, 0x15, 0xef, 0x66, 0xd7, 0xe4, 0x18, 0x77, 0x76, 0x25, 0xbc, 0x5f, 0x69, 0xd1,
+	0x37, 0x70, 0x78, 0x07, 0x40, 0xd5, 0x1f, 0x43, 0x4d, 0xd9, 0x25, 0x6f, 0xa8, 0x2e, 0x29, 0x4a,
+	0xcf, 0x80, 0xfc, 0x20, 0x74, 0xff, 0xce, 0x83, 0xf9, 0xf1, 0xee, 0x87, 0x75, 0x70, 0x23, 0x24,
+	0x87, 0x38, 0x6d, 0x8b, 0x61, 0xf6, 0xaa, 0xc9, 0x66, 0x6b, 0xf7, 0xe4, 0xcb, 0x7e, 0xef, 0x3a,
+	0xab, 0x7a, 0x20, 0x79, 0xd8, 0xf2, 0x22, 0x5a, 0x8d, 0xb1, 0x68, 0x7a, 0x0f, 0x49, 0x03, 0x07,
+	0xa7, 0xdb, 0x24, 0x38, 0xeb, 0x3a, 0xc5, 0x6d, 0xcd, 0xef, 0x0b, 0xfb, 0xc5, 0x70, 0xdc, 0x00,
+	0x7f, 0xb4, 0x80, 0xda, 0xbc, 0x23, 0xf5, 0x0d, 0x23, 0x2e, 0x58, 0x54, 0x4f, 0xe5, 0x2c, 0x9b,
+	0xbe, 0xfd, 0xec, 0x4a, 0x7d, 0xb1, 0x3d, 0x42, 0xdc, 0x23, 0x2c, 0x20, 0x89, 0xc0, 0x0d, 0x52,
+	0x2b, 0xcb, 0x58, 0xcf, 0xba, 0x8e, 0xbd, 0xcb, 0x63, 0x7a, 0x11, 0xd6, 0xb7, 0xe9, 0x25, 0x1e,
+	0xf8, 0x93, 0x05, 0x9c, 0x84, 0x26, 0x28, 0x2b, 0xc4, 0x99, 0xd7, 0x0f, 0xf1, 0xb6, 0x09, 0x71,
+	0xf5, 0x31, 0x4d, 0x2e, 0x8d, 0x72, 0x35, 0xb9, 0xdc, 0x09, 0xb7, 0x40, 0x11, 0x87, 0x71, 0x94,
+	0x20, 0x1c, 0x86, 0x8c, 0x70, 0x4e, 0xb8, 0x9d, 0x53, 0x0b, 0x67, 0xa5, 0xd7, 0x75, 0x6e, 0x9a,
+	0x85, 0x33, 0x0e, 0x70, 0xfd, 0x79, 0x65, 0xd9, 0xec, 0x1b, 0xe0, 0x2f, 0x16, 0xb8, 0x17, 0xd0,
+	0x38, 0x4e, 0x93, 0x48, 0x9c, 0xea, 0xb5, 0xa2, 0x27, 0x40, 0x50, 0xc4, 0x65, 0x13, 0xc9, 0x52,
+	0x1c, 0x37, 0x23, 0x41, 0xda, 0x11, 0x17, 0x24, 0x44, 0x98, 0x73, 0x22, 0x38, 0x12, 0xd4, 0xbe,
+	0xa6, 0xda, 0x62, 0xb3, 0xd7, 0x75, 0xee, 0xeb, 0x97, 0xfd, 0x3f, 0x1d, 0xd7, 0xf7, 0x06, 0x44,
+	0x39, 0x97, 0x6a, 0x82, 0x0e, 0xe8, 0xfe, 0x31, 0xee, 0x3c, 0xa6, 0xc9, 0xd7, 0x43, 0xca, 0xa6,
+	0x62, 0x1c, 0x50, 0x78, 0x00, 0x16, 0x19, 0x09, 0xd3, 0x80, 0x84, 0xea, 0x64, 0x06, 0xaa, 0x6a,
+	0x40, 0x67, 0x6b, 0xe5, 0x5e, 0xd7, 0xb9, 0xa5, 0x23, 0xba, 0x10, 0xe6, 0xfa, 0x6f, 0x19, 0xfb,
+	0x0e, 0x21, 0x03, 0x7d, 0xf8, 0x2d, 0x58, 0x0e, 0x52, 0x2e, 0x64, 0xc8, 0xc3, 0x9d, 0xaf, 0xd7,
+	0xac, 0x9e, 0xcb, 0x5c, 0x6d, 0xad, 0xd7, 0x75, 0xca, 0x26, 0xd7, 0xcb, 0xa0, 0xae, 0xbf, 0xa8,
+	0x7d, 0x83, 0xc9, 0x52, 0x7b, 0x99, 0xbb, 0xff, 0x58, 0xa0, 0x94, 0xdd, 0x13, 0xf0, 0x10, 0x14,
+	0xb9, 0xc0, 0xad, 0x28, 0x69, 0x20, 0x46, 0x8e, 0x31, 0x0b, 0xb9, 0x99, 0xbd, 0xfb, 0x57, 0x98,
+	0xbd, 0xe1, 0xa1, 0x4f, 0x68, 0xb8, 0xfe, 0xbc, 0xb1, 0xf8, 0xda, 0x00, 0x03, 0x30, 0x3f, 0x7e,
+	0x56, 0x6a, 0xe6, 0x66, 0x6b, 0x9f, 0x5f, 0xed, 0x35, 0x8b, 0x17, 0x1d, 0xb7, 0xeb, 0xbf, 0x39,
+	0x76, 0x8c, 0xee, 0xaf, 0xd3, 0x60, 0x61, 0x72, 0x7d, 0x43, 0x1f, 0x2c, 0x8e, 0xde, 0x04, 0x14,
+	0x71, 0xf5, 0xc8, 0x5f, 0xfd, 0xf5, 0xa0, 0x77, 0x1d, 0x1c, 0xae, 0x7f, 0xba, 0xaf, 0xa9, 0x10,
+	0x81, 0x5b, 0xe3, 0x9a, 0x2f, 0xe5, 0x76, 0x25, 0x69, 0x7b, 0x44, 0x7a, 0x6b, 0x34, 0x13, 0xd8,
+	0x02, 0xef, 0x34, 0x49, 0xd4, 0x68, 0x0a, 0x84, 0x83, 0x80, 0xa6, 0x89, 0x90, 0xc5, 0xe5, 0x02,
+	0x33, 0xc1, 0xd1, 0x21, 0xa3, 0xb1, 0x5a, 0x07, 0x33, 0xb5, 0x4a, 0xaf, 0xeb, 0xac, 0xe9, 0xd2,
+	0x64, 0xc2, 0x5d, 0x7f, 0x45, 0xfb, 0x37, 0x07, 0xee, 0x7d, 0xe5, 0xdd, 0x91, 0xce, 0x67, 0x16,
+	0x00, 0xc3, 0xeb, 0x09, 0x2e, 0x81, 0xeb, 0xe3, 0x77, 0x7d, 0xbe, 0xa3, 0xef, 0xf9, 0x36, 0x28,
+	0x8c, 0x5c, 0x7b, 0xaf, 0x4e, 0xf2, 0x43, 0x99, 0xe4, 0x7f, 0xfa, 0xc2, 0x02, 0xc3, 0x9b, 0xb1,
+	0xf6, 0xe4, 0xe9, 0x27, 0x23, 0x3c, 0xb3, 0xf6, 0xd6, 0xdb, 0xb8, 0xce, 0xfb, 0x0f, 0xd5, 0xa3,
+	0x8d, 0x8f, 0xab, 0x27, 0x63, 0xdf, 0x92, 0x4a, 0xec, 0xf9, 0x59, 0xc9, 0x7a, 0x71, 0x56, 0xb2,
+	0xfe, 0x3a, 0x2b, 0x59, 0x3f, 0x9c, 0x97, 0xa6, 0x5e, 0x9c, 0x97, 0xa6, 0x7e, 0x3b, 0x2f, 0x4d,
+	0xd5, 0xf3, 0xea, 0xbb, 0xf2, 0xa3, 0x7f, 0x07, 0x00, 0xf0, 0xd1, 0x70, 0x4e, 0xb1, 0x0b, 0x00,
+	0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -614,6 +650,25 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.NextTwapOrderId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextTwapOrderId))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.TwapOrders) > 0 {
+		for iNdEx := len(m.TwapOrders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TwapOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if len(m.DenomPairTakerFeeStore) > 0 {
 		for iNdEx := len(m.DenomPairTakerFeeStore) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -706,6 +761,24 @@ func (m *TakerFeeParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.CustomTakerFeePoolIds) > 0 {
+		dAtA2 := make([]byte, len(m.CustomTakerFeePoolIds)*10)
+		var j1 int
+		for _, num := range m.CustomTakerFeePoolIds {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintGenesis(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x3a
+	}
 	if len(m.ReducedFeeWhitelist) > 0 {
 		for iNdEx := len(m.ReducedFeeWhitelist) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.ReducedFeeWhitelist[iNdEx])
@@ -972,6 +1045,15 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.TwapOrders) > 0 {
+		for _, e := range m.TwapOrders {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextTwapOrderId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextTwapOrderId))
+	}
 	return n
 }
 
@@ -1003,6 +1085,13 @@ func (m *TakerFeeParams) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.CustomTakerFeePoolIds) > 0 {
+		l = 0
+		for _, e := range m.CustomTakerFeePoolIds {
+			l += sovGenesis(uint64(e))
+		}
+		n += 1 + sovGenesis(uint64(l)) + l
+	}
 	return n
 }
 
@@ -1435,6 +1524,59 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TwapOrders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TwapOrders = append(m.TwapOrders, TwapOrder{})
+			if err := m.TwapOrders[len(m.TwapOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextTwapOrderId", wireType)
+			}
+			m.NextTwapOrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextTwapOrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1681,6 +1823,82 @@ func (m *TakerFeeParams) Unmarshal(dAtA []byte) error {
 			}
 			m.ReducedFeeWhitelist = append(m.ReducedFeeWhitelist, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 7:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.CustomTakerFeePoolIds = append(m.CustomTakerFeePoolIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.CustomTakerFeePoolIds) == 0 {
+					m.CustomTakerFeePoolIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.CustomTakerFeePoolIds = append(m.CustomTakerFeePoolIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field CustomTakerFeePoolIds", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
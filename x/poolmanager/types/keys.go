@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/gogoproto/proto"
@@ -48,6 +49,15 @@ var (
 
 	// KeyTakerFeeCommunityPoolProtoRevArray defines key to store the taker fee for community pool tracker coin array.
 	KeyTakerFeeCommunityPoolProtoRevArray = []byte{0x09}
+
+	// KeyNextTwapOrderId defines key to store the next TWAP order id to be used.
+	KeyNextTwapOrderId = []byte{0x0A}
+
+	// KeyTwapOrderPrefix defines prefix to store TWAP orders by id.
+	KeyTwapOrderPrefix = []byte{0x0B}
+
+	// KeyTwapOrderQueuePrefix defines prefix to store the ids of TWAP orders by the time of their next slice.
+	KeyTwapOrderQueuePrefix = []byte{0x0C}
 )
 
 // ModuleRouteToBytes serializes moduleRoute to bytes.
@@ -92,6 +102,22 @@ func KeyPoolVolume(poolId uint64) []byte {
 	return []byte(fmt.Sprintf("%s%s%d%s", KeyPoolVolumePrefix, KeySeparator, poolId, KeySeparator))
 }
 
+// FormatTwapOrderKey returns the key for the TWAP order with the given id.
+func FormatTwapOrderKey(orderId uint64) []byte {
+	return append(append([]byte{}, KeyTwapOrderPrefix...), sdk.Uint64ToBigEndian(orderId)...)
+}
+
+// FormatTwapOrderQueueTimePrefix returns the prefix of the keys of the TWAP orders whose next slice is at the given time.
+func FormatTwapOrderQueueTimePrefix(executionTime time.Time) []byte {
+	return append(append([]byte{}, KeyTwapOrderQueuePrefix...), sdk.FormatTimeBytes(executionTime)...)
+}
+
+// FormatTwapOrderQueueKey returns the key for the TWAP order with the given id in the queue of orders
+// ordered by the time of their next slice.
+func FormatTwapOrderQueueKey(executionTime time.Time, orderId uint64) []byte {
+	return append(FormatTwapOrderQueueTimePrefix(executionTime), sdk.Uint64ToBigEndian(orderId)...)
+}
+
 // ParseDenomTradePairKey parses the raw bytes of the DenomTradePairKey into a denom trade pair.
 func ParseDenomTradePairKey(key []byte) (denom0, denom1 string, err error) {
 	keyStr := string(key)
@@ -40,6 +40,7 @@ type SwapAmountOutSplitRouteWrapper struct {
 var (
 	_ SwapMsgRoute = MsgSwapExactAmountIn{}
 	_ SwapMsgRoute = MsgSwapExactAmountOut{}
+	_ SwapMsgRoute = MsgSwapExactAmountInWithPriceLimit{}
 	_ SwapMsgRoute = SwapAmountInSplitRouteWrapper{}
 	_ SwapMsgRoute = SwapAmountOutSplitRouteWrapper{}
 )
@@ -108,3 +109,21 @@ func (msg MsgSwapExactAmountIn) TokenDenomsOnPath() []string {
 	}
 	return denoms
 }
+
+func (msg MsgSwapExactAmountInWithPriceLimit) TokenInDenom() string {
+	return msg.TokenIn.Denom
+}
+
+func (msg MsgSwapExactAmountInWithPriceLimit) TokenOutDenom() string {
+	lastRouteIndex := len(msg.Routes) - 1
+	return msg.Routes[lastRouteIndex].GetTokenOutDenom()
+}
+
+func (msg MsgSwapExactAmountInWithPriceLimit) TokenDenomsOnPath() []string {
+	denoms := make([]string, 0, len(msg.Routes)+1)
+	denoms = append(denoms, msg.TokenInDenom())
+	for i := 0; i < len(msg.Routes); i++ {
+		denoms = append(denoms, msg.Routes[i].TokenOutDenom)
+	}
+	return denoms
+}
@@ -4,6 +4,8 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
 )
 
 // constants.
@@ -13,6 +15,12 @@ const (
 	TypeMsgSplitRouteSwapExactAmountIn  = "split_route_swap_exact_amount_in"
 	TypeMsgSplitRouteSwapExactAmountOut = "split_route_swap_exact_amount_out"
 	TypeMsgSetDenomPairTakerFee         = "set_denom_pair_taker_fee"
+
+	TypeMsgSwapExactAmountInWithPriceLimit = "swap_exact_amount_in_with_price_limit"
+	TypeMsgCreateTwapOrder                 = "create_twap_order"
+	TypeMsgCancelTwapOrder                 = "cancel_twap_order"
+	TypeMsgJoinPool                        = "join_pool"
+	TypeMsgExitPool                        = "exit_pool"
 )
 
 var _ sdk.Msg = &MsgSwapExactAmountIn{}
@@ -193,3 +201,186 @@ func (msg MsgSetDenomPairTakerFee) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{sender}
 }
+
+var _ sdk.Msg = &MsgSwapExactAmountInWithPriceLimit{}
+
+func (msg MsgSwapExactAmountInWithPriceLimit) Route() string { return RouterKey }
+func (msg MsgSwapExactAmountInWithPriceLimit) Type() string {
+	return TypeMsgSwapExactAmountInWithPriceLimit
+}
+
+func (msg MsgSwapExactAmountInWithPriceLimit) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return InvalidSenderError{Sender: msg.Sender}
+	}
+
+	err = SwapAmountInRoutes(msg.Routes).Validate()
+	if err != nil {
+		return err
+	}
+
+	if !msg.TokenIn.IsValid() || !msg.TokenIn.IsPositive() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, msg.TokenIn.String())
+	}
+
+	return ValidatePriceLimit(msg.MaxPriceImpact, msg.LimitPrice)
+}
+
+func (msg MsgSwapExactAmountInWithPriceLimit) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgSwapExactAmountInWithPriceLimit) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// ValidatePriceLimit validates that exactly one of the max price impact and the limit price is set, i.e. positive,
+// and that the other one is unset, i.e. nil or zero.
+func ValidatePriceLimit(maxPriceImpact, limitPrice osmomath.Dec) error {
+	isMaxPriceImpactSet := !maxPriceImpact.IsNil() && !maxPriceImpact.IsZero()
+	isLimitPriceSet := !limitPrice.IsNil() && !limitPrice.IsZero()
+	if isMaxPriceImpactSet == isLimitPriceSet {
+		return InvalidPriceLimitError{MaxPriceImpact: maxPriceImpact, LimitPrice: limitPrice}
+	}
+	if isMaxPriceImpactSet && !maxPriceImpact.IsPositive() {
+		return InvalidPriceLimitError{MaxPriceImpact: maxPriceImpact, LimitPrice: limitPrice}
+	}
+	if isLimitPriceSet && !limitPrice.IsPositive() {
+		return InvalidPriceLimitError{MaxPriceImpact: maxPriceImpact, LimitPrice: limitPrice}
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgCreateTwapOrder{}
+
+func (msg MsgCreateTwapOrder) Route() string { return RouterKey }
+func (msg MsgCreateTwapOrder) Type() string  { return TypeMsgCreateTwapOrder }
+
+func (msg MsgCreateTwapOrder) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return InvalidSenderError{Sender: msg.Sender}
+	}
+
+	err = SwapAmountInRoutes(msg.Routes).Validate()
+	if err != nil {
+		return err
+	}
+
+	return ValidateTwapOrderSlicing(msg.TokenIn, msg.NumSlices, msg.Interval, msg.MaxSlippage)
+}
+
+func (msg MsgCreateTwapOrder) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgCreateTwapOrder) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgCancelTwapOrder{}
+
+func (msg MsgCancelTwapOrder) Route() string { return RouterKey }
+func (msg MsgCancelTwapOrder) Type() string  { return TypeMsgCancelTwapOrder }
+
+func (msg MsgCancelTwapOrder) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return InvalidSenderError{Sender: msg.Sender}
+	}
+
+	if msg.OrderId == 0 {
+		return TwapOrderNotFoundError{OrderId: msg.OrderId}
+	}
+
+	return nil
+}
+
+func (msg MsgCancelTwapOrder) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgCancelTwapOrder) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgJoinPool{}
+
+func (msg MsgJoinPool) Route() string { return RouterKey }
+func (msg MsgJoinPool) Type() string  { return TypeMsgJoinPool }
+
+func (msg MsgJoinPool) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return InvalidSenderError{Sender: msg.Sender}
+	}
+
+	if msg.TokensIn.Empty() || !msg.TokensIn.IsValid() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, msg.TokensIn.String())
+	}
+
+	if msg.ShareOutMinAmount.IsNil() || msg.ShareOutMinAmount.IsNegative() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "share out min amount must not be negative")
+	}
+
+	return nil
+}
+
+func (msg MsgJoinPool) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgJoinPool) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgExitPool{}
+
+func (msg MsgExitPool) Route() string { return RouterKey }
+func (msg MsgExitPool) Type() string  { return TypeMsgExitPool }
+
+func (msg MsgExitPool) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return InvalidSenderError{Sender: msg.Sender}
+	}
+
+	if msg.ShareInAmount.IsNil() || !msg.ShareInAmount.IsPositive() {
+		return nonPositiveAmountError{msg.ShareInAmount.String()}
+	}
+
+	if !msg.TokenOutMins.IsValid() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, msg.TokenOutMins.String())
+	}
+
+	return nil
+}
+
+func (msg MsgExitPool) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgExitPool) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
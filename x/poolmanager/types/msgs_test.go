@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -333,6 +334,62 @@ func TestAuthzMsg(t *testing.T) {
 				TokenInMaxAmount: osmomath.NewInt(1),
 			},
 		},
+		{
+			name: "MsgSwapExactAmountInWithPriceLimit",
+			msg: &types.MsgSwapExactAmountInWithPriceLimit{
+				Sender: addr1,
+				Routes: []types.SwapAmountInRoute{{
+					PoolId:        0,
+					TokenOutDenom: "test",
+				}, {
+					PoolId:        1,
+					TokenOutDenom: "test2",
+				}},
+				TokenIn:          coin,
+				MaxPriceImpact:   osmomath.MustNewDecFromStr("0.01"),
+				LimitPrice:       osmomath.ZeroDec(),
+				AllowPartialFill: true,
+			},
+		},
+		{
+			name: "MsgCreateTwapOrder",
+			msg: &types.MsgCreateTwapOrder{
+				Sender: addr1,
+				Routes: []types.SwapAmountInRoute{{
+					PoolId:        1,
+					TokenOutDenom: "test",
+				}},
+				TokenIn:     coin,
+				NumSlices:   1,
+				Interval:    time.Hour,
+				MaxSlippage: osmomath.MustNewDecFromStr("0.01"),
+			},
+		},
+		{
+			name: "MsgCancelTwapOrder",
+			msg: &types.MsgCancelTwapOrder{
+				Sender:  addr1,
+				OrderId: 1,
+			},
+		},
+		{
+			name: "MsgJoinPool",
+			msg: &types.MsgJoinPool{
+				Sender:            addr1,
+				PoolId:            1,
+				TokensIn:          sdk.NewCoins(coin),
+				ShareOutMinAmount: osmomath.OneInt(),
+			},
+		},
+		{
+			name: "MsgExitPool",
+			msg: &types.MsgExitPool{
+				Sender:        addr1,
+				PoolId:        1,
+				ShareInAmount: osmomath.OneInt(),
+				TokenOutMins:  sdk.NewCoins(coin),
+			},
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -654,3 +711,476 @@ func TestMsgSetDenomPairTakerFee(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgSwapExactAmountInWithPriceLimit(t *testing.T) {
+	properMsg := types.MsgSwapExactAmountInWithPriceLimit{
+		Sender:         addr1,
+		Routes:         validSwapExactAmountInRoutes,
+		TokenIn:        sdk.NewCoin("test", osmomath.NewInt(100)),
+		MaxPriceImpact: osmomath.MustNewDecFromStr("0.01"),
+	}
+
+	msg := createMsg(properMsg, func(msg types.MsgSwapExactAmountInWithPriceLimit) types.MsgSwapExactAmountInWithPriceLimit {
+		// Do nothing
+		return msg
+	})
+
+	require.Equal(t, msg.Route(), types.RouterKey)
+	require.Equal(t, msg.Type(), "swap_exact_amount_in_with_price_limit")
+	signers := msg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1)
+
+	tests := []struct {
+		name       string
+		msg        types.MsgSwapExactAmountInWithPriceLimit
+		expectPass bool
+	}{
+		{
+			name: "proper msg with max price impact",
+			msg: createMsg(properMsg, func(msg types.MsgSwapExactAmountInWithPriceLimit) types.MsgSwapExactAmountInWithPriceLimit {
+				// Do nothing
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "proper msg with limit price and zero max price impact",
+			msg: createMsg(properMsg, func(msg types.MsgSwapExactAmountInWithPriceLimit) types.MsgSwapExactAmountInWithPriceLimit {
+				msg.MaxPriceImpact = osmomath.ZeroDec()
+				msg.LimitPrice = osmomath.MustNewDecFromStr("1.5")
+				msg.AllowPartialFill = true
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: createMsg(properMsg, func(msg types.MsgSwapExactAmountInWithPriceLimit) types.MsgSwapExactAmountInWithPriceLimit {
+				msg.Sender = invalidAddr.String()
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "empty routes",
+			msg: createMsg(properMsg, func(msg types.MsgSwapExactAmountInWithPriceLimit) types.MsgSwapExactAmountInWithPriceLimit {
+				msg.Routes = nil
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero amount token",
+			msg: createMsg(properMsg, func(msg types.MsgSwapExactAmountInWithPriceLimit) types.MsgSwapExactAmountInWithPriceLimit {
+				msg.TokenIn.Amount = osmomath.NewInt(0)
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "neither max price impact nor limit price",
+			msg: createMsg(properMsg, func(msg types.MsgSwapExactAmountInWithPriceLimit) types.MsgSwapExactAmountInWithPriceLimit {
+				msg.MaxPriceImpact = osmomath.Dec{}
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "both max price impact and limit price",
+			msg: createMsg(properMsg, func(msg types.MsgSwapExactAmountInWithPriceLimit) types.MsgSwapExactAmountInWithPriceLimit {
+				msg.LimitPrice = osmomath.MustNewDecFromStr("1.5")
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "negative max price impact",
+			msg: createMsg(properMsg, func(msg types.MsgSwapExactAmountInWithPriceLimit) types.MsgSwapExactAmountInWithPriceLimit {
+				msg.MaxPriceImpact = osmomath.MustNewDecFromStr("-0.01")
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "negative limit price",
+			msg: createMsg(properMsg, func(msg types.MsgSwapExactAmountInWithPriceLimit) types.MsgSwapExactAmountInWithPriceLimit {
+				msg.MaxPriceImpact = osmomath.Dec{}
+				msg.LimitPrice = osmomath.MustNewDecFromStr("-1.5")
+				return msg
+			}),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestMsgCreateTwapOrder(t *testing.T) {
+	properMsg := types.MsgCreateTwapOrder{
+		Sender:      addr1,
+		Routes:      validSwapExactAmountInRoutes,
+		TokenIn:     sdk.NewCoin("test", osmomath.NewInt(100)),
+		NumSlices:   10,
+		Interval:    time.Hour,
+		MaxSlippage: osmomath.MustNewDecFromStr("0.01"),
+	}
+
+	msg := createMsg(properMsg, func(msg types.MsgCreateTwapOrder) types.MsgCreateTwapOrder {
+		// Do nothing
+		return msg
+	})
+
+	require.Equal(t, msg.Route(), types.RouterKey)
+	require.Equal(t, msg.Type(), "create_twap_order")
+	signers := msg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1)
+
+	tests := []struct {
+		name       string
+		msg        types.MsgCreateTwapOrder
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: createMsg(properMsg, func(msg types.MsgCreateTwapOrder) types.MsgCreateTwapOrder {
+				// Do nothing
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "zero max slippage",
+			msg: createMsg(properMsg, func(msg types.MsgCreateTwapOrder) types.MsgCreateTwapOrder {
+				msg.MaxSlippage = osmomath.ZeroDec()
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "as many slices as the token in amount",
+			msg: createMsg(properMsg, func(msg types.MsgCreateTwapOrder) types.MsgCreateTwapOrder {
+				msg.NumSlices = 100
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: createMsg(properMsg, func(msg types.MsgCreateTwapOrder) types.MsgCreateTwapOrder {
+				msg.Sender = invalidAddr.String()
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "empty routes",
+			msg: createMsg(properMsg, func(msg types.MsgCreateTwapOrder) types.MsgCreateTwapOrder {
+				msg.Routes = nil
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero amount token",
+			msg: createMsg(properMsg, func(msg types.MsgCreateTwapOrder) types.MsgCreateTwapOrder {
+				msg.TokenIn.Amount = osmomath.NewInt(0)
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero slices",
+			msg: createMsg(properMsg, func(msg types.MsgCreateTwapOrder) types.MsgCreateTwapOrder {
+				msg.NumSlices = 0
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "more slices than the token in amount",
+			msg: createMsg(properMsg, func(msg types.MsgCreateTwapOrder) types.MsgCreateTwapOrder {
+				msg.NumSlices = 101
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "more slices than the maximum",
+			msg: createMsg(properMsg, func(msg types.MsgCreateTwapOrder) types.MsgCreateTwapOrder {
+				msg.TokenIn.Amount = osmomath.NewInt(1_000_000)
+				msg.NumSlices = types.MaxTwapOrderSlices + 1
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero interval",
+			msg: createMsg(properMsg, func(msg types.MsgCreateTwapOrder) types.MsgCreateTwapOrder {
+				msg.Interval = 0
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "interval below the minimum",
+			msg: createMsg(properMsg, func(msg types.MsgCreateTwapOrder) types.MsgCreateTwapOrder {
+				msg.Interval = types.MinTwapOrderInterval - time.Second
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "nil max slippage",
+			msg: createMsg(properMsg, func(msg types.MsgCreateTwapOrder) types.MsgCreateTwapOrder {
+				msg.MaxSlippage = osmomath.Dec{}
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "negative max slippage",
+			msg: createMsg(properMsg, func(msg types.MsgCreateTwapOrder) types.MsgCreateTwapOrder {
+				msg.MaxSlippage = osmomath.MustNewDecFromStr("-0.01")
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "max slippage of one",
+			msg: createMsg(properMsg, func(msg types.MsgCreateTwapOrder) types.MsgCreateTwapOrder {
+				msg.MaxSlippage = osmomath.OneDec()
+				return msg
+			}),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestMsgCancelTwapOrder(t *testing.T) {
+	properMsg := types.MsgCancelTwapOrder{
+		Sender:  addr1,
+		OrderId: 1,
+	}
+
+	require.Equal(t, properMsg.Route(), types.RouterKey)
+	require.Equal(t, properMsg.Type(), "cancel_twap_order")
+	signers := properMsg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1)
+
+	tests := []struct {
+		name       string
+		msg        types.MsgCancelTwapOrder
+		expectPass bool
+	}{
+		{
+			name:       "proper msg",
+			msg:        properMsg,
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: createMsg(properMsg, func(msg types.MsgCancelTwapOrder) types.MsgCancelTwapOrder {
+				msg.Sender = invalidAddr.String()
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero order id",
+			msg: createMsg(properMsg, func(msg types.MsgCancelTwapOrder) types.MsgCancelTwapOrder {
+				msg.OrderId = 0
+				return msg
+			}),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestMsgJoinPool(t *testing.T) {
+	properMsg := types.MsgJoinPool{
+		Sender:            addr1,
+		PoolId:            1,
+		TokensIn:          sdk.NewCoins(sdk.NewCoin("test", osmomath.NewInt(100)), sdk.NewCoin("test2", osmomath.NewInt(100))),
+		ShareOutMinAmount: osmomath.NewInt(10),
+	}
+
+	require.Equal(t, properMsg.Route(), types.RouterKey)
+	require.Equal(t, properMsg.Type(), "join_pool")
+	signers := properMsg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1)
+
+	tests := []struct {
+		name       string
+		msg        types.MsgJoinPool
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: createMsg(properMsg, func(msg types.MsgJoinPool) types.MsgJoinPool {
+				// Do nothing
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "zero share out min amount",
+			msg: createMsg(properMsg, func(msg types.MsgJoinPool) types.MsgJoinPool {
+				msg.ShareOutMinAmount = osmomath.ZeroInt()
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: createMsg(properMsg, func(msg types.MsgJoinPool) types.MsgJoinPool {
+				msg.Sender = invalidAddr.String()
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "empty tokens in",
+			msg: createMsg(properMsg, func(msg types.MsgJoinPool) types.MsgJoinPool {
+				msg.TokensIn = sdk.Coins{}
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero amount token in",
+			msg: createMsg(properMsg, func(msg types.MsgJoinPool) types.MsgJoinPool {
+				msg.TokensIn = sdk.Coins{sdk.Coin{Denom: "test", Amount: osmomath.ZeroInt()}}
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "nil share out min amount",
+			msg: createMsg(properMsg, func(msg types.MsgJoinPool) types.MsgJoinPool {
+				msg.ShareOutMinAmount = osmomath.Int{}
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "negative share out min amount",
+			msg: createMsg(properMsg, func(msg types.MsgJoinPool) types.MsgJoinPool {
+				msg.ShareOutMinAmount = osmomath.NewInt(-1)
+				return msg
+			}),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestMsgExitPool(t *testing.T) {
+	properMsg := types.MsgExitPool{
+		Sender:        addr1,
+		PoolId:        1,
+		ShareInAmount: osmomath.NewInt(10),
+		TokenOutMins:  sdk.NewCoins(sdk.NewCoin("test", osmomath.NewInt(1))),
+	}
+
+	require.Equal(t, properMsg.Route(), types.RouterKey)
+	require.Equal(t, properMsg.Type(), "exit_pool")
+	signers := properMsg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1)
+
+	tests := []struct {
+		name       string
+		msg        types.MsgExitPool
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: createMsg(properMsg, func(msg types.MsgExitPool) types.MsgExitPool {
+				// Do nothing
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "no token out mins",
+			msg: createMsg(properMsg, func(msg types.MsgExitPool) types.MsgExitPool {
+				msg.TokenOutMins = sdk.Coins{}
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: createMsg(properMsg, func(msg types.MsgExitPool) types.MsgExitPool {
+				msg.Sender = invalidAddr.String()
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero share in amount",
+			msg: createMsg(properMsg, func(msg types.MsgExitPool) types.MsgExitPool {
+				msg.ShareInAmount = osmomath.ZeroInt()
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "nil share in amount",
+			msg: createMsg(properMsg, func(msg types.MsgExitPool) types.MsgExitPool {
+				msg.ShareInAmount = osmomath.Int{}
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "invalid token out mins",
+			msg: createMsg(properMsg, func(msg types.MsgExitPool) types.MsgExitPool {
+				msg.TokenOutMins = sdk.Coins{sdk.Coin{Denom: "test", Amount: osmomath.NewInt(-1)}}
+				return msg
+			}),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
@@ -21,6 +21,7 @@ var (
 	KeyCommunityPoolDenomToSwapNonWhitelistedAssetsTo = []byte("CommunityPoolDenomToSwapNonWhitelistedAssetsTo")
 	KeyAuthorizedQuoteDenoms                          = []byte("AuthorizedQuoteDenoms")
 	KeyReducedTakerFeeByWhitelist                     = []byte("ReducedTakerFeeByWhitelist")
+	KeyCustomTakerFeePoolIds                          = []byte("CustomTakerFeePoolIds")
 )
 
 // ParamTable for gamm module.
@@ -63,6 +64,7 @@ func DefaultParams() Params {
 			AdminAddresses: []string{},
 			CommunityPoolDenomToSwapNonWhitelistedAssetsTo: "ibc/D189335C6E4A68B513C10AB227BF1C1D38C746766278BA3EEB4FB14124F1D858", // USDC
 			ReducedFeeWhitelist:                            []string{},
+			CustomTakerFeePoolIds:                          []uint64{},
 		},
 		AuthorizedQuoteDenoms: []string{
 			"uosmo",
@@ -96,6 +98,9 @@ func (p Params) Validate() error {
 	if err := osmoutils.ValidateAddressList(p.TakerFeeParams.ReducedFeeWhitelist); err != nil {
 		return err
 	}
+	if err := validateCustomTakerFeePoolIds(p.TakerFeeParams.CustomTakerFeePoolIds); err != nil {
+		return err
+	}
 	if err := validateAuthorizedQuoteDenoms(p.AuthorizedQuoteDenoms); err != nil {
 		return err
 	}
@@ -114,6 +119,7 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyCommunityPoolDenomToSwapNonWhitelistedAssetsTo, &p.TakerFeeParams.CommunityPoolDenomToSwapNonWhitelistedAssetsTo, validateCommunityPoolDenomToSwapNonWhitelistedAssetsTo),
 		paramtypes.NewParamSetPair(KeyAuthorizedQuoteDenoms, &p.AuthorizedQuoteDenoms, validateAuthorizedQuoteDenoms),
 		paramtypes.NewParamSetPair(KeyReducedTakerFeeByWhitelist, &p.TakerFeeParams.ReducedFeeWhitelist, osmoutils.ValidateAddressList),
+		paramtypes.NewParamSetPair(KeyCustomTakerFeePoolIds, &p.TakerFeeParams.CustomTakerFeePoolIds, validateCustomTakerFeePoolIds),
 	}
 }
 
@@ -249,3 +255,31 @@ func validateDenomPairTakerFees(pairs []DenomPairTakerFee) error {
 	}
 	return nil
 }
+
+// validateCustomTakerFeePoolIds validates the ids of the pools allowed to charge the taker fee themselves.
+//
+// Parameters:
+// - i: The parameter to validate.
+//
+// Returns:
+// - An error if given type is not uint64 slice.
+// - An error if any of the pool ids is zero or repeated.
+func validateCustomTakerFeePoolIds(i interface{}) error {
+	poolIds, ok := i.([]uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[uint64]bool, len(poolIds))
+	for _, poolId := range poolIds {
+		if poolId == 0 {
+			return fmt.Errorf("invalid custom taker fee pool id: %d", poolId)
+		}
+		if seen[poolId] {
+			return fmt.Errorf("duplicate custom taker fee pool id: %d", poolId)
+		}
+		seen[poolId] = true
+	}
+
+	return nil
+}
@@ -39,6 +39,13 @@ type PoolI interface {
 	AsSerializablePool() PoolI
 }
 
+// TakerFeeHandlingPool is an optional extension of PoolI for pools that charge
+// the taker fee themselves. Poolmanager does not charge the taker fee on swaps
+// routed through pools for which HandlesTakerFee returns true.
+type TakerFeeHandlingPool interface {
+	HandlesTakerFee() bool
+}
+
 // NewPoolAddress returns an address for a pool from a given id.
 func NewPoolAddress(poolId uint64) sdk.AccAddress {
 	return osmoutils.NewModuleAddressWithPrefix(ModuleName, "pool", sdk.Uint64ToBigEndian(poolId))
@@ -0,0 +1,73 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+)
+
+const (
+	// MaxTwapOrderSlices is the maximum number of slices of a TWAP order.
+	MaxTwapOrderSlices = 1000
+	// MinTwapOrderInterval is the minimum interval between two slices of a TWAP order.
+	MinTwapOrderInterval = time.Minute
+)
+
+// TwapOrderCreationFeePerSlice is the amount of the bond denom charged per slice when creating a TWAP order, and sent to
+// the community pool. Since slices are executed at the end of the block, and only a bounded number of them per block,
+// it prevents orders of many small slices from crowding out the others for free.
+var TwapOrderCreationFeePerSlice = osmomath.NewInt(100_000)
+
+// TwapOrderEscrowAddress is the address holding the token in of the TWAP orders until their slices are executed.
+// It is not the module account, as swaps send the token out to it, and module accounts are blocked from receiving.
+var TwapOrderEscrowAddress = sdk.AccAddress(address.Module(ModuleName, []byte("twap_order_escrow")))
+
+// ValidateTwapOrderSlicing validates that tokenIn can be split in numSlices positive slices, at most
+// MaxTwapOrderSlices, spaced by an interval of at least MinTwapOrderInterval, and that maxSlippage is in [0, 1).
+func ValidateTwapOrderSlicing(tokenIn sdk.Coin, numSlices uint64, interval time.Duration, maxSlippage osmomath.Dec) error {
+	if !tokenIn.IsValid() || !tokenIn.IsPositive() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, tokenIn.String())
+	}
+	if numSlices == 0 || numSlices > MaxTwapOrderSlices || tokenIn.Amount.LT(osmomath.NewIntFromUint64(numSlices)) {
+		return InvalidNumSlicesError{NumSlices: numSlices, TokenInAmount: tokenIn.Amount}
+	}
+	if interval < MinTwapOrderInterval {
+		return InvalidTwapOrderIntervalError{Interval: interval}
+	}
+	if maxSlippage.IsNil() || maxSlippage.IsNegative() || maxSlippage.GTE(osmomath.OneDec()) {
+		return InvalidMaxSlippageError{MaxSlippage: maxSlippage}
+	}
+	return nil
+}
+
+// Validate validates the TWAP order, as stored between two of its slices.
+func (o TwapOrder) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(o.Owner); err != nil {
+		return InvalidSenderError{Sender: o.Owner}
+	}
+	if err := SwapAmountInRoutes(o.Routes).Validate(); err != nil {
+		return err
+	}
+	if o.TotalAmount.IsNil() {
+		return fmt.Errorf("TWAP order (%d) has no total amount", o.Id)
+	}
+	if err := ValidateTwapOrderSlicing(sdk.Coin{Denom: o.TokenInDenom, Amount: o.TotalAmount}, o.NumSlices, o.Interval, o.MaxSlippage); err != nil {
+		return err
+	}
+	if o.SlicesExecuted >= o.NumSlices {
+		return fmt.Errorf("TWAP order (%d) has executed all of its %d slices", o.Id, o.NumSlices)
+	}
+	if o.RemainingAmount.IsNil() || o.RemainingAmount.LT(osmomath.NewIntFromUint64(o.NumSlices-o.SlicesExecuted)) || o.RemainingAmount.GT(o.TotalAmount) {
+		return fmt.Errorf("TWAP order (%d) remaining amount (%s) must be at least the number of slices left and at most the total amount (%s)", o.Id, o.RemainingAmount, o.TotalAmount)
+	}
+	if o.TokenOutAmount.IsNil() || o.TokenOutAmount.IsNegative() {
+		return fmt.Errorf("TWAP order (%d) token out amount (%s) must not be negative", o.Id, o.TokenOutAmount)
+	}
+	return nil
+}
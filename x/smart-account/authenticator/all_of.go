@@ -14,7 +14,10 @@ type AllOfAuthenticator struct {
 	signatureAssignment SignatureAssignment
 }
 
-var _ Authenticator = &AllOfAuthenticator{}
+var (
+	_ Authenticator          = &AllOfAuthenticator{}
+	_ CompositeAuthenticator = &AllOfAuthenticator{}
+)
 
 func NewAllOfAuthenticator(am *AuthenticatorManager) AllOfAuthenticator {
 	return AllOfAuthenticator{
@@ -135,6 +138,14 @@ func (aoa AllOfAuthenticator) ConfirmExecution(ctx sdk.Context, request Authenti
 	return nil
 }
 
+func (aoa AllOfAuthenticator) SubAuthenticatorRequests(request AuthenticationRequest) ([]Authenticator, []AuthenticationRequest, error) {
+	requests, err := subRequests(request, aoa.SubAuthenticators, aoa.signatureAssignment)
+	if err != nil {
+		return nil, nil, err
+	}
+	return aoa.SubAuthenticators, requests, nil
+}
+
 func (aoa AllOfAuthenticator) OnAuthenticatorAdded(ctx sdk.Context, account sdk.AccAddress, data []byte, authenticatorId string) error {
 	return onSubAuthenticatorsAdded(ctx, account, data, authenticatorId, aoa.am)
 }
@@ -24,7 +24,10 @@ type AnyOfAuthenticator struct {
 	signatureAssignment SignatureAssignment
 }
 
-var _ Authenticator = &AnyOfAuthenticator{}
+var (
+	_ Authenticator          = &AnyOfAuthenticator{}
+	_ CompositeAuthenticator = &AnyOfAuthenticator{}
+)
 
 func NewAnyOfAuthenticator(am *AuthenticatorManager) AnyOfAuthenticator {
 	return AnyOfAuthenticator{
@@ -167,6 +170,14 @@ func (aoa AnyOfAuthenticator) ConfirmExecution(ctx sdk.Context, request Authenti
 	return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "all sub-authenticators failed to confirm execution: %s", strings.Join(subAuthErrors, "; "))
 }
 
+func (aoa AnyOfAuthenticator) SubAuthenticatorRequests(request AuthenticationRequest) ([]Authenticator, []AuthenticationRequest, error) {
+	requests, err := subRequests(request, aoa.SubAuthenticators, aoa.signatureAssignment)
+	if err != nil {
+		return nil, nil, err
+	}
+	return aoa.SubAuthenticators, requests, nil
+}
+
 func (aoa AnyOfAuthenticator) OnAuthenticatorAdded(ctx sdk.Context, account sdk.AccAddress, data []byte, authenticatorId string) error {
 	return onSubAuthenticatorsAdded(ctx, account, data, authenticatorId, aoa.am)
 }
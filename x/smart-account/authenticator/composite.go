@@ -14,6 +14,44 @@ type SubAuthenticatorInitData struct {
 	Data              []byte `json:"data"`
 }
 
+// CompositeAuthenticator is implemented by authenticators that delegate to sub-authenticators.
+// It allows inspecting every sub-authenticator on its own, e.g. when dry-running authentication.
+type CompositeAuthenticator interface {
+	Authenticator
+
+	// SubAuthenticatorRequests returns the sub-authenticators together with the request each of
+	// them receives when the composite authenticator is called with the given request.
+	SubAuthenticatorRequests(request AuthenticationRequest) ([]Authenticator, []AuthenticationRequest, error)
+}
+
+// subRequests builds the request passed to each sub-authenticator, updating the authenticator id
+// and, for partitioned signature assignments, the signature.
+func subRequests(
+	request AuthenticationRequest,
+	subAuthenticators []Authenticator,
+	signatureAssignment SignatureAssignment,
+) ([]AuthenticationRequest, error) {
+	var signatures [][]byte
+	var err error
+	if signatureAssignment == Partitioned {
+		signatures, err = splitSignatures(request.Signature, len(subAuthenticators))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	baseId := request.AuthenticatorId
+	requests := make([]AuthenticationRequest, len(subAuthenticators))
+	for i := range subAuthenticators {
+		requests[i] = request
+		requests[i].AuthenticatorId = compositeId(baseId, i)
+		if signatureAssignment == Partitioned {
+			requests[i].Signature = signatures[i]
+		}
+	}
+	return requests, nil
+}
+
 func subTrack(
 	ctx sdk.Context,
 	request AuthenticationRequest,
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"sort"
+	"strings"
 
 	errorsmod "cosmossdk.io/errors"
 
@@ -15,6 +16,36 @@ import (
 
 var _ Authenticator = &MessageFilterAuthenticator{}
 
+// Operators that can be used in a MessageFilterAuthenticator pattern in place of a value.
+// An object whose keys are all operators is treated as a constraint on the value at that position
+// instead of as a nested object. When several operators are used together, all of them must match.
+const (
+	// OperatorLt, OperatorLte, OperatorGt and OperatorGte compare the value numerically to a string encoded number.
+	OperatorLt  = "@lt"
+	OperatorLte = "@lte"
+	OperatorGt  = "@gt"
+	OperatorGte = "@gte"
+	// OperatorBetween checks that the value is within an inclusive range: {"@between": ["min", "max"]}.
+	OperatorBetween = "@between"
+	// OperatorIn checks that the value matches at least one of the patterns in the list: {"@in": ["1", "2"]}.
+	OperatorIn = "@in"
+	// OperatorAll checks that every element of an array matches the pattern: {"@all": {"pool_id": "1"}}.
+	OperatorAll = "@all"
+	// OperatorAny checks that at least one element of an array matches the pattern: {"@any": {"denom": "uosmo"}}.
+	OperatorAny = "@any"
+)
+
+var messageFilterOperators = map[string]bool{
+	OperatorLt:      true,
+	OperatorLte:     true,
+	OperatorGt:      true,
+	OperatorGte:     true,
+	OperatorBetween: true,
+	OperatorIn:      true,
+	OperatorAll:     true,
+	OperatorAny:     true,
+}
+
 // MessageFilterAuthenticator filters incoming messages based on a predefined JSON pattern.
 // It allows for complex pattern matching to support advanced authentication flows.
 type MessageFilterAuthenticator struct {
@@ -94,7 +125,8 @@ func (m MessageFilterAuthenticator) ConfirmExecution(ctx sdk.Context, request Au
 	return nil
 }
 
-// OnAuthenticatorAdded performs additional checks when an authenticator is added. Specifically, it ensures numbers in JSON are encoded as strings.
+// OnAuthenticatorAdded performs additional checks when an authenticator is added. Specifically, it ensures numbers in JSON
+// are encoded as strings and that the operators used in the pattern are well formed.
 func (m MessageFilterAuthenticator) OnAuthenticatorAdded(ctx sdk.Context, account sdk.AccAddress, data []byte, authenticatorId string) error {
 	var jsonData json.RawMessage
 	err := json.Unmarshal(data, &jsonData)
@@ -108,6 +140,14 @@ func (m MessageFilterAuthenticator) OnAuthenticatorAdded(ctx sdk.Context, accoun
 	if hasFloats {
 		return fmt.Errorf("invalid json representation of message. Numbers should be encoded as strings")
 	}
+
+	var pattern interface{}
+	if err := json.Unmarshal(data, &pattern); err != nil {
+		return errorsmod.Wrap(err, "invalid json representation of message") // This should never happen
+	}
+	if err := validatePattern(pattern); err != nil {
+		return errorsmod.Wrap(err, "invalid message filter pattern")
+	}
 	return nil
 }
 
@@ -147,10 +187,226 @@ func checkForFloats(v interface{}) bool {
 	return false
 }
 
+// isOperatorMap returns true if all the keys of the map are operators.
+// "@type" is excluded as it is a regular field of the JSON representation of messages.
+func isOperatorMap(m map[string]interface{}) bool {
+	if len(m) == 0 {
+		return false
+	}
+	for key := range m {
+		if key == "@type" || !strings.HasPrefix(key, "@") {
+			return false
+		}
+	}
+	return true
+}
+
+// sortedKeys returns the keys of the map sorted to avoid non-determinism.
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// validatePattern checks that all the operators in the pattern are known and have operands of the right type.
+func validatePattern(pattern interface{}) error {
+	switch pv := pattern.(type) {
+	case map[string]interface{}:
+		if !isOperatorMap(pv) {
+			for _, key := range sortedKeys(pv) {
+				if err := validatePattern(pv[key]); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+
+		for _, op := range sortedKeys(pv) {
+			operand := pv[op]
+			switch op {
+			case OperatorLt, OperatorLte, OperatorGt, OperatorGte:
+				if _, err := parseNumericOperand(op, operand); err != nil {
+					return err
+				}
+			case OperatorBetween:
+				if _, _, err := parseBetweenOperand(operand); err != nil {
+					return err
+				}
+			case OperatorIn:
+				options, ok := operand.([]interface{})
+				if !ok || len(options) == 0 {
+					return fmt.Errorf("operator %s expects a non-empty list", op)
+				}
+				for _, option := range options {
+					if err := validatePattern(option); err != nil {
+						return err
+					}
+				}
+			case OperatorAll, OperatorAny:
+				if err := validatePattern(operand); err != nil {
+					return err
+				}
+			default:
+				return fmt.Errorf("unknown operator %s", op)
+			}
+		}
+	case []interface{}:
+		for _, element := range pv {
+			if err := validatePattern(element); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func parseNumericOperand(op string, operand interface{}) (sdk.Dec, error) {
+	str, ok := operand.(string)
+	if !ok {
+		return sdk.Dec{}, fmt.Errorf("operator %s expects a number encoded as a string, got %T", op, operand)
+	}
+	dec, err := sdk.NewDecFromStr(str)
+	if err != nil {
+		return sdk.Dec{}, fmt.Errorf("operator %s expects a number encoded as a string, got %s", op, str)
+	}
+	return dec, nil
+}
+
+func parseBetweenOperand(operand interface{}) (sdk.Dec, sdk.Dec, error) {
+	bounds, ok := operand.([]interface{})
+	if !ok || len(bounds) != 2 {
+		return sdk.Dec{}, sdk.Dec{}, fmt.Errorf("operator %s expects a list with a min and a max", OperatorBetween)
+	}
+	min, err := parseNumericOperand(OperatorBetween, bounds[0])
+	if err != nil {
+		return sdk.Dec{}, sdk.Dec{}, err
+	}
+	max, err := parseNumericOperand(OperatorBetween, bounds[1])
+	if err != nil {
+		return sdk.Dec{}, sdk.Dec{}, err
+	}
+	if min.GT(max) {
+		return sdk.Dec{}, sdk.Dec{}, fmt.Errorf("operator %s min %s is greater than max %s", OperatorBetween, min, max)
+	}
+	return min, max, nil
+}
+
+// matchOperators checks that the value b satisfies all the operators in ops.
+func matchOperators(ops map[string]interface{}, b interface{}) error {
+	for _, op := range sortedKeys(ops) {
+		operand := ops[op]
+		switch op {
+		case OperatorLt, OperatorLte, OperatorGt, OperatorGte:
+			bound, err := parseNumericOperand(op, operand)
+			if err != nil {
+				return err
+			}
+			value, err := numericValue(b)
+			if err != nil {
+				return err
+			}
+			if !compareDec(op, value, bound) {
+				return fmt.Errorf("value %s does not satisfy %s %s", value, op, bound)
+			}
+
+		case OperatorBetween:
+			min, max, err := parseBetweenOperand(operand)
+			if err != nil {
+				return err
+			}
+			value, err := numericValue(b)
+			if err != nil {
+				return err
+			}
+			if value.LT(min) || value.GT(max) {
+				return fmt.Errorf("value %s is not between %s and %s", value, min, max)
+			}
+
+		case OperatorIn:
+			options, ok := operand.([]interface{})
+			if !ok {
+				return fmt.Errorf("operator %s expects a list", op)
+			}
+			matched := false
+			for _, option := range options {
+				if isSuperset(option, b) == nil {
+					matched = true
+					break
+				}
+			}
+			if !matched {
+				return fmt.Errorf("value %v is not in %v", b, options)
+			}
+
+		case OperatorAll, OperatorAny:
+			elements, ok := b.([]interface{})
+			if !ok {
+				return fmt.Errorf("operator %s expects a slice, got %T", op, b)
+			}
+			if op == OperatorAll {
+				for i, element := range elements {
+					if err := isSuperset(operand, element); err != nil {
+						return fmt.Errorf("element %d does not match: %w", i, err)
+					}
+				}
+				continue
+			}
+			matched := false
+			for _, element := range elements {
+				if isSuperset(operand, element) == nil {
+					matched = true
+					break
+				}
+			}
+			if !matched {
+				return fmt.Errorf("no element matches the pattern")
+			}
+
+		default:
+			return fmt.Errorf("unknown operator %s", op)
+		}
+	}
+	return nil
+}
+
+func numericValue(v interface{}) (sdk.Dec, error) {
+	str, ok := v.(string)
+	if !ok {
+		return sdk.Dec{}, fmt.Errorf("expected number encoded as a string, got %T", v)
+	}
+	dec, err := sdk.NewDecFromStr(str)
+	if err != nil {
+		return sdk.Dec{}, fmt.Errorf("expected number encoded as a string, got %s", str)
+	}
+	return dec, nil
+}
+
+func compareDec(op string, value, bound sdk.Dec) bool {
+	switch op {
+	case OperatorLt:
+		return value.LT(bound)
+	case OperatorLte:
+		return value.LTE(bound)
+	case OperatorGt:
+		return value.GT(bound)
+	case OperatorGte:
+		return value.GTE(bound)
+	default:
+		return false
+	}
+}
+
 // isSuperset checks if the first JSON structure is a superset of the second JSON structure.
 func isSuperset(a, b interface{}) error {
 	switch av := a.(type) {
 	case map[string]interface{}:
+		if isOperatorMap(av) {
+			return matchOperators(av, b)
+		}
+
 		bv, ok := b.(map[string]interface{})
 		if !ok {
 			return fmt.Errorf("expected map, got %T", b)
@@ -284,3 +284,90 @@ func (s *MessageFilterAuthenticatorTest) TestPoolManagerSwapExactAmountIn() {
 		})
 	}
 }
+
+// TestValueConstraints tests the MessageFilterAuthenticator with patterns that use value operators
+func (s *MessageFilterAuthenticatorTest) TestValueConstraints() {
+	fromAddr := s.TestAccAddress[0].String()
+	swap := func(amount int64, poolIds ...uint64) sdk.Msg {
+		var routes []poolmanagertypes.SwapAmountInRoute
+		for _, poolId := range poolIds {
+			routes = append(routes, poolmanagertypes.SwapAmountInRoute{PoolId: poolId, TokenOutDenom: "outputDenom"})
+		}
+		return &poolmanagertypes.MsgSwapExactAmountIn{
+			Sender:            fromAddr,
+			Routes:            routes,
+			TokenIn:           sdk.NewCoin("uosmo", sdk.NewInt(amount)),
+			TokenOutMinAmount: sdk.NewInt(1),
+		}
+	}
+	swapPattern := func(constraints string) string {
+		return fmt.Sprintf(`{"@type":"/osmosis.poolmanager.v1beta1.MsgSwapExactAmountIn","sender":"%s",%s}`, fromAddr, constraints)
+	}
+	restrictedSwap := swapPattern(`"token_in":{"denom":"uosmo","amount":{"@lte":"1000"}},"routes":{"@all":{"pool_id":{"@in":["1","1135","1400"]}}}`)
+
+	tests := []struct {
+		name           string
+		pattern        string
+		msg            sdk.Msg
+		passvalidation bool
+		match          bool
+	}{
+		{"lte. below", swapPattern(`"token_in":{"amount":{"@lte":"1000"}}`), swap(999, 1), true, true},
+		{"lte. equal", swapPattern(`"token_in":{"amount":{"@lte":"1000"}}`), swap(1000, 1), true, true},
+		{"lte. above", swapPattern(`"token_in":{"amount":{"@lte":"1000"}}`), swap(1001, 1), true, false},
+		{"lt. equal", swapPattern(`"token_in":{"amount":{"@lt":"1000"}}`), swap(1000, 1), true, false},
+		{"gte. equal", swapPattern(`"token_in":{"amount":{"@gte":"1000"}}`), swap(1000, 1), true, true},
+		{"gt. equal", swapPattern(`"token_in":{"amount":{"@gt":"1000"}}`), swap(1000, 1), true, false},
+		{"gt and lt. within", swapPattern(`"token_in":{"amount":{"@gt":"10","@lt":"1000"}}`), swap(500, 1), true, true},
+		{"gt and lt. outside", swapPattern(`"token_in":{"amount":{"@gt":"10","@lt":"1000"}}`), swap(5, 1), true, false},
+		{"between. within", swapPattern(`"token_in":{"amount":{"@between":["10","1000"]}}`), swap(10, 1), true, true},
+		{"between. outside", swapPattern(`"token_in":{"amount":{"@between":["10","1000"]}}`), swap(1001, 1), true, false},
+		{"in. match", swapPattern(`"token_in":{"denom":{"@in":["uosmo","uion"]}}`), swap(1, 1), true, true},
+		{"in. mismatch", swapPattern(`"token_in":{"denom":{"@in":["uatom","uion"]}}`), swap(1, 1), true, false},
+		{"any. match", swapPattern(`"routes":{"@any":{"pool_id":"1135"}}`), swap(1, 1, 1135), true, true},
+		{"any. mismatch", swapPattern(`"routes":{"@any":{"pool_id":"1400"}}`), swap(1, 1, 1135), true, false},
+		{"restricted swap. match", restrictedSwap, swap(1000, 1, 1135, 1400), true, true},
+		{"restricted swap. amount too high", restrictedSwap, swap(1001, 1135), true, false},
+		{"restricted swap. bad pool", restrictedSwap, swap(1000, 1, 2), true, false},
+		{"restricted swap. bad denom", restrictedSwap, &poolmanagertypes.MsgSwapExactAmountIn{
+			Sender: fromAddr, Routes: []poolmanagertypes.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: "outputDenom"}},
+			TokenIn: sdk.NewCoin("uion", sdk.NewInt(1)), TokenOutMinAmount: sdk.NewInt(1),
+		}, true, false},
+		{"all. on non array", swapPattern(`"token_in":{"@all":{"denom":"uosmo"}}`), swap(1, 1), true, false},
+		{"invalid. unknown operator", swapPattern(`"token_in":{"amount":{"@max":"1000"}}`), swap(1, 1), false, false},
+		{"invalid. number operand", swapPattern(`"token_in":{"amount":{"@lte":1000}}`), swap(1, 1), false, false},
+		{"invalid. non numeric operand", swapPattern(`"token_in":{"amount":{"@lte":"abc"}}`), swap(1, 1), false, false},
+		{"invalid. between with one bound", swapPattern(`"token_in":{"amount":{"@between":["10"]}}`), swap(1, 1), false, false},
+		{"invalid. between min above max", swapPattern(`"token_in":{"amount":{"@between":["10","1"]}}`), swap(1, 1), false, false},
+		{"invalid. empty in", swapPattern(`"token_in":{"denom":{"@in":[]}}`), swap(1, 1), false, false},
+		{"invalid. nested unknown operator", swapPattern(`"routes":{"@all":{"pool_id":{"@foo":"1"}}}`), swap(1, 1), false, false},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			err := s.MessageFilterAuthenticator.OnAuthenticatorAdded(s.Ctx, sdk.AccAddress{}, []byte(tt.pattern), "1")
+			if tt.passvalidation {
+				s.Require().NoError(err)
+			} else {
+				s.Require().Error(err)
+				return
+			}
+			filter, err := s.MessageFilterAuthenticator.Initialize([]byte(tt.pattern))
+			s.Require().NoError(err)
+
+			ak := s.OsmosisApp.AccountKeeper
+			sigModeHandler := s.EncodingConfig.TxConfig.SignModeHandler()
+			tx, err := s.GenSimpleTx([]sdk.Msg{tt.msg}, []cryptotypes.PrivKey{s.TestPrivKeys[0]})
+			s.Require().NoError(err)
+			request, err := authenticator.GenerateAuthenticationRequest(s.Ctx, ak, sigModeHandler, s.TestAccAddress[0], s.TestAccAddress[0], nil, sdk.NewCoins(), tt.msg, tx, 0, false, authenticator.SequenceMatch)
+			s.Require().NoError(err)
+
+			err = filter.Authenticate(s.Ctx, request)
+			if tt.match {
+				s.Require().NoError(err)
+			} else {
+				s.Require().Error(err)
+			}
+		})
+	}
+}
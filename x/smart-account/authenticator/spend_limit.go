@@ -0,0 +1,393 @@
+package authenticator
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v24/x/smart-account/types"
+)
+
+var _ Authenticator = &SpendLimitAuthenticator{}
+
+const (
+	// SpendLimitAuthenticatorType is the type of the native spend limit authenticator.
+	SpendLimitAuthenticatorType = "SpendLimitAuthenticator"
+
+	// DefaultSpendLimitTwapWindow is the twap window used to value tokens if none is configured.
+	DefaultSpendLimitTwapWindow = time.Hour
+
+	// MaxSpendLimitRollingEntries bounds the amount of spending entries kept for rolling periods.
+	MaxSpendLimitRollingEntries = 100
+)
+
+// SpendLimitPeriod defines how the spending tracked by a SpendLimitAuthenticator is bucketed over time.
+type SpendLimitPeriod string
+
+const (
+	// SpendLimitPeriodDay resets the spending at the start of every UTC day.
+	SpendLimitPeriodDay SpendLimitPeriod = "day"
+	// SpendLimitPeriodWeek resets the spending at the start of every UTC week (Monday).
+	SpendLimitPeriodWeek SpendLimitPeriod = "week"
+	// SpendLimitPeriodRolling limits the spending over the last RollingWindow.
+	SpendLimitPeriodRolling SpendLimitPeriod = "rolling"
+)
+
+// SpendLimitPriceStrategy defines how spent tokens are converted to the quote denom.
+type SpendLimitPriceStrategy string
+
+const (
+	// SpendLimitPriceStrategyTwap values tokens with the arithmetic twap over TwapWindow.
+	SpendLimitPriceStrategyTwap SpendLimitPriceStrategy = "twap"
+	// SpendLimitPriceStrategySpot values tokens with the current pool spot price.
+	SpendLimitPriceStrategySpot SpendLimitPriceStrategy = "spot"
+)
+
+// SpendLimitPriceSource is the pool used to value a denom in the quote denom.
+type SpendLimitPriceSource struct {
+	Denom  string `json:"denom"`
+	PoolId uint64 `json:"pool_id"`
+}
+
+// SpendLimitParams is the configuration of a SpendLimitAuthenticator, provided as the
+// authenticator data when it is added to an account.
+type SpendLimitParams struct {
+	// Limit is the maximum value, denominated in QuoteDenom, that can be spent per period.
+	Limit      osmomath.Int     `json:"limit"`
+	QuoteDenom string           `json:"quote_denom"`
+	Period     SpendLimitPeriod `json:"period"`
+	// RollingWindow is the length of the window for rolling periods.
+	RollingWindow time.Duration `json:"rolling_window,omitempty"`
+	// PriceStrategy defaults to twap.
+	PriceStrategy SpendLimitPriceStrategy `json:"price_strategy,omitempty"`
+	// TwapWindow defaults to DefaultSpendLimitTwapWindow.
+	TwapWindow time.Duration `json:"twap_window,omitempty"`
+	// PriceSources are the pools used to value every denom other than QuoteDenom.
+	// Spending a denom without a price source is rejected.
+	PriceSources []SpendLimitPriceSource `json:"price_sources,omitempty"`
+}
+
+// SpendLimitEntry is a single spending recorded for rolling periods.
+type SpendLimitEntry struct {
+	Time   time.Time    `json:"time"`
+	Amount osmomath.Int `json:"amount"`
+}
+
+// SpendLimitSpending is the spending of an account for an authenticator, kept in the module store.
+type SpendLimitSpending struct {
+	PeriodStart time.Time         `json:"period_start"`
+	Spent       osmomath.Int      `json:"spent"`
+	Entries     []SpendLimitEntry `json:"entries,omitempty"`
+}
+
+// SpendLimitAuthenticator limits the value an account can spend over a period of time.
+// The spending of a transaction is computed by comparing the balances of the account
+// before (Track) and after (ConfirmExecution) its execution, and valuing every decrease
+// in the configured quote denom using twap or spot prices.
+type SpendLimitAuthenticator struct {
+	storeKey          storetypes.StoreKey
+	bankKeeper        types.BankKeeper
+	twapKeeper        types.TwapKeeper
+	poolManagerKeeper types.PoolManagerKeeper
+
+	params SpendLimitParams
+}
+
+// NewSpendLimitAuthenticator creates a new SpendLimitAuthenticator.
+func NewSpendLimitAuthenticator(
+	storeKey storetypes.StoreKey,
+	bankKeeper types.BankKeeper,
+	twapKeeper types.TwapKeeper,
+	poolManagerKeeper types.PoolManagerKeeper,
+) SpendLimitAuthenticator {
+	return SpendLimitAuthenticator{
+		storeKey:          storeKey,
+		bankKeeper:        bankKeeper,
+		twapKeeper:        twapKeeper,
+		poolManagerKeeper: poolManagerKeeper,
+	}
+}
+
+// Type returns the type of the authenticator.
+func (sla SpendLimitAuthenticator) Type() string {
+	return SpendLimitAuthenticatorType
+}
+
+// StaticGas returns the static gas amount for the authenticator. Currently, it's set to zero.
+func (sla SpendLimitAuthenticator) StaticGas() uint64 {
+	return 0
+}
+
+// Initialize parses and validates the spend limit params.
+func (sla SpendLimitAuthenticator) Initialize(data []byte) (Authenticator, error) {
+	params, err := parseSpendLimitParams(data)
+	if err != nil {
+		return nil, err
+	}
+	sla.params = params
+	return sla, nil
+}
+
+// Authenticate rejects the message if the limit for the current period has already been reached.
+func (sla SpendLimitAuthenticator) Authenticate(ctx sdk.Context, request AuthenticationRequest) error {
+	spending := sla.getSpending(ctx, request.Account, request.AuthenticatorId)
+	if spending.Spent.GTE(sla.params.Limit) {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "spend limit reached: spent %s, limit %s %s", spending.Spent, sla.params.Limit, sla.params.QuoteDenom)
+	}
+	return nil
+}
+
+// Track stores the balances of the account before the transaction is executed.
+func (sla SpendLimitAuthenticator) Track(ctx sdk.Context, request AuthenticationRequest) error {
+	balances := sla.bankKeeper.GetAllBalances(ctx, request.Account)
+	bz, err := json.Marshal(balances)
+	if err != nil {
+		return errorsmod.Wrap(err, "failed to marshal pre-execution balances")
+	}
+	ctx.KVStore(sla.storeKey).Set(types.KeySpendLimitPreExecBalances(request.Account, request.AuthenticatorId), bz)
+	return nil
+}
+
+// ConfirmExecution values the balances spent by the transaction and rejects it if the spending
+// for the current period exceeds the limit.
+func (sla SpendLimitAuthenticator) ConfirmExecution(ctx sdk.Context, request AuthenticationRequest) error {
+	store := ctx.KVStore(sla.storeKey)
+	balancesKey := types.KeySpendLimitPreExecBalances(request.Account, request.AuthenticatorId)
+	bz := store.Get(balancesKey)
+	if bz == nil {
+		// The balances of the transaction have already been accounted for by a previous message
+		// that used the same authenticator.
+		return nil
+	}
+	store.Delete(balancesKey)
+
+	var preExecBalances sdk.Coins
+	if err := json.Unmarshal(bz, &preExecBalances); err != nil {
+		return errorsmod.Wrap(err, "failed to unmarshal pre-execution balances")
+	}
+
+	postExecBalances := sla.bankKeeper.GetAllBalances(ctx, request.Account)
+	spent := osmomath.ZeroInt()
+	for _, preExecBalance := range preExecBalances {
+		postExecAmount := postExecBalances.AmountOf(preExecBalance.Denom)
+		if postExecAmount.GTE(preExecBalance.Amount) {
+			continue
+		}
+
+		value, err := sla.quoteValue(ctx, sdk.NewCoin(preExecBalance.Denom, preExecBalance.Amount.Sub(postExecAmount)))
+		if err != nil {
+			return err
+		}
+		spent = spent.Add(value)
+	}
+
+	spending := sla.getSpending(ctx, request.Account, request.AuthenticatorId)
+	if spent.IsZero() {
+		return nil
+	}
+
+	if sla.params.Period == SpendLimitPeriodRolling {
+		if len(spending.Entries) >= MaxSpendLimitRollingEntries {
+			return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "too many spendings within the rolling window (max %d)", MaxSpendLimitRollingEntries)
+		}
+		spending.Entries = append(spending.Entries, SpendLimitEntry{Time: ctx.BlockTime(), Amount: spent})
+	}
+	spending.Spent = spending.Spent.Add(spent)
+
+	if spending.Spent.GT(sla.params.Limit) {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "spend limit exceeded: remaining quota %s, requested %s %s", sla.params.Limit.Sub(spending.Spent.Sub(spent)), spent, sla.params.QuoteDenom)
+	}
+
+	return sla.setSpending(ctx, request.Account, request.AuthenticatorId, spending)
+}
+
+// OnAuthenticatorAdded validates the spend limit params.
+func (sla SpendLimitAuthenticator) OnAuthenticatorAdded(ctx sdk.Context, account sdk.AccAddress, data []byte, authenticatorId string) error {
+	_, err := parseSpendLimitParams(data)
+	return err
+}
+
+// OnAuthenticatorRemoved deletes the state kept for the account.
+func (sla SpendLimitAuthenticator) OnAuthenticatorRemoved(ctx sdk.Context, account sdk.AccAddress, data []byte, authenticatorId string) error {
+	store := ctx.KVStore(sla.storeKey)
+	store.Delete(types.KeySpendLimitPreExecBalances(account, authenticatorId))
+	store.Delete(types.KeySpendLimitSpending(account, authenticatorId))
+	return nil
+}
+
+// GetSpending returns the spending of the account for the current period.
+func (sla SpendLimitAuthenticator) GetSpending(ctx sdk.Context, account sdk.AccAddress, authenticatorId string) SpendLimitSpending {
+	return sla.getSpending(ctx, account, authenticatorId)
+}
+
+// getSpending returns the stored spending, reset or pruned according to the current block time.
+func (sla SpendLimitAuthenticator) getSpending(ctx sdk.Context, account sdk.AccAddress, authenticatorId string) SpendLimitSpending {
+	blockTime := ctx.BlockTime()
+	spending := SpendLimitSpending{Spent: osmomath.ZeroInt()}
+
+	bz := ctx.KVStore(sla.storeKey).Get(types.KeySpendLimitSpending(account, authenticatorId))
+	if bz != nil {
+		// if the spending can't be unmarshalled, we fall back to an empty spending
+		if err := json.Unmarshal(bz, &spending); err != nil || spending.Spent.IsNil() {
+			spending = SpendLimitSpending{Spent: osmomath.ZeroInt()}
+		}
+	}
+
+	switch sla.params.Period {
+	case SpendLimitPeriodRolling:
+		windowStart := blockTime.Add(-sla.params.RollingWindow)
+		entries := []SpendLimitEntry{}
+		spent := osmomath.ZeroInt()
+		for _, entry := range spending.Entries {
+			if entry.Time.After(windowStart) {
+				entries = append(entries, entry)
+				spent = spent.Add(entry.Amount)
+			}
+		}
+		spending.Entries = entries
+		spending.Spent = spent
+		spending.PeriodStart = windowStart
+	default:
+		periodStart := sla.params.Period.periodStart(blockTime)
+		if !spending.PeriodStart.Equal(periodStart) {
+			spending = SpendLimitSpending{PeriodStart: periodStart, Spent: osmomath.ZeroInt()}
+		}
+	}
+
+	return spending
+}
+
+func (sla SpendLimitAuthenticator) setSpending(ctx sdk.Context, account sdk.AccAddress, authenticatorId string, spending SpendLimitSpending) error {
+	bz, err := json.Marshal(spending)
+	if err != nil {
+		return errorsmod.Wrap(err, "failed to marshal spending")
+	}
+	ctx.KVStore(sla.storeKey).Set(types.KeySpendLimitSpending(account, authenticatorId), bz)
+	return nil
+}
+
+// quoteValue returns the value of the coin denominated in the quote denom, rounded up.
+func (sla SpendLimitAuthenticator) quoteValue(ctx sdk.Context, coin sdk.Coin) (osmomath.Int, error) {
+	if coin.Denom == sla.params.QuoteDenom {
+		return coin.Amount, nil
+	}
+
+	poolId, found := sla.params.priceSource(coin.Denom)
+	if !found {
+		return osmomath.Int{}, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "no price source for spent denom %s", coin.Denom)
+	}
+
+	var price osmomath.Dec
+	switch sla.params.PriceStrategy {
+	case SpendLimitPriceStrategySpot:
+		spotPrice, err := sla.poolManagerKeeper.RouteCalculateSpotPrice(ctx, poolId, sla.params.QuoteDenom, coin.Denom)
+		if err != nil {
+			return osmomath.Int{}, errorsmod.Wrapf(err, "failed to get spot price of %s in pool %d", coin.Denom, poolId)
+		}
+		price = spotPrice.Dec()
+	default:
+		startTime := ctx.BlockTime().Add(-sla.params.TwapWindow)
+		twapPrice, err := sla.twapKeeper.GetArithmeticTwapToNow(ctx, poolId, coin.Denom, sla.params.QuoteDenom, startTime)
+		if err != nil {
+			return osmomath.Int{}, errorsmod.Wrapf(err, "failed to get twap of %s in pool %d", coin.Denom, poolId)
+		}
+		price = twapPrice
+	}
+
+	return coin.Amount.ToLegacyDec().Mul(price).Ceil().TruncateInt(), nil
+}
+
+// periodStart returns the start of the fixed period that contains t.
+func (p SpendLimitPeriod) periodStart(t time.Time) time.Time {
+	switch p {
+	case SpendLimitPeriodWeek:
+		// the zero time is a Monday, so truncating aligns weeks to Mondays
+		return t.UTC().Truncate(7 * 24 * time.Hour)
+	default:
+		return t.UTC().Truncate(24 * time.Hour)
+	}
+}
+
+func (p SpendLimitParams) priceSource(denom string) (uint64, bool) {
+	for _, source := range p.PriceSources {
+		if source.Denom == denom {
+			return source.PoolId, true
+		}
+	}
+	return 0, false
+}
+
+// Validate performs stateless validation of the spend limit params.
+func (p SpendLimitParams) Validate() error {
+	if p.Limit.IsNil() || !p.Limit.IsPositive() {
+		return fmt.Errorf("limit must be positive")
+	}
+	if err := sdk.ValidateDenom(p.QuoteDenom); err != nil {
+		return errorsmod.Wrap(err, "invalid quote denom")
+	}
+
+	switch p.Period {
+	case SpendLimitPeriodDay, SpendLimitPeriodWeek:
+	case SpendLimitPeriodRolling:
+		if p.RollingWindow <= 0 {
+			return fmt.Errorf("rolling window must be positive for rolling periods")
+		}
+	default:
+		return fmt.Errorf("invalid period %s, must be one of %s, %s or %s", p.Period, SpendLimitPeriodDay, SpendLimitPeriodWeek, SpendLimitPeriodRolling)
+	}
+
+	switch p.PriceStrategy {
+	case SpendLimitPriceStrategyTwap, SpendLimitPriceStrategySpot:
+	default:
+		return fmt.Errorf("invalid price strategy %s, must be one of %s or %s", p.PriceStrategy, SpendLimitPriceStrategyTwap, SpendLimitPriceStrategySpot)
+	}
+
+	if p.TwapWindow < 0 {
+		return fmt.Errorf("twap window must not be negative")
+	}
+
+	seenDenoms := make(map[string]bool)
+	for _, source := range p.PriceSources {
+		if err := sdk.ValidateDenom(source.Denom); err != nil {
+			return errorsmod.Wrap(err, "invalid price source denom")
+		}
+		if source.Denom == p.QuoteDenom {
+			return fmt.Errorf("price source for quote denom %s is not allowed", p.QuoteDenom)
+		}
+		if seenDenoms[source.Denom] {
+			return fmt.Errorf("duplicate price source for denom %s", source.Denom)
+		}
+		if source.PoolId == 0 {
+			return fmt.Errorf("invalid pool id for denom %s", source.Denom)
+		}
+		seenDenoms[source.Denom] = true
+	}
+
+	return nil
+}
+
+func parseSpendLimitParams(data []byte) (SpendLimitParams, error) {
+	var params SpendLimitParams
+	if err := json.Unmarshal(data, &params); err != nil {
+		return SpendLimitParams{}, errorsmod.Wrap(err, "failed to parse spend limit params")
+	}
+
+	if params.PriceStrategy == "" {
+		params.PriceStrategy = SpendLimitPriceStrategyTwap
+	}
+	if params.TwapWindow == 0 {
+		params.TwapWindow = DefaultSpendLimitTwapWindow
+	}
+
+	if err := params.Validate(); err != nil {
+		return SpendLimitParams{}, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return params, nil
+}
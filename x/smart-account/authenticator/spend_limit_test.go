@@ -0,0 +1,248 @@
+package authenticator_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v24/x/gamm/pool-models/balancer"
+	"github.com/osmosis-labs/osmosis/v24/x/smart-account/authenticator"
+)
+
+type NativeSpendLimitAuthenticatorTest struct {
+	BaseAuthenticatorSuite
+
+	SpendLimitAuth authenticator.SpendLimitAuthenticator
+}
+
+func TestNativeSpendLimitAuthenticatorTest(t *testing.T) {
+	suite.Run(t, new(NativeSpendLimitAuthenticatorTest))
+}
+
+func (s *NativeSpendLimitAuthenticatorTest) SetupTest() {
+	s.SetupKeys()
+	s.Ctx = s.Ctx.WithGasMeter(sdk.NewGasMeter(10_000_000))
+	s.Ctx = s.Ctx.WithBlockTime(time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC))
+
+	auth := s.OsmosisApp.SmartAccountKeeper.AuthenticatorManager.GetAuthenticatorByType(authenticator.SpendLimitAuthenticatorType)
+	s.Require().NotNil(auth)
+	s.SpendLimitAuth = auth.(authenticator.SpendLimitAuthenticator)
+}
+
+func (s *NativeSpendLimitAuthenticatorTest) TestOnAuthenticatorAdded() {
+	tests := map[string]struct {
+		params      authenticator.SpendLimitParams
+		expectError bool
+	}{
+		"valid daily limit": {
+			params: authenticator.SpendLimitParams{Limit: osmomath.NewInt(100), QuoteDenom: UUSDC, Period: authenticator.SpendLimitPeriodDay},
+		},
+		"valid rolling limit with price sources": {
+			params: authenticator.SpendLimitParams{
+				Limit:         osmomath.NewInt(100),
+				QuoteDenom:    UUSDC,
+				Period:        authenticator.SpendLimitPeriodRolling,
+				RollingWindow: time.Hour,
+				PriceStrategy: authenticator.SpendLimitPriceStrategySpot,
+				PriceSources:  []authenticator.SpendLimitPriceSource{{Denom: "uosmo", PoolId: 1}},
+			},
+		},
+		"zero limit": {
+			params:      authenticator.SpendLimitParams{Limit: osmomath.ZeroInt(), QuoteDenom: UUSDC, Period: authenticator.SpendLimitPeriodDay},
+			expectError: true,
+		},
+		"invalid period": {
+			params:      authenticator.SpendLimitParams{Limit: osmomath.NewInt(100), QuoteDenom: UUSDC, Period: "month"},
+			expectError: true,
+		},
+		"rolling period without window": {
+			params:      authenticator.SpendLimitParams{Limit: osmomath.NewInt(100), QuoteDenom: UUSDC, Period: authenticator.SpendLimitPeriodRolling},
+			expectError: true,
+		},
+		"invalid price strategy": {
+			params:      authenticator.SpendLimitParams{Limit: osmomath.NewInt(100), QuoteDenom: UUSDC, Period: authenticator.SpendLimitPeriodDay, PriceStrategy: "oracle"},
+			expectError: true,
+		},
+		"duplicate price source": {
+			params: authenticator.SpendLimitParams{
+				Limit:        osmomath.NewInt(100),
+				QuoteDenom:   UUSDC,
+				Period:       authenticator.SpendLimitPeriodDay,
+				PriceSources: []authenticator.SpendLimitPriceSource{{Denom: "uosmo", PoolId: 1}, {Denom: "uosmo", PoolId: 2}},
+			},
+			expectError: true,
+		},
+		"price source for quote denom": {
+			params: authenticator.SpendLimitParams{
+				Limit:        osmomath.NewInt(100),
+				QuoteDenom:   UUSDC,
+				Period:       authenticator.SpendLimitPeriodDay,
+				PriceSources: []authenticator.SpendLimitPriceSource{{Denom: UUSDC, PoolId: 1}},
+			},
+			expectError: true,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			bz, err := json.Marshal(tc.params)
+			s.Require().NoError(err)
+
+			err = s.SpendLimitAuth.OnAuthenticatorAdded(s.Ctx, s.TestAccAddress[0], bz, "1")
+			if tc.expectError {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+		})
+	}
+}
+
+func (s *NativeSpendLimitAuthenticatorTest) TestDailyLimitInQuoteDenom() {
+	account := s.TestAccAddress[0]
+	s.FundAcc(account, sdk.NewCoins(sdk.NewInt64Coin(UUSDC, 1_000)))
+
+	auth := s.initialize(authenticator.SpendLimitParams{Limit: osmomath.NewInt(100), QuoteDenom: UUSDC, Period: authenticator.SpendLimitPeriodDay})
+	request := authenticator.AuthenticationRequest{AuthenticatorId: "1", Account: account}
+
+	// spend within the limit
+	s.Require().NoError(s.spend(auth, request, sdk.NewInt64Coin(UUSDC, 60)))
+	s.Require().Equal(osmomath.NewInt(60), auth.GetSpending(s.Ctx, account, "1").Spent)
+
+	// receiving tokens does not count as spending
+	s.Require().NoError(auth.Track(s.Ctx, request))
+	s.FundAcc(account, sdk.NewCoins(sdk.NewInt64Coin(UUSDC, 500)))
+	s.Require().NoError(auth.ConfirmExecution(s.Ctx, request))
+	s.Require().Equal(osmomath.NewInt(60), auth.GetSpending(s.Ctx, account, "1").Spent)
+
+	// spend over the limit
+	err := s.spend(auth, request, sdk.NewInt64Coin(UUSDC, 41))
+	s.Require().ErrorContains(err, "spend limit exceeded: remaining quota 40")
+
+	// spend exactly the remaining quota, after which authentication is blocked
+	s.Require().NoError(s.spend(auth, request, sdk.NewInt64Coin(UUSDC, 40)))
+	s.Require().ErrorContains(auth.Authenticate(s.Ctx, request), "spend limit reached")
+
+	// the limit resets on the next day
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(12 * time.Hour))
+	s.Require().NoError(auth.Authenticate(s.Ctx, request))
+	s.Require().NoError(s.spend(auth, request, sdk.NewInt64Coin(UUSDC, 100)))
+}
+
+func (s *NativeSpendLimitAuthenticatorTest) TestRollingLimit() {
+	account := s.TestAccAddress[0]
+	s.FundAcc(account, sdk.NewCoins(sdk.NewInt64Coin(UUSDC, 1_000)))
+
+	auth := s.initialize(authenticator.SpendLimitParams{
+		Limit:         osmomath.NewInt(100),
+		QuoteDenom:    UUSDC,
+		Period:        authenticator.SpendLimitPeriodRolling,
+		RollingWindow: time.Hour,
+	})
+	request := authenticator.AuthenticationRequest{AuthenticatorId: "1", Account: account}
+
+	s.Require().NoError(s.spend(auth, request, sdk.NewInt64Coin(UUSDC, 70)))
+
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(30 * time.Minute))
+	s.Require().NoError(s.spend(auth, request, sdk.NewInt64Coin(UUSDC, 30)))
+	s.Require().Error(s.spend(auth, request, sdk.NewInt64Coin(UUSDC, 1)))
+
+	// the first spending leaves the window
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(31 * time.Minute))
+	s.Require().Equal(osmomath.NewInt(30), auth.GetSpending(s.Ctx, account, "1").Spent)
+	s.Require().NoError(s.spend(auth, request, sdk.NewInt64Coin(UUSDC, 70)))
+	s.Require().Error(s.spend(auth, request, sdk.NewInt64Coin(UUSDC, 1)))
+}
+
+func (s *NativeSpendLimitAuthenticatorTest) TestSpendingValuedWithSpotPrice() {
+	account := s.TestAccAddress[0]
+	s.FundAcc(account, sdk.NewCoins(sdk.NewInt64Coin("uosmo", 1_000), sdk.NewInt64Coin("uion", 1_000)))
+
+	// 1 uosmo = 2 uusdc
+	poolId := s.preparePool([]balancer.PoolAsset{
+		{Weight: osmomath.NewInt(1), Token: sdk.NewInt64Coin(UUSDC, 2_000_000)},
+		{Weight: osmomath.NewInt(1), Token: sdk.NewInt64Coin("uosmo", 1_000_000)},
+	})
+
+	auth := s.initialize(authenticator.SpendLimitParams{
+		Limit:         osmomath.NewInt(100),
+		QuoteDenom:    UUSDC,
+		Period:        authenticator.SpendLimitPeriodDay,
+		PriceStrategy: authenticator.SpendLimitPriceStrategySpot,
+		PriceSources:  []authenticator.SpendLimitPriceSource{{Denom: "uosmo", PoolId: poolId}},
+	})
+	request := authenticator.AuthenticationRequest{AuthenticatorId: "1", Account: account}
+
+	s.Require().NoError(s.spend(auth, request, sdk.NewInt64Coin("uosmo", 30)))
+	s.Require().Equal(osmomath.NewInt(60), auth.GetSpending(s.Ctx, account, "1").Spent)
+
+	err := s.spend(auth, request, sdk.NewInt64Coin("uosmo", 21))
+	s.Require().ErrorContains(err, "spend limit exceeded")
+
+	// denoms without a price source can't be spent
+	err = s.spend(auth, request, sdk.NewInt64Coin("uion", 1))
+	s.Require().ErrorContains(err, "no price source for spent denom uion")
+}
+
+func (s *NativeSpendLimitAuthenticatorTest) TestOnAuthenticatorRemoved() {
+	account := s.TestAccAddress[0]
+	s.FundAcc(account, sdk.NewCoins(sdk.NewInt64Coin(UUSDC, 1_000)))
+
+	params := authenticator.SpendLimitParams{Limit: osmomath.NewInt(100), QuoteDenom: UUSDC, Period: authenticator.SpendLimitPeriodDay}
+	auth := s.initialize(params)
+	request := authenticator.AuthenticationRequest{AuthenticatorId: "1", Account: account}
+	s.Require().NoError(s.spend(auth, request, sdk.NewInt64Coin(UUSDC, 60)))
+
+	bz, err := json.Marshal(params)
+	s.Require().NoError(err)
+	s.Require().NoError(auth.OnAuthenticatorRemoved(s.Ctx, account, bz, "1"))
+	s.Require().True(auth.GetSpending(s.Ctx, account, "1").Spent.IsZero())
+}
+
+func (s *NativeSpendLimitAuthenticatorTest) initialize(params authenticator.SpendLimitParams) authenticator.SpendLimitAuthenticator {
+	bz, err := json.Marshal(params)
+	s.Require().NoError(err)
+	auth, err := s.SpendLimitAuth.Initialize(bz)
+	s.Require().NoError(err)
+	return auth.(authenticator.SpendLimitAuthenticator)
+}
+
+// spend runs the authenticator hooks around a transfer of coin out of the request account.
+func (s *NativeSpendLimitAuthenticatorTest) spend(auth authenticator.Authenticator, request authenticator.AuthenticationRequest, coin sdk.Coin) error {
+	cacheCtx, write := s.Ctx.CacheContext()
+	if err := auth.Track(cacheCtx, request); err != nil {
+		return err
+	}
+	err := s.OsmosisApp.BankKeeper.SendCoins(cacheCtx, request.Account, s.TestAccAddress[2], sdk.NewCoins(coin))
+	s.Require().NoError(err)
+	if err := auth.ConfirmExecution(cacheCtx, request); err != nil {
+		return err
+	}
+	write()
+	return nil
+}
+
+func (s *NativeSpendLimitAuthenticatorTest) preparePool(poolAssets []balancer.PoolAsset) uint64 {
+	poolCreator := s.TestAccAddress[1]
+
+	s.FundAcc(poolCreator, s.OsmosisApp.PoolManagerKeeper.GetParams(s.Ctx).PoolCreationFee)
+	for _, asset := range poolAssets {
+		s.FundAcc(poolCreator, sdk.NewCoins(asset.Token))
+	}
+
+	poolParams := balancer.PoolParams{
+		SwapFee: osmomath.ZeroDec(),
+		ExitFee: osmomath.ZeroDec(),
+	}
+
+	poolId, err := s.OsmosisApp.PoolManagerKeeper.CreatePool(
+		s.Ctx,
+		balancer.NewMsgCreateBalancerPool(poolCreator, poolParams, poolAssets, ""),
+	)
+	s.Require().NoError(err)
+	return poolId
+}
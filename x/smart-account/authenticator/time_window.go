@@ -0,0 +1,230 @@
+package authenticator
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	gogotypes "github.com/gogo/protobuf/types"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v24/x/smart-account/types"
+)
+
+var (
+	_ Authenticator          = &TimeWindowAuthenticator{}
+	_ CompositeAuthenticator = &TimeWindowAuthenticator{}
+)
+
+// TimeWindowAuthenticatorType is the type of the time window authenticator.
+const TimeWindowAuthenticatorType = "TimeWindowAuthenticator"
+
+// TimeWindowAuthenticatorInitData is the data used to add a TimeWindowAuthenticator to an account.
+type TimeWindowAuthenticatorInitData struct {
+	// NotBefore is the first block time at which the authenticator can be used. Optional.
+	NotBefore *time.Time `json:"not_before,omitempty"`
+	// NotAfter is the last block time at which the authenticator can be used. Optional.
+	NotAfter *time.Time `json:"not_after,omitempty"`
+	// MaxUses is the maximum amount of messages the authenticator can authenticate. Zero means unlimited.
+	MaxUses uint64 `json:"max_uses,omitempty"`
+	// Authenticator is the wrapped sub-authenticator.
+	Authenticator SubAuthenticatorInitData `json:"authenticator"`
+}
+
+// TimeWindowAuthenticator wraps a sub-authenticator and only lets it authenticate messages
+// between NotBefore and NotAfter, up to MaxUses times. Once expired or out of uses, it becomes
+// permanently inert and can only be removed.
+type TimeWindowAuthenticator struct {
+	SubAuthenticator Authenticator
+	am               *AuthenticatorManager
+	storeKey         storetypes.StoreKey
+
+	notBefore *time.Time
+	notAfter  *time.Time
+	maxUses   uint64
+}
+
+// NewTimeWindowAuthenticator creates a new TimeWindowAuthenticator.
+func NewTimeWindowAuthenticator(am *AuthenticatorManager, storeKey storetypes.StoreKey) TimeWindowAuthenticator {
+	return TimeWindowAuthenticator{
+		am:       am,
+		storeKey: storeKey,
+	}
+}
+
+// Type returns the type of the authenticator.
+func (twa TimeWindowAuthenticator) Type() string {
+	return TimeWindowAuthenticatorType
+}
+
+// StaticGas returns the static gas of the sub-authenticator.
+func (twa TimeWindowAuthenticator) StaticGas() uint64 {
+	if twa.SubAuthenticator == nil {
+		return 0
+	}
+	return twa.SubAuthenticator.StaticGas()
+}
+
+// Initialize parses the time window and initializes the sub-authenticator.
+func (twa TimeWindowAuthenticator) Initialize(data []byte) (Authenticator, error) {
+	initData, err := parseTimeWindowInitData(data)
+	if err != nil {
+		return nil, err
+	}
+
+	authenticatorCode := twa.am.GetAuthenticatorByType(initData.Authenticator.AuthenticatorType)
+	if authenticatorCode == nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "sub-authenticator type %s is not registered", initData.Authenticator.AuthenticatorType)
+	}
+	instance, err := authenticatorCode.Initialize(initData.Authenticator.Data)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to initialize sub-authenticator (type = %s)", initData.Authenticator.AuthenticatorType)
+	}
+
+	twa.SubAuthenticator = instance
+	twa.notBefore = initData.NotBefore
+	twa.notAfter = initData.NotAfter
+	twa.maxUses = initData.MaxUses
+	return twa, nil
+}
+
+// Authenticate checks that the block time is within the time window and that the authenticator
+// has uses left before delegating to the sub-authenticator.
+func (twa TimeWindowAuthenticator) Authenticate(ctx sdk.Context, request AuthenticationRequest) error {
+	if err := twa.checkActive(ctx, request.Account, request.AuthenticatorId); err != nil {
+		return err
+	}
+
+	request.AuthenticatorId = compositeId(request.AuthenticatorId, 0)
+	return twa.SubAuthenticator.Authenticate(ctx, request)
+}
+
+// Track counts the use of the authenticator and delegates to the sub-authenticator.
+func (twa TimeWindowAuthenticator) Track(ctx sdk.Context, request AuthenticationRequest) error {
+	if twa.maxUses > 0 {
+		uses := twa.GetUses(ctx, request.Account, request.AuthenticatorId)
+		if uses >= twa.maxUses {
+			return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "authenticator has been used the maximum amount of times (%d)", twa.maxUses)
+		}
+		twa.setUses(ctx, request.Account, request.AuthenticatorId, uses+1)
+	}
+
+	return subTrack(ctx, request, []Authenticator{twa.SubAuthenticator})
+}
+
+// ConfirmExecution delegates to the sub-authenticator.
+func (twa TimeWindowAuthenticator) ConfirmExecution(ctx sdk.Context, request AuthenticationRequest) error {
+	request.AuthenticatorId = compositeId(request.AuthenticatorId, 0)
+	return twa.SubAuthenticator.ConfirmExecution(ctx, request)
+}
+
+// OnAuthenticatorAdded validates the time window and delegates to the sub-authenticator.
+func (twa TimeWindowAuthenticator) OnAuthenticatorAdded(ctx sdk.Context, account sdk.AccAddress, data []byte, authenticatorId string) error {
+	initData, err := parseTimeWindowInitData(data)
+	if err != nil {
+		return err
+	}
+
+	if initData.NotAfter != nil && !initData.NotAfter.After(ctx.BlockTime()) {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "not_after (%s) must be after the current block time (%s)", initData.NotAfter, ctx.BlockTime())
+	}
+
+	authenticatorCode := twa.am.GetAuthenticatorByType(initData.Authenticator.AuthenticatorType)
+	if authenticatorCode == nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "sub-authenticator type %s is not registered", initData.Authenticator.AuthenticatorType)
+	}
+
+	subId := compositeId(authenticatorId, 0)
+	if err := authenticatorCode.OnAuthenticatorAdded(ctx, account, initData.Authenticator.Data, subId); err != nil {
+		return errorsmod.Wrapf(err, "sub-authenticator `OnAuthenticatorAdded` failed (sub-authenticator id = %s)", subId)
+	}
+	return nil
+}
+
+// OnAuthenticatorRemoved deletes the uses kept for the account and delegates to the sub-authenticator.
+func (twa TimeWindowAuthenticator) OnAuthenticatorRemoved(ctx sdk.Context, account sdk.AccAddress, data []byte, authenticatorId string) error {
+	var initData TimeWindowAuthenticatorInitData
+	if err := json.Unmarshal(data, &initData); err != nil {
+		return err
+	}
+
+	ctx.KVStore(twa.storeKey).Delete(types.KeyTimeWindowUses(account, authenticatorId))
+
+	authenticatorCode := twa.am.GetAuthenticatorByType(initData.Authenticator.AuthenticatorType)
+	if authenticatorCode == nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "sub-authenticator type %s is not registered", initData.Authenticator.AuthenticatorType)
+	}
+
+	subId := compositeId(authenticatorId, 0)
+	if err := authenticatorCode.OnAuthenticatorRemoved(ctx, account, initData.Authenticator.Data, subId); err != nil {
+		return errorsmod.Wrapf(err, "sub-authenticator `OnAuthenticatorRemoved` failed (sub-authenticator id = %s)", subId)
+	}
+	return nil
+}
+
+// SubAuthenticatorRequests returns the wrapped sub-authenticator and its request.
+func (twa TimeWindowAuthenticator) SubAuthenticatorRequests(request AuthenticationRequest) ([]Authenticator, []AuthenticationRequest, error) {
+	request.AuthenticatorId = compositeId(request.AuthenticatorId, 0)
+	return []Authenticator{twa.SubAuthenticator}, []AuthenticationRequest{request}, nil
+}
+
+// IsExpired returns true if the authenticator can no longer authenticate messages, either because
+// the time window has passed or because it ran out of uses.
+func (twa TimeWindowAuthenticator) IsExpired(ctx sdk.Context, account sdk.AccAddress, authenticatorId string) bool {
+	if twa.notAfter != nil && ctx.BlockTime().After(*twa.notAfter) {
+		return true
+	}
+	return twa.maxUses > 0 && twa.GetUses(ctx, account, authenticatorId) >= twa.maxUses
+}
+
+// GetUses returns the amount of messages the authenticator has authenticated for the account.
+func (twa TimeWindowAuthenticator) GetUses(ctx sdk.Context, account sdk.AccAddress, authenticatorId string) uint64 {
+	uses := gogotypes.UInt64Value{}
+	found, err := osmoutils.Get(ctx.KVStore(twa.storeKey), types.KeyTimeWindowUses(account, authenticatorId), &uses)
+	if err != nil || !found {
+		return 0
+	}
+	return uses.Value
+}
+
+func (twa TimeWindowAuthenticator) setUses(ctx sdk.Context, account sdk.AccAddress, authenticatorId string, uses uint64) {
+	osmoutils.MustSet(ctx.KVStore(twa.storeKey), types.KeyTimeWindowUses(account, authenticatorId), &gogotypes.UInt64Value{Value: uses})
+}
+
+func (twa TimeWindowAuthenticator) checkActive(ctx sdk.Context, account sdk.AccAddress, authenticatorId string) error {
+	blockTime := ctx.BlockTime()
+	if twa.notBefore != nil && blockTime.Before(*twa.notBefore) {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "authenticator is not active before %s (block time %s)", twa.notBefore, blockTime)
+	}
+	if twa.notAfter != nil && blockTime.After(*twa.notAfter) {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "authenticator expired at %s (block time %s)", twa.notAfter, blockTime)
+	}
+	if twa.maxUses > 0 && twa.GetUses(ctx, account, authenticatorId) >= twa.maxUses {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "authenticator has been used the maximum amount of times (%d)", twa.maxUses)
+	}
+	return nil
+}
+
+func parseTimeWindowInitData(data []byte) (TimeWindowAuthenticatorInitData, error) {
+	var initData TimeWindowAuthenticatorInitData
+	if err := json.Unmarshal(data, &initData); err != nil {
+		return TimeWindowAuthenticatorInitData{}, errorsmod.Wrap(err, "failed to parse time window authenticator data")
+	}
+
+	if initData.NotBefore == nil && initData.NotAfter == nil && initData.MaxUses == 0 {
+		return TimeWindowAuthenticatorInitData{}, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "at least one of not_before, not_after or max_uses must be set")
+	}
+	if initData.NotBefore != nil && initData.NotAfter != nil && !initData.NotAfter.After(*initData.NotBefore) {
+		return TimeWindowAuthenticatorInitData{}, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("not_after (%s) must be after not_before (%s)", initData.NotAfter, initData.NotBefore))
+	}
+	if initData.Authenticator.AuthenticatorType == "" {
+		return TimeWindowAuthenticatorInitData{}, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "no sub-authenticator provided")
+	}
+
+	return initData, nil
+}
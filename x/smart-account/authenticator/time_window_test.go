@@ -0,0 +1,200 @@
+package authenticator_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/osmosis-labs/osmosis/v24/x/smart-account/authenticator"
+	"github.com/osmosis-labs/osmosis/v24/x/smart-account/testutils"
+	smartaccounttypes "github.com/osmosis-labs/osmosis/v24/x/smart-account/types"
+)
+
+type TimeWindowAuthenticatorTest struct {
+	BaseAuthenticatorSuite
+
+	TimeWindowAuth authenticator.TimeWindowAuthenticator
+	alwaysApprove  testutils.TestingAuthenticator
+	neverApprove   testutils.TestingAuthenticator
+	spyAuth        testutils.SpyAuthenticator
+}
+
+func TestTimeWindowAuthenticatorTest(t *testing.T) {
+	suite.Run(t, new(TimeWindowAuthenticatorTest))
+}
+
+func (s *TimeWindowAuthenticatorTest) SetupTest() {
+	s.SetupKeys()
+	s.Ctx = s.Ctx.WithBlockTime(time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC))
+
+	am := authenticator.NewAuthenticatorManager()
+	storeKey := s.OsmosisApp.GetKVStoreKey()[smartaccounttypes.StoreKey]
+
+	s.TimeWindowAuth = authenticator.NewTimeWindowAuthenticator(am, storeKey)
+	s.alwaysApprove = testutils.TestingAuthenticator{Approve: testutils.Always, GasConsumption: 10, Confirm: testutils.Always}
+	s.neverApprove = testutils.TestingAuthenticator{Approve: testutils.Never, GasConsumption: 10, Confirm: testutils.Never}
+	s.spyAuth = testutils.NewSpyAuthenticator(storeKey)
+
+	am.RegisterAuthenticator(s.TimeWindowAuth)
+	am.RegisterAuthenticator(s.alwaysApprove)
+	am.RegisterAuthenticator(s.neverApprove)
+	am.RegisterAuthenticator(s.spyAuth)
+}
+
+func (s *TimeWindowAuthenticatorTest) TestOnAuthenticatorAdded() {
+	blockTime := s.Ctx.BlockTime()
+	past := blockTime.Add(-time.Hour)
+	future := blockTime.Add(time.Hour)
+
+	tests := map[string]struct {
+		initData    authenticator.TimeWindowAuthenticatorInitData
+		expectError bool
+	}{
+		"valid time window": {
+			initData: s.initData(&past, &future, 0, s.alwaysApprove.Type(), nil),
+		},
+		"valid max uses": {
+			initData: s.initData(nil, nil, 3, s.alwaysApprove.Type(), nil),
+		},
+		"no restriction": {
+			initData:    s.initData(nil, nil, 0, s.alwaysApprove.Type(), nil),
+			expectError: true,
+		},
+		"not_after before not_before": {
+			initData:    s.initData(&future, &past, 0, s.alwaysApprove.Type(), nil),
+			expectError: true,
+		},
+		"already expired": {
+			initData:    s.initData(nil, &past, 0, s.alwaysApprove.Type(), nil),
+			expectError: true,
+		},
+		"unregistered sub-authenticator": {
+			initData:    s.initData(nil, &future, 0, "Unknown", nil),
+			expectError: true,
+		},
+		"missing sub-authenticator": {
+			initData:    s.initData(nil, &future, 0, "", nil),
+			expectError: true,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			bz, err := json.Marshal(tc.initData)
+			s.Require().NoError(err)
+
+			err = s.TimeWindowAuth.OnAuthenticatorAdded(s.Ctx, s.TestAccAddress[0], bz, "1")
+			if tc.expectError {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+		})
+	}
+}
+
+func (s *TimeWindowAuthenticatorTest) TestTimeWindow() {
+	blockTime := s.Ctx.BlockTime()
+	notBefore := blockTime.Add(time.Hour)
+	notAfter := blockTime.Add(2 * time.Hour)
+
+	auth := s.initialize(s.initData(&notBefore, &notAfter, 0, s.alwaysApprove.Type(), nil))
+	request := authenticator.AuthenticationRequest{AuthenticatorId: "1", Account: s.TestAccAddress[0]}
+
+	err := auth.Authenticate(s.Ctx, request)
+	s.Require().ErrorContains(err, "authenticator is not active before")
+
+	s.Ctx = s.Ctx.WithBlockTime(notBefore)
+	s.Require().NoError(auth.Authenticate(s.Ctx, request))
+
+	s.Ctx = s.Ctx.WithBlockTime(notAfter)
+	s.Require().NoError(auth.Authenticate(s.Ctx, request))
+	s.Require().False(auth.IsExpired(s.Ctx, request.Account, request.AuthenticatorId))
+
+	s.Ctx = s.Ctx.WithBlockTime(notAfter.Add(time.Nanosecond))
+	err = auth.Authenticate(s.Ctx, request)
+	s.Require().ErrorContains(err, "authenticator expired")
+	s.Require().True(auth.IsExpired(s.Ctx, request.Account, request.AuthenticatorId))
+
+	// the sub-authenticator still decides within the window
+	s.Ctx = s.Ctx.WithBlockTime(notBefore)
+	auth = s.initialize(s.initData(&notBefore, &notAfter, 0, s.neverApprove.Type(), nil))
+	s.Require().Error(auth.Authenticate(s.Ctx, request))
+}
+
+func (s *TimeWindowAuthenticatorTest) TestMaxUses() {
+	auth := s.initialize(s.initData(nil, nil, 2, s.alwaysApprove.Type(), nil))
+	request := authenticator.AuthenticationRequest{AuthenticatorId: "1", Account: s.TestAccAddress[0]}
+	otherAccountRequest := authenticator.AuthenticationRequest{AuthenticatorId: "1", Account: s.TestAccAddress[1]}
+
+	for i := 0; i < 2; i++ {
+		s.Require().NoError(auth.Authenticate(s.Ctx, request))
+		s.Require().NoError(auth.Track(s.Ctx, request))
+	}
+	s.Require().Equal(uint64(2), auth.GetUses(s.Ctx, request.Account, request.AuthenticatorId))
+	s.Require().True(auth.IsExpired(s.Ctx, request.Account, request.AuthenticatorId))
+
+	err := auth.Authenticate(s.Ctx, request)
+	s.Require().ErrorContains(err, "maximum amount of times")
+	err = auth.Track(s.Ctx, request)
+	s.Require().ErrorContains(err, "maximum amount of times")
+
+	// uses are tracked per account
+	s.Require().NoError(auth.Authenticate(s.Ctx, otherAccountRequest))
+
+	// removing the authenticator clears the uses
+	bz, err := json.Marshal(s.initData(nil, nil, 2, s.alwaysApprove.Type(), nil))
+	s.Require().NoError(err)
+	s.Require().NoError(auth.OnAuthenticatorRemoved(s.Ctx, request.Account, bz, request.AuthenticatorId))
+	s.Require().Equal(uint64(0), auth.GetUses(s.Ctx, request.Account, request.AuthenticatorId))
+}
+
+func (s *TimeWindowAuthenticatorTest) TestSubAuthenticatorCalls() {
+	notAfter := s.Ctx.BlockTime().Add(time.Hour)
+	spyData, err := json.Marshal(testutils.SpyAuthenticatorData{Name: "spy"})
+	s.Require().NoError(err)
+
+	initData := s.initData(nil, &notAfter, 0, s.spyAuth.Type(), spyData)
+	bz, err := json.Marshal(initData)
+	s.Require().NoError(err)
+
+	account := s.TestAccAddress[0]
+	s.Require().NoError(s.TimeWindowAuth.OnAuthenticatorAdded(s.Ctx, account, bz, "7"))
+
+	auth := s.initialize(initData)
+	request := authenticator.AuthenticationRequest{AuthenticatorId: "7", Account: account}
+	s.Require().NoError(auth.Authenticate(s.Ctx, request))
+	s.Require().NoError(auth.Track(s.Ctx, request))
+	s.Require().NoError(auth.ConfirmExecution(s.Ctx, request))
+	s.Require().NoError(s.TimeWindowAuth.OnAuthenticatorRemoved(s.Ctx, account, bz, "7"))
+
+	spy := auth.SubAuthenticator.(testutils.SpyAuthenticator)
+	calls := spy.GetLatestCalls(s.Ctx)
+	s.Require().Equal("7.0", calls.OnAuthenticatorAdded.AuthenticatorId)
+	s.Require().Equal("7.0", calls.Authenticate.AuthenticatorId)
+	s.Require().Equal("7.0", calls.Track.AuthenticatorId)
+	s.Require().Equal("7.0", calls.ConfirmExecution.AuthenticatorId)
+	s.Require().Equal("7.0", calls.OnAuthenticatorRemoved.AuthenticatorId)
+}
+
+func (s *TimeWindowAuthenticatorTest) initData(notBefore, notAfter *time.Time, maxUses uint64, subType string, subData []byte) authenticator.TimeWindowAuthenticatorInitData {
+	return authenticator.TimeWindowAuthenticatorInitData{
+		NotBefore: notBefore,
+		NotAfter:  notAfter,
+		MaxUses:   maxUses,
+		Authenticator: authenticator.SubAuthenticatorInitData{
+			AuthenticatorType: subType,
+			Data:              subData,
+		},
+	}
+}
+
+func (s *TimeWindowAuthenticatorTest) initialize(initData authenticator.TimeWindowAuthenticatorInitData) authenticator.TimeWindowAuthenticator {
+	bz, err := json.Marshal(initData)
+	s.Require().NoError(err)
+	auth, err := s.TimeWindowAuth.Initialize(bz)
+	s.Require().NoError(err)
+	return auth.(authenticator.TimeWindowAuthenticator)
+}
@@ -1,6 +1,12 @@
 package cli
 
 import (
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
 	"github.com/spf13/cobra"
 
 	"github.com/osmosis-labs/osmosis/osmoutils/osmocli"
@@ -11,6 +17,7 @@ func GetQueryCmd() *cobra.Command {
 	cmd := osmocli.QueryIndexCmd(types.ModuleName)
 	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdAuthenticators)
 	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdParams)
+	cmd.AddCommand(GetCmdDryRunAuthentication())
 
 	return cmd
 }
@@ -32,3 +39,56 @@ func GetCmdParams() (*osmocli.QueryDescriptor, *types.QueryParamsRequest) {
 {{.CommandPrefix}} params`,
 	}, &types.QueryParamsRequest{}
 }
+
+// GetCmdDryRunAuthentication implements a command to dry-run the authenticators of a transaction.
+func GetCmdDryRunAuthentication() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dry-run-authentication [tx-file] [authenticator-id]",
+		Short: "Dry-run the authenticators selected for a signed or unsigned transaction",
+		Args:  cobra.RangeArgs(1, 2),
+		Long: strings.TrimSpace(`Run Authenticate, Track and ConfirmExecution for every message of a transaction
+without committing any state, and print the result of every sub-authenticator.
+If no authenticator id is provided, the authenticators selected in the transaction extension are used.
+
+$ <appd> query smartaccount dry-run-authentication tx.json 5
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			tx, err := authclient.ReadTxFromFile(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+			txBytes, err := clientCtx.TxConfig.TxEncoder()(tx)
+			if err != nil {
+				return err
+			}
+
+			var selectedAuthenticator uint64
+			if len(args) == 2 {
+				selectedAuthenticator, err = strconv.ParseUint(args[1], 10, 64)
+				if err != nil {
+					return err
+				}
+			}
+
+			res, err := queryClient.DryRunAuthentication(cmd.Context(), &types.DryRunAuthenticationRequest{
+				TxBytes:               txBytes,
+				SelectedAuthenticator: selectedAuthenticator,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,357 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+
+	"github.com/osmosis-labs/osmosis/v24/x/smart-account/authenticator"
+	"github.com/osmosis-labs/osmosis/v24/x/smart-account/types"
+)
+
+// MaxDryRunGas is the maximum amount of gas a dry run can consume. It is also used when the
+// transaction does not specify a gas limit.
+const MaxDryRunGas = 50_000_000
+
+// authenticatorCall is one of the authenticator hooks called while processing a transaction.
+type authenticatorCall func(a authenticator.Authenticator, ctx sdk.Context, request authenticator.AuthenticationRequest) error
+
+// DryRunTx runs the same authentication flow as the smart account ante and post
+// handlers on a transaction, without committing any state. Authenticate, Track, the message
+// execution and ConfirmExecution are run in a cached context, and the result of every
+// sub-authenticator is reported.
+//
+// Fees are not deducted and account sequences are not incremented. If the transaction has no
+// signatures, empty signatures are added and the authenticators are run in simulation mode.
+func (k Keeper) DryRunTx(
+	ctx sdk.Context,
+	tx sdk.Tx,
+	selectedAuthenticator uint64,
+) (response *types.DryRunAuthenticationResponse, err error) {
+	msgs := tx.GetMsgs()
+	if len(msgs) == 0 {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "no messages in transaction")
+	}
+
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return nil, errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	sigTx, ok := tx.(authsigning.Tx)
+	if !ok {
+		return nil, errorsmod.Wrap(sdkerrors.ErrTxDecode, "invalid transaction type")
+	}
+	signatures, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return nil, err
+	}
+
+	simulate := len(signatures) == 0
+	if simulate {
+		tx, err = k.withEmptySignatures(ctx, sigTx)
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "failed to add empty signatures")
+		}
+	}
+
+	selectedAuthenticators, err := k.getDryRunSelectedAuthenticators(tx, len(msgs), selectedAuthenticator)
+	if err != nil {
+		return nil, err
+	}
+
+	gasLimit := feeTx.GetGas()
+	if gasLimit == 0 || gasLimit > MaxDryRunGas {
+		gasLimit = MaxDryRunGas
+	}
+	gasMeter := sdk.NewGasMeter(gasLimit)
+
+	// Recover from any OutOfGas panic to return an error with the gas limit used for the dry run
+	defer func() {
+		if r := recover(); r != nil {
+			switch r.(type) {
+			case sdk.ErrorOutOfGas:
+				response = nil
+				err = errorsmod.Wrapf(sdkerrors.ErrOutOfGas, "dry run exceeded the gas limit of %d", gasLimit)
+			default:
+				panic(r)
+			}
+		}
+	}()
+
+	// None of the state changes made by the dry run are ever written
+	ctx, _ = ctx.CacheContext()
+	ctx = ctx.WithGasMeter(gasMeter)
+
+	response = &types.DryRunAuthenticationResponse{
+		Results: make([]types.MessageAuthenticationResult, len(msgs)),
+	}
+	authenticators := make([]authenticator.InitializedAuthenticator, len(msgs))
+	requests := make([]authenticator.AuthenticationRequest, len(msgs))
+
+	// Authenticate the accounts of all messages, as done by the AuthenticatorDecorator
+	authenticated := true
+	for msgIndex, msg := range msgs {
+		result := &response.Results[msgIndex]
+		result.MsgIndex = uint64(msgIndex)
+		result.MsgTypeUrl = sdk.MsgTypeURL(msg)
+		result.AuthenticatorId = selectedAuthenticators[msgIndex]
+
+		signers := msg.GetSigners()
+		if len(signers) != 1 {
+			result.Error = "messages must have exactly one signer"
+			authenticated = false
+			continue
+		}
+		account := signers[0]
+		result.Account = account.String()
+
+		selected, err := k.GetInitializedAuthenticatorForAccount(ctx, account, int(selectedAuthenticators[msgIndex]))
+		if err != nil {
+			result.Error = errorsmod.Wrap(err, "failed to get initialized authenticator").Error()
+			authenticated = false
+			continue
+		}
+
+		request, err := authenticator.GenerateAuthenticationRequest(
+			ctx,
+			k.accountKeeper,
+			k.txConfig.SignModeHandler(),
+			account,
+			feeTx.FeePayer(),
+			feeTx.FeeGranter(),
+			feeTx.GetFee(),
+			msg,
+			tx,
+			msgIndex,
+			simulate,
+			authenticator.SequenceMatch,
+		)
+		if err != nil {
+			result.Error = errorsmod.Wrap(err, "failed to generate authentication data").Error()
+			authenticated = false
+			continue
+		}
+		request.AuthenticatorId = strconv.FormatUint(selected.Id, 10)
+
+		ctx.GasMeter().ConsumeGas(selected.Authenticator.StaticGas(), "authenticator static gas")
+
+		// Authenticate should never modify state
+		neverWriteCtx, _ := ctx.CacheContext()
+		trace := traceAuthenticatorCall(neverWriteCtx, selected.Authenticator, request, authenticator.Authenticator.Authenticate)
+		result.Authenticate = &trace
+
+		authenticators[msgIndex] = selected
+		requests[msgIndex] = request
+		authenticated = authenticated && trace.Passed
+	}
+
+	if !authenticated {
+		response.GasUsed = ctx.GasMeter().GasConsumed()
+		return response, nil
+	}
+
+	for msgIndex := range msgs {
+		if err := authenticators[msgIndex].Authenticator.Track(ctx, requests[msgIndex]); err != nil {
+			response.Results[msgIndex].TrackError = err.Error()
+			authenticated = false
+		}
+	}
+
+	if !authenticated {
+		response.GasUsed = ctx.GasMeter().GasConsumed()
+		return response, nil
+	}
+
+	// Execute the messages, so that ConfirmExecution sees their effects
+	for msgIndex, msg := range msgs {
+		if err := k.executeMsg(ctx, msg); err != nil {
+			response.ExecutionError = fmt.Sprintf("failed to execute message %d: %s", msgIndex, err)
+			response.GasUsed = ctx.GasMeter().GasConsumed()
+			return response, nil
+		}
+	}
+
+	// Confirm the execution of all messages, as done by the AuthenticatorPostDecorator
+	response.Success = true
+	for msgIndex, msg := range msgs {
+		account := msg.GetSigners()[0]
+		request, err := authenticator.GenerateAuthenticationRequest(
+			ctx,
+			k.accountKeeper,
+			k.txConfig.SignModeHandler(),
+			account,
+			feeTx.FeePayer(),
+			feeTx.FeeGranter(),
+			feeTx.GetFee(),
+			msg,
+			tx,
+			msgIndex,
+			simulate,
+			authenticator.NoReplayProtection,
+		)
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "failed to generate authentication data")
+		}
+		request.AuthenticatorId = requests[msgIndex].AuthenticatorId
+
+		trace := traceAuthenticatorCall(ctx, authenticators[msgIndex].Authenticator, request, authenticator.Authenticator.ConfirmExecution)
+		response.Results[msgIndex].ConfirmExecution = &trace
+		response.Success = response.Success && trace.Passed
+	}
+
+	response.GasUsed = ctx.GasMeter().GasConsumed()
+	return response, nil
+}
+
+// traceAuthenticatorCall calls an authenticator hook and records its result and gas usage. If the
+// authenticator is a composite authenticator, the hook is also called on each sub-authenticator in
+// its own cached context, so that the result of every sub-authenticator is reported regardless of
+// whether the composite authenticator short-circuits.
+//
+// Sub-authenticators are traced with a separate gas meter, limited to the gas remaining in the
+// parent meter. Only the call on the authenticator itself is charged to the parent meter, so the
+// reported gas matches what the transaction would consume.
+func traceAuthenticatorCall(
+	ctx sdk.Context,
+	a authenticator.Authenticator,
+	request authenticator.AuthenticationRequest,
+	call authenticatorCall,
+) types.AuthenticatorTrace {
+	trace := types.AuthenticatorTrace{
+		AuthenticatorId: request.AuthenticatorId,
+		Type:            a.Type(),
+	}
+
+	if composite, ok := a.(authenticator.CompositeAuthenticator); ok {
+		subAuthenticators, subRequests, err := composite.SubAuthenticatorRequests(request)
+		if err == nil {
+			for i, subAuthenticator := range subAuthenticators {
+				subCtx, _ := ctx.CacheContext()
+				subCtx = subCtx.WithGasMeter(sdk.NewGasMeter(ctx.GasMeter().GasRemaining()))
+				trace.SubAuthenticators = append(trace.SubAuthenticators, traceSubAuthenticatorCall(subCtx, subAuthenticator, subRequests[i], call))
+			}
+		}
+	}
+
+	gasBefore := ctx.GasMeter().GasConsumed()
+	err := call(a, ctx, request)
+	trace.GasUsed = ctx.GasMeter().GasConsumed() - gasBefore
+	trace.Passed = err == nil
+	if err != nil {
+		trace.Error = err.Error()
+	}
+	return trace
+}
+
+// traceSubAuthenticatorCall traces a sub-authenticator call on its own gas meter. Running out of
+// gas is reported as a failed call instead of aborting the dry run, as the parent meter has not been
+// charged for it.
+func traceSubAuthenticatorCall(
+	ctx sdk.Context,
+	a authenticator.Authenticator,
+	request authenticator.AuthenticationRequest,
+	call authenticatorCall,
+) (trace types.AuthenticatorTrace) {
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(sdk.ErrorOutOfGas); !ok {
+				panic(r)
+			}
+			trace = types.AuthenticatorTrace{
+				AuthenticatorId: request.AuthenticatorId,
+				Type:            a.Type(),
+				GasUsed:         ctx.GasMeter().GasConsumed(),
+				Error:           errorsmod.Wrapf(sdkerrors.ErrOutOfGas, "sub-authenticator exceeded the remaining gas of %d", ctx.GasMeter().Limit()).Error(),
+			}
+		}
+	}()
+	return traceAuthenticatorCall(ctx, a, request, call)
+}
+
+// getDryRunSelectedAuthenticators returns the authenticator to use for every message. If no
+// authenticator is selected explicitly, the ones selected in the transaction extension are used.
+func (k Keeper) getDryRunSelectedAuthenticators(tx sdk.Tx, msgCount int, selectedAuthenticator uint64) ([]uint64, error) {
+	if selectedAuthenticator != 0 {
+		selectedAuthenticators := make([]uint64, msgCount)
+		for i := range selectedAuthenticators {
+			selectedAuthenticators[i] = selectedAuthenticator
+		}
+		return selectedAuthenticators, nil
+	}
+
+	extTx, ok := tx.(authante.HasExtensionOptionsTx)
+	if !ok {
+		return nil, errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a HasExtensionOptionsTx to use Authenticators")
+	}
+
+	txOptions := k.GetAuthenticatorExtension(extTx.GetNonCriticalExtensionOptions())
+	if txOptions == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "no authenticator selected and cannot get AuthenticatorTxOptions from tx")
+	}
+
+	selectedAuthenticators := txOptions.GetSelectedAuthenticators()
+	if len(selectedAuthenticators) != msgCount {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest,
+			"Mismatch between the number of selected authenticators and messages, msg count %d, got %d selected authenticators", msgCount, len(selectedAuthenticators))
+	}
+	return selectedAuthenticators, nil
+}
+
+// withEmptySignatures returns a copy of the transaction with an empty signature for every signer,
+// using the current sequence of each signer account.
+func (k Keeper) withEmptySignatures(ctx sdk.Context, tx authsigning.Tx) (sdk.Tx, error) {
+	txBuilder, err := k.txConfig.WrapTxBuilder(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	signers := tx.GetSigners()
+	signatures := make([]signing.SignatureV2, len(signers))
+	for i, signer := range signers {
+		var pubKey cryptotypes.PubKey = &secp256k1.PubKey{}
+		var sequence uint64
+		if account := k.accountKeeper.GetAccount(ctx, signer); account != nil {
+			if account.GetPubKey() != nil {
+				pubKey = account.GetPubKey()
+			}
+			sequence = account.GetSequence()
+		}
+
+		signatures[i] = signing.SignatureV2{
+			PubKey: pubKey,
+			Data: &signing.SingleSignatureData{
+				SignMode: signing.SignMode_SIGN_MODE_DIRECT,
+			},
+			Sequence: sequence,
+		}
+	}
+
+	if err := txBuilder.SetSignatures(signatures...); err != nil {
+		return nil, err
+	}
+	return txBuilder.GetTx(), nil
+}
+
+// executeMsg routes a message to its handler and executes it.
+func (k Keeper) executeMsg(ctx sdk.Context, msg sdk.Msg) error {
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	handler := k.router.Handler(msg)
+	if handler == nil {
+		return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized message type: %s", sdk.MsgTypeURL(msg))
+	}
+
+	_, err := handler(ctx, msg)
+	return err
+}
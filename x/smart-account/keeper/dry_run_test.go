@@ -0,0 +1,119 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/osmosis-labs/osmosis/v24/x/smart-account/authenticator"
+	"github.com/osmosis-labs/osmosis/v24/x/smart-account/types"
+)
+
+func (s *KeeperTestSuite) TestKeeper_DryRunAuthentication() {
+	sender := s.TestAccs[0]
+	recipient := s.TestAccs[1]
+	coins := sdk.NewCoins(sdk.NewInt64Coin("uosmo", 1_000))
+	s.FundAcc(sender, coins)
+
+	sendFilter := authenticator.SubAuthenticatorInitData{
+		AuthenticatorType: "MessageFilterAuthenticator",
+		Data:              []byte(`{"@type":"/cosmos.bank.v1beta1.MsgSend"}`),
+	}
+	delegateFilter := authenticator.SubAuthenticatorInitData{
+		AuthenticatorType: "MessageFilterAuthenticator",
+		Data:              []byte(`{"@type":"/cosmos.staking.v1beta1.MsgDelegate"}`),
+	}
+	subAuthenticators, err := json.Marshal([]authenticator.SubAuthenticatorInitData{delegateFilter, sendFilter})
+	s.Require().NoError(err)
+
+	anyOfId, err := s.App.SmartAccountKeeper.AddAuthenticator(s.Ctx, sender, "AnyOfAuthenticator", subAuthenticators)
+	s.Require().NoError(err)
+	allOfId, err := s.App.SmartAccountKeeper.AddAuthenticator(s.Ctx, sender, "AllOfAuthenticator", subAuthenticators)
+	s.Require().NoError(err)
+
+	sendMsg := banktypes.NewMsgSend(sender, recipient, coins)
+	sendTx := s.encodeTx(sendMsg)
+
+	// AnyOf passes because of its second sub-authenticator
+	res, err := s.App.SmartAccountKeeper.DryRunAuthentication(sdk.WrapSDKContext(s.Ctx), &types.DryRunAuthenticationRequest{
+		TxBytes:               sendTx,
+		SelectedAuthenticator: anyOfId,
+	})
+	s.Require().NoError(err)
+	s.Require().True(res.Success)
+	s.Require().Empty(res.ExecutionError)
+	s.Require().NotZero(res.GasUsed)
+	s.Require().Len(res.Results, 1)
+
+	result := res.Results[0]
+	s.Require().Equal(sender.String(), result.Account)
+	s.Require().Equal("/cosmos.bank.v1beta1.MsgSend", result.MsgTypeUrl)
+	s.Require().Equal(anyOfId, result.AuthenticatorId)
+	s.Require().True(result.Authenticate.Passed)
+	s.Require().Equal("AnyOfAuthenticator", result.Authenticate.Type)
+	s.Require().Len(result.Authenticate.SubAuthenticators, 2)
+	s.Require().False(result.Authenticate.SubAuthenticators[0].Passed)
+	s.Require().NotEmpty(result.Authenticate.SubAuthenticators[0].Error)
+	s.Require().Equal(fmt.Sprintf("%d.0", anyOfId), result.Authenticate.SubAuthenticators[0].AuthenticatorId)
+	s.Require().True(result.Authenticate.SubAuthenticators[1].Passed)
+	s.Require().Equal(fmt.Sprintf("%d.1", anyOfId), result.Authenticate.SubAuthenticators[1].AuthenticatorId)
+	s.Require().NotNil(result.ConfirmExecution)
+	s.Require().True(result.ConfirmExecution.Passed)
+
+	// The messages were executed in a cached context
+	s.Require().Equal(coins, s.App.BankKeeper.GetAllBalances(s.Ctx, sender))
+
+	// AllOf fails because of its first sub-authenticator, and the messages are not executed
+	res, err = s.App.SmartAccountKeeper.DryRunAuthentication(sdk.WrapSDKContext(s.Ctx), &types.DryRunAuthenticationRequest{
+		TxBytes:               sendTx,
+		SelectedAuthenticator: allOfId,
+	})
+	s.Require().NoError(err)
+	s.Require().False(res.Success)
+	result = res.Results[0]
+	s.Require().False(result.Authenticate.Passed)
+	s.Require().False(result.Authenticate.SubAuthenticators[0].Passed)
+	s.Require().True(result.Authenticate.SubAuthenticators[1].Passed)
+	s.Require().Nil(result.ConfirmExecution)
+
+	// Execution errors are reported
+	failingSend := banktypes.NewMsgSend(sender, recipient, coins.Add(coins...))
+	res, err = s.App.SmartAccountKeeper.DryRunAuthentication(sdk.WrapSDKContext(s.Ctx), &types.DryRunAuthenticationRequest{
+		TxBytes:               s.encodeTx(failingSend),
+		SelectedAuthenticator: anyOfId,
+	})
+	s.Require().NoError(err)
+	s.Require().False(res.Success)
+	s.Require().True(res.Results[0].Authenticate.Passed)
+	s.Require().Contains(res.ExecutionError, "insufficient funds")
+
+	// Unknown authenticators are reported per message
+	delegateMsg := stakingtypes.NewMsgDelegate(sender, sdk.ValAddress(recipient), sdk.NewInt64Coin("uosmo", 1))
+	res, err = s.App.SmartAccountKeeper.DryRunAuthentication(sdk.WrapSDKContext(s.Ctx), &types.DryRunAuthenticationRequest{
+		TxBytes:               s.encodeTx(delegateMsg),
+		SelectedAuthenticator: 100,
+	})
+	s.Require().NoError(err)
+	s.Require().False(res.Success)
+	s.Require().Contains(res.Results[0].Error, "authenticator 100 not found")
+
+	// Without a selected authenticator, the tx extension is required
+	_, err = s.App.SmartAccountKeeper.DryRunAuthentication(sdk.WrapSDKContext(s.Ctx), &types.DryRunAuthenticationRequest{
+		TxBytes: sendTx,
+	})
+	s.Require().ErrorContains(err, "no authenticator selected")
+}
+
+func (s *KeeperTestSuite) encodeTx(msgs ...sdk.Msg) []byte {
+	txConfig := s.App.GetTxConfig()
+	txBuilder := txConfig.NewTxBuilder()
+	s.Require().NoError(txBuilder.SetMsgs(msgs...))
+	txBuilder.SetGasLimit(1_000_000)
+
+	bz, err := txConfig.TxEncoder()(txBuilder.GetTx())
+	s.Require().NoError(err)
+	return bz
+}
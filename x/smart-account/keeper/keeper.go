@@ -5,11 +5,13 @@ import (
 	"strconv"
 
 	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	gogotypes "github.com/gogo/protobuf/types"
 
@@ -33,6 +35,10 @@ type Keeper struct {
 	CircuitBreakerGovernor sdk.AccAddress
 
 	AuthenticatorManager *authenticator.AuthenticatorManager
+
+	accountKeeper authante.AccountKeeper
+	txConfig      client.TxConfig
+	router        types.MessageRouter
 }
 
 func NewKeeper(
@@ -41,6 +47,9 @@ func NewKeeper(
 	govModuleAddr sdk.AccAddress,
 	ps paramtypes.Subspace,
 	authenticatorManager *authenticator.AuthenticatorManager,
+	accountKeeper authante.AccountKeeper,
+	txConfig client.TxConfig,
+	router types.MessageRouter,
 ) Keeper {
 	// set KeyTable if it has not already been set
 	if !ps.HasKeyTable() {
@@ -53,6 +62,9 @@ func NewKeeper(
 		CircuitBreakerGovernor: govModuleAddr,
 		paramSpace:             ps,
 		AuthenticatorManager:   authenticatorManager,
+		accountKeeper:          accountKeeper,
+		txConfig:               txConfig,
+		router:                 router,
 	}
 }
 
@@ -33,3 +33,24 @@ func (k Keeper) GetAuthenticators(
 
 	return &types.GetAuthenticatorsResponse{AccountAuthenticators: authenticators}, nil
 }
+
+func (k Keeper) DryRunAuthentication(
+	ctx context.Context,
+	request *types.DryRunAuthenticationRequest,
+) (*types.DryRunAuthenticationResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	tx, err := k.txConfig.TxDecoder()(request.TxBytes)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	response, err := k.DryRunTx(sdk.UnwrapSDKContext(ctx), tx, request.SelectedAuthenticator)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return response, nil
+}
@@ -29,6 +29,8 @@ const (
 var (
 	KeyNextAccountAuthenticatorIdPrefix = []byte{0x01}
 	KeyAccountAuthenticatorsPrefix      = []byte{0x02}
+	KeySpendLimitPrefix                 = []byte{0x03}
+	KeyTimeWindowPrefix                 = []byte{0x04}
 	KeyMaximumUnauthenticatedGas        = []byte("MaximumUnauthenticatedGas")
 	KeyIsSmartAccountActive             = []byte("IsSmartAccountActive")
 	KeyCircuitBreakerControllers        = []byte("CircuitBreakerControllers")
@@ -50,6 +52,24 @@ func KeyAccountAuthenticatorsPrefixId() []byte {
 	return BuildKey(KeyAccountAuthenticatorsPrefix)
 }
 
+// KeySpendLimitPreExecBalances is the key under which the SpendLimitAuthenticator stores
+// the balances of an account before the execution of a transaction.
+func KeySpendLimitPreExecBalances(account sdk.AccAddress, authenticatorId string) []byte {
+	return BuildKey(KeySpendLimitPrefix, account.String(), authenticatorId, "balances")
+}
+
+// KeySpendLimitSpending is the key under which the SpendLimitAuthenticator stores
+// the spending of an account for the current period.
+func KeySpendLimitSpending(account sdk.AccAddress, authenticatorId string) []byte {
+	return BuildKey(KeySpendLimitPrefix, account.String(), authenticatorId, "spending")
+}
+
+// KeyTimeWindowUses is the key under which the TimeWindowAuthenticator stores
+// the amount of times it has been used by an account.
+func KeyTimeWindowUses(account sdk.AccAddress, authenticatorId string) []byte {
+	return BuildKey(KeyTimeWindowPrefix, account.String(), authenticatorId, "uses")
+}
+
 // BuildKey creates a key by concatenating the provided elements with the key separator.
 func BuildKey(elements ...interface{}) []byte {
 	strElements := make([]string, len(elements))
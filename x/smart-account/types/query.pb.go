@@ -203,11 +203,344 @@ func (m *GetAuthenticatorsResponse) GetAccountAuthenticators() []*AccountAuthent
 	return nil
 }
 
+// DryRunAuthenticationRequest defines the Query/DryRunAuthentication request
+// type.
+type DryRunAuthenticationRequest struct {
+	// tx_bytes is the encoded transaction. If the transaction has no signatures,
+	// the authenticators are run in simulation mode.
+	TxBytes []byte `protobuf:"bytes,1,opt,name=tx_bytes,json=txBytes,proto3" json:"tx_bytes,omitempty"`
+	// selected_authenticator is the authenticator used for every message in the
+	// transaction. If zero, the authenticators selected in the transaction
+	// extension are used instead.
+	SelectedAuthenticator uint64 `protobuf:"varint,2,opt,name=selected_authenticator,json=selectedAuthenticator,proto3" json:"selected_authenticator,omitempty"`
+}
+
+func (m *DryRunAuthenticationRequest) Reset()         { *m = DryRunAuthenticationRequest{} }
+func (m *DryRunAuthenticationRequest) String() string { return proto.CompactTextString(m) }
+func (*DryRunAuthenticationRequest) ProtoMessage()    {}
+func (*DryRunAuthenticationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab2e1fc442f3cc3, []int{4}
+}
+func (m *DryRunAuthenticationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DryRunAuthenticationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DryRunAuthenticationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DryRunAuthenticationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DryRunAuthenticationRequest.Merge(m, src)
+}
+func (m *DryRunAuthenticationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DryRunAuthenticationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DryRunAuthenticationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DryRunAuthenticationRequest proto.InternalMessageInfo
+
+func (m *DryRunAuthenticationRequest) GetTxBytes() []byte {
+	if m != nil {
+		return m.TxBytes
+	}
+	return nil
+}
+
+func (m *DryRunAuthenticationRequest) GetSelectedAuthenticator() uint64 {
+	if m != nil {
+		return m.SelectedAuthenticator
+	}
+	return 0
+}
+
+// DryRunAuthenticationResponse defines the Query/DryRunAuthentication response
+// type.
+type DryRunAuthenticationResponse struct {
+	// success is true if every message was authenticated, executed and
+	// confirmed.
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// results contains the authentication result of every message.
+	Results []MessageAuthenticationResult `protobuf:"bytes,2,rep,name=results,proto3" json:"results"`
+	// execution_error is set if the messages failed to execute after being
+	// authenticated.
+	ExecutionError string `protobuf:"bytes,3,opt,name=execution_error,json=executionError,proto3" json:"execution_error,omitempty"`
+	// gas_used is the total gas used by the dry run, excluding fee deduction.
+	GasUsed uint64 `protobuf:"varint,4,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+}
+
+func (m *DryRunAuthenticationResponse) Reset()         { *m = DryRunAuthenticationResponse{} }
+func (m *DryRunAuthenticationResponse) String() string { return proto.CompactTextString(m) }
+func (*DryRunAuthenticationResponse) ProtoMessage()    {}
+func (*DryRunAuthenticationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab2e1fc442f3cc3, []int{5}
+}
+func (m *DryRunAuthenticationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DryRunAuthenticationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DryRunAuthenticationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DryRunAuthenticationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DryRunAuthenticationResponse.Merge(m, src)
+}
+func (m *DryRunAuthenticationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DryRunAuthenticationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DryRunAuthenticationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DryRunAuthenticationResponse proto.InternalMessageInfo
+
+func (m *DryRunAuthenticationResponse) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *DryRunAuthenticationResponse) GetResults() []MessageAuthenticationResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+func (m *DryRunAuthenticationResponse) GetExecutionError() string {
+	if m != nil {
+		return m.ExecutionError
+	}
+	return ""
+}
+
+func (m *DryRunAuthenticationResponse) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+// MessageAuthenticationResult is the result of authenticating a single message.
+type MessageAuthenticationResult struct {
+	MsgIndex        uint64 `protobuf:"varint,1,opt,name=msg_index,json=msgIndex,proto3" json:"msg_index,omitempty"`
+	MsgTypeUrl      string `protobuf:"bytes,2,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	Account         string `protobuf:"bytes,3,opt,name=account,proto3" json:"account,omitempty"`
+	AuthenticatorId uint64 `protobuf:"varint,4,opt,name=authenticator_id,json=authenticatorId,proto3" json:"authenticator_id,omitempty"`
+	// error is set if the message could not be authenticated before any
+	// authenticator ran, for example if the authenticator does not exist.
+	Error string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	// authenticate is the trace of the Authenticate call.
+	Authenticate *AuthenticatorTrace `protobuf:"bytes,6,opt,name=authenticate,proto3" json:"authenticate,omitempty"`
+	// track_error is set if the Track call failed.
+	TrackError string `protobuf:"bytes,7,opt,name=track_error,json=trackError,proto3" json:"track_error,omitempty"`
+	// confirm_execution is the trace of the ConfirmExecution call. It is empty
+	// if the messages were not executed.
+	ConfirmExecution *AuthenticatorTrace `protobuf:"bytes,8,opt,name=confirm_execution,json=confirmExecution,proto3" json:"confirm_execution,omitempty"`
+}
+
+func (m *MessageAuthenticationResult) Reset()         { *m = MessageAuthenticationResult{} }
+func (m *MessageAuthenticationResult) String() string { return proto.CompactTextString(m) }
+func (*MessageAuthenticationResult) ProtoMessage()    {}
+func (*MessageAuthenticationResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab2e1fc442f3cc3, []int{6}
+}
+func (m *MessageAuthenticationResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MessageAuthenticationResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MessageAuthenticationResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MessageAuthenticationResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MessageAuthenticationResult.Merge(m, src)
+}
+func (m *MessageAuthenticationResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *MessageAuthenticationResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_MessageAuthenticationResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MessageAuthenticationResult proto.InternalMessageInfo
+
+func (m *MessageAuthenticationResult) GetMsgIndex() uint64 {
+	if m != nil {
+		return m.MsgIndex
+	}
+	return 0
+}
+
+func (m *MessageAuthenticationResult) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *MessageAuthenticationResult) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *MessageAuthenticationResult) GetAuthenticatorId() uint64 {
+	if m != nil {
+		return m.AuthenticatorId
+	}
+	return 0
+}
+
+func (m *MessageAuthenticationResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *MessageAuthenticationResult) GetAuthenticate() *AuthenticatorTrace {
+	if m != nil {
+		return m.Authenticate
+	}
+	return nil
+}
+
+func (m *MessageAuthenticationResult) GetTrackError() string {
+	if m != nil {
+		return m.TrackError
+	}
+	return ""
+}
+
+func (m *MessageAuthenticationResult) GetConfirmExecution() *AuthenticatorTrace {
+	if m != nil {
+		return m.ConfirmExecution
+	}
+	return nil
+}
+
+// AuthenticatorTrace is the result of calling an authenticator and each of its
+// sub-authenticators.
+type AuthenticatorTrace struct {
+	// authenticator_id is the (composite) id of the authenticator, e.g. "5.1".
+	AuthenticatorId   string               `protobuf:"bytes,1,opt,name=authenticator_id,json=authenticatorId,proto3" json:"authenticator_id,omitempty"`
+	Type              string               `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Passed            bool                 `protobuf:"varint,3,opt,name=passed,proto3" json:"passed,omitempty"`
+	Error             string               `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	GasUsed           uint64               `protobuf:"varint,5,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	SubAuthenticators []AuthenticatorTrace `protobuf:"bytes,6,rep,name=sub_authenticators,json=subAuthenticators,proto3" json:"sub_authenticators"`
+}
+
+func (m *AuthenticatorTrace) Reset()         { *m = AuthenticatorTrace{} }
+func (m *AuthenticatorTrace) String() string { return proto.CompactTextString(m) }
+func (*AuthenticatorTrace) ProtoMessage()    {}
+func (*AuthenticatorTrace) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab2e1fc442f3cc3, []int{7}
+}
+func (m *AuthenticatorTrace) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AuthenticatorTrace) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AuthenticatorTrace.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AuthenticatorTrace) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuthenticatorTrace.Merge(m, src)
+}
+func (m *AuthenticatorTrace) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuthenticatorTrace) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuthenticatorTrace.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuthenticatorTrace proto.InternalMessageInfo
+
+func (m *AuthenticatorTrace) GetAuthenticatorId() string {
+	if m != nil {
+		return m.AuthenticatorId
+	}
+	return ""
+}
+
+func (m *AuthenticatorTrace) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *AuthenticatorTrace) GetPassed() bool {
+	if m != nil {
+		return m.Passed
+	}
+	return false
+}
+
+func (m *AuthenticatorTrace) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *AuthenticatorTrace) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *AuthenticatorTrace) GetSubAuthenticators() []AuthenticatorTrace {
+	if m != nil {
+		return m.SubAuthenticators
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "osmosis.smartaccount.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "osmosis.smartaccount.v1beta1.QueryParamsResponse")
 	proto.RegisterType((*GetAuthenticatorsRequest)(nil), "osmosis.smartaccount.v1beta1.GetAuthenticatorsRequest")
 	proto.RegisterType((*GetAuthenticatorsResponse)(nil), "osmosis.smartaccount.v1beta1.GetAuthenticatorsResponse")
+	proto.RegisterType((*DryRunAuthenticationRequest)(nil), "osmosis.smartaccount.v1beta1.DryRunAuthenticationRequest")
+	proto.RegisterType((*DryRunAuthenticationResponse)(nil), "osmosis.smartaccount.v1beta1.DryRunAuthenticationResponse")
+	proto.RegisterType((*MessageAuthenticationResult)(nil), "osmosis.smartaccount.v1beta1.MessageAuthenticationResult")
+	proto.RegisterType((*AuthenticatorTrace)(nil), "osmosis.smartaccount.v1beta1.AuthenticatorTrace")
 }
 
 func init() {
@@ -215,34 +548,59 @@ func init() {
 }
 
 var fileDescriptor_aab2e1fc442f3cc3 = []byte{
-	// 430 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xb1, 0xae, 0xd3, 0x30,
-	0x14, 0x86, 0xe3, 0x0b, 0x14, 0xe1, 0x3b, 0x61, 0x2e, 0x28, 0x44, 0x55, 0xa8, 0xa2, 0x0e, 0x05,
-	0xa9, 0x71, 0x1b, 0xaa, 0x22, 0xc6, 0x76, 0x61, 0x85, 0x30, 0xc1, 0x82, 0x9c, 0x60, 0xa5, 0x91,
-	0x9a, 0x38, 0x8d, 0x9d, 0x8a, 0x0a, 0xb1, 0x30, 0x30, 0x23, 0xf5, 0x75, 0x78, 0x80, 0x8e, 0x95,
-	0x58, 0x18, 0x10, 0x42, 0x2d, 0x0f, 0x82, 0x6a, 0x3b, 0x15, 0x51, 0x43, 0x74, 0xbb, 0xc5, 0x27,
-	0xff, 0x7f, 0xbe, 0xdf, 0xe7, 0x18, 0xf6, 0x18, 0x4f, 0x18, 0x8f, 0x39, 0xe6, 0x09, 0xc9, 0x05,
-	0x09, 0x43, 0x56, 0xa4, 0x02, 0x2f, 0x87, 0x01, 0x15, 0x64, 0x88, 0x17, 0x05, 0xcd, 0x57, 0x6e,
-	0x96, 0x33, 0xc1, 0x50, 0x5b, 0x2b, 0xdd, 0x7f, 0x95, 0xae, 0x56, 0x5a, 0x57, 0x11, 0x8b, 0x98,
-	0x14, 0xe2, 0xc3, 0x97, 0xf2, 0x58, 0xed, 0x88, 0xb1, 0x68, 0x4e, 0x31, 0xc9, 0x62, 0x4c, 0xd2,
-	0x94, 0x09, 0x22, 0x62, 0x96, 0x72, 0xfd, 0xf7, 0x49, 0x28, 0x5b, 0xe2, 0x80, 0x70, 0xaa, 0x50,
-	0x47, 0x70, 0x46, 0xa2, 0x38, 0x95, 0x62, 0xad, 0x7d, 0xdc, 0x98, 0x33, 0x23, 0x39, 0x49, 0xf8,
-	0xb5, 0xa4, 0x09, 0x7b, 0x4f, 0xe7, 0x5a, 0xea, 0x5c, 0x41, 0xf4, 0xea, 0xc0, 0x7d, 0x29, 0xfd,
-	0x3e, 0x5d, 0x14, 0x94, 0x0b, 0xe7, 0x0d, 0xbc, 0x57, 0xa9, 0xf2, 0x8c, 0xa5, 0x9c, 0xa2, 0x29,
-	0x6c, 0x29, 0x8e, 0x09, 0x3a, 0xa0, 0x77, 0xe9, 0x75, 0xdd, 0xa6, 0x89, 0xb8, 0xca, 0x3d, 0xbd,
-	0xb9, 0xf9, 0xf5, 0xc8, 0xf0, 0xb5, 0xd3, 0x19, 0x41, 0xf3, 0x05, 0x15, 0x93, 0x42, 0xcc, 0x68,
-	0x2a, 0xe2, 0x90, 0x08, 0x96, 0x97, 0x58, 0x64, 0xc2, 0xdb, 0xba, 0x87, 0x04, 0xdc, 0xf1, 0xcb,
-	0xa3, 0xf3, 0x05, 0xc0, 0x87, 0x35, 0x36, 0x9d, 0x2b, 0x86, 0x0f, 0xb4, 0xf0, 0x1d, 0xa9, 0x28,
-	0x4c, 0xd0, 0xb9, 0xd1, 0xbb, 0xf4, 0xbc, 0xe6, 0x9c, 0x13, 0x75, 0xae, 0x34, 0xf7, 0xef, 0x93,
-	0x9a, 0x2a, 0xf7, 0x7e, 0x5e, 0xc0, 0x5b, 0x72, 0x34, 0x68, 0x0d, 0x60, 0x4b, 0xdd, 0x10, 0x0d,
-	0x9a, 0xfb, 0x9f, 0x0e, 0xd8, 0x1a, 0x9e, 0xe1, 0x50, 0x97, 0x74, 0xba, 0x9f, 0xbf, 0xff, 0x59,
-	0x5f, 0xd8, 0xa8, 0x8d, 0x6b, 0xb7, 0xab, 0xc6, 0x8b, 0xbe, 0x01, 0x78, 0xf7, 0x64, 0x50, 0x68,
-	0xdc, 0x8c, 0xfb, 0xdf, 0x42, 0xac, 0x67, 0x67, 0xfb, 0x74, 0xd8, 0xb1, 0x0c, 0x3b, 0x40, 0x6e,
-	0x7d, 0xd8, 0xea, 0x96, 0xf0, 0x47, 0x5d, 0xff, 0x34, 0x7d, 0xbd, 0xd9, 0xd9, 0x60, 0xbb, 0xb3,
-	0xc1, 0xef, 0x9d, 0x0d, 0xbe, 0xee, 0x6d, 0x63, 0xbb, 0xb7, 0x8d, 0x1f, 0x7b, 0xdb, 0x78, 0xfb,
-	0x3c, 0x8a, 0xc5, 0xac, 0x08, 0xdc, 0x90, 0x25, 0x65, 0xcf, 0xfe, 0x9c, 0x04, 0xfc, 0x08, 0x58,
-	0x7a, 0x23, 0xfc, 0x41, 0x61, 0xfa, 0x25, 0x47, 0xac, 0x32, 0xca, 0x83, 0x96, 0x7c, 0xea, 0x4f,
-	0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0xb2, 0x19, 0xec, 0x28, 0xea, 0x03, 0x00, 0x00,
+	// 827 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0x4f, 0x6f, 0xeb, 0x44,
+	0x10, 0x8f, 0xf3, 0xbf, 0xdb, 0x8a, 0xf7, 0xba, 0xe4, 0x55, 0x7e, 0x69, 0x94, 0x17, 0x59, 0x4f,
+	0x22, 0x0f, 0xf1, 0xec, 0x36, 0x94, 0xa2, 0x72, 0x6b, 0x44, 0x85, 0x7a, 0x40, 0x02, 0xd3, 0x1e,
+	0x8a, 0x84, 0xac, 0xb5, 0xb3, 0xb8, 0x16, 0xb6, 0x37, 0xdd, 0x5d, 0x57, 0x89, 0x10, 0x17, 0x90,
+	0xb8, 0x21, 0x21, 0xf5, 0x1b, 0xf0, 0x39, 0xb8, 0x71, 0xe9, 0xb1, 0x12, 0x07, 0x38, 0x21, 0xd4,
+	0xf2, 0x41, 0x90, 0x77, 0xd7, 0xc1, 0x26, 0x26, 0xa5, 0xbd, 0x65, 0xc6, 0xbf, 0x99, 0xdf, 0xcc,
+	0x6f, 0x66, 0x27, 0x60, 0x48, 0x58, 0x44, 0x58, 0xc0, 0x2c, 0x16, 0x21, 0xca, 0x91, 0xe7, 0x91,
+	0x24, 0xe6, 0xd6, 0xe5, 0xae, 0x8b, 0x39, 0xda, 0xb5, 0x2e, 0x12, 0x4c, 0xe7, 0xe6, 0x94, 0x12,
+	0x4e, 0x60, 0x4f, 0x21, 0xcd, 0x3c, 0xd2, 0x54, 0xc8, 0x6e, 0xc7, 0x27, 0x3e, 0x11, 0x40, 0x2b,
+	0xfd, 0x25, 0x63, 0xba, 0x3d, 0x9f, 0x10, 0x3f, 0xc4, 0x16, 0x9a, 0x06, 0x16, 0x8a, 0x63, 0xc2,
+	0x11, 0x0f, 0x48, 0xcc, 0xd4, 0xd7, 0xb7, 0x3d, 0x91, 0xd2, 0x72, 0x11, 0xc3, 0x92, 0x6a, 0x41,
+	0x3c, 0x45, 0x7e, 0x10, 0x0b, 0xb0, 0xc2, 0xbe, 0x5a, 0x59, 0xe7, 0x14, 0x51, 0x14, 0xb1, 0xff,
+	0x05, 0x8d, 0xc8, 0x04, 0x87, 0x0a, 0x6a, 0x74, 0x00, 0xfc, 0x34, 0xe5, 0xfd, 0x44, 0xc4, 0xdb,
+	0xf8, 0x22, 0xc1, 0x8c, 0x1b, 0x67, 0xe0, 0xcd, 0x82, 0x97, 0x4d, 0x49, 0xcc, 0x30, 0x1c, 0x83,
+	0xa6, 0xe4, 0xd1, 0xb5, 0x81, 0x36, 0x5c, 0x1f, 0xbd, 0x34, 0x57, 0x29, 0x62, 0xca, 0xe8, 0x71,
+	0xfd, 0xfa, 0x8f, 0x17, 0x15, 0x5b, 0x45, 0x1a, 0x7b, 0x40, 0xff, 0x08, 0xf3, 0xc3, 0x84, 0x9f,
+	0xe3, 0x98, 0x07, 0x1e, 0xe2, 0x84, 0x66, 0xb4, 0x50, 0x07, 0x2d, 0x95, 0x43, 0x10, 0xac, 0xd9,
+	0x99, 0x69, 0x7c, 0xaf, 0x81, 0xe7, 0x25, 0x61, 0xaa, 0xae, 0x00, 0x6c, 0x29, 0xa0, 0x83, 0x0a,
+	0x08, 0x5d, 0x1b, 0xd4, 0x86, 0xeb, 0xa3, 0xd1, 0xea, 0x3a, 0x0f, 0xa5, 0x5d, 0x48, 0x6e, 0x3f,
+	0x43, 0x25, 0x5e, 0x66, 0x10, 0xb0, 0xfd, 0x21, 0x9d, 0xdb, 0x49, 0x9c, 0xf3, 0x07, 0x24, 0xce,
+	0x3a, 0x78, 0x0e, 0xda, 0x7c, 0xe6, 0xb8, 0x73, 0x8e, 0xa5, 0x46, 0x1b, 0x76, 0x8b, 0xcf, 0xc6,
+	0xa9, 0x09, 0xdf, 0x03, 0x5b, 0x0c, 0x87, 0xd8, 0xe3, 0x78, 0x52, 0xac, 0x52, 0xaf, 0x0e, 0xb4,
+	0x61, 0xdd, 0x7e, 0x96, 0x7d, 0x2d, 0x30, 0x1a, 0xbf, 0x69, 0xa0, 0x57, 0xce, 0xa8, 0x9a, 0xd7,
+	0x41, 0x8b, 0x25, 0x9e, 0x87, 0x99, 0x64, 0x6c, 0xdb, 0x99, 0x09, 0xcf, 0x40, 0x8b, 0x62, 0x96,
+	0x84, 0x9c, 0xe9, 0x55, 0xa1, 0xc3, 0xc1, 0x6a, 0x1d, 0x3e, 0xc6, 0x8c, 0x21, 0x1f, 0x2f, 0xf1,
+	0x24, 0x21, 0x57, 0x43, 0xcc, 0xf2, 0xc1, 0xb7, 0xc0, 0x13, 0x3c, 0xc3, 0x5e, 0x92, 0x22, 0x1c,
+	0x4c, 0x29, 0xa1, 0x7a, 0x4d, 0x4c, 0xec, 0x8d, 0x85, 0xfb, 0x28, 0xf5, 0xa6, 0x82, 0xf8, 0x88,
+	0x39, 0x09, 0xc3, 0x13, 0xbd, 0x2e, 0xfa, 0x6c, 0xf9, 0x88, 0x9d, 0x32, 0x3c, 0x31, 0x7e, 0xa8,
+	0x81, 0xed, 0x15, 0x94, 0x70, 0x1b, 0xac, 0x45, 0xcc, 0x77, 0x82, 0x78, 0x82, 0x67, 0xa2, 0xb5,
+	0xba, 0xdd, 0x8e, 0x98, 0x7f, 0x9c, 0xda, 0x70, 0x00, 0x36, 0xd2, 0x8f, 0x7c, 0x3e, 0xc5, 0x4e,
+	0x42, 0x43, 0xa1, 0xe1, 0x9a, 0x0d, 0x22, 0xe6, 0x9f, 0xcc, 0xa7, 0xf8, 0x94, 0x86, 0xf9, 0x65,
+	0xaa, 0x15, 0x96, 0x09, 0xbe, 0x02, 0x4f, 0x0b, 0x03, 0x70, 0x82, 0xac, 0xb6, 0x27, 0x05, 0xff,
+	0xf1, 0x04, 0x76, 0x40, 0x43, 0x76, 0xd7, 0x10, 0x29, 0xa4, 0x01, 0x4f, 0xc0, 0x46, 0x0e, 0x88,
+	0xf5, 0xa6, 0x78, 0x0d, 0x3b, 0xf7, 0x6c, 0x59, 0x3e, 0xf5, 0x09, 0x45, 0x1e, 0xb6, 0x0b, 0x59,
+	0xe0, 0x0b, 0xb0, 0xce, 0x29, 0xf2, 0xbe, 0x52, 0x7a, 0xb6, 0x64, 0x47, 0xc2, 0x25, 0xb5, 0xfc,
+	0x02, 0x6c, 0x7a, 0x24, 0xfe, 0x32, 0xa0, 0x91, 0xb3, 0x50, 0x59, 0x6f, 0x3f, 0x92, 0xfb, 0xa9,
+	0x4a, 0x75, 0x94, 0x65, 0x32, 0xbe, 0xab, 0x02, 0xb8, 0x0c, 0x2c, 0x55, 0x4b, 0xbe, 0xce, 0x25,
+	0xb5, 0x20, 0xa8, 0xa7, 0x03, 0x51, 0xc3, 0x10, 0xbf, 0xe1, 0x56, 0x7a, 0x33, 0x58, 0x3a, 0xfe,
+	0x9a, 0xd8, 0x4e, 0x65, 0xfd, 0xa3, 0x6c, 0x3d, 0xaf, 0x6c, 0x7e, 0x5d, 0x1a, 0x85, 0x75, 0x81,
+	0x18, 0x40, 0x96, 0xb8, 0xff, 0x7e, 0xe0, 0xcd, 0x41, 0xed, 0x31, 0xed, 0xab, 0x7d, 0xde, 0x64,
+	0x89, 0x5b, 0xf8, 0xc8, 0x46, 0x3f, 0xd5, 0x41, 0x43, 0xdc, 0x3e, 0x78, 0xa5, 0x81, 0xa6, 0x3c,
+	0x61, 0xf0, 0x9e, 0xfc, 0xcb, 0x17, 0xb4, 0xbb, 0xfb, 0x80, 0x08, 0xf9, 0x90, 0x8d, 0x97, 0xdf,
+	0xfe, 0xfa, 0xd7, 0x55, 0xb5, 0x0f, 0x7b, 0x56, 0xe9, 0xf9, 0x96, 0xf7, 0x13, 0xfe, 0xac, 0x81,
+	0xcd, 0xa5, 0x4b, 0x08, 0xf7, 0x57, 0xd3, 0xfd, 0xd7, 0xc5, 0xed, 0xbe, 0xff, 0xe0, 0x38, 0x55,
+	0xec, 0xbe, 0x28, 0x76, 0x07, 0x9a, 0xe5, 0xc5, 0x16, 0xa7, 0x64, 0x7d, 0xad, 0xfc, 0xdf, 0xc0,
+	0x5f, 0x34, 0xd0, 0x29, 0x3b, 0x67, 0xf0, 0x9e, 0xdb, 0xb4, 0xe2, 0xe8, 0x76, 0x3f, 0x78, 0x4c,
+	0xa8, 0xea, 0x63, 0x4f, 0xf4, 0x61, 0xc2, 0x77, 0xca, 0xfb, 0x98, 0xd0, 0xb9, 0x43, 0x93, 0x38,
+	0xbf, 0x75, 0x01, 0x89, 0xc7, 0x9f, 0x5d, 0xdf, 0xf6, 0xb5, 0x9b, 0xdb, 0xbe, 0xf6, 0xe7, 0x6d,
+	0x5f, 0xfb, 0xf1, 0xae, 0x5f, 0xb9, 0xb9, 0xeb, 0x57, 0x7e, 0xbf, 0xeb, 0x57, 0x3e, 0x3f, 0xf0,
+	0x03, 0x7e, 0x9e, 0xb8, 0xa6, 0x47, 0xa2, 0x2c, 0xe3, 0xeb, 0x10, 0xb9, 0x6c, 0x91, 0xfe, 0x72,
+	0xb4, 0x67, 0xcd, 0x24, 0xc9, 0xeb, 0x8c, 0x25, 0x7d, 0x28, 0xcc, 0x6d, 0x8a, 0x7f, 0xe4, 0x77,
+	0xff, 0x1e, 0x00, 0xec, 0x07, 0x8d, 0x6a, 0x91, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -260,6 +618,10 @@ type QueryClient interface {
 	// Parameters queries the parameters of the module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	GetAuthenticators(ctx context.Context, in *GetAuthenticatorsRequest, opts ...grpc.CallOption) (*GetAuthenticatorsResponse, error)
+	// DryRunAuthentication runs the authenticators selected for a transaction
+	// through Authenticate, Track and ConfirmExecution without committing any
+	// state, and reports the result of every sub-authenticator.
+	DryRunAuthentication(ctx context.Context, in *DryRunAuthenticationRequest, opts ...grpc.CallOption) (*DryRunAuthenticationResponse, error)
 }
 
 type queryClient struct {
@@ -288,11 +650,24 @@ func (c *queryClient) GetAuthenticators(ctx context.Context, in *GetAuthenticato
 	return out, nil
 }
 
+func (c *queryClient) DryRunAuthentication(ctx context.Context, in *DryRunAuthenticationRequest, opts ...grpc.CallOption) (*DryRunAuthenticationResponse, error) {
+	out := new(DryRunAuthenticationResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.smartaccount.v1beta1.Query/DryRunAuthentication", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	GetAuthenticators(context.Context, *GetAuthenticatorsRequest) (*GetAuthenticatorsResponse, error)
+	// DryRunAuthentication runs the authenticators selected for a transaction
+	// through Authenticate, Track and ConfirmExecution without committing any
+	// state, and reports the result of every sub-authenticator.
+	DryRunAuthentication(context.Context, *DryRunAuthenticationRequest) (*DryRunAuthenticationResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -305,6 +680,9 @@ func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsReq
 func (*UnimplementedQueryServer) GetAuthenticators(ctx context.Context, req *GetAuthenticatorsRequest) (*GetAuthenticatorsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAuthenticators not implemented")
 }
+func (*UnimplementedQueryServer) DryRunAuthentication(ctx context.Context, req *DryRunAuthenticationRequest) (*DryRunAuthenticationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DryRunAuthentication not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -346,6 +724,24 @@ func _Query_GetAuthenticators_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DryRunAuthentication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DryRunAuthenticationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DryRunAuthentication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.smartaccount.v1beta1.Query/DryRunAuthentication",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DryRunAuthentication(ctx, req.(*DryRunAuthenticationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.smartaccount.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -358,6 +754,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetAuthenticators",
 			Handler:    _Query_GetAuthenticators_Handler,
 		},
+		{
+			MethodName: "DryRunAuthentication",
+			Handler:    _Query_DryRunAuthentication_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/smartaccount/v1beta1/query.proto",
@@ -486,48 +886,300 @@ func (m *GetAuthenticatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *DryRunAuthenticationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *DryRunAuthenticationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *DryRunAuthenticationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if m.SelectedAuthenticator != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SelectedAuthenticator))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TxBytes) > 0 {
+		i -= len(m.TxBytes)
+		copy(dAtA[i:], m.TxBytes)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TxBytes)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *GetAuthenticatorsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *DryRunAuthenticationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
+}
+
+func (m *DryRunAuthenticationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DryRunAuthenticationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasUsed != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ExecutionError) > 0 {
+		i -= len(m.ExecutionError)
+		copy(dAtA[i:], m.ExecutionError)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ExecutionError)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MessageAuthenticationResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MessageAuthenticationResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MessageAuthenticationResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ConfirmExecution != nil {
+		{
+			size, err := m.ConfirmExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.TrackError) > 0 {
+		i -= len(m.TrackError)
+		copy(dAtA[i:], m.TrackError)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TrackError)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Authenticate != nil {
+		{
+			size, err := m.Authenticate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.AuthenticatorId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AuthenticatorId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MsgIndex != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MsgIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AuthenticatorTrace) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuthenticatorTrace) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuthenticatorTrace) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SubAuthenticators) > 0 {
+		for iNdEx := len(m.SubAuthenticators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SubAuthenticators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Passed {
+		i--
+		if m.Passed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AuthenticatorId) > 0 {
+		i -= len(m.AuthenticatorId)
+		copy(dAtA[i:], m.AuthenticatorId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AuthenticatorId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *GetAuthenticatorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
 }
 
 func (m *GetAuthenticatorsResponse) Size() (n int) {
@@ -545,6 +1197,119 @@ func (m *GetAuthenticatorsResponse) Size() (n int) {
 	return n
 }
 
+func (m *DryRunAuthenticationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxBytes)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.SelectedAuthenticator != 0 {
+		n += 1 + sovQuery(uint64(m.SelectedAuthenticator))
+	}
+	return n
+}
+
+func (m *DryRunAuthenticationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Success {
+		n += 2
+	}
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.ExecutionError)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovQuery(uint64(m.GasUsed))
+	}
+	return n
+}
+
+func (m *MessageAuthenticationResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MsgIndex != 0 {
+		n += 1 + sovQuery(uint64(m.MsgIndex))
+	}
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.AuthenticatorId != 0 {
+		n += 1 + sovQuery(uint64(m.AuthenticatorId))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Authenticate != nil {
+		l = m.Authenticate.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TrackError)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ConfirmExecution != nil {
+		l = m.ConfirmExecution.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *AuthenticatorTrace) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AuthenticatorId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Passed {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovQuery(uint64(m.GasUsed))
+	}
+	if len(m.SubAuthenticators) > 0 {
+		for _, e := range m.SubAuthenticators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -850,6 +1615,771 @@ func (m *GetAuthenticatorsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DryRunAuthenticationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DryRunAuthenticationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DryRunAuthenticationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxBytes = append(m.TxBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxBytes == nil {
+				m.TxBytes = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelectedAuthenticator", wireType)
+			}
+			m.SelectedAuthenticator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SelectedAuthenticator |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DryRunAuthenticationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DryRunAuthenticationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DryRunAuthenticationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, MessageAuthenticationResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExecutionError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MessageAuthenticationResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MessageAuthenticationResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MessageAuthenticationResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgIndex", wireType)
+			}
+			m.MsgIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MsgIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthenticatorId", wireType)
+			}
+			m.AuthenticatorId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuthenticatorId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authenticate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Authenticate == nil {
+				m.Authenticate = &AuthenticatorTrace{}
+			}
+			if err := m.Authenticate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrackError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TrackError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfirmExecution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConfirmExecution == nil {
+				m.ConfirmExecution = &AuthenticatorTrace{}
+			}
+			if err := m.ConfirmExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AuthenticatorTrace) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AuthenticatorTrace: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AuthenticatorTrace: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthenticatorId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuthenticatorId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Passed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Passed = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubAuthenticators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubAuthenticators = append(m.SubAuthenticators, AuthenticatorTrace{})
+			if err := m.SubAuthenticators[len(m.SubAuthenticators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
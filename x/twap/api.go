@@ -1,11 +1,14 @@
 package twap
 
 import (
+	"errors"
+	"fmt"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v24/x/poolmanager/types"
 	"github.com/osmosis-labs/osmosis/v24/x/twap/types"
 )
 
@@ -79,30 +82,296 @@ func (k Keeper) GetGeometricTwapToNow(
 	return k.getTwapToNow(ctx, poolId, baseAssetDenom, quoteAssetDenom, startTime, k.GetGeometricStrategy())
 }
 
-// getTwap computes and returns twap from the start time until the end time. The type
-// of twap returned depends on the strategy given and can be either arithmetic or geometric.
-func (k Keeper) getTwap(
+// GetArithmeticTwapForRoute returns the price of the base asset, in units of the last token out denom of the route,
+// obtained by composing the arithmetic TWAPs of every hop of the route over (startTime, endTime).
+// The base asset is the token in of the first hop, and every hop prices its token in in units of its token out.
+//
+// N.B. The product of the arithmetic TWAPs of the hops is not the arithmetic TWAP of the route price,
+// as the mean of a product is not the product of the means. GetGeometricTwapForRoute does not have this issue
+// and should be preferred.
+//
+// This function errors under the same conditions as GetGeometricTwapForRoute.
+func (k Keeper) GetArithmeticTwapForRoute(
+	ctx sdk.Context,
+	baseAssetDenom string,
+	routes poolmanagertypes.SwapAmountInRoutes,
+	startTime time.Time,
+	endTime time.Time,
+) (osmomath.Dec, error) {
+	return k.getTwapForRoute(ctx, baseAssetDenom, routes, startTime, endTime, k.GetArithmeticStrategy())
+}
+
+// GetGeometricTwapForRoute returns the geometric TWAP of the base asset, in units of the last token out denom of
+// the route, over (startTime, endTime). The base asset is the token in of the first hop, and every hop prices its
+// token in in units of its token out. Since logarithms are additive, the product of the geometric TWAPs of the hops
+// is exactly the geometric TWAP of the route price.
+//
+// This function will error if:
+// * the route is empty, or has a hop whose token out is its token in
+// * the most recent record of any hop was written more than MaxRouteHopRecordAge before the block time,
+// in which case the error is a RouteHopTwapError wrapping a StaleTwapRecordError and no price is returned
+// * getting the TWAP of any hop errors as GetGeometricTwap does, in which case the error is a RouteHopTwapError
+// identifying the hop. If the records of the hop are missing, for example because the pool does not exist,
+// does not contain the assets of the hop, or was created after startTime, no price is returned.
+// If the spot price of any hop errored within the time range, the composed price is returned along with
+// the RouteHopTwapError of the first such hop, and could be faulty.
+func (k Keeper) GetGeometricTwapForRoute(
+	ctx sdk.Context,
+	baseAssetDenom string,
+	routes poolmanagertypes.SwapAmountInRoutes,
+	startTime time.Time,
+	endTime time.Time,
+) (osmomath.Dec, error) {
+	return k.getTwapForRoute(ctx, baseAssetDenom, routes, startTime, endTime, k.GetGeometricStrategy())
+}
+
+// MaxRouteHopRecordAge is the maximum age, relative to the block time, of the most recent record of every
+// hop of a route priced by GetArithmeticTwapForRoute and GetGeometricTwapForRoute. The records of a pool are
+// only updated when it changes, so the TWAP of a pool left untouched for longer only repeats its last spot price.
+const MaxRouteHopRecordAge = 24 * time.Hour
+
+// getTwapForRoute composes the twaps of every hop of the route, computed with the given strategy,
+// into the price of the base asset in units of the last token out denom of the route.
+func (k Keeper) getTwapForRoute(
+	ctx sdk.Context,
+	baseAssetDenom string,
+	routes poolmanagertypes.SwapAmountInRoutes,
+	startTime time.Time,
+	endTime time.Time,
+	strategy twapStrategy,
+) (osmomath.Dec, error) {
+	if err := routes.Validate(); err != nil {
+		return osmomath.Dec{}, err
+	}
+
+	var faultyErr error
+	routePrice := osmomath.OneDec()
+	hopBaseDenom := baseAssetDenom
+	for i, route := range routes {
+		if route.TokenOutDenom == hopBaseDenom {
+			return osmomath.Dec{}, fmt.Errorf("hop %d of the route has the same token in and token out denom %s", i, hopBaseDenom)
+		}
+
+		if err := k.checkRouteHopRecordAge(ctx, route.PoolId, hopBaseDenom, route.TokenOutDenom); err != nil {
+			return osmomath.Dec{}, types.RouteHopTwapError{HopIndex: i, PoolId: route.PoolId, BaseAsset: hopBaseDenom, QuoteAsset: route.TokenOutDenom, Err: err}
+		}
+
+		twap, err := k.getTwap(ctx, route.PoolId, hopBaseDenom, route.TokenOutDenom, startTime, endTime, strategy)
+		if err != nil {
+			hopErr := types.RouteHopTwapError{HopIndex: i, PoolId: route.PoolId, BaseAsset: hopBaseDenom, QuoteAsset: route.TokenOutDenom, Err: err}
+			// twaps are returned along with an error if they could be faulty
+			if twap.IsNil() {
+				return osmomath.Dec{}, hopErr
+			}
+			if faultyErr == nil {
+				faultyErr = hopErr
+			}
+		}
+
+		routePrice = routePrice.Mul(twap)
+		hopBaseDenom = route.TokenOutDenom
+	}
+	return routePrice, faultyErr
+}
+
+// checkRouteHopRecordAge returns a StaleTwapRecordError if the most recent record of the given assets in the pool
+// was written more than MaxRouteHopRecordAge before the block time.
+func (k Keeper) checkRouteHopRecordAge(ctx sdk.Context, poolId uint64, baseAssetDenom, quoteAssetDenom string) error {
+	record, err := k.getMostRecentRecordStoreRepresentation(ctx, poolId, baseAssetDenom, quoteAssetDenom)
+	if err != nil {
+		return err
+	}
+	if ctx.BlockTime().Sub(record.Time) > MaxRouteHopRecordAge {
+		return types.StaleTwapRecordError{PoolId: poolId, LastRecordTime: record.Time, BlockTime: ctx.BlockTime(), MaxAge: MaxRouteHopRecordAge}
+	}
+	return nil
+}
+
+// GetVolumeWeightedTwap returns the volume weighted average price (VWAP) of the base asset, in units of the
+// quote asset, from (startTime, endTime], as determined by the swaps of the base asset for the quote asset,
+// and vice versa, in pool `poolId`. Swap volumes are accounted for at the end of the block they occurred in.
+//
+// In addition to the errors returned by GetArithmeticTwap, this function errors if the base asset was not
+// swapped for the quote asset, or vice versa, within the time range.
+func (k Keeper) GetVolumeWeightedTwap(
 	ctx sdk.Context,
 	poolId uint64,
 	baseAssetDenom string,
 	quoteAssetDenom string,
 	startTime time.Time,
 	endTime time.Time,
-	strategy twapStrategy,
 ) (osmomath.Dec, error) {
-	if startTime.After(endTime) {
-		return osmomath.Dec{}, types.StartTimeAfterEndTimeError{StartTime: startTime, EndTime: endTime}
+	startRecord, endRecord, err := k.getIntervalRecords(ctx, poolId, baseAssetDenom, quoteAssetDenom, startTime, endTime)
+	if err != nil {
+		return osmomath.Dec{}, err
 	}
-	if endTime.Equal(ctx.BlockTime()) {
-		return k.getTwapToNow(ctx, poolId, baseAssetDenom, quoteAssetDenom, startTime, strategy)
-	} else if endTime.After(ctx.BlockTime()) {
-		return osmomath.Dec{}, types.EndTimeInFutureError{EndTime: endTime, BlockTime: ctx.BlockTime()}
+	return computeVolumeWeightedTwap(startRecord, endRecord, poolId, baseAssetDenom, quoteAssetDenom, k.GetVolumeWeightedStrategy())
+}
+
+// GetVolumeWeightedTwapToNow returns the volume weighted average price from start time until the current
+// block time for quote and base assets in a given pool.
+func (k Keeper) GetVolumeWeightedTwapToNow(
+	ctx sdk.Context,
+	poolId uint64,
+	baseAssetDenom string,
+	quoteAssetDenom string,
+	startTime time.Time,
+) (osmomath.Dec, error) {
+	startRecord, endRecord, err := k.getIntervalRecordsToNow(ctx, poolId, baseAssetDenom, quoteAssetDenom, startTime)
+	if err != nil {
+		return osmomath.Dec{}, err
 	}
-	startRecord, err := k.getInterpolatedRecord(ctx, poolId, startTime, baseAssetDenom, quoteAssetDenom)
+	return computeVolumeWeightedTwap(startRecord, endRecord, poolId, baseAssetDenom, quoteAssetDenom, k.GetVolumeWeightedStrategy())
+}
+
+// GetLogPriceVariance returns the time weighted variance of the base 2 logarithm of the spot price
+// of the base asset, in units of the quote asset, from (startTime, endTime) in pool `poolId`.
+// The variance of the log price of the quote asset in units of the base asset is the same.
+//
+// This function has the same semantics and errors as GetArithmeticTwap, and additionally errors if the
+// start time is before the log price variance was tracked for the pool, that is before the first
+// update of its records after the log price squared accumulator was introduced.
+func (k Keeper) GetLogPriceVariance(
+	ctx sdk.Context,
+	poolId uint64,
+	baseAssetDenom string,
+	quoteAssetDenom string,
+	startTime time.Time,
+	endTime time.Time,
+) (osmomath.Dec, error) {
+	startRecord, endRecord, err := k.getIntervalRecords(ctx, poolId, baseAssetDenom, quoteAssetDenom, startTime, endTime)
 	if err != nil {
 		return osmomath.Dec{}, err
 	}
-	endRecord, err := k.getInterpolatedRecord(ctx, poolId, endTime, baseAssetDenom, quoteAssetDenom)
+	if startRecord.LogPriceSquaredAccumulator == nil || endRecord.LogPriceSquaredAccumulator == nil {
+		return osmomath.Dec{}, types.LogPriceVarianceUnavailableError{
+			PoolId:     poolId,
+			Asset0:     startRecord.Asset0Denom,
+			Asset1:     startRecord.Asset1Denom,
+			RecordTime: startTime,
+		}
+	}
+	return computeLogPriceVariance(startRecord, endRecord)
+}
+
+// GetRealizedVolatility returns the realized volatility of the base asset price, in units of the quote asset,
+// from (startTime, endTime) in pool `poolId`. That is the standard deviation of the base 2 logarithm of the price,
+// i.e. the square root of GetLogPriceVariance, with which it shares its semantics and errors.
+func (k Keeper) GetRealizedVolatility(
+	ctx sdk.Context,
+	poolId uint64,
+	baseAssetDenom string,
+	quoteAssetDenom string,
+	startTime time.Time,
+	endTime time.Time,
+) (osmomath.Dec, error) {
+	variance, err := k.GetLogPriceVariance(ctx, poolId, baseAssetDenom, quoteAssetDenom, startTime, endTime)
+	if variance.IsNil() {
+		return osmomath.Dec{}, err
+	}
+	volatility, sqrtErr := osmomath.MonotonicSqrt(variance)
+	if sqrtErr != nil {
+		return osmomath.Dec{}, sqrtErr
+	}
+	return volatility, err
+}
+
+// GetSpotPriceRange returns the lowest and the highest spot price of the base asset, in units of the quote asset,
+// from (startTime, endTime) in pool `poolId`. These are taken from the spot prices recorded at the end of every
+// block within the time range, as well as the spot price in effect at startTime.
+//
+// This function will error if:
+// * startTime > endTime
+// * endTime in the future
+// * startTime older than 48 hours OR pool creation
+// * pool with id poolId does not exist, or does not contain quoteAssetDenom, baseAssetDenom
+// * there was an error in getting the pool's spot price within the time range,
+// in which case the returned prices could be faulty
+func (k Keeper) GetSpotPriceRange(
+	ctx sdk.Context,
+	poolId uint64,
+	baseAssetDenom string,
+	quoteAssetDenom string,
+	startTime time.Time,
+	endTime time.Time,
+) (minPrice osmomath.Dec, maxPrice osmomath.Dec, err error) {
+	if startTime.After(endTime) {
+		return osmomath.Dec{}, osmomath.Dec{}, types.StartTimeAfterEndTimeError{StartTime: startTime, EndTime: endTime}
+	}
+	if endTime.After(ctx.BlockTime()) {
+		return osmomath.Dec{}, osmomath.Dec{}, types.EndTimeInFutureError{EndTime: endTime, BlockTime: ctx.BlockTime()}
+	}
+
+	startRecord, err := k.getRecordAtOrBeforeTime(ctx, poolId, startTime, baseAssetDenom, quoteAssetDenom)
+	if err != nil {
+		return osmomath.Dec{}, osmomath.Dec{}, err
+	}
+	records, err := k.getRecordsInTimeRange(ctx, poolId, startTime, endTime, baseAssetDenom, quoteAssetDenom)
+	if err != nil {
+		return osmomath.Dec{}, osmomath.Dec{}, err
+	}
+	records = append([]types.TwapRecord{startRecord}, records...)
+
+	isQuoteAsset0 := quoteAssetDenom == startRecord.Asset0Denom
+	for i, record := range records {
+		price := record.P1LastSpotPrice
+		if isQuoteAsset0 {
+			price = record.P0LastSpotPrice
+		}
+		if i == 0 || price.LT(minPrice) {
+			minPrice = price
+		}
+		if i == 0 || price.GT(maxPrice) {
+			maxPrice = price
+		}
+	}
+
+	// the last error time is inherited by the subsequent records, so it is enough to check the last one
+	lastRecord := records[len(records)-1]
+	if !lastRecord.LastErrorTime.Before(startTime) || startRecord.LastErrorTime.Equal(startRecord.Time) {
+		err = errors.New("twap: error in pool spot price occurred between start and end time, spot price range may be faulty")
+	}
+	return minPrice, maxPrice, err
+}
+
+// computeVolumeWeightedTwap computes the volume weighted average price between two records. It returns
+// an error if the base asset was not swapped for the quote asset, or vice versa, between the records.
+// The accumulated volumes are checked rather than the price, as a tiny price may truncate to zero.
+func computeVolumeWeightedTwap(
+	startRecord types.TwapRecord,
+	endRecord types.TwapRecord,
+	poolId uint64,
+	baseAssetDenom string,
+	quoteAssetDenom string,
+	strategy twapStrategy,
+) (osmomath.Dec, error) {
+	if endRecord.Time.After(startRecord.Time) {
+		quoteVolume, baseVolume := swapVolumes(startRecord, endRecord, quoteAssetDenom)
+		if !quoteVolume.IsPositive() || !baseVolume.IsPositive() {
+			return osmomath.Dec{}, types.NoSwapVolumeError{
+				PoolId:     poolId,
+				BaseAsset:  baseAssetDenom,
+				QuoteAsset: quoteAssetDenom,
+				StartTime:  startRecord.Time,
+				EndTime:    endRecord.Time,
+			}
+		}
+	}
+	return computeTwap(startRecord, endRecord, quoteAssetDenom, strategy)
+}
+
+// getTwap computes and returns twap from the start time until the end time. The type
+// of twap returned depends on the strategy given and can be either arithmetic, geometric or volume weighted.
+func (k Keeper) getTwap(
+	ctx sdk.Context,
+	poolId uint64,
+	baseAssetDenom string,
+	quoteAssetDenom string,
+	startTime time.Time,
+	endTime time.Time,
+	strategy twapStrategy,
+) (osmomath.Dec, error) {
+	startRecord, endRecord, err := k.getIntervalRecords(ctx, poolId, baseAssetDenom, quoteAssetDenom, startTime, endTime)
 	if err != nil {
 		return osmomath.Dec{}, err
 	}
@@ -111,7 +380,7 @@ func (k Keeper) getTwap(
 }
 
 // getTwapToNow computes and returns twap from the start time until the current block time. The type
-// of twap returned depends on the strategy given and can be either arithmetic or geometric.
+// of twap returned depends on the strategy given and can be either arithmetic, geometric or volume weighted.
 func (k Keeper) getTwapToNow(
 	ctx sdk.Context,
 	poolId uint64,
@@ -120,20 +389,64 @@ func (k Keeper) getTwapToNow(
 	startTime time.Time,
 	strategy twapStrategy,
 ) (osmomath.Dec, error) {
-	if startTime.After(ctx.BlockTime()) {
-		return osmomath.Dec{}, types.StartTimeAfterEndTimeError{StartTime: startTime, EndTime: ctx.BlockTime()}
+	startRecord, endRecord, err := k.getIntervalRecordsToNow(ctx, poolId, baseAssetDenom, quoteAssetDenom, startTime)
+	if err != nil {
+		return osmomath.Dec{}, err
 	}
 
-	startRecord, err := k.getInterpolatedRecord(ctx, poolId, startTime, baseAssetDenom, quoteAssetDenom)
+	return computeTwap(startRecord, endRecord, quoteAssetDenom, strategy)
+}
+
+// getIntervalRecords returns the records of the asset pair interpolated to the start time and the end time.
+func (k Keeper) getIntervalRecords(
+	ctx sdk.Context,
+	poolId uint64,
+	baseAssetDenom string,
+	quoteAssetDenom string,
+	startTime time.Time,
+	endTime time.Time,
+) (startRecord types.TwapRecord, endRecord types.TwapRecord, err error) {
+	if startTime.After(endTime) {
+		return types.TwapRecord{}, types.TwapRecord{}, types.StartTimeAfterEndTimeError{StartTime: startTime, EndTime: endTime}
+	}
+	if endTime.Equal(ctx.BlockTime()) {
+		return k.getIntervalRecordsToNow(ctx, poolId, baseAssetDenom, quoteAssetDenom, startTime)
+	} else if endTime.After(ctx.BlockTime()) {
+		return types.TwapRecord{}, types.TwapRecord{}, types.EndTimeInFutureError{EndTime: endTime, BlockTime: ctx.BlockTime()}
+	}
+	startRecord, err = k.getInterpolatedRecord(ctx, poolId, startTime, baseAssetDenom, quoteAssetDenom)
 	if err != nil {
-		return osmomath.Dec{}, err
+		return types.TwapRecord{}, types.TwapRecord{}, err
 	}
-	endRecord, err := k.GetBeginBlockAccumulatorRecord(ctx, poolId, baseAssetDenom, quoteAssetDenom)
+	endRecord, err = k.getInterpolatedRecord(ctx, poolId, endTime, baseAssetDenom, quoteAssetDenom)
 	if err != nil {
-		return osmomath.Dec{}, err
+		return types.TwapRecord{}, types.TwapRecord{}, err
 	}
+	return startRecord, endRecord, nil
+}
 
-	return computeTwap(startRecord, endRecord, quoteAssetDenom, strategy)
+// getIntervalRecordsToNow returns the records of the asset pair interpolated to the start time
+// and to the current block time.
+func (k Keeper) getIntervalRecordsToNow(
+	ctx sdk.Context,
+	poolId uint64,
+	baseAssetDenom string,
+	quoteAssetDenom string,
+	startTime time.Time,
+) (startRecord types.TwapRecord, endRecord types.TwapRecord, err error) {
+	if startTime.After(ctx.BlockTime()) {
+		return types.TwapRecord{}, types.TwapRecord{}, types.StartTimeAfterEndTimeError{StartTime: startTime, EndTime: ctx.BlockTime()}
+	}
+
+	startRecord, err = k.getInterpolatedRecord(ctx, poolId, startTime, baseAssetDenom, quoteAssetDenom)
+	if err != nil {
+		return types.TwapRecord{}, types.TwapRecord{}, err
+	}
+	endRecord, err = k.GetBeginBlockAccumulatorRecord(ctx, poolId, baseAssetDenom, quoteAssetDenom)
+	if err != nil {
+		return types.TwapRecord{}, types.TwapRecord{}, err
+	}
+	return startRecord, endRecord, nil
 }
 
 // GetBeginBlockAccumulatorRecord returns a TwapRecord struct corresponding to the state of pool `poolId`
@@ -11,6 +11,7 @@ import (
 	"github.com/osmosis-labs/osmosis/osmomath"
 	sdkrand "github.com/osmosis-labs/osmosis/v24/simulation/simtypes/random"
 	"github.com/osmosis-labs/osmosis/v24/x/gamm/pool-models/balancer"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v24/x/poolmanager/types"
 	"github.com/osmosis-labs/osmosis/v24/x/twap"
 	"github.com/osmosis-labs/osmosis/v24/x/twap/types"
 )
@@ -909,3 +910,406 @@ func (s *TestSuite) TestGeometricTwapToNow_BalancerPool_Randomized() {
 		})
 	}
 }
+
+// TestGetVolumeWeightedTwap tests if we get the expected volume weighted average price from
+// `GetVolumeWeightedTwap` and `GetVolumeWeightedTwapToNow`, using records stored manually.
+func (s *TestSuite) TestGetVolumeWeightedTwap() {
+	// 100 denom0 swapped for 1000 denom1 by t + 10s, then 300 denom0 swapped for 300 denom1 by t + 20s
+	tPlus10Record := withVolumes(withTime(baseRecord, baseTime.Add(10*time.Second)), 100, 1000)
+	tPlus20Record := withVolumes(withTime(baseRecord, baseTime.Add(20*time.Second)), 400, 1300)
+	// 1 denom0 swapped for 10^19 denom1 by t + 10s
+	tinyPriceRecord := withTime(baseRecord, baseTime.Add(10*time.Second))
+	tinyVolume0, hugeVolume1 := osmomath.OneInt(), osmomath.NewIntWithDecimal(1, 19)
+	tinyPriceRecord.Asset0VolumeAccumulator, tinyPriceRecord.Asset1VolumeAccumulator = &tinyVolume0, &hugeVolume1
+
+	tests := map[string]struct {
+		recordsToSet  []types.TwapRecord
+		input         getTwapInput
+		toNow         bool
+		expTwap       osmomath.Dec
+		expectedError error
+	}{
+		"volume in both blocks": {
+			recordsToSet: []types.TwapRecord{baseRecord, tPlus10Record, tPlus20Record},
+			input:        makeSimpleTwapInput(baseTime, baseTime.Add(20*time.Second), baseQuoteBA),
+			expTwap:      osmomath.MustNewDecFromStr("0.307692307692307692"), // 400 / 1300
+		},
+		"volume in both blocks, use asset1 as quote": {
+			recordsToSet: []types.TwapRecord{baseRecord, tPlus10Record, tPlus20Record},
+			input:        makeSimpleTwapInput(baseTime, baseTime.Add(20*time.Second), baseQuoteAB),
+			expTwap:      osmomath.NewDec(1300).QuoInt64(400),
+		},
+		"only volume in second block": {
+			recordsToSet: []types.TwapRecord{baseRecord, tPlus10Record, tPlus20Record},
+			input:        makeSimpleTwapInput(baseTime.Add(10*time.Second), baseTime.Add(20*time.Second), baseQuoteBA),
+			expTwap:      osmomath.OneDec(),
+		},
+		"to now": {
+			recordsToSet: []types.TwapRecord{baseRecord, tPlus10Record, tPlus20Record},
+			input:        makeSimpleTwapInput(baseTime.Add(5*time.Second), tPlusOneMin, baseQuoteBA),
+			toNow:        true,
+			expTwap:      osmomath.MustNewDecFromStr("0.307692307692307692"),
+		},
+		"volume with price truncated to zero": {
+			recordsToSet: []types.TwapRecord{baseRecord, tinyPriceRecord},
+			input:        makeSimpleTwapInput(baseTime, baseTime.Add(10*time.Second), baseQuoteBA),
+			expTwap:      osmomath.ZeroDec(),
+		},
+		"no volume in time range": {
+			recordsToSet: []types.TwapRecord{baseRecord, tPlus10Record, tPlus20Record},
+			input:        makeSimpleTwapInput(baseTime.Add(20*time.Second), tPlusOneMin, baseQuoteBA),
+			expectedError: types.NoSwapVolumeError{
+				PoolId:     0,
+				BaseAsset:  denom1,
+				QuoteAsset: denom0,
+				StartTime:  baseTime.Add(20 * time.Second),
+				EndTime:    tPlusOneMin,
+			},
+		},
+	}
+	counter := uint64(0)
+	for name, test := range tests {
+		curPoolId := counter
+		s.Run(name, func() {
+			s.preSetRecordsWithPoolId(curPoolId, test.recordsToSet)
+			s.Ctx = s.Ctx.WithBlockTime(tPlusOneMin)
+
+			var twap osmomath.Dec
+			var err error
+			if test.toNow {
+				twap, err = s.twapkeeper.GetVolumeWeightedTwapToNow(s.Ctx, curPoolId,
+					test.input.baseAssetDenom, test.input.quoteAssetDenom, test.input.startTime)
+			} else {
+				twap, err = s.twapkeeper.GetVolumeWeightedTwap(s.Ctx, curPoolId,
+					test.input.baseAssetDenom, test.input.quoteAssetDenom, test.input.startTime, test.input.endTime)
+			}
+
+			if test.expectedError != nil {
+				if noVolumeErr, ok := test.expectedError.(types.NoSwapVolumeError); ok {
+					noVolumeErr.PoolId = curPoolId
+					test.expectedError = noVolumeErr
+				}
+				s.Require().Equal(test.expectedError, err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().True(test.expTwap.Equal(twap), "expected %s, got %s", test.expTwap, twap)
+		})
+		counter++
+	}
+}
+
+func (s *TestSuite) TestGetLogPriceVariance() {
+	// log_{2}{P_0} is 1 until t + 10s, then 3
+	sp2Record := withLogPriceSquaredAccum(newTwoAssetPoolTwapRecordWithDefaults(baseTime, osmomath.NewDec(2), osmomath.ZeroDec(), osmomath.ZeroDec(), osmomath.ZeroDec()), osmomath.ZeroDec())
+	sp8Record := withLogPriceSquaredAccum(newTwoAssetPoolTwapRecordWithDefaults(baseTime.Add(10*time.Second), osmomath.NewDec(8), osmomath.ZeroDec(), osmomath.ZeroDec(), osmomath.NewDec(10_000)), osmomath.NewDec(10_000))
+	legacyRecord := newTwoAssetPoolTwapRecordWithDefaults(baseTime, osmomath.NewDec(2), osmomath.ZeroDec(), osmomath.ZeroDec(), osmomath.ZeroDec())
+
+	tests := map[string]struct {
+		recordsToSet  []types.TwapRecord
+		input         getTwapInput
+		expVariance   osmomath.Dec
+		expVolatility osmomath.Dec
+		expectedError error
+	}{
+		"constant price": {
+			recordsToSet:  []types.TwapRecord{sp2Record, sp8Record},
+			input:         makeSimpleTwapInput(baseTime, baseTime.Add(10*time.Second), baseQuoteBA),
+			expVariance:   osmomath.ZeroDec(),
+			expVolatility: osmomath.ZeroDec(),
+		},
+		"price change": {
+			recordsToSet:  []types.TwapRecord{sp2Record, sp8Record},
+			input:         makeSimpleTwapInput(baseTime, baseTime.Add(20*time.Second), baseQuoteBA),
+			expVariance:   osmomath.OneDec(),
+			expVolatility: osmomath.OneDec(),
+		},
+		"price change, use asset1 as quote": {
+			recordsToSet:  []types.TwapRecord{sp2Record, sp8Record},
+			input:         makeSimpleTwapInput(baseTime, baseTime.Add(20*time.Second), baseQuoteAB),
+			expVariance:   osmomath.OneDec(),
+			expVolatility: osmomath.OneDec(),
+		},
+		"interpolated start and end": {
+			recordsToSet:  []types.TwapRecord{sp2Record, sp8Record},
+			input:         makeSimpleTwapInput(baseTime.Add(5*time.Second), baseTime.Add(15*time.Second), baseQuoteBA),
+			expVariance:   osmomath.OneDec(),
+			expVolatility: osmomath.OneDec(),
+		},
+		"start time equals end time": {
+			recordsToSet:  []types.TwapRecord{sp2Record, sp8Record},
+			input:         makeSimpleTwapInput(baseTime.Add(5*time.Second), baseTime.Add(5*time.Second), baseQuoteBA),
+			expVariance:   osmomath.ZeroDec(),
+			expVolatility: osmomath.ZeroDec(),
+		},
+		"start record predates the log price squared accumulator": {
+			recordsToSet: []types.TwapRecord{legacyRecord, sp8Record},
+			input:        makeSimpleTwapInput(baseTime, baseTime.Add(20*time.Second), baseQuoteBA),
+			expectedError: types.LogPriceVarianceUnavailableError{
+				Asset0:     denom0,
+				Asset1:     denom1,
+				RecordTime: baseTime,
+			},
+		},
+		"start time after end time": {
+			recordsToSet:  []types.TwapRecord{sp2Record, sp8Record},
+			input:         makeSimpleTwapInput(baseTime.Add(20*time.Second), baseTime, baseQuoteBA),
+			expectedError: types.StartTimeAfterEndTimeError{StartTime: baseTime.Add(20 * time.Second), EndTime: baseTime},
+		},
+	}
+	counter := uint64(0)
+	for name, test := range tests {
+		curPoolId := counter
+		s.Run(name, func() {
+			s.preSetRecordsWithPoolId(curPoolId, test.recordsToSet)
+			s.Ctx = s.Ctx.WithBlockTime(tPlusOneMin)
+
+			variance, err := s.twapkeeper.GetLogPriceVariance(s.Ctx, curPoolId,
+				test.input.baseAssetDenom, test.input.quoteAssetDenom, test.input.startTime, test.input.endTime)
+			volatility, volatilityErr := s.twapkeeper.GetRealizedVolatility(s.Ctx, curPoolId,
+				test.input.baseAssetDenom, test.input.quoteAssetDenom, test.input.startTime, test.input.endTime)
+
+			if test.expectedError != nil {
+				if unavailableErr, ok := test.expectedError.(types.LogPriceVarianceUnavailableError); ok {
+					unavailableErr.PoolId = curPoolId
+					test.expectedError = unavailableErr
+				}
+				s.Require().Equal(test.expectedError, err)
+				s.Require().Equal(test.expectedError, volatilityErr)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().NoError(volatilityErr)
+			s.Require().Equal(test.expVariance.String(), variance.String())
+			s.Require().Equal(test.expVolatility.String(), volatility.String())
+		})
+		counter++
+	}
+}
+
+func (s *TestSuite) TestGetSpotPriceRange() {
+	sp2Record := newTwoAssetPoolTwapRecordWithDefaults(baseTime, osmomath.NewDec(2), osmomath.ZeroDec(), osmomath.ZeroDec(), osmomath.ZeroDec())
+	sp8Record := newTwoAssetPoolTwapRecordWithDefaults(baseTime.Add(10*time.Second), osmomath.NewDec(8), osmomath.ZeroDec(), osmomath.ZeroDec(), osmomath.ZeroDec())
+	sp4Record := newTwoAssetPoolTwapRecordWithDefaults(baseTime.Add(20*time.Second), osmomath.NewDec(4), osmomath.ZeroDec(), osmomath.ZeroDec(), osmomath.ZeroDec())
+	records := []types.TwapRecord{sp2Record, sp8Record, sp4Record}
+
+	tests := map[string]struct {
+		recordsToSet  []types.TwapRecord
+		input         getTwapInput
+		expMin        osmomath.Dec
+		expMax        osmomath.Dec
+		expectedError error
+	}{
+		"all records": {
+			recordsToSet: records,
+			input:        makeSimpleTwapInput(baseTime, tPlusOneMin, baseQuoteBA),
+			expMin:       osmomath.NewDec(2),
+			expMax:       osmomath.NewDec(8),
+		},
+		"all records, use asset1 as quote": {
+			recordsToSet: records,
+			input:        makeSimpleTwapInput(baseTime, tPlusOneMin, baseQuoteAB),
+			expMin:       osmomath.OneDec().QuoInt64(8),
+			expMax:       osmomath.OneDec().QuoInt64(2),
+		},
+		"start price is the one in effect at start time": {
+			recordsToSet: records,
+			input:        makeSimpleTwapInput(baseTime.Add(15*time.Second), tPlusOneMin, baseQuoteBA),
+			expMin:       osmomath.NewDec(4),
+			expMax:       osmomath.NewDec(8),
+		},
+		"end time excludes later records": {
+			recordsToSet: records,
+			input:        makeSimpleTwapInput(baseTime, baseTime.Add(15*time.Second), baseQuoteBA),
+			expMin:       osmomath.NewDec(2),
+			expMax:       osmomath.NewDec(8),
+		},
+		"single price": {
+			recordsToSet: records,
+			input:        makeSimpleTwapInput(baseTime.Add(20*time.Second), baseTime.Add(20*time.Second), baseQuoteBA),
+			expMin:       osmomath.NewDec(4),
+			expMax:       osmomath.NewDec(4),
+		},
+		"end time in the future": {
+			recordsToSet:  records,
+			input:         makeSimpleTwapInput(baseTime, tPlusOneMin.Add(time.Second), baseQuoteBA),
+			expectedError: types.EndTimeInFutureError{EndTime: tPlusOneMin.Add(time.Second), BlockTime: tPlusOneMin},
+		},
+		"start time after end time": {
+			recordsToSet:  records,
+			input:         makeSimpleTwapInput(baseTime.Add(20*time.Second), baseTime, baseQuoteBA),
+			expectedError: types.StartTimeAfterEndTimeError{StartTime: baseTime.Add(20 * time.Second), EndTime: baseTime},
+		},
+	}
+	counter := uint64(0)
+	for name, test := range tests {
+		curPoolId := counter
+		s.Run(name, func() {
+			s.preSetRecordsWithPoolId(curPoolId, test.recordsToSet)
+			s.Ctx = s.Ctx.WithBlockTime(tPlusOneMin)
+
+			minPrice, maxPrice, err := s.twapkeeper.GetSpotPriceRange(s.Ctx, curPoolId,
+				test.input.baseAssetDenom, test.input.quoteAssetDenom, test.input.startTime, test.input.endTime)
+
+			if test.expectedError != nil {
+				s.Require().Equal(test.expectedError, err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(test.expMin.String(), minPrice.String())
+			s.Require().Equal(test.expMax.String(), maxPrice.String())
+		})
+		counter++
+	}
+}
+
+func (s *TestSuite) TestGetTwapForRoute() {
+	// price of denom1 in units of denom0 is 10
+	poolABRecord := newTwoAssetPoolTwapRecordWithDefaults(baseTime, osmomath.NewDec(10), osmomath.ZeroDec(), osmomath.ZeroDec(), osmomath.ZeroDec())
+	// price of denom2 in units of denom1 is 4
+	poolBCRecord := newTwoAssetPoolTwapRecordWithDefaults(baseTime, osmomath.NewDec(4), osmomath.ZeroDec(), osmomath.ZeroDec(), osmomath.ZeroDec())
+	poolBCRecord.Asset0Denom, poolBCRecord.Asset1Denom = denom1, denom2
+	poolABId, poolBCId := uint64(1), uint64(2)
+
+	tests := map[string]struct {
+		poolABRecord   types.TwapRecord
+		baseAssetDenom string
+		routes         poolmanagertypes.SwapAmountInRoutes
+		startTime      time.Time
+		endTime        time.Time
+		expTwap        osmomath.Dec
+		expHopErrIndex int
+		expStaleErr    bool
+		expectPrice    bool
+		expectedError  bool
+	}{
+		"single hop": {
+			poolABRecord:   poolABRecord,
+			baseAssetDenom: denom1,
+			routes:         []poolmanagertypes.SwapAmountInRoute{{PoolId: poolABId, TokenOutDenom: denom0}},
+			startTime:      baseTime,
+			expTwap:        osmomath.NewDec(10),
+			expectPrice:    true,
+		},
+		"two hops": {
+			poolABRecord:   poolABRecord,
+			baseAssetDenom: denom2,
+			routes:         []poolmanagertypes.SwapAmountInRoute{{PoolId: poolBCId, TokenOutDenom: denom1}, {PoolId: poolABId, TokenOutDenom: denom0}},
+			startTime:      baseTime,
+			expTwap:        osmomath.NewDec(40),
+			expectPrice:    true,
+		},
+		"two hops, reverse direction": {
+			poolABRecord:   poolABRecord,
+			baseAssetDenom: denom0,
+			routes:         []poolmanagertypes.SwapAmountInRoute{{PoolId: poolABId, TokenOutDenom: denom1}, {PoolId: poolBCId, TokenOutDenom: denom2}},
+			startTime:      baseTime,
+			expTwap:        osmomath.MustNewDecFromStr("0.025"),
+			expectPrice:    true,
+		},
+		"spot price error in second hop: price returned with error": {
+			poolABRecord:   withLastErrTime(poolABRecord, baseTime.Add(time.Second)),
+			baseAssetDenom: denom2,
+			routes:         []poolmanagertypes.SwapAmountInRoute{{PoolId: poolBCId, TokenOutDenom: denom1}, {PoolId: poolABId, TokenOutDenom: denom0}},
+			startTime:      baseTime,
+			expTwap:        osmomath.NewDec(40),
+			expHopErrIndex: 1,
+			expectPrice:    true,
+			expectedError:  true,
+		},
+		"start time before the records of the second hop": {
+			poolABRecord:   withTime(poolABRecord, baseTime.Add(time.Second)),
+			baseAssetDenom: denom2,
+			routes:         []poolmanagertypes.SwapAmountInRoute{{PoolId: poolBCId, TokenOutDenom: denom1}, {PoolId: poolABId, TokenOutDenom: denom0}},
+			startTime:      baseTime,
+			expHopErrIndex: 1,
+			expectedError:  true,
+		},
+		"records of the second hop older than the max age: no price": {
+			poolABRecord:   withTime(poolABRecord, baseTime.Add(twap.MaxRouteHopRecordAge)),
+			baseAssetDenom: denom0,
+			routes:         []poolmanagertypes.SwapAmountInRoute{{PoolId: poolABId, TokenOutDenom: denom1}, {PoolId: poolBCId, TokenOutDenom: denom2}},
+			startTime:      baseTime.Add(twap.MaxRouteHopRecordAge),
+			endTime:        baseTime.Add(twap.MaxRouteHopRecordAge + time.Minute),
+			expHopErrIndex: 1,
+			expStaleErr:    true,
+			expectedError:  true,
+		},
+		"records of every hop exactly at the max age": {
+			poolABRecord:   poolABRecord,
+			baseAssetDenom: denom0,
+			routes:         []poolmanagertypes.SwapAmountInRoute{{PoolId: poolABId, TokenOutDenom: denom1}, {PoolId: poolBCId, TokenOutDenom: denom2}},
+			startTime:      baseTime,
+			endTime:        baseTime.Add(twap.MaxRouteHopRecordAge),
+			expTwap:        osmomath.MustNewDecFromStr("0.025"),
+			expectPrice:    true,
+		},
+		"pool does not contain the assets of the hop": {
+			poolABRecord:   poolABRecord,
+			baseAssetDenom: denom2,
+			routes:         []poolmanagertypes.SwapAmountInRoute{{PoolId: poolABId, TokenOutDenom: denom0}},
+			startTime:      baseTime,
+			expHopErrIndex: 0,
+			expectedError:  true,
+		},
+		"hop with the same token in and token out": {
+			poolABRecord:   poolABRecord,
+			baseAssetDenom: denom1,
+			routes:         []poolmanagertypes.SwapAmountInRoute{{PoolId: poolABId, TokenOutDenom: denom1}},
+			startTime:      baseTime,
+			expHopErrIndex: -1,
+			expectedError:  true,
+		},
+		"empty route": {
+			poolABRecord:   poolABRecord,
+			baseAssetDenom: denom1,
+			routes:         []poolmanagertypes.SwapAmountInRoute{},
+			startTime:      baseTime,
+			expHopErrIndex: -1,
+			expectedError:  true,
+		},
+	}
+	for name, test := range tests {
+		for _, strategyName := range []string{"arithmetic", "geometric"} {
+			s.Run(name+" "+strategyName, func() {
+				s.SetupTest()
+				s.preSetRecordsWithPoolId(poolABId, []types.TwapRecord{test.poolABRecord})
+				s.preSetRecordsWithPoolId(poolBCId, []types.TwapRecord{poolBCRecord})
+				endTime := tPlusOneMin
+				if !test.endTime.IsZero() {
+					endTime = test.endTime
+				}
+				s.Ctx = s.Ctx.WithBlockTime(endTime)
+
+				var twap osmomath.Dec
+				var err error
+				if strategyName == "arithmetic" {
+					twap, err = s.twapkeeper.GetArithmeticTwapForRoute(s.Ctx, test.baseAssetDenom, test.routes, test.startTime, endTime)
+				} else {
+					twap, err = s.twapkeeper.GetGeometricTwapForRoute(s.Ctx, test.baseAssetDenom, test.routes, test.startTime, endTime)
+				}
+
+				if test.expectedError {
+					s.Require().Error(err)
+					var hopErr types.RouteHopTwapError
+					if test.expHopErrIndex >= 0 {
+						s.Require().ErrorAs(err, &hopErr)
+						s.Require().Equal(test.expHopErrIndex, hopErr.HopIndex)
+					} else {
+						s.Require().False(errors.As(err, &hopErr))
+					}
+					var staleErr types.StaleTwapRecordError
+					s.Require().Equal(test.expStaleErr, errors.As(err, &staleErr))
+				} else {
+					s.Require().NoError(err)
+				}
+
+				if test.expectPrice {
+					s.Require().Equal(test.expTwap.String(), twap.String())
+				} else {
+					s.Require().True(twap.IsNil())
+				}
+			})
+		}
+	}
+}
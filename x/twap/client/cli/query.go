@@ -30,6 +30,7 @@ func GetQueryCmd() *cobra.Command {
 	cmd := osmocli.QueryIndexCmd(types.ModuleName)
 	cmd.AddCommand(GetQueryArithmeticCommand())
 	cmd.AddCommand(GetQueryGeometricCommand())
+	cmd.AddCommand(GetQueryVolumeWeightedCommand())
 
 	return cmd
 }
@@ -134,6 +135,55 @@ Example:
 	return cmd
 }
 
+// GetQueryVolumeWeightedCommand returns a volume weighted twap query command.
+func GetQueryVolumeWeightedCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "volume-weighted [poolid] [base denom] [start time] [end time]",
+		Short:   "Query volume weighted twap",
+		Aliases: []string{"vwap"},
+		Long: osmocli.FormatLongDescDirect(`Query volume weighted twap for pool. Start time must be unix time. End time can be unix time or duration.
+
+Example:
+{{.CommandPrefix}} volume-weighted 1 uosmo 1667088000 24h
+{{.CommandPrefix}} volume-weighted 1 uosmo 1667088000 1667174400
+`, types.ModuleName),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// boilerplate parse fields
+			twapArgs, err := twapQueryParseArgs(args)
+			if err != nil {
+				return err
+			}
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			quoteDenom, err := getQuoteDenomFromLiquidity(cmd.Context(), clientCtx, twapArgs.PoolId, twapArgs.BaseDenom)
+			if err != nil {
+				return err
+			}
+
+			queryClient := queryproto.NewQueryClient(clientCtx)
+			res, err := queryClient.VolumeWeightedTwap(cmd.Context(), &queryproto.VolumeWeightedTwapRequest{
+				PoolId:     twapArgs.PoolId,
+				BaseAsset:  twapArgs.BaseDenom,
+				QuoteAsset: quoteDenom,
+				StartTime:  twapArgs.StartTime,
+				EndTime:    &twapArgs.EndTime,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // getQuoteDenomFromLiquidity gets the quote liquidity denom from the pool. In addition, validates that base denom
 // exists in the pool. Fails if not.
 func getQuoteDenomFromLiquidity(ctx context.Context, clientCtx client.Context, poolId uint64, baseDenom string) (string, error) {
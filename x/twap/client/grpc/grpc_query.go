@@ -21,6 +21,66 @@ type Querier struct {
 
 var _ queryproto.QueryServer = Querier{}
 
+func (q Querier) VolumeWeightedTwapToNow(grpcCtx context.Context,
+	req *queryproto.VolumeWeightedTwapToNowRequest,
+) (*queryproto.VolumeWeightedTwapToNowResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.VolumeWeightedTwapToNow(ctx, *req)
+}
+
+func (q Querier) VolumeWeightedTwap(grpcCtx context.Context,
+	req *queryproto.VolumeWeightedTwapRequest,
+) (*queryproto.VolumeWeightedTwapResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.VolumeWeightedTwap(ctx, *req)
+}
+
+func (q Querier) ArithmeticTwapForRoute(grpcCtx context.Context,
+	req *queryproto.ArithmeticTwapForRouteRequest,
+) (*queryproto.ArithmeticTwapForRouteResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.ArithmeticTwapForRoute(ctx, *req)
+}
+
+func (q Querier) GeometricTwapForRoute(grpcCtx context.Context,
+	req *queryproto.GeometricTwapForRouteRequest,
+) (*queryproto.GeometricTwapForRouteResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.GeometricTwapForRoute(ctx, *req)
+}
+
+func (q Querier) Volatility(grpcCtx context.Context,
+	req *queryproto.VolatilityRequest,
+) (*queryproto.VolatilityResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.Volatility(ctx, *req)
+}
+
+func (q Querier) SpotPriceRange(grpcCtx context.Context,
+	req *queryproto.SpotPriceRangeRequest,
+) (*queryproto.SpotPriceRangeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.SpotPriceRange(ctx, *req)
+}
+
 func (q Querier) Params(grpcCtx context.Context,
 	req *queryproto.ParamsRequest,
 ) (*queryproto.ParamsResponse, error) {
@@ -5,6 +5,8 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/osmosis-labs/osmosis/osmomath"
+
 	"github.com/osmosis-labs/osmosis/v24/x/twap"
 	"github.com/osmosis-labs/osmosis/v24/x/twap/client/queryproto"
 )
@@ -61,6 +63,96 @@ func (q Querier) GeometricTwapToNow(ctx sdk.Context,
 	return &queryproto.GeometricTwapToNowResponse{GeometricTwap: twap}, err
 }
 
+func (q Querier) VolumeWeightedTwap(ctx sdk.Context,
+	req queryproto.VolumeWeightedTwapRequest,
+) (*queryproto.VolumeWeightedTwapResponse, error) {
+	if req.EndTime == nil {
+		req.EndTime = &time.Time{}
+	}
+	if (*req.EndTime == time.Time{}) {
+		*req.EndTime = ctx.BlockTime()
+	}
+
+	twap, err := q.K.GetVolumeWeightedTwap(ctx, req.PoolId, req.BaseAsset, req.QuoteAsset, req.StartTime, *req.EndTime)
+
+	return &queryproto.VolumeWeightedTwapResponse{VolumeWeightedTwap: twap}, err
+}
+
+func (q Querier) VolumeWeightedTwapToNow(ctx sdk.Context,
+	req queryproto.VolumeWeightedTwapToNowRequest,
+) (*queryproto.VolumeWeightedTwapToNowResponse, error) {
+	twap, err := q.K.GetVolumeWeightedTwapToNow(ctx, req.PoolId, req.BaseAsset, req.QuoteAsset, req.StartTime)
+
+	return &queryproto.VolumeWeightedTwapToNowResponse{VolumeWeightedTwap: twap}, err
+}
+
+func (q Querier) ArithmeticTwapForRoute(ctx sdk.Context,
+	req queryproto.ArithmeticTwapForRouteRequest,
+) (*queryproto.ArithmeticTwapForRouteResponse, error) {
+	if req.EndTime == nil {
+		req.EndTime = &time.Time{}
+	}
+	if (*req.EndTime == time.Time{}) {
+		*req.EndTime = ctx.BlockTime()
+	}
+
+	twap, err := q.K.GetArithmeticTwapForRoute(ctx, req.BaseAsset, req.Routes, req.StartTime, *req.EndTime)
+
+	return &queryproto.ArithmeticTwapForRouteResponse{ArithmeticTwap: twap}, err
+}
+
+func (q Querier) GeometricTwapForRoute(ctx sdk.Context,
+	req queryproto.GeometricTwapForRouteRequest,
+) (*queryproto.GeometricTwapForRouteResponse, error) {
+	if req.EndTime == nil {
+		req.EndTime = &time.Time{}
+	}
+	if (*req.EndTime == time.Time{}) {
+		*req.EndTime = ctx.BlockTime()
+	}
+
+	twap, err := q.K.GetGeometricTwapForRoute(ctx, req.BaseAsset, req.Routes, req.StartTime, *req.EndTime)
+
+	return &queryproto.GeometricTwapForRouteResponse{GeometricTwap: twap}, err
+}
+
+func (q Querier) Volatility(ctx sdk.Context,
+	req queryproto.VolatilityRequest,
+) (*queryproto.VolatilityResponse, error) {
+	if req.EndTime == nil {
+		req.EndTime = &time.Time{}
+	}
+	if (*req.EndTime == time.Time{}) {
+		*req.EndTime = ctx.BlockTime()
+	}
+
+	variance, err := q.K.GetLogPriceVariance(ctx, req.PoolId, req.BaseAsset, req.QuoteAsset, req.StartTime, *req.EndTime)
+	if variance.IsNil() {
+		return nil, err
+	}
+	volatility, sqrtErr := osmomath.MonotonicSqrt(variance)
+	if sqrtErr != nil {
+		return nil, sqrtErr
+	}
+
+	return &queryproto.VolatilityResponse{LogPriceVariance: variance, RealizedVolatility: volatility}, err
+}
+
+func (q Querier) SpotPriceRange(ctx sdk.Context,
+	req queryproto.SpotPriceRangeRequest,
+) (*queryproto.SpotPriceRangeResponse, error) {
+	if req.EndTime == nil {
+		req.EndTime = &time.Time{}
+	}
+	if (*req.EndTime == time.Time{}) {
+		*req.EndTime = ctx.BlockTime()
+	}
+
+	minPrice, maxPrice, err := q.K.GetSpotPriceRange(ctx, req.PoolId, req.BaseAsset, req.QuoteAsset, req.StartTime, *req.EndTime)
+
+	return &queryproto.SpotPriceRangeResponse{MinSpotPrice: minPrice, MaxSpotPrice: maxPrice}, err
+}
+
 func (q Querier) Params(ctx sdk.Context,
 	req queryproto.ParamsRequest,
 ) (*queryproto.ParamsResponse, error) {
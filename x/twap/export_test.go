@@ -14,6 +14,7 @@ type (
 	TwapStrategy           = twapStrategy
 	ArithmeticTwapStrategy = arithmetic
 	GeometricTwapStrategy  = geometric
+	VolumeWeightedStrategy = volumeWeighted
 )
 
 func (k Keeper) GetMostRecentRecordStoreRepresentation(ctx sdk.Context, poolId uint64, asset0Denom string, asset1Denom string) (types.TwapRecord, error) {
@@ -24,6 +25,10 @@ func (k Keeper) GetRecordAtOrBeforeTime(ctx sdk.Context, poolId uint64, time tim
 	return k.getRecordAtOrBeforeTime(ctx, poolId, time, asset0Denom, asset1Denom)
 }
 
+func (k Keeper) GetRecordsInTimeRange(ctx sdk.Context, poolId uint64, startTime, endTime time.Time, asset0Denom string, asset1Denom string) ([]types.TwapRecord, error) {
+	return k.getRecordsInTimeRange(ctx, poolId, startTime, endTime, asset0Denom, asset1Denom)
+}
+
 func (k Keeper) TrackChangedPool(ctx sdk.Context, poolId uint64) {
 	k.trackChangedPool(ctx, poolId)
 }
@@ -32,6 +37,14 @@ func (k Keeper) GetChangedPools(ctx sdk.Context) []uint64 {
 	return k.getChangedPools(ctx)
 }
 
+func (k Keeper) TrackSwapVolume(ctx sdk.Context, poolId uint64, input sdk.Coins, output sdk.Coins) {
+	k.trackSwapVolume(ctx, poolId, input, output)
+}
+
+func (k Keeper) GetSwapVolumes(ctx sdk.Context, poolId uint64, denom0, denom1 string) (osmomath.Int, osmomath.Int) {
+	return k.getSwapVolumes(ctx, poolId, denom0, denom1)
+}
+
 func (k Keeper) UpdateRecord(ctx sdk.Context, record types.TwapRecord) (types.TwapRecord, error) {
 	return k.updateRecord(ctx, record)
 }
@@ -60,6 +73,14 @@ func (s geometric) ComputeTwap(startRecord types.TwapRecord, endRecord types.Twa
 	return s.computeTwap(startRecord, endRecord, quoteAsset)
 }
 
+func (s volumeWeighted) ComputeTwap(startRecord types.TwapRecord, endRecord types.TwapRecord, quoteAsset string) osmomath.Dec {
+	return s.computeTwap(startRecord, endRecord, quoteAsset)
+}
+
+func RecordWithUpdatedVolumes(record types.TwapRecord, volume0, volume1 osmomath.Int) types.TwapRecord {
+	return recordWithUpdatedVolumes(record, volume0, volume1)
+}
+
 func RecordWithUpdatedAccumulators(record types.TwapRecord, t time.Time) types.TwapRecord {
 	return recordWithUpdatedAccumulators(record, t)
 }
@@ -100,6 +100,11 @@ func (k Keeper) GetArithmeticStrategy() *arithmetic {
 	return &arithmetic{k}
 }
 
+// GetVolumeWeightedStrategy gets volume weighted TWAP keeper.
+func (k Keeper) GetVolumeWeightedStrategy() *volumeWeighted {
+	return &volumeWeighted{k}
+}
+
 // GetPruningState gets the current pruning state, which is used to determine
 // whether to prune historical records in the EndBlock. This allows us to spread
 // out the computational cost of pruning over time rather than all at once at epoch.
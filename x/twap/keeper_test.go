@@ -170,6 +170,18 @@ func withSp1(twap types.TwapRecord, sp osmomath.Dec) types.TwapRecord {
 	return twap
 }
 
+func withVolumes(twap types.TwapRecord, volume0, volume1 int64) types.TwapRecord {
+	asset0Volume, asset1Volume := osmomath.NewInt(volume0), osmomath.NewInt(volume1)
+	twap.Asset0VolumeAccumulator = &asset0Volume
+	twap.Asset1VolumeAccumulator = &asset1Volume
+	return twap
+}
+
+func withLogPriceSquaredAccum(twap types.TwapRecord, accum osmomath.Dec) types.TwapRecord {
+	twap.LogPriceSquaredAccumulator = &accum
+	return twap
+}
+
 // TestTWAPInitGenesis tests that genesis is initialized correctly
 // with different parameters and state.
 // Asserts that the most recent records are set correctly.
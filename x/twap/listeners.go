@@ -5,6 +5,7 @@ import (
 
 	"github.com/osmosis-labs/osmosis/osmomath"
 	concentratedliquiditytypes "github.com/osmosis-labs/osmosis/v24/x/concentrated-liquidity/types"
+	cosmwasmpooltypes "github.com/osmosis-labs/osmosis/v24/x/cosmwasmpool/types"
 	gammtypes "github.com/osmosis-labs/osmosis/v24/x/gamm/types"
 	"github.com/osmosis-labs/osmosis/v24/x/twap/types"
 	epochtypes "github.com/osmosis-labs/osmosis/x/epochs/types"
@@ -63,6 +64,7 @@ func (hook *gammhook) AfterCFMMPoolCreated(ctx sdk.Context, sender sdk.AccAddres
 // AfterCFMMSwap is called after SwapExactAmountIn and SwapExactAmountOut in x/gamm.
 func (hook *gammhook) AfterCFMMSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins) {
 	hook.k.trackChangedPool(ctx, poolId)
+	hook.k.trackSwapVolume(ctx, poolId, input, output)
 }
 
 func (hook *gammhook) AfterJoinPool(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, enterCoins sdk.Coins, shareOutAmount osmomath.Int) {
@@ -95,4 +97,57 @@ func (l *concentratedLiquidityListener) AfterLastPoolPositionRemoved(ctx sdk.Con
 
 func (l *concentratedLiquidityListener) AfterConcentratedPoolSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins) {
 	l.k.trackChangedPool(ctx, poolId)
+	l.k.trackSwapVolume(ctx, poolId, input, output)
+}
+
+type cosmWasmPoolListener struct {
+	k Keeper
+}
+
+func (k Keeper) CosmWasmPoolListener() cosmwasmpooltypes.CosmWasmPoolListener {
+	return &cosmWasmPoolListener{k}
+}
+
+// AfterCosmWasmPoolCreated tracks the pool if its contract declares the twap hooks capability.
+func (l *cosmWasmPoolListener) AfterCosmWasmPoolCreated(ctx sdk.Context, sender sdk.AccAddress, poolId uint64) {
+	if !l.hasTwapHooks(ctx, poolId) {
+		return
+	}
+	l.k.mustTrackCreatedPool(ctx, poolId)
+}
+
+// AfterCosmWasmPoolSwap tracks the pool if its contract declares the twap hooks capability.
+func (l *cosmWasmPoolListener) AfterCosmWasmPoolSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins) {
+	if !l.hasTwapHooks(ctx, poolId) {
+		return
+	}
+	l.k.trackChangedPool(ctx, poolId)
+	l.k.trackSwapVolume(ctx, poolId, input, output)
+}
+
+// AfterCosmWasmPoolJoin tracks the pool if its contract declares the twap hooks capability.
+func (l *cosmWasmPoolListener) AfterCosmWasmPoolJoin(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, tokensIn sdk.Coins, shareOutAmount osmomath.Int) {
+	if !l.hasTwapHooks(ctx, poolId) {
+		return
+	}
+	l.k.trackChangedPool(ctx, poolId)
+}
+
+// AfterCosmWasmPoolExit tracks the pool if its contract declares the twap hooks capability.
+func (l *cosmWasmPoolListener) AfterCosmWasmPoolExit(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, shareInAmount osmomath.Int, tokensOut sdk.Coins) {
+	if !l.hasTwapHooks(ctx, poolId) {
+		return
+	}
+	l.k.trackChangedPool(ctx, poolId)
+}
+
+// hasTwapHooks returns true if the cosmwasm pool with the given id declares the twap hooks capability.
+// Pools that do not are not tracked by twap.
+func (l *cosmWasmPoolListener) hasTwapHooks(ctx sdk.Context, poolId uint64) bool {
+	pool, err := l.k.poolmanagerKeeper.GetPool(ctx, poolId)
+	if err != nil {
+		return false
+	}
+	cosmwasmPool, ok := pool.(cosmwasmpooltypes.CosmWasmExtension)
+	return ok && cosmwasmPool.SupportsCapability(cosmwasmpooltypes.CapabilityTwapHooks)
 }
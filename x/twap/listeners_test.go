@@ -7,6 +7,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v24/app/apptesting"
+	cwpooltypes "github.com/osmosis-labs/osmosis/v24/x/cosmwasmpool/types"
 	"github.com/osmosis-labs/osmosis/v24/x/twap"
 	"github.com/osmosis-labs/osmosis/v24/x/twap/types"
 
@@ -69,6 +71,9 @@ func (s *TestSuite) TestAfterPoolCreatedHook() {
 					if poolType == poolmanagertypes.Concentrated {
 						expectedRecord.LastErrorTime = s.Ctx.BlockTime()
 					}
+					// The volumes swapped in the pool creation block are accumulated at EndBlock.
+					volume0, volume1 := s.twapkeeper.GetSwapVolumes(s.Ctx, poolId, denomPair.Denom0, denomPair.Denom1)
+					expectedRecord = twap.RecordWithUpdatedVolumes(expectedRecord, volume0, volume1)
 					expectedRecords = append(expectedRecords, expectedRecord)
 				}
 
@@ -596,3 +601,94 @@ func (s *TestSuite) validateUnchangedPool() {
 // func (s *TestSuite) TestSafetyWithPoolThatHasSpotPriceError() {
 // 	s.Require().Fail("Need to implement")
 // }
+
+// TestTrackSwapVolume tests that swap volumes are tracked per asset pair during the block,
+// and added to the volume accumulators of the records at EndBlock.
+func (s *TestSuite) TestTrackSwapVolume() {
+	s.SetupTest()
+	poolId := s.CreatePoolFromTypeWithCoins(poolmanagertypes.Balancer, defaultThreeAssetCoins)
+	s.EndBlock()
+	s.Commit()
+
+	s.twapkeeper.TrackSwapVolume(s.Ctx, poolId, sdk.NewCoins(sdk.NewInt64Coin(denom1, 100)), sdk.NewCoins(sdk.NewInt64Coin(denom0, 90)))
+	s.twapkeeper.TrackSwapVolume(s.Ctx, poolId, sdk.NewCoins(sdk.NewInt64Coin(denom0, 50)), sdk.NewCoins(sdk.NewInt64Coin(denom1, 55)))
+
+	volume0, volume1 := s.twapkeeper.GetSwapVolumes(s.Ctx, poolId, denom0, denom1)
+	s.Require().Equal(osmomath.NewInt(140), volume0)
+	s.Require().Equal(osmomath.NewInt(155), volume1)
+
+	// pairs that were not swapped are not affected
+	volume0, volume2 := s.twapkeeper.GetSwapVolumes(s.Ctx, poolId, denom0, denom2)
+	s.Require().True(volume0.IsZero())
+	s.Require().True(volume2.IsZero())
+
+	s.twapkeeper.TrackChangedPool(s.Ctx, poolId)
+	s.twapkeeper.EndBlock(s.Ctx)
+
+	record, err := s.twapkeeper.GetMostRecentRecordStoreRepresentation(s.Ctx, poolId, denom0, denom1)
+	s.Require().NoError(err)
+	s.Require().Equal(osmomath.NewInt(140), *record.Asset0VolumeAccumulator)
+	s.Require().Equal(osmomath.NewInt(155), *record.Asset1VolumeAccumulator)
+
+	record, err = s.twapkeeper.GetMostRecentRecordStoreRepresentation(s.Ctx, poolId, denom0, denom2)
+	s.Require().NoError(err)
+	s.Require().Nil(record.Asset0VolumeAccumulator)
+	s.Require().Nil(record.Asset1VolumeAccumulator)
+}
+
+// TestCosmWasmPoolListener tests that cosmwasm pools are tracked by twap
+// only if their contract declares the twap hooks capability.
+func (s *TestSuite) TestCosmWasmPoolListener() {
+	var (
+		denomA        = apptesting.DefaultTransmuterDenomA
+		denomB        = apptesting.DefaultTransmuterDenomB
+		poolLiquidity = sdk.NewCoins(sdk.NewInt64Coin(denomA, 10000), sdk.NewInt64Coin(denomB, 10000))
+		tokenIn       = sdk.NewInt64Coin(denomA, 100)
+	)
+
+	tests := map[string]struct {
+		hasTwapHooks bool
+	}{
+		"pool with twap hooks is tracked": {hasTwapHooks: true},
+		"legacy pool is not tracked":      {hasTwapHooks: false},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+
+			s.FundAcc(s.TestAccs[0], poolLiquidity)
+			pool := s.PrepareCustomTransmuterPool(s.TestAccs[0], []string{denomA, denomB})
+			s.JoinTransmuterPool(s.TestAccs[0], pool.GetId(), poolLiquidity)
+			poolId := pool.GetId()
+
+			if tc.hasTwapHooks {
+				pool.SetCapabilities(cwpooltypes.MaxSupportedInterfaceVersion, []string{cwpooltypes.CapabilityTwapHooks})
+				s.App.CosmwasmPoolKeeper.SetPool(s.Ctx, pool)
+				// The transmuter does not declare capabilities itself, so the creation
+				// notification that InitializePool sent is repeated after setting them.
+				s.App.TwapKeeper.CosmWasmPoolListener().AfterCosmWasmPoolCreated(s.Ctx, s.TestAccs[0], poolId)
+			}
+			s.twapkeeper.EndBlock(s.Ctx)
+			s.Commit()
+
+			s.FundAcc(s.TestAccs[1], sdk.NewCoins(tokenIn))
+			_, err := s.App.PoolManagerKeeper.SwapExactAmountIn(s.Ctx, s.TestAccs[1], poolId, tokenIn, denomB, osmomath.OneInt())
+			s.Require().NoError(err)
+
+			records, err := s.twapkeeper.GetAllMostRecentRecordsForPool(s.Ctx, poolId)
+			s.Require().NoError(err)
+			if !tc.hasTwapHooks {
+				s.Require().Empty(records)
+				s.Require().Empty(s.twapkeeper.GetChangedPools(s.Ctx))
+				return
+			}
+
+			s.Require().Len(records, 1)
+			s.Require().Equal([]uint64{poolId}, s.twapkeeper.GetChangedPools(s.Ctx))
+			volume0, volume1 := s.twapkeeper.GetSwapVolumes(s.Ctx, poolId, records[0].Asset0Denom, records[0].Asset1Denom)
+			s.Require().True(volume0.IsPositive())
+			s.Require().True(volume1.IsPositive())
+		})
+	}
+}
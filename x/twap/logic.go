@@ -18,6 +18,7 @@ func newTwapRecord(k types.PoolManagerInterface, ctx sdk.Context, poolId uint64,
 	}
 	previousErrorTime := time.Time{} // no previous error
 	sp0, sp1, lastErrorTime := getSpotPrices(ctx, k, poolId, denom0, denom1, previousErrorTime)
+	logPriceSquaredAccumulator := osmomath.ZeroDec()
 	return types.TwapRecord{
 		PoolId:                      poolId,
 		Asset0Denom:                 denom0,
@@ -30,6 +31,7 @@ func newTwapRecord(k types.PoolManagerInterface, ctx sdk.Context, poolId uint64,
 		P1ArithmeticTwapAccumulator: osmomath.ZeroDec(),
 		GeometricTwapAccumulator:    osmomath.ZeroDec(),
 		LastErrorTime:               lastErrorTime,
+		LogPriceSquaredAccumulator:  &logPriceSquaredAccumulator,
 	}, nil
 }
 
@@ -192,6 +194,15 @@ func (k Keeper) updateRecord(ctx sdk.Context, record types.TwapRecord) (types.Tw
 	newRecord := recordWithUpdatedAccumulators(record, ctx.BlockTime())
 	newRecord.Height = ctx.BlockHeight()
 
+	// Records whose history predates the log price squared accumulator start accumulating from now on.
+	if newRecord.LogPriceSquaredAccumulator == nil {
+		logPriceSquaredAccumulator := osmomath.ZeroDec()
+		newRecord.LogPriceSquaredAccumulator = &logPriceSquaredAccumulator
+	}
+
+	volume0, volume1 := k.getSwapVolumes(ctx, record.PoolId, record.Asset0Denom, record.Asset1Denom)
+	newRecord = recordWithUpdatedVolumes(newRecord, volume0, volume1)
+
 	newSp0, newSp1, lastErrorTime := getSpotPrices(
 		ctx, k.poolmanagerKeeper, record.PoolId, record.Asset0Denom, record.Asset1Denom, record.LastErrorTime)
 
@@ -247,9 +258,36 @@ func recordWithUpdatedAccumulators(record types.TwapRecord, newTime time.Time) t
 	p0NewGeomAccum := types.SpotPriceMulDuration(logP0SpotPrice, timeDelta)
 	newRecord.GeometricTwapAccumulator = p0NewGeomAccum.AddMut(newRecord.GeometricTwapAccumulator)
 
+	// The log price squared accumulator is left unset if the record's history predates it,
+	// as its value would not account for the time before it was introduced.
+	if record.LogPriceSquaredAccumulator != nil {
+		// p0NewLogSquaredAccum = (log_{2}{P_0})^2 * timeDelta
+		p0NewLogSquaredAccum := types.SpotPriceMulDuration(logP0SpotPrice.Mul(logP0SpotPrice), timeDelta)
+		p0NewLogSquaredAccum.AddMut(*record.LogPriceSquaredAccumulator)
+		newRecord.LogPriceSquaredAccumulator = &p0NewLogSquaredAccum
+	}
+
+	return newRecord
+}
+
+// recordWithUpdatedVolumes returns a record with the given swapped amounts added to its volume accumulators.
+// The volume accumulators are left unset if nothing was ever swapped for the record's asset pair.
+// This does not mutate the passed in record.
+func recordWithUpdatedVolumes(record types.TwapRecord, volume0, volume1 osmomath.Int) types.TwapRecord {
+	if volume0.IsZero() && volume1.IsZero() {
+		return record
+	}
+	newRecord := record
+	newRecord.Asset0VolumeAccumulator = addVolume(record.Asset0VolumeAccumulator, volume0)
+	newRecord.Asset1VolumeAccumulator = addVolume(record.Asset1VolumeAccumulator, volume1)
 	return newRecord
 }
 
+func addVolume(accumulator *osmomath.Int, volume osmomath.Int) *osmomath.Int {
+	total := types.VolumeAccumulatorOrZero(accumulator).Add(volume)
+	return &total
+}
+
 // getInterpolatedRecord returns a record for this pool, representing its accumulator state at time `t`.
 // This is achieved by getting the record `r` that is at, or immediately preceding in state time `t`.
 // To be clear: the record r s.t. `t - r.Time` is minimized AND `t >= r.Time`
@@ -305,6 +343,37 @@ func computeTwap(startRecord types.TwapRecord, endRecord types.TwapRecord, quote
 	return strategy.computeTwap(startRecord, endRecord, quoteAsset), err
 }
 
+// computeLogPriceVariance computes and returns the time weighted variance of the base 2 logarithm
+// of the spot price between two records. The variance is the same regardless of which asset is the quote asset.
+// precondition: endRecord.Time >= startRecord.Time, and both records have a log price squared accumulator.
+// Returns an error alongside the result under the same spot price error conditions as computeTwap.
+// if (endRecord.Time == startRecord.Time) returns zero
+// else returns
+// E[(log_{2}{P_0})^2] - E[log_{2}{P_0}]^2 over the time between the records
+func computeLogPriceVariance(startRecord types.TwapRecord, endRecord types.TwapRecord) (osmomath.Dec, error) {
+	var err error = nil
+	if endRecord.LastErrorTime.After(startRecord.Time) ||
+		endRecord.LastErrorTime.Equal(startRecord.Time) ||
+		startRecord.LastErrorTime.Equal(startRecord.Time) {
+		err = errors.New("twap: error in pool spot price occurred between start and end time, variance result may be faulty")
+	}
+
+	timeDelta := types.CanonicalTimeMs(endRecord.Time) - types.CanonicalTimeMs(startRecord.Time)
+	if timeDelta == 0 {
+		return osmomath.ZeroDec(), err
+	}
+
+	meanLogPrice := types.AccumDiffDivDuration(endRecord.GeometricTwapAccumulator.Sub(startRecord.GeometricTwapAccumulator), timeDelta)
+	meanSquaredLogPrice := types.AccumDiffDivDuration(endRecord.LogPriceSquaredAccumulator.Sub(*startRecord.LogPriceSquaredAccumulator), timeDelta)
+
+	variance := meanSquaredLogPrice.Sub(meanLogPrice.Mul(meanLogPrice))
+	// Rounding errors can make the variance of a constant price slightly negative.
+	if variance.IsNegative() {
+		return osmomath.ZeroDec(), err
+	}
+	return variance, err
+}
+
 // twapLog returns the logarithm of the given spot price, base 2.
 // Panics if zero is given.
 func twapLog(price osmomath.Dec) osmomath.Dec {
@@ -28,6 +28,7 @@ var (
 	logOneOverTen        = twap.TwapLog(osmomath.OneDec().QuoInt64(10))
 	tenSecAccum          = OneSec.MulInt64(10)
 	geometricTenSecAccum = OneSec.Mul(logTen)
+	logTenSquaredAccum   = logTen.Mul(logTen).Mul(OneSec)
 )
 
 func (s *TestSuite) TestGetSpotPrices() {
@@ -177,7 +178,8 @@ func (s *TestSuite) TestUpdateRecord() {
 	baseTimeMinusOne := time.Unix(1, 0).UTC()
 
 	zeroAccumNoErrSp10Record := newRecord(poolId, baseTime, osmomath.NewDec(10), zeroDec, zeroDec, zeroDec)
-	sp10OneTimeUnitAccumRecord := newExpRecord(OneSec.MulInt64(10), OneSec.QuoInt64(10), geometricTenSecAccum)
+	// the start record has no log price squared accumulator, so it starts accumulating from the update
+	sp10OneTimeUnitAccumRecord := withLogPriceSquaredAccum(newExpRecord(OneSec.MulInt64(10), OneSec.QuoInt64(10), geometricTenSecAccum), zeroDec)
 	// all tests occur with updateTime = base time + time.Unix(1, 0)
 	tests := map[string]struct {
 		record           types.TwapRecord
@@ -221,6 +223,12 @@ func (s *TestSuite) TestUpdateRecord() {
 			spotPriceResult1: spotPriceResOneErr,
 			expRecord:        withLastErrTime(sp10OneTimeUnitAccumRecord, updateTime),
 		},
+		"log price squared accum start, accum updated": {
+			record:           withLogPriceSquaredAccum(zeroAccumNoErrSp10Record, zeroDec),
+			spotPriceResult0: spotPriceResOne,
+			spotPriceResult1: spotPriceResOne,
+			expRecord:        withLogPriceSquaredAccum(sp10OneTimeUnitAccumRecord, logTenSquaredAccum),
+		},
 	}
 	for name, test := range tests {
 		s.Run(name, func() {
@@ -301,6 +309,17 @@ func TestRecordWithUpdatedAccumulators(t *testing.T) {
 			expRecord: newExpRecord(oneDec.Add(OneSec), twoDec.Add(OneSec), pointFiveDec),
 		},
 
+		"log price squared accumulator updated": {
+			record:    withLogPriceSquaredAccum(defaultRecord, oneDec),
+			newTime:   time.Unix(2, 0),
+			expRecord: withLogPriceSquaredAccum(newExpRecord(oneDec.Add(OneSec.MulInt64(10)), twoDec.Add(OneSec.QuoInt64(10)), pointFiveDec.Add(geometricTenSecAccum)), oneDec.Add(logTenSquaredAccum)),
+		},
+		"sp0 - zero spot price - log price squared accumulator unchanged": {
+			record:    withLogPriceSquaredAccum(withPrice0Set(defaultRecord, osmomath.ZeroDec()), oneDec),
+			newTime:   defaultRecord.Time.Add(time.Second),
+			expRecord: withLogPriceSquaredAccum(withLastErrTime(newExpRecord(oneDec, twoDec.Add(osmomath.NewDecWithPrec(1, 1).Mul(OneSec)), pointFiveDec), defaultRecord.Time.Add(time.Second)), oneDec),
+		},
+
 		"nanoseconds in time of the original record do not affect final result": {
 			record:    withTime(defaultRecord, defaultRecord.Time.Add(oneHundredNanoseconds)),
 			newTime:   time.Unix(2, 0),
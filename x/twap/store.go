@@ -5,8 +5,10 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/osmoutils"
 	"github.com/osmosis-labs/osmosis/v24/x/twap/types"
 )
@@ -42,18 +44,62 @@ var sentinelExistsValue = []byte{1}
 // to track that this pool changed this block.
 // This tracking is for use in EndBlock, to create new TWAP records.
 func (k Keeper) trackChangedPool(ctx sdk.Context, poolId uint64) {
-	store := ctx.TransientStore(k.transientKey)
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.ChangedPoolsTransientPrefix)
 	poolIdBz := make([]byte, 8)
 	binary.LittleEndian.PutUint64(poolIdBz, poolId)
 
 	store.Set(poolIdBz, sentinelExistsValue)
 }
 
+// trackSwapVolume adds the amounts swapped in the pool to the volumes of the swapped
+// asset pair in this block, in a transient store.
+// This tracking is for use in EndBlock, to update the volume accumulators of the TWAP records.
+func (k Keeper) trackSwapVolume(ctx sdk.Context, poolId uint64, input sdk.Coins, output sdk.Coins) {
+	store := ctx.TransientStore(k.transientKey)
+	for _, tokenIn := range input {
+		for _, tokenOut := range output {
+			denom0, denom1, err := types.LexicographicalOrderDenoms(tokenIn.Denom, tokenOut.Denom)
+			if err != nil {
+				continue
+			}
+			for _, token := range []sdk.Coin{tokenIn, tokenOut} {
+				key := types.FormatSwapVolumeTransientKey(poolId, denom0, denom1, token.Denom)
+				volume := getSwapVolume(store, key).Add(token.Amount)
+				bz, err := volume.Marshal()
+				if err != nil {
+					panic(err)
+				}
+				store.Set(key, bz)
+			}
+		}
+	}
+}
+
+// getSwapVolumes returns the amounts of denom0 and denom1 swapped in this block for the (denom0, denom1) pair of the pool.
+func (k Keeper) getSwapVolumes(ctx sdk.Context, poolId uint64, denom0, denom1 string) (osmomath.Int, osmomath.Int) {
+	store := ctx.TransientStore(k.transientKey)
+	volume0 := getSwapVolume(store, types.FormatSwapVolumeTransientKey(poolId, denom0, denom1, denom0))
+	volume1 := getSwapVolume(store, types.FormatSwapVolumeTransientKey(poolId, denom0, denom1, denom1))
+	return volume0, volume1
+}
+
+func getSwapVolume(store sdk.KVStore, key []byte) osmomath.Int {
+	bz := store.Get(key)
+	if bz == nil {
+		return osmomath.ZeroInt()
+	}
+	var volume osmomath.Int
+	if err := volume.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return volume
+}
+
 // getChangedPools returns all poolIDs that changed this block.
 // This is to be guaranteed by trackChangedPool being called on every
 // price-affecting pool action.
 func (k Keeper) getChangedPools(ctx sdk.Context) []uint64 {
-	store := ctx.TransientStore(k.transientKey)
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.ChangedPoolsTransientPrefix)
 	iter := store.Iterator(nil, nil)
 	defer iter.Close()
 
@@ -267,6 +313,21 @@ func (k Keeper) getRecordAtOrBeforeTime(ctx sdk.Context, poolId uint64, t time.T
 	return twap, nil
 }
 
+// getRecordsInTimeRange returns the historical records of the pool for the given asset pair
+// with a time within (startTime, endTime], in ascending time order.
+// The time suffix keys sort right after the record key with the same time, so the iteration over
+// [startKey, endKey) skips a record at startTime and includes a record at endTime.
+func (k Keeper) getRecordsInTimeRange(ctx sdk.Context, poolId uint64, startTime, endTime time.Time, asset0Denom string, asset1Denom string) ([]types.TwapRecord, error) {
+	asset0Denom, asset1Denom, err := types.LexicographicalOrderDenoms(asset0Denom, asset1Denom)
+	if err != nil {
+		return nil, err
+	}
+	store := ctx.KVStore(k.storeKey)
+	startKey := types.FormatHistoricalPoolIndexTimeSuffix(poolId, asset0Denom, asset1Denom, startTime)
+	endKey := types.FormatHistoricalPoolIndexTimeSuffix(poolId, asset0Denom, asset1Denom, endTime)
+	return osmoutils.GatherValuesFromStore(store, startKey, endKey, types.ParseTwapFromBz)
+}
+
 // DeleteHistoricalTimeIndexedTWAPs deletes every historical twap record indexed by time (now deprecated) up till the limit.
 // This is to be used in the upgrade handler, to clear out the now-obsolete historical twap records
 // that were indexed by time.
@@ -326,6 +326,56 @@ func (s *TestSuite) TestGetRecordAtOrBeforeTime() {
 	}
 }
 
+// TestGetRecordsInTimeRange tests that the records in (startTime, endTime] are returned,
+// including at the boundaries of the time range.
+func (s *TestSuite) TestGetRecordsInTimeRange() {
+	tMin1 := baseTime.Add(-time.Second)
+	tMin1Record := newEmptyPriceRecord(1, tMin1, denom0, denom1)
+	baseRecord := newEmptyPriceRecord(1, baseTime, denom0, denom1)
+	tPlus1 := baseTime.Add(time.Second)
+	tPlus1Record := newEmptyPriceRecord(1, tPlus1, denom0, denom1)
+	tPlus2 := baseTime.Add(2 * time.Second)
+	tPlus2Record := newEmptyPriceRecord(1, tPlus2, denom0, denom1)
+	otherPoolRecord := newEmptyPriceRecord(2, baseTime, denom0, denom1)
+
+	tests := map[string]struct {
+		startTime       time.Time
+		endTime         time.Time
+		expectedRecords []types.TwapRecord
+	}{
+		"start time excluded, end time included": {
+			startTime:       baseTime,
+			endTime:         tPlus1,
+			expectedRecords: []types.TwapRecord{tPlus1Record},
+		},
+		"range between records": {
+			startTime:       tMin1.Add(time.Millisecond),
+			endTime:         tPlus2.Add(-time.Millisecond),
+			expectedRecords: []types.TwapRecord{baseRecord, tPlus1Record},
+		},
+		"whole range": {
+			startTime:       tMin1.Add(-time.Second),
+			endTime:         tPlus2,
+			expectedRecords: []types.TwapRecord{tMin1Record, baseRecord, tPlus1Record, tPlus2Record},
+		},
+		"empty range": {
+			startTime:       baseTime,
+			endTime:         baseTime,
+			expectedRecords: []types.TwapRecord{},
+		},
+	}
+	for name, test := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			s.preSetRecords([]types.TwapRecord{tMin1Record, baseRecord, tPlus1Record, tPlus2Record, otherPoolRecord})
+
+			records, err := s.twapkeeper.GetRecordsInTimeRange(s.Ctx, 1, test.startTime, test.endTime, denom0, denom1)
+			s.Require().NoError(err)
+			s.Require().Equal(test.expectedRecords, records)
+		})
+	}
+}
+
 // TestPruneRecordsBeforeTime tests that all twap records earlier than
 // current block time - given time are pruned from the store while
 // the newest record for each pool before the time to keep is preserved.
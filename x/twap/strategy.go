@@ -8,7 +8,7 @@ import (
 )
 
 // twapStrategy is an interface for computing TWAPs.
-// We have two strategies implementing the interface - arithmetic and geometric.
+// We have three strategies implementing the interface - arithmetic, geometric and volume weighted.
 // We expose a common TWAP API to reduce duplication and avoid complexity.
 type twapStrategy interface {
 	// computeTwap calculates the TWAP with specific startRecord and endRecord.
@@ -23,6 +23,10 @@ type geometric struct {
 	TwapKeeper Keeper
 }
 
+type volumeWeighted struct {
+	TwapKeeper Keeper
+}
+
 // computeTwap computes and returns an arithmetic TWAP between
 // two records given the quote asset.
 func (s *arithmetic) computeTwap(startRecord types.TwapRecord, endRecord types.TwapRecord, quoteAsset string) osmomath.Dec {
@@ -70,3 +74,26 @@ func (s *geometric) computeTwap(startRecord types.TwapRecord, endRecord types.Tw
 	// by the underlying spot price function.
 	return osmomath.SigFigRound(result.Dec(), gammtypes.SpotPriceSigFigs)
 }
+
+// computeTwap computes and returns a volume weighted average price between
+// two records given the quote asset. That is the amount of the quote asset
+// swapped divided by the amount of the base asset swapped between the two records.
+// Returns zero if the base asset was not swapped between the two records.
+func (s *volumeWeighted) computeTwap(startRecord types.TwapRecord, endRecord types.TwapRecord, quoteAsset string) osmomath.Dec {
+	quoteVolume, baseVolume := swapVolumes(startRecord, endRecord, quoteAsset)
+	if !baseVolume.IsPositive() {
+		return osmomath.ZeroDec()
+	}
+	return osmomath.NewDecFromInt(quoteVolume).QuoInt(baseVolume)
+}
+
+// swapVolumes returns the amounts of the quote asset and of the base asset swapped between two records.
+func swapVolumes(startRecord types.TwapRecord, endRecord types.TwapRecord, quoteAsset string) (quoteVolume osmomath.Int, baseVolume osmomath.Int) {
+	volume0 := types.VolumeAccumulatorOrZero(endRecord.Asset0VolumeAccumulator).Sub(types.VolumeAccumulatorOrZero(startRecord.Asset0VolumeAccumulator))
+	volume1 := types.VolumeAccumulatorOrZero(endRecord.Asset1VolumeAccumulator).Sub(types.VolumeAccumulatorOrZero(startRecord.Asset1VolumeAccumulator))
+
+	if quoteAsset != startRecord.Asset0Denom {
+		return volume1, volume0
+	}
+	return volume0, volume1
+}
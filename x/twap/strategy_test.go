@@ -375,3 +375,52 @@ func (s *TestSuite) TestComputeGeometricStrategyTwap_ThreeAsset() {
 		})
 	}
 }
+
+// TestComputeVolumeWeightedStrategyTwap tests volume weighted strategy's computeTwap.
+func (s *TestSuite) TestComputeVolumeWeightedStrategyTwap() {
+	tests := map[string]computeTwapTestCase{
+		"no volume accumulators": {
+			startRecord: baseRecord,
+			endRecord:   withTime(baseRecord, tPlusOne),
+			quoteAsset:  denom0,
+			expTwap:     osmomath.ZeroDec(),
+		},
+		"volume accumulators only set in end record, quote asset 0": {
+			startRecord: baseRecord,
+			endRecord:   withVolumes(withTime(baseRecord, tPlusOne), 100, 1000),
+			quoteAsset:  denom0,
+			expTwap:     osmomath.NewDecWithPrec(1, 1),
+		},
+		"volume accumulators only set in end record, quote asset 1": {
+			startRecord: baseRecord,
+			endRecord:   withVolumes(withTime(baseRecord, tPlusOne), 100, 1000),
+			quoteAsset:  denom1,
+			expTwap:     osmomath.NewDec(10),
+		},
+		"volume accumulators set in both records": {
+			startRecord: withVolumes(baseRecord, 100, 1000),
+			endRecord:   withVolumes(withTime(baseRecord, tPlusOne), 400, 1300),
+			quoteAsset:  denom0,
+			expTwap:     osmomath.OneDec(),
+		},
+		"no volume between the records": {
+			startRecord: withVolumes(baseRecord, 100, 1000),
+			endRecord:   withVolumes(withTime(baseRecord, tPlusOne), 100, 1000),
+			quoteAsset:  denom1,
+			expTwap:     osmomath.ZeroDec(),
+		},
+		"volume weighted average is not affected by elapsed time": {
+			startRecord: withVolumes(baseRecord, 100, 1000),
+			endRecord:   withVolumes(withTime(baseRecord, tPlusOneMin), 400, 1300),
+			quoteAsset:  denom0,
+			expTwap:     osmomath.OneDec(),
+		},
+	}
+	for name, test := range tests {
+		s.Run(name, func() {
+			volumeWeightedStrategy := &twap.VolumeWeightedStrategy{TwapKeeper: *s.App.TwapKeeper}
+			actualTwap := volumeWeightedStrategy.ComputeTwap(test.startRecord, test.endRecord, test.quoteAsset)
+			s.Require().Equal(test.expTwap, actualTwap)
+		})
+	}
+}
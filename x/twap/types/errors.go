@@ -73,3 +73,57 @@ type InvalidUpdateRecordError struct {
 func (e InvalidUpdateRecordError) Error() string {
 	return fmt.Sprintf("failed to update the record, the context time must be greater than record time; record: block %d at %s, actual: block %d at %s", e.RecordBlockHeight, e.RecordTime, e.ActualBlockHeight, e.ActualTime)
 }
+
+type NoSwapVolumeError struct {
+	PoolId     uint64
+	BaseAsset  string
+	QuoteAsset string
+	StartTime  time.Time
+	EndTime    time.Time
+}
+
+func (e NoSwapVolumeError) Error() string {
+	return fmt.Sprintf("no %s was swapped for %s in pool %d between %s and %s, cannot compute a volume weighted average price",
+		e.BaseAsset, e.QuoteAsset, e.PoolId, e.StartTime, e.EndTime)
+}
+
+type LogPriceVarianceUnavailableError struct {
+	PoolId     uint64
+	Asset0     string
+	Asset1     string
+	RecordTime time.Time
+}
+
+func (e LogPriceVarianceUnavailableError) Error() string {
+	return fmt.Sprintf("log price variance is not tracked for %s and %s in pool %d as of %s, use a later start time",
+		e.Asset0, e.Asset1, e.PoolId, e.RecordTime)
+}
+
+type StaleTwapRecordError struct {
+	PoolId         uint64
+	LastRecordTime time.Time
+	BlockTime      time.Time
+	MaxAge         time.Duration
+}
+
+func (e StaleTwapRecordError) Error() string {
+	return fmt.Sprintf("most recent twap record of pool %d was written at %s, more than %s before the block time %s",
+		e.PoolId, e.LastRecordTime, e.MaxAge, e.BlockTime)
+}
+
+type RouteHopTwapError struct {
+	HopIndex   int
+	PoolId     uint64
+	BaseAsset  string
+	QuoteAsset string
+	Err        error
+}
+
+func (e RouteHopTwapError) Error() string {
+	return fmt.Sprintf("twap of hop %d of the route, for %s in units of %s in pool %d: %v",
+		e.HopIndex, e.BaseAsset, e.QuoteAsset, e.PoolId, e.Err)
+}
+
+func (e RouteHopTwapError) Unwrap() error {
+	return e.Err
+}
@@ -4,6 +4,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v24/x/poolmanager/types"
 )
 
 // AmmInterface is the functionality needed from a given pool ID, in order to maintain records and serve TWAPs.
@@ -20,4 +21,5 @@ type PoolManagerInterface interface {
 		baseAssetDenom string,
 	) (price osmomath.BigDec, err error)
 	GetNextPoolId(ctx sdk.Context) uint64
+	GetPool(ctx sdk.Context, poolId uint64) (poolmanagertypes.PoolI, error)
 }
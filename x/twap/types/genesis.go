@@ -84,5 +84,17 @@ func (t TwapRecord) validate() error {
 	if t.GeometricTwapAccumulator.IsNil() {
 		return fmt.Errorf("twap record geometric accumulator cannot be nil, was (%s)", t.GeometricTwapAccumulator)
 	}
+
+	if t.Asset0VolumeAccumulator != nil && (t.Asset0VolumeAccumulator.IsNil() || t.Asset0VolumeAccumulator.IsNegative()) {
+		return fmt.Errorf("twap record asset0 volume accumulator cannot be negative, was (%s)", t.Asset0VolumeAccumulator)
+	}
+
+	if t.Asset1VolumeAccumulator != nil && (t.Asset1VolumeAccumulator.IsNil() || t.Asset1VolumeAccumulator.IsNegative()) {
+		return fmt.Errorf("twap record asset1 volume accumulator cannot be negative, was (%s)", t.Asset1VolumeAccumulator)
+	}
+
+	if t.LogPriceSquaredAccumulator != nil && (t.LogPriceSquaredAccumulator.IsNil() || t.LogPriceSquaredAccumulator.IsNegative()) {
+		return fmt.Errorf("twap record log price squared accumulator cannot be negative, was (%s)", t.LogPriceSquaredAccumulator)
+	}
 	return nil
 }
@@ -290,6 +290,35 @@ func TestTWAPRecord_Validate(t *testing.T) {
 
 			expectedErr: true,
 		},
+		"valid volume accumulators": {
+			twapRecord: func() TwapRecord {
+				r := baseRecord
+				volume := osmomath.NewInt(10)
+				r.Asset0VolumeAccumulator = &volume
+				r.Asset1VolumeAccumulator = &volume
+				return r
+			}(),
+		},
+		"invalid asset1 volume accum: negative": {
+			twapRecord: func() TwapRecord {
+				r := baseRecord
+				volume := osmomath.NewInt(-1)
+				r.Asset1VolumeAccumulator = &volume
+				return r
+			}(),
+
+			expectedErr: true,
+		},
+		"invalid log price squared accum: negative": {
+			twapRecord: func() TwapRecord {
+				r := baseRecord
+				accum := osmomath.NewDec(-1)
+				r.LogPriceSquaredAccumulator = &accum
+				return r
+			}(),
+
+			expectedErr: true,
+		},
 		"invalid p0 arithmetic accum: negative": {
 			twapRecord: func() TwapRecord {
 				r := baseRecord
@@ -43,6 +43,10 @@ var (
 	// format is pool id | denom1 | denom2 | time
 	// made for efficiently getting records given (pool id, denom1, denom2) and time bounds
 	HistoricalTWAPPoolIndexPrefix = historicalTWAPPoolIndexNoSeparator + KeySeparator
+
+	// Prefixes of the transient store, which is cleared at the end of every block.
+	ChangedPoolsTransientPrefix = []byte{0x01}
+	SwapVolumesTransientPrefix  = []byte{0x02}
 )
 
 // TODO: make utility command to automatically interlace separators
@@ -83,6 +87,13 @@ func FormatHistoricalPoolIndexTimeSuffix(poolId uint64, denom1, denom2 string, a
 	return []byte(fmt.Sprintf("%s%d%s%s%s%s%s%s.", HistoricalTWAPPoolIndexPrefix, poolId, KeySeparator, denom1, KeySeparator, denom2, KeySeparator, timeS))
 }
 
+// FormatSwapVolumeTransientKey returns the transient store key of the amount of denom swapped
+// in the current block for the (denom0, denom1) pair of the pool.
+func FormatSwapVolumeTransientKey(poolId uint64, denom0, denom1, denom string) []byte {
+	poolIdS := osmoutils.FormatFixedLengthU64(poolId)
+	return []byte(fmt.Sprintf("%s%s%s%s%s%s%s%s", SwapVolumesTransientPrefix, poolIdS, KeySeparator, denom0, KeySeparator, denom1, KeySeparator, denom))
+}
+
 // GetAllMostRecentTwapsForPool returns all of the most recent twap records for a pool id.
 // if the pool id doesn't exist, then this returns a blank list.
 func GetAllMostRecentTwapsForPool(store sdk.KVStore, poolId uint64) ([]TwapRecord, error) {
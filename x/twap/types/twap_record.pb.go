@@ -57,6 +57,17 @@ type TwapRecord struct {
 	// It is used to alert the caller if they are getting a potentially erroneous
 	// TWAP, due to an unforeseen underlying error.
 	LastErrorTime time.Time `protobuf:"bytes,11,opt,name=last_error_time,json=lastErrorTime,proto3,stdtime" json:"last_error_time" yaml:"last_error_time"`
+	// Cumulative amounts of asset0 and asset1 swapped through the pool for this
+	// asset pair, either in or out. They are used to compute volume weighted
+	// average prices. They are nil until the first swap of the pair, so that
+	// records created before they were introduced remain valid.
+	Asset0VolumeAccumulator *cosmossdk_io_math.Int `protobuf:"bytes,12,opt,name=asset0_volume_accumulator,json=asset0VolumeAccumulator,proto3,customtype=cosmossdk.io/math.Int" json:"asset0_volume_accumulator,omitempty"`
+	Asset1VolumeAccumulator *cosmossdk_io_math.Int `protobuf:"bytes,13,opt,name=asset1_volume_accumulator,json=asset1VolumeAccumulator,proto3,customtype=cosmossdk.io/math.Int" json:"asset1_volume_accumulator,omitempty"`
+	// Accumulator of the squared base 2 logarithm of p0, weighted by time.
+	// Together with the geometric accumulator, it is used to compute the
+	// variance of the log price. It is nil for records whose history predates
+	// it, and set from the first record update after it was introduced.
+	LogPriceSquaredAccumulator *cosmossdk_io_math.LegacyDec `protobuf:"bytes,14,opt,name=log_price_squared_accumulator,json=logPriceSquaredAccumulator,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"log_price_squared_accumulator,omitempty"`
 }
 
 func (m *TwapRecord) Reset()         { *m = TwapRecord{} }
@@ -226,46 +237,51 @@ func init() {
 }
 
 var fileDescriptor_dbf5c78678e601aa = []byte{
-	// 622 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x4d, 0x4f, 0xdb, 0x40,
-	0x10, 0x8d, 0x21, 0x0d, 0xb0, 0x09, 0x45, 0xb5, 0x68, 0x6b, 0x05, 0xd5, 0x0e, 0xa9, 0x84, 0xc2,
-	0xa1, 0x76, 0x4c, 0x7b, 0xea, 0x8d, 0x88, 0x1e, 0xda, 0xa2, 0x2a, 0x32, 0x9c, 0x7a, 0x59, 0x6d,
-	0x9c, 0xc1, 0x59, 0x11, 0x7b, 0x57, 0xde, 0x0d, 0x34, 0xff, 0x82, 0xbf, 0xd4, 0x1b, 0x47, 0x8e,
-	0x55, 0x0f, 0x69, 0x05, 0xea, 0xa5, 0x47, 0x7e, 0x41, 0xb5, 0xbb, 0x4e, 0x0a, 0xf4, 0x03, 0xb8,
-	0x79, 0x66, 0xdf, 0xbc, 0xf7, 0xc6, 0x3b, 0xb3, 0x68, 0x83, 0x89, 0x94, 0x09, 0x2a, 0x02, 0x79,
-	0x4c, 0x78, 0x70, 0x14, 0xf6, 0x40, 0x92, 0x50, 0x07, 0x38, 0x87, 0x98, 0xe5, 0x7d, 0x9f, 0xe7,
-	0x4c, 0x32, 0x7b, 0xb5, 0xc0, 0xf9, 0xea, 0xc8, 0x2f, 0x70, 0xf5, 0xd5, 0x84, 0x25, 0x4c, 0x03,
-	0x02, 0xf5, 0x65, 0xb0, 0x75, 0x2f, 0x61, 0x2c, 0x19, 0x42, 0xa0, 0xa3, 0xde, 0xe8, 0x20, 0x90,
-	0x34, 0x05, 0x21, 0x49, 0xca, 0x0d, 0xa0, 0xf9, 0xb9, 0x82, 0xd0, 0xfe, 0x31, 0xe1, 0x91, 0x56,
-	0xb0, 0x9f, 0xa2, 0x05, 0xce, 0xd8, 0x10, 0xd3, 0xbe, 0x63, 0x35, 0xac, 0x56, 0x39, 0xaa, 0xa8,
-	0xf0, 0x6d, 0xdf, 0x5e, 0x47, 0x35, 0x22, 0x04, 0xc8, 0x36, 0xee, 0x43, 0xc6, 0x52, 0x67, 0xae,
-	0x61, 0xb5, 0x96, 0xa2, 0xaa, 0xc9, 0xed, 0xa8, 0xd4, 0x0c, 0x12, 0x16, 0x90, 0xf9, 0x2b, 0x90,
-	0xd0, 0x40, 0xb6, 0x51, 0x65, 0x00, 0x34, 0x19, 0x48, 0xa7, 0xdc, 0xb0, 0x5a, 0xf3, 0x9d, 0xcd,
-	0x9f, 0x13, 0x6f, 0xd9, 0x34, 0x87, 0xcd, 0xc1, 0xe5, 0xc4, 0x5b, 0x1d, 0x93, 0x74, 0xf8, 0xba,
-	0x79, 0x2d, 0xdd, 0x8c, 0x8a, 0x42, 0xfb, 0x03, 0x2a, 0xab, 0x1e, 0x9c, 0x07, 0x0d, 0xab, 0x55,
-	0xdd, 0xaa, 0xfb, 0xa6, 0x41, 0x7f, 0xda, 0xa0, 0xbf, 0x3f, 0x6d, 0xb0, 0xe3, 0x9e, 0x4e, 0xbc,
-	0xd2, 0xe5, 0xc4, 0xb3, 0xaf, 0xf1, 0xa9, 0xe2, 0xe6, 0xc9, 0x37, 0xcf, 0x8a, 0x34, 0x8f, 0xdd,
-	0x45, 0x36, 0x6f, 0xe3, 0x21, 0x11, 0x12, 0x0b, 0xce, 0x24, 0xe6, 0x39, 0x8d, 0xc1, 0xa9, 0x28,
-	0xef, 0x9d, 0xe7, 0x8a, 0xe1, 0xeb, 0xc4, 0x5b, 0x8b, 0xf5, 0x2f, 0x17, 0xfd, 0x43, 0x9f, 0xb2,
-	0x20, 0x25, 0x72, 0xe0, 0xef, 0x42, 0x42, 0xe2, 0xf1, 0x0e, 0xc4, 0xd1, 0x0a, 0x6f, 0xef, 0x12,
-	0x21, 0xf7, 0x38, 0x93, 0x5d, 0x55, 0xab, 0x19, 0xc3, 0x3f, 0x18, 0x17, 0xee, 0xc3, 0x18, 0x5e,
-	0x67, 0x1c, 0x20, 0x97, 0xb7, 0x31, 0xc9, 0xa9, 0x1c, 0xa4, 0x20, 0x69, 0x8c, 0xf5, 0x50, 0x90,
-	0x38, 0x1e, 0xa5, 0xa3, 0x21, 0x91, 0x2c, 0x77, 0x16, 0xef, 0xce, 0xbe, 0xc6, 0xdb, 0xdb, 0x33,
-	0x26, 0x75, 0xf5, 0xdb, 0xbf, 0x79, 0xb4, 0x52, 0xf8, 0x5f, 0xa5, 0xa5, 0xfb, 0x28, 0x85, 0xff,
-	0x56, 0x22, 0xa8, 0x9e, 0x00, 0x4b, 0x41, 0xe6, 0x7f, 0x53, 0x41, 0x77, 0x57, 0x71, 0x66, 0x34,
-	0x37, 0x25, 0x0e, 0xd0, 0x8a, 0xbe, 0x05, 0xc8, 0x73, 0x96, 0xeb, 0x8b, 0x77, 0xaa, 0xb7, 0x4e,
-	0x4d, 0xb3, 0x98, 0x9a, 0x27, 0x66, 0x6a, 0x6e, 0x10, 0x98, 0xc9, 0x59, 0x56, 0xd9, 0x37, 0x2a,
-	0xa9, 0xea, 0x9a, 0x3f, 0x2c, 0x54, 0xeb, 0xe6, 0xa3, 0x8c, 0x66, 0xc9, 0x9e, 0x24, 0x12, 0xec,
-	0x67, 0x08, 0x51, 0x81, 0xb9, 0x49, 0xe9, 0x45, 0x5a, 0x8c, 0x96, 0xa8, 0x28, 0x30, 0x76, 0x8c,
-	0x1e, 0x6a, 0xda, 0x43, 0xe0, 0xd2, 0xd8, 0x9a, 0xbb, 0xd5, 0xd6, 0x7a, 0x61, 0xeb, 0xf1, 0x15,
-	0x5b, 0xb3, 0x7a, 0xe3, 0xaa, 0xa6, 0x92, 0xef, 0x81, 0x4b, 0x55, 0x65, 0x6f, 0xa0, 0xe5, 0x02,
-	0x34, 0xc6, 0x02, 0x20, 0xd3, 0xeb, 0x58, 0xeb, 0xcc, 0x39, 0x56, 0x54, 0x35, 0xc0, 0xf1, 0x1e,
-	0x40, 0x66, 0x6f, 0xa2, 0x47, 0x66, 0x54, 0x01, 0x32, 0x3c, 0xdd, 0xfd, 0xb2, 0xde, 0x7d, 0xed,
-	0x52, 0x81, 0xba, 0xfa, 0x0d, 0xe8, 0xbc, 0x3b, 0x3d, 0x77, 0xad, 0xb3, 0x73, 0xd7, 0xfa, 0x7e,
-	0xee, 0x5a, 0x27, 0x17, 0x6e, 0xe9, 0xec, 0xc2, 0x2d, 0x7d, 0xb9, 0x70, 0x4b, 0x1f, 0xdb, 0x09,
-	0x95, 0x83, 0x51, 0xcf, 0x8f, 0x59, 0x1a, 0x14, 0xaf, 0xd3, 0x8b, 0x21, 0xe9, 0x89, 0x69, 0x10,
-	0x1c, 0x6d, 0xbd, 0x0a, 0x3e, 0x99, 0x87, 0x4d, 0x8e, 0x39, 0x88, 0x5e, 0x45, 0xf7, 0xf8, 0xf2,
-	0x57, 0x00, 0x00, 0x00, 0xff, 0xff, 0xca, 0xe8, 0x8b, 0xb4, 0xf5, 0x04, 0x00, 0x00,
+	// 696 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xc1, 0x4e, 0xdb, 0x40,
+	0x10, 0x8d, 0x21, 0x0d, 0xb0, 0x49, 0x40, 0xb5, 0xa0, 0xb8, 0x41, 0xd8, 0x21, 0x95, 0x50, 0x38,
+	0xd4, 0x8e, 0x69, 0x4f, 0xbd, 0x11, 0xd1, 0x03, 0x2d, 0xaa, 0x22, 0x07, 0x55, 0x6a, 0x2f, 0xab,
+	0x8d, 0xb3, 0x38, 0x16, 0x76, 0x76, 0xeb, 0xdd, 0x40, 0xf3, 0x17, 0x7c, 0x16, 0x47, 0x8e, 0x15,
+	0x07, 0x53, 0x81, 0x7a, 0xe9, 0x91, 0x2f, 0xa8, 0x76, 0xd7, 0x49, 0x13, 0xa0, 0x05, 0x6e, 0x99,
+	0xf1, 0x9b, 0xf7, 0xfc, 0xfc, 0x26, 0x03, 0x36, 0x09, 0x8b, 0x09, 0x0b, 0x99, 0xc3, 0x4f, 0x10,
+	0x75, 0x8e, 0xdd, 0x0e, 0xe6, 0xc8, 0x95, 0x05, 0x4c, 0xb0, 0x4f, 0x92, 0xae, 0x4d, 0x13, 0xc2,
+	0x89, 0xbe, 0x9c, 0xe1, 0x6c, 0xf1, 0xc8, 0xce, 0x70, 0x95, 0xe5, 0x80, 0x04, 0x44, 0x02, 0x1c,
+	0xf1, 0x4b, 0x61, 0x2b, 0x56, 0x40, 0x48, 0x10, 0x61, 0x47, 0x56, 0x9d, 0xc1, 0xa1, 0xc3, 0xc3,
+	0x18, 0x33, 0x8e, 0x62, 0xaa, 0x00, 0xb5, 0xcb, 0x79, 0x00, 0x0e, 0x4e, 0x10, 0xf5, 0xa4, 0x82,
+	0xbe, 0x0a, 0xe6, 0x28, 0x21, 0x11, 0x0c, 0xbb, 0x86, 0x56, 0xd5, 0xea, 0x79, 0xaf, 0x20, 0xca,
+	0xbd, 0xae, 0xbe, 0x01, 0x4a, 0x88, 0x31, 0xcc, 0x1b, 0xb0, 0x8b, 0xfb, 0x24, 0x36, 0x66, 0xaa,
+	0x5a, 0x7d, 0xc1, 0x2b, 0xaa, 0xde, 0xae, 0x68, 0x8d, 0x21, 0x6e, 0x06, 0x99, 0x9d, 0x80, 0xb8,
+	0x0a, 0xb2, 0x03, 0x0a, 0x3d, 0x1c, 0x06, 0x3d, 0x6e, 0xe4, 0xab, 0x5a, 0x7d, 0xb6, 0xb9, 0xf5,
+	0x3b, 0xb5, 0xca, 0xca, 0x1c, 0x54, 0x0f, 0x6e, 0x52, 0x6b, 0x79, 0x88, 0xe2, 0xe8, 0x5d, 0x6d,
+	0xaa, 0x5d, 0xf3, 0xb2, 0x41, 0xfd, 0x13, 0xc8, 0x0b, 0x0f, 0xc6, 0xb3, 0xaa, 0x56, 0x2f, 0x6e,
+	0x57, 0x6c, 0x65, 0xd0, 0x1e, 0x19, 0xb4, 0x0f, 0x46, 0x06, 0x9b, 0xe6, 0x59, 0x6a, 0xe5, 0x6e,
+	0x52, 0x4b, 0x9f, 0xe2, 0x13, 0xc3, 0xb5, 0xd3, 0x4b, 0x4b, 0xf3, 0x24, 0x8f, 0xde, 0x02, 0x3a,
+	0x6d, 0xc0, 0x08, 0x31, 0x0e, 0x19, 0x25, 0x1c, 0xd2, 0x24, 0xf4, 0xb1, 0x51, 0x10, 0xef, 0xde,
+	0x7c, 0x25, 0x18, 0x2e, 0x52, 0x6b, 0xcd, 0x97, 0x9f, 0x9c, 0x75, 0x8f, 0xec, 0x90, 0x38, 0x31,
+	0xe2, 0x3d, 0x7b, 0x1f, 0x07, 0xc8, 0x1f, 0xee, 0x62, 0xdf, 0x5b, 0xa2, 0x8d, 0x7d, 0xc4, 0x78,
+	0x9b, 0x12, 0xde, 0x12, 0xb3, 0x92, 0xd1, 0xbd, 0xc3, 0x38, 0xf7, 0x14, 0x46, 0x77, 0x9a, 0xb1,
+	0x07, 0x4c, 0xda, 0x80, 0x28, 0x09, 0x79, 0x2f, 0xc6, 0x3c, 0xf4, 0xa1, 0x5c, 0x0a, 0xe4, 0xfb,
+	0x83, 0x78, 0x10, 0x21, 0x4e, 0x12, 0x63, 0xfe, 0xf1, 0xec, 0x6b, 0xb4, 0xb1, 0x33, 0x66, 0x12,
+	0xd1, 0xef, 0xfc, 0xe5, 0x91, 0x4a, 0xee, 0x7f, 0x95, 0x16, 0x9e, 0xa2, 0xe4, 0xfe, 0x5b, 0x09,
+	0x81, 0x4a, 0x80, 0x49, 0x8c, 0x79, 0x72, 0x9f, 0x0a, 0x78, 0xbc, 0x8a, 0x31, 0xa6, 0xb9, 0x2d,
+	0x71, 0x08, 0x96, 0x64, 0x0a, 0x38, 0x49, 0x48, 0x22, 0x83, 0x37, 0x8a, 0x0f, 0x6e, 0x4d, 0x2d,
+	0xdb, 0x9a, 0x17, 0x6a, 0x6b, 0x6e, 0x11, 0xa8, 0xcd, 0x29, 0x8b, 0xee, 0x7b, 0xd1, 0x14, 0x73,
+	0xfa, 0x17, 0xf0, 0x32, 0xfb, 0x6f, 0x1c, 0x93, 0x68, 0x10, 0xe3, 0x29, 0x27, 0x25, 0xe9, 0x64,
+	0xfd, 0x2c, 0xb5, 0xb4, 0x8b, 0xd4, 0x5a, 0xb9, 0xeb, 0x64, 0xaf, 0xcf, 0xbd, 0x55, 0x35, 0xff,
+	0x59, 0x8e, 0x4f, 0x5a, 0x18, 0x51, 0xbb, 0xf7, 0x51, 0x97, 0x1f, 0x4f, 0xed, 0xde, 0xa5, 0x3e,
+	0x04, 0xeb, 0x11, 0x09, 0xd4, 0x76, 0x42, 0xf6, 0x6d, 0x80, 0x12, 0xdc, 0x9d, 0xa2, 0x5f, 0x1c,
+	0x67, 0xa0, 0x3d, 0x94, 0x41, 0x25, 0x22, 0x81, 0x5c, 0xd5, 0xb6, 0xe2, 0x99, 0xd0, 0xa9, 0xfd,
+	0xd2, 0x40, 0xa9, 0x95, 0x0c, 0xfa, 0x61, 0x3f, 0x68, 0x73, 0xc4, 0xb1, 0xbe, 0x0e, 0x40, 0xc8,
+	0x20, 0x55, 0x2d, 0x79, 0x66, 0xe6, 0xbd, 0x85, 0x90, 0x65, 0x18, 0xdd, 0x07, 0x8b, 0xf2, 0xa3,
+	0x1f, 0x61, 0xca, 0x55, 0x68, 0x33, 0x0f, 0x86, 0xb6, 0x91, 0x85, 0xb6, 0x32, 0x11, 0xda, 0x78,
+	0x5e, 0x65, 0x56, 0x12, 0xcd, 0x8f, 0x98, 0x72, 0x19, 0xd9, 0x26, 0x28, 0x67, 0xa0, 0x21, 0x64,
+	0x18, 0xf7, 0xe5, 0xb1, 0x2a, 0x35, 0x67, 0x0c, 0xcd, 0x2b, 0x2a, 0xe0, 0xb0, 0x8d, 0x71, 0x5f,
+	0xdf, 0x02, 0xcf, 0x25, 0x4e, 0x60, 0xe0, 0xe8, 0x32, 0xe6, 0xe5, 0x65, 0x94, 0x6f, 0x29, 0x40,
+	0x2d, 0x79, 0x21, 0x9b, 0x1f, 0xce, 0xae, 0x4c, 0xed, 0xfc, 0xca, 0xd4, 0x7e, 0x5e, 0x99, 0xda,
+	0xe9, 0xb5, 0x99, 0x3b, 0xbf, 0x36, 0x73, 0x3f, 0xae, 0xcd, 0xdc, 0xd7, 0x46, 0x10, 0xf2, 0xde,
+	0xa0, 0x63, 0xfb, 0x24, 0x76, 0xb2, 0xdb, 0xfd, 0x3a, 0x42, 0x1d, 0x36, 0x2a, 0x9c, 0xe3, 0xed,
+	0xb7, 0xce, 0x77, 0x75, 0xf6, 0xf9, 0x90, 0x62, 0xd6, 0x29, 0x48, 0x8f, 0x6f, 0xfe, 0x0c, 0x00,
+	0x70, 0x2f, 0x76, 0x35, 0x13, 0x06, 0x00, 0x00,
 }
 
 func (m *TwapRecord) Marshal() (dAtA []byte, err error) {
@@ -288,6 +304,42 @@ func (m *TwapRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.LogPriceSquaredAccumulator != nil {
+		{
+			size := m.LogPriceSquaredAccumulator.Size()
+			i -= size
+			if _, err := m.LogPriceSquaredAccumulator.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTwapRecord(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.Asset1VolumeAccumulator != nil {
+		{
+			size := m.Asset1VolumeAccumulator.Size()
+			i -= size
+			if _, err := m.Asset1VolumeAccumulator.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTwapRecord(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.Asset0VolumeAccumulator != nil {
+		{
+			size := m.Asset0VolumeAccumulator.Size()
+			i -= size
+			if _, err := m.Asset0VolumeAccumulator.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTwapRecord(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
 	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.LastErrorTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastErrorTime):])
 	if err1 != nil {
 		return 0, err1
@@ -479,6 +531,18 @@ func (m *TwapRecord) Size() (n int) {
 	n += 1 + l + sovTwapRecord(uint64(l))
 	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastErrorTime)
 	n += 1 + l + sovTwapRecord(uint64(l))
+	if m.Asset0VolumeAccumulator != nil {
+		l = m.Asset0VolumeAccumulator.Size()
+		n += 1 + l + sovTwapRecord(uint64(l))
+	}
+	if m.Asset1VolumeAccumulator != nil {
+		l = m.Asset1VolumeAccumulator.Size()
+		n += 1 + l + sovTwapRecord(uint64(l))
+	}
+	if m.LogPriceSquaredAccumulator != nil {
+		l = m.LogPriceSquaredAccumulator.Size()
+		n += 1 + l + sovTwapRecord(uint64(l))
+	}
 	return n
 }
 
@@ -876,6 +940,114 @@ func (m *TwapRecord) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset0VolumeAccumulator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.Asset0VolumeAccumulator = &v
+			if err := m.Asset0VolumeAccumulator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset1VolumeAccumulator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.Asset1VolumeAccumulator = &v
+			if err := m.Asset1VolumeAccumulator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogPriceSquaredAccumulator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.LogPriceSquaredAccumulator = &v
+			if err := m.LogPriceSquaredAccumulator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTwapRecord(dAtA[iNdEx:])
@@ -4,6 +4,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v24/x/poolmanager/types"
 	"github.com/osmosis-labs/osmosis/v24/x/twap/types"
 )
 
@@ -89,3 +90,7 @@ func (p *ProgrammedPoolManagerInterface) RouteCalculateSpotPrice(ctx sdk.Context
 func (p *ProgrammedPoolManagerInterface) GetNextPoolId(ctx sdk.Context) uint64 {
 	return p.underlyingKeeper.GetNextPoolId(ctx)
 }
+
+func (p *ProgrammedPoolManagerInterface) GetPool(ctx sdk.Context, poolId uint64) (poolmanagertypes.PoolI, error) {
+	return p.underlyingKeeper.GetPool(ctx, poolId)
+}
@@ -50,6 +50,14 @@ func AccumDiffDivDuration(accumDiff osmomath.Dec, timeDeltaMs int64) osmomath.De
 	return accumDiff.QuoInt64(timeDeltaMs)
 }
 
+// VolumeAccumulatorOrZero returns the value of a volume accumulator, which is zero if it is unset.
+func VolumeAccumulatorOrZero(accumulator *osmomath.Int) osmomath.Int {
+	if accumulator == nil {
+		return osmomath.ZeroInt()
+	}
+	return *accumulator
+}
+
 // LexicographicalOrderDenoms takes two denoms and returns them to be in lexicographically ascending order.
 // In other words, the first returned denom string will be the lexicographically smaller of the two denoms.
 // If the denoms are equal, an error will be returned.
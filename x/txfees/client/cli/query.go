@@ -21,6 +21,8 @@ func GetQueryCmd() *cobra.Command {
 	)
 
 	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdQueryBaseFee)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdQueryBaseFeeHistory)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdQueryFeeEstimate)
 
 	return cmd
 }
@@ -67,3 +69,21 @@ func GetCmdQueryBaseFee() (*osmocli.QueryDescriptor, *types.QueryEipBaseFeeReque
 		QueryFnName: "GetEipBaseFee",
 	}, &types.QueryEipBaseFeeRequest{}
 }
+
+func GetCmdQueryBaseFeeHistory() (*osmocli.QueryDescriptor, *types.QueryEipBaseFeeHistoryRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "base-fee-history",
+		Short: "Query the eip base fee at each of the recent heights.",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} base-fee-history --reverse`,
+	}, &types.QueryEipBaseFeeHistoryRequest{}
+}
+
+func GetCmdQueryFeeEstimate() (*osmocli.QueryDescriptor, *types.QueryFeeEstimateRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "fee-estimate",
+		Short: "Query the minimum fee in the given denom accepted by the node's mempool for a tx wanting the given gas.",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} fee-estimate 200000 ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2`,
+	}, &types.QueryFeeEstimateRequest{}
+}
@@ -0,0 +1,175 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	gogotypes "github.com/gogo/protobuf/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	mempool1559 "github.com/osmosis-labs/osmosis/v24/x/txfees/keeper/mempool-1559"
+	"github.com/osmosis-labs/osmosis/v24/x/txfees/types"
+)
+
+// IsEip1559StateEnabled returns true if the EIP-1559 base fee is tracked in the module store
+// rather than locally by each node.
+func (k Keeper) IsEip1559StateEnabled(ctx sdk.Context) bool {
+	return k.GetParams(ctx).Eip1559Params.Enabled
+}
+
+// GetCurBaseFee returns the current EIP-1559 base fee. If the base fee is tracked in the module
+// store it is read from there, otherwise the node-local base fee is returned.
+func (k Keeper) GetCurBaseFee(ctx sdk.Context) osmomath.Dec {
+	ctx = withoutGasMetering(ctx)
+	params := k.GetParams(ctx).Eip1559Params
+	if !params.Enabled {
+		return mempool1559.CurEipState.GetCurBaseFee()
+	}
+	return k.getStateBaseFee(ctx, params)
+}
+
+// GetCurRecheckBaseFee returns the current EIP-1559 base fee used to recheck transactions.
+func (k Keeper) GetCurRecheckBaseFee(ctx sdk.Context) osmomath.Dec {
+	ctx = withoutGasMetering(ctx)
+	params := k.GetParams(ctx).Eip1559Params
+	if !params.Enabled {
+		return mempool1559.CurEipState.GetCurRecheckBaseFee()
+	}
+	return mempool1559.RecheckBaseFee(k.getStateBaseFee(ctx, params))
+}
+
+// SetStateBaseFee sets the EIP-1559 base fee tracked in the module store.
+func (k Keeper) SetStateBaseFee(ctx sdk.Context, baseFee osmomath.Dec) {
+	osmoutils.MustSetDec(ctx.KVStore(k.storeKey), types.KeyEip1559CurBaseFee, baseFee)
+}
+
+// getStateBaseFee returns the base fee tracked in the module store, or the default base fee
+// if it has not been set yet.
+func (k Keeper) getStateBaseFee(ctx sdk.Context, params types.Eip1559Params) osmomath.Dec {
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has(types.KeyEip1559CurBaseFee) {
+		return params.DefaultBaseFee.Clone()
+	}
+	return osmoutils.MustGetDec(store, types.KeyEip1559CurBaseFee)
+}
+
+// AddBlockGasWanted adds the gas wanted by a transaction to the total gas wanted in the current block.
+// It is a no-op if the base fee is not tracked in the module store.
+func (k Keeper) AddBlockGasWanted(ctx sdk.Context, gasWanted uint64) {
+	ctx = withoutGasMetering(ctx)
+	if !k.IsEip1559StateEnabled(ctx) {
+		return
+	}
+	total := k.GetBlockGasWanted(ctx)
+	osmoutils.MustSet(ctx.KVStore(k.storeKey), types.KeyEip1559BlockGasWanted, &gogotypes.UInt64Value{Value: total + gasWanted})
+}
+
+// GetBlockGasWanted returns the total gas wanted by the transactions of the current block.
+func (k Keeper) GetBlockGasWanted(ctx sdk.Context) uint64 {
+	gasWanted := gogotypes.UInt64Value{}
+	found, err := osmoutils.Get(ctx.KVStore(k.storeKey), types.KeyEip1559BlockGasWanted, &gasWanted)
+	if err != nil || !found {
+		return 0
+	}
+	return gasWanted.Value
+}
+
+// Eip1559BeginBlock resets the base fee tracked in the module store to its default value every
+// ResetInterval blocks.
+func (k Keeper) Eip1559BeginBlock(ctx sdk.Context) {
+	params := k.GetParams(ctx).Eip1559Params
+	if !params.Enabled {
+		return
+	}
+
+	if ctx.BlockHeight()%params.ResetInterval == 0 {
+		k.SetStateBaseFee(ctx, params.DefaultBaseFee)
+	}
+}
+
+// Eip1559EndBlock updates the base fee tracked in the module store based on the gas wanted in the
+// block, records it in the base fee history and prunes the records that are older than HistoryLength.
+func (k Keeper) Eip1559EndBlock(ctx sdk.Context) {
+	params := k.GetParams(ctx).Eip1559Params
+	if !params.Enabled {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	gasWanted := k.GetBlockGasWanted(ctx)
+	store.Delete(types.KeyEip1559BlockGasWanted)
+
+	baseFee := mempool1559.NextBaseFee(
+		k.getStateBaseFee(ctx, params),
+		int64(gasWanted),
+		params.TargetGas,
+		params.MaxBlockChangeRate,
+		params.MinBaseFee,
+		params.MaxBaseFee,
+	)
+	k.SetStateBaseFee(ctx, baseFee)
+
+	height := ctx.BlockHeight()
+	k.setBaseFeeRecord(ctx, types.BaseFeeRecord{Height: height, BaseFee: baseFee})
+	k.pruneBaseFeeHistory(ctx, height-int64(params.HistoryLength))
+}
+
+// GetBaseFeeHistory returns all the base fee records, in ascending height order.
+func (k Keeper) GetBaseFeeHistory(ctx sdk.Context) ([]types.BaseFeeRecord, error) {
+	return osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(k.storeKey), types.KeyEip1559BaseFeeHistoryPrefix, parseBaseFeeRecord)
+}
+
+// GetBaseFeeHistoryPaginated returns the base fee records for the given page, in ascending height order.
+func (k Keeper) GetBaseFeeHistoryPaginated(ctx sdk.Context, pagination *query.PageRequest) ([]types.BaseFeeRecord, *query.PageResponse, error) {
+	historyStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyEip1559BaseFeeHistoryPrefix)
+
+	var records []types.BaseFeeRecord
+	pageRes, err := query.Paginate(historyStore, pagination, func(_, value []byte) error {
+		record, err := parseBaseFeeRecord(value)
+		if err != nil {
+			return err
+		}
+		records = append(records, record)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return records, pageRes, nil
+}
+
+func (k Keeper) setBaseFeeRecord(ctx sdk.Context, record types.BaseFeeRecord) {
+	osmoutils.MustSet(ctx.KVStore(k.storeKey), types.KeyEip1559BaseFeeHistory(record.Height), &record)
+}
+
+// pruneBaseFeeHistory deletes the base fee records at or below the given height.
+func (k Keeper) pruneBaseFeeHistory(ctx sdk.Context, height int64) {
+	if height < 0 {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.KeyEip1559BaseFeeHistoryPrefix, types.KeyEip1559BaseFeeHistory(height+1))
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// withoutGasMetering returns a context with an infinite gas meter, so that tracking the base fee from
+// the ante handler does not change the gas consumed by transactions.
+func withoutGasMetering(ctx sdk.Context) sdk.Context {
+	return ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+}
+
+func parseBaseFeeRecord(bz []byte) (types.BaseFeeRecord, error) {
+	var record types.BaseFeeRecord
+	err := record.Unmarshal(bz)
+	return record, err
+}
@@ -0,0 +1,118 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	mempool1559 "github.com/osmosis-labs/osmosis/v24/x/txfees/keeper/mempool-1559"
+	"github.com/osmosis-labs/osmosis/v24/x/txfees/types"
+)
+
+func (s *KeeperTestSuite) enableEip1559State(historyLength uint64) types.Eip1559Params {
+	params := s.App.TxFeesKeeper.GetParams(s.Ctx)
+	params.Eip1559Params.Enabled = true
+	params.Eip1559Params.HistoryLength = historyLength
+	s.App.TxFeesKeeper.SetParams(s.Ctx, params)
+	return params.Eip1559Params
+}
+
+func (s *KeeperTestSuite) TestEip1559StateDisabled() {
+	s.SetupTest(false)
+
+	// The node-local base fee is used while tracking in state is disabled
+	originalEipState := mempool1559.CurEipState.Clone()
+	defer func() { mempool1559.CurEipState = originalEipState }()
+	mempool1559.CurEipState.CurBaseFee = osmomath.MustNewDecFromStr("0.01")
+	s.Require().Equal(osmomath.MustNewDecFromStr("0.01"), s.App.TxFeesKeeper.GetCurBaseFee(s.Ctx))
+
+	s.App.TxFeesKeeper.AddBlockGasWanted(s.Ctx, 1_000_000)
+	s.Require().Equal(uint64(0), s.App.TxFeesKeeper.GetBlockGasWanted(s.Ctx))
+
+	s.App.TxFeesKeeper.Eip1559EndBlock(s.Ctx)
+	history, err := s.App.TxFeesKeeper.GetBaseFeeHistory(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Empty(history)
+}
+
+func (s *KeeperTestSuite) TestEip1559State() {
+	s.SetupTest(false)
+	params := s.enableEip1559State(3)
+
+	s.Ctx = s.Ctx.WithBlockHeight(params.ResetInterval + 1)
+	s.Require().Equal(params.DefaultBaseFee, s.App.TxFeesKeeper.GetCurBaseFee(s.Ctx))
+
+	// A full block increases the base fee by the max change rate
+	s.App.TxFeesKeeper.Eip1559BeginBlock(s.Ctx)
+	s.App.TxFeesKeeper.AddBlockGasWanted(s.Ctx, uint64(params.TargetGas))
+	s.App.TxFeesKeeper.AddBlockGasWanted(s.Ctx, uint64(params.TargetGas))
+	s.Require().Equal(uint64(2*params.TargetGas), s.App.TxFeesKeeper.GetBlockGasWanted(s.Ctx))
+	s.App.TxFeesKeeper.Eip1559EndBlock(s.Ctx)
+
+	expectedBaseFee := params.DefaultBaseFee.Mul(osmomath.OneDec().Add(params.MaxBlockChangeRate))
+	s.Require().Equal(expectedBaseFee, s.App.TxFeesKeeper.GetCurBaseFee(s.Ctx))
+	s.Require().Equal(expectedBaseFee.Quo(mempool1559.RecheckFeeLowBaseFeeDec), s.App.TxFeesKeeper.GetCurRecheckBaseFee(s.Ctx))
+	s.Require().Equal(uint64(0), s.App.TxFeesKeeper.GetBlockGasWanted(s.Ctx))
+
+	// Empty blocks decrease the base fee down to the min base fee
+	for i := 0; i < 100; i++ {
+		s.Ctx = s.Ctx.WithBlockHeight(s.Ctx.BlockHeight() + 1)
+		s.App.TxFeesKeeper.Eip1559BeginBlock(s.Ctx)
+		s.App.TxFeesKeeper.Eip1559EndBlock(s.Ctx)
+	}
+	s.Require().Equal(params.MinBaseFee, s.App.TxFeesKeeper.GetCurBaseFee(s.Ctx))
+
+	// Only the last HistoryLength heights are kept
+	history, err := s.App.TxFeesKeeper.GetBaseFeeHistory(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Len(history, 3)
+	s.Require().Equal(s.Ctx.BlockHeight()-2, history[0].Height)
+	s.Require().Equal(s.Ctx.BlockHeight(), history[2].Height)
+	s.Require().Equal(params.MinBaseFee, history[2].BaseFee)
+
+	// The base fee is reset every ResetInterval blocks
+	s.Ctx = s.Ctx.WithBlockHeight(2 * params.ResetInterval)
+	s.App.TxFeesKeeper.Eip1559BeginBlock(s.Ctx)
+	s.Require().Equal(params.DefaultBaseFee, s.App.TxFeesKeeper.GetCurBaseFee(s.Ctx))
+}
+
+func (s *KeeperTestSuite) TestEip1559StateDoesNotConsumeTxGas() {
+	s.SetupTest(false)
+	s.enableEip1559State(10)
+
+	s.Ctx = s.Ctx.WithGasMeter(sdk.NewGasMeter(1_000_000))
+	s.App.TxFeesKeeper.AddBlockGasWanted(s.Ctx, 100)
+	s.App.TxFeesKeeper.GetCurBaseFee(s.Ctx)
+	s.Require().Equal(uint64(0), s.Ctx.GasMeter().GasConsumed())
+}
+
+func (s *KeeperTestSuite) TestEipBaseFeeHistoryQuery() {
+	s.SetupTest(false)
+	s.enableEip1559State(10)
+
+	for height := int64(1); height <= 5; height++ {
+		s.Ctx = s.Ctx.WithBlockHeight(height)
+		s.App.TxFeesKeeper.Eip1559BeginBlock(s.Ctx)
+		s.App.TxFeesKeeper.Eip1559EndBlock(s.Ctx)
+	}
+
+	res, err := s.queryClient.EipBaseFeeHistory(s.Ctx.Context(), &types.QueryEipBaseFeeHistoryRequest{
+		Pagination: &query.PageRequest{Limit: 2, Reverse: true},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(res.BaseFees, 2)
+	s.Require().Equal(int64(5), res.BaseFees[0].Height)
+	s.Require().Equal(int64(4), res.BaseFees[1].Height)
+	s.Require().NotNil(res.Pagination.NextKey)
+
+	baseFeeRes, err := s.queryClient.GetEipBaseFee(s.Ctx.Context(), &types.QueryEipBaseFeeRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(res.BaseFees[0].BaseFee, baseFeeRes.BaseFee)
+
+	// The state is exported and imported through genesis
+	genesis := s.App.TxFeesKeeper.ExportGenesis(s.Ctx)
+	s.Require().NotNil(genesis.Eip1559BaseFee)
+	s.Require().Equal(baseFeeRes.BaseFee, *genesis.Eip1559BaseFee)
+	s.Require().Len(genesis.Eip1559BaseFeeHistory, 5)
+	s.Require().NoError(genesis.Validate())
+}
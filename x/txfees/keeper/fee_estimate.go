@@ -0,0 +1,95 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v24/x/txfees/types"
+)
+
+// EstimateFee returns the minimum fee, in the given fee denom, that a MempoolFeeDecorator with the given
+// options accepts in CheckTx and ReCheckTx for a tx wanting the given gas.
+// The tx is assumed not to be an arbitrage tx and not to belong to any fee lane.
+func (k Keeper) EstimateFee(ctx sdk.Context, opts types.MempoolFeeOptions, gas uint64, feeDenom string) (*types.QueryFeeEstimateResponse, error) {
+	if gas == 0 {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "gas must be positive")
+	}
+	if gas > opts.MaxGasWantedPerTx {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrOutOfGas, "Too much gas wanted: %d, maximum is %d", gas, opts.MaxGasWantedPerTx)
+	}
+
+	baseDenom, err := k.GetBaseDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &types.QueryFeeEstimateResponse{
+		SpotPrice:  osmomath.OneDec(),
+		EipBaseFee: k.GetCurBaseFee(ctx),
+	}
+	if feeDenom != baseDenom {
+		feeToken, err := k.GetFeeToken(ctx, feeDenom)
+		if err != nil {
+			return nil, err
+		}
+		spotPrice, err := k.CalcFeeSpotPrice(ctx, feeDenom)
+		if err != nil {
+			return nil, err
+		}
+		response.PoolID = feeToken.PoolID
+		// Note: spotPrice truncation is done here to match ConvertToBaseToken.
+		response.SpotPrice = spotPrice.Dec()
+	}
+
+	mfd := MempoolFeeDecorator{TxFeesKeeper: k, Opts: opts}
+	checkTxCtx := ctx.WithIsCheckTx(true).WithIsReCheckTx(false)
+	recheckTxCtx := ctx.WithIsCheckTx(true).WithIsReCheckTx(true)
+
+	response.CheckTxMinGasPrice = sdk.MaxDec(types.ConsensusMinFee, mfd.getMinBaseGasPriceForGas(checkTxCtx, baseDenom, gas, nil, false))
+	response.RecheckTxMinGasPrice = sdk.MaxDec(types.ConsensusMinFee, mfd.getMinBaseGasPriceForGas(recheckTxCtx, baseDenom, gas, nil, false))
+
+	response.CheckTxMinFee, err = minFeeInDenom(getRequiredBaseFee(baseDenom, response.CheckTxMinGasPrice, gas), feeDenom, response.SpotPrice)
+	if err != nil {
+		return nil, err
+	}
+	response.RecheckTxMinFee, err = minFeeInDenom(getRequiredBaseFee(baseDenom, response.RecheckTxMinGasPrice, gas), feeDenom, response.SpotPrice)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// minFeeInDenom returns the smallest amount of the fee denom that ConvertToBaseToken converts to at least
+// the required base fee, given the spot price of the fee denom in the base denom.
+func minFeeInDenom(requiredBaseFee sdk.Coin, feeDenom string, spotPrice osmomath.Dec) (sdk.Coin, error) {
+	if feeDenom == requiredBaseFee.Denom {
+		return requiredBaseFee, nil
+	}
+	if !spotPrice.IsPositive() {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrInvalidFeeToken, "spot price of %s is not positive", feeDenom)
+	}
+
+	// convertToBase mirrors the conversion done by ConvertToBaseToken with the same spot price.
+	convertToBase := func(amount osmomath.Int) osmomath.Int {
+		return spotPrice.MulInt(amount).RoundInt()
+	}
+
+	// ConvertToBaseToken rounds the converted amount, so the smallest fee is around (required - 0.5) / spotPrice.
+	// The division and the banker's rounding of the conversion can put it off by one in either direction.
+	amount := osmomath.NewDecFromInt(requiredBaseFee.Amount).Sub(osmomath.NewDecWithPrec(5, 1)).Quo(spotPrice).Ceil().RoundInt()
+	if amount.IsNegative() {
+		amount = osmomath.ZeroInt()
+	}
+	if convertToBase(amount).LT(requiredBaseFee.Amount) {
+		amount = amount.AddRaw(1)
+	} else if amount.IsPositive() && convertToBase(amount.SubRaw(1)).GTE(requiredBaseFee.Amount) {
+		amount = amount.SubRaw(1)
+	}
+
+	if convertToBase(amount).LT(requiredBaseFee.Amount) {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrInvalidFeeToken, "failed to compute the minimum fee in %s for %s", feeDenom, requiredBaseFee)
+	}
+	return sdk.NewCoin(feeDenom, amount), nil
+}
@@ -0,0 +1,73 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v24/x/txfees/types"
+)
+
+func (s *KeeperTestSuite) TestEstimateFee() {
+	s.SetupTest(false)
+	s.enableEip1559State(10)
+	baseDenom, _ := s.App.TxFeesKeeper.GetBaseDenom(s.Ctx)
+	baseFee := osmomath.MustNewDecFromStr("0.01")
+	s.App.TxFeesKeeper.SetStateBaseFee(s.Ctx, baseFee)
+	recheckBaseFee := s.App.TxFeesKeeper.GetCurRecheckBaseFee(s.Ctx)
+
+	// uion is worth a third of the base denom
+	uionPoolId := s.PrepareBalancerPoolWithCoins(
+		sdk.NewInt64Coin(baseDenom, 1_000_000),
+		sdk.NewInt64Coin("uion", 3_000_000),
+	)
+	s.Require().NoError(s.ExecuteUpgradeFeeTokenProposal("uion", uionPoolId))
+
+	mempoolFeeOpts := types.NewDefaultMempoolFeeOptions()
+	mempoolFeeOpts.Mempool1559Enabled = true
+	s.Ctx = s.Ctx.WithMinGasPrices(sdk.NewDecCoins(sdk.NewDecCoinFromDec(baseDenom, osmomath.MustNewDecFromStr("0.0025"))))
+	gas := uint64(123_457)
+
+	// Base denom fees need no conversion
+	estimate, err := s.App.TxFeesKeeper.EstimateFee(s.Ctx, mempoolFeeOpts, gas, baseDenom)
+	s.Require().NoError(err)
+	s.Require().Equal(baseFee, estimate.EipBaseFee)
+	s.Require().Equal(baseFee, estimate.CheckTxMinGasPrice)
+	s.Require().Equal(recheckBaseFee, estimate.RecheckTxMinGasPrice)
+	s.Require().Equal(uint64(0), estimate.PoolID)
+	s.Require().Equal(sdk.NewInt64Coin(baseDenom, 1235), estimate.CheckTxMinFee)
+
+	// Fees in other fee tokens are the smallest amounts that are sufficient
+	estimate, err = s.App.TxFeesKeeper.EstimateFee(s.Ctx, mempoolFeeOpts, gas, "uion")
+	s.Require().NoError(err)
+	s.Require().Equal(uionPoolId, estimate.PoolID)
+	s.Require().Equal("uion", estimate.CheckTxMinFee.Denom)
+	for _, tc := range []struct {
+		minGasPrice osmomath.Dec
+		minFee      sdk.Coin
+	}{
+		{estimate.CheckTxMinGasPrice, estimate.CheckTxMinFee},
+		{estimate.RecheckTxMinGasPrice, estimate.RecheckTxMinFee},
+	} {
+		s.Require().NoError(s.App.TxFeesKeeper.IsSufficientFee(s.Ctx, tc.minGasPrice, gas, tc.minFee))
+		lowerFee := tc.minFee.SubAmount(osmomath.OneInt())
+		s.Require().Error(s.App.TxFeesKeeper.IsSufficientFee(s.Ctx, tc.minGasPrice, gas, lowerFee))
+	}
+
+	// The high gas floor applies
+	mempoolFeeOpts.MinGasPriceForHighGasTx = osmomath.MustNewDecFromStr("0.5")
+	estimate, err = s.App.TxFeesKeeper.EstimateFee(s.Ctx, mempoolFeeOpts, mempoolFeeOpts.HighGasTxThreshold, baseDenom)
+	s.Require().NoError(err)
+	s.Require().Equal(mempoolFeeOpts.MinGasPriceForHighGasTx, estimate.CheckTxMinGasPrice)
+
+	// Unknown fee tokens and too much gas are rejected
+	_, err = s.App.TxFeesKeeper.EstimateFee(s.Ctx, mempoolFeeOpts, gas, "moooooo")
+	s.Require().Error(err)
+	_, err = s.App.TxFeesKeeper.EstimateFee(s.Ctx, mempoolFeeOpts, mempoolFeeOpts.MaxGasWantedPerTx+1, baseDenom)
+	s.Require().Error(err)
+
+	// The query uses the node mempool options
+	res, err := s.queryClient.FeeEstimate(sdk.WrapSDKContext(s.Ctx), &types.QueryFeeEstimateRequest{Gas: gas, Denom: "uion"})
+	s.Require().NoError(err)
+	s.Require().Equal(uionPoolId, res.PoolID)
+	s.Require().False(res.CheckTxMinFee.IsZero())
+}
@@ -74,6 +74,7 @@ func (mfd MempoolFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate b
 	// I want ctx.IsDeliverTx() but that doesn't exist.
 	if !ctx.IsCheckTx() && !ctx.IsReCheckTx() {
 		mempool1559.DeliverTxCode(ctx, feeTx)
+		mfd.TxFeesKeeper.AddBlockGasWanted(ctx, feeTx.GetGas())
 	}
 
 	baseDenom, err := mfd.TxFeesKeeper.GetBaseDenom(ctx)
@@ -140,12 +141,7 @@ func (k Keeper) IsSufficientFee(ctx sdk.Context, minBaseGasPrice osmomath.Dec, g
 		return err
 	}
 
-	// Determine the required fees by multiplying the required minimum gas
-	// price by the gas limit, where fee = ceil(minGasPrice * gasLimit).
-	// note we mutate this one line below, to avoid extra heap allocations.
-	glDec := osmomath.NewDec(int64(gasRequested))
-	baseFeeAmt := glDec.MulMut(minBaseGasPrice).Ceil().RoundInt()
-	requiredBaseFee := sdk.Coin{Denom: baseDenom, Amount: baseFeeAmt}
+	requiredBaseFee := getRequiredBaseFee(baseDenom, minBaseGasPrice, gasRequested)
 
 	convertedFee, err := k.ConvertToBaseToken(ctx, feeCoin)
 	if err != nil {
@@ -159,24 +155,49 @@ func (k Keeper) IsSufficientFee(ctx sdk.Context, minBaseGasPrice osmomath.Dec, g
 	return nil
 }
 
+// getRequiredBaseFee determines the required fees by multiplying the required minimum gas
+// price by the gas limit, where fee = ceil(minGasPrice * gasLimit).
+func getRequiredBaseFee(baseDenom string, minBaseGasPrice osmomath.Dec, gasRequested uint64) sdk.Coin {
+	// note we mutate this one line below, to avoid extra heap allocations.
+	glDec := osmomath.NewDec(int64(gasRequested))
+	baseFeeAmt := glDec.MulMut(minBaseGasPrice).Ceil().RoundInt()
+	return sdk.Coin{Denom: baseDenom, Amount: baseFeeAmt}
+}
+
+// GetMinBaseGasPriceForTx returns the min gas price, in the base denom, required for the tx to enter the local mempool.
+// If the tx belongs to a fee lane, the node min gas price and the EIP-1559 base fee are scaled by the lane multiplier,
+// and txs in a recheck fee exempt lane are not evicted because of an increase of the base fee.
 func (mfd MempoolFeeDecorator) GetMinBaseGasPriceForTx(ctx sdk.Context, baseDenom string, tx sdk.FeeTx) osmomath.Dec {
+	var txLane *types.FeeLane
+	if lane, inLane := mfd.Opts.GetFeeLane(tx); inLane {
+		txLane = &lane
+	}
+	return mfd.getMinBaseGasPriceForGas(ctx, baseDenom, tx.GetGas(), txLane, txfee_filters.IsArbTxLoose(tx))
+}
+
+// getMinBaseGasPriceForGas returns the min gas price, in the base denom, required in the local mempool
+// for a tx wanting the given gas. lane is nil if the tx does not belong to any fee lane.
+func (mfd MempoolFeeDecorator) getMinBaseGasPriceForGas(ctx sdk.Context, baseDenom string, gas uint64, lane *types.FeeLane, isArbTx bool) osmomath.Dec {
 	var is1559enabled = mfd.Opts.Mempool1559Enabled
 
 	cfgMinGasPrice := ctx.MinGasPrices().AmountOf(baseDenom)
-	// the check below prevents tx gas from getting over HighGasTxThreshold which is default to 1_000_000
-	if tx.GetGas() >= mfd.Opts.HighGasTxThreshold {
-		cfgMinGasPrice = sdk.MaxDec(cfgMinGasPrice, mfd.Opts.MinGasPriceForHighGasTx)
-	}
-	if txfee_filters.IsArbTxLoose(tx) {
-		cfgMinGasPrice = sdk.MaxDec(cfgMinGasPrice, mfd.Opts.MinGasPriceForArbitrageTx)
-	}
 	// Initial tx only, no recheck
 	if is1559enabled && ctx.IsCheckTx() && !ctx.IsReCheckTx() {
-		cfgMinGasPrice = sdk.MaxDec(cfgMinGasPrice, mempool1559.CurEipState.GetCurBaseFee())
+		cfgMinGasPrice = sdk.MaxDec(cfgMinGasPrice, mfd.TxFeesKeeper.GetCurBaseFee(ctx))
 	}
 	// RecheckTx only
-	if is1559enabled && ctx.IsReCheckTx() {
-		cfgMinGasPrice = sdk.MaxDec(cfgMinGasPrice, mempool1559.CurEipState.GetCurRecheckBaseFee())
+	if is1559enabled && ctx.IsReCheckTx() && !(lane != nil && lane.RecheckFeeExempt) {
+		cfgMinGasPrice = sdk.MaxDec(cfgMinGasPrice, mfd.TxFeesKeeper.GetCurRecheckBaseFee(ctx))
+	}
+	if lane != nil {
+		cfgMinGasPrice = cfgMinGasPrice.Mul(lane.MinGasPriceMultiplier)
+	}
+	// the check below prevents tx gas from getting over HighGasTxThreshold which is default to 1_000_000
+	if gas >= mfd.Opts.HighGasTxThreshold {
+		cfgMinGasPrice = sdk.MaxDec(cfgMinGasPrice, mfd.Opts.MinGasPriceForHighGasTx)
+	}
+	if isArbTx {
+		cfgMinGasPrice = sdk.MaxDec(cfgMinGasPrice, mfd.Opts.MinGasPriceForArbitrageTx)
 	}
 	return cfgMinGasPrice
 }
@@ -5,8 +5,11 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v24/x/txfees/keeper"
 	"github.com/osmosis-labs/osmosis/v24/x/txfees/types"
 )
 
@@ -168,3 +171,65 @@ func (s *KeeperTestSuite) TestFeeDecorator() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestGetMinBaseGasPriceForTx_FeeLanes() {
+	s.SetupTest(false)
+	s.enableEip1559State(10)
+	baseDenom, _ := s.App.TxFeesKeeper.GetBaseDenom(s.Ctx)
+	s.App.TxFeesKeeper.SetStateBaseFee(s.Ctx, osmomath.MustNewDecFromStr("0.01"))
+	recheckBaseFee := s.App.TxFeesKeeper.GetCurRecheckBaseFee(s.Ctx)
+
+	mempoolFeeOpts := types.NewDefaultMempoolFeeOptions()
+	mempoolFeeOpts.Mempool1559Enabled = true
+	mempoolFeeOpts.FeeLanes = []types.FeeLane{
+		{
+			Name:                  "relayer",
+			MsgTypeUrls:           []string{"/cosmos.bank.v1beta1.MsgSend"},
+			MinGasPriceMultiplier: osmomath.MustNewDecFromStr("0.5"),
+			RecheckFeeExempt:      true,
+		},
+	}
+	mfd := keeper.NewMempoolFeeDecorator(*s.App.TxFeesKeeper, mempoolFeeOpts)
+
+	sender, recipient := s.TestAccs[0], s.TestAccs[1]
+	coins := sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 1))
+	sendMsg := banktypes.NewMsgSend(sender, recipient, coins)
+	delegateMsg := stakingtypes.NewMsgDelegate(sender, sdk.ValAddress(recipient), coins[0])
+
+	tests := map[string]struct {
+		msgs             []sdk.Msg
+		isRecheckTx      bool
+		expectedMinPrice osmomath.Dec
+	}{
+		"lane tx in CheckTx pays the multiplied base fee": {
+			msgs:             []sdk.Msg{sendMsg},
+			expectedMinPrice: osmomath.MustNewDecFromStr("0.005"),
+		},
+		"lane tx in RecheckTx is exempt from the recheck base fee": {
+			msgs:             []sdk.Msg{sendMsg, sendMsg},
+			isRecheckTx:      true,
+			expectedMinPrice: osmomath.ZeroDec(),
+		},
+		"tx not in a lane in CheckTx pays the base fee": {
+			msgs:             []sdk.Msg{delegateMsg},
+			expectedMinPrice: osmomath.MustNewDecFromStr("0.01"),
+		},
+		"tx with messages outside of the lane in RecheckTx pays the recheck base fee": {
+			msgs:             []sdk.Msg{sendMsg, delegateMsg},
+			isRecheckTx:      true,
+			expectedMinPrice: recheckBaseFee,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			txBuilder := s.clientCtx.TxConfig.NewTxBuilder()
+			s.Require().NoError(txBuilder.SetMsgs(tc.msgs...))
+			txBuilder.SetGasLimit(100_000)
+
+			ctx := s.Ctx.WithIsCheckTx(true).WithIsReCheckTx(tc.isRecheckTx)
+			minGasPrice := mfd.GetMinBaseGasPriceForTx(ctx, baseDenom, txBuilder.GetTx())
+			s.Require().Equal(tc.expectedMinPrice, minGasPrice)
+		})
+	}
+}
@@ -18,6 +18,12 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 		panic(err)
 	}
 	k.SetParams(ctx, genState.Params)
+	if genState.Eip1559BaseFee != nil {
+		k.SetStateBaseFee(ctx, *genState.Eip1559BaseFee)
+	}
+	for _, record := range genState.Eip1559BaseFeeHistory {
+		k.setBaseFeeRecord(ctx, record)
+	}
 }
 
 // ExportGenesis returns the txfees module's exported genesis.
@@ -26,5 +32,14 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	genesis.Basedenom, _ = k.GetBaseDenom(ctx)
 	genesis.Feetokens = k.GetFeeTokens(ctx)
 	genesis.Params = k.GetParams(ctx)
+	if ctx.KVStore(k.storeKey).Has(types.KeyEip1559CurBaseFee) {
+		baseFee := k.getStateBaseFee(ctx, genesis.Params.Eip1559Params)
+		genesis.Eip1559BaseFee = &baseFee
+	}
+	history, err := k.GetBaseFeeHistory(ctx)
+	if err != nil {
+		panic(err)
+	}
+	genesis.Eip1559BaseFeeHistory = history
 	return genesis
 }
@@ -31,6 +31,7 @@ func (s *KeeperTestSuite) TestInitGenesis() {
 		Feetokens: testFeeTokens,
 		Params: types.Params{
 			WhitelistedFeeTokenSetters: testWhitelistAddrs,
+			Eip1559Params:              types.DefaultEip1559Params(),
 		},
 	})
 
@@ -53,6 +54,7 @@ func (s *KeeperTestSuite) TestExportGenesis() {
 		Feetokens: testFeeTokens,
 		Params: types.Params{
 			WhitelistedFeeTokenSetters: testWhitelistAddrs,
+			Eip1559Params:              types.DefaultEip1559Params(),
 		},
 	})
 
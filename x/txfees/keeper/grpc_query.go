@@ -20,10 +20,12 @@ var _ types.QueryServer = Querier{}
 type Querier struct {
 	Keeper
 	mempool1559.EipState
+
+	mempoolFeeOptions types.MempoolFeeOptions
 }
 
-func NewQuerier(k Keeper) Querier {
-	return Querier{Keeper: k}
+func NewQuerier(k Keeper, mempoolFeeOptions types.MempoolFeeOptions) Querier {
+	return Querier{Keeper: k, mempoolFeeOptions: mempoolFeeOptions}
 }
 
 func (q Querier) FeeTokens(ctx context.Context, _ *types.QueryFeeTokensRequest) (*types.QueryFeeTokensResponse, error) {
@@ -86,7 +88,34 @@ func (q Querier) BaseDenom(ctx context.Context, _ *types.QueryBaseDenomRequest)
 	return &types.QueryBaseDenomResponse{BaseDenom: baseDenom}, nil
 }
 
-func (q Querier) GetEipBaseFee(_ context.Context, _ *types.QueryEipBaseFeeRequest) (*types.QueryEipBaseFeeResponse, error) {
-	response := mempool1559.CurEipState.GetCurBaseFee()
+func (q Querier) GetEipBaseFee(ctx context.Context, _ *types.QueryEipBaseFeeRequest) (*types.QueryEipBaseFeeResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	response := q.Keeper.GetCurBaseFee(sdkCtx)
 	return &types.QueryEipBaseFeeResponse{BaseFee: response}, nil
 }
+
+func (q Querier) EipBaseFeeHistory(ctx context.Context, req *types.QueryEipBaseFeeHistoryRequest) (*types.QueryEipBaseFeeHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	records, pageRes, err := q.Keeper.GetBaseFeeHistoryPaginated(sdkCtx, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryEipBaseFeeHistoryResponse{BaseFees: records, Pagination: pageRes}, nil
+}
+
+func (q Querier) FeeEstimate(ctx context.Context, req *types.QueryFeeEstimateRequest) (*types.QueryFeeEstimateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if len(req.Denom) == 0 {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty denom")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	return q.Keeper.EstimateFee(sdkCtx, q.mempoolFeeOptions, req.Gas, req.Denom)
+}
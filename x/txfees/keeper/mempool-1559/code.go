@@ -153,24 +153,35 @@ func (e *EipState) updateBaseFee(height int64) {
 	}
 	e.lastBlockHeight = height
 
-	gasUsed := e.totalGasWantedThisBlock
-	gasDiff := gasUsed - TargetGas
+	e.CurBaseFee = NextBaseFee(e.CurBaseFee, e.totalGasWantedThisBlock, TargetGas, MaxBlockChangeRate, MinBaseFee, MaxBaseFee)
+
+	go e.Clone().tryPersist()
+}
+
+// NextBaseFee returns the base fee following curBaseFee, given the gas wanted in the block.
+// It employs the following equation:
+//
+//	baseFeeMultiplier = 1 + (gasWanted - targetGas) / targetGas * maxChangeRate
+//	newBaseFee = baseFee * baseFeeMultiplier
+//
+// The result is bounded by minBaseFee and maxBaseFee. curBaseFee is not modified.
+func NextBaseFee(curBaseFee osmomath.Dec, gasWanted, targetGas int64, maxBlockChangeRate, minBaseFee, maxBaseFee osmomath.Dec) osmomath.Dec {
+	gasDiff := gasWanted - targetGas
 	//  (gasUsed - targetGas) / targetGas * maxChangeRate
-	baseFeeIncrement := sdk.NewDec(gasDiff).Quo(sdk.NewDec(TargetGas)).Mul(MaxBlockChangeRate)
+	baseFeeIncrement := sdk.NewDec(gasDiff).Quo(sdk.NewDec(targetGas)).Mul(maxBlockChangeRate)
 	baseFeeMultiplier := sdk.NewDec(1).Add(baseFeeIncrement)
-	e.CurBaseFee.MulMut(baseFeeMultiplier)
+	baseFee := curBaseFee.Mul(baseFeeMultiplier)
 
-	// Enforce the minimum base fee by resetting the CurBaseFee is it drops below the MinBaseFee
-	if e.CurBaseFee.LT(MinBaseFee) {
-		e.CurBaseFee = MinBaseFee.Clone()
+	// Enforce the minimum base fee by resetting the base fee if it drops below the minBaseFee
+	if baseFee.LT(minBaseFee) {
+		return minBaseFee.Clone()
 	}
 
-	// Enforce the maximum base fee by resetting the CurBaseFee is it goes above the MaxBaseFee
-	if e.CurBaseFee.GT(MaxBaseFee) {
-		e.CurBaseFee = MaxBaseFee.Clone()
+	// Enforce the maximum base fee by resetting the base fee if it goes above the maxBaseFee
+	if baseFee.GT(maxBaseFee) {
+		return maxBaseFee.Clone()
 	}
-
-	go e.Clone().tryPersist()
+	return baseFee
 }
 
 // GetCurBaseFee returns a clone of the CurBaseFee to avoid overwriting the initial value in
@@ -182,7 +193,13 @@ func (e *EipState) GetCurBaseFee() osmomath.Dec {
 // GetCurRecheckBaseFee returns a clone of the CurBaseFee / RecheckFeeConstant to account for
 // rechecked transactions in the feedecorator ante handler
 func (e *EipState) GetCurRecheckBaseFee() osmomath.Dec {
-	baseFee := e.CurBaseFee.Clone()
+	return RecheckBaseFee(e.CurBaseFee)
+}
+
+// RecheckBaseFee returns a clone of baseFee divided by the recheck fee constant
+// that applies to it.
+func RecheckBaseFee(baseFee osmomath.Dec) osmomath.Dec {
+	baseFee = baseFee.Clone()
 
 	// At higher base fees, we apply a smaller re-check factor.
 	// The reason for this is that the recheck factor forces the base fee to get at minimum
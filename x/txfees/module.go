@@ -102,13 +102,15 @@ func (AppModuleBasic) GetQueryCmd() *cobra.Command {
 type AppModule struct {
 	AppModuleBasic
 
-	keeper keeper.Keeper
+	keeper            keeper.Keeper
+	mempoolFeeOptions types.MempoolFeeOptions
 }
 
-func NewAppModule(keeper keeper.Keeper) AppModule {
+func NewAppModule(keeper keeper.Keeper, mempoolFeeOptions types.MempoolFeeOptions) AppModule {
 	return AppModule{
-		AppModuleBasic: NewAppModuleBasic(),
-		keeper:         keeper,
+		AppModuleBasic:    NewAppModuleBasic(),
+		keeper:            keeper,
+		mempoolFeeOptions: mempoolFeeOptions,
 	}
 }
 
@@ -124,7 +126,7 @@ func (AppModule) QuerierRoute() string { return "" }
 // module-specific GRPC queries.
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(&am.keeper))
-	types.RegisterQueryServer(cfg.QueryServer(), keeper.NewQuerier(am.keeper))
+	types.RegisterQueryServer(cfg.QueryServer(), keeper.NewQuerier(am.keeper, am.mempoolFeeOptions))
 }
 
 // RegisterInvariants registers the txfees module's invariants.
@@ -154,6 +156,7 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 // BeginBlock executes all ABCI BeginBlock logic respective to the txfees module.
 func (am AppModule) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
 	mempool1559.BeginBlockCode(ctx)
+	am.keeper.Eip1559BeginBlock(ctx)
 
 	// Check if the block gas limit has changed.
 	// If it has, update the target gas for eip1559.
@@ -164,6 +167,7 @@ func (am AppModule) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
 // returns no validator updates.
 func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
 	mempool1559.EndBlockCode(ctx)
+	am.keeper.Eip1559EndBlock(ctx)
 	return []abci.ValidatorUpdate{}
 }
 
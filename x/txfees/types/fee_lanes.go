@@ -0,0 +1,141 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/spf13/cast"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+)
+
+// FeeLane groups txs by message type so that they can be charged a different min gas price
+// in the local mempool. A tx belongs to a lane if all of its messages are of one of the lane's types.
+type FeeLane struct {
+	// Name identifies the lane in logs and errors.
+	Name string
+	// MsgTypeUrls are the message types of the txs in the lane.
+	MsgTypeUrls []string
+	// MinGasPriceMultiplier multiplies the min gas price, including the EIP-1559 base fee,
+	// required for txs in the lane.
+	MinGasPriceMultiplier osmomath.Dec
+	// RecheckFeeExempt exempts txs in the lane from being evicted from the mempool during
+	// RecheckTx because of an increase of the EIP-1559 base fee.
+	RecheckFeeExempt bool
+}
+
+// Matches returns true if all the messages of the tx belong to the lane.
+func (l FeeLane) Matches(tx sdk.Tx) bool {
+	msgs := tx.GetMsgs()
+	if len(msgs) == 0 {
+		return false
+	}
+
+	for _, msg := range msgs {
+		if !l.hasMsgType(sdk.MsgTypeURL(msg)) {
+			return false
+		}
+	}
+	return true
+}
+
+func (l FeeLane) hasMsgType(msgTypeUrl string) bool {
+	for _, laneMsgTypeUrl := range l.MsgTypeUrls {
+		if laneMsgTypeUrl == msgTypeUrl {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate checks that the lane is well formed.
+func (l FeeLane) Validate() error {
+	if l.Name == "" {
+		return fmt.Errorf("fee lane name cannot be empty")
+	}
+	if len(l.MsgTypeUrls) == 0 {
+		return fmt.Errorf("fee lane %s must have at least one message type", l.Name)
+	}
+	if l.MinGasPriceMultiplier.IsNil() || l.MinGasPriceMultiplier.IsNegative() {
+		return fmt.Errorf("fee lane %s min gas price multiplier must be non-negative", l.Name)
+	}
+	return nil
+}
+
+// GetFeeLane returns the first configured fee lane the tx belongs to.
+func (opts MempoolFeeOptions) GetFeeLane(tx sdk.Tx) (FeeLane, bool) {
+	for _, lane := range opts.FeeLanes {
+		if lane.Matches(tx) {
+			return lane, true
+		}
+	}
+	return FeeLane{}, false
+}
+
+// parseFeeLanes parses the fee lanes configured as an array of tables, e.g.
+//
+//	[[osmosis-mempool.fee-lanes]]
+//	name = "ibc-relayer"
+//	msg-type-urls = ["/ibc.core.client.v1.MsgUpdateClient", "/ibc.core.channel.v1.MsgRecvPacket"]
+//	min-gas-price-multiplier = "1"
+//	recheck-fee-exempt = true
+func parseFeeLanes(opts servertypes.AppOptions) []FeeLane {
+	valueInterface := opts.Get("osmosis-mempool.fee-lanes")
+	if valueInterface == nil {
+		return nil
+	}
+
+	rawLanes, err := cast.ToSliceE(valueInterface)
+	if err != nil {
+		panic(fmt.Errorf("invalidly configured osmosis-mempool.fee-lanes, err= %v", err))
+	}
+
+	lanes := make([]FeeLane, 0, len(rawLanes))
+	for _, rawLane := range rawLanes {
+		lane, err := parseFeeLane(rawLane)
+		if err != nil {
+			panic(fmt.Errorf("invalidly configured osmosis-mempool.fee-lanes, err= %v", err))
+		}
+		lanes = append(lanes, lane)
+	}
+	return lanes
+}
+
+func parseFeeLane(rawLane interface{}) (FeeLane, error) {
+	fields, err := cast.ToStringMapE(rawLane)
+	if err != nil {
+		return FeeLane{}, err
+	}
+
+	msgTypeUrls, err := cast.ToStringSliceE(fields["msg-type-urls"])
+	if err != nil {
+		return FeeLane{}, err
+	}
+
+	multiplier := osmomath.OneDec()
+	if rawMultiplier, ok := fields["min-gas-price-multiplier"]; ok {
+		// prepend 0 to allow the config to start with a decimal, e.g. ".5"
+		multiplier, err = osmomath.NewDecFromStr("0" + cast.ToString(rawMultiplier))
+		if err != nil {
+			return FeeLane{}, err
+		}
+	}
+
+	recheckFeeExempt := false
+	if rawExempt, ok := fields["recheck-fee-exempt"]; ok {
+		recheckFeeExempt, err = cast.ToBoolE(rawExempt)
+		if err != nil {
+			return FeeLane{}, err
+		}
+	}
+
+	lane := FeeLane{
+		Name:                  cast.ToString(fields["name"]),
+		MsgTypeUrls:           msgTypeUrls,
+		MinGasPriceMultiplier: multiplier,
+		RecheckFeeExempt:      recheckFeeExempt,
+	}
+	return lane, lane.Validate()
+}
@@ -1,6 +1,10 @@
 package types
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 // DefaultGenesis returns the default txfee genesis state.
 func DefaultGenesis() *GenesisState {
@@ -31,5 +35,15 @@ func (gs GenesisState) Validate() error {
 		return err
 	}
 
+	if gs.Eip1559BaseFee != nil && !gs.Eip1559BaseFee.IsPositive() {
+		return fmt.Errorf("eip1559 base fee must be positive: %s", gs.Eip1559BaseFee)
+	}
+
+	for _, record := range gs.Eip1559BaseFeeHistory {
+		if record.BaseFee.IsNil() || !record.BaseFee.IsPositive() {
+			return fmt.Errorf("eip1559 base fee at height %d must be positive", record.Height)
+		}
+	}
+
 	return nil
 }
@@ -4,6 +4,7 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -30,6 +31,11 @@ type GenesisState struct {
 	Feetokens []FeeToken `protobuf:"bytes,2,rep,name=feetokens,proto3" json:"feetokens"`
 	// params is the container of txfees parameters.
 	Params Params `protobuf:"bytes,4,opt,name=params,proto3" json:"params"`
+	// eip1559_base_fee is the base fee tracked in the module store. It is only
+	// set if EIP-1559 state tracking is enabled.
+	Eip1559BaseFee *cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=eip1559_base_fee,json=eip1559BaseFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"eip1559_base_fee,omitempty" yaml:"eip1559_base_fee"`
+	// eip1559_base_fee_history is the base fee at each of the recent heights.
+	Eip1559BaseFeeHistory []BaseFeeRecord `protobuf:"bytes,6,rep,name=eip1559_base_fee_history,json=eip1559BaseFeeHistory,proto3" json:"eip1559_base_fee_history" yaml:"eip1559_base_fee_history"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -86,8 +92,62 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetEip1559BaseFeeHistory() []BaseFeeRecord {
+	if m != nil {
+		return m.Eip1559BaseFeeHistory
+	}
+	return nil
+}
+
+// BaseFeeRecord is the EIP-1559 base fee at a given height.
+type BaseFeeRecord struct {
+	Height  int64                       `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty" yaml:"height"`
+	BaseFee cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=base_fee,json=baseFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"base_fee" yaml:"base_fee"`
+}
+
+func (m *BaseFeeRecord) Reset()         { *m = BaseFeeRecord{} }
+func (m *BaseFeeRecord) String() string { return proto.CompactTextString(m) }
+func (*BaseFeeRecord) ProtoMessage()    {}
+func (*BaseFeeRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4423c18e3d020b37, []int{1}
+}
+func (m *BaseFeeRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BaseFeeRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BaseFeeRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BaseFeeRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BaseFeeRecord.Merge(m, src)
+}
+func (m *BaseFeeRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *BaseFeeRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_BaseFeeRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BaseFeeRecord proto.InternalMessageInfo
+
+func (m *BaseFeeRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "osmosis.txfees.v1beta1.GenesisState")
+	proto.RegisterType((*BaseFeeRecord)(nil), "osmosis.txfees.v1beta1.BaseFeeRecord")
 }
 
 func init() {
@@ -95,26 +155,38 @@ func init() {
 }
 
 var fileDescriptor_4423c18e3d020b37 = []byte{
-	// 304 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x90, 0xcb, 0x4a, 0xf3, 0x40,
-	0x18, 0x86, 0x33, 0x7f, 0x43, 0xf9, 0x93, 0x2a, 0x48, 0x10, 0x09, 0x45, 0xc6, 0xe0, 0x01, 0xb2,
-	0x71, 0x86, 0x46, 0x97, 0xae, 0x8a, 0x54, 0x10, 0x17, 0x12, 0xbb, 0x72, 0x37, 0x89, 0x5f, 0x63,
-	0xa8, 0xc9, 0x84, 0xcc, 0x58, 0xe2, 0x5d, 0x78, 0x53, 0x42, 0x97, 0x5d, 0xba, 0x12, 0x49, 0x6e,
-	0x44, 0x92, 0x4c, 0xec, 0xc6, 0xec, 0xe6, 0xf0, 0x7c, 0xcf, 0xbc, 0xf3, 0x9a, 0xa7, 0x5c, 0x24,
-	0x5c, 0xc4, 0x82, 0xca, 0x62, 0x01, 0x20, 0xe8, 0x6a, 0x12, 0x80, 0x64, 0x13, 0x1a, 0x41, 0x0a,
-	0x22, 0x16, 0x24, 0xcb, 0xb9, 0xe4, 0xd6, 0x81, 0xa2, 0x48, 0x4b, 0x11, 0x45, 0x8d, 0xf7, 0x23,
-	0x1e, 0xf1, 0x06, 0xa1, 0xf5, 0xaa, 0xa5, 0xc7, 0x67, 0x3d, 0xce, 0x05, 0x80, 0xe4, 0x4b, 0x48,
-	0x15, 0x86, 0xc3, 0x86, 0xa3, 0x01, 0x13, 0xf0, 0xcb, 0x84, 0x3c, 0xee, 0xee, 0x4f, 0x7a, 0x34,
-	0x19, 0xcb, 0x59, 0xa2, 0x92, 0x1d, 0x7f, 0x20, 0x73, 0xe7, 0xa6, 0xcd, 0xfa, 0x20, 0x99, 0x04,
-	0xeb, 0xd0, 0x34, 0x6a, 0xe1, 0x13, 0xa4, 0x3c, 0xb1, 0x91, 0x83, 0x5c, 0xc3, 0xdf, 0x1e, 0x58,
-	0xd7, 0xa6, 0xd1, 0xa5, 0x10, 0xf6, 0x3f, 0x67, 0xe0, 0x8e, 0x3c, 0x87, 0xfc, 0xfd, 0x39, 0x32,
-	0x03, 0x98, 0xd7, 0xe0, 0x54, 0x5f, 0x7f, 0x1d, 0x69, 0xfe, 0x76, 0xd0, 0xba, 0x32, 0x87, 0x6d,
-	0x08, 0x5b, 0x77, 0x90, 0x3b, 0xf2, 0x70, 0x9f, 0xe2, 0xbe, 0xa1, 0x94, 0x40, 0xcd, 0xdc, 0xea,
-	0xff, 0x07, 0x7b, 0xba, 0xbf, 0x2b, 0x8b, 0x19, 0x80, 0x98, 0xe7, 0x2c, 0x5c, 0x42, 0x3e, 0xbd,
-	0x5b, 0x97, 0x18, 0x6d, 0x4a, 0x8c, 0xbe, 0x4b, 0x8c, 0xde, 0x2b, 0xac, 0x6d, 0x2a, 0xac, 0x7d,
-	0x56, 0x58, 0x7b, 0xf4, 0xa2, 0x58, 0x3e, 0xbf, 0x06, 0x24, 0xe4, 0x09, 0x55, 0xcf, 0x9c, 0xbf,
-	0xb0, 0x40, 0x74, 0x1b, 0xba, 0xf2, 0x2e, 0x69, 0xd1, 0x95, 0x24, 0xdf, 0x32, 0x10, 0xc1, 0xb0,
-	0x29, 0xe7, 0xe2, 0x27, 0x00, 0x00, 0xff, 0xff, 0x2d, 0x7e, 0x18, 0x10, 0xde, 0x01, 0x00, 0x00,
+	// 481 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xcf, 0x6e, 0xd3, 0x30,
+	0x1c, 0xc7, 0xeb, 0xb5, 0x94, 0xd5, 0xa3, 0x30, 0x22, 0xfe, 0x44, 0x03, 0x25, 0x55, 0x60, 0xa2,
+	0x1c, 0x88, 0xd5, 0xc2, 0x90, 0x40, 0x88, 0x43, 0x34, 0x15, 0x84, 0x76, 0x80, 0xb0, 0x13, 0x97,
+	0xca, 0x49, 0x7f, 0x4d, 0xac, 0x2e, 0x75, 0x15, 0x9b, 0xa9, 0x7d, 0x02, 0x4e, 0x48, 0xbc, 0x0e,
+	0x6f, 0xd0, 0xe3, 0x8e, 0x88, 0x43, 0x84, 0xda, 0x37, 0xe8, 0x13, 0xa0, 0xc4, 0xce, 0xaa, 0x4d,
+	0xab, 0x76, 0x4b, 0x7e, 0xf9, 0xf8, 0xeb, 0x8f, 0xbf, 0x31, 0x7e, 0xca, 0x45, 0xc2, 0x05, 0x13,
+	0x44, 0x4e, 0x87, 0x00, 0x82, 0x9c, 0x76, 0x02, 0x90, 0xb4, 0x43, 0x22, 0x18, 0x83, 0x60, 0xc2,
+	0x9d, 0xa4, 0x5c, 0x72, 0xe3, 0x81, 0xa6, 0x5c, 0x45, 0xb9, 0x9a, 0xda, 0xbb, 0x17, 0xf1, 0x88,
+	0x17, 0x08, 0xc9, 0x9f, 0x14, 0xbd, 0xb7, 0xbf, 0x21, 0x73, 0x08, 0x20, 0xf9, 0x08, 0xc6, 0x1a,
+	0xb3, 0xc2, 0x82, 0x23, 0x01, 0x15, 0x70, 0xce, 0x84, 0x9c, 0x95, 0xdf, 0x9f, 0x6c, 0x88, 0x99,
+	0xd0, 0x94, 0x26, 0xda, 0xcc, 0xf9, 0x5d, 0xc5, 0xb7, 0x3e, 0x28, 0xd7, 0xaf, 0x92, 0x4a, 0x30,
+	0x1e, 0xe3, 0x46, 0x1e, 0x38, 0x80, 0x31, 0x4f, 0x4c, 0xd4, 0x42, 0xed, 0x86, 0xbf, 0x1e, 0x18,
+	0x87, 0xb8, 0x51, 0x5a, 0x08, 0x73, 0xab, 0x55, 0x6d, 0xef, 0x74, 0x5b, 0xee, 0xd5, 0x87, 0x73,
+	0x7b, 0x00, 0xc7, 0x39, 0xe8, 0xd5, 0xe6, 0x99, 0x5d, 0xf1, 0xd7, 0x0b, 0x8d, 0x77, 0xb8, 0xae,
+	0x24, 0xcc, 0x5a, 0x0b, 0xb5, 0x77, 0xba, 0xd6, 0xa6, 0x88, 0xcf, 0x05, 0xa5, 0x03, 0xf4, 0x1a,
+	0x23, 0xc6, 0xbb, 0xc0, 0x26, 0x9d, 0x83, 0x83, 0x37, 0xfd, 0x5c, 0xac, 0x3f, 0x04, 0x30, 0x6f,
+	0xe4, 0xa2, 0xde, 0xfb, 0x79, 0x66, 0xa3, 0xbf, 0x99, 0xfd, 0x48, 0x35, 0x23, 0x06, 0x23, 0x97,
+	0x71, 0x92, 0x50, 0x19, 0xbb, 0x47, 0x10, 0xd1, 0x70, 0x76, 0x08, 0xe1, 0x2a, 0xb3, 0x1f, 0xce,
+	0x68, 0x72, 0xf2, 0xd6, 0xb9, 0x1c, 0xe2, 0xf8, 0xb7, 0xf5, 0xc8, 0xa3, 0x02, 0x7a, 0x00, 0xc6,
+	0x0f, 0x84, 0xcd, 0xcb, 0x54, 0x3f, 0x66, 0x42, 0xf2, 0x74, 0x66, 0xd6, 0x8b, 0xd3, 0xef, 0x6f,
+	0x52, 0xd7, 0x19, 0x3e, 0x84, 0x3c, 0x1d, 0x78, 0xcf, 0xf2, 0x13, 0xac, 0x32, 0xdb, 0xbe, 0x7a,
+	0xeb, 0x32, 0xd4, 0xf1, 0xef, 0x5f, 0x54, 0xf8, 0xa8, 0xe6, 0x9f, 0x6a, 0xdb, 0xd5, 0xdd, 0x9a,
+	0xdf, 0x94, 0xd3, 0x1e, 0x80, 0x38, 0x4e, 0x69, 0x38, 0x82, 0xd4, 0xf9, 0x89, 0x70, 0xf3, 0xc2,
+	0x36, 0xc6, 0x73, 0x5c, 0x8f, 0x81, 0x45, 0xb1, 0x2c, 0xfe, 0x5c, 0xd5, 0xbb, 0xbb, 0xca, 0xec,
+	0xa6, 0xda, 0x52, 0xcd, 0x1d, 0x5f, 0x03, 0xc6, 0x17, 0xbc, 0x7d, 0xde, 0xde, 0x56, 0xd1, 0xde,
+	0xeb, 0xdc, 0xf1, 0xfa, 0xf6, 0xee, 0xa8, 0xbc, 0x75, 0x6b, 0x37, 0x03, 0xe5, 0xe0, 0x1d, 0xcd,
+	0x17, 0x16, 0x3a, 0x5b, 0x58, 0xe8, 0xdf, 0xc2, 0x42, 0xbf, 0x96, 0x56, 0xe5, 0x6c, 0x69, 0x55,
+	0xfe, 0x2c, 0xad, 0xca, 0xb7, 0x6e, 0xc4, 0x64, 0xfc, 0x3d, 0x70, 0x43, 0x9e, 0x10, 0xdd, 0xd7,
+	0x8b, 0x13, 0x1a, 0x88, 0xf2, 0x85, 0x9c, 0x76, 0x5f, 0x91, 0x69, 0x79, 0x51, 0xe5, 0x6c, 0x02,
+	0x22, 0xa8, 0x17, 0x17, 0xf4, 0xe5, 0xff, 0x01, 0x00, 0xc3, 0xb2, 0x64, 0xaa, 0x62, 0x03, 0x00,
+	0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -137,6 +209,32 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Eip1559BaseFeeHistory) > 0 {
+		for iNdEx := len(m.Eip1559BaseFeeHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Eip1559BaseFeeHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.Eip1559BaseFee != nil {
+		{
+			size := m.Eip1559BaseFee.Size()
+			i -= size
+			if _, err := m.Eip1559BaseFee.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -171,6 +269,44 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *BaseFeeRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BaseFeeRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BaseFeeRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.BaseFee.Size()
+		i -= size
+		if _, err := m.BaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -200,6 +336,30 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if m.Eip1559BaseFee != nil {
+		l = m.Eip1559BaseFee.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Eip1559BaseFeeHistory) > 0 {
+		for _, e := range m.Eip1559BaseFeeHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BaseFeeRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovGenesis(uint64(m.Height))
+	}
+	l = m.BaseFee.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -337,6 +497,179 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Eip1559BaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.Eip1559BaseFee = &v
+			if err := m.Eip1559BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Eip1559BaseFeeHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Eip1559BaseFeeHistory = append(m.Eip1559BaseFeeHistory, BaseFeeRecord{})
+			if err := m.Eip1559BaseFeeHistory[len(m.Eip1559BaseFeeHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BaseFeeRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BaseFeeRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BaseFeeRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1,5 +1,7 @@
 package types
 
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
 const (
 	// ModuleName defines the module name.
 	ModuleName   = "txfees"
@@ -37,4 +39,12 @@ var (
 	FeeTokensStorePrefix               = []byte("fee_tokens")
 	KeyTxFeeProtorevTracker            = []byte("txfee_protorev_tracker")
 	KeyTxFeeProtorevTrackerStartHeight = []byte("txfee_protorev_tracker_start_height")
+	KeyEip1559CurBaseFee               = []byte("eip1559_cur_base_fee")
+	KeyEip1559BlockGasWanted           = []byte("eip1559_block_gas_wanted")
+	KeyEip1559BaseFeeHistoryPrefix     = []byte("eip1559_base_fee_history")
 )
+
+// KeyEip1559BaseFeeHistory returns the key of the base fee history record at the given height.
+func KeyEip1559BaseFeeHistory(height int64) []byte {
+	return append(append([]byte{}, KeyEip1559BaseFeeHistoryPrefix...), sdk.Uint64ToBigEndian(uint64(height))...)
+}
@@ -34,6 +34,7 @@ type MempoolFeeOptions struct {
 	HighGasTxThreshold        uint64
 	MinGasPriceForHighGasTx   osmomath.Dec
 	Mempool1559Enabled        bool
+	FeeLanes                  []FeeLane
 }
 
 func NewDefaultMempoolFeeOptions() MempoolFeeOptions {
@@ -53,6 +54,7 @@ func NewMempoolFeeOptions(opts servertypes.AppOptions) MempoolFeeOptions {
 		HighGasTxThreshold:        DefaultHighGasTxThreshold,
 		MinGasPriceForHighGasTx:   parseMinGasPriceForHighGasTx(opts),
 		Mempool1559Enabled:        parseMempool1559(opts),
+		FeeLanes:                  parseFeeLanes(opts),
 	}
 }
 
@@ -1,6 +1,9 @@
 package types
 
 import (
+	"fmt"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/osmoutils"
 
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
@@ -9,6 +12,7 @@ import (
 // Parameter store keys.
 var (
 	KeyWhitelistedFeeTokenSetters = []byte("WhitelistedFeeTokenSetters")
+	KeyEip1559Params              = []byte("Eip1559Params")
 )
 
 // ParamTable for txfees module.
@@ -16,9 +20,10 @@ func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
-func NewParams(whitelistedFeeTokenSetters []string) Params {
+func NewParams(whitelistedFeeTokenSetters []string, eip1559Params Eip1559Params) Params {
 	return Params{
 		WhitelistedFeeTokenSetters: whitelistedFeeTokenSetters,
+		Eip1559Params:              eip1559Params,
 	}
 }
 
@@ -26,6 +31,22 @@ func NewParams(whitelistedFeeTokenSetters []string) Params {
 func DefaultParams() Params {
 	return Params{
 		WhitelistedFeeTokenSetters: []string{},
+		Eip1559Params:              DefaultEip1559Params(),
+	}
+}
+
+// DefaultEip1559Params are the default EIP-1559 parameters. They match the values used by the
+// base fee tracked locally by each node. Tracking the base fee in state is disabled by default.
+func DefaultEip1559Params() Eip1559Params {
+	return Eip1559Params{
+		Enabled:            false,
+		TargetGas:          187_500_000,
+		MaxBlockChangeRate: osmomath.NewDecWithPrec(1, 1),
+		DefaultBaseFee:     osmomath.MustNewDecFromStr("0.006"),
+		MinBaseFee:         osmomath.MustNewDecFromStr("0.0025"),
+		MaxBaseFee:         osmomath.NewDec(5),
+		ResetInterval:      6000,
+		HistoryLength:      1000,
 	}
 }
 
@@ -35,6 +56,10 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateEip1559Params(p.Eip1559Params); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -42,5 +67,39 @@ func (p Params) Validate() error {
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyWhitelistedFeeTokenSetters, &p.WhitelistedFeeTokenSetters, osmoutils.ValidateAddressList),
+		paramtypes.NewParamSetPair(KeyEip1559Params, &p.Eip1559Params, validateEip1559Params),
+	}
+}
+
+func validateEip1559Params(i interface{}) error {
+	params, ok := i.(Eip1559Params)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
 	}
+
+	if params.TargetGas <= 0 {
+		return fmt.Errorf("target gas must be positive: %d", params.TargetGas)
+	}
+	if params.MaxBlockChangeRate.IsNil() || !params.MaxBlockChangeRate.IsPositive() || params.MaxBlockChangeRate.GT(osmomath.OneDec()) {
+		return fmt.Errorf("max block change rate must be in (0, 1]: %s", params.MaxBlockChangeRate)
+	}
+	// The base fee is updated multiplicatively, so it could never recover from zero
+	if params.MinBaseFee.IsNil() || !params.MinBaseFee.IsPositive() {
+		return fmt.Errorf("min base fee must be positive: %s", params.MinBaseFee)
+	}
+	if params.MaxBaseFee.IsNil() || params.MaxBaseFee.LT(params.MinBaseFee) {
+		return fmt.Errorf("max base fee (%s) must be greater than or equal to min base fee (%s)", params.MaxBaseFee, params.MinBaseFee)
+	}
+	if params.DefaultBaseFee.IsNil() || params.DefaultBaseFee.LT(params.MinBaseFee) || params.DefaultBaseFee.GT(params.MaxBaseFee) {
+		return fmt.Errorf("default base fee (%s) must be between min base fee (%s) and max base fee (%s)", params.DefaultBaseFee, params.MinBaseFee, params.MaxBaseFee)
+	}
+	if params.ResetInterval <= 0 {
+		return fmt.Errorf("reset interval must be positive: %d", params.ResetInterval)
+	}
+	// The record written in the current block is pruned if no history is kept
+	if params.HistoryLength == 0 {
+		return fmt.Errorf("history length must be positive: %d", params.HistoryLength)
+	}
+
+	return nil
 }
@@ -4,6 +4,7 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -26,6 +27,9 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // Params holds parameters for the txfees module
 type Params struct {
 	WhitelistedFeeTokenSetters []string `protobuf:"bytes,1,rep,name=whitelisted_fee_token_setters,json=whitelistedFeeTokenSetters,proto3" json:"whitelisted_fee_token_setters,omitempty" yaml:"whitelisted_fee_token_setters"`
+	// eip1559_params configures the EIP-1559 base fee tracked in the module
+	// store.
+	Eip1559Params Eip1559Params `protobuf:"bytes,2,opt,name=eip1559_params,json=eip1559Params,proto3" json:"eip1559_params" yaml:"eip1559_params"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -68,8 +72,103 @@ func (m *Params) GetWhitelistedFeeTokenSetters() []string {
 	return nil
 }
 
+func (m *Params) GetEip1559Params() Eip1559Params {
+	if m != nil {
+		return m.Eip1559Params
+	}
+	return Eip1559Params{}
+}
+
+// Eip1559Params holds the parameters of the EIP-1559 base fee tracked in the
+// module store. When disabled, the base fee is only tracked locally by each
+// node.
+type Eip1559Params struct {
+	// enabled sets whether the base fee is tracked in the module store.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty" yaml:"enabled"`
+	// target_gas is the amount of gas wanted per block at which the base fee
+	// stays constant.
+	TargetGas int64 `protobuf:"varint,2,opt,name=target_gas,json=targetGas,proto3" json:"target_gas,omitempty" yaml:"target_gas"`
+	// max_block_change_rate is the maximum relative change of the base fee in a
+	// single block.
+	MaxBlockChangeRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=max_block_change_rate,json=maxBlockChangeRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_block_change_rate" yaml:"max_block_change_rate"`
+	// default_base_fee is the base fee set every reset_interval blocks.
+	DefaultBaseFee cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=default_base_fee,json=defaultBaseFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"default_base_fee" yaml:"default_base_fee"`
+	// min_base_fee is the lower bound of the base fee.
+	MinBaseFee cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=min_base_fee,json=minBaseFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_base_fee" yaml:"min_base_fee"`
+	// max_base_fee is the upper bound of the base fee.
+	MaxBaseFee cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=max_base_fee,json=maxBaseFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_base_fee" yaml:"max_base_fee"`
+	// reset_interval is the interval, in blocks, at which the base fee is reset
+	// to default_base_fee.
+	ResetInterval int64 `protobuf:"varint,7,opt,name=reset_interval,json=resetInterval,proto3" json:"reset_interval,omitempty" yaml:"reset_interval"`
+	// history_length is the number of blocks for which the base fee is kept in
+	// the base fee history.
+	HistoryLength uint64 `protobuf:"varint,8,opt,name=history_length,json=historyLength,proto3" json:"history_length,omitempty" yaml:"history_length"`
+}
+
+func (m *Eip1559Params) Reset()         { *m = Eip1559Params{} }
+func (m *Eip1559Params) String() string { return proto.CompactTextString(m) }
+func (*Eip1559Params) ProtoMessage()    {}
+func (*Eip1559Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fcbfbe8e37bb08e6, []int{1}
+}
+func (m *Eip1559Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Eip1559Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Eip1559Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Eip1559Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Eip1559Params.Merge(m, src)
+}
+func (m *Eip1559Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Eip1559Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Eip1559Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Eip1559Params proto.InternalMessageInfo
+
+func (m *Eip1559Params) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *Eip1559Params) GetTargetGas() int64 {
+	if m != nil {
+		return m.TargetGas
+	}
+	return 0
+}
+
+func (m *Eip1559Params) GetResetInterval() int64 {
+	if m != nil {
+		return m.ResetInterval
+	}
+	return 0
+}
+
+func (m *Eip1559Params) GetHistoryLength() uint64 {
+	if m != nil {
+		return m.HistoryLength
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "osmosis.txfees.v1beta1.Params")
+	proto.RegisterType((*Eip1559Params)(nil), "osmosis.txfees.v1beta1.Eip1559Params")
 }
 
 func init() {
@@ -77,22 +176,42 @@ func init() {
 }
 
 var fileDescriptor_fcbfbe8e37bb08e6 = []byte{
-	// 230 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xce, 0x2f, 0xce, 0xcd,
-	0x2f, 0xce, 0x2c, 0xd6, 0x2f, 0xa9, 0x48, 0x4b, 0x4d, 0x2d, 0xd6, 0x2f, 0x33, 0x4c, 0x4a, 0x2d,
-	0x49, 0x34, 0xd4, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17,
-	0x12, 0x83, 0x2a, 0xd2, 0x83, 0x28, 0xd2, 0x83, 0x2a, 0x92, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07,
-	0x2b, 0xd1, 0x07, 0xb1, 0x20, 0xaa, 0x95, 0x2a, 0xb9, 0xd8, 0x02, 0xc0, 0xba, 0x85, 0xf2, 0xb9,
-	0x64, 0xcb, 0x33, 0x32, 0x4b, 0x52, 0x73, 0x32, 0x8b, 0x4b, 0x52, 0x53, 0xe2, 0xd3, 0x52, 0x53,
-	0xe3, 0x4b, 0xf2, 0xb3, 0x53, 0xf3, 0xe2, 0x8b, 0x53, 0x4b, 0x4a, 0x52, 0x8b, 0x8a, 0x25, 0x18,
-	0x15, 0x98, 0x35, 0x38, 0x9d, 0x74, 0x4e, 0xdc, 0x93, 0x67, 0xf8, 0x74, 0x4f, 0x5e, 0xa5, 0x32,
-	0x31, 0x37, 0xc7, 0x4a, 0x09, 0xaf, 0x16, 0xa5, 0x20, 0x29, 0x24, 0x79, 0xb7, 0xd4, 0xd4, 0x10,
-	0x90, 0x6c, 0x30, 0x44, 0xd2, 0xc9, 0xe7, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f,
-	0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18,
-	0xa2, 0x8c, 0xd2, 0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5, 0xa1, 0xbe, 0xd1,
-	0xcd, 0x49, 0x4c, 0x2a, 0x86, 0x71, 0xf4, 0xcb, 0x8c, 0x4c, 0xf4, 0x2b, 0x60, 0xa1, 0x50, 0x52,
-	0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0xf6, 0x8f, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0x54, 0x2a,
-	0x56, 0x19, 0x24, 0x01, 0x00, 0x00,
+	// 556 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0x8d, 0x49, 0x9b, 0x36, 0x0b, 0x89, 0xc0, 0x10, 0x30, 0x85, 0xda, 0xd1, 0x02, 0x52, 0x0e,
+	0xc5, 0x56, 0x42, 0x7b, 0xa0, 0x07, 0x84, 0x5c, 0x28, 0x42, 0xca, 0x01, 0x19, 0x4e, 0x08, 0xc9,
+	0x5a, 0x3b, 0x13, 0xdb, 0x8a, 0xed, 0x8d, 0xbc, 0xdb, 0xe0, 0xfc, 0x05, 0x17, 0xfe, 0xa9, 0xc7,
+	0x1e, 0x11, 0x07, 0x0b, 0x25, 0x12, 0x1f, 0x90, 0x2f, 0x40, 0xf6, 0x3a, 0x24, 0x41, 0x15, 0xaa,
+	0x7a, 0xf3, 0xcc, 0x7b, 0xf3, 0xde, 0x3c, 0xad, 0x77, 0xd1, 0x13, 0xca, 0x22, 0xca, 0x02, 0x66,
+	0xf0, 0x74, 0x08, 0xc0, 0x8c, 0x49, 0xd7, 0x01, 0x4e, 0xba, 0xc6, 0x98, 0x24, 0x24, 0x62, 0xfa,
+	0x38, 0xa1, 0x9c, 0xca, 0xf7, 0x4b, 0x92, 0x2e, 0x48, 0x7a, 0x49, 0xda, 0xbb, 0xe7, 0x51, 0x8f,
+	0x16, 0x14, 0x23, 0xff, 0x12, 0x6c, 0xfc, 0x5b, 0x42, 0xb5, 0x0f, 0xc5, 0xb8, 0x4c, 0xd1, 0xfe,
+	0x57, 0x3f, 0xe0, 0x10, 0x06, 0x8c, 0xc3, 0xc0, 0x1e, 0x02, 0xd8, 0x9c, 0x8e, 0x20, 0xb6, 0x19,
+	0x70, 0x0e, 0x09, 0x53, 0xa4, 0x76, 0xb5, 0x53, 0x37, 0x0f, 0xce, 0x33, 0xad, 0xb2, 0xc8, 0xb4,
+	0xa7, 0x53, 0x12, 0x85, 0xc7, 0xf8, 0xbf, 0x23, 0xd8, 0xda, 0x5b, 0xc3, 0x4f, 0x01, 0x3e, 0xe5,
+	0xe8, 0x47, 0x01, 0xca, 0x23, 0xd4, 0x84, 0x60, 0xdc, 0x3d, 0x3a, 0x7a, 0x69, 0x8b, 0x04, 0xca,
+	0x8d, 0xb6, 0xd4, 0xb9, 0xd9, 0x7b, 0xa6, 0x5f, 0x1e, 0x41, 0x7f, 0x2b, 0xd8, 0x62, 0x5f, 0x73,
+	0xbf, 0x5c, 0xa4, 0x25, 0x16, 0xd9, 0x94, 0xc2, 0x56, 0x03, 0xd6, 0xd9, 0xf8, 0xfb, 0x36, 0x6a,
+	0x6c, 0xcc, 0xcb, 0x07, 0x68, 0x07, 0x62, 0xe2, 0x84, 0x30, 0x50, 0xa4, 0xb6, 0xd4, 0xd9, 0x35,
+	0xe5, 0x45, 0xa6, 0x35, 0x4b, 0x31, 0x01, 0x60, 0x6b, 0x49, 0x91, 0x0f, 0x11, 0xe2, 0x24, 0xf1,
+	0x80, 0xdb, 0x1e, 0x11, 0x8b, 0x56, 0xcd, 0xd6, 0x22, 0xd3, 0xee, 0x88, 0x81, 0x15, 0x86, 0xad,
+	0xba, 0x28, 0xde, 0x11, 0x26, 0x4f, 0x50, 0x2b, 0x22, 0xa9, 0xed, 0x84, 0xd4, 0x1d, 0xd9, 0xae,
+	0x4f, 0x62, 0x0f, 0xec, 0x84, 0x70, 0x50, 0xaa, 0x6d, 0xa9, 0x53, 0x37, 0x4f, 0xf2, 0x08, 0x3f,
+	0x33, 0xed, 0x91, 0x5b, 0x24, 0x66, 0x83, 0x91, 0x1e, 0x50, 0x23, 0x22, 0xdc, 0xd7, 0xfb, 0xe0,
+	0x11, 0x77, 0xfa, 0x06, 0xdc, 0x45, 0xa6, 0x3d, 0x16, 0x1e, 0x97, 0x2a, 0x61, 0x4b, 0x8e, 0x48,
+	0x6a, 0xe6, 0xed, 0x93, 0xa2, 0x6b, 0x11, 0x0e, 0xb2, 0x8f, 0x6e, 0x0f, 0x60, 0x48, 0xce, 0x42,
+	0x6e, 0x3b, 0x84, 0x41, 0x7e, 0x32, 0xca, 0x56, 0x61, 0xf9, 0xea, 0x6a, 0x96, 0x0f, 0x84, 0xe5,
+	0xbf, 0x22, 0xd8, 0x6a, 0x96, 0x2d, 0x93, 0x30, 0x38, 0x05, 0x90, 0xbf, 0xa0, 0x5b, 0x51, 0x10,
+	0xaf, 0x5c, 0xb6, 0x0b, 0x97, 0xe3, 0xab, 0xb9, 0xdc, 0x2d, 0x83, 0xad, 0x09, 0x60, 0x0b, 0x45,
+	0x41, 0xbc, 0xae, 0x4e, 0xd2, 0xbf, 0xa0, 0x52, 0xbb, 0x8e, 0x3a, 0x49, 0x37, 0xd4, 0x49, 0xba,
+	0x54, 0x7f, 0x8d, 0x9a, 0x09, 0x30, 0xe0, 0x76, 0x10, 0x73, 0x48, 0x26, 0x24, 0x54, 0x76, 0x8a,
+	0x73, 0x7d, 0xb8, 0xfa, 0xab, 0x36, 0x71, 0x6c, 0x35, 0x8a, 0xc6, 0xfb, 0xb2, 0xce, 0x15, 0xfc,
+	0x80, 0x71, 0x9a, 0x4c, 0xed, 0x10, 0x62, 0x8f, 0xfb, 0xca, 0x6e, 0x5b, 0xea, 0x6c, 0xad, 0x2b,
+	0x6c, 0xe2, 0xd8, 0x6a, 0x94, 0x8d, 0x7e, 0x51, 0x9b, 0xfd, 0xf3, 0x99, 0x2a, 0x5d, 0xcc, 0x54,
+	0xe9, 0xd7, 0x4c, 0x95, 0xbe, 0xcd, 0xd5, 0xca, 0xc5, 0x5c, 0xad, 0xfc, 0x98, 0xab, 0x95, 0xcf,
+	0x3d, 0x2f, 0xe0, 0xfe, 0x99, 0xa3, 0xbb, 0x34, 0x32, 0xca, 0x0b, 0xf1, 0x3c, 0x24, 0x0e, 0x5b,
+	0x16, 0xc6, 0xa4, 0x77, 0x68, 0xa4, 0xcb, 0xb7, 0x80, 0x4f, 0xc7, 0xc0, 0x9c, 0x5a, 0x71, 0xab,
+	0x5f, 0xfc, 0x19, 0x00, 0x4c, 0x2a, 0x09, 0xf8, 0x2a, 0x04, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -115,6 +234,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Eip1559Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	if len(m.WhitelistedFeeTokenSetters) > 0 {
 		for iNdEx := len(m.WhitelistedFeeTokenSetters) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.WhitelistedFeeTokenSetters[iNdEx])
@@ -127,6 +256,94 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Eip1559Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Eip1559Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Eip1559Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.HistoryLength != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.HistoryLength))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.ResetInterval != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ResetInterval))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.MaxBaseFee.Size()
+		i -= size
+		if _, err := m.MaxBaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.MinBaseFee.Size()
+		i -= size
+		if _, err := m.MinBaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.DefaultBaseFee.Size()
+		i -= size
+		if _, err := m.DefaultBaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MaxBlockChangeRate.Size()
+		i -= size
+		if _, err := m.MaxBlockChangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.TargetGas != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.TargetGas))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
 	offset -= sovParams(v)
 	base := offset
@@ -150,6 +367,37 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovParams(uint64(l))
 		}
 	}
+	l = m.Eip1559Params.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *Eip1559Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	if m.TargetGas != 0 {
+		n += 1 + sovParams(uint64(m.TargetGas))
+	}
+	l = m.MaxBlockChangeRate.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.DefaultBaseFee.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MinBaseFee.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MaxBaseFee.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.ResetInterval != 0 {
+		n += 1 + sovParams(uint64(m.ResetInterval))
+	}
+	if m.HistoryLength != 0 {
+		n += 1 + sovParams(uint64(m.HistoryLength))
+	}
 	return n
 }
 
@@ -220,6 +468,302 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.WhitelistedFeeTokenSetters = append(m.WhitelistedFeeTokenSetters, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Eip1559Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Eip1559Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Eip1559Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Eip1559Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Eip1559Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetGas", wireType)
+			}
+			m.TargetGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetGas |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBlockChangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxBlockChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultBaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DefaultBaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinBaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxBaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResetInterval", wireType)
+			}
+			m.ResetInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResetInterval |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoryLength", wireType)
+			}
+			m.HistoryLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HistoryLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -0,0 +1,20 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/osmosis/v24/x/txfees/types"
+)
+
+func TestEip1559ParamsHistoryLength(t *testing.T) {
+	params := types.DefaultParams()
+	require.NoError(t, params.Validate())
+
+	params.Eip1559Params.HistoryLength = 1
+	require.NoError(t, params.Validate())
+
+	params.Eip1559Params.HistoryLength = 0
+	require.Error(t, params.Validate())
+}
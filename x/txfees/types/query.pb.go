@@ -7,6 +7,8 @@ import (
 	context "context"
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -445,6 +447,231 @@ func (m *QueryEipBaseFeeResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryEipBaseFeeResponse proto.InternalMessageInfo
 
+type QueryEipBaseFeeHistoryRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryEipBaseFeeHistoryRequest) Reset()         { *m = QueryEipBaseFeeHistoryRequest{} }
+func (m *QueryEipBaseFeeHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEipBaseFeeHistoryRequest) ProtoMessage()    {}
+func (*QueryEipBaseFeeHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cbc1b48c44dfdd6, []int{10}
+}
+func (m *QueryEipBaseFeeHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEipBaseFeeHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEipBaseFeeHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEipBaseFeeHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEipBaseFeeHistoryRequest.Merge(m, src)
+}
+func (m *QueryEipBaseFeeHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEipBaseFeeHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEipBaseFeeHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEipBaseFeeHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryEipBaseFeeHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryEipBaseFeeHistoryResponse struct {
+	BaseFees   []BaseFeeRecord     `protobuf:"bytes,1,rep,name=base_fees,json=baseFees,proto3" json:"base_fees" yaml:"base_fees"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryEipBaseFeeHistoryResponse) Reset()         { *m = QueryEipBaseFeeHistoryResponse{} }
+func (m *QueryEipBaseFeeHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEipBaseFeeHistoryResponse) ProtoMessage()    {}
+func (*QueryEipBaseFeeHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cbc1b48c44dfdd6, []int{11}
+}
+func (m *QueryEipBaseFeeHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEipBaseFeeHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEipBaseFeeHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEipBaseFeeHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEipBaseFeeHistoryResponse.Merge(m, src)
+}
+func (m *QueryEipBaseFeeHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEipBaseFeeHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEipBaseFeeHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEipBaseFeeHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryEipBaseFeeHistoryResponse) GetBaseFees() []BaseFeeRecord {
+	if m != nil {
+		return m.BaseFees
+	}
+	return nil
+}
+
+func (m *QueryEipBaseFeeHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryFeeEstimateRequest struct {
+	Gas   uint64 `protobuf:"varint,1,opt,name=gas,proto3" json:"gas,omitempty" yaml:"gas"`
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+}
+
+func (m *QueryFeeEstimateRequest) Reset()         { *m = QueryFeeEstimateRequest{} }
+func (m *QueryFeeEstimateRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeEstimateRequest) ProtoMessage()    {}
+func (*QueryFeeEstimateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cbc1b48c44dfdd6, []int{12}
+}
+func (m *QueryFeeEstimateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeEstimateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeEstimateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeEstimateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeEstimateRequest.Merge(m, src)
+}
+func (m *QueryFeeEstimateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeEstimateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeEstimateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeEstimateRequest proto.InternalMessageInfo
+
+func (m *QueryFeeEstimateRequest) GetGas() uint64 {
+	if m != nil {
+		return m.Gas
+	}
+	return 0
+}
+
+func (m *QueryFeeEstimateRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+type QueryFeeEstimateResponse struct {
+	// check_tx_min_fee is the minimum fee accepted when the tx enters the
+	// mempool.
+	CheckTxMinFee types.Coin `protobuf:"bytes,1,opt,name=check_tx_min_fee,json=checkTxMinFee,proto3" json:"check_tx_min_fee" yaml:"check_tx_min_fee"`
+	// recheck_tx_min_fee is the minimum fee for the tx to stay in the mempool
+	// when it is rechecked.
+	RecheckTxMinFee types.Coin `protobuf:"bytes,2,opt,name=recheck_tx_min_fee,json=recheckTxMinFee,proto3" json:"recheck_tx_min_fee" yaml:"recheck_tx_min_fee"`
+	// check_tx_min_gas_price is the minimum gas price in the base denom
+	// required in CheckTx.
+	CheckTxMinGasPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=check_tx_min_gas_price,json=checkTxMinGasPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"check_tx_min_gas_price" yaml:"check_tx_min_gas_price"`
+	// recheck_tx_min_gas_price is the minimum gas price in the base denom
+	// required in ReCheckTx.
+	RecheckTxMinGasPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=recheck_tx_min_gas_price,json=recheckTxMinGasPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"recheck_tx_min_gas_price" yaml:"recheck_tx_min_gas_price"`
+	// poolID is the pool used to convert the fee to the base denom. It is zero
+	// if the fee denom is the base denom.
+	PoolID uint64 `protobuf:"varint,5,opt,name=poolID,proto3" json:"poolID,omitempty" yaml:"pool_id"`
+	// spot_price is the price of the fee denom in the base denom used for the
+	// conversion.
+	SpotPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=spot_price,json=spotPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"spot_price" yaml:"spot_price"`
+	// eip_base_fee is the current EIP-1559 base fee.
+	EipBaseFee cosmossdk_io_math.LegacyDec `protobuf:"bytes,7,opt,name=eip_base_fee,json=eipBaseFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"eip_base_fee" yaml:"eip_base_fee"`
+}
+
+func (m *QueryFeeEstimateResponse) Reset()         { *m = QueryFeeEstimateResponse{} }
+func (m *QueryFeeEstimateResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeEstimateResponse) ProtoMessage()    {}
+func (*QueryFeeEstimateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cbc1b48c44dfdd6, []int{13}
+}
+func (m *QueryFeeEstimateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeEstimateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeEstimateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeEstimateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeEstimateResponse.Merge(m, src)
+}
+func (m *QueryFeeEstimateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeEstimateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeEstimateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeEstimateResponse proto.InternalMessageInfo
+
+func (m *QueryFeeEstimateResponse) GetCheckTxMinFee() types.Coin {
+	if m != nil {
+		return m.CheckTxMinFee
+	}
+	return types.Coin{}
+}
+
+func (m *QueryFeeEstimateResponse) GetRecheckTxMinFee() types.Coin {
+	if m != nil {
+		return m.RecheckTxMinFee
+	}
+	return types.Coin{}
+}
+
+func (m *QueryFeeEstimateResponse) GetPoolID() uint64 {
+	if m != nil {
+		return m.PoolID
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*QueryFeeTokensRequest)(nil), "osmosis.txfees.v1beta1.QueryFeeTokensRequest")
 	proto.RegisterType((*QueryFeeTokensResponse)(nil), "osmosis.txfees.v1beta1.QueryFeeTokensResponse")
@@ -456,6 +683,10 @@ func init() {
 	proto.RegisterType((*QueryBaseDenomResponse)(nil), "osmosis.txfees.v1beta1.QueryBaseDenomResponse")
 	proto.RegisterType((*QueryEipBaseFeeRequest)(nil), "osmosis.txfees.v1beta1.QueryEipBaseFeeRequest")
 	proto.RegisterType((*QueryEipBaseFeeResponse)(nil), "osmosis.txfees.v1beta1.QueryEipBaseFeeResponse")
+	proto.RegisterType((*QueryEipBaseFeeHistoryRequest)(nil), "osmosis.txfees.v1beta1.QueryEipBaseFeeHistoryRequest")
+	proto.RegisterType((*QueryEipBaseFeeHistoryResponse)(nil), "osmosis.txfees.v1beta1.QueryEipBaseFeeHistoryResponse")
+	proto.RegisterType((*QueryFeeEstimateRequest)(nil), "osmosis.txfees.v1beta1.QueryFeeEstimateRequest")
+	proto.RegisterType((*QueryFeeEstimateResponse)(nil), "osmosis.txfees.v1beta1.QueryFeeEstimateResponse")
 }
 
 func init() {
@@ -463,51 +694,75 @@ func init() {
 }
 
 var fileDescriptor_6cbc1b48c44dfdd6 = []byte{
-	// 695 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0xc1, 0x4e, 0x13, 0x5d,
-	0x14, 0xee, 0xf0, 0xff, 0xa0, 0xbd, 0x28, 0xea, 0x8d, 0x40, 0x1d, 0xcc, 0x94, 0xdc, 0xa8, 0x21,
-	0x98, 0xce, 0x85, 0x82, 0xc6, 0xb8, 0xb3, 0xa9, 0x18, 0x13, 0x62, 0x60, 0x34, 0x31, 0x61, 0x33,
-	0x99, 0x69, 0xcf, 0x94, 0x09, 0x6d, 0xef, 0xd0, 0x7b, 0x4b, 0x68, 0x8c, 0x1b, 0x9f, 0xc0, 0xc4,
-	0xc4, 0x07, 0x60, 0xe3, 0xce, 0xbd, 0x6f, 0xc0, 0x92, 0xc4, 0x8d, 0x71, 0xd1, 0x18, 0xf0, 0x09,
-	0x78, 0x02, 0x33, 0x77, 0xee, 0x74, 0x0a, 0x74, 0x68, 0xd9, 0x75, 0xe6, 0x9c, 0xf3, 0x7d, 0xdf,
-	0x99, 0xf3, 0x7d, 0x29, 0x22, 0x8c, 0x37, 0x18, 0xf7, 0x39, 0x15, 0xfb, 0x1e, 0x00, 0xa7, 0x7b,
-	0xcb, 0x2e, 0x08, 0x67, 0x99, 0xee, 0xb6, 0xa1, 0xd5, 0x31, 0x83, 0x16, 0x13, 0x0c, 0xcf, 0xa8,
-	0x1e, 0x33, 0xea, 0x31, 0x55, 0x8f, 0x7e, 0xb7, 0xc6, 0x6a, 0x4c, 0xb6, 0xd0, 0xf0, 0x57, 0xd4,
-	0xad, 0xdf, 0xaf, 0x31, 0x56, 0xab, 0x03, 0x75, 0x02, 0x9f, 0x3a, 0xcd, 0x26, 0x13, 0x8e, 0xf0,
-	0x59, 0x93, 0xab, 0xaa, 0xa1, 0xaa, 0xf2, 0xc9, 0x6d, 0x7b, 0xb4, 0xda, 0x6e, 0xc9, 0x06, 0x55,
-	0x7f, 0x98, 0xa2, 0xc7, 0x03, 0x10, 0x6c, 0x07, 0x54, 0x1b, 0x99, 0x45, 0xd3, 0x9b, 0xa1, 0xc2,
-	0x35, 0x80, 0x77, 0xe1, 0x6b, 0x6e, 0xc1, 0x6e, 0x1b, 0xb8, 0x20, 0x02, 0xcd, 0x9c, 0x2f, 0xf0,
-	0x80, 0x35, 0x39, 0xe0, 0x2d, 0x84, 0x3c, 0x00, 0x5b, 0xa2, 0xf0, 0x9c, 0x36, 0xff, 0xdf, 0xc2,
-	0x64, 0x71, 0xde, 0x1c, 0xbc, 0x9a, 0x19, 0x8f, 0x97, 0xee, 0x1d, 0x76, 0xf3, 0x99, 0xd3, 0x6e,
-	0xfe, 0x4e, 0xc7, 0x69, 0xd4, 0x9f, 0x93, 0x04, 0x81, 0x58, 0x59, 0x2f, 0xe6, 0x20, 0x65, 0xa4,
-	0x4b, 0xd6, 0x32, 0x34, 0x59, 0xe3, 0x6d, 0xc0, 0xc4, 0x46, 0xcb, 0xaf, 0x80, 0xd2, 0x84, 0x1f,
-	0xa1, 0xf1, 0x6a, 0x58, 0xc8, 0x69, 0xf3, 0xda, 0x42, 0xb6, 0x74, 0xfb, 0xb4, 0x9b, 0xbf, 0x11,
-	0xc1, 0xc9, 0xd7, 0xc4, 0x8a, 0xca, 0xe4, 0x40, 0x43, 0x73, 0x03, 0x61, 0xd4, 0x06, 0x8b, 0x68,
-	0x22, 0x60, 0xac, 0xfe, 0xba, 0x2c, 0x81, 0xfe, 0x2f, 0xe1, 0xd3, 0x6e, 0x7e, 0x2a, 0x02, 0x0a,
-	0xdf, 0xdb, 0x7e, 0x95, 0x58, 0xaa, 0x03, 0xbf, 0x47, 0x88, 0x07, 0x4c, 0xd8, 0x41, 0x88, 0x90,
-	0x1b, 0x93, 0xc4, 0xcf, 0xc2, 0x5d, 0x7e, 0x77, 0xf3, 0x73, 0x15, 0xb9, 0x35, 0xaf, 0xee, 0x98,
-	0x3e, 0xa3, 0x0d, 0x47, 0x6c, 0x9b, 0xeb, 0x50, 0x73, 0x2a, 0x9d, 0x32, 0x54, 0x92, 0x55, 0x93,
-	0x71, 0x62, 0x65, 0x79, 0x2c, 0x86, 0xbc, 0x40, 0xb3, 0x89, 0xc6, 0x8d, 0x90, 0xac, 0x7a, 0xd5,
-	0x3d, 0xd7, 0x50, 0xee, 0x22, 0xc4, 0xd5, 0x77, 0xec, 0x99, 0xa0, 0xe4, 0x70, 0x90, 0x58, 0xb1,
-	0x09, 0xde, 0x28, 0x13, 0xf4, 0x15, 0x14, 0xfc, 0x2a, 0x42, 0xae, 0xc3, 0xc1, 0xee, 0xd7, 0x39,
-	0x9d, 0xec, 0x9c, 0xd4, 0x88, 0x95, 0x75, 0xe3, 0x69, 0x92, 0x53, 0x78, 0x2f, 0xfd, 0x20, 0x84,
-	0x5c, 0x83, 0xf8, 0xb4, 0xa4, 0xae, 0xbe, 0x46, 0x7f, 0x45, 0x51, 0x6d, 0xa2, 0xeb, 0x12, 0xce,
-	0x03, 0x50, 0x44, 0x4f, 0x47, 0xfb, 0xfe, 0xb7, 0xfa, 0xb4, 0x78, 0x00, 0xc4, 0xba, 0xe6, 0x46,
-	0xd0, 0xc5, 0x1f, 0x13, 0x68, 0x5c, 0xd2, 0xe1, 0xaf, 0x1a, 0xca, 0xf6, 0x2c, 0x8e, 0x0b, 0x69,
-	0x36, 0x1e, 0x98, 0x11, 0xdd, 0x1c, 0xb5, 0x3d, 0xda, 0x84, 0x2c, 0x7e, 0xfa, 0xf9, 0xf7, 0xcb,
-	0xd8, 0x03, 0x4c, 0x68, 0x7a, 0x38, 0x55, 0x2a, 0xf0, 0x77, 0x0d, 0x4d, 0x9d, 0xb5, 0x2f, 0x2e,
-	0x5e, 0x4a, 0x37, 0x30, 0x32, 0xfa, 0xca, 0x95, 0x66, 0x94, 0xce, 0x15, 0xa9, 0xb3, 0x80, 0x1f,
-	0xa7, 0xe9, 0x4c, 0x2c, 0x6d, 0xbb, 0x9d, 0xe8, 0xce, 0xf8, 0x9b, 0x86, 0x26, 0xfb, 0x8c, 0x88,
-	0xe9, 0x70, 0xe6, 0x33, 0xae, 0xd7, 0x97, 0x46, 0x1f, 0x50, 0x3a, 0x9f, 0x48, 0x9d, 0x14, 0x17,
-	0xd2, 0x74, 0x4a, 0x65, 0xb6, 0xf2, 0x3b, 0xfd, 0x20, 0x1f, 0x3f, 0xca, 0x9b, 0xf7, 0x1c, 0x3d,
-	0xe4, 0xe6, 0xe7, 0x23, 0x31, 0xe4, 0xe6, 0x17, 0x82, 0x32, 0xfc, 0xe6, 0x49, 0x54, 0xf0, 0x81,
-	0x86, 0x6e, 0xbe, 0x02, 0x91, 0x64, 0x00, 0x5f, 0xce, 0x76, 0x21, 0x46, 0x3a, 0x1d, 0xb9, 0x5f,
-	0xc9, 0x5b, 0x92, 0xf2, 0x16, 0xf1, 0x42, 0x9a, 0xbc, 0x4a, 0xbb, 0x65, 0x83, 0x1f, 0xd8, 0x71,
-	0x8a, 0x4a, 0xeb, 0x87, 0xc7, 0x86, 0x76, 0x74, 0x6c, 0x68, 0x7f, 0x8e, 0x0d, 0xed, 0xf3, 0x89,
-	0x91, 0x39, 0x3a, 0x31, 0x32, 0xbf, 0x4e, 0x8c, 0xcc, 0x56, 0xb1, 0xe6, 0x8b, 0xed, 0xb6, 0x6b,
-	0x56, 0x58, 0x23, 0x46, 0x2b, 0xd4, 0x1d, 0x97, 0xf7, 0xa0, 0xf7, 0x8a, 0xab, 0x74, 0x3f, 0x26,
-	0x10, 0x9d, 0x00, 0xb8, 0x3b, 0x21, 0xff, 0x86, 0x56, 0xfe, 0x05, 0x00, 0x00, 0xff, 0xff, 0x13,
-	0x97, 0x61, 0x9d, 0x3f, 0x07, 0x00, 0x00,
+	// 1087 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xcd, 0x6e, 0xdb, 0x46,
+	0x10, 0x36, 0xed, 0xd8, 0x89, 0xd6, 0x89, 0xe3, 0x6c, 0x13, 0x5b, 0x66, 0x1a, 0xc9, 0x5d, 0x38,
+	0xae, 0xe1, 0xc6, 0xa4, 0x23, 0x3b, 0x41, 0x91, 0x5b, 0x55, 0x47, 0x6e, 0x81, 0xb4, 0x70, 0xd8,
+	0x00, 0x05, 0x82, 0x00, 0x04, 0x45, 0x8d, 0x68, 0xc2, 0x12, 0x97, 0xe1, 0x52, 0x81, 0x84, 0xa2,
+	0x3d, 0xf4, 0x09, 0x0a, 0x14, 0xed, 0xb1, 0x87, 0x5c, 0x7a, 0xeb, 0xb9, 0x6f, 0x90, 0x1c, 0x03,
+	0xf4, 0x52, 0xf4, 0x20, 0x14, 0x76, 0x9f, 0xc0, 0x4f, 0x50, 0x70, 0xb9, 0xfc, 0xd1, 0x0f, 0x2d,
+	0x0a, 0xc8, 0x2d, 0xe2, 0xcc, 0x7c, 0x3f, 0xbb, 0x33, 0x3b, 0x31, 0x22, 0x94, 0xb5, 0x29, 0xb3,
+	0x99, 0xea, 0x77, 0x9b, 0x00, 0x4c, 0x7d, 0x75, 0xbf, 0x0e, 0xbe, 0x71, 0x5f, 0x7d, 0xd9, 0x01,
+	0xaf, 0xa7, 0xb8, 0x1e, 0xf5, 0x29, 0x5e, 0x11, 0x39, 0x4a, 0x98, 0xa3, 0x88, 0x1c, 0xf9, 0xa6,
+	0x45, 0x2d, 0xca, 0x53, 0xd4, 0xe0, 0x5f, 0x61, 0xb6, 0xfc, 0xa1, 0x45, 0xa9, 0xd5, 0x02, 0xd5,
+	0x70, 0x6d, 0xd5, 0x70, 0x1c, 0xea, 0x1b, 0xbe, 0x4d, 0x1d, 0x26, 0xa2, 0x25, 0x11, 0xe5, 0xbf,
+	0xea, 0x9d, 0xa6, 0xda, 0xe8, 0x78, 0x3c, 0x41, 0xc4, 0xb7, 0x4d, 0x4e, 0xa6, 0xd6, 0x0d, 0x06,
+	0xa1, 0x88, 0x58, 0x92, 0x6b, 0x58, 0xb6, 0x93, 0xce, 0x2d, 0xa5, 0x73, 0xa3, 0x2c, 0x93, 0xda,
+	0x51, 0xfc, 0x6e, 0x86, 0xb7, 0x26, 0x80, 0x4f, 0x4f, 0x20, 0x4a, 0xdb, 0xc8, 0x48, 0xb3, 0xc0,
+	0x81, 0xc0, 0x35, 0xcf, 0x22, 0xab, 0xe8, 0xd6, 0xd3, 0x40, 0x4e, 0x0d, 0xe0, 0x59, 0x50, 0xcc,
+	0x34, 0x78, 0xd9, 0x01, 0xe6, 0x13, 0x1f, 0xad, 0x0c, 0x07, 0x98, 0x4b, 0x1d, 0x06, 0xf8, 0x39,
+	0x42, 0x4d, 0x00, 0x9d, 0x73, 0xb1, 0xa2, 0xb4, 0x3e, 0xb7, 0xb5, 0x58, 0x59, 0x57, 0xc6, 0x1f,
+	0xa6, 0x12, 0x95, 0x57, 0xd7, 0xde, 0xf6, 0xcb, 0x33, 0xe7, 0xfd, 0xf2, 0x8d, 0x9e, 0xd1, 0x6e,
+	0x3d, 0x22, 0x09, 0x02, 0xd1, 0x0a, 0xcd, 0x88, 0x83, 0x1c, 0x20, 0x99, 0xb3, 0x1e, 0x80, 0x43,
+	0xdb, 0xdf, 0xb8, 0xd4, 0x3f, 0xf2, 0x6c, 0x13, 0x84, 0x26, 0xbc, 0x89, 0xe6, 0x1b, 0x41, 0xa0,
+	0x28, 0xad, 0x4b, 0x5b, 0x85, 0xea, 0xf2, 0x79, 0xbf, 0x7c, 0x35, 0x84, 0xe3, 0x9f, 0x89, 0x16,
+	0x86, 0xc9, 0x6b, 0x09, 0xdd, 0x1e, 0x0b, 0x23, 0x1c, 0x6c, 0xa3, 0x05, 0x97, 0xd2, 0xd6, 0x97,
+	0x07, 0x1c, 0xe8, 0x52, 0x15, 0x9f, 0xf7, 0xcb, 0x4b, 0x21, 0x50, 0xf0, 0x5d, 0xb7, 0x1b, 0x44,
+	0x13, 0x19, 0xf8, 0x5b, 0x84, 0x98, 0x4b, 0x7d, 0xdd, 0x0d, 0x10, 0x8a, 0xb3, 0x9c, 0xf8, 0xd3,
+	0xc0, 0xcb, 0x3f, 0xfd, 0xf2, 0xed, 0xf0, 0xa6, 0x58, 0xe3, 0x44, 0xb1, 0xa9, 0xda, 0x36, 0xfc,
+	0x63, 0xe5, 0x09, 0x58, 0x86, 0xd9, 0x3b, 0x00, 0x33, 0xb1, 0x9a, 0x94, 0x13, 0xad, 0xc0, 0x22,
+	0x31, 0xe4, 0x33, 0xb4, 0x9a, 0x68, 0x3c, 0x0a, 0xc8, 0x1a, 0xd3, 0xfa, 0xac, 0xa1, 0xe2, 0x28,
+	0xc4, 0xf4, 0x1e, 0xe3, 0x26, 0xa8, 0x1a, 0x0c, 0x38, 0x56, 0xd4, 0x04, 0x5f, 0xa3, 0x95, 0xe1,
+	0x80, 0x80, 0xdf, 0x47, 0x28, 0xe8, 0x4f, 0x3d, 0xad, 0xf3, 0x56, 0xe2, 0x39, 0x89, 0x11, 0xad,
+	0x50, 0x8f, 0xaa, 0x49, 0x51, 0xe0, 0x3d, 0xb6, 0xdd, 0x00, 0xb2, 0x06, 0xd1, 0xd5, 0x92, 0x16,
+	0x5a, 0x1d, 0x89, 0x08, 0xaa, 0xa7, 0xe8, 0x0a, 0x87, 0x6b, 0x02, 0x08, 0xa2, 0x87, 0xf9, 0xce,
+	0xff, 0x7a, 0x4a, 0x4b, 0x13, 0x80, 0x68, 0x97, 0xeb, 0x21, 0x34, 0xb1, 0xd0, 0x9d, 0x21, 0xb6,
+	0x2f, 0x6c, 0xe6, 0x53, 0xaf, 0x17, 0xdd, 0x40, 0x0d, 0xa1, 0x64, 0x2e, 0x39, 0xeb, 0x62, 0x65,
+	0x53, 0x09, 0xe9, 0x94, 0x00, 0x45, 0x09, 0x5f, 0x92, 0xa8, 0xcd, 0x8f, 0x0c, 0x2b, 0xb2, 0xa2,
+	0xa5, 0x2a, 0xc9, 0x1b, 0x09, 0x95, 0xb2, 0x98, 0x84, 0xbd, 0x17, 0xa8, 0x10, 0x29, 0x8c, 0xa6,
+	0xe9, 0x6e, 0xd6, 0x34, 0xc5, 0x47, 0x63, 0x52, 0xaf, 0x51, 0x2d, 0x8a, 0x91, 0x5a, 0x1e, 0xf4,
+	0xc9, 0x88, 0x76, 0x45, 0x18, 0x65, 0xf8, 0x70, 0xc0, 0xc8, 0x2c, 0x37, 0xf2, 0xf1, 0x44, 0x23,
+	0xa1, 0xb4, 0x01, 0x27, 0xa6, 0xb8, 0xa0, 0x1a, 0xc0, 0x63, 0xe6, 0xdb, 0x6d, 0xc3, 0x8f, 0xc7,
+	0x72, 0x1d, 0xcd, 0x59, 0x06, 0x13, 0x7d, 0xb6, 0x74, 0xde, 0x2f, 0xa3, 0x50, 0x90, 0x65, 0x30,
+	0xa2, 0x05, 0xa1, 0xa4, 0xa1, 0x67, 0x2f, 0x6e, 0xe8, 0x37, 0xf3, 0xa8, 0x38, 0xca, 0x22, 0x0e,
+	0xca, 0x44, 0xcb, 0xe6, 0x31, 0x98, 0x27, 0xba, 0xdf, 0xd5, 0xdb, 0xb6, 0x13, 0xf7, 0xc3, 0x62,
+	0x65, 0x6d, 0xc0, 0x50, 0x64, 0xe5, 0x73, 0x6a, 0x3b, 0xd5, 0xb2, 0x38, 0xa3, 0xd5, 0x90, 0x6e,
+	0x18, 0x80, 0x68, 0xd7, 0xf8, 0xa7, 0x67, 0xdd, 0xaf, 0x6c, 0xa7, 0x06, 0x80, 0x6d, 0x84, 0x3d,
+	0x18, 0xa1, 0x99, 0x9d, 0x44, 0xf3, 0x91, 0xa0, 0x59, 0x0b, 0x69, 0x46, 0x21, 0x88, 0x76, 0xdd,
+	0x83, 0x41, 0xaa, 0x2e, 0x5a, 0x19, 0xc8, 0xb2, 0x0c, 0x26, 0x5e, 0x99, 0x39, 0x7e, 0x4a, 0x07,
+	0xf9, 0xba, 0xfc, 0xce, 0x18, 0x67, 0x31, 0x14, 0xd1, 0x70, 0x42, 0x7a, 0x68, 0x30, 0xfe, 0xf4,
+	0xe0, 0x1f, 0x50, 0xd1, 0x83, 0xf1, 0x05, 0xc5, 0x4b, 0x9c, 0xbb, 0x96, 0x8f, 0xbb, 0x3c, 0xd6,
+	0x6e, 0x8a, 0xfd, 0xa6, 0x07, 0x63, 0xf8, 0x93, 0xb7, 0x69, 0x7e, 0xca, 0xf7, 0x77, 0xe1, 0xbd,
+	0xbd, 0xbf, 0xf8, 0x05, 0xba, 0x0a, 0xb6, 0xab, 0xc7, 0x4f, 0xcb, 0x65, 0x0e, 0xfd, 0x28, 0x1f,
+	0xf4, 0x07, 0x21, 0x74, 0x1a, 0x80, 0x68, 0x08, 0xe2, 0x21, 0xaf, 0xfc, 0x52, 0x40, 0xf3, 0xbc,
+	0x93, 0xf1, 0xaf, 0x12, 0x2a, 0xc4, 0x4b, 0x14, 0xef, 0x64, 0x8d, 0xf6, 0xd8, 0x2d, 0x2c, 0x2b,
+	0x79, 0xd3, 0xc3, 0x19, 0x21, 0xdb, 0x3f, 0xfe, 0xf5, 0xdf, 0xcf, 0xb3, 0x1b, 0x98, 0xa8, 0xd9,
+	0xff, 0x49, 0x10, 0x7b, 0x17, 0xff, 0x21, 0xa1, 0xa5, 0xc1, 0x05, 0x89, 0x2b, 0x17, 0xd2, 0x8d,
+	0x5d, 0xca, 0xf2, 0xde, 0x54, 0x35, 0x42, 0xe7, 0x1e, 0xd7, 0xb9, 0x83, 0x3f, 0xc9, 0xd2, 0x99,
+	0x5c, 0x9a, 0x5e, 0xef, 0x85, 0x9b, 0x04, 0xff, 0x2e, 0xa1, 0xc5, 0xd4, 0xaa, 0xc3, 0xea, 0x64,
+	0xe6, 0x81, 0xbd, 0x2a, 0xef, 0xe6, 0x2f, 0x10, 0x3a, 0x1f, 0x70, 0x9d, 0x2a, 0xde, 0xc9, 0xd2,
+	0xc9, 0x95, 0xe9, 0xa2, 0x6b, 0xd5, 0xef, 0xf8, 0xcf, 0xef, 0xf9, 0x9d, 0xc7, 0x3b, 0x73, 0xc2,
+	0x9d, 0x0f, 0x2f, 0x5d, 0x59, 0xc9, 0x9b, 0x9e, 0xf7, 0xce, 0x93, 0x65, 0x8c, 0x5f, 0x4b, 0xe8,
+	0xda, 0x21, 0xf8, 0xc9, 0x36, 0xc2, 0x17, 0xb3, 0x8d, 0x2c, 0x6a, 0x59, 0xcd, 0x9d, 0x2f, 0xe4,
+	0xed, 0x72, 0x79, 0xdb, 0x78, 0x2b, 0x4b, 0x9e, 0xd9, 0xf1, 0xf4, 0xf4, 0x20, 0xe1, 0x3f, 0x25,
+	0x74, 0x63, 0x64, 0x5f, 0xe2, 0x07, 0x39, 0x89, 0x07, 0x37, 0xb9, 0xfc, 0x70, 0xda, 0x32, 0x21,
+	0x7b, 0x9f, 0xcb, 0x56, 0xf0, 0xbd, 0x2c, 0xd9, 0x69, 0xc9, 0xfa, 0xb1, 0x10, 0xf9, 0x9b, 0x84,
+	0x16, 0x53, 0xbb, 0x6b, 0x42, 0x8b, 0x8e, 0xee, 0x52, 0x79, 0x37, 0x7f, 0x81, 0x10, 0x7a, 0x8f,
+	0x0b, 0xdd, 0xc4, 0x1b, 0x17, 0x8d, 0x3c, 0x88, 0xaa, 0xea, 0x93, 0xb7, 0xa7, 0x25, 0xe9, 0xdd,
+	0x69, 0x49, 0xfa, 0xf7, 0xb4, 0x24, 0xfd, 0x74, 0x56, 0x9a, 0x79, 0x77, 0x56, 0x9a, 0xf9, 0xfb,
+	0xac, 0x34, 0xf3, 0xbc, 0x62, 0xd9, 0xfe, 0x71, 0xa7, 0xae, 0x98, 0xb4, 0x1d, 0x21, 0xed, 0xb4,
+	0x8c, 0x3a, 0x8b, 0x61, 0x5f, 0x55, 0xf6, 0xd5, 0x6e, 0x04, 0xee, 0xf7, 0x5c, 0x60, 0xf5, 0x05,
+	0xfe, 0x47, 0xc4, 0xde, 0xff, 0x03, 0x00, 0x4c, 0x1c, 0xf5, 0xdc, 0x6f, 0x0d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -534,6 +789,15 @@ type QueryClient interface {
 	BaseDenom(ctx context.Context, in *QueryBaseDenomRequest, opts ...grpc.CallOption) (*QueryBaseDenomResponse, error)
 	// Returns a list of all base denom tokens and their corresponding pools.
 	GetEipBaseFee(ctx context.Context, in *QueryEipBaseFeeRequest, opts ...grpc.CallOption) (*QueryEipBaseFeeResponse, error)
+	// EipBaseFeeHistory returns the EIP-1559 base fee at each of the recent
+	// heights, in ascending order. It is only populated when the base fee is
+	// tracked in the module store.
+	EipBaseFeeHistory(ctx context.Context, in *QueryEipBaseFeeHistoryRequest, opts ...grpc.CallOption) (*QueryEipBaseFeeHistoryResponse, error)
+	// FeeEstimate returns the minimum fee, in the given fee denom, that the
+	// queried node's mempool accepts for a tx wanting the given gas, both when
+	// the tx enters the mempool (CheckTx) and when it is rechecked after each
+	// block (ReCheckTx).
+	FeeEstimate(ctx context.Context, in *QueryFeeEstimateRequest, opts ...grpc.CallOption) (*QueryFeeEstimateResponse, error)
 }
 
 type queryClient struct {
@@ -589,6 +853,24 @@ func (c *queryClient) GetEipBaseFee(ctx context.Context, in *QueryEipBaseFeeRequ
 	return out, nil
 }
 
+func (c *queryClient) EipBaseFeeHistory(ctx context.Context, in *QueryEipBaseFeeHistoryRequest, opts ...grpc.CallOption) (*QueryEipBaseFeeHistoryResponse, error) {
+	out := new(QueryEipBaseFeeHistoryResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.txfees.v1beta1.Query/EipBaseFeeHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeeEstimate(ctx context.Context, in *QueryFeeEstimateRequest, opts ...grpc.CallOption) (*QueryFeeEstimateResponse, error) {
+	out := new(QueryFeeEstimateResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.txfees.v1beta1.Query/FeeEstimate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// FeeTokens returns a list of all the whitelisted fee tokens and their
@@ -603,6 +885,15 @@ type QueryServer interface {
 	BaseDenom(context.Context, *QueryBaseDenomRequest) (*QueryBaseDenomResponse, error)
 	// Returns a list of all base denom tokens and their corresponding pools.
 	GetEipBaseFee(context.Context, *QueryEipBaseFeeRequest) (*QueryEipBaseFeeResponse, error)
+	// EipBaseFeeHistory returns the EIP-1559 base fee at each of the recent
+	// heights, in ascending order. It is only populated when the base fee is
+	// tracked in the module store.
+	EipBaseFeeHistory(context.Context, *QueryEipBaseFeeHistoryRequest) (*QueryEipBaseFeeHistoryResponse, error)
+	// FeeEstimate returns the minimum fee, in the given fee denom, that the
+	// queried node's mempool accepts for a tx wanting the given gas, both when
+	// the tx enters the mempool (CheckTx) and when it is rechecked after each
+	// block (ReCheckTx).
+	FeeEstimate(context.Context, *QueryFeeEstimateRequest) (*QueryFeeEstimateResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -624,6 +915,12 @@ func (*UnimplementedQueryServer) BaseDenom(ctx context.Context, req *QueryBaseDe
 func (*UnimplementedQueryServer) GetEipBaseFee(ctx context.Context, req *QueryEipBaseFeeRequest) (*QueryEipBaseFeeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetEipBaseFee not implemented")
 }
+func (*UnimplementedQueryServer) EipBaseFeeHistory(ctx context.Context, req *QueryEipBaseFeeHistoryRequest) (*QueryEipBaseFeeHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EipBaseFeeHistory not implemented")
+}
+func (*UnimplementedQueryServer) FeeEstimate(ctx context.Context, req *QueryFeeEstimateRequest) (*QueryFeeEstimateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeEstimate not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -719,6 +1016,42 @@ func _Query_GetEipBaseFee_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_EipBaseFeeHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEipBaseFeeHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EipBaseFeeHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.txfees.v1beta1.Query/EipBaseFeeHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EipBaseFeeHistory(ctx, req.(*QueryEipBaseFeeHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeeEstimate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeEstimateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeEstimate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.txfees.v1beta1.Query/FeeEstimate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeEstimate(ctx, req.(*QueryFeeEstimateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.txfees.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -743,6 +1076,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetEipBaseFee",
 			Handler:    _Query_GetEipBaseFee_Handler,
 		},
+		{
+			MethodName: "EipBaseFeeHistory",
+			Handler:    _Query_EipBaseFeeHistory_Handler,
+		},
+		{
+			MethodName: "FeeEstimate",
+			Handler:    _Query_FeeEstimate_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/txfees/v1beta1/query.proto",
@@ -1043,83 +1384,290 @@ func (m *QueryEipBaseFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryEipBaseFeeHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryFeeTokensRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *QueryEipBaseFeeHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryFeeTokensResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryEipBaseFeeHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.FeeTokens) > 0 {
-		for _, e := range m.FeeTokens {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryDenomSpotPriceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryEipBaseFeeHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryDenomSpotPriceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PoolID != 0 {
-		n += 1 + sovQuery(uint64(m.PoolID))
-	}
-	l = m.SpotPrice.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryEipBaseFeeHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDenomPoolIdRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryEipBaseFeeHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
-}
-
-func (m *QueryDenomPoolIdResponse) Size() (n int) {
-	if m == nil {
+	if len(m.BaseFees) > 0 {
+		for iNdEx := len(m.BaseFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BaseFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeEstimateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeEstimateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeEstimateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Gas != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Gas))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeEstimateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeEstimateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeEstimateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.EipBaseFee.Size()
+		i -= size
+		if _, err := m.EipBaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.SpotPrice.Size()
+		i -= size
+		if _, err := m.SpotPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.PoolID != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolID))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.RecheckTxMinGasPrice.Size()
+		i -= size
+		if _, err := m.RecheckTxMinGasPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.CheckTxMinGasPrice.Size()
+		i -= size
+		if _, err := m.CheckTxMinGasPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.RecheckTxMinFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.CheckTxMinFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryFeeTokensRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryFeeTokensResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FeeTokens) > 0 {
+		for _, e := range m.FeeTokens {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDenomSpotPriceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomSpotPriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolID != 0 {
+		n += 1 + sovQuery(uint64(m.PoolID))
+	}
+	l = m.SpotPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDenomPoolIdRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomPoolIdResponse) Size() (n int) {
+	if m == nil {
 		return 0
 	}
 	var l int
@@ -1172,6 +1720,78 @@ func (m *QueryEipBaseFeeResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryEipBaseFeeHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEipBaseFeeHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BaseFees) > 0 {
+		for _, e := range m.BaseFees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeeEstimateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Gas != 0 {
+		n += 1 + sovQuery(uint64(m.Gas))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeeEstimateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CheckTxMinFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.RecheckTxMinFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.CheckTxMinGasPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.RecheckTxMinGasPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.PoolID != 0 {
+		n += 1 + sovQuery(uint64(m.PoolID))
+	}
+	l = m.SpotPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.EipBaseFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1914,6 +2534,584 @@ func (m *QueryEipBaseFeeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryEipBaseFeeHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEipBaseFeeHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEipBaseFeeHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEipBaseFeeHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEipBaseFeeHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEipBaseFeeHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseFees = append(m.BaseFees, BaseFeeRecord{})
+			if err := m.BaseFees[len(m.BaseFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeEstimateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeEstimateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeEstimateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
+			}
+			m.Gas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Gas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeEstimateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeEstimateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeEstimateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckTxMinFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CheckTxMinFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecheckTxMinFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RecheckTxMinFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckTxMinGasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CheckTxMinGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecheckTxMinGasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RecheckTxMinGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
+			}
+			m.PoolID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpotPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SpotPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EipBaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EipBaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0